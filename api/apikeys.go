@@ -0,0 +1,73 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/tidepool-org/clinic/apikeys"
+	"github.com/tidepool-org/clinic/auth"
+	"github.com/tidepool-org/clinic/errors"
+)
+
+func (h *Handler) ListAPIKeys(ec echo.Context, clinicId ClinicId, params ListAPIKeysParams) error {
+	ctx := ec.Request().Context()
+	keys, err := h.APIKeys.List(ctx, apikeys.Filter{
+		ClinicId: &clinicId,
+		Active:   params.Active,
+	})
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewAPIKeysDto(keys))
+}
+
+func (h *Handler) CreateAPIKey(ec echo.Context, clinicId ClinicId) error {
+	ctx := ec.Request().Context()
+	dto := ApiKeyCreationV1{}
+	if err := ec.Bind(&dto); err != nil {
+		return errors.BadRequest
+	}
+	if dto.UserId == nil {
+		return errors.BadRequest
+	}
+
+	createdBy := ""
+	if authData := auth.GetAuthData(ctx); authData != nil {
+		createdBy = authData.SubjectId
+	}
+
+	key, token, err := h.APIKeys.Create(ctx, apikeys.Create{
+		ClinicId:       clinicId,
+		UserId:         *dto.UserId,
+		Name:           dto.Name,
+		Scopes:         NewAPIKeyScopes(dto.Scopes),
+		ExpirationTime: dto.ExpirationTime,
+		CreatedBy:      createdBy,
+	})
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, CreatedApiKeyV1{
+		ApiKey: NewAPIKeyDto(key),
+		Token:  token,
+	})
+}
+
+func (h *Handler) RevokeAPIKey(ec echo.Context, clinicId ClinicId, apiKeyId ApiKeyId) error {
+	ctx := ec.Request().Context()
+
+	revokedBy := ""
+	if authData := auth.GetAuthData(ctx); authData != nil {
+		revokedBy = authData.SubjectId
+	}
+
+	key, err := h.APIKeys.Revoke(ctx, clinicId, apiKeyId, revokedBy)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewAPIKeyDto(key))
+}
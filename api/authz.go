@@ -0,0 +1,99 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/labstack/echo/v4"
+
+	"github.com/tidepool-org/clinic/auth"
+	"github.com/tidepool-org/clinic/errors"
+)
+
+// getRouter returns the router of the api spec, which is used to resolve the path parameters of hypothetical requests
+var getRouter = sync.OnceValues(func() (routers.Router, error) {
+	swagger, err := GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	// Match paths regardless of the host
+	swagger.Servers = nil
+	return gorillamux.NewRouter(swagger)
+})
+
+func (h *Handler) EvaluateAuthorizationPolicy(ec echo.Context) error {
+	ctx := ec.Request().Context()
+	dto := AuthorizationRequestV1{}
+	if err := ec.Bind(&dto); err != nil {
+		return err
+	}
+
+	u, err := url.Parse(dto.Path)
+	if err != nil {
+		return fmt.Errorf("%w: invalid path", errors.BadRequest)
+	}
+
+	pathParams, err := getPathParams(string(dto.Method), u)
+	if err != nil {
+		return err
+	}
+
+	request := auth.PolicyRequest{
+		Method:     string(dto.Method),
+		Path:       u.Path,
+		PathParams: pathParams,
+		Auth: &auth.Auth{
+			SubjectId:    pstr(dto.SubjectId),
+			ServerAccess: dto.ServerAccess != nil && *dto.ServerAccess,
+		},
+		ClientIP: auth.GetClientIP(ctx),
+	}
+	if dto.ClientIp != nil {
+		request.ClientIP = *dto.ClientIp
+	}
+
+	decision, err := h.Authorizer.Explain(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewAuthorizationDecisionDto(decision))
+}
+
+func getPathParams(method string, u *url.URL) (map[string]string, error) {
+	router, err := getRouter()
+	if err != nil {
+		return nil, err
+	}
+
+	_, pathParams, err := router.FindRoute(&http.Request{Method: method, URL: u})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s %s doesn't match any route", errors.BadRequest, method, u.Path)
+	}
+
+	return pathParams, nil
+}
+
+func (h *Handler) InvalidateAuthenticationCache(ec echo.Context) error {
+	dto := AuthenticationCacheInvalidationV1{}
+	if err := ec.Bind(&dto); err != nil {
+		return err
+	}
+	if dto.Token == "" {
+		return fmt.Errorf("%w: token is required", errors.BadRequest)
+	}
+
+	invalidated := 0
+	if h.TokenCache.Invalidate(dto.Token) {
+		invalidated++
+	}
+
+	return ec.JSON(http.StatusOK, AuthenticationCacheInvalidationResultV1{
+		Invalidated: invalidated,
+	})
+}
@@ -144,6 +144,21 @@ func (h *Handler) UpdateClinician(ec echo.Context, clinicId ClinicId, clinicianI
 	return ec.JSON(http.StatusOK, NewClinicianDto(result))
 }
 
+func (h *Handler) UpdateClinicianSites(ec echo.Context, clinicId ClinicId, clinicianId ClinicianId) error {
+	ctx := ec.Request().Context()
+	dto := ClinicianSitesV1{}
+	if err := ec.Bind(&dto); err != nil {
+		return err
+	}
+
+	result, err := h.Clinicians.AssignSites(ctx, string(clinicId), string(clinicianId), dto.Sites)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewClinicianDto(result))
+}
+
 func (h *Handler) ListClinicsForClinician(ec echo.Context, userId UserId, params ListClinicsForClinicianParams) error {
 	page := pagination(params.Offset, params.Limit)
 	filter := clinicians.Filter{
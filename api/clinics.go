@@ -1,12 +1,17 @@
 package api
 
 import (
+	"bytes"
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 
+	"github.com/tidepool-org/clinic/audit"
 	"github.com/tidepool-org/clinic/auth"
 	"github.com/tidepool-org/clinic/clinicians"
 	"github.com/tidepool-org/clinic/clinics"
@@ -203,7 +208,7 @@ func (h *Handler) UpdateMigration(ec echo.Context, clinicId ClinicIdV1, userId U
 		return err
 	}
 
-	migration, err := h.ClinicsMigrator.UpdateMigrationStatus(ctx, string(clinicId), string(userId), string(dto.Status))
+	migration, err := h.ClinicsMigrator.UpdateMigration(ctx, string(clinicId), string(userId), NewMigrationUpdate(dto))
 	if err != nil {
 		return err
 	}
@@ -211,6 +216,27 @@ func (h *Handler) UpdateMigration(ec echo.Context, clinicId ClinicIdV1, userId U
 	return ec.JSON(http.StatusOK, NewMigrationDto(migration))
 }
 
+func (h *Handler) RetryMigration(ec echo.Context, clinicId ClinicIdV1, userId UserId) error {
+	ctx := ec.Request().Context()
+	migration, err := h.ClinicsMigrator.RetryMigration(ctx, string(clinicId), string(userId))
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewMigrationDto(migration))
+}
+
+func (h *Handler) ListIncompleteMigrations(ec echo.Context, params ListIncompleteMigrationsParams) error {
+	ctx := ec.Request().Context()
+	page := pagination(params.Offset, params.Limit)
+	migrations, err := h.ClinicsMigrator.ListIncompleteMigrations(ctx, params.UpdatedBefore, page)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewMigrationDtos(migrations))
+}
+
 func (h *Handler) DeleteUserFromClinics(ec echo.Context, userId UserId) error {
 	ctx := ec.Request().Context()
 
@@ -337,6 +363,43 @@ func (h *Handler) ListMembershipRestrictions(ec echo.Context, clinicId ClinicId)
 	return ec.JSON(http.StatusOK, NewMembershipRestrictionsDto(updated))
 }
 
+func (h *Handler) GetAccessRestrictions(ec echo.Context, clinicId ClinicId) error {
+	ctx := ec.Request().Context()
+	restrictions, err := h.Clinics.GetAccessRestrictions(ctx, clinicId)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewAccessRestrictionsDto(restrictions))
+}
+
+func (h *Handler) UpdateAccessRestrictions(ec echo.Context, clinicId ClinicId) error {
+	ctx := ec.Request().Context()
+	dto := AccessRestrictionsV1{}
+	if err := ec.Bind(&dto); err != nil {
+		return err
+	}
+
+	if err := h.Clinics.UpdateAccessRestrictions(ctx, clinicId, NewAccessRestrictions(dto)); err != nil {
+		return err
+	}
+
+	return h.GetAccessRestrictions(ec, clinicId)
+}
+
+func (h *Handler) ListAuditEvents(ec echo.Context, clinicId ClinicId, params ListAuditEventsParams) error {
+	ctx := ec.Request().Context()
+	events, err := h.AuditEvents.List(ctx, audit.Filter{
+		ClinicId: &clinicId,
+		Type:     params.Type,
+	}, pagination(params.Offset, params.Limit))
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewAuditEventsDto(events))
+}
+
 func (h *Handler) UpdateMembershipRestrictions(ec echo.Context, clinicId ClinicId) error {
 	ctx := ec.Request().Context()
 	dto := MembershipRestrictionsV1{}
@@ -351,6 +414,31 @@ func (h *Handler) UpdateMembershipRestrictions(ec echo.Context, clinicId ClinicI
 	return h.ListMembershipRestrictions(ec, clinicId)
 }
 
+func (h *Handler) ListClinicianRoles(ec echo.Context, clinicId ClinicId) error {
+	ctx := ec.Request().Context()
+	roles, err := h.Clinicians.ListRoles(ctx, clinicId)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewClinicianRoleListDto(roles))
+}
+
+func (h *Handler) UpdateClinicianRoles(ec echo.Context, clinicId ClinicId) error {
+	ctx := ec.Request().Context()
+	dto := ClinicianRoleListV1{}
+	if err := ec.Bind(&dto); err != nil {
+		return err
+	}
+
+	roles, err := h.Clinicians.UpdateCustomRoles(ctx, clinicId, NewCustomClinicianRoles(dto))
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewClinicianRoleListDto(roles))
+}
+
 func (h *Handler) GetEHRSettings(ec echo.Context, clinicId ClinicId) error {
 	ctx := ec.Request().Context()
 
@@ -473,6 +561,9 @@ func (h *Handler) RefreshPatientCount(ec echo.Context, clinicId ClinicId) error
 	return ec.NoContent(http.StatusOK)
 }
 
+// mergeReportContentTypes are the formats of the merge report in order of preference
+var mergeReportContentTypes = []string{mimeApplicationExcel, mimeApplicationMergeReportV1, echo.MIMEApplicationJSON, echo.MIMETextHTML}
+
 func (h *Handler) GenerateMergeReport(ec echo.Context, clinicId ClinicId) error {
 	ctx := ec.Request().Context()
 	dto := GenerateMergeReportV1{}
@@ -486,11 +577,47 @@ func (h *Handler) GenerateMergeReport(ec echo.Context, clinicId ClinicId) error
 		return err
 	}
 
-	if ec.Request().Header.Get("Accept") == "application/json" {
-		return ec.JSON(http.StatusOK, plan)
+	return writeMergeReport(ec, plan)
+}
+
+func (h *Handler) GenerateConsolidationReport(ec echo.Context, clinicId ClinicId) error {
+	ctx := ec.Request().Context()
+	dto := ConsolidateClinicsV1{}
+	if err := ec.Bind(&dto); err != nil {
+		return err
 	}
 
+	planner := merge.NewConsolidationPlanner(h.Clinics, h.Patients, h.Clinicians, dto.SourceIds, clinicId, NewConsolidationOptions(dto))
+	plan, err := planner.Plan(ctx)
+	if err != nil {
+		return err
+	}
+
+	return writeMergeReport(ec, plan.Combined())
+}
+
+// writeMergeReport writes the report of the merge plan in the format selected by the Accept header
+func writeMergeReport(ec echo.Context, plan merge.ClinicMergePlan) error {
 	report := merge.NewReport(plan)
+	switch negotiateContentType(ec.Request().Header.Get(echo.HeaderAccept), mergeReportContentTypes) {
+	case echo.MIMEApplicationJSON:
+		// The plan is returned unchanged for the existing clients of the endpoint
+		return ec.JSON(http.StatusOK, plan)
+	case mimeApplicationMergeReportV1:
+		body, err := json.Marshal(NewClinicMergeReportDto(report.Data()))
+		if err != nil {
+			return err
+		}
+		return ec.Blob(http.StatusOK, mimeApplicationMergeReportV1, body)
+	case echo.MIMETextHTML:
+		// The report is rendered before the status is written, so template errors are returned as server errors
+		var body bytes.Buffer
+		if err := report.GenerateHTML(&body); err != nil {
+			return err
+		}
+		return ec.HTMLBlob(http.StatusOK, body.Bytes())
+	}
+
 	file, err := report.Generate()
 	if err != nil {
 		return err
@@ -498,7 +625,7 @@ func (h *Handler) GenerateMergeReport(ec echo.Context, clinicId ClinicId) error
 
 	disposition := fmt.Sprintf("attachment; filename=merge-report-%d.xlsx", time.Now().Unix())
 	ec.Response().Header().Set(echo.HeaderContentDisposition, disposition)
-	ec.Response().Header().Set(echo.HeaderContentType, "application/vnd.ms-excel")
+	ec.Response().Header().Set(echo.HeaderContentType, mimeApplicationExcel)
 	ec.Response().WriteHeader(http.StatusOK)
 	return file.Write(ec.Response())
 }
@@ -516,21 +643,252 @@ func (h *Handler) MergeClinic(ec echo.Context, clinicId ClinicId) error {
 		return err
 	}
 
-	_, err = h.ClinicMergePlanExecutor.Execute(ctx, plan)
+	schedule := h.ClinicMergePlanExecutor.Schedule
+	if dto.ReviewDuplicates != nil && *dto.ReviewDuplicates {
+		schedule = h.ClinicMergePlanExecutor.ScheduleReview
+	}
+	planId, err := schedule(ctx, plan)
 	if err != nil {
 		return err
 	}
 
-	return ec.NoContent(http.StatusOK)
+	job, err := h.ClinicMergePlanExecutor.GetJob(ctx, planId)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusAccepted, NewClinicMergeJobDto(job))
+}
+
+func (h *Handler) ConsolidateClinics(ec echo.Context, clinicId ClinicId) error {
+	ctx := ec.Request().Context()
+	dto := ConsolidateClinicsV1{}
+	if err := ec.Bind(&dto); err != nil {
+		return err
+	}
+
+	planner := merge.NewConsolidationPlanner(h.Clinics, h.Patients, h.Clinicians, dto.SourceIds, clinicId, NewConsolidationOptions(dto))
+	plan, err := planner.Plan(ctx)
+	if err != nil {
+		return err
+	}
+
+	consolidationId, err := h.ClinicMergePlanExecutor.ScheduleConsolidation(ctx, plan)
+	if err != nil {
+		return err
+	}
+
+	consolidation, err := h.ClinicMergePlanExecutor.GetConsolidation(ctx, consolidationId)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusAccepted, NewClinicConsolidationDto(consolidation))
+}
+
+func (h *Handler) GetClinicConsolidation(ec echo.Context, clinicId ClinicId, consolidationId ConsolidationId) error {
+	id, err := primitive.ObjectIDFromHex(consolidationId)
+	if err != nil {
+		return fmt.Errorf("%w: invalid consolidation id", errors.BadRequest)
+	}
+
+	consolidation, err := h.ClinicMergePlanExecutor.GetConsolidation(ec.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+	if consolidation.TargetClinicId.Hex() != clinicId {
+		return errors.NotFound
+	}
+
+	return ec.JSON(http.StatusOK, NewClinicConsolidationDto(consolidation))
+}
+
+func (h *Handler) GenerateSplitReport(ec echo.Context, clinicId ClinicId) error {
+	ctx := ec.Request().Context()
+	dto := SplitClinicV1{}
+	if err := ec.Bind(&dto); err != nil {
+		return err
+	}
+
+	planner := merge.NewSplitPlanner(h.Clinics, h.Patients, h.Clinicians, clinicId, dto.Name, NewSplitSelection(dto))
+	plan, err := planner.Plan(ctx)
+	if err != nil {
+		return err
+	}
+
+	file, err := merge.NewSplitReport(plan).Generate()
+	if err != nil {
+		return err
+	}
+
+	disposition := fmt.Sprintf("attachment; filename=split-report-%d.xlsx", time.Now().Unix())
+	ec.Response().Header().Set(echo.HeaderContentDisposition, disposition)
+	ec.Response().Header().Set(echo.HeaderContentType, mimeApplicationExcel)
+	ec.Response().WriteHeader(http.StatusOK)
+	return file.Write(ec.Response())
+}
+
+func (h *Handler) SplitClinic(ec echo.Context, clinicId ClinicId) error {
+	ctx := ec.Request().Context()
+	dto := SplitClinicV1{}
+	if err := ec.Bind(&dto); err != nil {
+		return err
+	}
+
+	planner := merge.NewSplitPlanner(h.Clinics, h.Patients, h.Clinicians, clinicId, dto.Name, NewSplitSelection(dto))
+	plan, err := planner.Plan(ctx)
+	if err != nil {
+		return err
+	}
+
+	clinic, err := h.ClinicMergePlanExecutor.Split(ctx, plan)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusCreated, NewClinicDto(clinic))
+}
+
+func (h *Handler) GetClinicMerge(ec echo.Context, clinicId ClinicId, planId PlanId) error {
+	ctx := ec.Request().Context()
+	job, err := h.getClinicMergeJob(ec, clinicId, planId)
+	if err != nil {
+		return err
+	}
+
+	job, err = h.ClinicMergePlanExecutor.GetJob(ctx, job.Id)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewClinicMergeJobDto(job))
+}
+
+func (h *Handler) PreflightClinicMergeRollback(ec echo.Context, clinicId ClinicId, planId PlanId) error {
+	ctx := ec.Request().Context()
+	job, err := h.getClinicMergeJob(ec, clinicId, planId)
+	if err != nil {
+		return err
+	}
+
+	report, err := h.ClinicMergePlanExecutor.PreflightRollback(ctx, job.Id)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewClinicMergeRollbackReportDto(report))
+}
+
+func (h *Handler) RollbackClinicMerge(ec echo.Context, clinicId ClinicId, planId PlanId) error {
+	ctx := ec.Request().Context()
+	job, err := h.getClinicMergeJob(ec, clinicId, planId)
+	if err != nil {
+		return err
+	}
+
+	report, err := h.ClinicMergePlanExecutor.Rollback(ctx, job.Id)
+	if stderrors.Is(err, merge.ErrRollbackConflicts) {
+		return ec.JSON(http.StatusUnprocessableEntity, NewClinicMergeRollbackReportDto(report))
+	} else if err != nil {
+		return err
+	}
+
+	job, err = h.ClinicMergePlanExecutor.GetJob(ctx, job.Id)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusAccepted, NewClinicMergeJobDto(job))
+}
+
+func (h *Handler) ListClinicMergeDuplicates(ec echo.Context, clinicId ClinicId, planId PlanId) error {
+	ctx := ec.Request().Context()
+	job, err := h.getClinicMergeJob(ec, clinicId, planId)
+	if err != nil {
+		return err
+	}
+
+	clusters, err := h.ClinicMergePlanExecutor.ListDuplicates(ctx, job.Id)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewClinicMergeDuplicatesDto(clusters))
+}
+
+func (h *Handler) ResolveClinicMergeDuplicate(ec echo.Context, clinicId ClinicId, planId PlanId, duplicateId DuplicateId) error {
+	ctx := ec.Request().Context()
+	dto := ClinicMergeDuplicateResolutionV1{}
+	if err := ec.Bind(&dto); err != nil {
+		return err
+	}
+
+	clusterId, err := primitive.ObjectIDFromHex(duplicateId)
+	if err != nil {
+		return fmt.Errorf("%w: invalid duplicate id", errors.BadRequest)
+	}
+
+	job, err := h.getClinicMergeJob(ec, clinicId, planId)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := h.ClinicMergePlanExecutor.ResolveDuplicate(ctx, job.Id, clusterId, NewDuplicateResolution(dto))
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewClinicMergeDuplicateDto(*cluster))
+}
+
+func (h *Handler) StartClinicMerge(ec echo.Context, clinicId ClinicId, planId PlanId) error {
+	ctx := ec.Request().Context()
+	job, err := h.getClinicMergeJob(ec, clinicId, planId)
+	if err != nil {
+		return err
+	}
+
+	if err := h.ClinicMergePlanExecutor.StartJob(ctx, job.Id); err != nil {
+		return err
+	}
+
+	job, err = h.ClinicMergePlanExecutor.GetJob(ctx, job.Id)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusAccepted, NewClinicMergeJobDto(job))
+}
+
+// getClinicMergeJob returns the merge job if the clinic is the target of the merge
+func (h *Handler) getClinicMergeJob(ec echo.Context, clinicId ClinicId, planId PlanId) (*merge.Job, error) {
+	id, err := primitive.ObjectIDFromHex(planId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid plan id", errors.BadRequest)
+	}
+
+	job, err := h.ClinicMergePlanExecutor.GetJob(ec.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+	if job.TargetClinicId.Hex() != clinicId {
+		return nil, errors.NotFound
+	}
+
+	return job, nil
 }
 
 func (h *Handler) CreateSite(ec echo.Context, clinicId ClinicId) error {
 	ctx := ec.Request().Context()
-	site := &SiteV1{}
-	if err := ec.Bind(site); err != nil {
+	dto := &SiteCreationV1{}
+	if err := ec.Bind(dto); err != nil {
 		return errors.BadRequest
 	}
-	created, err := h.ClinicsManager.CreateSite(ctx, clinicId, site.Name)
+	site, err := NewSiteFromCreation(*dto)
+	if err != nil {
+		return err
+	}
+	created, err := h.Clinics.CreateSite(ctx, clinicId, site)
 	if err != nil {
 		return err
 	}
@@ -558,6 +916,32 @@ func (h *Handler) UpdateSite(ec echo.Context, clinicId ClinicId, siteId SiteId)
 	return ec.JSON(http.StatusOK, updated)
 }
 
+func (h *Handler) UpdateSiteParent(ec echo.Context, clinicId ClinicId, siteId SiteId) error {
+	ctx := ec.Request().Context()
+	dto := &SiteParentV1{}
+	if err := ec.Bind(dto); err != nil {
+		return errors.BadRequest
+	}
+	updated, err := h.Clinics.UpdateSiteParent(ctx, clinicId, siteId, dto.ParentId)
+	if err != nil {
+		return err
+	}
+	return ec.JSON(http.StatusOK, updated)
+}
+
+func (h *Handler) UpdateSiteSettings(ec echo.Context, clinicId ClinicId, siteId SiteId) error {
+	ctx := ec.Request().Context()
+	dto := &SiteSettingsV1{}
+	if err := ec.Bind(dto); err != nil {
+		return errors.BadRequest
+	}
+	updated, err := h.Clinics.UpdateSiteSettings(ctx, clinicId, siteId, NewSiteSettings(*dto))
+	if err != nil {
+		return err
+	}
+	return ec.JSON(http.StatusOK, updated)
+}
+
 func (h *Handler) MergeSite(ec echo.Context, clinicId ClinicId, targetSiteId SiteId) error {
 	ctx := ec.Request().Context()
 	site := &SiteByIdV1{}
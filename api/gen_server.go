@@ -13,6 +13,12 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Invalidate Authentication Cache
+	// (POST /v1/auth/cache/invalidate)
+	InvalidateAuthenticationCache(ctx echo.Context) error
+	// Evaluate Authorization Policy
+	// (POST /v1/authz/evaluate)
+	EvaluateAuthorizationPolicy(ctx echo.Context) error
 	// List All Clinicians
 	// (GET /v1/clinicians)
 	ListAllClinicians(ctx echo.Context, params ListAllCliniciansParams) error
@@ -40,6 +46,30 @@ type ServerInterface interface {
 	// Update Clinic
 	// (PUT /v1/clinics/{clinicId})
 	UpdateClinic(ctx echo.Context, clinicId ClinicId) error
+	// Get Access Restrictions
+	// (GET /v1/clinics/{clinicId}/access_restrictions)
+	GetAccessRestrictions(ctx echo.Context, clinicId ClinicId) error
+	// Update Access Restrictions
+	// (PUT /v1/clinics/{clinicId}/access_restrictions)
+	UpdateAccessRestrictions(ctx echo.Context, clinicId ClinicId) error
+	// List API Keys
+	// (GET /v1/clinics/{clinicId}/api_keys)
+	ListAPIKeys(ctx echo.Context, clinicId ClinicId, params ListAPIKeysParams) error
+	// Create API Key
+	// (POST /v1/clinics/{clinicId}/api_keys)
+	CreateAPIKey(ctx echo.Context, clinicId ClinicId) error
+	// Revoke API Key
+	// (DELETE /v1/clinics/{clinicId}/api_keys/{apiKeyId})
+	RevokeAPIKey(ctx echo.Context, clinicId ClinicId, apiKeyId ApiKeyId) error
+	// List Audit Events
+	// (GET /v1/clinics/{clinicId}/audit_events)
+	ListAuditEvents(ctx echo.Context, clinicId ClinicId, params ListAuditEventsParams) error
+	// List Clinician Roles
+	// (GET /v1/clinics/{clinicId}/clinician_roles)
+	ListClinicianRoles(ctx echo.Context, clinicId ClinicId) error
+	// Update Clinician Roles
+	// (PUT /v1/clinics/{clinicId}/clinician_roles)
+	UpdateClinicianRoles(ctx echo.Context, clinicId ClinicId) error
 	// List Clinicians
 	// (GET /v1/clinics/{clinicId}/clinicians)
 	ListClinicians(ctx echo.Context, clinicId ClinicId, params ListCliniciansParams) error
@@ -55,6 +85,15 @@ type ServerInterface interface {
 	// Update Clinician
 	// (PUT /v1/clinics/{clinicId}/clinicians/{clinicianId})
 	UpdateClinician(ctx echo.Context, clinicId ClinicId, clinicianId ClinicianId) error
+	// Update Clinician Sites
+	// (PUT /v1/clinics/{clinicId}/clinicians/{clinicianId}/sites)
+	UpdateClinicianSites(ctx echo.Context, clinicId ClinicId, clinicianId ClinicianId) error
+	// Consolidate Clinics
+	// (POST /v1/clinics/{clinicId}/consolidate)
+	ConsolidateClinics(ctx echo.Context, clinicId ClinicId) error
+	// Get Clinic Consolidation
+	// (GET /v1/clinics/{clinicId}/consolidations/{consolidationId})
+	GetClinicConsolidation(ctx echo.Context, clinicId ClinicId, consolidationId ConsolidationId) error
 	// Sync EHR Data
 	// (POST /v1/clinics/{clinicId}/ehr/sync)
 	SyncEHRData(ctx echo.Context, clinicId ClinicId) error
@@ -76,6 +115,24 @@ type ServerInterface interface {
 	// Merge Clinic
 	// (POST /v1/clinics/{clinicId}/merge)
 	MergeClinic(ctx echo.Context, clinicId ClinicId) error
+	// Get Clinic Merge
+	// (GET /v1/clinics/{clinicId}/merges/{planId})
+	GetClinicMerge(ctx echo.Context, clinicId ClinicId, planId PlanId) error
+	// List Clinic Merge Duplicates
+	// (GET /v1/clinics/{clinicId}/merges/{planId}/duplicates)
+	ListClinicMergeDuplicates(ctx echo.Context, clinicId ClinicId, planId PlanId) error
+	// Resolve Clinic Merge Duplicate
+	// (PUT /v1/clinics/{clinicId}/merges/{planId}/duplicates/{duplicateId})
+	ResolveClinicMergeDuplicate(ctx echo.Context, clinicId ClinicId, planId PlanId, duplicateId DuplicateId) error
+	// Preflight Clinic Merge Rollback
+	// (GET /v1/clinics/{clinicId}/merges/{planId}/rollback)
+	PreflightClinicMergeRollback(ctx echo.Context, clinicId ClinicId, planId PlanId) error
+	// Rollback Clinic Merge
+	// (POST /v1/clinics/{clinicId}/merges/{planId}/rollback)
+	RollbackClinicMerge(ctx echo.Context, clinicId ClinicId, planId PlanId) error
+	// Start Clinic Merge
+	// (POST /v1/clinics/{clinicId}/merges/{planId}/start)
+	StartClinicMerge(ctx echo.Context, clinicId ClinicId, planId PlanId) error
 	// Trigger initial migration
 	// (POST /v1/clinics/{clinicId}/migrate)
 	TriggerInitialMigration(ctx echo.Context, clinicId string) error
@@ -91,12 +148,24 @@ type ServerInterface interface {
 	// Update Migration
 	// (PATCH /v1/clinics/{clinicId}/migrations/{userId})
 	UpdateMigration(ctx echo.Context, clinicId ClinicIdV1, userId UserId) error
+	// Retry Migration
+	// (POST /v1/clinics/{clinicId}/migrations/{userId}/retry)
+	RetryMigration(ctx echo.Context, clinicId ClinicIdV1, userId UserId) error
 	// Get Patient Count
 	// (GET /v1/clinics/{clinicId}/patient_count)
 	GetPatientCount(ctx echo.Context, clinicId ClinicId) error
 	// Refresh Patient Count
 	// (POST /v1/clinics/{clinicId}/patient_count/refresh)
 	RefreshPatientCount(ctx echo.Context, clinicId ClinicId) error
+	// Get Patient Duplicates
+	// (GET /v1/clinics/{clinicId}/patient_duplicates)
+	GetPatientDuplicates(ctx echo.Context, clinicId ClinicId) error
+	// Merge Patient Duplicate
+	// (POST /v1/clinics/{clinicId}/patient_duplicates/merge)
+	MergePatientDuplicate(ctx echo.Context, clinicId ClinicId) error
+	// Scan Patient Duplicates
+	// (POST /v1/clinics/{clinicId}/patient_duplicates/scan)
+	ScanPatientDuplicates(ctx echo.Context, clinicId ClinicId) error
 	// Create Patient Tag
 	// (POST /v1/clinics/{clinicId}/patient_tags)
 	CreatePatientTag(ctx echo.Context, clinicId ClinicId) error
@@ -151,9 +220,15 @@ type ServerInterface interface {
 	// Send Upload Reminder
 	// (POST /v1/clinics/{clinicId}/patients/{patientId}/upload_reminder)
 	SendUploadReminder(ctx echo.Context, clinicId ClinicId, patientId PatientId) error
+	// Generate Clinic Consolidation Report
+	// (POST /v1/clinics/{clinicId}/reports/consolidation)
+	GenerateConsolidationReport(ctx echo.Context, clinicId ClinicId) error
 	// Generate Clinic Merge Report
 	// (POST /v1/clinics/{clinicId}/reports/merge)
 	GenerateMergeReport(ctx echo.Context, clinicId ClinicId) error
+	// Generate Clinic Split Report
+	// (POST /v1/clinics/{clinicId}/reports/split)
+	GenerateSplitReport(ctx echo.Context, clinicId ClinicId) error
 	// Add Service Account
 	// (POST /v1/clinics/{clinicId}/service_accounts)
 	AddServiceAccount(ctx echo.Context, clinicId ClinicId) error
@@ -187,6 +262,15 @@ type ServerInterface interface {
 	// Merge two sites
 	// (POST /v1/clinics/{clinicId}/sites/{siteId}/merge)
 	MergeSite(ctx echo.Context, clinicId ClinicId, siteId SiteId) error
+	// Update the Parent of a Site
+	// (PUT /v1/clinics/{clinicId}/sites/{siteId}/parent)
+	UpdateSiteParent(ctx echo.Context, clinicId ClinicId, siteId SiteId) error
+	// Update Site Settings
+	// (PUT /v1/clinics/{clinicId}/sites/{siteId}/settings)
+	UpdateSiteSettings(ctx echo.Context, clinicId ClinicId, siteId SiteId) error
+	// Split Clinic
+	// (POST /v1/clinics/{clinicId}/split)
+	SplitClinic(ctx echo.Context, clinicId ClinicId) error
 	// Update Suppressed Notifications
 	// (POST /v1/clinics/{clinicId}/suppressed_notifications)
 	UpdateSuppressedNotifications(ctx echo.Context, clinicId ClinicId) error
@@ -196,6 +280,18 @@ type ServerInterface interface {
 	// Update Tier
 	// (POST /v1/clinics/{clinicId}/tier)
 	UpdateTier(ctx echo.Context, clinicId ClinicId) error
+	// List Xealth Patients Not Viewed Since Last Upload
+	// (GET /v1/clinics/{clinicId}/xealth/patients_not_viewed)
+	ListXealthPatientsNotViewed(ctx echo.Context, clinicId ClinicId, params ListXealthPatientsNotViewedParams) error
+	// List Xealth Report Views
+	// (GET /v1/clinics/{clinicId}/xealth/report_views)
+	ListXealthReportViews(ctx echo.Context, clinicId ClinicId, params ListXealthReportViewsParams) error
+	// Get Xealth Report View Statistics
+	// (GET /v1/clinics/{clinicId}/xealth/report_views/stats)
+	GetXealthReportViewStats(ctx echo.Context, clinicId ClinicId, params GetXealthReportViewStatsParams) error
+	// List Incomplete Migrations
+	// (GET /v1/migrations)
+	ListIncompleteMigrations(ctx echo.Context, params ListIncompleteMigrationsParams) error
 	// Find Patients
 	// (GET /v1/patients)
 	FindPatients(ctx echo.Context, params FindPatientsParams) error
@@ -251,6 +347,28 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// InvalidateAuthenticationCache converts echo context to params.
+func (w *ServerInterfaceWrapper) InvalidateAuthenticationCache(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InvalidateAuthenticationCache(ctx)
+	return err
+}
+
+// EvaluateAuthorizationPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) EvaluateAuthorizationPolicy(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EvaluateAuthorizationPolicy(ctx)
+	return err
+}
+
 // ListAllClinicians converts echo context to params.
 func (w *ServerInterfaceWrapper) ListAllClinicians(ctx echo.Context) error {
 	var err error
@@ -482,6 +600,190 @@ func (w *ServerInterfaceWrapper) UpdateClinic(ctx echo.Context) error {
 	return err
 }
 
+// GetAccessRestrictions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccessRestrictions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAccessRestrictions(ctx, clinicId)
+	return err
+}
+
+// UpdateAccessRestrictions converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateAccessRestrictions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateAccessRestrictions(ctx, clinicId)
+	return err
+}
+
+// ListAPIKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) ListAPIKeys(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAPIKeysParams
+	// ------------- Optional query parameter "active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active", ctx.QueryParams(), &params.Active)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter active: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListAPIKeys(ctx, clinicId, params)
+	return err
+}
+
+// CreateAPIKey converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateAPIKey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateAPIKey(ctx, clinicId)
+	return err
+}
+
+// RevokeAPIKey converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeAPIKey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	// ------------- Path parameter "apiKeyId" -------------
+	var apiKeyId ApiKeyId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "apiKeyId", ctx.Param("apiKeyId"), &apiKeyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter apiKeyId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevokeAPIKey(ctx, clinicId, apiKeyId)
+	return err
+}
+
+// ListAuditEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) ListAuditEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditEventsParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListAuditEvents(ctx, clinicId, params)
+	return err
+}
+
+// ListClinicianRoles converts echo context to params.
+func (w *ServerInterfaceWrapper) ListClinicianRoles(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListClinicianRoles(ctx, clinicId)
+	return err
+}
+
+// UpdateClinicianRoles converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateClinicianRoles(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateClinicianRoles(ctx, clinicId)
+	return err
+}
+
 // ListClinicians converts echo context to params.
 func (w *ServerInterfaceWrapper) ListClinicians(ctx echo.Context) error {
 	var err error
@@ -633,6 +935,76 @@ func (w *ServerInterfaceWrapper) UpdateClinician(ctx echo.Context) error {
 	return err
 }
 
+// UpdateClinicianSites converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateClinicianSites(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	// ------------- Path parameter "clinicianId" -------------
+	var clinicianId ClinicianId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicianId", ctx.Param("clinicianId"), &clinicianId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicianId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateClinicianSites(ctx, clinicId, clinicianId)
+	return err
+}
+
+// ConsolidateClinics converts echo context to params.
+func (w *ServerInterfaceWrapper) ConsolidateClinics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ConsolidateClinics(ctx, clinicId)
+	return err
+}
+
+// GetClinicConsolidation converts echo context to params.
+func (w *ServerInterfaceWrapper) GetClinicConsolidation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	// ------------- Path parameter "consolidationId" -------------
+	var consolidationId ConsolidationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "consolidationId", ctx.Param("consolidationId"), &consolidationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter consolidationId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetClinicConsolidation(ctx, clinicId, consolidationId)
+	return err
+}
+
 // SyncEHRData converts echo context to params.
 func (w *ServerInterfaceWrapper) SyncEHRData(ctx echo.Context) error {
 	var err error
@@ -783,6 +1155,170 @@ func (w *ServerInterfaceWrapper) MergeClinic(ctx echo.Context) error {
 	return err
 }
 
+// GetClinicMerge converts echo context to params.
+func (w *ServerInterfaceWrapper) GetClinicMerge(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	// ------------- Path parameter "planId" -------------
+	var planId PlanId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "planId", ctx.Param("planId"), &planId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter planId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetClinicMerge(ctx, clinicId, planId)
+	return err
+}
+
+// ListClinicMergeDuplicates converts echo context to params.
+func (w *ServerInterfaceWrapper) ListClinicMergeDuplicates(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	// ------------- Path parameter "planId" -------------
+	var planId PlanId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "planId", ctx.Param("planId"), &planId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter planId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListClinicMergeDuplicates(ctx, clinicId, planId)
+	return err
+}
+
+// ResolveClinicMergeDuplicate converts echo context to params.
+func (w *ServerInterfaceWrapper) ResolveClinicMergeDuplicate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	// ------------- Path parameter "planId" -------------
+	var planId PlanId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "planId", ctx.Param("planId"), &planId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter planId: %s", err))
+	}
+
+	// ------------- Path parameter "duplicateId" -------------
+	var duplicateId DuplicateId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "duplicateId", ctx.Param("duplicateId"), &duplicateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duplicateId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ResolveClinicMergeDuplicate(ctx, clinicId, planId, duplicateId)
+	return err
+}
+
+// PreflightClinicMergeRollback converts echo context to params.
+func (w *ServerInterfaceWrapper) PreflightClinicMergeRollback(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	// ------------- Path parameter "planId" -------------
+	var planId PlanId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "planId", ctx.Param("planId"), &planId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter planId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PreflightClinicMergeRollback(ctx, clinicId, planId)
+	return err
+}
+
+// RollbackClinicMerge converts echo context to params.
+func (w *ServerInterfaceWrapper) RollbackClinicMerge(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	// ------------- Path parameter "planId" -------------
+	var planId PlanId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "planId", ctx.Param("planId"), &planId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter planId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RollbackClinicMerge(ctx, clinicId, planId)
+	return err
+}
+
+// StartClinicMerge converts echo context to params.
+func (w *ServerInterfaceWrapper) StartClinicMerge(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	// ------------- Path parameter "planId" -------------
+	var planId PlanId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "planId", ctx.Param("planId"), &planId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter planId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StartClinicMerge(ctx, clinicId, planId)
+	return err
+}
+
 // TriggerInitialMigration converts echo context to params.
 func (w *ServerInterfaceWrapper) TriggerInitialMigration(ctx echo.Context) error {
 	var err error
@@ -859,38 +1395,118 @@ func (w *ServerInterfaceWrapper) GetMigration(ctx echo.Context) error {
 	ctx.Set(SessionTokenScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetMigration(ctx, clinicId, userId)
+	err = w.Handler.GetMigration(ctx, clinicId, userId)
+	return err
+}
+
+// UpdateMigration converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateMigration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicIdV1
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId UserId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateMigration(ctx, clinicId, userId)
+	return err
+}
+
+// RetryMigration converts echo context to params.
+func (w *ServerInterfaceWrapper) RetryMigration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicIdV1
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId UserId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RetryMigration(ctx, clinicId, userId)
+	return err
+}
+
+// GetPatientCount converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPatientCount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPatientCount(ctx, clinicId)
+	return err
+}
+
+// RefreshPatientCount converts echo context to params.
+func (w *ServerInterfaceWrapper) RefreshPatientCount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RefreshPatientCount(ctx, clinicId)
 	return err
 }
 
-// UpdateMigration converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateMigration(ctx echo.Context) error {
+// GetPatientDuplicates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPatientDuplicates(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "clinicId" -------------
-	var clinicId ClinicIdV1
+	var clinicId ClinicId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
 	}
 
-	// ------------- Path parameter "userId" -------------
-	var userId UserId
-
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
-	}
-
 	ctx.Set(SessionTokenScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateMigration(ctx, clinicId, userId)
+	err = w.Handler.GetPatientDuplicates(ctx, clinicId)
 	return err
 }
 
-// GetPatientCount converts echo context to params.
-func (w *ServerInterfaceWrapper) GetPatientCount(ctx echo.Context) error {
+// MergePatientDuplicate converts echo context to params.
+func (w *ServerInterfaceWrapper) MergePatientDuplicate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "clinicId" -------------
 	var clinicId ClinicId
@@ -903,12 +1519,12 @@ func (w *ServerInterfaceWrapper) GetPatientCount(ctx echo.Context) error {
 	ctx.Set(SessionTokenScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetPatientCount(ctx, clinicId)
+	err = w.Handler.MergePatientDuplicate(ctx, clinicId)
 	return err
 }
 
-// RefreshPatientCount converts echo context to params.
-func (w *ServerInterfaceWrapper) RefreshPatientCount(ctx echo.Context) error {
+// ScanPatientDuplicates converts echo context to params.
+func (w *ServerInterfaceWrapper) ScanPatientDuplicates(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "clinicId" -------------
 	var clinicId ClinicId
@@ -921,7 +1537,7 @@ func (w *ServerInterfaceWrapper) RefreshPatientCount(ctx echo.Context) error {
 	ctx.Set(SessionTokenScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RefreshPatientCount(ctx, clinicId)
+	err = w.Handler.ScanPatientDuplicates(ctx, clinicId)
 	return err
 }
 
@@ -2269,6 +2885,24 @@ func (w *ServerInterfaceWrapper) SendUploadReminder(ctx echo.Context) error {
 	return err
 }
 
+// GenerateConsolidationReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GenerateConsolidationReport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GenerateConsolidationReport(ctx, clinicId)
+	return err
+}
+
 // GenerateMergeReport converts echo context to params.
 func (w *ServerInterfaceWrapper) GenerateMergeReport(ctx echo.Context) error {
 	var err error
@@ -2287,6 +2921,24 @@ func (w *ServerInterfaceWrapper) GenerateMergeReport(ctx echo.Context) error {
 	return err
 }
 
+// GenerateSplitReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GenerateSplitReport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GenerateSplitReport(ctx, clinicId)
+	return err
+}
+
 // AddServiceAccount converts echo context to params.
 func (w *ServerInterfaceWrapper) AddServiceAccount(ctx echo.Context) error {
 	var err error
@@ -2509,6 +3161,76 @@ func (w *ServerInterfaceWrapper) MergeSite(ctx echo.Context) error {
 	return err
 }
 
+// UpdateSiteParent converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateSiteParent(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	// ------------- Path parameter "siteId" -------------
+	var siteId SiteId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "siteId", ctx.Param("siteId"), &siteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter siteId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateSiteParent(ctx, clinicId, siteId)
+	return err
+}
+
+// UpdateSiteSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateSiteSettings(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	// ------------- Path parameter "siteId" -------------
+	var siteId SiteId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "siteId", ctx.Param("siteId"), &siteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter siteId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateSiteSettings(ctx, clinicId, siteId)
+	return err
+}
+
+// SplitClinic converts echo context to params.
+func (w *ServerInterfaceWrapper) SplitClinic(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SplitClinic(ctx, clinicId)
+	return err
+}
+
 // UpdateSuppressedNotifications converts echo context to params.
 func (w *ServerInterfaceWrapper) UpdateSuppressedNotifications(ctx echo.Context) error {
 	var err error
@@ -2600,6 +3322,177 @@ func (w *ServerInterfaceWrapper) UpdateTier(ctx echo.Context) error {
 	return err
 }
 
+// ListXealthPatientsNotViewed converts echo context to params.
+func (w *ServerInterfaceWrapper) ListXealthPatientsNotViewed(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListXealthPatientsNotViewedParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListXealthPatientsNotViewed(ctx, clinicId, params)
+	return err
+}
+
+// ListXealthReportViews converts echo context to params.
+func (w *ServerInterfaceWrapper) ListXealthReportViews(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListXealthReportViewsParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "createdTimeStart" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdTimeStart", ctx.QueryParams(), &params.CreatedTimeStart)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdTimeStart: %s", err))
+	}
+
+	// ------------- Optional query parameter "createdTimeEnd" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdTimeEnd", ctx.QueryParams(), &params.CreatedTimeEnd)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdTimeEnd: %s", err))
+	}
+
+	// ------------- Optional query parameter "patientUserId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "patientUserId", ctx.QueryParams(), &params.PatientUserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter patientUserId: %s", err))
+	}
+
+	// ------------- Optional query parameter "systemLogin" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "systemLogin", ctx.QueryParams(), &params.SystemLogin)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter systemLogin: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListXealthReportViews(ctx, clinicId, params)
+	return err
+}
+
+// GetXealthReportViewStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetXealthReportViewStats(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "clinicId" -------------
+	var clinicId ClinicId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clinicId", ctx.Param("clinicId"), &clinicId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clinicId: %s", err))
+	}
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetXealthReportViewStatsParams
+	// ------------- Optional query parameter "createdTimeStart" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdTimeStart", ctx.QueryParams(), &params.CreatedTimeStart)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdTimeStart: %s", err))
+	}
+
+	// ------------- Optional query parameter "createdTimeEnd" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdTimeEnd", ctx.QueryParams(), &params.CreatedTimeEnd)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdTimeEnd: %s", err))
+	}
+
+	// ------------- Optional query parameter "interval" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "interval", ctx.QueryParams(), &params.Interval)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interval: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetXealthReportViewStats(ctx, clinicId, params)
+	return err
+}
+
+// ListIncompleteMigrations converts echo context to params.
+func (w *ServerInterfaceWrapper) ListIncompleteMigrations(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SessionTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListIncompleteMigrationsParams
+	// ------------- Optional query parameter "updatedBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updatedBefore", ctx.QueryParams(), &params.UpdatedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter updatedBefore: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListIncompleteMigrations(ctx, params)
+	return err
+}
+
 // FindPatients converts echo context to params.
 func (w *ServerInterfaceWrapper) FindPatients(ctx echo.Context) error {
 	var err error
@@ -2936,6 +3829,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.POST(baseURL+"/v1/auth/cache/invalidate", wrapper.InvalidateAuthenticationCache)
+	router.POST(baseURL+"/v1/authz/evaluate", wrapper.EvaluateAuthorizationPolicy)
 	router.GET(baseURL+"/v1/clinicians", wrapper.ListAllClinicians)
 	router.GET(baseURL+"/v1/clinicians/:userId/clinics", wrapper.ListClinicsForClinician)
 	router.POST(baseURL+"/v1/clinicians/:userId/migrate", wrapper.EnableNewClinicExperience)
@@ -2945,11 +3840,22 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/v1/clinics/:clinicId", wrapper.DeleteClinic)
 	router.GET(baseURL+"/v1/clinics/:clinicId", wrapper.GetClinic)
 	router.PUT(baseURL+"/v1/clinics/:clinicId", wrapper.UpdateClinic)
+	router.GET(baseURL+"/v1/clinics/:clinicId/access_restrictions", wrapper.GetAccessRestrictions)
+	router.PUT(baseURL+"/v1/clinics/:clinicId/access_restrictions", wrapper.UpdateAccessRestrictions)
+	router.GET(baseURL+"/v1/clinics/:clinicId/api_keys", wrapper.ListAPIKeys)
+	router.POST(baseURL+"/v1/clinics/:clinicId/api_keys", wrapper.CreateAPIKey)
+	router.DELETE(baseURL+"/v1/clinics/:clinicId/api_keys/:apiKeyId", wrapper.RevokeAPIKey)
+	router.GET(baseURL+"/v1/clinics/:clinicId/audit_events", wrapper.ListAuditEvents)
+	router.GET(baseURL+"/v1/clinics/:clinicId/clinician_roles", wrapper.ListClinicianRoles)
+	router.PUT(baseURL+"/v1/clinics/:clinicId/clinician_roles", wrapper.UpdateClinicianRoles)
 	router.GET(baseURL+"/v1/clinics/:clinicId/clinicians", wrapper.ListClinicians)
 	router.POST(baseURL+"/v1/clinics/:clinicId/clinicians", wrapper.CreateClinician)
 	router.DELETE(baseURL+"/v1/clinics/:clinicId/clinicians/:clinicianId", wrapper.DeleteClinician)
 	router.GET(baseURL+"/v1/clinics/:clinicId/clinicians/:clinicianId", wrapper.GetClinician)
 	router.PUT(baseURL+"/v1/clinics/:clinicId/clinicians/:clinicianId", wrapper.UpdateClinician)
+	router.PUT(baseURL+"/v1/clinics/:clinicId/clinicians/:clinicianId/sites", wrapper.UpdateClinicianSites)
+	router.POST(baseURL+"/v1/clinics/:clinicId/consolidate", wrapper.ConsolidateClinics)
+	router.GET(baseURL+"/v1/clinics/:clinicId/consolidations/:consolidationId", wrapper.GetClinicConsolidation)
 	router.POST(baseURL+"/v1/clinics/:clinicId/ehr/sync", wrapper.SyncEHRData)
 	router.DELETE(baseURL+"/v1/clinics/:clinicId/invites/clinicians/:inviteId/clinician", wrapper.DeleteInvitedClinician)
 	router.GET(baseURL+"/v1/clinics/:clinicId/invites/clinicians/:inviteId/clinician", wrapper.GetInvitedClinician)
@@ -2957,13 +3863,23 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/v1/clinics/:clinicId/membership_restrictions", wrapper.ListMembershipRestrictions)
 	router.PUT(baseURL+"/v1/clinics/:clinicId/membership_restrictions", wrapper.UpdateMembershipRestrictions)
 	router.POST(baseURL+"/v1/clinics/:clinicId/merge", wrapper.MergeClinic)
+	router.GET(baseURL+"/v1/clinics/:clinicId/merges/:planId", wrapper.GetClinicMerge)
+	router.GET(baseURL+"/v1/clinics/:clinicId/merges/:planId/duplicates", wrapper.ListClinicMergeDuplicates)
+	router.PUT(baseURL+"/v1/clinics/:clinicId/merges/:planId/duplicates/:duplicateId", wrapper.ResolveClinicMergeDuplicate)
+	router.GET(baseURL+"/v1/clinics/:clinicId/merges/:planId/rollback", wrapper.PreflightClinicMergeRollback)
+	router.POST(baseURL+"/v1/clinics/:clinicId/merges/:planId/rollback", wrapper.RollbackClinicMerge)
+	router.POST(baseURL+"/v1/clinics/:clinicId/merges/:planId/start", wrapper.StartClinicMerge)
 	router.POST(baseURL+"/v1/clinics/:clinicId/migrate", wrapper.TriggerInitialMigration)
 	router.GET(baseURL+"/v1/clinics/:clinicId/migrations", wrapper.ListMigrations)
 	router.POST(baseURL+"/v1/clinics/:clinicId/migrations", wrapper.MigrateLegacyClinicianPatients)
 	router.GET(baseURL+"/v1/clinics/:clinicId/migrations/:userId", wrapper.GetMigration)
 	router.PATCH(baseURL+"/v1/clinics/:clinicId/migrations/:userId", wrapper.UpdateMigration)
+	router.POST(baseURL+"/v1/clinics/:clinicId/migrations/:userId/retry", wrapper.RetryMigration)
 	router.GET(baseURL+"/v1/clinics/:clinicId/patient_count", wrapper.GetPatientCount)
 	router.POST(baseURL+"/v1/clinics/:clinicId/patient_count/refresh", wrapper.RefreshPatientCount)
+	router.GET(baseURL+"/v1/clinics/:clinicId/patient_duplicates", wrapper.GetPatientDuplicates)
+	router.POST(baseURL+"/v1/clinics/:clinicId/patient_duplicates/merge", wrapper.MergePatientDuplicate)
+	router.POST(baseURL+"/v1/clinics/:clinicId/patient_duplicates/scan", wrapper.ScanPatientDuplicates)
 	router.POST(baseURL+"/v1/clinics/:clinicId/patient_tags", wrapper.CreatePatientTag)
 	router.DELETE(baseURL+"/v1/clinics/:clinicId/patient_tags/:patientTagId", wrapper.DeletePatientTag)
 	router.PUT(baseURL+"/v1/clinics/:clinicId/patient_tags/:patientTagId", wrapper.UpdatePatientTag)
@@ -2982,7 +3898,9 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/v1/clinics/:clinicId/patients/:patientId/reviews", wrapper.DeletePatientReviews)
 	router.PUT(baseURL+"/v1/clinics/:clinicId/patients/:patientId/reviews", wrapper.UpdatePatientReviews)
 	router.POST(baseURL+"/v1/clinics/:clinicId/patients/:patientId/upload_reminder", wrapper.SendUploadReminder)
+	router.POST(baseURL+"/v1/clinics/:clinicId/reports/consolidation", wrapper.GenerateConsolidationReport)
 	router.POST(baseURL+"/v1/clinics/:clinicId/reports/merge", wrapper.GenerateMergeReport)
+	router.POST(baseURL+"/v1/clinics/:clinicId/reports/split", wrapper.GenerateSplitReport)
 	router.POST(baseURL+"/v1/clinics/:clinicId/service_accounts", wrapper.AddServiceAccount)
 	router.GET(baseURL+"/v1/clinics/:clinicId/settings/ehr", wrapper.GetEHRSettings)
 	router.PUT(baseURL+"/v1/clinics/:clinicId/settings/ehr", wrapper.UpdateEHRSettings)
@@ -2994,9 +3912,16 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/v1/clinics/:clinicId/sites/:siteId", wrapper.DeleteSite)
 	router.PUT(baseURL+"/v1/clinics/:clinicId/sites/:siteId", wrapper.UpdateSite)
 	router.POST(baseURL+"/v1/clinics/:clinicId/sites/:siteId/merge", wrapper.MergeSite)
+	router.PUT(baseURL+"/v1/clinics/:clinicId/sites/:siteId/parent", wrapper.UpdateSiteParent)
+	router.PUT(baseURL+"/v1/clinics/:clinicId/sites/:siteId/settings", wrapper.UpdateSiteSettings)
+	router.POST(baseURL+"/v1/clinics/:clinicId/split", wrapper.SplitClinic)
 	router.POST(baseURL+"/v1/clinics/:clinicId/suppressed_notifications", wrapper.UpdateSuppressedNotifications)
 	router.GET(baseURL+"/v1/clinics/:clinicId/tide_report", wrapper.TideReport)
 	router.POST(baseURL+"/v1/clinics/:clinicId/tier", wrapper.UpdateTier)
+	router.GET(baseURL+"/v1/clinics/:clinicId/xealth/patients_not_viewed", wrapper.ListXealthPatientsNotViewed)
+	router.GET(baseURL+"/v1/clinics/:clinicId/xealth/report_views", wrapper.ListXealthReportViews)
+	router.GET(baseURL+"/v1/clinics/:clinicId/xealth/report_views/stats", wrapper.GetXealthReportViewStats)
+	router.GET(baseURL+"/v1/migrations", wrapper.ListIncompleteMigrations)
 	router.GET(baseURL+"/v1/patients", wrapper.FindPatients)
 	router.POST(baseURL+"/v1/patients/:patientId/ehr/sync", wrapper.SyncEHRDataForPatient)
 	router.POST(baseURL+"/v1/patients/:patientId/summary", wrapper.UpdatePatientSummary)
@@ -18,350 +18,490 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y963LbuLIo/CoonV21ZvaybPmSyaXq1P4c20k8sR1vXzJnrUmOBiRbImISYABQtjLj",
-	"qvMQ35/v9c6TfIULSfAmUbLkeO2dH3FEEAQajUaj0ejLnz2fxQmjQKXovfqzl2COY5DA9ZMfEUr840D9",
-	"JrT3qpdgGfY2ehTH0HtVvN7ocfiaEg5B75XkKWz0hB9CjE2LUgJXH//v33F/NOi//Pznzt79v/U2enKa",
-	"qGaE5ISOe/f3G3mLm5Nt9W0AwuckkYSp7w/0S3R82NtYGpp/4zDqver9j61i2FvmrdhyOy+AIZjOQYCp",
-	"0REHP/0+6L/E/dHnP7cH93/lDy/u+/nvvQ6/t3fuf25BIQcsIbgiMRzRoI7FC5App4iDz3ggkK2OPBgx",
-	"DkiGgMZkAhQFWAL6Ce78KBVkAj9nSP+aAp86KCh35456xHiMZe9VTzXVlySGeQBfSsxlZ5DxSAKvQUxo",
-	"d4hNf0vADCE/otiLoBnBnMAEkKEOgW6JDBGY6ujo3QUiVMKYY12/GUanfRc6C4jHWASYGkhiTKKcPKvN",
-	"6JeN48te1cdG6IRIaKX5/PUsgo8JPQE6lmHv1XZTHxGJiWyD2rx0mwtghNNI9l5tDzZU2yROY7dljVDg",
-	"umk2Gglobdu+rcBq2hs0tpdgSYDKVnwU758KB7AQXeHxPKBNlZVx74SzCQmAHzesinP7rpV9Ox8vy8CL",
-	"JjIWzlkEbaSg3zWsLmdAAjD3w/pg3qRRhCTcSWRqoKzppn5sI3N6CjGHAxa0QltUmNPQrLUr5q/cWfhl",
-	"3hfwZYFdwZq49SXjEjGu5hrTAGEpOfFSvZlsjjfR3xUsiHHUVz/amLRuupkQf/qPV/2/Pn36+88//cer",
-	"33H/237/n5//Gv7890aSFGkcYz5tx0j+flmk5C1kWElFtgIa+rMvl+1MkgASxiLVDAl693qI5p369E3E",
-	"sHxDIglcPcIdjhO1AHqf0sFgF/7ns81nvY0SL7Iv/jL/m/98++j//Pvf+5//46efPn0K/v7Tp0+bnz4F",
-	"//7zf/z8l/3995+bGNBG75jOguH54hCovhp7uk7UNn2ZJgkHISA4Y5KMiK93ViPOcpYAlwT0k2ivOHuG",
-	"Gz/LeUw+k7+39vB5oyeJ1GiYDXM+SLPWikFeEYPP8oikLZ1NM8CbYNXf1gDT/TRAgYPgEviE+LDv+yyl",
-	"0oroZXj8SG0rQxLMEwI2end9IVkSkXFoNuug96p3Mx5NMU7i0RfVnpXBVYMCfG429cUbffElYIIOZOR9",
-	"TZ7pRs1aXKat3d3d3bH4Nv26e/f8pVl+Lkp1wxsOFqoDcNC9X0VoDestIPjPb9iLwSCOgl0zqVgI5hMs",
-	"4SA7jlyxa2EmvTZDBXeqM0t3KLaeC3DWDcr7aSIUbxyfAyes+Qx3abglYiOEkUjAV7SPXr89RUrWRon+",
-	"Ev3kp5wDldH0FdoONtDzYANt7wUbaHcQqB2jPCQ8AY7HcIhJNL0wZ4R6x/umEgpULcQBB4SO1XrLGdTu",
-	"5rN8ODSNPb0O7vpj1reFI8Vdf9nTq6He5SFEEtf7PSSjEXCgPiAP5C0A1SeWhgYQUa+IyJCgNs8JcEEY",
-	"Na8AsSRhag9HuRibQ7+zOPRvo9RnAk5jFrXjy1ZSE8Yb4XSBePYgIJbCYAZfA/I0xmQI3KIrw+ayaPMZ",
-	"jEbEV+v5w+gj5sSc4NxN7uHN5UhYqs0AT8VvRIaHuNrI7CYIldXPGwDp1EaIxX7zgqyeXt26FVpsrKpO",
-	"7Md0n07fkXF4DtwHKrtVngdEXvmE3XZu+ITddmv36E5yiKE71M4H3Xro3nT3NjujojMerjAfg+zWqKnb",
-	"rd2PwBcgiax297Y7Y8JWntsykziaWSnGd6WV96IzA4jxXX3hLvA5KfOz3UW+rHfc/XMhMQ0wDw5h8kC2",
-	"WmvpYRxVtjCdinbDvFAbJhsZUUYkQKXalvbpFIVkHKKx3ao4pmNwN6HB5u7DAFps52xq4WHCx2BzZzn4",
-	"W8W1AyUR653bFTvmo3J70GmTagLiAUhciQT3bDHIy1xpMXKM2O2qqbEAZ0k8Fg18B1osc+2upDgTjYtS",
-	"YgHC8gh8dDpsFm26EyOY71fPH+uALYPVeiuPTZvNomAXAu2G2oWotA7MA5H66PS6NKGunEAfSJnfkSSX",
-	"oMUV0uADie+7UN2yW/Wqt+mH7dHfb4NefHde3c78sG35e+zJtWN2d4KT+tOV0lwJmmVQWGrgsSmvpobo",
-	"QnzzsLgQ/ZUgWB5/j06FDUqZ7nQ4Ab6GM3MFpGWQWWniscmxQXfVhSA7oHMhmqyA8RBEfhe6XHY/1nhc",
-	"9aZcBmhZXH6/zbmu9OxMkqvbpctAPACJj0mPFTXwPLQtgxyniwWR4nz5MGR0ArVy+RwT2nNUykYh7ii3",
-	"226OWi8bWm8WWi5RWm5MZlw6zLhhmH0VNPvep/3iq/2Wq/kSp/nGpv2qo/1eo/XKrPV+rH7t0XpPWLnD",
-	"bLqTdGwSvHGc5MYGs4wRhLVGwEFAFOXj6LxkQzDLgKZk0XC/Ub0oRzFOkGQIsB8ikSYJ4xICbdNgbcOK",
-	"m/Ia3KIJcGeB9MUNSfosMTD3E0aoNqySPAUzvkuJpWi0tdjP+9fWFqkALhQzUZuEgi5jvRMcpSBqZhU+",
-	"oyMy7mj+dqArZwjCEkTHDw9VXfudMRvqbmy30bNI7DyDudGWMxEiQ2AD/fhLGLMc/DBm+WHM8sOY5b+E",
-	"MYtlkaeY4jHEQOUxDYiPJeNN7DYATiYQGIaKbkPihwjiNFIsDu1vH7hz9XwBRLRDsRiVtbezLMUtMor1",
-	"GQa9nTlLrQYbB29PrwWcEpra7WpOzS5GIKZmZ3sjJbZ1AWD9Zk+doVi3iVQ3QB7HpqobLGuyvurW+brM",
-	"tLr1vm6Trm5QPIIBWHdAHt9aLGQpf8hGWfp+2Z3yh8nakzJZq+1trdql2NSxWs5bwJzQMcLIH8cl2XLQ",
-	"XR9X6nxxZVzp88fSDNe2+I5q4VaELawKLkGwLNa+ixK4JvQ8lNi2F6W1pRW/pc8f1X6vsr11RFknI8hF",
-	"1moVlgdYQT7yiv1hlfvDKvcpWuUuvahnXoctvKYLSJa3J/0eK/qHXfMPu+anZNe81HLuZny72Jquw/RA",
-	"69tHX90/rMV/WIv/q1mLL7X8V7nsH7jeV7bQdwY/7Ox/2Nk/dTv7pZbrCgXvh0nd30Pk/uGc8MM54bGd",
-	"E5ZapfPM6hdbqCVAlrerf/yt9Yd7xw/3jqfj3rHUSu7gj7DYYq5A8xCHhEfff384yvxwlHnCjjLLL/AV",
-	"StVlWB7g3vFdVvcPd6Mf7kY/3I2esLvRDGeiGaal3Z2NMh76QC+khmaWd05qaGwVfksNzc72amr1UGpo",
-	"aUm/pZahLuXR1NBWk6tTN4et5sYaEVU2T2oxYW6xQl7I+6pu1dZqn9bqRDDbHaBqsNhogNjBDWxjrpug",
-	"4yjmz3EU81fhKOYv5Sh2MMtRzF+Ro5i/rKPYwX8rRzG/1VHMn+MoRuS0OWkNkVNEcQyb7i7VO8cRQ/uR",
-	"ZIaA3TDUtkP1YVPMc5NVZEaCnLpLWRBwEPPxLDmA3DeVLdp8TE/JmGOp42VzwMEHGk2zmPV1U2SFhrk4",
-	"tqjKx3JJvunmgaax2o4H/Z29l72N3s6zQX/vpfr1bDDov9S/tgeDwd/1wm7Gy5UuLtrKUkIME459SXwl",
-	"84WAIxn6mMNQTIWEuLfRm4AETijm06GfYVE7v+hQ/SaFgwmXPjO1ieJ8KZV8PhJMtQwPRUqa1iQ0Lcgv",
-	"ep6/KEoJjjZ6ERbqAAASgvM8H8jcHBt5TdtKFkx91kea/u1CzL/XBEkkxGLhPu3YMed4qhsNGYUzLe7N",
-	"b8ypm8HEhMRRln1j5sd5zexTDiPgHILX42tKTA6tjPbi8VZwotZ3zKKtk0aiLaX9mLk4s4q2XyX9dkeg",
-	"qt2Euu77h+J984FUlTIAV59rYSNPd1DwUVUy2B4Mamx07nJRXx667NNt9g0HWKbJGL4xCt3W4ZWtbceW",
-	"6vQLD+QBt+CpuS41kHLSmBrFPQHpVAU2e4Gbacbh/2UuVYa3jk07Vw0LxBHK8v2qvp12yANHAqCKRICX",
-	"t9adEezsvXixs/0cYG8Xtr0deLHr74yMZJjN585eaXrV44xcRzXEl0Zgchq17UjONDvMYX/EiY+39j0S",
-	"fNGJFLIC3+fYeQwCIob7HvbcwmhMFKcrCkSMS1+JGNzn1zjGN8x9puOUlJ6/pJHzTITAqfMcYSqnHJwS",
-	"jr99wxMSRW5h+iWNvdTt+QATztxHgb0IU9+tAql0HxnFNzoVkC04xDeYu498CGJ4iSOMY6f4C/FYKp1B",
-	"HbIUR07DR9FwH5PUwbVa5ZLdOiVvsce4WsF5yTvMsTvwX1mIKQXhpXzslKbu/LzHcVLq+n2IuWSpA+57",
-	"MsYRcZ+pCLFwvjnBY+ZM8QnxOFTwfcJi9ylVpzT32UtjD4uQuGUC3zh1TnGEPeY+J6ksPQvgDiGcKkJ0",
-	"0XPKxjggInTrMKqELqeXM0UEngPGWfAFx0DdKgTH4Ez6GUvxjR8yKYuyDyke44ClY+b0ds64ZP0zNnGg",
-	"vsRseFXCzRWJvfRGOt9dcZIwdwauUkocfP9GaBAyuFElMdi1iEuP1A+ZOkGXysYpiczU50WSjNNSCcfj",
-	"FBNaLhsrbkbVIgLKxHCfcH3Uqlc4wBLHmPvNnx+wmAUXZIIDbOegoQoPmNf87tf0SzptfHOChxeEfWn+",
-	"7BRowL41v7sgbPgWRxFYeq5VuMTm9N70hg5/TQ17bHx5kpLmNq9SP41bPrwWYYoruEnL+BAp9c02lhdJ",
-	"csNuyi3KG/ej1zgktefha0wD4FiUXnAPByVkvIZIH0OcZ3UocgoU0+xfYi8qQfWa4eFHIkroe83GrFJA",
-	"RKmtZgo7wLHHSTCG4Ws8LZcnbPiWq4GUiqmf0lIBxz4ut1in1AM8BUrLDU3LM3UQEh+PWbkkTHFYWkUH",
-	"JA1woMiDwze3nHEcDd9h7rGUl8srVH+ghPnhBSnDx0HIEo4PUoLL36VqoC58h5jGmN+IEE9oqfhWsHrB",
-	"8IBDibEcAp0ALxVIznQOzLyExUqYcME4CmJGy6AeEZ5SSFzsHkVqq5zggLkdHFEBFAduc28Yl8MziMoQ",
-	"69Lf8LQ0Y6oQR1Ba728j7Fcp5y0LZIi9UgkTtVqKsoZXKb8pFVbhe5viACKWlkb3NsUSYhxVKk7x11Rn",
-	"Ny3KprjEb9/hiIy0trAomVSqAI+ZIFHkzvQxDQim+f9qCxENr99TdtdQfIo50HFTe+c653ImVFReXkEU",
-	"Da0+qPruI0xwYzmhvlpoTdD9RiiOsV9/Ux9OOiHutBx/xVFaIsxfcYzLdFndQn5NKRhh0ha8BypT/2a6",
-	"dcJSInKZpvr2lFFJfCjjXyF2eHzmlnAcAQ3IFxfOEzw8xy5XOCGxC+OJ4n90DFEJP43wnLBb4MNzrvDp",
-	"Vj7FPhBWKqC4vNGrkrT8DSdjJsslklDyNYVSocQx46z86TcsoxKfrG+6p0AVn4BSY8BJUK4kI3yjGisV",
-	"3hGfVYnsVAFW3nFOGfVltUSqE960WjYhAbBKIQccVYoEcI5dnJzh7PSRFcDt8B+sxB/OSELGJTDOrMCX",
-	"P3JGQ1wukeHwEN8wqTbYNMJh29sD0MqPlrcKnEtc3rDP0tQF78MXQvHY7f0cqzVXLhhTwmVKx6VSrrZM",
-	"4rmIOw8ZUOIyFCX19nHaN2RZeTFko+FlggmtlLPhvs+hVvgRorDUWwqq+IL45VIq8XBfsWWXLC8wodPh",
-	"BSnvXxeY3hA6PKYRuBN7AT4ZQalgXBaDL0CwKJWlOoQNX3NMS9BcMIF5afVdYgXfscAeRNViXpoqVUTK",
-	"8oUqYkO9x1bK2fAcpyUOdOkzDsKbilSnO8+LQ5Jw5rtEcEnKAuKlHL7GXIZK1puWy39lIRXlovdEykrR",
-	"SeqTSoNXIYtxpZph/S7iL2/JSA4PTBw6p/wKxqmvTqKJ2+xVmJY44FWYKhm2sm1fkS9pecO8UktOsnKJ",
-	"ZCU+81FNZFqmlo+Ej0vE+ltIJISMl6TW3wilJAF3sfwD36SyxDr+obaL2xtqyUzNvS+t4sEwqKLoEE/M",
-	"ZucUpUqkOrzm+SZQvDvF/tcUc1IrzmQ8p8w/TXnAyoXnOIrNsIuyC33FgMuFlyyV4fCcVQG4nLLbStUr",
-	"zqKoXPSRCck0FeqCrRNGx1PA3JuChlIQdZB1fkcxNjxfP8VWFNcPFAdTnj99lYYp2wfmQf4kwjH2zETY",
-	"55sQezjIC+SUFx+/xuMwKF6+xiG3zMo83jg16fjGjMY8coqNnko/AuFp3ulrIsIbKOoqSZhkTwc48lNp",
-	"TkX6OSTuAyMejkQx8oOQ0fFXkwbWFqR0fOMWsIjFhkmrx0Ps+7h4iLHwzcavn0Orc9EPJMqhOkw97DyI",
-	"ENMCqW9wjMepKMB8i7/lv9XxpkDZO/A4K57Y8CAkw1NCw6KIjofvWQH+OzbJ8X/Mb1IpcsQdC4mpV2D5",
-	"V3yDeQHFr3iKE6vi08/AU5FthqrgPXY+fo9jP8SyGP57dUgMSfGoSIcXjzKMMQ1Sp6D8HGIaTMdFcyy6",
-	"wQVw7zkWlE0xL4bzPsURHp6kcZIW3aR+6Mzl+/QWk5yOTrOzXfaQFg9jHBREcopvlKDCi2dKohyU01T4",
-	"xYo4Iz4TJH95xibsJv1GwcG7KhPEIw7sH2LnN8c5Vs9DyuLhORQTfK5kZUxxXv18qtY9Lgb5n1gWoP6n",
-	"OvlSnC/7/5x+m0aMBzmAF5iOWUFSF2SKg7yzS5yJXubpJsQRcZ7VURjTnL4ugRUEcRliOg4Lqr8kdIwT",
-	"xnOyv+QQULhh0dQZ/BUmSbGYr7Ba6TRH7pVHIiKK1xDyYpauIBruT8gkfw5J7KXuUxIWj+xmyooHB4Lr",
-	"LykdD88xDRycXkcYUw+7mL2OMB2+tvZhpoSn8dccuGsh+2dQLJ+PBPTM5eP/GOGATHImror0NiecR+qg",
-	"/x9wg/XVdXZ2NIUcJgYH6syg9oH9b8yqe7KS18Dj1Ex5VnSAKTaq96IkgeFH4Eb1k5W+wcBZpaRS8Cum",
-	"w1NsN52s8BQHQHipywuY3nzB9pSZFZot8C0wPial2pdy+A4iqzkuCjGNzO6eCslxpHacg6vycwARJmYU",
-	"eeFrTkSmznYK2Q3Q4Ttidta8/EAxZ26ALwpTbiWCvOgQ81uzGvKio9SPyt+9Yx7mslR08u64/ExoAHY3",
-	"LgoZD4bv2G25y1OIPJbyykDOLn8rP6szTKnkHKol/5kCUBHZ1ZsX6/kol0wDWkH5FRYxpqQ80I/El4xX",
-	"Cn8DUR77P5RUeEuontfXHH8j0ZY9q9inQygOdLboCOtW7JNt8+BIzfvB5dUvB4f6F6Y4UAKIoZWiRB3x",
-	"DEu1Bao54LQoOGXqyEOckjO4HbGUBhY/tvQc67jPRcElFjdY+iHcYufjf6Q3etUehCSCrQPFsClQaUDQ",
-	"ZQaC4wz9B0YnfaRHdHRp/z470uM6Gk8TNd4jorF0JP2tt6dXxa+/D5zf2+7v0ovSmx3nwf296/zec34/",
-	"c37/4vx+7vx+4fx+WfzuO1D0t93fpRelNzvuw6774ADVd2u5ldw6DuB9B/C+A3jfAbzvAJ6DxwHoLfFD",
-	"+3x9kCH/+uog+0XVsVjoGVbP/0wjtdMcpZwlsLUfq9kO9B1mVkQDZjhMVqAWyE2oySgrkiHoo6J9fg3R",
-	"yCyEomDMseZ0eQk3+3P2zLEkIsIT7JalQkDkNpz6IeZQajoNcFIpEYSOwWn8ICSCUOwM9IAlQENcqnWY",
-	"eiWQ3hKP48jso1lRCpyaQ5steQeRIPSGFCXHIoIhGw1PXQw5Aqwt+RV4qaH3Sl4hVKHJKSQwcZ84cx+n",
-	"xHk6IcJjTo8nX1Iv+mIOw1kRo0GpSnoHsWLS46LsFAecBO6zuQfLHzmBEMdOK6eEajkge2QUa7VI/ix8",
-	"dls8F1KnLfggIqf6OebEmfBzFowZN7rcrIjjcepQ0gUZO28vjMbNPmm5D7vPSgDghDK3jOMvMKmUSBfT",
-	"lyQeAWcJc+bv8oYlX9yu2Mgd1aVk/k3IImclXeEoItTB3BXhZqN3nkWpk+toiimbuPi9/haOGWfOFH3E",
-	"QfrNfVRnbqcbJc65ZPCRRJSkDpI/smjMyoT3G+YCO7P2Tzzm4LnPCePsWzh1wP9nyg3vefta/+nbfcDs",
-	"ARn/zxit5Vsuz3qn9xN1Lrwxx8JjH+y+Y+4CtvapOiJQzImeL1t6EFqzhPyZEyGNCiorYn6pBosZd1p4",
-	"D3ycKhmuKDrFIbhPUUAmINySlBNp8JgXTZmUzlcXkFJzY3tspP9jwbFWBRY3FL/iRL96f4u/4Ag0Azoh",
-	"3lS9O9Xb7Oml/fv8VG+zRi2+9Rp/wUp8gnLRpT5S2oK3QMEIFGf/1H/6B+/2VRtneIK/KAScX6id4fzy",
-	"6sW5btwKDlv7iabm/DH1b+xUZEWvWTrGhGZaqaz4IMQy1BtIUWL00NmzESncgpGx5cqfaQDcS7XQn5W9",
-	"wTeYjZhbQr4Q9zGleGRsbbKitzjCiSWNoiz2SKn3tykOcORjqvHklLpjeMcoi8xWmRVp9ai5dciK3mNa",
-	"KSCKRmJcAus9U1TgFjhTn5Wd4i8pZ6UC/jUFgd3BnJLgFrtYOsMpd2E8I6nb0RnjIxbdlErSGNyJPsdj",
-	"Njw3muiiLMJuq+dE+phwF9xzFlJzGi5KKE6gVMDl8NToqZ3iC8yZZHTsAnGJiVkURUHM3ApXOCQlnF5h",
-	"jm9LNVSTEicu3Fe8RIe/4RsoPUbmojEr+AdO1BPL6J5xmY41kVx8ONB/3/c2eq6y4CrlN3pPN5LX9eXW",
-	"fqTk7uw3pOpYbZ84+caofVUI/teXen307eVnUWKOAdeXW+/wLSbE/La1+pcScz2Y68utU+KHZJx14xwY",
-	"ri+dY8H1ZY5UIxy6guFv/ctr9Z9mP1pCrBlIosyEsGZkuNG76wvJkoiMQ+1cSoLeq97zUKa3HE9S9kJQ",
-	"beppLBEJpo32lFchoD9I8AeK8RR5gCBO5BSRkXa1yz9Fiv9IQCEWiDKJPACKsO9DIiHYrDuFrMKkHWJM",
-	"otLnpqQJD4WL3yIm8ZIEkDAWpQKULKa+0cM8DprxRALtp6t+GXSQESJSIYX+rYKTJpPQzFi+3rB6kzWd",
-	"47w3192As6iDX1LW3oWqvTp744oRcTY3BqZZ9sI1EjdjbTEDJpiaWhcQGXPwkCSWkitEZwyKO6Gj5IKi",
-	"+u+KxMwzyB26O2MWiKYx1oex4Kgz/61OVv9zsHffCUDRq7tb1OnJBSmzcD44OT47PhjuH55qew37eHp0",
-	"+vroQktDR5cHF8fqockzIib02LS43QAnOgceEyG0S0HNqSHVBhX288wBLvt0ORS2OJ6Y90s02dZe4RbU",
-	"7KLNm5zJri/3293IrD9Sk326Xp/W9adxPWEpQUhNC5epFxOp2FpjDgqPcO2bCWU/ip3B9k5/8KK/q84f",
-	"JRbTBFBA8JgyQUTmxTULj6XKFp2jNIrOWlmselvis9avaD6XHUdTH2LiX2A6ns9uy7Uzl0VhHSlaEBjB",
-	"GPvTnMCPl9i5Yk6bBx5DQHwcWc9+ZPziF0ZC4iy5bg5bziL9Hm5LqhqLVU+JnPZejXAk8rJvwFnOG6R1",
-	"Ruvmg3Zcdw490CsJneeorPH0AEt8yVLuQ+NCK143CR6Xeg4Qh4SDAGoWpPHV5SD0Z0grklbu33KIJUYG",
-	"MPRBDwYdNwo2cJcQruHKBIqZaxdLUCJG7oio2Izqa4mPYxaQESkEmQU+zZxDzzq4MLp1M2LOfOJyZ1Og",
-	"gRFH7a8L8BmlxlPb/tJyYUCE+wicM+1bWjBO93VpMux02/eKDgwY84SzrFZpzI6MYmkXBUUns+l4oU2v",
-	"TP4NG587MzX6//3izQHa3d19+fmnUMpEvNraur293SQgR5uMj7f4yFf/VI1NeSd/Rlvo9+PLD+jFL4Pt",
-	"yieC6S+IYH31tq+lXUwDLfH2zQ61Gco4+lkHahESxwka3hIZDlHm3ocINRUryfDUbve8P9jpD365Guy8",
-	"2n3+au+Xf1b3vUy0diYVtjInuk6HmtrG51Cgqpz5p+/Y/3fVGXSc7eP60JlwCAj2wASNiIyhdcyCqeP1",
-	"TJncT5KI+NjTSqcyfeYdZcPIgEJ6727iECE/BCEJLTw6a2xwFLFbEQI0BEpyvkXHh4hNgHMSABoxjt5k",
-	"n4n5uxhljdGsZjV/xgye5h3FQKSRXLDtC/vRnNYri7nAU9FtNjRnTR/CCKeRRBcQsDsUlMHANEAJ8H7M",
-	"AohyoERjjIm6juHbL/4X/m1nAM/iSarhg5Dn83AJUhI6bp5k4lt/5yqWpBIXqD5Sm0gTSIQsjQLkARI2",
-	"1BT2fcYx9QGpNYmOD1gAO4izVPNblEUqERuIcdXQLWSNCLA5LW3bWOpIb8BRwsEnSkrZ7NVjGlQdZTXw",
-	"Do7zMaNs0E1sE0J+iqUfnoIQeAwXMGoVA07VfLhr+gMPTOSBOidgfqpjErkypbPiJkpgqQREOIPbhrYq",
-	"oyzgcFspdeig4OjdRWVwHYkIb+9sP5PPJiTZlTwjIt3UBXxNQTQfSuKimzm7TjPWiyAEomsDFhy7R4oP",
-	"SeGUfl/CA9LVka3fiAbLQ1Xf981ooV8HcRQ/C74Go9tBE1oa4KirQjiRwAluDDmndiSBMEWfeh8uPvVQ",
-	"rKFW/EjHplFbehZqK5O4a8f70wt1qj+9OBsefnjd2+gdfng9fHN9cnK2f3pUp7DmkcZjsn2z82XKvvh7",
-	"N3qk5WN/Veivt7DNAj7e/fri27eARLoFRq/18V8jrD76D/aogLCRniRT7CUxOIEA3YZAEUZGhWARE2Jh",
-	"VHva+kOtCYuDo7P91ydHw4uj8w8XV5cKCceXpZKOeBAv0y+jZz7sTUJf0VRVu5TNZYO8poepWJ8lho5L",
-	"b2+X/kJIsPttHN7FFRoTCaMCVqVg67rWsnqZcJ1x0/lL1N1tmhVzPae9BsaVDbkj7hK4ufkyeMkjTKf5",
-	"+lRCwuxtj/pRGsDb02NDlHprzk+lZSI9HiF9DkP2I/T29DhbkHqXb9ipVnsodtiaGtm8ze2cMx+ClEMb",
-	"M1Ln431fq7c0D58TRMetv0+DI6qE0AtIGJeivte1sFFvl+3g5zc34GGzuwREqHZs2n6nuTngAG3+rMJX",
-	"MxwgJZRobmo/QZdK9haS+EKLXeeHb5BtBonUc6NmzJMC3b2m3KFApzjpSMHj6fOAbcswinfHzzIKnkm9",
-	"jvB4HHRZlFVZP0djg37DTK+S2OwMaUJXQ1SEO+bZSauuNRsVkv98kBrFU6M3Ow4yOWnWBDQj8+75HuO3",
-	"yd7uNH1pGFB+xsBR9GHUe/X7XNCq3OP+86p1XElGLJpWOmCrvKQdbYkrBNzpUFr6FBKwu67b3bfd7UkI",
-	"L0MPf+VGxFb9BmkEgbO6ZmoGK/WzTcNRodWj8+BOu8kVHotZO0pGxU5vNey6NOUgrmGcFqwSJZd3qHbW",
-	"2yJTDcjtXjKIRzt71MvWdnVQDWpiIpAJ5Jdyq1+k0RThJIkICCUmOWtRIG33Zw+WWi+VHReqoQGD5tjR",
-	"il+VbjtxpNUqWvZUzUOg+hQQgS9NiFg81mBgIZhPsLQnQEeFvYmORyiAEaEQbCAcReYbrSe1VdAtiSIl",
-	"8HFIIuxDgECHBtaBlzFFQDmLInXEQUwduzQXd7pARCA910KYS+9cLO5C9+HeIE5/Sb/c3dBvI8PO50u4",
-	"I58/A/FiN/lGfjHbmIBEW83xOmKPR0iA1GhClNG+uco3QG1kaMxOwBkuRBIRaWdUB94tOthYgh8S/7l8",
-	"Ptgl42RHr+7KrqVIcVGavt0NB8948tXfe0YmhqY5Z7xZ1rDahVxXtTfYy3vRNAxcc31zKCzfV9kDFgS5",
-	"Yl2gW2vwkAn/s4/OvgmclbXurmWt5W2Qn8backzCKfCxFXIaR+Zyt85h/qpq4KwNB7C39f47zssu+7r9",
-	"QmDBv7EB033V777qE5QKyeLFLtEO9DdFzD1hVITdGzjX35SUz44QnjW54cSNtAUW2rkqE/32cw0DBeA1",
-	"PGS2IHOETxlyECGLgu73ZmUQrrIGMnGnuCR6Vlrfe1W1fGWINj6cA9DnJQPSNkoobRNWmigc4Eur43Nm",
-	"q1yKA3zOYUwx9adXVk9dLdsxZTrWMhE38wJ7asUNTeP+BHOFBqFDuB3uO73uH+7Xeq2W7Zgyt9dV4as0",
-	"y82HT0Em0HT2XO4g6VgzzaHgNEmAv9bccznCvc4baGJpliydXj43MdkuTdfwli4R01PvriWTKp3EoFeN",
-	"S18dh/luw/bZNIYYdNTSkCQXoPrTGqyWiM6pAI6+MO3ngLAVslCcCmmVWko+shKYlZnUnpe1Wo/yrK27",
-	"DlmMSYuVgfN1LjZqGdJaEmqIQqz1jroxZAMTG0EOG7gUuIHpxbk5C5mQGfup37sYHB4HSaNApEUaLCUn",
-	"XioBaflGbiAs83QMCg8ZqgqJtABaY80DhFMZApXEx0pCwGNMqJCmfROMUk6RI+x3sJaz+HQ249N8jpEz",
-	"yb2u1CCWJgeJIsBCIkZz+xiRgK/v1lHR12wiKb3sul21kvV9w4Y0G0+iGVF8DAdu2OzVyFUFKHn7XQXa",
-	"X0I62E7TMKKD2zuj+AaJW+TZtClxjLYZzQ15zKkpX0Bq8kYkki4ZOqKvzrZx0N6ufl9tvaGlEg6061Id",
-	"98QeGefZlz3MUHgl1sYKknQ+tWYjutTVVxm9Vy3SNsNjvYAL62NjLuZYZ2OjLEUyxBJRgEDYq43Ymp1t",
-	"LnjHbIHpaMZ7SmpqugYiKFDWOEbfBKhBZiKyocZO09k2fH50dnh89ra30bu4Pjszvw4+nJ6fHF0dHTbC",
-	"hUzfvUZTU1vnWo+tmUcsTRsNdjipaATRdD8TgQsZ3ZTW3n1Dh022vTGnMxXBxViqM3hh3qDTizNzi6YJ",
-	"R7EjxpEmG/U7vw5qUueaW7cmBfGIcd80bepQJTf4jArJMXFN7tou7vPfeS/uFFycLaqSePH8F3/3683d",
-	"dJeEL43WF7eYL53VTPvtWmwzcEZnLaKOgaglLPb6rQQzKGdaAhoYyfeCsc2ScSbMydOxgS4M+6wuSnS1",
-	"TDyofbnK7BKO4d/yVn80jSJtTlbupbACfKgB+EMdhp6yAfkSFuERFvI6iRgOLiAmNAD+MCL4F7Aw5zAh",
-	"cLtwbpEL/VkXMl0gZ8aTNHa3mZE64sXeYGeALWUor77jY5CHMCFV9lFXd1ZYwurd1PT+ki90l23PTnHR",
-	"wJnr9jhN0pvFyPp82LIF1m1mmqTSYonWvdfO28BfaKwLSa4zMXbfAbQmyTZrVB2TWmwcYtZyMNFHKzbK",
-	"pdfiFjIoriFztRGWCHP1jIUw+hMskGpdW38ETF8nfaI4SaIpkuwWc3VaU5wywrTvYQFBfuFouo5ITOQm",
-	"shE81Vda6STMC3NXOlBy9vbGJ+qlEuEoYrdCX1+OUplyQHCXYKqTaGqA4zSSJInAwJWPi4z04TE/LpJY",
-	"HbgGTVoEBewK0FXGksaJVmE4aPlE5yFGX1/DnTaWEkW3TTMg0C1Eke6LTtEnqu3d85o235CZRDtTi84T",
-	"+qSOK2iCOWGpQF4qiD6ucMBCbVTzMWslOrFsIsJsd8wlQ0vytZyEC8+WV2g4N9G+MPaiGQ6s/7jz+Sda",
-	"JrZ8ZOYyWqSxFv6jKH9jwBG6LbjzAYIiQWwZ2ZtN/EfXm0OVurv5Y91AHMaYB5GaOzaqkMi8WaxeDzIT",
-	"6UvzGLt2Gqw5jVJuBl9V7080zTdxMKDBYYc8XVU3qNaFPJvO59KxkJjLhQGqbk2zUIVOLCAzETZToRFi",
-	"HphWOm5KpSnQRkcj+YAG7tuGNsvksln8bb5qD6xze60RyszM1F6k+sjQ+EpJyQ0vGgbR6KFdH0GVRT18",
-	"Q1G8F2d3F/58dvWJVhtbG7uq6xRhJo+fvby64Cm3X8rgFTCOgaqhelPX2c+4EaqdFFDChCBeBJ+oAdFI",
-	"M5lH4gZy3Rc3kDbF2UDW5XHDOhaV/R+X5tX5QPSGHOIJNM2t3s3d0SzJne2MNHCbjE7ncujiIDkvzW4+",
-	"NJtpNzCBjJtOA7lDeGPKwdZz0RxLJadp25AzcAsdz4czb8jLCPilU3eLvO6cQFeCUc9mQp4HoudkTHYS",
-	"KHfIIFx8dl/DpyhG045Qm+i0bsUxVwXkKl9nhpuReKyWcRJhE1CionIdVHU1rsb106fkz5N79ffsfvj3",
-	"T+lgsAv6r9///Of2fen9p0+iWuXf/60xMk4anzueIpUFu7ydTGuiy4ZFfoXHs2flOKhRecUHoGipkp9y",
-	"5Qptt6vm/JM1NVbjl+iEuJ5qs2KouC46C6702WtczHQ60st4Me+hGOZ/kt24NwgvotUjqJxsOPO+znT9",
-	"f2a2Pa96f99GPz179uxn9OzZs/72zvZO0ZQ2+biv8qXsy/kGgR08FWo2UqZxl+bVKJAZxpxRttiUUOMb",
-	"qHdp3Ri1tV2z6I6ZmLNpa7oTM5CWUjx3o9ZSrub6UtWvka/eo2PjW3W9ebm5geQ0IT6OtJYF0DeS6EpI",
-	"pH6oBI0/Xu7tDrb/QIzbn/3t54O9P8ohefSL1qA8tm+bZLeuB2+70ZnhXDVbQ9rFbhtG/mQK+JmIbnd/",
-	"0RS0THyMZrPjsl61NQZFIWTl0S1mudI2+HyReM/3wRtE6eC2NIbm27H6bZ/nMSm7c7dZM9XNvj99yUcT",
-	"znfol8j/atzU4M43BtKPBcPzCfzy7EuMuXyefDEM8ZaobeE7wnBf3/pKIrsBMMfWRjZ1rgxroSo099yZ",
-	"+24U5b/4+vyWf3vG5RielSgqv4bPjFJySHLY6iBdhYQH/XPM5dT4zZznur5uq3QUxCP/29dpvMt8Wlul",
-	"1Q2pgMmRP7YHg1bGlK3ANguEJser+i1/Vsl6ObqqjArvwgFQv0VGtS9za8S8VW77RofaXTCzeKtVQAED",
-	"47uBRyPwZV5+GxLFyzmgzPciQHgk1UFSHxoEaE8fowcxuvBskreVAPlc/dneU393B0Hh933Y1QOOePLm",
-	"2ctbfxyyFy8zt3Xd21Gbk+Ql0ABhx3dUH7RxEfwESWYdsmYN5RNtdh1ugPHLi2BnEn7bezkapCUYz5iE",
-	"Ize4RGGffwa3DrLM04XxteqKm3Cw473Yfsl37gLtXl3ZSqqI2siJyFlmOSnwgky7rXfGd8nORJBpAPyF",
-	"cbfK8uE30vrbiHlaVrARA3RtIzAYRZS9qdOKFeclEWgXjTlLE61c3kPaft3HAhCOkhDTVKc0Q36IOfYl",
-	"cIEINfor/dUm2o89Mk5ZKtw6uZBy/Iemjz+2/9ChUP74YJ8Hf2i6t3clJnCrc/jYf31wePTm7btf35+c",
-	"np3/58Xl1fXH3/7XP/65s7v37JfnL15+/nPvvr/CWh2S619qpLXJStm1eYNuwGD+bwIl4VRo8wTGUcTG",
-	"+mfECj3+oqdsoQPEVs7Y8z5wAodVzrot19ePc/a9JM0mhwrk19MWU7OOuP2//+f/JYH2AVgGyyVdRn0o",
-	"lTEoWNvGocPztbtKLEknC098k99Kx5nI56GbR3lZDVSnsAbjp65mLZ8tRGdt1o6azxEJfxN5pNKSZmmm",
-	"aun3V2OOk/DVZ1eH9Lm5GDVpkhawzpG4hXNcqjeKBrRkRf3286FgMUhtcR+RG0B/HOybY+EBjsiIcUpw",
-	"5Vh40B6o9VK2WAQKyQHkvvGVabOqVPu/dafZnNGFrmebauzLqCcPtCN4c19W4WpIDU2Aa5sCtbf4OPJT",
-	"Y4NRdiWvLZ2QjMO3UeozAbknVhMl2VfGHVxtp4ENEqZQTkYIG1dmtZmqJmsuVmVK125Yv+xpwzh2u+L+",
-	"I514pWP3BnkfDe664nhO84SaCzrg03erR6/2kl8Mx+qTk5XjWQOyALKr5vclzG80U+IMLDaTTvtgHf7u",
-	"54tqSa/ZYn0eYgnNrCDQ93WSY//GojK7IXFWZ209jgg38VcbwvJpXjiyLkVcyCwmUYAyh8Uud08bvRCL",
-	"N243dfvpEIsT3KGCkf8zu4LGah9SqU33Lok9XTYEXMZdhqxq5ahbeMzG/lZDkoHbsSszSwv3cqFNjOr9",
-	"nBChr1OtCVJ2m60J4xYLZC0dFcG0B7Vo0N9HtemoBPowej91El0RSpmd2WVHGmOuVkbWzMIjZlXKmrdc",
-	"tOOWOX7bg3lGeRuIQ8wmWdC5AiULI0MDk9uglCHSHs7WMM0CVlodlrVKhhKuYz0iQkeEEgnoawopoKDY",
-	"xZe4ZS4t+/Iab1rQDavXYaFBxvcezEHbfGgspZgQgug4v8Irx/kdwc7eixc7288B9nZh29uBF7v+zqh+",
-	"zdd0rzfYqLjTZJ023+KJNEm4jjdzxmRhoZidBxotR6z1d5nJl4xqj+mEGKeWJu547wKXdY9K/TcadJAA",
-	"SqJjky3Dgn62Cu+SQwzdhJpTY/SB8oAHKA9XgQhFxm1f74s+ljBmnHzLZYyxab2QNWzPkZF8NhUCcl95",
-	"z9wO7e8fHG12l4mMU67o4snxxlS1SAgXGr3W7jx09KpLhAUCIbEXEREuPeqM4R2kko1GXc1VOkrpp/hu",
-	"dWOO2O1qhpwAJywo6+K11tjhCD9tB389D/7a3gv+2h0EP/9bm759xknh6vjwaKljwrKeHN2PFyulxfz4",
-	"sZrZ6Xw0WSlxZUeXVYxhzrEmZ7U5JdZW4frPPkVsO8v1nJ1ccbj2A5HdSxwWWJuZj0Sk2uGs6klqOoMg",
-	"nxEQNowcBLWDT8BZcqxjDxzTK+2kdA7ct740xco1BkyDzWeVBWzK/+df5n/zn28f/Z8/fQo+fdrUf4P/",
-	"aFzdivEdvD29FvDo3R7Tfapm7Tt0fFRs6N+h9+/U7XeirmP60S7n79T145LYfZnDjAoG0sJiZmW/ehSX",
-	"56fqsbyMu/ET8r1dOqVT7cJMt1TZuRyjxha6qhg0ViNl6Pt7NkJY9jkRN4XlsnG2YbSwcde3vUqoyKPA",
-	"14Osqn20y3QVh7NyvpJ539ksJY2WXabzorkKqmbjKI3kzPxzE+B4DFayOI1Zg7vAvqmDbCWj+/EZD4SJ",
-	"mE4EykWgnP0823zWWVi0ctwppngMOvsFDdQpuCn+6j4KgJNJptWyhiYQa72OQPvbBy4UzxeAwlVXdjs9",
-	"dfQKrnDBklRySmgqmwP4pmq5KWTHpo4iWCrRLWBuQ4yNY3es+uKvy8GkSSSqJbBQL9SkK65GYpjX+WBz",
-	"tzumc+mosmt2BIJQ7ViqDyvZEYBjOoaHA1TeSxeDR507VglOsxDXHSarW1k9npaGaOWQLDtdq56qmujZ",
-	"HRQTuGF90BxC1HgDQ0Yj4Nr4zwN5C0DzWI2VBio8Xt9DZ3fSNmEGSxImiFbLj2yQ3wL8nQWhb5Cmu2Oz",
-	"0GKsEqF1KXtBiFZFby0xJSoCgZETOklQmcwxw0Oyk5zZKGw03O3UwZx1dFCy77UOktfiIJF5R1zZykjV",
-	"RseHoquDREOKV3yXOUgMrCVN4TAxR3Ru8pGo9LBIvK7qqDbRhyhAQk4jUGPUVobbg35AxkTaCPQmYhsR",
-	"JijcyESHDeEOB3BHYh9HSNcWm+gMbitN7f5im/r9+vr4EE32isSOQDdvyQ1JICBYZ3dUT1t5kv1oOjRD",
-	"HxYeWf/DqsqGe8OfOKYBi3/+uXIQ/X3Qf4n7o89/bg/u/8ofXtz38997HX5v79z/PMvGsYrFzuc+SQr3",
-	"oyz5IwE+2B4MeubtYKf4uVv83BsMKqkc3c/KlpfAJ8QHdEWa4tlu9CQn4zHw064xPmfGT3PW31Wl3aYV",
-	"aG6sFdYOQWISNbtztJ+uuybaua72UwdGp2TwU07k9FKtXOvdDdr5/YrdAG06KOQzbysiqWtu9Ih6HwI2",
-	"QYSNlWHvrp8t1b6t38/qZ8s8Ie9hauylCR0xezKU2JcOJvR1IuPy/8maUwum6CYDSrELrqq7yVNLn9T8",
-	"z38DDwlLMNpZW0jGQbjJUhH2WCqtvaXYKKKXmrRIMgTC3QCREfGBCiiMLXuvLw/7O/2DCKfaN68M45jI",
-	"MPU2fRbnnLOv2IHpZsuLmLcVYyGBb50cHxydXR7Vc+ALtH9+bHTa5salt7050IvQwb8eZPeO9U19AhQn",
-	"pPeqt7s50C0mWIaaULYm20WeeV0ybkqAegEy5VSgyBo44CgqEGgbQNyNgZQJQGIqJMSb6Fgd2SiOENBA",
-	"60sKeytjJq/jc6Y8YcJkGFMryaZ8spYV+1F0UICqBsFxDOZKs8UmtaiyZQWw+425NU18kQ4VHceyS4n5",
-	"ot8c0UDnWuJWXaPRr/imXTxWoMImEa5CxdYXa3ZiNukF0/4TTNuDWpVDXdcW2If3htVk8duMqct+FKHS",
-	"lBjN1++9IiB1Nu+9z+r7Mrlt/Wni/N7bsvn0hxsoUOR+DgRTRNSjCeqN2KiRjixwbxjPYV8/NT10npec",
-	"Xqs26zifGRtSq9LFTXVaP98vii8b0FlbcLcRAaGTLGjh2hrf+tP8OA7ul+9n/qRnncyGyUbHbgBEb8OK",
-	"SRe7Yx4SuzjmGGmuoJGqIPHZ+CE3JCbImLEWgnOOLBkyCcYQhdssog3cqdOtPg4bn6K26N+bKPMrQbeM",
-	"/k268b9RSiWJ3EA5gRFotHidsMSa46mdOI8cbaxg1KIgMpqiG+LfQNBnoxHypsiLSFLfJoxf1hncGko9",
-	"ymHvrX35dV1oNt+gE7K/M8+cyR85gYnJXZixSD9vsJUHLsz3Ou+N3Rlk7uL2WFvu/C8g5JmH3yMw7lk8",
-	"eqO3t8L+8sRlDb29xkHu76673X2Ubt8w7pEgAG0W/eyRxprzP3XIBI5MdrT2DbFpC1RiOWeRycZ0dXx4",
-	"dP7hw8lw//D0+Ky30Ts4OT47Pqg+mv+O98/MDtrIm43VMsIOD66tYFPnIHtpHctfs2C6HqZ2/0jcc6PU",
-	"zl0clZupHn1nr5gu5D2fFhcnHDuB+ezMpJwqhzfccOizALb+zDnj/XzGn6fVMdjJ0hoVTr81InoLdhd4",
-	"Pb3MWfCT2SbfQrb61G5fdsOdL402yE+iNMY2EWq2ZuZzZbL+zEzd7s3MRNDoZ6HLi2OKzUnFQQs/lBXR",
-	"7fKgfVakEugnDwQJIIsfa4t/rks+phOHJZRmca8hMQRDB3Zay5g3Lc2gX501shMtelOTUKGF8L4ntW30",
-	"IkJvMomqX9aBlOEt3ousXuB+UKa+PGye+vTfLGve/JoCn27mbwxP/c6Mqlhia9rfFpIsc6tRfWxJG51J",
-	"tCcPpgjurB6/ZX80NR9tf1x027r/nlz2e5GdVVJrUiirp3//rKa8oEs7zyshzdr+WrDsjnrPtnNVlpZu",
-	"E804YC2lqRSAuR+u9pDV+dxmVPUdKqoJeCS11jJKrBaF5EP50mx1iqtJ8etyvFGmtYvy5v36uBXBdLZA",
-	"Pwe9Jcm2TTPYZb1lpTr+60zZ6UI7KZZVvCPO4rxgpiCUIXThkZZkoHYd6DwxKNdDZzxjTCbGnITwLKle",
-	"u3jUDv1aaGIBkXxVWuFibW10rGsiBs8TESryQdO6c0WEp77uShvi4usOQr4lptRfXOHdgfftU0Rq7M9Y",
-	"nuoU/0kqQut5LUHI/ISTuUXr23kh9TUODXSEsSxQmlY3u+kSbkNmo3BThH1JJoBE6uXQmDBX2Jcpjkw8",
-	"biJ0/zoDx5T6IWeUpSKabqJ9JFLfByFGaYSyKUEx4Cz9hurC+QZJLG50AmEPgDrR3hSQpcDupVx79hje",
-	"DO4n+on+pnDE9e0W2hvsaS/PNyylQZa4OFOXZ8HkKuM/eneBigiD9WPh5ZT6R+8urMtzhfZ2GqbT9yGR",
-	"EFQoUDWj+7INzdJZK+ksI4kiFlQLbWbXMmVZbGkqXaSjB10FLcK52i6DusNXlM7aKxvFEFNZUYxpLXA2",
-	"0tx/vWkHPTbVV7OR2saW21Abx8WzXbY2rM2mHbXjaL7fxtoVQ49CptpAxA87TkWewaIkpakXWsapz8d+",
-	"9kE+2Ct2LbTF0To2YdzS3aMptxcghRw1BSEoTFrsLLLrZwwlcYL9rZGvZt08La5aZHcfVrPDd7Qz0Umg",
-	"mxPQG0FBSzkeIJjgKDVpXEKglvZ11vvi9KK3/A80ym7PEQ5i9d43t+gghLEnz1ZXsx3UaWPu/XVytPZs",
-	"/51P5TNS5q/4iJ52lVIls6GHKhqd6jy3nB1mTMPqedjMGbh/slNvTy7dJ39pQTIGPoa1nHBOVcvqADAW",
-	"G/lZxLUf3bAyiDnBFDdueRJ5m+SoRWGh21+rrjouenjQOVRD2q6XXX7uFrGDcqJLrMASylp762kilEiC",
-	"o1LO//JU2drHpqJrIr6OaWuycF/3aq/0NXeBZ/hrwt0Chk11api5TetdsKjWuEm6r9ePr84cMdcO5gCi",
-	"S4llOtcSbOPR14aBEFzXfWHcb0omgIX9X521mRZOdPVcoD0vjOzXwu3mrpedR1svLeqUDLMGMY6o76Dm",
-	"wasntzNtXUbq3Ikde0tvOkspXWZ3T4UBqTGcdmU6ywqWWSjrrnbIi52frRCqJQXNCswia9+GrPy55u0n",
-	"79909xR3n0y8fPimY/nbUHOymQummi23tk7OnUyr61wq1ZTiXVdLLd3vKs9fHTG8xWHEQYSPdyWhVYa6",
-	"T3c/s7k+s0SlNu5Jrk2sn78vTBsd5niuHGCAmTcbSwvVGb6zYDQrR3PJUDXDp9RZDJuuuM/zgDdr4lfl",
-	"/JVrZlW1zpoy4lK4jaZ9a35eQlHjvXolE+QCuj53rrf+dEMLdbxcd2ArX68b30g8HhcDEC0XBpUJ7mB/",
-	"6M+yP5yHjXWqwl0Mdr9snrUGTN3/hmsgC+7dTv0WjWuhfp0DZb166DqtNHNLRifAZWWt6UsSnUWozjXN",
-	"BwXJXDGbpGVtE5qlM5rFztSiVXBBYOHWxoIvGwaMKWUyq25CqJRGjK5CItQGHAWI+X7KzV26CQ1glOha",
-	"+W6joJrwH0o0FlrXFmKBcMQBB1NzJ88B+yEEm1XmagFwE89KhrKEN4vTmehijJ9dH5TPzS0awBMipHPy",
-	"+xeyFhTMumA1RzKX00QHd1fVkDfN4gtoY2jHMp9xk8zN1VIUISJMOC03OoY/jv/yxvHPjTEYaxo+EgM6",
-	"NwF6JMvyTm/YUK0mY7weRjNwefi2JtAWD6pch+84CxbeAkCEhQ1SCGUwusXDbwgqXA0OnIUSbgHAH8eb",
-	"Mb4r9T2Li7yJGJYmem4jAIQuAwChqwIgi9lXBqIcuW8uPA3BAVcEXhZKMM5D/qE85p8D5QzYZsQMXBGM",
-	"DVGdfh9sDvrbm4PPSBUdvD1FJkpGG5D1UHuPApsHEbtFz/ZQPN4KTubAVwtv9YggPh90BLEcmu9RICTU",
-	"hOpCz/b6neH8bkA+H/S3X3SFshyu7nEB3X4x6O886wqpGwjuUeDEHpsA6g5gNVrdIwK52xnIhkiSjwhn",
-	"d7qsRARd3V6sw6cuyLGzyKxdoTimHWBYjjOvEZQFOfBaIFmW064XmCU46noBWpxzrgWeZTnkGoFZihOu",
-	"EZ6FOd5KYbmwEv6C/O4iF7lXCMNy/G6NoCzI79YCybL8br3ALMHv1gvQ4vxuLfAsy+/WCMzC/GWlsGSq",
-	"hUyTkABHAZ7O1yMcYhJNFwVljnB3xaTOUZTTTq7KakWM+mKlCDEwhCzlwrhCGbesDrDob34jMrQeRysD",
-	"JsDTRWFRn6wWlEuJaYB5gAKYkDyucUkj1U0PJWxDh1k7q6KeAwajEfG1yvzDCH3E/EFw+kVzH0Z5Yys7",
-	"xyys1PS+t1LTe2JKTW+tSs0VKOW8p6+U8/5FlHLev4RSzvuXUcp5T10p5/0rKOW8fxGlnLdmpdxCB0Tv",
-	"6RwQvSd2QPSe1AHRe3IHRO+pHBC9p3RA9J7QAdF7SgdEby0HRJ2DSfHipe0STBanFaGkAGdZK4X1gIMb",
-	"xfvlDBVWCuE6rRXWg0q96S9norBGgB5kl7B2uJYzRlgjWA+0QHgMyJY3O3gM6Ja2NVgjcA80MFg7ZA+x",
-	"Klg7cMuaEqwHsPhBhgWLwdRNvohXYWawfsCWMzpYJ1wPNEF4FNCWN0h4FPCWNk9YJ3QPNFZYP2gPMV1Y",
-	"P3TLGjKsA7LsCOdbH8LOFg3rBOZB9g3rB2w5a4d1wvVA24dHAW15S4hHAW9pu4h1QvdAK4n1g7aszcQ6",
-	"IMOrsKBYk7Dtqss6WlGsA0WyyaaiqyXF+gAq21V0tKZYCzgmjsaa7CvWRFshIMdEYqVGFiuFeJ63mgIj",
-	"wkKqyX3DWbwCj7Wju+5dXrEVdPgAnbT3tHTS3jp10opmG/XSyxqbfG/1qvdE1aveU1avek9Xveo9bfWq",
-	"9yTVq96TVa96T1m96j2qepWvwkTku5+xvSd9xvae8Bnbe+JnbO9pnrG9p3vG9p7sGdtbxRl7kYOkAWum",
-	"MtNb3zF73gHHe/wDjrfqA84Bi2PcF5Bgk5y9EqxGx+k5PhS9jR7cJRELoPdqhCMBzeDpEDouUERCLErQ",
-	"/e/fcX806L/8/OfO3n1DVJS8AHOOp+pZyKmOsKKa6HUfgQ1aJoiEBUagAwo99hB+C0GGwEvZjYQ6SU19",
-	"iIlvuK1AAiKT2wcRgf6dMvnvn6g6ee0f7hdaDlv3J9gcbyIsUCogQN5UH9Gujg+PbEqlnz9REep4Sx4g",
-	"FhMpIdj8RFvITlU4YzRzVbnQffQaIgl7jEWAqQklvO5oY+LCdrBQ9oEZcXXXkhEwj43op0KygOAoi5Js",
-	"slplsz47VuK+7+cBJtcWK+7xAsV1nbFKOMQCC8sE69rCQpAxHUo8boiM+N3Dwu1r6BqjwqWeAJeptYZf",
-	"NG24geIMgtYcZ1uSABLGomsd9bgtAPq+Yof1UZhA08eHQg1WaiHWRGHFY2RmLFYU01suUYHFqhvy7Ypl",
-	"6cQfHmM7py0TWPOp0pYNpNkU3TOKkGZD3YmsGuNTyT5PiMzOsRA6LV5GbtmYyyTmjtjJrpeNehN9iIlE",
-	"dhjIY8HU/TiKah8sSaD1GKdIIXQNJJrRZccc9RnaSkFgN3VmaMJBaKRl6JFMyRExDkDJGriU0wjZiMp+",
-	"yjlQGU0RTmUIVCoigCCLM6/aiLH0y0GZSbA5m/5WkuC+aGuZ1K5OusfFcru2DuG77bdOUPDvFWl3wfTK",
-	"aqfQYZUJHTtzYYjWSQ+fmrRpM0QsterWmHrOd/t68pKWxt9RhrzWnHMLhkOeFfm0FBb5v5ucWw58vKx8",
-	"6zD4LZsJduvPhLMJCfLsH4+yfjtUzqGasdovgQY2qLyz0bjbg2RZyluETRCHrOGmIMqq3nnx/gH7hm0M",
-	"Oa2tcHfeSoDHRIgs8dGj8dwZa9kByWZFzja7EAvEJuAcZYs0QMcjk7bZ+RhzJTZM2I1OxYww4iDSKBfD",
-	"TJDwjdIsJ5yNSAR5fkWug9UHhkkVsluRUjFXpPiYIj/UymrdYAHH5mwGdO7MwFp5kdPR47GleqcLcihU",
-	"xs+DuZUzMVt/Fg8dUhboEOJ0HLmT+1+UQEtybzEBq5SAUanZ7yb/bTSmNEvKQ25LagY0jRXUmb5N1Z4Q",
-	"uO1t9NIkYliJ4pRJ6H2u68c/L0i3XEfjFrPJNA96bz/8m0ARFupsaUJ5I5Pzye5sqpSlAgmQsyngwva9",
-	"fnZhe5rBKjZ6ey1UZwZZpB+flySAg82NEJDRCLhOzJPlRPubsM3NpuECMd/1ADNjM51LDJi6ydmPD2dv",
-	"Vk+JEmZtGrMmZsGFZ1bykENMqJK/HlNKapVVBTJgoQysirham0X10bX+5CIbyFI6JC0om4aQ09IiWDbX",
-	"QmKNyXPfAlVj10mGRMIBByIEkPZCSh/kVefqvChvmSV/0aA3Mc3oDLQX+ts1SWjjek8PypmbQZ6p9kwO",
-	"3XwIi8yWAD4hPgytHLOerF77QSBcXZ2SrPzIMi0LQX6XpSUldVIr2JbJF92Q8z8ILs3X673VwtV+HjR7",
-	"+0GAbHMz7qGWztEmQEpCx2ILQj43J/3RuwvEIdLq0+zDJgXj0buLy+L12jYHCHnWzSKKRjUKB7wFUbn6",
-	"zPB2t2pCrr2qnam6qiJ79QRdx/NypOwMdHn8dyDlmNP5CZJOL85m0vDpxdlj0HDM6TI0rKB/gjRcAasx",
-	"Z2wFr2vIGltD6YPIdQFUdyHOJdO8zqRVNxfoYxBt0tDf0vlfZyH3UYm3FaoZR6A6ytemsWvC9oMIu/Ms",
-	"dCVxbcO21jyvNmVe021anplw9ROgBqY7mZXR/XFzIOYpXR1zw+abNbxMnkE9lVt/qv863dq3TY1525w0",
-	"comErDPGsk4Fi0HDXP7RggPzds3k+f3JMsuy2k6QVTQtT5AP1xYsNumNTMkco00m0Q1EAnVa1Ym7CS3Z",
-	"qHgsmG4gQq1ORhsG12tfX5zUT6y6izVTzuvpcfD9qUdP6CziMdiWtwxlptILkU+aJByEgGBImUK8GcN6",
-	"dqsjJ+G7WRX5uHIwUBmMFp6RVz+r1F4HMczu86HCRtEuqg5mkYmUJICh0dx1yYFrdXxuFlwdH0B1ibyp",
-	"sUz3sYQx4wTq83BFgkLXVyGULjle52V0nXGbtNIMr2txt2gHPvddmEVvzPsCvrTMZ6MXE3psPtte2JEh",
-	"95hBMaE6VTMbmQsNNjLXHNpGw1oUZjYyM7LdHmKJD1LJRqOZ41yt74vjJuHQpKImrvVvaqtQsKpdg/EA",
-	"uL5Gtk4UiHEEcSKn5oY4gBFOI1klcOemWLUIAeojAZClvDaeGDnp/d5ryXbQnGGgLbx7S6TygLPkmF41",
-	"htdvSs0aA6gDiqmqj4ZdaLW0uBsoNLu1XWKkjTHiV4yEZVEz2zVInarHrK/K+uKGJH2maRNHfb1xAc9I",
-	"/a6vyEvTVbnoG3CWn8fnrc4R0p8qwqQI7vwoDQBtacqt8GXK8pQ4FcehttVqmztjtcw4Fdeg5ce8Xqci",
-	"qbeYxRyKPhK4NciZcYvzAD3JrP33YZeeC1jY1qUnewuiYWiWmK7I2uxonQ4eKgtZKLuoWbok/NdXM9qa",
-	"KFtLkqHbkFiT9uJiLMQCYd8HoWrUMPiG0MCx+q/McNPaizltWnHuztfoTEq4Dr0E8z6uHxPycxPPzKJu",
-	"Gb8RCfbbPFfz98fB4t2pSrWOHCDm93mlumm0EsqWxEYPQn7JUq5B/NwoP8xZXmw0EiC7HGojEhPZewwn",
-	"SUPUFxAZMT8kSWcNsSLE2e6SlcVRstCAkG+JKfUX51JdzC32lRRW5VRmrWnRKkmFWXURlurwnwnYdnRI",
-	"SCyJkMQX2tbm/PCN3d/0olWL+OjdBQKKvSg/DptbwGJBZ23q5UwR9qWSfEXq5YBuIkW92JepjoqmNlSh",
-	"QdOmhVPqh5xRlopouon2kUg1TxilEcqoAsWAc4NbWvoGSSxudN8eAEVq2oM00l68n+g+2hvsFa3UpE0y",
-	"Ult8A8TGItEDNGIpDfSAjTOLI6xXbFim1D96d6Gd4BlvdWnZaZhD34dEQlA1ZplSX2P/UJ8VGJ9hFt/t",
-	"jrKRPvMO10Gexy20aTZS4zuVkSKh7qaRh2IwHxQeUdaPaI7d8qUd1VpvQGwni959tBoJSvRGUVvjJl0b",
-	"1yK79dafqXYVvM9EqJn3fIVTn0DY4luU/LtquD8hwnJYUSL+xSjqv9i+od3sDxyWuUqPMjOhhWxcn2rF",
-	"Z4dCb+RL6BXz9lvuGFrkYwG82GQ0pzcQ5P4oKACJSSSy5W6cA7EQzCeuvt4u/znLXLHGSzvE9Sz1oOhh",
-	"zevc3o8xnh0zcAmB1SAJXdY/h4Dd6blvEx5K/oOE+iwmdIwu1HcoBiHwuEELec6Z2qCP3l2cmioPwL2V",
-	"Lo3ybfmTjIFY7ZaZtttBUY6YDVOvtzHzQt9F3pbeeGaisC5/6Vgj2o5TfawdMqk1pzJXMCYqyRncfuAB",
-	"8J9NdIPMV5gGuZChRJjjwlfQ6orDfDUF+WryjdV4JrAYpVt2QNVij9XZ/aEEopwE/9Dd6ffqcyy1nqFw",
-	"QXZPcE39aggPU221H2H/Rh1OUkq+pkDVqc5nVEiOiWqBGRXK6cWZ7vPww2s0IhAFAhH5N4ESJgTxIjAy",
-	"XpxGkiQR1KQBxzM6AwVLyYmXShCbaF8PVKSRbFAt5vdgVhpUYOi+9akUR5GaKYszkVUjXkTk1NjtS+Ax",
-	"oYBCpg35Q0yDCFCQGvrW3ximlc+bwYWFmgh3crKR5TTicyKBE5wDjgM1vIqnnw0FpahrlMqUa4HIEpSS",
-	"qFVLmm0winAuDf9sNKmV2zzVs1kI+/lRe30me7q7C9P2I1zwFT3O1WUtyKh1s5nxMi7OhkvL5YbTTICT",
-	"0bSd1TT7fBvG54c4ioCOAelWLMZqM/5Rd+FwyKU5rWlpdczWtE/0nbrpqiKtttl7tJ0wAhu9YYkTRl59",
-	"XswF95DRTSBo9UnTrm+NRiaLCf6LipE5sgtJUkl+zSeG7nOgmXg2BZhOzSkik/L0+VrvZdkxQ4Yw1Q6H",
-	"zilDiTvGdB6xUcZSixNg+URS7JG1jc+A4TLRfi5jFlJ9UweMam1brAagQ3jo7E3qqUNfuZqza2/qN6PR",
-	"NIsWMmNMrz7RfmNflqY3UAR4oj1IioguCuEsNfoT1YPTBtb7P+0XobmKNeIcfMohYtT3NwCJOS9mTobl",
-	"LzbUS3abBZ7QcoQfYRLXo4BZisAUQYxJ1NJ6XlnxXb3PwQQoIiNDQj6m//f//H9aDtLdQIBuQxOChAMi",
-	"wrzN+lA7LFdyinP8yEUsnPs2NHGAawG8iPcjlmWl2nNXtWXugovWVrrI3bPckpcdCveZzIeOjSBl5Q+i",
-	"ZJipQjncSaBWZhFpoi+O8+BWRuPTdp4z41SYODS9rEkOMWA4/bRKIos4D9t7FD2RBfxdTmh3gCMZbrnW",
-	"N64EUEbV/9KVXXOV5ejObQH9Bl7I2M3SwosdQcJB2wC0yy9XIaBzW6sgNKtmJdQ4muvVipGv9oJcZWCP",
-	"JZp7JBH2lehuLA4Ub05xFE31urUC/tG7i010aZS5nmI6qg8lJee9v2E8Nq1xENoPPgiIuX1FhBorCu0/",
-	"zzbUPsTBBzJRQCapCQmzUYPRgxHjDmB2XBrcYLPatXqLI6GjRZE4iXSWVwiQYAhngE00YFl7mj94oKPI",
-	"6DYRUEk4RFO9l4RSJuLV1pbANPDY3aaZlU3CtnCSbOGE9APmi/8h8XjrkIyJxFH/AHPYSrAMRT55W3rm",
-	"NhrJLhvBciRXGv/qaI6NOY41yaWt6+UtyHNT8ZpHvSU9FnWIE9UGMo2sAm7RGXDxYLDFQ2E2t0Jbt+Bt",
-	"aQdtvhnKOGrVI2uLgOI2yWqvSlcoM6Mgqe/PD9+02bo1WtYUN5jtFlIdr4SLu40VNMZB1fAlBEPJboAu",
-	"1ObnpWY+R3+rPca8yVfNgZ9yIqca4wJ0xIkrPYBXv39WgCmRtNn6ULU25tkWlfKo96qXsSglJaieNp1K",
-	"m1lIw03Gxw0mOwlnQeo3NocTMu/rACbbte9U4WYAk3kff8X1b79i/SlELNExOec2sdPQxM6MJj7nE1az",
-	"8tZJwbOD04b5galw9YZayrLEl813HaqsJUZHxG541r/TujL71sVkA4kQK3JUuzSRIDYQSN/tw22ioaf9",
-	"82Oh1aRaODSaZitwqm3Zm6KrbPRFozl51ts7T72I+LkMIXLpwZsafYjTjNFB3H++//8DAAD//xt4ITRa",
-	"+wEA",
+	"H4sIAAAAAAAC/+y97XLbuNIgfCso7ak6M8+RP5NMMqnael7HdhLPxI4f28nsOZOsA5EtETEJaADQtpJJ",
+	"1V7E++e9vb2St/BFgiRIUbLk+OzOjzgiCAKNRqPRaPTH10HEsimjQKUYPP86mGKOM5DA9ROekl9hdhSr",
+	"34QOng+mWCaD4YDiDAbPy9fDAYc/csIhHjyXPIfhQEQJZFh99zcO48HzwX/bKjvaMm/FFht9hkgexZvX",
+	"O4Nv34aDKCWURK39Fa+7+ptiKYGrj//n73hjvL3x88evu4+//W0wHMjZVDUjJCd0UulQAfD86yAGEXEy",
+	"lYSp7/f1S3R0MBguDU3X6P3OS2AIpnMQYGr0xMEPv29v/Iw3xh+/7mx/+7N4ePZto/j9uMfvnd1vP7ag",
+	"kFHBUhJjhbV2yGu1VkYxHLCE+IJkcEjj5hyegcw5RRwixmOBbHU0gjHjgGQCaEKugaIYS0A/wG2U5oJc",
+	"w49uyv/Igc+8YVS786EeM55hOXg+UE1tSJJBGF1lC+cSc9kbZDyWwBsQE9ofYtPfEjDH+TQlEZbQOr1+",
+	"jVVNLST8kOJRCuFp5QSuAZkVIdANkQkCUx0dvj5DhEqYcE1uLZjx2vdBtMMfMZYCpgaSDJO0GHm9Gf0y",
+	"iFX3qolRQq9JBzqL1124zAh9A3Qik8HznVAfKcmIbIPavPSbi2GM81QOnu9sD1XbJMszv2WNUOC6aTYe",
+	"C2ht276twWra2w62N8WSAJWt+CjfPxSuZyG6wJN5QJsqK9uxbKvvBPCjdn7HaDorOAgba7ZhvzRLpeQj",
+	"uQCOSNyySKrdBdaJD1rasXXZl6tiDlPOrkkcxsGpfde6c3sfLwtP2YSDiMOUcfmewM0RlcCvcdqE7CIB",
+	"lOo1W0wKcMJigczX6JrAjUCYA8KTCYeJ2axmLZNDXEfBdTy4AbgaDAdA1cL7fRBj9aktzBiVyeBjiMI4",
+	"S6Ftaet33VQgAPMoaY79ZZ6mSMKtRKYGck2H+rGNzOkpwRz2WdwKbVlhTkNdvFiQle5qgoX2/HM1+Ywr",
+	"osU0RlhKTka5Fkk2J5voHwoWxDjaUD/atnrddJix/PCfzzf+/PDhHz/+8J/Pf8cbX/Y2/vXxz8sf/xFk",
+	"MSLPMszbxf7y/bJIKVoosDITErI3bEJoEzkdPE3t9JqB1ZiaaQ+lusEWbHlddpNHXvDaADJycSc+IkkM",
+	"U8ZS1QyJB980Msw79enLlGH5kqQSuHqEW5xN1eocfMi3tx/Bf3+y+WQwrGx89sWf5n/zX2Qfox9//8fG",
+	"x//84YcPH+J//PDhw+aHD/F//PifP/5pf//jx9BuNxwc0S4Yni4Ogeor2NO7qZJEz/PplIMQEJ8wScZK",
+	"sCSMmvMpZ1PgkoB+Eu0Vu8kv+FnJyd1M/t7ag+KcRGo0dMNcDNIwgnKQFwR4c0TSlnbTDPAQrPrbBmC6",
+	"nwAUOIpAiDNQyI+K8QcWX1kB3SQkShCeTtMZukmAouJAKpBpT69BU7qJzuCPHIR0310TlmJpTl3cbxZz",
+	"+EBjoARilNNUNXNh1wVSE6CZ4zVwTmLQPWRmvWM04oCv0CTFQm2hWDC6ORjWUIrTlN1AfALyhvErEd6V",
+	"948OzhDHdAICjTnLLMje+CJMQ2PcozNETdOICGQ7Q2SMIJvKmQKHSMhEdens/Ly7ub25u7m9tfs4tA5s",
+	"AeYcz9Rzhm/3cpkAlZaw9iZwDhGjsQhNmaYJH3jJUIKvAeGyEYg1Egn12CbNsxFwxVyFbXzYKboX9Hc8",
+	"xn3h8EEw05jlqSTTFNAYR5Jxb834hzBH1XtmDny6DJJ3HJ8DvyYR7EURy6m01F2ljSglQOUliecdqIaD",
+	"2w0h2TQlk8QcfOLB88HVZDzDeJqNP6v2rA5HNSgg4iCXa/TZ55gJui3T0R/TJ7pRs9Us09ajR48eTcSX",
+	"2R+Pbp/+bHYXn2PohoceFuoD8LjJXh2hDay3gBA9vWLPtrezNH5keJZRG7bNR6EA7C1VFUqVF7PABl5R",
+	"ufRVegwHcDslRn2w2HdkUdhTLNTxakHwHEk0XnC4ZletqLBvF+tLRGxqpqfgZF3jM9N7rj6yQ6xzs1Kg",
+	"Wkg0qtKuo1angy0EMUvUFurGTPrUUqUNn9hPj9CvMAsyFj28ffUdYTRIxU3aqTLGA3NE0+zw520U45nd",
+	"dCi7GWp2nOFbxXTVjsIooBlgPhguSBqd+qEVzGpG6JH5cmdNU9wypc15Qm462iesgPz51+JEXG5Mzzng",
+	"eFBoV5rPN5xI8AtiSMEvuZR40qhmCjNM8UTDD1ISOilaH5E0JXTiHjNiFZZFBYUf8zswZj2iEAGYATtx",
+	"boHZbVmuWAgWESxh3+HrgimFUJD2y4kPaBaac+uPy3WDin6C05nHRB5eQ+eOfjRd3U4Qg8Qkra6UuQLb",
+	"wttABjJhcbBtfeAMc3Ml8QZfidw23wGtL5BqQfpSC9KD4ZypI7Gr4vfTzkvVhCE9Y93zuRjFVsggRLUV",
+	"eXkfRwkc0Wvs7qCCxCPZFdDwAUGAEIRRpKsoxg3XJJJzcWVarGDDBwtpuJAPWBhHnWM5A5Gn4eVAXDWI",
+	"w+MqRX49IIjNCMWgKe3XycBrepEBIgNu2zgZJ190vQOIiGibKX3OCl3XDAdjTFKIz/IUWs56XL1CY8bt",
+	"SVQfUiFGZglqBZxacvYIGJOY/l2iDEutkOxJnN44TllKopmC5yUmac7bpCLdgwf4XXpq62IJJjPVjb7G",
+	"ImlZGAneffITSrAolNpwjdNcnzDNxyGu6ikAwu3as3ZFUWDbN/u2mx+jN/CmEinFOY+1HsF+0Q6HAH4N",
+	"3Bwqw/TUxUprS8JQZYHnodMVVjqpzXSVYGsLqZha5FbD3GVTJYLGwqkgOoT3iGUZUImmHCKICZ0Y1OZp",
+	"cHNMCYVwO+qNQ7/6GhEanIo29qJbHlYabkWOGTM6qwA5Fz/ecgyJ8Nxw/BYm4lWojNIQ5Q1wUFxDYEnE",
+	"mOgr5v7Cg0b2HRZ+DZN27vwh9cEksviZi1Gr5psrjjWRSKYIxzH3FqqpP7RoJAJFCURXECM8wYQKqSs5",
+	"fVs7b9hE/hlLhrvCaQp8M0TVJZ90R4VXhxeD4eD07bn+753+u3ex/3owHBwcvjm8OAzcpZVMtZSztq53",
+	"rN2P2Ppp+6efxiM8in4aP372ePfp093tbRz99HjLHTL68Kvium+MUwHDGpJ/S0AmUNnoFFKnwJXsq+8W",
+	"lRYVR1dAYySMfmcwnMcFmzNpb5Edbm1t11HBRQwIc6WmKv9sJdazenMlgY4m2am+Wg0qtc/N/ZOCFiMx",
+	"hUgp7NGLV8dIksxdyqIfopxzoDKdPUc78RA9jYdo53E8RI+24x+bauZr4HgCB5ikszNzS9XseM9UQrGq",
+	"hdTJTp0HB8OSQh5tPimGY2QzrVebsA1bOFZXQj891quw2eUBpDKgij0g4zFwoBGgEcgbAMOLAw0YNk2E",
+	"Q4KShq6Ba9nXcnA2nTJBJKDC0KOAfndx6F+lecQEHGcsbceXraQmjAfh9IF4cicglsKggy+API0xvQgN",
+	"uhw2l0VbxGA8JhEBKt+O32NOsNvLV9hcgYSl2lQKrd+ITA5wvZHuJgiV9c8DgPRqI8FiL7wgm6ytrFuj",
+	"xWBVdbw9ont09ppMklPgEVDZr/I8IIrKb9hN74bfsJt+7R7eSg4Z9Ifa+6BfD/2b7t9mb1T0xsMF5hOQ",
+	"/Ro1dfu1+x74AiThavdvuzcmbOW5LTOJ085KGb6trLxnvRlAhm+bC3eBz0mVnz1a5Mtmx/0/FxLTGPP4",
+	"AK7vyFYbLd2No8oWplMzfDMv1IbJxkaUEVOgUm1L6p46IZMETexWpS+7/U1oe/PR3QBabOcMtXA34WN7",
+	"c3c5+FvFtX11zwm8JnbMR+XOdq9NKgTEHZC4EgnuyWKQV7nSYuSYsptVU2MJzpJ4LBv4DrRY5dp9SbET",
+	"jYtSYgnC8gi8dzoMizb9iRHM96vnj03AlsFqs5X7ps2wKNiHQPuhdiEqbQJzR6TeO70uTagrJ9A7UuZ3",
+	"JMklaHGFNHhH4vsuVLfsVr3qbfpue/T326AX351XtzPfbVv+Hnty45jdn+Ck/nSlNFeBZhkUVhq4b8pr",
+	"qCH6EN88LC5EfxUIlsffvVNhQCnTnw6vga/hzFwDaRlk1pq4b3IM6K76EGQPdC5EkzUw7oLI70KXy+7H",
+	"Go+r3pSrAC2Ly++3OTeVnr1JcnW7dBWIOyDxPumxpgaeh7ZlkON1sSBSvC/vhoxeoNZvoQkdeCploxD3",
+	"lNttN0etlw2tNwstlygtNyYdlw4dNwzdV0Hd9z7tF1/tt1zhS5zwjU37VUf7vUbrlVnr/Vjz2qP1nrB2",
+	"hxm6k/SsEkaTbFoYG3QZIzgLVxzHRFE+Tk8rNgRdBj8Vi4ZvdUOPPZThqTZLxVHinOYg1jYN1tu2vClv",
+	"wC1CgHsLZENckekGmxqYN6ZMe54bT1czvnOJZdiBcA8J39oiF8CFYiZApYbOsV5lMwiiYVYRMTomk54O",
+	"xfu6skMQliB6fnig6trvSNzzo8J22yKx9wwWnqbeRAiHwAD9REsYs+z/ZczylzHLX8Ys/0cYs1gWeaz9",
+	"iDKg8ojGJMKS8RC7jYGTa4gNQ7WGlJDlqWJxaG9n35+rpwsgoh2KxaisvZ1lKW6RUazPMOhV5yy1Gmzs",
+	"vzp+J+CY0NxuV3Nq9jECMTV72xspsa0PAOs3e+oNxbpNpPoBcj82Vf1gWZP1Vb/O12Wm1a/3dZt09YPi",
+	"HgzA+gNy/9ZiCcv5XTbKyvfL7pR/maw9KJO1xt7Wql3KTB2r5bwBzAmdIIyiSVaRLbf76+MqnS+ujKt8",
+	"fl+a4cYW31Mt3IqwhVXBFQiWxdp3UQI3hJ67EtvOorS2tOK38vm92u/VtreeKOtlBLnIWq3DcgcryHte",
+	"sX9Z5f5llfsQrXKXXtSd12ELr+kSkuXtSb/Hiv7Lrvkvu+aHZNe81HLuZ3y72JpuwnRH69t7X91/WYv/",
+	"ZS3+72YtvtTyX+Wyv+N6X9lC393+y87+Lzv7h25nv9RyXaHgfTep+3uI3H85J/zlnHDfzglLrdJ5ZvWL",
+	"LdQKIMvb1d//1vqXe8df7h0Px71jqZXcwx9hscVcg+YuDgn3vv/+5Sjzl6PMA3aUWX6Br1CqrsJyB/eO",
+	"77K6/3I3+svd6C93owfsbtThTNRhWtrf2cjx0Dt6IQWaWd45KdDYKvyWAs12ezW1eigFWlrSb6llqEt5",
+	"NAXaCrk69XPYCjcWRFTVPKnFhLnFCnkh76umVVurfVqrE0G3O0DdYDFogNjDDWw4103QcxSL5jiKRatw",
+	"FIuWchTb73IUi1bkKBYt6yi2/3+Vo1jU6igWzXEUI3IWTmVP5AxRnMGmv0sNTnHK0F4q2WBYTxdkO1Qf",
+	"hsJQ2zDb7WnzG/Njo2/PRZnkAHLPVLZoizA91rlxQH3NAcdvaTpziTabpsgKDXNxbFFVjOWcfAE/4vf2",
+	"xu7jnwfDwe6T7Y3HP6tfT7a3N37Wv3a2t7f/EYz4bdq6mE0rbblswZdTjiNpQmwngFOZRJjDpUlFOhgO",
+	"rkECJxTz2WXksKidXwY6YYJOimtyPM5J7qQTtPH5SDDVqlnUOrPjtCB/kfxnLmeXl//sAFKQEJ8WGbPn",
+	"tVHm1ratuKRXXR9p+rcLsfi+f6qNRp/1sP3ThFE40eLe/Ma8ug4mJiROXT7jzo+Lmu5TDmPgHOIXk3eU",
+	"SOHTXjbZit+o9Z2xdOtNkGgriZQ7F6eraPsVRC6Qq0TVDqGu//6heN98ICUu+llDgthhkaO15KOqZHtn",
+	"e7vBRucuF/XlQTUlR9nsSw6wTJMZfGEU+q3DC1vbji2fxnfnATcwUnNdaSDnpF9iKZfrzcvd7fH/Kpeq",
+	"wtvEpikZBBaIJ5QV+1VzO9Vv9hkVrDNtVOTXOFo88xeftKUqMu+KrAos55FLcSGKY6hOG06odfWUCcx0",
+	"Bnm4hSiX1dQj8+nhWPX4Cxu18Di1uPIKf5kCjU0CUp5Tan6p1jVHL7LbDHRe+ZTQyaXKNmEfITZPIaZk",
+	"Ljj2eyYErWwqNbKqT0+j7WJYxWQ0yANVqKCdWI7iDpkIkRio4icm7Ui50HfHsPv42bPdnacAjx/BzmgX",
+	"nj2KdsfmGOEW/+7jCi/YfVzJu/073hhvb/z88evu429/C63S6niODtrFOk0DB/k0VYwPWoR1ux0GabPw",
+	"3LWVCgq2t1a2FpEoJVeQzlDsOmvK9d6rvhtNaBRWtmgh64XT9XEQLM0dy14UmLPia7dPaexZGHsKIiFS",
+	"1xy02tjQx2CTrjVgqICsnbJb0Rlih+OURHIfS5gwTmoz5xhH0RayCYXV3L8x9BB8d3x2ghRFo2Obi+0E",
+	"Z+CXfOyRSXt6ZxxPC8QGBjoXwaicl16IrpJKA9eaDq/vumGbZfluqZytZTbJKof4dAUwfcFk8gll7BqE",
+	"zyUc77C5mSpcYYg+aSb8yW1+ge8ItV8aLXGNu7haigl9iomIMI/3cyFZTHD6CXEo4al+H7lKqJxjR61u",
+	"NG6PUOuq1nSA/mqko9/OJ5FyzvtSyUKpM9uY/Ld5gIlBcz01pYYGjWIpIZtK32/YyyBfyAqLZWW9m8y1",
+	"VCJYdVI95LwSz6F8m7FY7ewLtjlNsFhuZztVX7adRFO8OE6MQPYCR1eLDcGszaUENV+ebBF+ERGIg+QE",
+	"YoTHEjjCaGwS06GcSpIa/qCbUXU/GXnz06YhXmGlYiUQf8I3mEglgnK4JnDzqSIm29YL8VlIzCXERTta",
+	"KVmhOl3tk23zkwfNVLXPclGOoCDyoeFKEaYRKDDRTQJUCUIG7E2P49SgHQwHtmAw7C90u46+p/xtqbFB",
+	"KV1yeMEziiVSP/lV1lsbT/2Fjeaw0GIZBcQYh88g3+JwDbxHWl01eoFcbZVwT288LE0V6t3W4/aUZj/6",
+	"UjIMgqypGSWelLnHB0Y9UyTJN+m0ze+eO5XrvEpbxcDbkK5ROgftZ6DuHcJ4x1RXCYeZKAazFNM03Rb5",
+	"xVvY51K7A6idYcEs4RlgkXNYfATH9sOqOvMOKLFS6WnaihTLco7oNZFwl54q7bR05jLlL9/NuWmhrf27",
+	"jeG8RZNZ7IZLkmTlNLif5kICvxOd6xZaIJX4LvhtV4Mbpn43DLiNYZ0YqGuJKruLncZiNMOSLRVL3Vu/",
+	"HsVWWFRj2Vi0Owr0lm5j2htYaOO3Zqi9GO6+f21XZbsLX9y4K5e+Ot1u8NE8NWwb/24eN2IbjKe5ecTs",
+	"hk44jiEOv4cMkzTIuVsGOxyoRMRiiiNYiPXXkGR13qb7SptDO5wK7L0wafb7PsgslkgDlXffV2zrXdq3",
+	"sHpFzB+lbnmRMXYprYwi8mBxZWObPsTb5TlV2heto1ppw4ps1tNyTy1ZmxjRrTZroLo5kgbS+hFDT+Wa",
+	"JQqrvWsRQrVKb3b/Css1oL4YTNn6PHxa3PRCpC+MhhLAe/J610GJg8hTtYeiDFSZ0Edlzf5E8GRUcsT9",
+	"3p24pstv0ZizDDkuG+jFzWwxsc+/dlVzNgVdUBSVkZIGkEywRDckTdHIngTjICi1hdMNUJPrNOsEGEiz",
+	"kr8J9Ju/QgFbLoXQ+Vn1Hp8TCQvgS4tNVYTZhoKdiD6tl4A7oazZkMSTBdqxMl69mfqy9OXEIDEX0mKV",
+	"ukrpsYLEijDZJNt2+mkQS5My5vCL41IQns8vurbhEeHaujIsbEUpJlmb8DbO0/SkTUwjS9xrKH3vu2nK",
+	"8IKagIzTIAzNQ+dcBUHjjLaYXKmF7wIvQw+7BsoSo7VzyZzpXmSbrWkWmuJ65G5Rm2tL6c6oUs3qOmjM",
+	"uH+rPESwOdlEx2/fHw7R8eHZq0PEODo7vNg7Omm5MtCb2l3EWU9mCEzXMjqgVez3+j46T+/aSHHumH+s",
+	"s7Pmf+NrHosTconzfiSFFJ30o6uGHimgwc0r8W89fr7AUP0RmhbnDcVAhrwz/9zReOqqxjAyLSsGeV7r",
+	"sdToEt4rU+nge6NaaHsfPp76bVZbGFoY5yDGDrIfQoiEOfzCyeWKAQyGg2Lhnx2e7B0fBoXr+cf43tjw",
+	"ycLCNG/4RPZTi1vdWpMQ2tXi96KfqOglDDBzhnyB5862vQg5EiKHDsVUc5cFIfAEOgZTUshnfQ1UmFT7",
+	"toWeNYevNsETuxf2virRe60DqhUtdrRID7cnarrvSlytlusSf8+r2aQlmKqLzQzHUF5+2jtLc2uqLjK1",
+	"nYZ3Y7WEOWFjjld1ZX2DOXXXBAsPDnNA7Br4DSdSAq3fzK1lnG03o/48+pPmDXEuSc1TA/uWvt7a2Btz",
+	"EuGtvRGJP2vadwVRxLH3GMdEXO6N8MgvTCfEqKpdgchw5SuRgf/8Amf4ivnPdJKTyvPnPPWeiRA4955T",
+	"TOWMg1fC8Zcv+JqkqV+Yf86zUe73vI8JZ/6jwKMU08ivArn0HxnFV3xWFhzgK8z9R34J4vIcpxhnXvFn",
+	"MmK59AZ1wHKceg0fppd7mOQerpWht2Q3XskrPGKcUW9MrzHH/sB/YQmmFMQo5xOvNPfn51ecTStd/5pg",
+	"LlnugfsrmeCU+M9UJFh437zBE+ZN8Rsy4lDD9xuW+U+5ctTzn0d5NsIiIX6ZwFdenWOc4hHzn6e5rDwL",
+	"4B4hHCtC9NFzzCY4JiLx6zCq/G68Xk4UEYw8ME7izzgD6lchOANv0k9Yjq+ihElZlr3N8QTHLJ8wr7dT",
+	"xiXbOGHXHtTnmF1eVHBzQbJRfiW97y44mTJ/Bi5ySjx8/0ZonDBQfGEvA7sWceWRRgnjeAKVsklO0hRX",
+	"iiSZ5JUSjic5JrRaNgEqCVWLCCgTl3vEnOubFfaxxBnmUfjzfZax+Ixc4xhfk7YqPGaj8Ltf8s/5LPjm",
+	"Db48I+xz+LNjoDH7En53RtjlK5ymYOm5UeEcGwfO0Bt6+UuOaevLNzkJt3mRR3nW8uE7keS4hpu8ig+R",
+	"08gctIoiSa7YVbVFeeV/9AInpPF8+QLTGDgWlRd8hOMKMl5ACln1WfnFeQWKaW6c41FageoFw5fviaig",
+	"7wWbsFoBEZW2whS2j7MRJ/EELl/gWbV8yi5fcTWQSjGNclop4DjC1RablLqPZ0BptaFZdab2ExLhCauW",
+	"JDlOKqton+QxjhV5cPjilzOO08vXmI9YzqvlNarfZ0Iqoq7Cx0HICo73c4Kr3+VqoD58B5hmmF+JBF/T",
+	"SvGNYM2Cy30OFcZyAPQaeKVAckakX8IyQquQHsYZo1VQDwnPKUx97B6maqu8xjHzOzikAiiO/eZeMi4v",
+	"TyCtQqxLf8MzCrVCnEJlvb9KcVSnnFcslgkeVUqYaNRSlHV5kfOrSmEdvlc5jiFleWV0r3IsIcNpreIM",
+	"/5GTtFI2wxV++xqnZIxvKyXXtSrAMyZImvozrSJAYFr8r7YQEXj9K2W3geJjzIFOQu2dggReCBW1lxeQ",
+	"ppfWJbj+7j1c42A5oRFQCiHofiMUZzhqvmkOJ78m/rQc/YHTvEKYv+AMV+myvoX8klPAuVfwK1CZR1ez",
+	"rTcsJ6KQaepvjxmVJIIq/hViL49O/BKOU6Ax+ezD+QZfnmKfK7whmQ/jG8X/6ATSCn6C8LxhN8AvT7nC",
+	"p1/5GEdAWKWA4upGr0ry6jecTJislkhCyR85VAolzhhn1U+/YJlW+GRz0z0GqvgEVBoDTuJqJZniK9VY",
+	"pfCWRKxOZMcKsOqOc8xoJOslEjiHWb1M+V6zWiEHnNaKBHCOfZycYHf6cAVwc/lPVuEPJ2RKJhUwTqzA",
+	"VzxyRhNcLZHJ5QG+YlJtsHmKk7a3+0Al8La3CpxzXN2wT/LcB+/tZ0LxxO/9FKs1Vy2YUMJlTieVUq62",
+	"TDLyEXeaMKDEZyhK6t3A+YYhy9qLSza+PJ9iQmvl7HIv4tAofA9pUuktB1V8RqJqKZX4ck+xZZ8szzCh",
+	"s8szUt2/zjC9IvTyiKbgT+wZRGQMlYJJVQy2LhiVOoRdvuCYVqA5YwLzyuo7xwq+I4FHkNaLOWS1IlKV",
+	"L1QRu9R7bK2cXZ7ivMKBziPGQYxmIqexX5yQKWeRTwTnpCognsvLF5jLBFLIZtXyX1hCRbXoVyJlrehN",
+	"HpFagxcJy3CtmmH9PuLPb8hYXu6bVMRe+QVM8kidRKd+sxdJXuGAF0muZNjatn1BPufVDfNCLTnJqiWS",
+	"VfjMezWReZVa3hM+qRDrbwmRkDBekVp/I5SSKfiL5Z/4KpcV1vFPtV3cXFFLZmruI2kVDzCrFh3gayJq",
+	"RbkSqQ7e8WITKN8d4+iPHHPSKHYynlcWHec8ZtXCU5xmwKtlZzrKBK4WnrNcJpenrA7A+Yzd1KpeKK1Z",
+	"teg9E5JpKtQFW28YncwA89EMNJSCqIOs9zvNsJwVT5kVxfUDxfGMF09/SJx7D2wExZNIJniEpfd8leAR",
+	"josCOePlxy/wJInLly9wwi2zMo9XXk06uWJX5SOnOE+LRyA8Lzp9QURyBWVdJQkT97SP0yiXEhfPCfEf",
+	"GBnhVJQj308Ynfxh7kxsQU4nV34BS1k2Yu7xAEcRLh8yLKJcFM+J1bnoB5IWUB3kI+w9iATTEqkvcYYn",
+	"uSjBfIW/FL/V8aZE2WsYcVY+scv9hFweE5qURXRy+SsrwX/Nrgv8H/GrXIoCcUdCYjoqsfyLUr+VUPyC",
+	"Z3ia8/IZeC7cZqgKfsXex7/iLEqwLIf/qzokJqR8VKTDy0eZZJjGuVdQfU4wjWeTsjmWXuESuF85FpTN",
+	"MC+H86tSAl6+ybNpXnaTR4k3l7/mysPHPR27s517yMuHCY5LIjnGV0pQ4eUzJWkBynEuonJFnJCICVK8",
+	"VOqqq/wLBQ/vqkyQEfFgf5t5vzkusHqaUJZdnkI5wadM7WkUF9VPZ2rd43KQ/4VlCep/qZMvxcWy/6/Z",
+	"l1nKeFwAeIbphJUkdUZmOC46O8dO9DJPVwlOifesjsKYFvR1DqwkiHN1QZCUVH9O6ARPGS/I/pxDTOGK",
+	"pTNv8BeYTMvFfIHVSqcFci9GJCWifA0JL2fpAtLLvWtyXTwnShXoP02T8pFdzVj54EHw7nNOJ5enSsNa",
+	"4vRdijEdYR+z71JML1/YEIGmhOfZHwVw74TcOIFy+bwnoGeuGP/7FMfkumDigthtTniP1EP/P+EKS+CE",
+	"urOjKeRwbXCgzgxqH9j7wqy6x5W8AJ7lMfaL9jHFfFYtmcLle+Ax+KUvMXBWK6kV/ILp5TG2m44rPMYx",
+	"EF7p8gxmV5+xPWW6QrMFvgLGJ6RS+1xevoYUaK0Q09Ts7rmQHKdqx9m/qD7HkGISQ6XwBSfCqbO9QnYF",
+	"9PI1SdNK+b5izpzjamHOrURQFB1gfkNopegwj9Lqd6/ZCHNZKXrz+qj6TGgMdjcuCxmPL1+zm2qXx5Aq",
+	"ZVdtICfnv1Wf1RmmUnIK9ZL/ygGoSO3qLYr1fFRLZjGtofwCiwxTUh3oexJJxmuFv4Gojv2fSiq8IVTP",
+	"q7paIumWPavYpwMoD3S26BALWT7ZNvcP1bzvn1/8tH+gf2GlRtpytFKWqCOeYam2QDUHnJYFx0wdeYhX",
+	"cgI3Y5bT2OLHlp7iiIz9ps+xuNL2ezfY+/if+ZVetfsJSUHdcklCgRo/P1NmIDhy6N83OulDPaLDc/v3",
+	"yaEe1+FkNlXjPSQaS4cy2np1fFH++se293vH/115UXmz6z34vx95vx97v594v3/yfj/1fj/zfv9c/t7w",
+	"oNjY8X9XXlTe7PoPj/wHD6gNv5Zfya/jAb7hAb7hAb7hAb7hAV6AxwHoDYkS+/xu3yH/3cW++0XVsVjg",
+	"1D7/K0/VTnOYczaFrb1MzXaMM6+IxsxwGFegFshVgqlXJBOgonx+AenYLISyQBu0+iXc7M/umWNJRIqv",
+	"sV+WCwGp33AeJZhDpek8xtNaiSB0Al7j+wkRhGJvoPtsCjTBlVoH+agC0isy4uoWiHtFOXBqDm225DWk",
+	"gtArUpYciRSUtuPYx5AnwNqSX4BXGvpVyStE2/16hQSu/SfO/McZ8Z7eEDFiXo9vPuej9LM5DLsiRuNK",
+	"lfwWshEze7QtO8YxJ7H/bO7BikdOIMGZ18oxoeLKe2QUR8x/FhG7KZ9LqdMWvBWpV/0Uc+JN+CmLJ4wb",
+	"Xa4rUjeVHiWdkYn39sxo3OyTlvuw/6wEAE4o88s4/gzXtRLpY/qcZGPgbMq8+Tu/YtPPflds7I/qXLLo",
+	"KmGpt5IusHK69zB3QbjZ6L1nUenkXTrDlF37+H33JZkwzrwpeo/j/Iv/qM7cXjdKnPPJ4D1JKck9JL9n",
+	"6YRVCe83zAX2Zu1feMJh5D9PGWdfkpkH/r9ybnjPqxf6z4bdB8we4Pi/Y7SWb/k867XeT9S58MocC48i",
+	"sPuOuQtQ99aYKnmQXLOydD+xZgnFMydCZtgvYlGlBlOq7PL5V+CTHFKgZdExTsB/SmNyDcIvyTmRJK8U",
+	"zZiU3ldnkFNzY3tkpP8jwbFWBZY3FL/gqX716w3+jFPQDOgNGc3Uu2O9zR6f279Pj/U2a9TiWy/wZ6zE",
+	"J6gWnee8LHgFFIxAcfIv/Wdj//WeauMEX+PPCgGnZ2pnOD2/eHaqG7eCw9belGD/MY+u7FS4ohcsn2BC",
+	"nVbKFe8nWCY4q5QYPbR7NiKFXzA2ZujFM42Bj3I+88pe4ivMxswvIZ+J/5hTPM6lX/QKp3hqSaMsy0ak",
+	"0ru6w8NphClOq6X+GF4zylKzVboirR41tw6u6FdMawVE0UiGK2D9yhQV+AXe1LuyY/w556xSwP/IQWB/",
+	"MMckvsE+lk5wzn0YT0jud3TC+JilV5WSPAN/ok/xRKmXJ6xSlmK/1VMiI0y4D+4pS6g5DZclFE+hUsDl",
+	"5bHRU3vFZ5gzyejEB+IcE7MoyoKM+RUucEIqOL3AHN9UaqgmJZ76cF/wCh3+hq+g8piai0ZX8E88VU/M",
+	"0T3jMp9oIjl7u6///joYDnxlgbov1nu6kbzenW/tpUrudr8hl8amVD1x8oVR+6oU/N+d6/WxYS8/yxJz",
+	"DHh3vvVaBYMh5rettXEuMdeDeXe+dUyihExcN96B4d25dyx4d14g1QiHvmD428b5O/WfZj9aQmxaHDoT",
+	"woZ3hQrhKiSbpmSSSGe0O3iayPyG4+ucPRN08K2wRCw9t5suH59I/AlleKY8uyCbyhkiNjiX+xQRbVeP",
+	"EiwQZRKNAKhyNYOpNAF0aiayq4hqXDiGF5+bkhAeyiwPd3M/MsM8agkwQ4qoZRYdZIyIVEihf6/hZNBh",
+	"CN9sWL1BrIbzwdyI05ylfeOYEG3sWsT+6PDRc95+ldkXiIPqN5IQI8k20UXlvSILNXIhkGQIp2kZhFLH",
+	"WlIkI0Bu9rXv9aIWt5DJQlGMVTWWER3daDZ4PsapKMq+AGdFrOMVROKtWRs7kjVT1RVJt7HyO4MJEExN",
+	"rTNITaDkhEw7nIH7UUklOLvqvy9tBT2gwyGQ6mNsDmPBUS8Rh68Ve996Adgek88us5bwrdpvVC0Etdan",
+	"wDMihGrSGsdHmCoOjIUgE6rXGaqGNal6beYklUcBJ74X6sUGoUjTnDa5x9eYpHiUAiJUL08Xzlh5e0fY",
+	"cC8Uafv9uJ3GA74vXl6DvYujw5OLy+O9k71Xh2eDaqDcvY1/fVR/tjd+vvz4dXv40yMdMLfpiV9iJRjA",
+	"1AD+XPkPVMJsiecK5mqJq+R4katSPDcqxJCCX3KpHDTr1UxhpvMCeUFoXOsjokO+ucdMB9FW4zElc+Ol",
+	"Dge5Ngk6MkPXnCnsGuTjKrSylJsCdC4mVeENEWE/l2J3WWxhOfqf54dhmm+FW8wFXLTKM6PqCvi0/+bo",
+	"5Gj/cu/g+Ojk07B4Pj48fnF49kkvgk+nZ4fn+2dHqmCIrM+rwrMOQqiDlGa2uRjGhJZR5QoPpwJNrXT/",
+	"H0GSzwi1c70TQsapN8s9SMVD0nmrk2bH9k/iMvR5D0lA4+oTzdP0k+JXE3INtS/CckFfSaDmfKQ6Unys",
+	"RRoIrpwmSs+7XUQV81hqT2kLbaffL9FkW3tFQEzYrzRdm2TtOnoUt0y02wJKxywTBaMM9FsLETwn9H0R",
+	"Q2OBARbzWl0DwfBuLUErflPypSaGRvBzk22pGiOdV/dXjCjcIJtQrjlou2OXvmyV1gbNjbHG48op8Plc",
+	"OX02bFSYFss8MeGcfTyUXejd+V57XiHzVdBNX4ulXUEisJQgpN7JzvNRRqRsiwlRCSfhxdvf3tnd2H62",
+	"8UhpIyuSdQigmOAJZYIIl9ani5gqlS1F+ZEpmsSv3lZOXaVL6pwz1ySdRZCR6Ez7Os6DrFrbgkaEzazR",
+	"gsAUJjiaFexqmfDgNhxGc+AZxCTCqQvCXQZSWQgJNSmtR1B3bxf7Hnlsep8AXfCPfkmJjprZwvb1SuqM",
+	"1qFrxHtT8ivMwmtNv5oHBi4aUN0ru4XwlOtXKMuFFvKFDhIkEEYjwBy4fW2ZO85lwjj5otc5SgDHfrS3",
+	"trgUBlwHxMc6MmK0d3qEbJU6NmIs8blmk0FUlK9DSplzDRDiMOWgBmbA1lGjOVheTeJ1ZPRQyQiRAQy9",
+	"1YNBR0GlD9xOiTkAOK1CJyfDEiQpUmalWIKQqq8lPq4HR1/gU5c77aRHhi+/rhdgHML5auwvlXWSUpPI",
+	"0P6C2Ib39x51SBNFUOU24r+uTIbbms17RQcGjHnU62pVxuzRsF3JKC476abjhQS9KvkHhD1/Zhr0//vZ",
+	"y3306NGjnz/+kEg5Fc+3tm5ubjYJyPEm45MtPo7UP1VjU97KH9EW+v3o/C169tP2Tu0TwfQXRLAN9XZD",
+	"q7wwjbXaa8Ps15uJzNIfdR5jIXE2RZc3RCaXyGW/QoSaiuYurrL3P93Y3t3Y/ulie/f5o6fPH//0r7oU",
+	"UIR2KidV3dRm0Fvh2xADPApUlV36xl37/6NoMBxMnFSjFfJTDjHBIzDng9Q4oWUsnnlJASmTe1Mdzmuk",
+	"j9dV+iw6csNwQKELG967DjYkKn+WJLRMeNZgg+OU3YgEIJBH3PsWHR3o6AqcxKADN710n4n5ezplQfm6",
+	"q/kTJqFHyyZO0oJtn9mP5rReW8wlnspu3dC8NX0AY5ynEp1BzG5RXAVDqQOmwDcyFkNaACWCKVib9y9f",
+	"foo+8y+72/Aku841fJDwYh5sNJ7wJJPIpgOsY0kCzwjV1w0mESsSCcvTuNjPiT5mM45pBEitSXSkQq3s",
+	"Iq4s1nSUPZvIV+gDOxmjG3CNCLCJo2zbWKKETBLgaMohIkpm25x/1DHAezguxuxCEAVPOZBwHe7u2ARx",
+	"OYNxqxhwrObDX9Nv1Uk0qFCLWZTrlN3hMDY6wEo9X+gJ3MyPO1PC4bdS6dBDweHrs9rgehIR3tndeSKf",
+	"XJPpI8kdEemmzkBdTsuWUEVFN3N2nTDWa3En+zRgwbF7pHg7LXM2fqvgwQRfRbZ+EA2Wh6q+v4XRQv/Y",
+	"ztLsSfxHPL7ZDqElAEfzPoQTCZwEMtSfAlc7ktKKow+Dt2cfBkiH2NL8SCsTiISsEJXt+aOhoj4+OzFx",
+	"aC8P3ioDnYO3Ly5fvnvzJhwgKzzSbEJ2rnY/z9jn6PHV4FunhiTcwg6L+eTRH8++fIlJqltg9J1Wjx27",
+	"0GbV0b+1BycX+E8yxV6mBicQm4s8jIyKzSJG3fvpa09tGevlUTk82Xvx5vDy7PD07dnFuULC0XmlpCce",
+	"xM/55/GTCB5fJ5GiqUb8eDuXAXlND1OxPksMPZfe40f0J0LiR18myW1WozExZbQtccnit2x915qrZz/z",
+	"0zTMWaL+bhO+nfOD6AcYlxtyT9xN4erq8/bPPMV0VqxPJSR0b3s0SvMYXh0fGaLUW3NxRq8S6dHY6vrs",
+	"R+jV8ZFbkHqXD+xUq1UReGxNjWze5nbKWQRxe6RmDmVQWFUwL8e0X3+PxodUCaEmClUgxlwLGx09Yrv4",
+	"6dUVjLDZXWKiIjPBuUmd7jU3Bxyg4c9qfNXhACmhRHNT+wlSmemJkO4q8vTgpQ2pJZDIR2Uj86VAf6+p",
+	"dijQMZ72pODJ7GnMdmSSZo8mTxwFd1KvJzwexX0WZV3WL9AY0G+Y6VUSm50hTehqiDqsJq8lRvUD85aS",
+	"/3yQguKp0SIexU5O6pqAMDJvnz5m/Gb6+NEs/9kwoOKMgdP07Xjw/Pe5oNW5x7ePq9b4TR2xaFrpga3q",
+	"kva0Jb4QcKszzetTSMxu+253Xx7tXCfwczLCf3AjYqt+4zyF2FtdXfDV61ey27SIw310npBwFQa7a0dx",
+	"VOz11sCuT1Me4gLjLIIje5Rc3aHaWW+LTLVNbh5Pt7Px7mM6cmu7PqiABlWnkqNjMsm51S+qZAJYqQBA",
+	"32t6a1Eg7RNhD5ZaL+WOC/UwuXHotG34VcUSDKdaraJlT9W8ubgSkEIk7Y3VRIOBhWARwdKeAD2F/iY6",
+	"Grtr66G+htXfaD2preJFdp+mOIIYwTXwmVbtKHkYqPI2z1RNcwFYiwutLob1XAthDAJbop6GpyZ5vJ3l",
+	"P+Wfb6/ol7Fh5/Ml3HHEn4B49mj6hfxktjEBU+1RwJuIPRpruxtz5cfohjFzNEANHRrdCdjhQkxTIu2M",
+	"SkUHZQfDJfghiZ7Kp9uPyGS6q1d3bddSpLgoTd88Sraf8Okf0eMn5NrQtFKZtkRmjqtXco+3H4fC7ntB",
+	"XouqA3vAgrhQrAt0Y41BnfDffXTW3QejtZr8ngH5aaKt6iXMy17nc7dlEyYWbXiAvWr233NeHrE/dp4J",
+	"LPgXts10X82bwOYEaSuTxa4UdSLcrNiIQIBcrIFT/U1F+ewJ4a7J8mRXFFhoe+bfbWCgBLyBB2dbNkf4",
+	"lAkHkbA07n+LWAXhwjXgxJ3ykuhJZX0/7heq2QPoY4hMFpBaKhJK24RVJgrH+Nzq+LzZqpbiGJ9ymFBM",
+	"o9mF1VPXy3ZN2WsySc6I0GlK53E61dnGNeYKDUKHtz3Y83rdO9hr9Fov2zVlfq+rwldllsOHT0GuIXT2",
+	"XO4g6VlHzqHgfDoF/kJzz+UI913RQIilWbL0evkYYrJ9mm7gLadEVrRe2WQrfjMYDrKMpVtvglrZaxf5",
+	"vrj3GacMeyzVWCM0xmG+G9o+Q2OwGYYSMj2zxmll7va6EW4ugKPPTPuAIuwMffT1uFFqKfnISmDO1I+X",
+	"rTYNcbWJ9wHLMGm5gPe+LsRGLUNaLwsNkTahp0g3hnAccxDCCHLYwKXAjU0v3s1ZwoR07Kd572JweBRP",
+	"gwKRFmmwlJyMcqlTJguQQ6X/V1BpyY+NC1SVEmkJtDMqUGYDQKXOcBMjrNzVhDTtk1i9kDPkCfs9TOYt",
+	"Pr3N+LiYY+RN8qAvNYilyUGiFLCQiNHCWkhMIdJ366jsq5tIKi/7bletZB2yre3Gkwgjik+gIz2lSYJd",
+	"zc/XaX1X5N5WYmGR5LtM0l3mlCrs9DAHZPpRlEPjajs2IXhQxbGs1Fciqhh9X3H7p4Ru7+R5ktLtm1vd",
+	"mIbUqpwrif2biiL3dhl7LouuxT8NJwTUR3MfoI81tBSpYYoxhelH4u4kMF2pu8yBtuBtat7HJK2kl6yn",
+	"5N5vb1e/r7cezgdWjlTisoY3LGeyP88Q8m7+bVHPNO81E+jV+NbpI1fLboWFMaLSM2LT/mtdwVhnuEcl",
+	"fvp05Vb6S/3xPKLw/Ma0S4y6obbuKZk1muzoNZBBzze17NWx6wYJhsaY9+vN5tCfx88d4s51dTuhK3A6",
+	"Gw7Uem9zW1TvPN9FY15atdBX68pk+KMAsbCXfz7GF7HCsMD09HY7Jg1FdmAtligLjjEy4S2RmQg31Mxr",
+	"2gmqp4cnB0cnr5Rf8buTE/Nr/+3x6ZvDi8ODwXDwcu/ozeFBEEBkgAg7cdg67/Qgw3ByTAUxakJcwoas",
+	"ub0BfbNIZVVUENozTG+FiOdUSyqM69U41FumK6x9oSgwBQmu8iZ6WVvAbvuVnEBspE2ZmIIZAhrrc80m",
+	"Ogu3PwJkp1QNgZvsQzIBoixH2ERJsE3X4EU5zyY6s5RlbqBLTCn5wEzWZg+L5XVwoYxQkimq2l43B+ru",
+	"aXnuE7CFzEWQ9g1ddy7RhQwfK5vst0CHISfLjNPOy7hyLHVUWyJCKj2wpiPNmuxK0lTsJVEVQXnTWD6E",
+	"LunGjEemaVOHqrOb8tKRHBMqA63V8F78Lnrxp+DsZFG18LOnP0WP/ri6nT0iyc+6N4pbTEhPGq7nm4O6",
+	"tUk1IMFJy3HTl1O+g6W2g7LTGtvASL4XjG3W5J0wTx+OV05pXG3vA0Rf6/D9xperFGc94+vlLa87fQtX",
+	"4ZJ014AWD9ml6U6pfs8gIzQGfjci+DfweTKKjv40ahs605/1c4Htq6J+mO5XwtgF9cSLtSJygC3luuWS",
+	"qh7ANYkWTRW9+nghXdmj209TQc7ctIkMSW8WI+sLJtIz17K3y7UorYJhRE7bwF9orAtJrp0Y+9YDtJBk",
+	"6xpVB/EWO7OMtRx91TeVA0RhCeKd6QrVPZb60BelWAijw8YCqdb1UTJmWnf7gaqLihmS7EY5DRhOmWK6",
+	"McICYteT7TolGZGbyGaYSGdW8S/MC3M63FZy9s7wAx3lUt2wsBuhTUjGuVTqJbidqrMxowbgLE8lmaZg",
+	"4CrGRcZaPdHr8JViugJ0VbGkcaJ1lR5aPtB5iNEmRHCrDVZF2W1oBgS6gTTVfdEZ+kC1z1FRM7KHbz2J",
+	"dqYWnSf0QR1X0DXmhOUCjZR1izqumMO36IFZK9EZ+TOOiWH4pxVq7bM7FpJhXiRDv+Nsjcpbpk20J4zN",
+	"vsOBjW/mff6BVomtGJm5zBB5pjrWUSvsGwOO0G3BbQRgriuM7rmC7M0Q/9H15lBlJXhW+1iHiMME8zgF",
+	"YSKUVEhk3izWTTSYiUSteYxdOwGLej1TXXxVvX+jaT7EwYDG7vyxiCtq60LupvM+ShMuFwYokEy4FVXo",
+	"jQWkE2GdCo0E89i00nNTqkyBGiUbyzs08K1taF1m79M+12GV8+j8cBCVO8hFtukCCu3Q0OJkW5zMOs9c",
+	"q4kwaCyfYoLT8EHdHmN6iIl+UxUUBQiy8x6vjqr9NBcSeMuN+YSzfBrQkiqpIiVXkM7Ke94yDovznq1S",
+	"ge8Ds9Ss9sr47Trpwguyg+6Dn4KUAhefJov4PpYwYeYg4y4e3hjclD1aNw5hvMWQOiQYryUdNzgDv+Tj",
+	"cBXkF6KiBsidWHLwzcXReYRpxxFiwWt7Jfrr6VmeUjyiDnAACrdSgdx5lGtTze33ue6urZYb4IBEhCn1",
+	"zRv8/WlBaIL+XUfxoAalh0mvj845P4/CkSjDao6wWWtso0k2GqFMQvBFrlVDwVdKGxJ4EdisgsHTmiOo",
+	"i6J3PzgoGRs7O6Fovlj6gdYbW5tYWp0dHf6iU5bvFqP64KnwFXDwCphkQKUJoucF1jAhO0x02SkTgoxS",
+	"+EANiObU6qJ/DJEfKmSI9LXiENnwIkPrxF+NNbK0TF4MRB+8EnwNobk1UXW80SwphdsZCSxJR6dzJfFS",
+	"YRjYv62OreL+oc5/kYty0nRWqYaiaqrF7sqmTNO2IW/gFjpeDGfekJdR5FS0qy16GU/TuBKMjiaZuoWd",
+	"C6Kr5zbBnp9F/mffGvgU5WjaEXqBJ2GL6Z4GS6SI2dh6ZaFcbmIipik2oexqV2vbdZ28f7P24cP065tv",
+	"6u/Jt8t/fMi3tx+B/httfPy6863y/sMHUa8SDsVJ8+zUk0hrC3Z5m/SQ3EVb4gxd4En3rFjNtU/lNX/b",
+	"siVrfjsmwDdr2F3JxaXf1dFBGKW164rgl0hFoe0X3tR3h19wpXevcdHp4K+X8WKe+hnM/8SZUAaEF9Hq",
+	"fa9gThiFEy1cWnCLO92vzo7++eAfO+iHJ0+e/IiePHmysbO7s1s2pc2rv9X5kvtyvvNND6/ghj+Cadyn",
+	"eTUKZIYxZ5Qt9tvUxOHQu7RujNrafQOR1hDZFo/XQOrD05NamdB2q3HY+uJUv0aReo+OjHXTu83zzSGS",
+	"s6m6tExNkOEvZKorIZGrk7ZAn35+/Gh755OOvqt/buw83X78qRoMVL9oDQdq+943fnnNs03bzX1HIIN4",
+	"gQNL2HIFxtH1DPATkd48+mnwzYNjkVh0YRe/6v1Za7y3UshyA+8MW9McAyXZ4yiC0Xaab99UxhC2gmgg",
+	"E49GTMr+3K1rpvr50uY/8/E157v0cxr9oUGO4TZi2X3C8PQafnryOcNcPp1+NgzxhhAhvycMgQQUFZHd",
+	"AFhga+imzpdhLVTlDS335r4fRUXP/nh6w7884XICTyoUVZhbOS1TAUkBWxOki4TweOMUczkzPuqnxZ1O",
+	"v1U6jrNx9OWPWfaIRbSxSusbUgmTJ3/sbG+3Mia3AtsszUJBDprWXK6SjSjiq6xrvAvHQKMWGdW+LDx/",
+	"ila57Rsd6NAczoWhUQHFDIxDDB6PIZJFeak1dX7OLtK0OUUK0F71Rg9i7jzdJO/Eg+Hgqfqz81j9fbQd",
+	"lzGWDvpGmyAjefXk55tokrBnP7sQUbq3w7aAJOdAY4S9OC36oI3LQIPqrtcEP+gaygcaDtMTgPHzs3j3",
+	"Ovny+Ofxdl6BUUUGOfQDuZW+sCqU27AS2G04ODNxDfriJtneHT3b+Znv3sazbccIyoVfR9SwICJvmRWk",
+	"wEsy7bfeGX9Edq8FmcXAn+lRiwSbGBpBWn+VspGWFWx0Ll3bCAxGEWUtMrRixXtJBHpk9PlaUf8YaV/R",
+	"CAtAOJ0mmOYZcHUNnmCOIwlcIEKN/kp/tYn2shGZ5Oom2atTCClHNrnCjsmp8Omtfd7+pOne3olr0q4k",
+	"TXixf3D48tXrX359c3xy+l9n5xfv3v/2P/75r91Hj5/89PTZzx+/Pv62scJaXScca/R6rpHWJis586iA",
+	"bsBg/u8CTZOZ0GZojKOUTfTPlJX3tYuesoVOVFU7Y8/7wAvSWzvrzvFxuYs/9hTzwnetX+CfqgahDpyK",
+	"AdSVnEsNtLDc10nZhA3fQSQMEWxONnU9DpPCwNctENWLydBDvRwYbGwriM1FQrCpj2shlbSSz8d7rysD",
+	"P2VBMUKlVGXaTkS7uwmkmgIaY3UCne+1tF5txjkJXzUqyF/MjuK7rJb//b/+XzXZNJ0ts24qtNUcSm0M",
+	"Cta2cejo5u2O5kuu/CWWsr/Alh36IhNYTN8qVnPQ2rmvHetHC9FJm3uDy2j3d1Eky6ioGDt1jL8/n3A8",
+	"TZ5/9JWJH8PFKKRSXMwc91RPY+cw6mwN69JNtGffEpkwZWCHDEkg7eBvmB1j0jHIhADHPEoC6+eOvPpj",
+	"XeH37VuVpJAZZBtldYbieutCMVf9VZBjx/WYVGG+uYneUcXgXbRjDojQBLi2VBxzltmmmQChxNsIhGQ8",
+	"sLcI0DdCkGnZxvvS7CS/mXipXnwsH5ridk9vMEPr/cyFrCZf8nrCBmIk8RUIHZoZtMTZnMNqkMGloxJO",
+	"OYyBc4hfTN4tEe1jRZHrXGz5fuYCLllqXaWqSa/LZkmH+qqEQggsQB1zjZQuiiWd0dhL1Ycydl3SnEow",
+	"5Ivf5mUl/oGqltNgwwECriU6Km6e1W2KC6CQEh2ry1Xa7LrSE51ew6KRl7RjfHvNWBlZGSSEIhxnhPZW",
+	"zQbS2nSlieqXXrUEt7fT6NECEaaaMLfcNuiLnAVd/wWRsOBH4ZBAldn3N321CmxSquAqkW2+zeq6UYuk",
+	"WhFEo3Z1tmAZSB3xQRmLoU/7e0aLvY9TMmacElzTYu+3Z7Q6ly32gkJyALlnwui0AKxquEg7mx1d6Hq2",
+	"qWBf5jZ1X8eIDPdlaiAzQ+gauDZ1N8k30yg3rgHVKJONFati8r9K84gJKII0hcjdvjKRItWOGNv8AQrl",
+	"ZFzsI0ToMP+N6EtVeUxHaPrpsRpnym5W3H/Kbvp3b5D33uCuL47nNE+osScCPnu9evSqZhfEsfrkzcrx",
+	"rAFZANl1r/AK5odhSuzAYph02gfrMaSoWFRLBtQr16eyNQ6zghjrPJccR1cWlfYTf3U21qOW1g7srXAt",
+	"Ywcu8wnqai5ceYxcLLN+9oUJFi/9bprWwgkWb3CPCkZd6eytg9Xe5lIBE58TGrXUSnGfIataBeoWHrNx",
+	"C9WQOHB7dmVmaeFezrTnS7MfZZKg+rGeMc74ThOGCn3qYl+MdfDS/k6DaWM6ajGAbdK0eGUoZbm800gz",
+	"zNXKcM0sPGJWp6x5y0XLnua2wN4jOMobIg5G/DRRHAqULIwMDUzhGlGFSAc/tP5SFrDK6nBHMabOYdcm",
+	"A8+YUCIB/ZFDDigud/EljOIqy766xkMLOrB6PRYaO753Zw7aFtrBvEUmuwg6KiyOqinAxrD7+Nmz3Z2n",
+	"AI8fwc5oF549inbHTaukkBnS9rAW5cF1GjY6Evl0ynUo6hMmS8c5C37Y0NU6JQf9E4xEfESviYm1EOKO",
+	"/mmz6B5V+g/an5IYKqLjSqzl4VZyyKCfUHNsbFRREQsVFZFs1XWPOePrfTEyPgLkSyFjTEzrpaxhe06N",
+	"5LOpEFDPmL23t3+42V8mMkHhep28XpqqFgnJQqOH2xWMXnWpbr5ASHUnLJKlR+0Y3n4u2Xjc3yWhl5R+",
+	"jG9XN+aU3axmyFPghMVV04GncfVu8Ied+M+n8Z87j+M/H23HP/6tzTyg46RwcXRwuNQxYdkAA/2PFyul",
+	"xeL4sZrZ6X00WSlxuaPLKsYw51jje8wYSmyswvWffcq0F5breTu5JDG0H4jsXuKxwMbMvCci13FQ6gGO",
+	"TGcQFzMCwmo7IW4cfGLOpkfabeiIXujYGafAIxvioVy5xt56e/NJbQGb8v/+p/nf/BfZx+jHDx/iDx82",
+	"9d/4P4OrW5IM9l8dvxNw790e0T2qZu07dHxYbujfoffv1O13oq4j+t4u5+/U9f2S2LcqhxmXDKSFxZx2",
+	"BCS7l0hcDzWQ1jL+4A8oJNTSue8b1iC6pdrO5flgtNBVzf+iHsBRmxsqYx25wYm4Km/ATAwIRkuXPG2c",
+	"poSKIkHkMOCoTSZ9pqs8nFVTGc/7ziYwDuHIdl42V0NVN47yVHatQHwNHE/AShbHGQt4N+6ZOshWMrqf",
+	"iPFYmGSKRKBCBCrYz5PNJ72FRSvHHWOKJ6AT49JYnYJDoV/3UAycXDutlr3zhkzrdQTa29n3oXi6ABS+",
+	"unIhh+4+83ta9SkqpJJjQnMZzu2Vq+WmkJ2ZOopgVeotwNxmH5hk/li1eUqfg0lIJGrktlUv8MRwNZLB",
+	"vM63Nx/1x3QhHdV2zZ5AEKrt2PRhxR0BOKYTuDtA1b10MXjUuWOV4ISFuP4wWd3K6vG0NEQrh2TZ6Vr1",
+	"VDVEz/6gmHiC64PmANLgDQwZj4FrX4URyBuwsbIDDdR4vL6HdnfSxHzFplNmjEzHY5v/qwR/d0HoA9J0",
+	"f2yWWoxVIrQpZS8I0arorSXUYU0gMHJCLwnKyRwdAR16G7I0hI3A3U4TzK6jg5J93+nsAC3+nM6Z88JW",
+	"Rqo2OjoQdzAawrfOaGjb2nu657mic8ils9bDImGk66PaRG/TGAk5S0GNURuD7WxvxGRCpE1OaQKJE2Fi",
+	"lY9N4qgEbnEMtyRTpsS6tthEJ3BTa+rRT7ap39+9OzpA148//pBIORXPt7aAbt6QKzKFmOBNxidb6mnr",
+	"HSWKESg7nUsz9MvSgfy/WVXZ5ePLHzimMct+/LF2EP19e+NnvDH++HVn+9ufxcOzbxvF78c9fu/sfvux",
+	"yyWjjsXe5z5JSm9pa8ioirZ3trcH5u32bvnzUfnz8fb24KO3wKufVR1FgF+TCNAFCaW6Gg4kJ5MJ8OO+",
+	"OWY6w3p76++i1m5oBZoba4W1A5CYpGHv0/bTdd8c3O/q/YSAMRmST91xVp3u3hO4qeT5qALWGYutvFVf",
+	"LIST+u69zj7lvgvEqVD833iM6EOouhNX3yGbtmo00+KiYgjmwkv7u43rn1C4Bm6/2ex9Xx2OB1ccVd4V",
+	"iV+675Sr1Rv48jac/6EnpsgAZQ/eCkeN/CctsylOmMVpr5AKvfj6XHIJ7E2LBmDw8WXDtugmWpEj9Gyb",
+	"oaKucA0G+hLsFfnQaz/tacpmGbQFILMAe9WWUlzVLFEbxDc361CRZzrRIlO5Ksx6aIlAMOE4O4o7R2Zr",
+	"IRJsQ8yEhOwNm7QlZ1SZl00l7ZtTmGBr8G8S5tZ4uZYHC6ZfKnGg+lqk3ZBqrb6Oc/ejMsMl6qphw5uU",
+	"7C3vPoR7qo8MRXChBg3rqKrtnFS/LkjCqZj6UboRSHzfue7IZKa+Xpy8R/VCDbtAyK5ytO77Bph1ODpn",
+	"ABn0aj5LhAwlJgzMSftsGAyLBZls62yHIuCvf1KALz8A4F3ALzPlPad5WOC+HET31C82597AGrPeFGn6",
+	"LbC5vDJjQrqwZl18cyWrdyGOGupxmdktuGnrNNeQ2zmnwBecVbFqeakqcdyriOThQXTIRoruIMo5kTMV",
+	"sSOz2wjooJ0X7Apo6MagOALaikjqmsOBIt1BAtgkGjYuQYPbDXdm37D1N1x9h48p+RVmJs4DoWNmr4gk",
+	"jqR3JNJ2hYzL/8c1p07OZTcOKEU2XFV3h+2bm5vNyieNuJm/wQgJe3LUQSaFZByEMivVS1cNEY+Ua6f1",
+	"Qx/6/ljW64twP4FZSiJQGC9cigcvzg82djf2U5wLaMA4ITLJR5sRywoVyobSC5hutkYpG21lWEjgW2+O",
+	"9g9Pzg8H3+qHX4H2To+McYsxvRrsbG7r07iHfz3I/h2rXtgUKJ6SwfPBo81t3eIUy0QTytb1zpbKPr0V",
+	"4SiBLUKvcUpia1c9ZUKGbhKVAbFA2BBN6bnpZbFW+NYt2nABxghZJmC/0dqckXIYoHExcUqa5XDNriA2",
+	"rn4qqPAHF+7HJvOmCK5JZLJiqJaUjZMF2WbOLoIQcHVc1LVs8FMFUDw0IqRXXn7P6Ad9yJwh26k6ZioW",
+	"Yj1BB88HRwWG9irD3VeND8wCByFfsHjmFoE9W+jU5aby1mdrR25YxDwGgps9FWC4DIA2xIphE3pqlSrm",
+	"niAwik4LR31tvv3VcCmXmshDIariEDkkmpv03wdHVAKnOFXs8XaDuKfCM9pR75ctUHefnWR7aGuIglaV",
+	"5Zzpd8pSEs1srJdkNmUyAWlzXhnic17Fuck05xzFp8AVg9H2d3LzA7Xp6vUkqKQsqfYyNzeyn3Talk+I",
+	"5ynYvM2K5PiwVHVYQlfpMpXHZwyUKII138OtNoomjIoP1MJj2nJ+27YBnVZdJvZmQi11m3m4Sc0OKXs+",
+	"Ok41NtZIy0VPfnyx9RNw0e0BRESUC2cuwTosoQqaUIGnRci13HYUyBMIMliZcyq0E7ILVV18ZhtA3E+I",
+	"5K6djECpXEdN10UO2tLLzZyVFcFOcz5lAkSTKpQ/y16a7peg6hgZOANjSN4S0aCssmWvvb4N59Y0yUZ6",
+	"VPSO4ecS80W/OaSxDq9wJwrrJTkWU9We4aqabWE+AaoJQXtpiipT4siuzMFfEmCT3La+GuH8my2bT384",
+	"QIECYS/zNVGPGdh4O0E6ssC9ZLyAff3U9HGNnKRjekVfhqLn08l8alX6uKlP68dvi+LLTPTg28cOIiD0",
+	"2mUwXFvjW1/Nj6P42/L9zJ9010k3TDYrdAAQfeZR22R5FCnOseV5zdyhlTRS1zV+HLYIHgUz1lePBUeW",
+	"DIGOfueFVFB7PHCijRCMMNKWbH6zVKHfsFpqbZRTSVI/wktsrpG0tDtlU+sEqaSDMou69j1Si4KoFHRX",
+	"RDnzbrDxWN3OjFIyDQgPGvwTuDGUeljAPlj78uu9cxsMlxyyP8/s5I+cwDUUkUK8+GZdPHBhvtd7b+zP",
+	"IIs4iPe15c7/AhLuwkDeA+Pu4tHDweMV9qdTarT09gLHRVBk3e2je+n2JeMjEsegndGf3NNYC/53bk7k",
+	"h6pux4YY2gKVPM1ZCrpUOaCdvn375nLv4PjoZDAc7L85Ojnarz+a/472TswOGuTNxlcc4WpYm+oKNnWK",
+	"oC7rOBRVmNq3e+Kew0o7t1labaauZ+xeMX3Iez4tLk44dgKL2emknDqHN9zwMmIxbH0tOOO3+YzfycCI",
+	"leoAhL3IsA0iegV2F3gxO3cdPZxt8hW41ad2+2qs1vnSaEB+EpUxtolQ3fYwH2uT9dU5GH4zM5NCMLqF",
+	"Li+PKVa5wkELP5SVKZCKK3UrUgn0wwiEjo9nksna4h+bko/pxGMJlVl83ITqhKF9O61VzJuWOuj327Av",
+	"LY5m5g6/hfC+J7UNBymhV06i2qjqQKrwlu+Fqxf7H1SprwjEpj79m1Pa/pEDn20WbwxP/c6Mqlxia9rf",
+	"FpIs3VLSEtc0D4bw0AparKzmrfVky/5oat7b/rjotvXte3LZ70V29kZQk0L1LvD3j98++nRp53klpPnx",
+	"WyvL3sJRBEJcclD8XUeBnK+AMjrt8gMv5L/T97s4Nt5lnu7IDyX6gX6g+krIPJsoigJFmJaViShO5psh",
+	"HrqnK5750K9TW93obZGd3MCKasDeXa00n2nsUUQaqmfJbKgnXyNhEc+rMIYYSwvm13Az0Yb0bw9ypu3C",
+	"7TfZwwXuJqrLdkour2DWb63unR4hVRkRIfIyyEQleQJEHGQRFFXX1oKZtu3lurHQRZW+kjg9+lWB0qDb",
+	"EERG21b0UXIOyqS7ZUaMK52XFk6t6YMWHUo5FkeSXMMgoPcrbcjXqbMwdhWL6ZXVLFg8rXrNh/WbarKF",
+	"khTs/LvkrfZa38rR1Ti4hhpU5QnHVIpyuqZlylkXmF9EbAr+HOJUMPOhF9q32t1myzneENG6WIieLj+u",
+	"/boFEKNt29P9tlCJsaS4SWcbtnYxT/rYqhCsjSHMlOifam80OmVujxnOtiJ8/LYkF6S4+bxl66vB25yT",
+	"3ZletD6hbaIzu5D1GlcbOmUoZXQCXAFfXHpWzReapGGa8Uhjrcu5Y54cY7IDrGHbgNmJ7eXX93xFrZul",
+	"QcfRfAvnMZGXOqhfv01Df4AkxyStsggv08iIA75CkxQLJ9+ZIPalGZsxLLMsgqlA9yzWAscHGhA1QrzI",
+	"N8404GtW47YkE1mxZWNSQzi8toZj678p797vLPB2iHq7Cm9u9lXnldYaDTIKnC22u2lyKZC90h2unaqL",
+	"88WlPQr1IOxRTlK5QbzDCdIfF0HmdWL3zBZWKLIMSu/vhjJx2+VmxzUTwfRMw3gfd+CqJ9X1EhffCh8O",
+	"0Ps4m9gEZmIO6jfRCzdv5m2xFUYJphN7bao4A5lQxnUytn2/NVNfT5Nu9pNp9/kNJxJs/q7yuOqK/Xku",
+	"5RwO2orRbGKV0+vmBzPLXmUhSZpWMh6U3fgbug7/utmpxqnQ0LrUOU3y+fYQSbaiIOkm2h78Y6lbZWti",
+	"IzZR97pffPcRgHmUrPaKufettbEK71FRLax7MuoRy3Gy+ztshdQrUfMW05gStV9kmvdrXdzdi3oOeiv3",
+	"em12UX3W29ZXL1nHnPOFs2svvjC27bhN8+1fAzmELjzSyg1QuwXYvEugwgrP8YwJuTYhTAh3vqTtl0Pt",
+	"0K+FJha4kFyVTdwiBx2PYuZekNRuR0LrrrazPuh1V9/tVrTudMa9JYwNVz5rTk9qz57maFjP0ORUSsE0",
+	"UriZo8kkG9v8QG2GLPRJF3xS1T+pCCmfrNjVvNLYRPu+WIcSfF2ApTpL0xIKDhPM47SEmHDb8zySU+m7",
+	"1i7M6U7uU5JbWoJz6FiIshkVzHONWvXG7tJtGxLJgE/MyaRMdKcjfBZm2IRaIq1o16t1MLctxZpYbUZp",
+	"ymSitgMbqYrHUGTns/QxNCmK9adaqzZS5qjKe8UefzhLU6Xfx9GVyUKls6jqZvVHm+i4GABGJeoIo0U6",
+	"M78NCwtXlwDCwhRQ4JZTUN5rrIWkGx21kvXuisl638dVC4GrK5+phLj1ermFFCiTxtcNWX+h6sRMU0y9",
+	"ZOtquhHcQpTrVnVfP4d8SQ2Z6NBKUGWlQjsIpBxwPFNzrKMqgBB1Wa9Ed09L3YWusCqjVG/8ZysTzlXm",
+	"CB0nJUTPTpvjBqfq6OVjLy/MUm4XvSozvn4hrA+BOcuFoCGTRC9ZTuN2C7L6gBacyLUKdtWp71T5QcK3",
+	"xIxGa+H2wYtyo8q5UVqcaS4Sm7hIgpCFqZpwWdoKt3hNf6cHL61jl3EnqYgNKliKESsoMveZyo2xgMbs",
+	"HDiSKrR9jCVWi1b1r3i9QkDCGWW5SGc6TW6uZZNxnpZ+jhlgvUqw1F143yCJxRVKsEAjAIqKFKUaSL1I",
+	"3MAC6s8WcJU1x28KR0Yzjx5vP0YFVSJSaafgZrXxq7AH1g6QMKrzz5IyIIJOwejtioXHnTlsCS/mjECM",
+	"KmtBNQk/6OsK470fsBk8n9Ho8PWZzUIU2kbauHxloalmNJS2oZWxSeezU1VVrUGX3uzoTn5Ci6z/Nk+h",
+	"/vCVpV2qhKCWxlRWVGha81LeoiKlVEjBcGSqr0bPYBtbTt8QHJdbF81hBS2peo7m++kd+mLoXshUdSOj",
+	"pOdUYCFYRIwusHKaxSa4XmM+9twHxWAv2DsTD2Yt9hEt3T3EU2OBGu/gKBmy2Fnk6OgYytQL3rNGvuq6",
+	"eVhc1V5hJGS6mA1o6YRszsWulep9vhY+tOQ0atiGGtpHn5lJ+L8qo1B1AXBcgHNflqFZsMeFLi1KoB+g",
+	"iWj1wqs+zy16ro5pWD0P65yBbw926q0SrP/kLy1I6gPvfenICjMXPBHD4szjR3caWrnEnJRKF61C7VvR",
+	"lFhbIN00EVb/AbHTUCltlUqOQuOhPtwXh36rJSvN5QoLjjLYmml1moYEI60vW6sPRVb2cG/aLD2qX9io",
+	"lyKrW7nkHcR6KZV01+1uDXejbrH1VU3jgsqjVk3RNMEmiQwu+R+fdDgRHtvXa5aW5kzf3dREbgwPSD1k",
+	"5nSOGFOZ/q04NyjuaRNWKGVCvMez5VFK9XSGytbVB8XHlhnZRBnOWrLgOBwES3MTgy1hAqgzxDe5soA3",
+	"CKu0ItGzclAO6n5orOxwdaTmGWgYWkOVYf2fQnZbX4vfaxf0HaDza3owtYuC5yBFnWA1Eyy+Vgbe7lWx",
+	"xzo7vDLKSbFd63jCIeM23cw1hGh8rdei1a7KwdzbgbcKwOKeihfV6SHCu0VyYeeK6eqzRlt2+orUpbrA",
+	"N5hIkwdEMS13ZWV5nlMbe6IAN1OslNY+CWmKslee9quGVb3+sIVXrFF2UHZtqZIoe20dhuwFynAMXjxN",
+	"gzSzc7hk+qrcta0Qp3YUZROvbE2l2Q5IU9l4ymGckkni7wRnDsL7IVTXnYl9u7q9oBhadZK90T3E7aDf",
+	"MaicaHM3mU1TMCEg9UV84y5YrRkOhS9QIZQGj07mWCWCxyf1S9sSmzt8yVQITHfxH2HrkVC76VdCCqEI",
+	"m5wgN4TG7KakZsLdAMJuOnaonULwdz3DzOV9u7vfbd2ULDbCVLHY2tREKWCaNnyOHHndTWzvzxT1Hn4/",
+	"ckzPFVYYQZglZnBYcmC3QY2rm6HY9ATOImAs9nfTcgVJ5u9uVhXRvEJUqPk3Jv5lNv769adCwfpocZEY",
+	"gV6++xVECbT5p4zKiBJJcFoG52tQgq19ZCr6SavWIcuGcm6tW9lZ62uuftPhL4S7BYL+Namh85ZCXwKU",
+	"1YJ3BP7r9eOrt0K4sB0vAPTSU3UhbHjva8NACA0NSi08Zhkbs6leNS280dWL+zwvLcVaNK5z18vuva2X",
+	"FmsSh1mDGO+m00PNnVdPEYO1dRkptSAul6w6oHS4LFTZ3UNhQGoMx32ZzrLnBw3MsH+M3sXMB7wwLb69",
+	"Y/s2ZK/f1rz9FP2b7h7i7uNu11a56RTLZouD5LPlReIliKa/75mGDWGbYaCkFXuR5ocX5sYD1+R71L5q",
+	"U6CxEvWKai7XgZU47EVf0YiNQdHU7kk+e5hcQYO2Crqw+96l3uE6GalLPKnz5Yf4p63g3q8NWVOvn0W4",
+	"aH0A9+TjX8HwFocxB5Hcn/WvXku6z0q+Rw1NoWoVU4jImBRGdqG1oNvoMcdzKdcAM282lj5sOXwveIUW",
+	"pblQiFZ7U/2arJQOx9oa2N576VyzQqukqq7++553hHpPIbZZFUmkkjgr5Wlh4LvZsZbu58ZsWuvsPMJL",
+	"mPBfeHbW2Jy53dgVmXnT0b40g1dp9uXaF2gJ4RptTawes7Qt0UrQodVKiKFJ4OcZphsF6fHZiVXE5kKy",
+	"WJ1ES+osfLbKZK5qs4tSTDJwKchcuCzVmu81WDZTJNxqcUvWoNcJc51WJfW+7kFC83L5t6g6Cxy7a3Hr",
+	"CYel5GSUy9IAqHZ/1XIL5tpL7JoJz1r9SsxptQpyuKOqzEHh1PZlR9rFISbjMXBV4fjsJGwS01jC4RW8",
+	"0DIUkbGHX4PFF26xnDAfa47VNJYYzdT4hyi2F4AjwrVDSYxUDnSklRQN5WpUKAQeJDevqkEjTJszKZad",
+	"SvPRGuavEl/f0a7Ek5bYFBboCzxZE7uaFh3cH48qO5sfmc9HUTAghptzg6JFrND9ud76WsLWOyqGB1s1",
+	"LoZhRngyKQcgWlxZahPcI2x61BU2fR421npN62Gwf5SIrjVg6v5fuAaM3qmL+i0a10L9OjbFmi8cG7QS",
+	"5paMXgOXtbWm3XcUjEGPePVBSTIXTMU2WOeupQGZw85QZOCC2MLdJtHsm4toW90J296I0UVChDoDpzFi",
+	"UZRz4zl6i5WRgHHvUB9l+FZlqEY0d0FwjMlCgkv7IO2BykHngN1seqBrADz6UkBYXC5BZ6JPDhHn2FK9",
+	"0mgR6tVVkqeU/zcK8yUYD4WtPDfY1wEqFbJVNTSatcSqVG8v6vEqLR0Mng+iSaaRIiVw9fX//CGaZH+O",
+	"JtmPf2umNm/CorJVIZOsX4ESEzFN8WyIxiSVwIfG9EUNIwycURm0gPY0rkG2E//5NP5z53H856PtuB98",
+	"R1RpPUwk6hAAKRbyzBj3VsHok7i+2d0xvkWTNI+Y0MmRc0CEoixj6dabFgCiSbaZ4dtK311c5GXKsHyp",
+	"sRsEgNBlACB0VQDsXQPHE6gBwcaIQ8R4LHrBg00jr0wbx5nObL4S8GyTKMMUTyBTLOuIKp2VZNyDsgM2",
+	"O7DjooHi+1XBeAo8Aiqxdd9QK+z37c3tjZ3N7Y9IFe2/OkYmk3obkOqj/VfH7wTYxu4HthGo0CdPHqNs",
+	"shW/mQPfEX0PfPaG3XwHEJ9u9wRxj947hIQijukE0JPHG73h/G5APt3e2HnWF8oLbaX1fQDdeba9sfuk",
+	"L6SvySS5VzjxiF0D6g+gWjvfCchHvYE8vJUcMvhOcPanyz26DlweE+qUpYtwbPtZbyiOaA8YluPMawRl",
+	"QQ68FkiW5bTrBWYJjrpegBbnnGuBZ1kOuUZgluKEa4RnYY63UljOrIS/IL87K0TuFcKwHL9bIygL8ru1",
+	"QLIsv1svMEvwu/UCtDi/Wws8y/K7NQKzMH9ZKSxOteA0CVPgKMaz+XqEA0zS2aKgzBHuLpjEqa/UKFRZ",
+	"rYhRX6wUIQaGhOXcuMnYIIQ9YNHf/EZkYmPhrQyYGM8WhUV9slpQziWmMeYxiuGaYOd/VNFI9dNDCdvQ",
+	"gWtnVdSzz2A8JpFWmb8do/eY3wnOqGzu7bhobGXnmIWVmqPvrdQcPTCl5mitSs0VKOVGD18pN/o3UcqN",
+	"/i2UcqN/G6Xc6KEr5Ub/Dkq50b+JUm60ZqXcQgfE0cM5II4e2AFx9KAOiKMHd0AcPZQD4ughHRBHD+iA",
+	"OHpIB8TRWg6IB5BKrHjx0nYJuoVVoaQEZ1krhfWAg4Pi/XKGCiuFcJ3WCutBpd70lzNRWCNAd7JLWDtc",
+	"yxkjrBGsO1og3Adky5sd3Ad0S9sarBG4OxoYrB2yu1gVrB24ZU0J1gNYdifDgsVgOqKLQHQnM4P1A7ac",
+	"0cE64bqjCcK9gLa8QcK9gLe0ecI6obujscL6QbuL6cL6oVvWkGEdkLkjXGTd+HtbNKwTmDvZN6wfsOWs",
+	"HdYJ1x1tH+4FtOUtIe4FvKXtItYJ3R2tJNYP2rI2E+uADK/CgmJNwravLutpRbEOFMmQTUVfS4r1AVS1",
+	"q+hpTbEWcEyIszXZV6yJtnTG3tLiYpVGFiuFeJ63mgIjxUKqyX3JWbYCj7XD2/5dXrAVdHgHnfToYemk",
+	"R+vUSSuaDeqllzU2+d7q1dEDVa+OHrJ6dfRw1aujh61eHT1I9erowapXRw9ZvTq6V/UqX4WJyHc/Y48e",
+	"9Bl79IDP2KMHfsYePcwz9ujhnrFHD/aMPVrFGXuRg6QBq1OZOVrfMXveAWd0/wec0aoPOPssy/CGgCnm",
+	"OjZTLViNjtNzdCAGwwHcTlMWw+D5GKcCwuDpEDo+UERCJirQ/c/f8cZ4e+Pnj193H38LREUpCjDneKae",
+	"hZzpCCuqiUH/EdigZYJIWGAEqvq9D+G3BPzEZQLdJOoENUlnEWQkMtxWIAEpRC7K9n9QJv/jA1Unr72D",
+	"vVLLYev+AJuTTYSFum8tAuReHB0cIq6TB/34gYpEx1saAWIZkSqf3gfaQnaqwgmjzlXlTPcxCCR5GDGW",
+	"AqYmy8O6o42JM9vBQnmxO1Ie3DURdndsxDJUrIsiqoJcFUHAumMl7kVREeN5bbHivntA18aM1cIhllhY",
+	"JljXFhaCTKgKDheIjPjdw8LtaeiCUeHykQCfqbWGXzRt+IHiDILWnAJFkhimjKXvdG6Bttw0e4odNkdh",
+	"coAcHQg1WJsaS60MNXwzY5mimMG3peKbW6z6Id8umMssdff0JwVtmcCaD5W2bCDNUHTPNNW5IxcgsnqM",
+	"TyX7PCAyO8VCIEwRduTmxlwlMX/EXqRhN+pN9DYjEtlhoBGLZ/7Hadr4YEkCbcY4RQqhayBRR5dzQsEa",
+	"gESBtkoQWJWqVyd6cil9DXokU3KEzlk6mhWVEY4zQl2w7CjnHKhMZwjnMgEqFRFAbJY/0RH5Miyjal4E",
+	"EojHX6G/vgFm93sEmG0RCeYFeHSwlktnQq5NyErCuzIctQ7hu+23XvD/7xVpd8HcNGqn0GGVCZ0gXCNa",
+	"WkbkVdPQLWKpVffO1FpLpmi/rwcvaWn8HTrkWbQE6WGRcMhdkU8rYZH/b5Nzq4GPl5VvPQa/FTFKIZJb",
+	"X6ecXZO4SMx2L+u3R+UCqq60AEBjG1Te22j87UEyZAeKsAni4BoOBVFW9U7L93fYN2xjyGtthbvz1hR4",
+	"RoRwOSnvjed2rGUPJCQTLMtNPsFCpx33uG+RofForEUl/2PMdS5dduVyuHMQeVqIYSZI+LAyy1POxkQF",
+	"giZpqtMZg0mnW8uQsvmBfqBvVTbqQpESYWozqpsGSzg2uxnQaVlzvbzI6+j+2FKz0wU5FKri587cypuY",
+	"ra/lQ4+UBTqEOJ2k/uT+H0qgFbm3nIBVSsCo0ux3k/+GwWyz0+qQ2/LNAs0zBbXTt6naOsX0cJBPU4Zj",
+	"1SKTMPjY1I9/XJBubZqobjItgt7bD/8uTKoybkN5m/w1bmdTpSwXSIDspoAz2/f62YXtafEcZCfMDlKN",
+	"ziJoTpIADjY3QpnjKHLpav8uwtnCazRcIua7HmA6NtO5xIBpXA4bHR10b1YPiRK6No2uiVlw4ZmVfMkh",
+	"I1TJX/cpJbXKqgIZsJADqyauNpNRAY3f6U/O3ECW0iGpdpBpCHktLYJlcy0k1LlBsJTE2PS1hmRRr4Aq",
+	"HIAot27TuT7LZ8AnOldsnkoyTQEJlvPI7aCiTK7n9FEXSfF9pHZ5o5hqTxmJI86E0Lt+rWmXbM3q+PwO",
+	"zN2fEwFsd8RdyUFcpp8zGbhRAljNvwIDyUYDmU7RZpoJ5Zs0CNr3p+JMV16XbqLoCSytrEgWbWaOMUOf",
+	"pnpbttsvG32GyAgmfnPXNN7MxAbcRpBWmy2uYkeEYj4rWyovuhstOeXxppnXDQ3Ihp2D651/LIgx3YhO",
+	"tXfmmtDdSriVW4nMahDX4WvdRVvSEtZJlYPON3iNUxI3dHiGfJwGuUJGqKCjlSV4dZxjfUk6fY4RYBXy",
+	"hjnE3G21biAheR7JnEOMfjl/e4J+WIaKfhyqkwYnVOJRCuj1xfEbNMUTQD8UxPGjvXOZcsCxSAAk+qGN",
+	"9M1RI4YxzlP5oxlhuYpMxm2Zc6pU6dScJUyK1/rSbOc0Hh2vicNMmj39xWK+A4vp5BS6r3YO0Ud8ENOU",
+	"yPtiAnaBl1u7LzULm3b3xlm5BI/k5oJdaRjts76+0qMw+aBsHwoX+tlkHIvYlEDsxLvy8/Yldq6aXOsS",
+	"00CbuVpqad1lLSy8nRWmTJXEaNyfPiJ6b3EauWvY2gTwaxLBpVUXrSd56l4cC/9KVGElSu3Z0EJQposW",
+	"FXIlmKIMVDa8JuXtxfG5+Xq9xkO43k839c2z14hjZJvrMPe5w4xKSehEbEHC5+ahV7m/OaT6ltp9GLrH",
+	"PXx9dl6+XtsZHBLuulnkPleNwgNvQVTezSKuQwcSQq61iOu8Iawje/UE3cTzcqTsDXR5/Pcg5YzT+Xko",
+	"Vcb6Lho+Pju5DxrOOF2GhhX0D5CGa2CFyLWO19WTaxOldyLXBVDdhzhdRl7DydvI1LM7QfuqZiet2pq6",
+	"4n0Q7TTQ3xIWNXZkHci9V+JthapD09xE+douRkPYvhNh956FviROJKw5nb7NTBwyWrKv1nKQIBJ0J4TR",
+	"e7iT7pNqusic73l1hA2Y8DLpnPVUbn1V//UyjmybGvM2nJt7ibz3HWNZ5z2WQcNc/tGCA/N2zeT5/cnS",
+	"JbNvJ8g6mpYnyLurVheb9CBTMsohk7B9iEgMVJKxUoIQWjEFVpbSw/KaRlUP1H539sZoMqOEpDEHWrka",
+	"MWoWNIKIZc0qHU02qFFDvWZifDE7ir8/QVrEtdOjmUClLHdObnegyCnmdkDfjw8ds2t7iWhvzIkUSFUB",
+	"GmMqBcr1HSymTHvX6WoVY/+hNk03dMoZKxSKCQGOeZTM0E1i7LiRGa/WSeVpuqnXtLHeGaktSmhD9qlp",
+	"jAOgFK4hVdDAdLODRZ4aNK6PNk0H/0bsUiHbAG2she/OPIuTxHcl1jOYpjiybhPqnpqT2ETFLemxqgep",
+	"qLY91reJ9iiCbCpnyNxqWHMzc6ddNN1FdmuW4IXXxb8R6SnMdJ7OumhubfceRq6tqn3LC0xneaivJhz1",
+	"VIlKvTL0IQvNO8SttyaSISLN3lxUuQKYWncWz0JxaI2WxBAZXBIwrSmNl3KucqOw17JeJ16DnKXgXa0M",
+	"CzOMMeFClvAaXyIjEpih6DOByS1Qu4VBv5V8242Se2MfzawYU4oTCqapdLKERZ1bho1LJXf/ru+Umivt",
+	"vLyK+Y6XPTsr6y3yOxoGCTRe6X2P8xnTwzT33DEDUwGnKqAM3EKU6x7aDAr37EVwdTUQk96EswkHIep2",
+	"VLq7YuJWp6rNp1PVHcSXlCmp1czCerQHh841SjLL+wrWV4CBqmC07BRF9ZNa7XVQdHefd1X+lO2i+mAW",
+	"YfOSxHBpbp/nq9z9e+oytIPm3Oo2eTQzARkiLGHCOIHmPFyQuDTLqBFKbZGRDJTxNmH6VjomYpq2xnEp",
+	"kvt0GFHf4myqY1Y8jXXfUpG6Cn7xw07859P4z53H8Z+PtuMf//bdooy0A1+E7OiiNyM72ZPbcJARemQ+",
+	"21k4fkcRKAZlhJIsz9TYNNmxsbHu1a5J1pHWuYa1TI4L77KfSzYed45ztSFfvOggHk0qajL2RYhQpGBV",
+	"R27GY+Dae8LGDlEMW8ukFWulOoF7DhLWYmkDCQBkqc0EIClI7/dBS5LPcGLNtqyGLQn6Ys6mR/QimFWy",
+	"ke5nMBxkAEq6MlW1qr4PrVYWd4BCnbPCEiMNpkZcMRKWRU13RBy1d07YhirbEFdkusE0beJ0Q29cwB2p",
+	"324o8tJ0VS36ApwV9yPzVucY6U8VYSqH4CjNY0BbmnJrfJmyIhN0LV5O22q1zZ2wRkLoWkSc5ce83lg6",
+	"Um8xi8XRea88OjRyOmzF7nBv1bX/Al+L0NR0LG9KT9YqRcMQlpguyNrcx70O7ioLWSiXvPa6BZzKpPDK",
+	"UALtpXFdmWtPUzmQ6QWHKcKRVDvn/9DNVo6NNgyW3ZJusKB/l8h0pc306IxRQILQCOxZUu+1xgejeShT",
+	"0Z9MLy6mxgmT7w3kixIrG48FyD56oZRkRA7WuoRvw4NaKjqWnQbXmBKWkWkOnWtEv1Eofuf86O5p2Vua",
+	"M5RwWbjczSW2hAjJuA4Cc2Ftcx056UYUGSllRS6A63OhGf4QZUy7ZEU6FALhQnaRk2GC761r05oJaX5F",
+	"e1WqNvVzifmi3xzSRXyiTCiePh+ImZCQvWETQu9jOXiTcpeFYJpB79fgULgYvW8JiWU/qqe5MghdlOgR",
+	"nkw4TPSRQHtLm5DHVPNa9WHIxvQVNNbAuYZzYbTcC9HyAkq91V3j9H5JUeNmEcuhJhUi1QYRkkTroseM",
+	"THipngpSW1Ba0udI48ROYx2Nh8aI55Sq32Wj+lqnCJ8lzHkxBVww3HRWqOw7WO8RVeNJQcJxCe8cJYl2",
+	"vbeHWdWrB5RR6yb4GpSQMQKgBRBOwCBCL4m2k4Ct/gLGjMNSkVn/nWSOEnULcdVy1lBl2vrIok5ynMsD",
+	"FXW5yoowzdxW9Pg6JgSOIhCqRoPAXhIae4HXakQVmv2M09Dpz5/e0GcjwnX2G5j3cVOlXhhAFBcgN4xf",
+	"iSmO2oIHF++P4sW7U5UaHXlAzO/zQnUTDNTgWNBwoEyvtafjURwKz/BvtkicJaMe3xmkhtwTMu29aBQh",
+	"dkesrS2OipM8JHxLzGi0+Im5j8f7HkWksQ9Ynyul5pvmwqy6FEsQharF3hXOkCg2Mr1TnB68tGKKXrRq",
+	"ESspBahyoowLz1d1JVcuaNemXs7FWdI/RJqrRxzJHKdWuSM0aDq6y4xGCWeU5SKdbaI9JHLNE8Z5ihxV",
+	"oAxwEfOIVr5BEosr3bfeMNS0x3mqAyl/oHvo8fbjspWG5pOMEWUhiAuzkjHLqfHkNPEEPcVx7bpxRqPD",
+	"12c6DjnjrVEFdwNzqP1foe5fpRrU2D/QemvGOyKT9bsUC9Jn0eE6yPOohTbNPm0vwy0pEupvGkU0fPNB",
+	"GZTShnKcEzrq3I5qrdbRtpNF7aJb47RI9FJRW1Bh1BjXIrv11tdcHxG/uXNOpw+AJxgibPEtKiE2g8Kg",
+	"BaRC/P/GCp277xta2Nr3WOYqg3rm9sz/sXWqFZ+9NCELlrjjLtpvMaVq0dUK4OUmozm9gaAICYhikJik",
+	"wi13ozPEQrCI+BZCdvnPWeaKNZ7bIa5nqcdlD2te59Z2nnGn8sYVBNbj1PdZ/xxidqvnvk14qIRwJepg",
+	"oA6KZ+o7lIEQeBK4ET/lTG3Qh6/Pjk2VO+C+HhpgSa26gVjtls7ywkNRgZihqTcYdjr7+Mjb0htPJwqb",
+	"8pdO96AQqj82p3DraqmRZBNDnMDNWx4D/9H4v3uWYk7IUCLMURmutWJxplZTXKymyATucgKLNaqylyVa",
+	"7LFH7k9KICpI8JPuTr9Xn2Op77zKKND+CS7Ur4bwINeB01IcXanDSU7JHzlQEAJFjArJMVEtMHPcV55t",
+	"qs+Dty/QmEAaC0SUj/WUCUFUpA4t4xWBh+rSgBec2oGCpeRklEsQm2gvTW2kwMA1d2Ejb6VBY638d2vH",
+	"htNUzZTFmXDVyCglcmZCp0ngGaGAEqZjqSWYximU4Y1EwbSKeTO4sFAT4U+OG1lBIxEnEjjBBeA4jsvw",
+	"BlVEaOoa5zLnWiCyBKUkatWSZhuMIlxIwz+aW/2aWb7q2SyEveKovT53Xt3dmWn7HgxSyx7nKp4XZNS6",
+	"Wbuxm1PTHeVyw2mugZPxrJ3VhMNuG8YXJThNgU4A6VYsxhoz/l534XHIpTmtaWl1zLawXd36aruqSatt",
+	"vmBtJwxTf6kTRlF9Xth7/5DRTyBoDQuqIwYFHdAWE/wXFSMLZJeSpL6SCJ4Y+s+BZuJuCjCdmVOEk/KM",
+	"slftZbZxhfaZtuf1ThkcYRtWA7GxY6nlCbB6Iin3yMbGZ8DwmehGIWOWUn2oA0a1ti1TA9CWz0ZvTmd9",
+	"+irUnH17U78ZTW1XnWN6/oFuBPuyND1EKeBrtRWUbzXCWW70J6oHrw2s93+6UWZHKtdICXTNpl19r03I",
+	"1dfuS1r9YmgMhV3sfy1HRCkmWTMRU2mMABkmaUvrRWXFd/U+B0orQ8aGhCJM//f/+v+0HKS7UWHGrBU6",
+	"B0SEeev6UDssByH840chYuEi7kmIA6gr1zLliliWlergyaotY5dYtrbSRe6f5ZY0vFG4dzIfOjKClJU/",
+	"iJJhZgrlcCuBWplFmTkz7yxoWms9z5lxKkwcmF7WJIcYMLx+WiWRReI3mzGYiSzh73NCs5fMviW4LwFU",
+	"UWUuIn3T6eXozm8B/QajhLGrpYUXO4IpB22P2i6/KBXwqa1VEppVsxJqYn3r1YpRhLmnMrDHEs09tAOX",
+	"WsGmnQzTHKfpTK9bK+Afvj7bROdGmTsCblS5ufB6f8l4ZlrjIBR14jgmxhIQEWquChVuJNPeiRwiUApi",
+	"Qqe5ycoxbMA40leOJWB2XBrceLPetXqLU6ET9hB1F5cB1decDGEH2LUGzLWnekUj0Ik8dJsIqCQc0pne",
+	"SxIpp+L51pbANB6x200zK5uEbeHpdAtPyUbMIvHfVGasAzIhEqcb+5iDUhslopi8LT1zwyDZuREsR3KV",
+	"8a+O5tiE40yTXN66XlSsElPxHU+Xg17HDzFtINPIKuAWvQEXdwZb3BVmcyu0dQOjLWuH4oIfBvXI2kqi",
+	"vE2y2qvKFUpnIhr1/enByza/i6CVd3mD2W6t3/NKuLzbWEFjHFSNSEJ8KdkV0IXa/LjUzBfoXzocn2oO",
+	"opwTOdMYF6D9DS/0AJ7//lEBpkTSsJGHas1aFgyGg5yng+cDx6Lg1vS06VUqo3YyPgmYj085i/Mo2Bye",
+	"knlfx3C90/hOFW7GcD3v4z9w89s/sP4UUjbVaRHnNrEbaGK3o4mPxYQ1/O0xxRMoDXf0D0yFrzcUmyXx",
+	"ufn+NmxridExsRuejf1mwxxGNvzMEIkEK3JUu7RxDwUZ+X34TQR62js9ElpNqoVDo2m2AqfalpUPlht9",
+	"2WhBns32TvNRSqJChhCF9DCaGX2I14x+Vofb/38AlL/jrsq+AgA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,31 +1,43 @@
 package api
 
 import (
+	"github.com/tidepool-org/clinic/apikeys"
+	"github.com/tidepool-org/clinic/audit"
 	"github.com/tidepool-org/clinic/auth"
 	"github.com/tidepool-org/clinic/clinicians"
 	"github.com/tidepool-org/clinic/clinics"
 	"github.com/tidepool-org/clinic/clinics/manager"
 	"github.com/tidepool-org/clinic/clinics/merge"
 	"github.com/tidepool-org/clinic/clinics/migration"
+	"github.com/tidepool-org/clinic/ehr"
 	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/patients/duplicates"
 	"github.com/tidepool-org/clinic/redox"
 	"github.com/tidepool-org/clinic/store"
 	"github.com/tidepool-org/clinic/xealth"
 	"go.uber.org/fx"
+	"go.uber.org/zap"
 )
 
 type Handler struct {
 	fx.In
 
+	APIKeys                     apikeys.Service
+	AuditEvents                 audit.Repository
+	Authorizer                  auth.RequestAuthorizer
 	ClinicMergePlanExecutor     merge.ClinicPlanExecutor
 	Clinics                     clinics.Service
 	ClinicsManager              manager.Manager
 	ClinicsMigrator             migration.Migrator
 	Clinicians                  clinicians.Service
+	EHRProviders                *ehr.Registry
+	Logger                      *zap.SugaredLogger
 	Patients                    patients.Service
+	PatientDuplicates           *duplicates.Scanner
 	Redox                       redox.Redox
 	Xealth                      xealth.Xealth
 	ServiceAccountAuthenticator *auth.ServiceAccountAuthenticator
+	TokenCache                  auth.TokenCache
 	Users                       patients.UserService
 }
 
@@ -2,15 +2,26 @@ package api
 
 import (
 	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+	"github.com/tidepool-org/clinic/auth"
 	"net/http"
 )
 
 type HealthCheck struct {
-	ready bool
+	ready    bool
+	policies *auth.PolicyStore
 }
 
-func NewHealthCheck() *HealthCheck {
-	return &HealthCheck{}
+type Status struct {
+	Ready               bool              `json:"ready"`
+	AuthorizationPolicy auth.PolicyStatus `json:"authorizationPolicy"`
+}
+
+func NewHealthCheck(policies *auth.PolicyStore) *HealthCheck {
+	return &HealthCheck{
+		policies: policies,
+	}
 }
 
 func (h *HealthCheck) SetReady(ready bool) {
@@ -25,3 +36,39 @@ func (h *HealthCheck) Ready(c echo.Context) error {
 
 	return c.NoContent(http.StatusOK)
 }
+
+// Status returns the readiness and the active authorization policy. The last policy error is only returned to
+// backend services.
+func (h *HealthCheck) Status(c echo.Context) error {
+	policy := h.policies.Status()
+	if !auth.IsServerAuth(auth.GetAuthData(c.Request().Context())) {
+		policy.LastError = ""
+		policy.LastErrorTime = nil
+	}
+
+	return c.JSON(http.StatusOK, Status{
+		Ready:               h.ready,
+		AuthorizationPolicy: policy,
+	})
+}
+
+// Metrics exposes the metrics registered with the default prometheus registry
+func (h *HealthCheck) Metrics(c echo.Context) error {
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		return err
+	}
+
+	format := expfmt.NewFormat(expfmt.TypeTextPlain)
+	c.Response().Header().Set(echo.HeaderContentType, string(format))
+	c.Response().WriteHeader(http.StatusOK)
+
+	encoder := expfmt.NewEncoder(c.Response(), format)
+	for _, family := range families {
+		if err := encoder.Encode(family); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -17,20 +17,27 @@ import (
 	"go.uber.org/fx/fxevent"
 	"go.uber.org/zap"
 
+	apiKeysRepository "github.com/tidepool-org/clinic/apikeys/repository"
+	apiKeysService "github.com/tidepool-org/clinic/apikeys/service"
+	auditRepository "github.com/tidepool-org/clinic/audit/repository"
 	"github.com/tidepool-org/clinic/auth"
 	cliniciansRepository "github.com/tidepool-org/clinic/clinicians/repository"
 	cliniciansService "github.com/tidepool-org/clinic/clinicians/service"
 	"github.com/tidepool-org/clinic/clinics"
 	"github.com/tidepool-org/clinic/clinics/manager"
+	"github.com/tidepool-org/clinic/clinics/merge"
 	"github.com/tidepool-org/clinic/clinics/migration"
 	clinicsRepository "github.com/tidepool-org/clinic/clinics/repository"
 	clinicsService "github.com/tidepool-org/clinic/clinics/service"
 	"github.com/tidepool-org/clinic/config"
+	"github.com/tidepool-org/clinic/ehr"
 	"github.com/tidepool-org/clinic/errors"
 	"github.com/tidepool-org/clinic/logger"
 	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/patients/duplicates"
 	patientsRepository "github.com/tidepool-org/clinic/patients/repository"
 	patientsService "github.com/tidepool-org/clinic/patients/service"
+	"github.com/tidepool-org/clinic/ratelimit"
 	"github.com/tidepool-org/clinic/redox"
 	"github.com/tidepool-org/clinic/store"
 	"github.com/tidepool-org/clinic/xealth"
@@ -42,8 +49,36 @@ import (
 var (
 	ServerString        = ":8080"
 	ServerTimeoutAmount = 20
+	// MetricsServerString is the internal address of the metrics server, which is not exposed by the load balancers
+	MetricsServerString = ":8081"
 )
 
+// MetricsServer serves the prometheus metrics separately from the api, so they are not publicly accessible
+type MetricsServer struct {
+	*echo.Echo
+}
+
+func NewMetricsServer(healthCheck *HealthCheck) *MetricsServer {
+	e := echo.New()
+	return &MetricsServer{Echo: e}
+}
+
+func StartMetricsServer(server *MetricsServer, lifecycle fx.Lifecycle) {
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go func() {
+				if err := server.Start(MetricsServerString); err != nil {
+					fmt.Println(err)
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return server.Shutdown(ctx)
+		},
+	})
+}
+
 func Start(e *echo.Echo, lifecycle fx.Lifecycle) {
 	lifecycle.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
@@ -77,8 +112,15 @@ func SetReady(healthCheck *HealthCheck, db *mongo.Database, lifecycle fx.Lifecyc
 	})
 }
 
-func NewServer(handler Handler, healthCheck *HealthCheck, authorizer auth.RequestAuthorizer, authenticator auth.Authenticator, logger *zap.Logger) (*echo.Echo, error) {
+// StartWorkers makes sure background workers are instantiated and their lifecycle hooks are registered
+func StartWorkers(_ *xealth.OrderRetryWorker, _ *xealth.AgeTransitionWorker, _ *merge.Worker, _ *duplicates.Worker) {
+}
+
+func NewServer(handler Handler, healthCheck *HealthCheck, authorizer auth.RequestAuthorizer, authenticator auth.Authenticator, accessConfig auth.AccessConfig, limiter ratelimit.Limiter, rateLimitConfig ratelimit.Config, logger *zap.Logger) (*echo.Echo, error) {
 	e := echo.New()
+	// Trust the X-Forwarded-For header only when it's set by the configured load balancers, because the client ip
+	// is used to enforce the network restrictions of clinics
+	e.IPExtractor = auth.NewIPExtractor(accessConfig)
 	logger.Info("Starting Main Loop")
 	swagger, err := GetSwagger()
 	if err != nil {
@@ -88,7 +130,7 @@ func NewServer(handler Handler, healthCheck *HealthCheck, authorizer auth.Reques
 	// Do not validate servers in the open api spec
 	swagger.Servers = nil
 
-	healthcheckRoutes := []string{"/ready", "/metrics"}
+	healthcheckRoutes := []string{"/ready", "/status"}
 	redoxRoutes := []string{"/v1/redox", "/v1/redox/verify"}
 	xealthRoutes := []string{"/v1/xealth/preorder", "/v1/xealth/notification", "/v1/xealth/programs", "/v1/xealth/program"}
 	externalRoutes := append(append(healthcheckRoutes, redoxRoutes...), xealthRoutes...)
@@ -125,6 +167,7 @@ func NewServer(handler Handler, healthCheck *HealthCheck, authorizer auth.Reques
 		}
 	})
 	e.Use(authMiddleware)
+	e.Use(ratelimit.NewMiddleware(limiter, rateLimitConfig, logger.Sugar()))
 	e.Use(requestValidator)
 
 	pdf := os.DirFS("../pdf")
@@ -141,6 +184,9 @@ func NewServer(handler Handler, healthCheck *HealthCheck, authorizer auth.Reques
 
 	e.HTTPErrorHandler = errors.CustomHTTPErrorHandler
 	e.GET("/ready", healthCheck.Ready)
+	// The status is public, but the details of policy errors are only returned to backend services
+	e.GET("/status", healthCheck.Status, auth.NewAuthMiddleware(authenticator, auth.AuthMiddlewareOpts{Optional: true}))
+	e.GET("/metrics", healthCheck.Metrics)
 	RegisterHandlers(e, &handler)
 
 	return e, nil
@@ -158,12 +204,23 @@ func Dependencies() []fx.Option {
 			patientsRepository.NewRepository,
 			patientsService.NewCustodialService,
 			patientsService.NewService,
+			duplicates.NewConfig,
+			duplicates.NewScanner,
+			duplicates.NewWorker,
 			redox.NewConfig,
 			redox.NewHandler,
 			xealth.NewStore,
 			xealth.NewHandler,
+			merge.NewConfig,
+			merge.NewWorker,
+			xealth.NewOrderRetryWorker,
+			xealth.NewAgeTransitionWorker,
+			ehr.NewRegistry,
 			cliniciansRepository.NewRepository,
 			cliniciansService.NewService,
+			apiKeysRepository.NewRepository,
+			apiKeysService.NewService,
+			auditRepository.NewRepository,
 			clinicsRepository.NewRepository,
 			clinicsService.NewService,
 			clinics.NewShareCodeGenerator,
@@ -174,20 +231,34 @@ func Dependencies() []fx.Option {
 			authClient.NewExternalEnvconfigLoader,
 			platform.NewEnvconfigLoader,
 			client.NewEnvconfigLoader,
+			auth.NewOIDCConfig,
+			auth.NewCacheConfig,
 			auth.NewAuthenticator,
 			auth.NewServiceAccountAuthenticator,
+			auth.NewPolicyConfig,
+			auth.NewPolicyStore,
+			auth.NewDecisionLogConfig,
+			auth.NewLoggerDecisionSink,
+			auth.NewDecisionLogger,
+			auth.NewAccessConfig,
+			auth.NewAccessRestrictionEnforcer,
 			auth.NewRequestAuthorizer,
+			ratelimit.NewConfig,
+			ratelimit.NewMemoryLimiter,
 			NewHealthCheck,
 			NewServer,
+			NewMetricsServer,
 		),
 		fx.WithLogger(func(log *zap.Logger) fxevent.Logger {
 			return &fxevent.ZapLogger{Logger: log}
 		}),
 		patients.UserServiceModule,
+		redox.ProviderModule,
+		xealth.ProviderModule,
 	}
 }
 
 func MainLoop() {
-	app := append(Dependencies(), fx.Invoke(SetReady), fx.Invoke(Start))
+	app := append(Dependencies(), fx.Invoke(SetReady), fx.Invoke(Start), fx.Invoke(StartMetricsServer), fx.Invoke(StartWorkers))
 	fx.New(app...).Run()
 }
@@ -2,7 +2,9 @@ package api
 
 import (
 	"fmt"
+	"maps"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -10,13 +12,19 @@ import (
 	"github.com/oapi-codegen/runtime/types"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
+	"github.com/tidepool-org/clinic/apikeys"
+	"github.com/tidepool-org/clinic/audit"
+	"github.com/tidepool-org/clinic/auth"
 	"github.com/tidepool-org/clinic/clinicians"
 	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/clinics/merge"
 	"github.com/tidepool-org/clinic/clinics/migration"
 	"github.com/tidepool-org/clinic/errors"
 	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/patients/duplicates"
 	"github.com/tidepool-org/clinic/sites"
 	"github.com/tidepool-org/clinic/store"
+	"github.com/tidepool-org/clinic/xealth"
 )
 
 func NewClinicWithDefaults(c ClinicV1) *clinics.Clinic {
@@ -114,10 +122,17 @@ func NewClinicDto(c *clinics.Clinic) ClinicV1 {
 	if c.Sites != nil {
 		sites := make([]SiteV1, 0, len(c.Sites))
 		for _, site := range c.Sites {
+			var parentId *string
+			if site.ParentId != nil {
+				parentId = strp(site.ParentId.Hex())
+			}
 			sites = append(sites, SiteV1{
-				Id:          site.Id.Hex(),
-				Name:        site.Name,
-				NumPatients: site.Patients,
+				Id:            site.Id.Hex(),
+				Name:          site.Name,
+				NumPatients:   site.Patients,
+				ParentId:      parentId,
+				TotalPatients: site.TotalPatients,
+				Settings:      NewSiteSettingsDto(site.Settings),
 			})
 		}
 		dto.Sites = sites
@@ -148,6 +163,9 @@ func NewClinicianDto(clinician *clinicians.Clinician) ClinicianV1 {
 		CreatedTime: &clinician.CreatedTime,
 		UpdatedTime: &clinician.UpdatedTime,
 	}
+	if clinician.Sites != nil {
+		dto.Sites = NewSitesDto(clinician.Sites)
+	}
 	return dto
 }
 
@@ -861,17 +879,37 @@ func NewMigrationDto(migration *migration.Migration) *MigrationV1 {
 	}
 
 	result := &MigrationV1{
-		CreatedTime: &migration.CreatedTime,
-		UpdatedTime: &migration.UpdatedTime,
-		UserId:      migration.UserId,
+		CreatedTime:      &migration.CreatedTime,
+		UpdatedTime:      &migration.UpdatedTime,
+		UserId:           migration.UserId,
+		PatientsMigrated: &migration.PatientsMigrated,
+		PatientsFailed:   &migration.PatientsFailed,
+	}
+	if migration.ClinicId != nil {
+		result.ClinicId = strp(migration.ClinicId.Hex())
 	}
 	if migration.Status != "" {
 		status := MigrationStatusV1(strings.ToUpper(migration.Status))
 		result.Status = &status
 	}
+	if migration.Error != "" {
+		result.Error = &migration.Error
+	}
 	return result
 }
 
+func NewMigrationUpdate(dto MigrationUpdateV1) migration.Update {
+	update := migration.Update{
+		Status:           string(dto.Status),
+		PatientsMigrated: dto.PatientsMigrated,
+		PatientsFailed:   dto.PatientsFailed,
+	}
+	if dto.Error != nil {
+		update.Error = *dto.Error
+	}
+	return update
+}
+
 func NewMigrationDtos(migrations []*migration.Migration) []*MigrationV1 {
 	var dtos []*MigrationV1
 	if len(migrations) == 0 {
@@ -918,6 +956,456 @@ func NewMembershipRestrictions(dto MembershipRestrictionsV1) []clinics.Membershi
 	return restrictions
 }
 
+func NewAccessRestrictionsDto(restrictions *clinics.AccessRestrictions) AccessRestrictionsV1 {
+	allowedNetworks := restrictions.AllowedNetworks
+	if allowedNetworks == nil {
+		allowedNetworks = []string{}
+	}
+	maxAuthenticationAgeSeconds := int(restrictions.MaxAuthenticationAge / time.Second)
+	requireMfa := restrictions.RequireMFA
+
+	return AccessRestrictionsV1{
+		AllowedNetworks:             &allowedNetworks,
+		MaxAuthenticationAgeSeconds: &maxAuthenticationAgeSeconds,
+		RequireMfa:                  &requireMfa,
+	}
+}
+
+func NewAccessRestrictions(dto AccessRestrictionsV1) *clinics.AccessRestrictions {
+	restrictions := &clinics.AccessRestrictions{}
+	if dto.AllowedNetworks != nil {
+		restrictions.AllowedNetworks = *dto.AllowedNetworks
+	}
+	if dto.RequireMfa != nil {
+		restrictions.RequireMFA = *dto.RequireMfa
+	}
+	if dto.MaxAuthenticationAgeSeconds != nil {
+		restrictions.MaxAuthenticationAge = time.Duration(*dto.MaxAuthenticationAgeSeconds) * time.Second
+	}
+
+	return restrictions
+}
+
+func NewAuditEventsDto(events []*audit.Event) AuditEventsV1 {
+	dtos := make(AuditEventsV1, 0, len(events))
+	for _, event := range events {
+		dto := AuditEventV1{
+			Id:          event.Id.Hex(),
+			Type:        event.Type,
+			SubjectId:   event.SubjectId,
+			CreatedTime: event.CreatedTime,
+		}
+		if event.Method != "" {
+			dto.Method = strp(event.Method)
+		}
+		if event.Path != "" {
+			dto.Path = strp(event.Path)
+		}
+		if event.ClientIP != "" {
+			dto.ClientIp = strp(event.ClientIP)
+		}
+		if event.Reason != "" {
+			dto.Reason = strp(event.Reason)
+		}
+		if len(event.Details) > 0 {
+			details := event.Details
+			dto.Details = &details
+		}
+		dtos = append(dtos, dto)
+	}
+	return dtos
+}
+
+func NewClinicMergeJobDto(job *merge.Job) ClinicMergeJobV1 {
+	dto := ClinicMergeJobV1{
+		PlanId:         job.Id.Hex(),
+		SourceClinicId: strp(job.SourceClinicId.Hex()),
+		TargetClinicId: strp(job.TargetClinicId.Hex()),
+		Status:         ClinicMergeJobV1Status(job.Status),
+		Attempts:       job.Attempts,
+		LastError:      job.LastError,
+		Phases:         make([]ClinicMergePhaseV1, 0, len(job.Phases)),
+		CreatedTime:    job.CreatedTime,
+		ModifiedTime:   job.ModifiedTime,
+		CompletedTime:  job.CompletedTime,
+		RolledBackTime: job.RolledBackTime,
+	}
+	if job.ConsolidationId != nil {
+		dto.ConsolidationId = strp(job.ConsolidationId.Hex())
+	}
+	for _, phase := range job.Phases {
+		dto.Phases = append(dto.Phases, ClinicMergePhaseV1{
+			Type:      ClinicMergePhaseV1Type(phase.Type),
+			Total:     phase.Total,
+			Completed: phase.Completed,
+			Reverted:  phase.Reverted,
+		})
+	}
+	return dto
+}
+
+func NewClinicConsolidationDto(consolidation *merge.Consolidation) ClinicConsolidationV1 {
+	dto := ClinicConsolidationV1{
+		ConsolidationId: consolidation.Id.Hex(),
+		TargetClinicId:  strp(consolidation.TargetClinicId.Hex()),
+		Status:          ClinicConsolidationV1Status(consolidation.Status()),
+		Merges:          make([]ClinicMergeJobV1, 0, len(consolidation.Jobs)),
+	}
+	for _, job := range consolidation.Jobs {
+		dto.Merges = append(dto.Merges, NewClinicMergeJobDto(&job))
+	}
+	return dto
+}
+
+func NewConsolidationOptions(dto ConsolidateClinicsV1) merge.ConsolidationOptions {
+	return merge.ConsolidationOptions{
+		SourceSites: dto.SourceSites != nil && *dto.SourceSites,
+	}
+}
+
+func NewSplitSelection(dto SplitClinicV1) merge.SplitSelection {
+	selection := merge.SplitSelection{
+		SiteId:       dto.SiteId,
+		TagId:        dto.PatientTagId,
+		ClinicianIds: dto.ClinicianIds,
+	}
+	if dto.PatientIds != nil {
+		selection.PatientIds = *dto.PatientIds
+	}
+	return selection
+}
+
+func NewClinicMergeRollbackReportDto(report *merge.RollbackReport) ClinicMergeRollbackReportV1 {
+	return ClinicMergeRollbackReportV1{
+		PlanId:      report.PlanId.Hex(),
+		CanRollback: report.CanRollback(),
+		Conflicts:   NewClinicMergeRollbackIssuesDto(report.Conflicts),
+		Warnings:    NewClinicMergeRollbackIssuesDto(report.Warnings),
+	}
+}
+
+func NewClinicMergeRollbackIssuesDto(issues []merge.RollbackIssue) []ClinicMergeRollbackIssueV1 {
+	dtos := make([]ClinicMergeRollbackIssueV1, 0, len(issues))
+	for _, issue := range issues {
+		dtos = append(dtos, ClinicMergeRollbackIssueV1{
+			Type:    ClinicMergeRollbackIssueV1Type(issue.Type),
+			Id:      issue.Id,
+			Message: issue.Message,
+		})
+	}
+	return dtos
+}
+
+func NewClinicMergeDuplicatesDto(clusters []merge.DuplicateCluster) ClinicMergeDuplicatesV1 {
+	dtos := make(ClinicMergeDuplicatesV1, 0, len(clusters))
+	for _, cluster := range clusters {
+		dtos = append(dtos, NewClinicMergeDuplicateDto(cluster))
+	}
+	return dtos
+}
+
+func NewClinicMergeDuplicateDto(cluster merge.DuplicateCluster) ClinicMergeDuplicateV1 {
+	dto := ClinicMergeDuplicateV1{
+		Id:            cluster.Id.Hex(),
+		SourcePatient: NewPatientDto(cluster.Plan.SourcePatient),
+		Duplicates:    make([]ClinicMergeDuplicatePatientV1, 0),
+	}
+
+	// A target patient can be a duplicate in more than one category
+	duplicates := map[string]*ClinicMergeDuplicatePatientV1{}
+	var userIds []string
+	for category, conflicts := range cluster.Plan.Conflicts {
+		for _, conflict := range conflicts {
+			userId := *conflict.Patient.UserId
+			if _, ok := duplicates[userId]; !ok {
+				duplicates[userId] = &ClinicMergeDuplicatePatientV1{
+					Patient:            NewPatientDto(&conflict.Patient),
+					ConflictCategories: make([]ClinicMergeDuplicatePatientV1ConflictCategories, 0),
+				}
+				userIds = append(userIds, userId)
+			}
+			duplicates[userId].ConflictCategories = append(duplicates[userId].ConflictCategories, ClinicMergeDuplicatePatientV1ConflictCategories(category))
+		}
+	}
+	slices.Sort(userIds)
+	for _, userId := range userIds {
+		slices.Sort(duplicates[userId].ConflictCategories)
+		dto.Duplicates = append(dto.Duplicates, *duplicates[userId])
+	}
+
+	if resolution := cluster.Plan.Resolution; resolution != nil {
+		dto.Resolution = &ClinicMergeDuplicateResolutionV1{
+			Type:         ClinicMergeDuplicateResolutionV1Type(resolution.Type),
+			TargetUserId: resolution.TargetUserId,
+			ResolvedTime: &resolution.ResolvedTime,
+		}
+	}
+
+	return dto
+}
+
+func NewDuplicateResolution(dto ClinicMergeDuplicateResolutionV1) merge.DuplicateResolution {
+	return merge.DuplicateResolution{
+		Type:         string(dto.Type),
+		TargetUserId: dto.TargetUserId,
+	}
+}
+
+func NewPatientDuplicateScanDto(scan *duplicates.Scan) PatientDuplicateScanV1 {
+	dto := PatientDuplicateScanV1{
+		ClinicId:     strp(scan.ClinicId.Hex()),
+		PatientCount: scan.PatientCount,
+		Clusters:     make([]PatientDuplicateClusterV1, 0, len(scan.Clusters)),
+		NextScanTime: &scan.NextScanTime,
+	}
+	if scan.ScanTime != nil {
+		dto.ScanTime = *scan.ScanTime
+	}
+
+	for _, cluster := range scan.Clusters {
+		c := PatientDuplicateClusterV1{
+			Patients: make([]PatientDuplicateV1, 0, len(cluster.Patients)),
+		}
+		for _, patient := range cluster.Patients {
+			p := PatientDuplicateV1{
+				Id:          strp(patient.UserId),
+				FullName:    patient.FullName,
+				Mrn:         patient.Mrn,
+				Email:       patient.Email,
+				IsCustodial: patient.IsCustodial,
+				BirthDate:   strtodatep(patient.BirthDate),
+				Duplicates:  make([]PatientDuplicateMatchV1, 0),
+			}
+			categories := slices.Sorted(maps.Keys(patient.Duplicates))
+			for _, category := range categories {
+				for _, userId := range patient.Duplicates[category] {
+					p.Duplicates = append(p.Duplicates, PatientDuplicateMatchV1{
+						Id:               strp(userId),
+						ConflictCategory: PatientDuplicateMatchV1ConflictCategory(category),
+					})
+				}
+			}
+			c.Patients = append(c.Patients, p)
+		}
+		dto.Clusters = append(dto.Clusters, c)
+	}
+
+	return dto
+}
+
+func NewClinicMergeReportDto(data merge.ReportData) ClinicMergeReportV1 {
+	dto := ClinicMergeReportV1{
+		CreatedTime: data.CreatedTime,
+		Source:      NewClinicMergeReportClinicDto(data.Source),
+		Target:      NewClinicMergeReportClinicDto(data.Target),
+		CanMerge:    data.CanMerge,
+		Errors:      nonNilSlice(data.Errors),
+		Measures: ClinicMergeReportMeasuresV1{
+			Clinicians:              data.Measures.Clinicians,
+			DowngradedClinicians:    data.Measures.DowngradedClinicians,
+			Tags:                    data.Measures.Tags,
+			DuplicateTags:           data.Measures.DuplicateTags,
+			Sites:                   data.Measures.Sites,
+			RenamedSites:            data.Measures.RenamedSites,
+			Patients:                data.Measures.Patients,
+			DuplicateAccounts:       data.Measures.DuplicateAccounts,
+			LikelyDuplicateAccounts: data.Measures.LikelyDuplicates,
+			MrnOnlyMatches:          data.Measures.MRNOnlyMatches,
+			NameOnlyMatches:         data.Measures.NameOnlyMatches,
+		},
+		Settings:       make([]ClinicMergeReportSettingV1, 0, len(data.Settings)),
+		Clinicians:     make([]ClinicMergeReportClinicianV1, 0, len(data.Clinicians)),
+		PendingInvites: make([]ClinicMergeReportPendingInvitesV1, 0, len(data.PendingInvites)),
+		Tags:           make([]ClinicMergeReportTagV1, 0, len(data.Tags)),
+		Sites:          make([]ClinicMergeReportSiteV1, 0, len(data.Sites)),
+		Patients:       make([]ClinicMergeReportPatientPlanV1, 0, len(data.Patients)),
+		SourceClusters: NewClinicMergeReportClustersDto(data.SourceClusters),
+		TargetClusters: NewClinicMergeReportClustersDto(data.TargetClusters),
+	}
+
+	for _, setting := range data.Settings {
+		dto.Settings = append(dto.Settings, ClinicMergeReportSettingV1{
+			Name:        setting.Name,
+			SourceValue: setting.SourceValue,
+			TargetValue: setting.TargetValue,
+			Match:       setting.Match,
+		})
+	}
+	for _, clinician := range data.Clinicians {
+		dto.Clinicians = append(dto.Clinicians, ClinicMergeReportClinicianV1{
+			Name:       clinician.Name,
+			Email:      clinician.Email,
+			Workspaces: nonNilSlice(clinician.Workspaces),
+			Admin:      clinician.Admin,
+			Downgraded: clinician.Downgraded,
+		})
+	}
+	for _, invites := range data.PendingInvites {
+		dto.PendingInvites = append(dto.PendingInvites, ClinicMergeReportPendingInvitesV1{
+			Workspace: invites.Workspace,
+			Count:     invites.Count,
+		})
+	}
+	for _, tag := range data.Tags {
+		dto.Tags = append(dto.Tags, ClinicMergeReportTagV1{
+			Name:       tag.Name,
+			Workspaces: nonNilSlice(tag.Workspaces),
+			Merge:      tag.Merge,
+		})
+	}
+	for _, site := range data.Sites {
+		dto.Sites = append(dto.Sites, ClinicMergeReportSiteV1{
+			Name:      site.Name,
+			Workspace: site.Workspace,
+			Action:    ClinicMergeReportSiteV1Action(site.Action),
+		})
+	}
+	for _, plan := range data.Patients {
+		p := ClinicMergeReportPatientPlanV1{
+			Action:    plan.Action,
+			Workspace: plan.Workspace,
+			Patient:   NewClinicMergeReportPatientDto(plan.Patient),
+			Errors:    nonNilSlice(plan.Errors),
+			Conflicts: make([]ClinicMergeReportConflictV1, 0, len(plan.Conflicts)),
+		}
+		if plan.Result != nil {
+			result := NewClinicMergeReportPatientDto(*plan.Result)
+			p.Result = &result
+		}
+		for _, conflict := range plan.Conflicts {
+			p.Conflicts = append(p.Conflicts, ClinicMergeReportConflictV1{
+				Category: ClinicMergeReportConflictV1Category(conflict.Category),
+				Patient:  NewClinicMergeReportPatientDto(conflict.Patient),
+			})
+		}
+		dto.Patients = append(dto.Patients, p)
+	}
+
+	return dto
+}
+
+func NewClinicMergeReportClinicDto(clinic merge.ReportClinic) ClinicMergeReportClinicV1 {
+	dto := ClinicMergeReportClinicV1{
+		Name: clinic.Name,
+	}
+	if clinic.Id != "" {
+		dto.Id = strp(clinic.Id)
+	}
+	return dto
+}
+
+func NewClinicMergeReportClustersDto(clusters []merge.ReportCluster) []ClinicMergeReportClusterV1 {
+	dtos := make([]ClinicMergeReportClusterV1, 0, len(clusters))
+	for _, cluster := range clusters {
+		dto := ClinicMergeReportClusterV1{
+			Patients: make([]ClinicMergeReportClusterPatientV1, 0, len(cluster.Patients)),
+		}
+		for _, patient := range cluster.Patients {
+			dto.Patients = append(dto.Patients, ClinicMergeReportClusterPatientV1{
+				Patient:          NewClinicMergeReportPatientDto(patient.ReportPatient),
+				LikelyDuplicates: nonNilSlice(patient.LikelyDuplicates),
+				NameOnlyMatches:  nonNilSlice(patient.NameOnlyMatches),
+				MrnOnlyMatches:   nonNilSlice(patient.MRNOnlyMatches),
+			})
+		}
+		dtos = append(dtos, dto)
+	}
+	return dtos
+}
+
+func NewClinicMergeReportPatientDto(patient merge.ReportPatient) ClinicMergeReportPatientV1 {
+	return ClinicMergeReportPatientV1{
+		Id:             strp(patient.UserId),
+		FullName:       patient.FullName,
+		BirthDate:      patient.BirthDate,
+		Mrn:            patient.Mrn,
+		Claimed:        patient.Claimed,
+		Tags:           nonNilSlice(patient.Tags),
+		Sites:          nonNilSlice(patient.Sites),
+		LastUploadTime: patient.LastUploadTime,
+	}
+}
+
+func NewClinicianRoleListDto(roles []clinicians.Role) ClinicianRoleListV1 {
+	dto := ClinicianRoleListV1{
+		Roles: make([]ClinicianRoleV1, 0, len(roles)),
+	}
+	for _, role := range roles {
+		permissions := make([]ClinicianRoleV1Permissions, 0, len(role.Permissions))
+		for _, permission := range role.Permissions {
+			permissions = append(permissions, ClinicianRoleV1Permissions(permission))
+		}
+		builtIn := clinicians.IsBuiltInRole(role.Name)
+		dto.Roles = append(dto.Roles, ClinicianRoleV1{
+			Name:        role.Name,
+			Permissions: permissions,
+			BuiltIn:     &builtIn,
+		})
+	}
+
+	return dto
+}
+
+func NewAuthorizationDecisionDto(decision *auth.Decision) AuthorizationDecisionV1 {
+	dto := AuthorizationDecisionV1{
+		Allow:        decision.Allow,
+		Method:       decision.Method,
+		Path:         decision.Path,
+		ServerAccess: decision.ServerAccess,
+		PolicyHash:   &decision.PolicyHash,
+		MatchedRules: make([]AuthorizationPolicyRuleV1, 0, len(decision.MatchedRules)),
+		FailedRules:  make([]AuthorizationPolicyRuleFailureV1, 0, len(decision.FailedRules)),
+	}
+	if decision.SubjectId != "" {
+		dto.SubjectId = &decision.SubjectId
+	}
+	if decision.Restriction != "" {
+		dto.Restriction = &decision.Restriction
+	}
+	for _, rule := range decision.MatchedRules {
+		dto.MatchedRules = append(dto.MatchedRules, NewAuthorizationPolicyRuleDto(rule))
+	}
+	for _, failure := range decision.FailedRules {
+		expressions := failure.Expressions
+		if expressions == nil {
+			expressions = []string{}
+		}
+		dto.FailedRules = append(dto.FailedRules, AuthorizationPolicyRuleFailureV1{
+			Rule:        NewAuthorizationPolicyRuleDto(failure.Rule),
+			Expressions: expressions,
+		})
+	}
+
+	return dto
+}
+
+func NewAuthorizationPolicyRuleDto(rule auth.PolicyRule) AuthorizationPolicyRuleV1 {
+	return AuthorizationPolicyRuleV1{
+		Line:        rule.Line,
+		Description: rule.Description,
+	}
+}
+
+// NewCustomClinicianRoles returns the custom roles from the dto. Built-in roles are ignored.
+func NewCustomClinicianRoles(dto ClinicianRoleListV1) []clinicians.Role {
+	roles := make([]clinicians.Role, 0, len(dto.Roles))
+	for _, r := range dto.Roles {
+		if clinicians.IsBuiltInRole(r.Name) {
+			continue
+		}
+		permissions := make([]string, 0, len(r.Permissions))
+		for _, permission := range r.Permissions {
+			permissions = append(permissions, string(permission))
+		}
+		roles = append(roles, clinicians.Role{
+			Name:        r.Name,
+			Permissions: permissions,
+		})
+	}
+
+	return roles
+}
+
 func NewEHRSettings(dto EhrSettingsV1) *clinics.EHRSettings {
 	settings := &clinics.EHRSettings{
 		Enabled:  dto.Enabled,
@@ -1579,6 +2067,14 @@ func isSortAttributeValid(attribute string, t string) bool {
 
 const dateFormat = "2006-01-02"
 
+// nonNilSlice returns an empty slice instead of nil, so it's serialized as an empty array
+func nonNilSlice[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
 func strtodatep(s *string) *types.Date {
 	if s == nil {
 		return nil
@@ -1981,3 +2477,195 @@ func NewSite(site SiteV1) sites.Site {
 		Name: site.Name,
 	}
 }
+
+func NewSiteFromCreation(dto SiteCreationV1) (*sites.Site, error) {
+	site := sites.New(dto.Name)
+	if dto.ParentId != nil {
+		parentId, err := primitive.ObjectIDFromHex(*dto.ParentId)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid parent site id", errors.BadRequest)
+		}
+		site.ParentId = &parentId
+	}
+	return site, nil
+}
+
+func NewSiteSettings(dto SiteSettingsV1) *sites.Settings {
+	settings := &sites.Settings{}
+	if dto.Timezone != nil {
+		settings.Timezone = strp(string(*dto.Timezone))
+	}
+	if dto.PreferredBgUnits != nil {
+		settings.PreferredBgUnits = strp(string(*dto.PreferredBgUnits))
+	}
+	if dto.EhrDestinationIds != nil {
+		settings.EHRDestinationIds = &sites.EHRDestinationIds{
+			Flowsheet: dto.EhrDestinationIds.Flowsheet,
+			Notes:     dto.EhrDestinationIds.Notes,
+			Results:   dto.EhrDestinationIds.Results,
+		}
+	}
+	if dto.ScheduledReports != nil {
+		settings.ScheduledReports = &sites.ScheduledReports{
+			Cadence:         string(dto.ScheduledReports.Cadence),
+			OnUploadEnabled: dto.ScheduledReports.OnUploadEnabled,
+		}
+		if settings.ScheduledReports.OnUploadEnabled && dto.ScheduledReports.OnUploadNoteEventType != nil {
+			settings.ScheduledReports.OnUploadNoteEventType = strp(string(*dto.ScheduledReports.OnUploadNoteEventType))
+		}
+	}
+	return settings
+}
+
+func NewSiteSettingsDto(settings *sites.Settings) *SiteSettingsV1 {
+	if settings.IsEmpty() {
+		return nil
+	}
+
+	dto := &SiteSettingsV1{}
+	if settings.Timezone != nil {
+		timezone := ClinicTimezoneV1(*settings.Timezone)
+		dto.Timezone = &timezone
+	}
+	if settings.PreferredBgUnits != nil {
+		units := SiteSettingsV1PreferredBgUnits(*settings.PreferredBgUnits)
+		dto.PreferredBgUnits = &units
+	}
+	if settings.EHRDestinationIds != nil {
+		dto.EhrDestinationIds = &EhrDestinationsV1{
+			Flowsheet: settings.EHRDestinationIds.Flowsheet,
+			Notes:     settings.EHRDestinationIds.Notes,
+			Results:   settings.EHRDestinationIds.Results,
+		}
+	}
+	if settings.ScheduledReports != nil {
+		dto.ScheduledReports = &ScheduledReportsV1{
+			Cadence:         ScheduledReportsV1Cadence(settings.ScheduledReports.Cadence),
+			OnUploadEnabled: settings.ScheduledReports.OnUploadEnabled,
+		}
+		if settings.ScheduledReports.OnUploadNoteEventType != nil {
+			eventType := ScheduledReportsV1OnUploadNoteEventType(*settings.ScheduledReports.OnUploadNoteEventType)
+			dto.ScheduledReports.OnUploadNoteEventType = &eventType
+		}
+	}
+	return dto
+}
+
+func NewXealthReportViewsResponseDto(list *xealth.ReportViewList) XealthReportViewsResponseV1 {
+	data := make([]XealthReportViewV1, 0, len(list.Views))
+	for _, view := range list.Views {
+		data = append(data, NewXealthReportViewDto(view))
+	}
+	count := len(data)
+	return XealthReportViewsResponseV1{
+		Data: data,
+		Meta: MetaV1{
+			Count:      &count,
+			TotalCount: &list.TotalCount,
+		},
+	}
+}
+
+func NewXealthReportViewDto(view xealth.ReportView) XealthReportViewV1 {
+	dto := XealthReportViewV1{
+		CreatedTime:   view.CreatedTime,
+		Deployment:    view.DeploymentId,
+		PatientUserId: view.PatientUserId,
+		ProgramId:     view.ProgramId,
+		SystemLogin:   view.SystemLogin,
+		UserId:        view.UserId,
+	}
+	if view.Id != nil {
+		dto.Id = view.Id.Hex()
+	}
+	return dto
+}
+
+func NewXealthReportViewStatsDto(stats *xealth.ReportViewStats) XealthReportViewStatsV1 {
+	dto := XealthReportViewStatsV1{
+		Views:          stats.Views,
+		UniquePatients: stats.UniquePatients,
+		UniqueViewers:  stats.UniqueViewers,
+		Periods:        make([]XealthReportViewPeriodStatsV1, 0, len(stats.Periods)),
+		Viewers:        make([]XealthReportViewerStatsV1, 0, len(stats.Viewers)),
+	}
+	for _, period := range stats.Periods {
+		dto.Periods = append(dto.Periods, XealthReportViewPeriodStatsV1{
+			StartTime:      period.StartTime,
+			Views:          period.Views,
+			UniquePatients: period.UniquePatients,
+			UniqueViewers:  period.UniqueViewers,
+		})
+	}
+	for _, viewer := range stats.Viewers {
+		dto.Viewers = append(dto.Viewers, XealthReportViewerStatsV1{
+			UserId:         viewer.UserId,
+			SystemLogin:    viewer.SystemLogin,
+			Views:          viewer.Views,
+			UniquePatients: viewer.UniquePatients,
+			LastViewedTime: viewer.LastViewedTime,
+		})
+	}
+	return dto
+}
+
+func NewXealthPatientsNotViewedResponseDto(list *xealth.PatientReportViewStatusList) XealthPatientsNotViewedResponseV1 {
+	data := make([]XealthPatientReportViewStatusV1, 0, len(list.Patients))
+	for _, status := range list.Patients {
+		dto := XealthPatientReportViewStatusV1{
+			FullName:       status.Patient.FullName,
+			Mrn:            status.Patient.Mrn,
+			LastUploadTime: status.LastUploadTime,
+			LastViewedTime: status.LastViewedTime,
+		}
+		if status.Patient.UserId != nil {
+			dto.PatientUserId = *status.Patient.UserId
+		}
+		data = append(data, dto)
+	}
+	count := len(data)
+	return XealthPatientsNotViewedResponseV1{
+		Data: data,
+		Meta: MetaV1{
+			Count:      &count,
+			TotalCount: &list.TotalCount,
+		},
+	}
+}
+
+func NewAPIKeyDto(key *apikeys.APIKey) ApiKeyV1 {
+	userId := key.UserId
+	scopes := make([]ApiKeyScopeV1, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		scopes = append(scopes, ApiKeyScopeV1(scope))
+	}
+	return ApiKeyV1{
+		Id:             key.Id.Hex(),
+		ClinicId:       key.ClinicId.Hex(),
+		UserId:         &userId,
+		Name:           key.Name,
+		Scopes:         scopes,
+		ExpirationTime: key.ExpirationTime,
+		LastUsedTime:   key.LastUsedTime,
+		RevokedTime:    key.RevokedTime,
+		RevokedBy:      key.RevokedBy,
+		CreatedBy:      key.CreatedBy,
+		CreatedTime:    key.CreatedTime,
+	}
+}
+
+func NewAPIKeysDto(keys []*apikeys.APIKey) ApiKeysV1 {
+	dtos := make(ApiKeysV1, 0, len(keys))
+	for _, key := range keys {
+		dtos = append(dtos, NewAPIKeyDto(key))
+	}
+	return dtos
+}
+
+func NewAPIKeyScopes(dtos []ApiKeyScopeV1) []string {
+	scopes := make([]string, 0, len(dtos))
+	for _, dto := range dtos {
+		scopes = append(scopes, string(dto))
+	}
+	return scopes
+}
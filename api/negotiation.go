@@ -0,0 +1,51 @@
+package api
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	mimeApplicationExcel = "application/vnd.ms-excel"
+
+	// mimeApplicationMergeReportV1 is the media type of the structured merge report
+	mimeApplicationMergeReportV1 = "application/vnd.tidepool.clinic-merge-report.v1+json"
+)
+
+// negotiateContentType returns the offered content type with the highest quality in the Accept header. The first
+// offer is returned when the header is empty or none of the offers are acceptable.
+func negotiateContentType(accept string, offers []string) string {
+	best := offers[0]
+	bestQuality := 0.0
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, _ := strings.Cut(part, ";")
+		mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+		quality := 1.0
+		for _, param := range strings.Split(params, ";") {
+			key, value, _ := strings.Cut(param, "=")
+			if strings.TrimSpace(key) != "q" {
+				continue
+			}
+			if q, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+				quality = q
+			}
+		}
+
+		for _, offer := range offers {
+			if quality > bestQuality && mediaTypeMatches(mediaType, offer) {
+				best, bestQuality = offer, quality
+			}
+		}
+	}
+	return best
+}
+
+func mediaTypeMatches(mediaType string, offer string) bool {
+	if mediaType == "*/*" || mediaType == offer {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(mediaType, "/*"); ok {
+		return strings.HasPrefix(offer, prefix+"/")
+	}
+	return false
+}
@@ -1,8 +1,11 @@
 package api
 
 import (
+	"context"
+	stderrors "errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/tidepool-org/clinic/deletions"
@@ -16,6 +19,7 @@ import (
 	"github.com/tidepool-org/clinic/auth"
 	"github.com/tidepool-org/clinic/clinics"
 	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/sites"
 	"github.com/tidepool-org/clinic/store"
 )
 
@@ -62,6 +66,15 @@ func (h *Handler) ListPatients(ec echo.Context, clinicId ClinicId, params ListPa
 		return err
 	}
 
+	allowedSites, err := h.getSiteRestrictions(ctx, clinicId)
+	if err != nil {
+		return err
+	}
+	var hasAccess bool
+	if filter.Sites, hasAccess = restrictSites(filter.Sites, allowedSites); !hasAccess {
+		return ec.JSON(http.StatusOK, NewPatientsResponseDto(&patients.ListResult{}, 0))
+	}
+
 	list, err := h.Patients.List(ctx, &filter, page, sorts)
 	if err != nil {
 		return err
@@ -69,6 +82,7 @@ func (h *Handler) ListPatients(ec echo.Context, clinicId ClinicId, params ListPa
 
 	clinicPatientsCount, err := h.Patients.Count(ctx, &patients.Filter{
 		ClinicId: strp(clinicId),
+		Sites:    allowedSites,
 	})
 	if err != nil {
 		return err
@@ -105,6 +119,17 @@ func (h *Handler) CreatePatientAccount(ec echo.Context, clinicId ClinicId) error
 		patient.InvitedBy = &authData.SubjectId
 	}
 
+	allowedSites, err := h.getSiteRestrictions(ctx, clinicId)
+	if err != nil {
+		return err
+	}
+	if !areAllPatientSitesAllowed(&patient, allowedSites) {
+		return &echo.HTTPError{
+			Code:    http.StatusForbidden,
+			Message: "site restricted clinicians can only create patients in their sites",
+		}
+	}
+
 	result, err := h.Patients.Create(ctx, patient)
 	if err != nil {
 		return err
@@ -120,6 +145,14 @@ func (h *Handler) GetPatient(ec echo.Context, clinicId ClinicId, patientId Patie
 		return err
 	}
 
+	allowedSites, err := h.getSiteRestrictions(ctx, clinicId)
+	if err != nil {
+		return err
+	}
+	if !isPatientInSites(patient, allowedSites) {
+		return patients.ErrNotFound
+	}
+
 	return ec.JSON(http.StatusOK, NewPatientDto(patient))
 }
 
@@ -252,6 +285,9 @@ func (h *Handler) ListClinicsForPatient(ec echo.Context, patientId UserId, param
 	}
 
 	clinicList, err := h.Clinics.List(ctx, &clinics.Filter{Ids: clinicIds}, store.Pagination{})
+	if err != nil {
+		return err
+	}
 	dtos, err := NewPatientClinicRelationshipsDto(list.Patients, clinicList)
 	if err != nil {
 		return err
@@ -277,6 +313,59 @@ func (h *Handler) DeletePatient(ec echo.Context, clinicId ClinicId, patientId Pa
 	return ec.NoContent(http.StatusNoContent)
 }
 
+func (h *Handler) GetPatientDuplicates(ec echo.Context, clinicId ClinicId) error {
+	ctx := ec.Request().Context()
+	scan, err := h.PatientDuplicates.GetScan(ctx, clinicId)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewPatientDuplicateScanDto(scan))
+}
+
+func (h *Handler) ScanPatientDuplicates(ec echo.Context, clinicId ClinicId) error {
+	ctx := ec.Request().Context()
+	if _, err := h.Clinics.Get(ctx, clinicId); err != nil {
+		return err
+	}
+
+	scan, err := h.PatientDuplicates.Scan(ctx, clinicId)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewPatientDuplicateScanDto(scan))
+}
+
+func (h *Handler) MergePatientDuplicate(ec echo.Context, clinicId ClinicId) error {
+	ctx := ec.Request().Context()
+	dto := MergePatientDuplicateV1{}
+	if err := ec.Bind(&dto); err != nil {
+		return err
+	}
+	if dto.PatientId == nil || dto.DuplicateId == nil {
+		return fmt.Errorf("%w: the patient and the duplicate are required", errors.BadRequest)
+	}
+
+	var deletedByUserId *string
+	authData := auth.GetAuthData(ctx)
+	if authData != nil && authData.ServerAccess == false {
+		deletedByUserId = &authData.SubjectId
+	}
+
+	// Only the patients in the same cluster of the last duplicate scan of the clinic can be merged
+	if err := h.PatientDuplicates.CheckDuplicates(ctx, clinicId, *dto.PatientId, *dto.DuplicateId); err != nil {
+		return err
+	}
+
+	patient, err := h.Patients.MergeDuplicate(ctx, clinicId, *dto.PatientId, *dto.DuplicateId, deletions.Metadata{DeletedByUserId: deletedByUserId})
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewPatientDto(patient))
+}
+
 func (h *Handler) UpdatePatientSummary(ec echo.Context, patientId PatientId) error {
 	ctx := ec.Request().Context()
 	var dto *PatientSummaryV1
@@ -307,7 +396,15 @@ func (h *Handler) DeletePatientSummary(ec echo.Context, summaryId SummaryId) err
 
 func (h *Handler) TideReport(ec echo.Context, clinicId ClinicId, params TideReportParams) error {
 	ctx := ec.Request().Context()
-	tide, err := h.Patients.TideReport(ctx, clinicId, NewTideReportParams(params))
+	allowedSites, err := h.getSiteRestrictions(ctx, clinicId)
+	if err != nil {
+		return err
+	}
+
+	tideParams := NewTideReportParams(params)
+	tideParams.Sites = allowedSites
+
+	tide, err := h.Patients.TideReport(ctx, clinicId, tideParams)
 	if err != nil {
 		return err
 	}
@@ -527,3 +624,78 @@ func (h *Handler) ConnectProvider(ec echo.Context, clinicId ClinicId, patientId
 
 	return ec.NoContent(http.StatusNoContent)
 }
+
+// getSiteRestrictions returns the ids of the sites the authenticated clinician is restricted to,
+// or nil if the clinician has access to all patients of the clinic
+func (h *Handler) getSiteRestrictions(ctx context.Context, clinicId string) (*[]string, error) {
+	authData := auth.GetAuthData(ctx)
+	if authData == nil || authData.ServerAccess || authData.SubjectId == "" {
+		return nil, nil
+	}
+
+	clinician, err := h.Clinicians.Get(ctx, clinicId, authData.SubjectId)
+	if stderrors.Is(err, errors.NotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if !clinician.IsSiteRestricted() {
+		return nil, nil
+	}
+
+	// Access to a site grants access to all of its descendants
+	clinicianSites, err := h.Clinicians.ResolveSites(ctx, clinician)
+	if err != nil {
+		return nil, err
+	}
+	siteIds := make([]string, 0, len(clinicianSites))
+	for _, site := range clinicianSites {
+		siteIds = append(siteIds, site.Id.Hex())
+	}
+	return &siteIds, nil
+}
+
+// restrictSites returns the sites filter limited to the allowed sites and false if no patients can match the filter
+func restrictSites(requested *[]string, allowed *[]string) (*[]string, bool) {
+	if allowed == nil {
+		return requested, true
+	}
+	if requested == nil {
+		return allowed, len(*allowed) > 0
+	}
+
+	result := make([]string, 0, len(*requested))
+	for _, siteId := range *requested {
+		if slices.Contains(*allowed, siteId) {
+			result = append(result, siteId)
+		}
+	}
+	return &result, len(result) > 0
+}
+
+// areAllPatientSitesAllowed returns true if the patient is assigned to at least one site and all of them are allowed
+func areAllPatientSitesAllowed(patient *patients.Patient, allowed *[]string) bool {
+	if allowed == nil {
+		return true
+	}
+	if patient.Sites == nil || len(*patient.Sites) == 0 {
+		return false
+	}
+
+	return !slices.ContainsFunc(*patient.Sites, func(site sites.Site) bool {
+		return !slices.Contains(*allowed, site.Id.Hex())
+	})
+}
+
+func isPatientInSites(patient *patients.Patient, allowed *[]string) bool {
+	if allowed == nil {
+		return true
+	}
+	if patient.Sites == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(*patient.Sites, func(site sites.Site) bool {
+		return slices.Contains(*allowed, site.Id.Hex())
+	})
+}
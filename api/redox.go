@@ -1,14 +1,14 @@
 package api
 
 import (
+	stderrors "errors"
 	"fmt"
 	"io"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
-	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/ehr"
 	"github.com/tidepool-org/clinic/errors"
-	"github.com/tidepool-org/clinic/patients"
 	"github.com/tidepool-org/clinic/redox"
 	models "github.com/tidepool-org/clinic/redox_models"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -90,28 +90,9 @@ func (h *Handler) MatchClinicAndPatient(ec echo.Context) error {
 		}
 		matchOrder.PatientAttributes = criteria
 
-		if request.Patients.OnUniqueMatch != nil {
-			update := &patients.SubscriptionUpdate{
-				MatchedMessage: patients.MatchedMessage{
-					DocumentId: documentId,
-					DataModel:  order.Meta.DataModel,
-					EventType:  order.Meta.EventType,
-				},
-				Provider: clinics.EHRProviderRedox,
-			}
-
-			switch *request.Patients.OnUniqueMatch {
-			case ENABLEREPORTS:
-				update.Name = patients.SubscriptionRedoxSummaryAndReports
-				update.Active = true
-			case DISABLEREPORTS:
-				update.Name = patients.SubscriptionRedoxSummaryAndReports
-				update.Active = false
-			default:
-				return fmt.Errorf("%w: invalid 'onMatch' value %s", errors.BadRequest, *request.Patients.OnUniqueMatch)
-			}
-
-			matchOrder.SubscriptionUpdate = update
+		onUniqueMatch := request.Patients.OnUniqueMatch
+		if onUniqueMatch != nil && *onUniqueMatch != ENABLEREPORTS && *onUniqueMatch != DISABLEREPORTS {
+			return fmt.Errorf("%w: invalid 'onMatch' value %s", errors.BadRequest, *onUniqueMatch)
 		}
 	}
 
@@ -120,9 +101,31 @@ func (h *Handler) MatchClinicAndPatient(ec echo.Context) error {
 		return err
 	}
 
+	// Update the subscription for matched patient only if single match was found
+	if request.Patients != nil && request.Patients.OnUniqueMatch != nil && len(result.Patients) == 1 {
+		provider, err := h.EHRProviders.GetForClinic(result.Clinic)
+		if err != nil {
+			return err
+		}
+
+		orderRef := ehr.OrderRef{
+			DocumentId: documentId,
+			DataModel:  order.Meta.DataModel,
+			EventType:  order.Meta.EventType,
+		}
+		if *request.Patients.OnUniqueMatch == ENABLEREPORTS {
+			err = provider.OrderCreated(ctx, result.Clinic, *result.Patients[0], orderRef)
+		} else {
+			err = provider.OrderCancelled(ctx, result.Clinic, *result.Patients[0], orderRef)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
 	response := EhrMatchResponseV1{
 		Clinic:   NewClinicDto(&result.Clinic),
-		Settings: *NewEHRSettingsDto(result.Clinic.EHRSettings),
+		Settings: *NewEHRSettingsDto(result.Settings),
 	}
 
 	if result.Patients != nil {
@@ -135,7 +138,21 @@ func (h *Handler) MatchClinicAndPatient(ec echo.Context) error {
 
 func (h *Handler) SyncEHRData(ec echo.Context, clinicId ClinicId) error {
 	ctx := ec.Request().Context()
-	if err := h.Redox.RescheduleSubscriptionOrders(ctx, clinicId); err != nil {
+	clinic, err := h.Clinics.Get(ctx, clinicId)
+	if err != nil {
+		return err
+	}
+
+	provider, err := h.EHRProviders.GetForClinic(*clinic)
+	if err != nil {
+		return err
+	}
+
+	err = provider.SyncClinicReports(ctx, clinicId)
+	if stderrors.Is(err, ehr.ErrNotSupported) {
+		// Providers which retrieve reports on demand don't have reports to push
+		return fmt.Errorf("%w: the ehr provider of the clinic doesn't push reports", errors.NotFound)
+	} else if err != nil {
 		return err
 	}
 
@@ -144,7 +161,7 @@ func (h *Handler) SyncEHRData(ec echo.Context, clinicId ClinicId) error {
 
 func (h *Handler) SyncEHRDataForPatient(ec echo.Context, patientId PatientId) error {
 	ctx := ec.Request().Context()
-	if err := h.Redox.RescheduleSubscriptionOrdersForPatient(ctx, patientId); err != nil {
+	if err := ehr.DeliverPatientReports(ctx, h.EHRProviders, h.Clinics, h.Patients, h.Logger, patientId); err != nil {
 		return err
 	}
 
@@ -7,6 +7,8 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
 	"github.com/tidepool-org/clinic/errors"
 	"github.com/tidepool-org/clinic/xealth"
 	"github.com/tidepool-org/clinic/xealth_client"
@@ -148,3 +150,83 @@ func (h *Handler) ViewPDFReport(ec echo.Context, params ViewPDFReportParams) err
 
 	return ec.Render(http.StatusOK, "viewer.html.tmpl", report)
 }
+
+func (h *Handler) ListXealthReportViews(ec echo.Context, clinicId ClinicId, params ListXealthReportViewsParams) error {
+	ctx := ec.Request().Context()
+
+	clinicObjId, err := primitive.ObjectIDFromHex(clinicId)
+	if err != nil {
+		return fmt.Errorf("%w: invalid clinic id", errors.BadRequest)
+	}
+
+	filter := xealth.ReportViewHistoryFilter{
+		ClinicId:         clinicObjId,
+		PatientUserId:    params.PatientUserId,
+		SystemLogin:      params.SystemLogin,
+		CreatedTimeStart: params.CreatedTimeStart,
+		CreatedTimeEnd:   params.CreatedTimeEnd,
+	}
+	if filter.Sites, err = h.getSiteRestrictions(ctx, clinicId); err != nil {
+		return err
+	}
+
+	list, err := h.Xealth.ListReportViews(ctx, filter, pagination(params.Offset, params.Limit))
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewXealthReportViewsResponseDto(list))
+}
+
+func (h *Handler) GetXealthReportViewStats(ec echo.Context, clinicId ClinicId, params GetXealthReportViewStatsParams) error {
+	ctx := ec.Request().Context()
+
+	clinicObjId, err := primitive.ObjectIDFromHex(clinicId)
+	if err != nil {
+		return fmt.Errorf("%w: invalid clinic id", errors.BadRequest)
+	}
+
+	filter := xealth.ReportViewHistoryFilter{
+		ClinicId:         clinicObjId,
+		CreatedTimeStart: params.CreatedTimeStart,
+		CreatedTimeEnd:   params.CreatedTimeEnd,
+	}
+	if filter.Sites, err = h.getSiteRestrictions(ctx, clinicId); err != nil {
+		return err
+	}
+
+	interval := xealth.ReportViewIntervalWeek
+	if params.Interval != nil {
+		interval = string(*params.Interval)
+	}
+
+	stats, err := h.Xealth.GetReportViewStats(ctx, filter, interval)
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewXealthReportViewStatsDto(stats))
+}
+
+func (h *Handler) ListXealthPatientsNotViewed(ec echo.Context, clinicId ClinicId, params ListXealthPatientsNotViewedParams) error {
+	ctx := ec.Request().Context()
+
+	clinicObjId, err := primitive.ObjectIDFromHex(clinicId)
+	if err != nil {
+		return fmt.Errorf("%w: invalid clinic id", errors.BadRequest)
+	}
+
+	filter := xealth.PatientsNotViewedFilter{
+		ClinicId: clinicObjId,
+	}
+	if filter.Sites, err = h.getSiteRestrictions(ctx, clinicId); err != nil {
+		return err
+	}
+
+	list, err := h.Xealth.ListPatientsNotViewedSinceLastUpload(ctx, filter, pagination(params.Offset, params.Limit))
+	if err != nil {
+		return err
+	}
+
+	return ec.JSON(http.StatusOK, NewXealthPatientsNotViewedResponseDto(list))
+}
@@ -0,0 +1,171 @@
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/tidepool-org/clinic/clinicians"
+	"github.com/tidepool-org/clinic/errors"
+)
+
+const (
+	CollectionName = "api_keys"
+
+	// TokenPrefix identifies api keys in the authorization header
+	TokenPrefix = "tpk_"
+
+	ScopeTideRead = "tide:read"
+
+	DefaultLifetime = 90 * 24 * time.Hour
+	MaxLifetime     = 365 * 24 * time.Hour
+
+	secretLength = 32
+)
+
+var (
+	ErrNotFound       = fmt.Errorf("api key %w", errors.NotFound)
+	ErrInvalidScope   = fmt.Errorf("%w: invalid api key scope", errors.BadRequest)
+	ErrInvalidToken   = fmt.Errorf("api key is invalid")
+	ErrInvalidAccount = fmt.Errorf("%w: api keys can only be issued for service accounts of the clinic", errors.BadRequest)
+)
+
+// Scopes are the permissions which can be granted to api keys. The permissions reserved for clinic admins can't be granted.
+var Scopes = append(slices.DeleteFunc(slices.Clone(clinicians.Permissions), func(permission string) bool {
+	return slices.Contains(clinicians.AdminOnlyPermissions, permission)
+}), ScopeTideRead)
+
+//go:generate go tool mockgen -source=./apikeys.go -destination=./test/mock_apikeys.go -package test
+type Service interface {
+	Get(ctx context.Context, clinicId string, id string) (*APIKey, error)
+	List(ctx context.Context, filter Filter) ([]*APIKey, error)
+	// Create issues a new api key and returns it with the token, which can't be retrieved later
+	Create(ctx context.Context, create Create) (*APIKey, string, error)
+	Revoke(ctx context.Context, clinicId string, id string, revokedBy string) (*APIKey, error)
+	// Authenticate returns the active api key for the token and records its usage
+	Authenticate(ctx context.Context, token string) (*APIKey, error)
+}
+
+type Repository interface {
+	Get(ctx context.Context, id string) (*APIKey, error)
+	List(ctx context.Context, filter Filter) ([]*APIKey, error)
+	Create(ctx context.Context, key *APIKey) (*APIKey, error)
+	Revoke(ctx context.Context, clinicId string, id string, revokedBy string, revokedTime time.Time) (*APIKey, error)
+	UpdateLastUsedTime(ctx context.Context, id string, lastUsedTime time.Time) error
+}
+
+// APIKey is a credential issued by a clinic for one of its service accounts, which grants a subset of the service
+// account permissions
+type APIKey struct {
+	Id             *primitive.ObjectID `bson:"_id,omitempty"`
+	ClinicId       *primitive.ObjectID `bson:"clinicId"`
+	UserId         string              `bson:"userId"`
+	Name           string              `bson:"name"`
+	Scopes         []string            `bson:"scopes"`
+	SecretHash     string              `bson:"secretHash"`
+	ExpirationTime time.Time           `bson:"expirationTime"`
+	LastUsedTime   *time.Time          `bson:"lastUsedTime,omitempty"`
+	RevokedTime    *time.Time          `bson:"revokedTime,omitempty"`
+	RevokedBy      *string             `bson:"revokedBy,omitempty"`
+	CreatedBy      string              `bson:"createdBy"`
+	CreatedTime    time.Time           `bson:"createdTime"`
+	UpdatedTime    time.Time           `bson:"updatedTime"`
+}
+
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedTime != nil
+}
+
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return !now.Before(k.ExpirationTime)
+}
+
+// IsActive returns true if the key can be used for authentication
+func (k *APIKey) IsActive(now time.Time) bool {
+	return !k.IsRevoked() && !k.IsExpired(now)
+}
+
+// VerifySecret returns true if the secret matches the hash of the key secret
+func (k *APIKey) VerifySecret(secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashSecret(secret)), []byte(k.SecretHash)) == 1
+}
+
+type Create struct {
+	ClinicId       string
+	UserId         string
+	Name           string
+	Scopes         []string
+	ExpirationTime *time.Time
+	CreatedBy      string
+}
+
+type Filter struct {
+	ClinicId *string
+	UserId   *string
+	// Active returns only keys which are not revoked or expired if set to true
+	Active *bool
+}
+
+// ValidateScopes makes sure the scopes are known and unique
+func ValidateScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return fmt.Errorf("%w: at least one scope is required", ErrInvalidScope)
+	}
+	for i, scope := range scopes {
+		if !slices.Contains(Scopes, scope) {
+			return fmt.Errorf("%w: %s", ErrInvalidScope, scope)
+		}
+		if slices.Contains(scopes[:i], scope) {
+			return fmt.Errorf("%w: duplicate scope %s", ErrInvalidScope, scope)
+		}
+	}
+	return nil
+}
+
+// NewSecret returns a new random secret
+func NewSecret() (string, error) {
+	b := make([]byte, secretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashSecret returns the hex encoded sha256 hash of the secret. Secrets are random, so they don't need to be salted.
+func HashSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+// NewToken returns the token which is used to authenticate with the key
+func NewToken(id primitive.ObjectID, secret string) string {
+	return fmt.Sprintf("%s%s_%s", TokenPrefix, id.Hex(), secret)
+}
+
+// IsToken returns true if the token is an api key
+func IsToken(token string) bool {
+	return strings.HasPrefix(token, TokenPrefix)
+}
+
+// ParseToken returns the id and the secret of the key
+func ParseToken(token string) (string, string, error) {
+	if !IsToken(token) {
+		return "", "", ErrInvalidToken
+	}
+
+	id, secret, found := strings.Cut(strings.TrimPrefix(token, TokenPrefix), "_")
+	if !found || secret == "" || !primitive.IsValidObjectID(id) {
+		return "", "", ErrInvalidToken
+	}
+
+	return id, secret, nil
+}
@@ -0,0 +1,11 @@
+package apikeys_test
+
+import (
+	"testing"
+
+	"github.com/tidepool-org/clinic/test"
+)
+
+func TestSuite(t *testing.T) {
+	test.Test(t)
+}
@@ -0,0 +1,78 @@
+package apikeys_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/tidepool-org/clinic/apikeys"
+)
+
+var _ = Describe("API Keys", func() {
+	Describe("Tokens", func() {
+		It("parses tokens", func() {
+			id := primitive.NewObjectID()
+			secret, err := apikeys.NewSecret()
+			Expect(err).ToNot(HaveOccurred())
+
+			token := apikeys.NewToken(id, secret)
+			Expect(apikeys.IsToken(token)).To(BeTrue())
+
+			parsedId, parsedSecret, err := apikeys.ParseToken(token)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsedId).To(Equal(id.Hex()))
+			Expect(parsedSecret).To(Equal(secret))
+		})
+
+		It("rejects malformed tokens", func() {
+			for _, token := range []string{"", "session-token", "tpk_", "tpk_invalid_secret", "tpk_" + primitive.NewObjectID().Hex() + "_"} {
+				_, _, err := apikeys.ParseToken(token)
+				Expect(err).To(MatchError(apikeys.ErrInvalidToken), token)
+			}
+		})
+
+		It("verifies secrets", func() {
+			key := apikeys.APIKey{SecretHash: apikeys.HashSecret("secret")}
+			Expect(key.VerifySecret("secret")).To(BeTrue())
+			Expect(key.VerifySecret("other")).To(BeFalse())
+		})
+	})
+
+	Describe("Scopes", func() {
+		It("accepts known scopes", func() {
+			Expect(apikeys.ValidateScopes([]string{"patients:read", apikeys.ScopeTideRead})).To(Succeed())
+		})
+
+		It("rejects unknown, duplicate and missing scopes", func() {
+			Expect(apikeys.ValidateScopes(nil)).To(MatchError(apikeys.ErrInvalidScope))
+			Expect(apikeys.ValidateScopes([]string{"patients:erase"})).To(MatchError(apikeys.ErrInvalidScope))
+			Expect(apikeys.ValidateScopes([]string{"patients:read", "patients:read"})).To(MatchError(apikeys.ErrInvalidScope))
+		})
+
+		It("can't grant admin only permissions", func() {
+			Expect(apikeys.ValidateScopes([]string{"clinicians:write"})).To(MatchError(apikeys.ErrInvalidScope))
+			Expect(apikeys.ValidateScopes([]string{"clinic:write"})).To(MatchError(apikeys.ErrInvalidScope))
+		})
+	})
+
+	Describe("IsActive", func() {
+		now := time.Now()
+
+		It("is active before the expiration time", func() {
+			key := apikeys.APIKey{ExpirationTime: now.Add(time.Hour)}
+			Expect(key.IsActive(now)).To(BeTrue())
+		})
+
+		It("is inactive after the expiration time", func() {
+			key := apikeys.APIKey{ExpirationTime: now.Add(-time.Hour)}
+			Expect(key.IsActive(now)).To(BeFalse())
+		})
+
+		It("is inactive when revoked", func() {
+			key := apikeys.APIKey{ExpirationTime: now.Add(time.Hour), RevokedTime: &now}
+			Expect(key.IsActive(now)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/apikeys"
+)
+
+func NewRepository(db *mongo.Database, logger *zap.SugaredLogger, lifecycle fx.Lifecycle) (apikeys.Repository, error) {
+	repo := &Repository{
+		collection: db.Collection(apikeys.CollectionName),
+		logger:     logger,
+	}
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			return repo.Initialize(ctx)
+		},
+	})
+
+	return repo, nil
+}
+
+type Repository struct {
+	collection *mongo.Collection
+	logger     *zap.SugaredLogger
+}
+
+func (r *Repository) Initialize(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "clinicId", Value: 1},
+				{Key: "userId", Value: 1},
+				{Key: "createdTime", Value: -1},
+			},
+			Options: options.Index().
+				SetBackground(true).
+				SetName("APIKeysByClinicUser"),
+		},
+	})
+	return err
+}
+
+func (r *Repository) Get(ctx context.Context, id string) (*apikeys.APIKey, error) {
+	objId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, apikeys.ErrNotFound
+	}
+
+	return r.getOne(ctx, bson.M{"_id": objId})
+}
+
+func (r *Repository) List(ctx context.Context, filter apikeys.Filter) ([]*apikeys.APIKey, error) {
+	selector := bson.M{}
+	if filter.ClinicId != nil {
+		clinicObjId, _ := primitive.ObjectIDFromHex(*filter.ClinicId)
+		selector["clinicId"] = clinicObjId
+	}
+	if filter.UserId != nil {
+		selector["userId"] = *filter.UserId
+	}
+	if filter.Active != nil {
+		if *filter.Active {
+			selector["revokedTime"] = bson.M{"$exists": false}
+			selector["expirationTime"] = bson.M{"$gt": time.Now()}
+		} else {
+			selector["$or"] = bson.A{
+				bson.M{"revokedTime": bson.M{"$exists": true}},
+				bson.M{"expirationTime": bson.M{"$lte": time.Now()}},
+			}
+		}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "createdTime", Value: -1}})
+	cursor, err := r.collection.Find(ctx, selector, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error listing api keys: %w", err)
+	}
+
+	keys := make([]*apikeys.APIKey, 0)
+	if err = cursor.All(ctx, &keys); err != nil {
+		return nil, fmt.Errorf("error decoding api keys list: %w", err)
+	}
+
+	return keys, nil
+}
+
+func (r *Repository) Create(ctx context.Context, key *apikeys.APIKey) (*apikeys.APIKey, error) {
+	key.CreatedTime = time.Now()
+	key.UpdatedTime = key.CreatedTime
+	if _, err := r.collection.InsertOne(ctx, key); err != nil {
+		return nil, fmt.Errorf("error creating api key: %w", err)
+	}
+
+	return r.getOne(ctx, bson.M{"_id": key.Id})
+}
+
+func (r *Repository) Revoke(ctx context.Context, clinicId string, id string, revokedBy string, revokedTime time.Time) (*apikeys.APIKey, error) {
+	objId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, apikeys.ErrNotFound
+	}
+	clinicObjId, err := primitive.ObjectIDFromHex(clinicId)
+	if err != nil {
+		return nil, apikeys.ErrNotFound
+	}
+
+	selector := bson.M{
+		"_id":      objId,
+		"clinicId": clinicObjId,
+	}
+	key, err := r.getOne(ctx, selector)
+	if err != nil {
+		return nil, err
+	}
+	if key.IsRevoked() {
+		return key, nil
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"revokedTime": revokedTime,
+			"revokedBy":   revokedBy,
+			"updatedTime": time.Now(),
+		},
+	}
+	if _, err = r.collection.UpdateOne(ctx, selector, update); err != nil {
+		return nil, fmt.Errorf("error revoking api key: %w", err)
+	}
+
+	return r.getOne(ctx, selector)
+}
+
+func (r *Repository) UpdateLastUsedTime(ctx context.Context, id string, lastUsedTime time.Time) error {
+	objId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return apikeys.ErrNotFound
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"lastUsedTime": lastUsedTime,
+		},
+	}
+	if _, err = r.collection.UpdateOne(ctx, bson.M{"_id": objId}, update); err != nil {
+		return fmt.Errorf("error updating api key last used time: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) getOne(ctx context.Context, selector bson.M) (*apikeys.APIKey, error) {
+	key := &apikeys.APIKey{}
+	err := r.collection.FindOne(ctx, selector).Decode(key)
+	if err == mongo.ErrNoDocuments {
+		return nil, apikeys.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/apikeys"
+	"github.com/tidepool-org/clinic/clinicians"
+	"github.com/tidepool-org/clinic/errors"
+)
+
+// lastUsedTimeResolution limits how often the last used time of a key is updated
+const lastUsedTimeResolution = time.Minute
+
+type service struct {
+	repository apikeys.Repository
+	clinicians clinicians.Service
+	logger     *zap.SugaredLogger
+	now        func() time.Time
+}
+
+var _ apikeys.Service = &service{}
+
+func NewService(repository apikeys.Repository, cliniciansService clinicians.Service, logger *zap.SugaredLogger) (apikeys.Service, error) {
+	return &service{
+		repository: repository,
+		clinicians: cliniciansService,
+		logger:     logger,
+		now:        time.Now,
+	}, nil
+}
+
+func (s *service) Get(ctx context.Context, clinicId string, id string) (*apikeys.APIKey, error) {
+	key, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if key.ClinicId == nil || key.ClinicId.Hex() != clinicId {
+		return nil, apikeys.ErrNotFound
+	}
+	return key, nil
+}
+
+func (s *service) List(ctx context.Context, filter apikeys.Filter) ([]*apikeys.APIKey, error) {
+	return s.repository.List(ctx, filter)
+}
+
+func (s *service) Create(ctx context.Context, create apikeys.Create) (*apikeys.APIKey, string, error) {
+	if strings.TrimSpace(create.Name) == "" {
+		return nil, "", fmt.Errorf("%w: api key name is required", errors.BadRequest)
+	}
+	if err := apikeys.ValidateScopes(create.Scopes); err != nil {
+		return nil, "", err
+	}
+
+	now := s.now()
+	expirationTime := now.Add(apikeys.DefaultLifetime)
+	if create.ExpirationTime != nil {
+		expirationTime = *create.ExpirationTime
+	}
+	if !expirationTime.After(now) || expirationTime.After(now.Add(apikeys.MaxLifetime)) {
+		return nil, "", fmt.Errorf("%w: the expiration time must be in the future and within %v", errors.BadRequest, apikeys.MaxLifetime)
+	}
+
+	clinician, err := s.clinicians.Get(ctx, create.ClinicId, create.UserId)
+	if stderrors.Is(err, clinicians.ErrNotFound) {
+		return nil, "", apikeys.ErrInvalidAccount
+	} else if err != nil {
+		return nil, "", err
+	}
+	if !clinician.IsServiceAccount {
+		return nil, "", apikeys.ErrInvalidAccount
+	}
+
+	secret, err := apikeys.NewSecret()
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to generate api key secret: %w", err)
+	}
+
+	id := primitive.NewObjectID()
+	key, err := s.repository.Create(ctx, &apikeys.APIKey{
+		Id:             &id,
+		ClinicId:       clinician.ClinicId,
+		UserId:         create.UserId,
+		Name:           create.Name,
+		Scopes:         create.Scopes,
+		SecretHash:     apikeys.HashSecret(secret),
+		ExpirationTime: expirationTime,
+		CreatedBy:      create.CreatedBy,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return key, apikeys.NewToken(id, secret), nil
+}
+
+func (s *service) Revoke(ctx context.Context, clinicId string, id string, revokedBy string) (*apikeys.APIKey, error) {
+	return s.repository.Revoke(ctx, clinicId, id, revokedBy, s.now())
+}
+
+func (s *service) Authenticate(ctx context.Context, token string) (*apikeys.APIKey, error) {
+	id, secret, err := apikeys.ParseToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := s.repository.Get(ctx, id)
+	if stderrors.Is(err, apikeys.ErrNotFound) {
+		return nil, apikeys.ErrInvalidToken
+	} else if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	if !key.VerifySecret(secret) {
+		return nil, apikeys.ErrInvalidToken
+	}
+	if key.IsRevoked() {
+		return nil, fmt.Errorf("%w: the key was revoked", apikeys.ErrInvalidToken)
+	}
+	if key.IsExpired(now) {
+		return nil, fmt.Errorf("%w: the key expired", apikeys.ErrInvalidToken)
+	}
+
+	if key.LastUsedTime == nil || now.Sub(*key.LastUsedTime) >= lastUsedTimeResolution {
+		// Failing to track the usage shouldn't prevent the key from being used
+		if err := s.repository.UpdateLastUsedTime(ctx, id, now); err != nil {
+			s.logger.Warnw("unable to update api key last used time", "apiKeyId", id, zap.Error(err))
+		} else {
+			key.LastUsedTime = &now
+		}
+	}
+
+	return key, nil
+}
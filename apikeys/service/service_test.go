@@ -0,0 +1,167 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/apikeys"
+	apiKeysService "github.com/tidepool-org/clinic/apikeys/service"
+	apiKeysTest "github.com/tidepool-org/clinic/apikeys/test"
+	"github.com/tidepool-org/clinic/clinicians"
+	cliniciansTest "github.com/tidepool-org/clinic/clinicians/test"
+	"github.com/tidepool-org/clinic/errors"
+)
+
+var _ = Describe("API Keys Service", func() {
+	var ctrl *gomock.Controller
+	var repository *apiKeysTest.MockRepository
+	var cliniciansSvc *cliniciansTest.MockService
+	var service apikeys.Service
+	var clinicId primitive.ObjectID
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		repository = apiKeysTest.NewMockRepository(ctrl)
+		cliniciansSvc = cliniciansTest.NewMockService(ctrl)
+		clinicId = primitive.NewObjectID()
+
+		var err error
+		service, err = apiKeysService.NewService(repository, cliniciansSvc, zap.NewNop().Sugar())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Create", func() {
+		var create apikeys.Create
+
+		BeforeEach(func() {
+			create = apikeys.Create{
+				ClinicId:  clinicId.Hex(),
+				UserId:    "1234567890",
+				Name:      "Reporting",
+				Scopes:    []string{"patients:read"},
+				CreatedBy: "9876543210",
+			}
+		})
+
+		It("issues keys for service accounts", func() {
+			cliniciansSvc.EXPECT().Get(gomock.Any(), create.ClinicId, create.UserId).Return(&clinicians.Clinician{
+				ClinicId:         &clinicId,
+				UserId:           &create.UserId,
+				IsServiceAccount: true,
+			}, nil)
+
+			var stored *apikeys.APIKey
+			repository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, key *apikeys.APIKey) (*apikeys.APIKey, error) {
+				stored = key
+				return key, nil
+			})
+
+			key, token, err := service.Create(context.Background(), create)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key.Scopes).To(ConsistOf("patients:read"))
+			Expect(key.ExpirationTime).To(BeTemporally("~", time.Now().Add(apikeys.DefaultLifetime), time.Minute))
+
+			id, secret, err := apikeys.ParseToken(token)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(id).To(Equal(stored.Id.Hex()))
+			Expect(stored.SecretHash).ToNot(ContainSubstring(secret))
+			Expect(stored.VerifySecret(secret)).To(BeTrue())
+		})
+
+		It("doesn't issue keys for clinicians", func() {
+			cliniciansSvc.EXPECT().Get(gomock.Any(), create.ClinicId, create.UserId).Return(&clinicians.Clinician{
+				ClinicId: &clinicId,
+				UserId:   &create.UserId,
+			}, nil)
+
+			_, _, err := service.Create(context.Background(), create)
+			Expect(err).To(MatchError(apikeys.ErrInvalidAccount))
+		})
+
+		It("doesn't issue keys for users who are not members of the clinic", func() {
+			cliniciansSvc.EXPECT().Get(gomock.Any(), create.ClinicId, create.UserId).Return(nil, clinicians.ErrNotFound)
+
+			_, _, err := service.Create(context.Background(), create)
+			Expect(err).To(MatchError(apikeys.ErrInvalidAccount))
+		})
+
+		It("rejects expiration times beyond the maximum lifetime", func() {
+			expirationTime := time.Now().Add(apikeys.MaxLifetime + time.Hour)
+			create.ExpirationTime = &expirationTime
+
+			_, _, err := service.Create(context.Background(), create)
+			Expect(err).To(MatchError(errors.BadRequest))
+		})
+
+		It("rejects unknown scopes", func() {
+			create.Scopes = []string{"clinic:write"}
+
+			_, _, err := service.Create(context.Background(), create)
+			Expect(err).To(MatchError(apikeys.ErrInvalidScope))
+		})
+	})
+
+	Describe("Authenticate", func() {
+		var key *apikeys.APIKey
+		var token string
+
+		BeforeEach(func() {
+			id := primitive.NewObjectID()
+			secret, err := apikeys.NewSecret()
+			Expect(err).ToNot(HaveOccurred())
+
+			token = apikeys.NewToken(id, secret)
+			key = &apikeys.APIKey{
+				Id:             &id,
+				ClinicId:       &clinicId,
+				UserId:         "1234567890",
+				Scopes:         []string{"patients:read"},
+				SecretHash:     apikeys.HashSecret(secret),
+				ExpirationTime: time.Now().Add(time.Hour),
+			}
+			repository.EXPECT().Get(gomock.Any(), id.Hex()).Return(key, nil)
+		})
+
+		It("returns active keys and records their usage", func() {
+			repository.EXPECT().UpdateLastUsedTime(gomock.Any(), key.Id.Hex(), gomock.Any()).Return(nil)
+
+			result, err := service.Authenticate(context.Background(), token)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.LastUsedTime).ToNot(BeNil())
+		})
+
+		It("doesn't record the usage more than once a minute", func() {
+			lastUsedTime := time.Now().Add(-time.Second)
+			key.LastUsedTime = &lastUsedTime
+
+			_, err := service.Authenticate(context.Background(), token)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("rejects revoked keys", func() {
+			revokedTime := time.Now()
+			key.RevokedTime = &revokedTime
+
+			_, err := service.Authenticate(context.Background(), token)
+			Expect(err).To(MatchError(apikeys.ErrInvalidToken))
+		})
+
+		It("rejects expired keys", func() {
+			key.ExpirationTime = time.Now().Add(-time.Second)
+
+			_, err := service.Authenticate(context.Background(), token)
+			Expect(err).To(MatchError(apikeys.ErrInvalidToken))
+		})
+
+		It("rejects invalid secrets", func() {
+			_, err := service.Authenticate(context.Background(), token+"x")
+			Expect(err).To(MatchError(apikeys.ErrInvalidToken))
+		})
+	})
+})
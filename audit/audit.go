@@ -0,0 +1,44 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/tidepool-org/clinic/store"
+)
+
+const (
+	CollectionName = "audit_events"
+
+	// EventTypeBreakGlass is recorded when Tidepool support overrides the access restrictions of a clinic
+	EventTypeBreakGlass = "break_glass"
+)
+
+//go:generate go tool mockgen -source=./audit.go -destination=./test/mock_audit.go -package test
+type Repository interface {
+	Create(ctx context.Context, event *Event) (*Event, error)
+	List(ctx context.Context, filter Filter, pagination store.Pagination) ([]*Event, error)
+}
+
+// Event is an entry of the audit trail
+type Event struct {
+	Id        *primitive.ObjectID `bson:"_id,omitempty"`
+	Type      string              `bson:"type"`
+	ClinicId  *primitive.ObjectID `bson:"clinicId,omitempty"`
+	SubjectId string              `bson:"subjectId"`
+	Method    string              `bson:"method,omitempty"`
+	Path      string              `bson:"path,omitempty"`
+	ClientIP  string              `bson:"clientIp,omitempty"`
+	// Reason is the justification provided by the subject
+	Reason string `bson:"reason,omitempty"`
+	// Details describe the event, e.g. the access restrictions which were overridden
+	Details     []string  `bson:"details,omitempty"`
+	CreatedTime time.Time `bson:"createdTime"`
+}
+
+type Filter struct {
+	ClinicId *string
+	Type     *string
+}
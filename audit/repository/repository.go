@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/audit"
+	"github.com/tidepool-org/clinic/store"
+)
+
+func NewRepository(db *mongo.Database, logger *zap.SugaredLogger, lifecycle fx.Lifecycle) (audit.Repository, error) {
+	repo := &Repository{
+		collection: db.Collection(audit.CollectionName),
+		logger:     logger,
+	}
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			return repo.Initialize(ctx)
+		},
+	})
+
+	return repo, nil
+}
+
+type Repository struct {
+	collection *mongo.Collection
+	logger     *zap.SugaredLogger
+}
+
+func (r *Repository) Initialize(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "clinicId", Value: 1},
+				{Key: "createdTime", Value: -1},
+			},
+			Options: options.Index().
+				SetBackground(true).
+				SetName("AuditEventsByClinic"),
+		},
+	})
+	return err
+}
+
+func (r *Repository) Create(ctx context.Context, event *audit.Event) (*audit.Event, error) {
+	if event.CreatedTime.IsZero() {
+		event.CreatedTime = time.Now()
+	}
+
+	res, err := r.collection.InsertOne(ctx, event)
+	if err != nil {
+		return nil, fmt.Errorf("error creating audit event: %w", err)
+	}
+
+	id := res.InsertedID.(primitive.ObjectID)
+	event.Id = &id
+	return event, nil
+}
+
+func (r *Repository) List(ctx context.Context, filter audit.Filter, pagination store.Pagination) ([]*audit.Event, error) {
+	selector := bson.M{}
+	if filter.ClinicId != nil {
+		clinicObjId, _ := primitive.ObjectIDFromHex(*filter.ClinicId)
+		selector["clinicId"] = clinicObjId
+	}
+	if filter.Type != nil {
+		selector["type"] = *filter.Type
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "createdTime", Value: -1}}).
+		SetLimit(int64(pagination.Limit)).
+		SetSkip(int64(pagination.Offset))
+
+	cursor, err := r.collection.Find(ctx, selector, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error listing audit events: %w", err)
+	}
+
+	events := make([]*audit.Event, 0)
+	if err = cursor.All(ctx, &events); err != nil {
+		return nil, fmt.Errorf("error decoding audit events: %w", err)
+	}
+
+	return events, nil
+}
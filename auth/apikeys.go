@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/tidepool-org/clinic/apikeys"
+)
+
+// APIKeyAuthenticator authenticates clinic api keys. The request is authenticated as the service account of the key
+// and the scopes of the key limit the permissions of the service account in the policy.
+type APIKeyAuthenticator struct {
+	apiKeys apikeys.Service
+}
+
+var _ Authenticator = &APIKeyAuthenticator{}
+
+func NewAPIKeyAuthenticator(apiKeys apikeys.Service) *APIKeyAuthenticator {
+	return &APIKeyAuthenticator{apiKeys: apiKeys}
+}
+
+func (a *APIKeyAuthenticator) ValidateAndSetAuthData(token string, ec echo.Context) (bool, error) {
+	if !apikeys.IsToken(token) {
+		return false, ErrUnauthenticated
+	}
+
+	key, err := a.apiKeys.Authenticate(ec.Request().Context(), token)
+	if errors.Is(err, apikeys.ErrInvalidToken) {
+		return false, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	} else if err != nil {
+		return false, fmt.Errorf("%w: %w", ErrAuthenticationUnavailable, err)
+	}
+
+	SetAuthData(ec, &Auth{
+		SubjectId: key.UserId,
+		Scopes:    key.Scopes,
+		APIKey: &APIKeyAuth{
+			Id:       key.Id.Hex(),
+			ClinicId: key.ClinicId.Hex(),
+		},
+	})
+
+	return true, nil
+}
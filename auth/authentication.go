@@ -2,30 +2,46 @@ package auth
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
-	"github.com/hashicorp/golang-lru/simplelru"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/tidepool-org/clinic/apikeys"
 	"github.com/tidepool-org/clinic/errors"
 	"github.com/tidepool-org/go-common/clients/shoreline"
 	"net/http"
-	"sync"
+	"strings"
 	"time"
 )
 
 var (
 	ErrUnauthenticated            = fmt.Errorf("session token is invalid")
 	AuthContextKey                = AuthKey("auth")
+	ClientIPContextKey            = AuthKey("clientIp")
 	TidepoolSessionTokenHeaderKey = "x-tidepool-session-token"
-	DefaultCacheSize              = 10000           // Cache up to 10000 tokens
-	DefaultCacheEntryExpiration   = 5 * time.Minute // Cache tokens for 5 minutes
 )
 
+// ErrAuthenticationUnavailable is returned if the token can't be validated, because the service which issued it is
+// unavailable. It's not a failed validation of the token.
+var ErrAuthenticationUnavailable = fmt.Errorf("unable to validate session token")
+
 type AuthKey string
 
 type Auth struct {
-	SubjectId    string `json:"subjectId"`
-	ServerAccess bool   `json:"serverAccess"`
+	SubjectId    string   `json:"subjectId"`
+	ServerAccess bool     `json:"serverAccess"`
+	Scopes       []string `json:"scopes,omitempty"`
+	// APIKey is set when the request is authenticated with a clinic api key
+	APIKey *APIKeyAuth `json:"apiKey,omitempty"`
+	// AuthenticationMethods (amr) and AuthenticationTime describe how and when the user authenticated. They are only
+	// available for tokens issued by the identity provider.
+	AuthenticationMethods []string   `json:"amr,omitempty"`
+	AuthenticationTime    *time.Time `json:"authTime,omitempty"`
+}
+
+type APIKeyAuth struct {
+	Id       string `json:"id"`
+	ClinicId string `json:"clinicId"`
 }
 
 func IsServerAuth(a *Auth) bool {
@@ -54,6 +70,8 @@ var _ Authenticator = &ShorelineAuthenticator{}
 
 type AuthMiddlewareOpts struct {
 	Skipper middleware.Skipper
+	// Optional allows requests without a session token. The auth data is only set if a token is provided.
+	Optional bool
 }
 
 func NewAuthMiddleware(authenticator Authenticator, opts AuthMiddlewareOpts) echo.MiddlewareFunc {
@@ -66,13 +84,32 @@ func NewAuthMiddleware(authenticator Authenticator, opts AuthMiddlewareOpts) ech
 				}
 			}
 
+			SetClientIP(c)
+
 			token := c.Request().Header.Get(TidepoolSessionTokenHeaderKey)
 			if token == "" {
+				token = getBearerToken(c.Request())
+			}
+			if token == "" && opts.Optional {
+				return next(c)
+			} else if token == "" {
 				return echo.NewHTTPError(http.StatusBadRequest, "session token is missing")
 			}
 
 			valid, err := authenticator.ValidateAndSetAuthData(token, c)
-			if err != nil {
+			if stderrors.Is(err, ErrTooManyFailedAttempts) {
+				return &echo.HTTPError{
+					Code:     http.StatusTooManyRequests,
+					Message:  "too many failed authentication attempts",
+					Internal: err,
+				}
+			} else if stderrors.Is(err, ErrAuthenticationUnavailable) {
+				return &echo.HTTPError{
+					Code:     http.StatusServiceUnavailable,
+					Message:  "unable to validate session token",
+					Internal: err,
+				}
+			} else if err != nil {
 				return &echo.HTTPError{
 					Code:     http.StatusUnauthorized,
 					Message:  "session token is invalid",
@@ -86,15 +123,32 @@ func NewAuthMiddleware(authenticator Authenticator, opts AuthMiddlewareOpts) ech
 	}
 }
 
-// NewAuthenticator returns a shoreline authenticator that caches server tokens
-func NewAuthenticator(shoreline shoreline.Client) (Authenticator, error) {
-	delegate := NewShorelineAuthenticator(shoreline)
-	return NewCachingAuthenticator(
-		DefaultCacheSize,
-		DefaultCacheEntryExpiration,
-		delegate,
-		IsServerAuth,
-	)
+// getBearerToken returns the token from the authorization header
+func getBearerToken(req *http.Request) string {
+	scheme, token, found := strings.Cut(req.Header.Get(echo.HeaderAuthorization), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// NewAuthenticator returns a shoreline authenticator that caches server tokens and failed validations. Clinic api keys
+// are accepted as well and if oidc is enabled, so are the tokens issued by the identity provider. The returned token
+// cache is used to evict tokens from the cache.
+func NewAuthenticator(shoreline shoreline.Client, oidcConfig OIDCConfig, apiKeys apikeys.Service, cacheConfig CacheConfig) (Authenticator, TokenCache, error) {
+	// Api keys and oidc tokens are recognized locally, so they are checked first to avoid a shoreline request
+	authenticators := []Authenticator{NewAPIKeyAuthenticator(apiKeys)}
+	if oidcConfig.Enabled {
+		authenticators = append(authenticators, NewOIDCAuthenticator(oidcConfig, nil))
+	}
+	authenticators = append(authenticators, NewShorelineAuthenticator(shoreline))
+
+	authenticator, err := NewCachingAuthenticator(cacheConfig, NewChainAuthenticator(authenticators...), IsServerAuth)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return authenticator, authenticator, nil
 }
 
 func NewShorelineAuthenticator(shoreline shoreline.Client) Authenticator {
@@ -122,88 +176,20 @@ func GetAuthData(ctx context.Context) *Auth {
 	return nil
 }
 
-func SetAuthData(ec echo.Context, auth *Auth) {
-	ctx := context.WithValue(ec.Request().Context(), AuthContextKey, auth)
-	ec.SetRequest(ec.Request().WithContext(ctx))
-}
-
-type CacheEntry struct {
-	token  string
-	auth   *Auth
-	expiry time.Time
-}
-
-func (c CacheEntry) IsExpired() bool {
-	return time.Now().After(c.expiry)
+// GetClientIP returns the ip address of the client, which is used to enforce the network restrictions of clinics
+func GetClientIP(ctx context.Context) string {
+	ip, _ := ctx.Value(ClientIPContextKey).(string)
+	return ip
 }
 
-type CachingAuthenticator struct {
-	delegate    Authenticator
-	expiration  time.Duration
-	lru         *simplelru.LRU
-	mu          *sync.Mutex
-	shouldCache func(*Auth) bool
-}
-
-var _ Authenticator = &CachingAuthenticator{}
-
-func NewCachingAuthenticator(size int, expiration time.Duration, delegate Authenticator, shouldCache func(*Auth) bool) (Authenticator, error) {
-	var onEvict simplelru.EvictCallback
-	lru, err := simplelru.NewLRU(size, onEvict)
-	if err != nil {
-		return nil, err
-	}
-
-	return &CachingAuthenticator{
-		delegate:    delegate,
-		expiration:  expiration,
-		lru:         lru,
-		mu:          &sync.Mutex{},
-		shouldCache: shouldCache,
-	}, nil
-}
-
-func (c CachingAuthenticator) ValidateAndSetAuthData(token string, ec echo.Context) (bool, error) {
-	entry := c.getCachedEntry(token)
-	if entry != nil {
-		SetAuthData(ec, entry.auth)
-		return true, nil
-	}
-
-	res, err := c.delegate.ValidateAndSetAuthData(token, ec)
-	auth := GetAuthData(ec.Request().Context())
-
-	if c.shouldCache(auth) {
-		entry := CacheEntry{
-			token:  token,
-			auth:   auth,
-			expiry: time.Now().Add(c.expiration),
-		}
-		c.setCacheEntry(entry)
-	}
-
-	return res, err
-}
-
-func (c *CachingAuthenticator) getCachedEntry(token string) *CacheEntry {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	if e, ok := c.lru.Get(token); ok {
-		entry := e.(CacheEntry)
-		if entry.IsExpired() {
-			c.lru.Remove(token)
-			return nil
-		}
-		return &entry
-	}
-
-	return nil
+// SetClientIP stores the ip address of the client, as determined by the ip extractor of the server, in the request
+// context
+func SetClientIP(ec echo.Context) {
+	ctx := context.WithValue(ec.Request().Context(), ClientIPContextKey, ec.RealIP())
+	ec.SetRequest(ec.Request().WithContext(ctx))
 }
 
-func (c *CachingAuthenticator) setCacheEntry(entry CacheEntry) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	_ = c.lru.Add(entry.token, entry)
+func SetAuthData(ec echo.Context, auth *Auth) {
+	ctx := context.WithValue(ec.Request().Context(), AuthContextKey, auth)
+	ec.SetRequest(ec.Request().WithContext(ctx))
 }
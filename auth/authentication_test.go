@@ -0,0 +1,59 @@
+package auth_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/tidepool-org/go-common/clients/disc"
+	"github.com/tidepool-org/go-common/clients/shoreline"
+
+	"github.com/tidepool-org/clinic/auth"
+)
+
+var _ = Describe("Shoreline Authenticator", func() {
+	var status int
+	var server *httptest.Server
+	var authenticator auth.Authenticator
+
+	newContext := func() echo.Context {
+		return echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/v1/clinics", nil), httptest.NewRecorder())
+	}
+
+	BeforeEach(func() {
+		status = http.StatusOK
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal("/token/session-token"))
+			w.WriteHeader(status)
+			if status == http.StatusOK {
+				Expect(json.NewEncoder(w).Encode(shoreline.TokenData{UserID: "1234567890", IsServer: false})).To(Succeed())
+			}
+		}))
+		client := shoreline.NewShorelineClientBuilder().
+			WithHostGetter(disc.NewStaticHostGetterFromString(server.URL)).
+			WithHttpClient(server.Client()).
+			WithName("clinics").
+			WithSecret("server-secret").
+			Build()
+		authenticator = auth.NewShorelineAuthenticator(client)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("sets the auth data of valid tokens", func() {
+		ec := newContext()
+		Expect(authenticator.ValidateAndSetAuthData("session-token", ec)).To(BeTrue())
+		Expect(auth.GetAuthData(ec.Request().Context())).To(Equal(&auth.Auth{SubjectId: "1234567890"}))
+	})
+
+	It("rejects invalid tokens", func() {
+		status = http.StatusNotFound
+		_, err := authenticator.ValidateAndSetAuthData("session-token", newContext())
+		Expect(err).To(MatchError(auth.ErrUnauthenticated))
+	})
+})
@@ -7,21 +7,25 @@ import (
 	"fmt"
 	"github.com/fatih/structs"
 	"github.com/getkin/kin-openapi/openapi3filter"
-	"github.com/open-policy-agent/opa/ast"
 	"github.com/open-policy-agent/opa/rego"
+	"github.com/open-policy-agent/opa/topdown"
 	"github.com/tidepool-org/clinic/clinicians"
 	internalErrs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/sites"
 	"go.uber.org/zap"
 
 	"strings"
+	"time"
 )
 
 const (
-	authHeaderPrefix      = "x-auth-"
-	subjectIdHeaderName   = "x-auth-subject-id"
-	serverAccessHeaderKey = "x-auth-server-access"
-	sessionTokenHeaderKey = "x-tidepool-session-token"
-	clinicIdPathParameter = "clinicId"
+	authHeaderPrefix       = "x-auth-"
+	subjectIdHeaderName    = "x-auth-subject-id"
+	serverAccessHeaderKey  = "x-auth-server-access"
+	sessionTokenHeaderKey  = "x-tidepool-session-token"
+	clinicIdPathParameter  = "clinicId"
+	patientIdPathParameter = "patientId"
 )
 
 var (
@@ -34,83 +38,238 @@ var (
 type RequestAuthorizer interface {
 	Authorize(context.Context, *openapi3filter.AuthenticationInput) error
 	EvaluatePolicy(context.Context, map[string]interface{}) error
+	// Explain evaluates the policy for a hypothetical request and returns the decision with the rules which matched
+	Explain(context.Context, PolicyRequest) (*Decision, error)
 }
 
-func NewRequestAuthorizer(clinicians clinicians.Service, logger *zap.SugaredLogger) (RequestAuthorizer, error) {
-	compiler, err := ast.CompileModules(map[string]string{
-		"policy.rego": authzPolicy,
-	})
-	if err != nil {
-		return nil, err
+// PolicyRequest is the request which is authorized by the policy
+type PolicyRequest struct {
+	Method     string
+	Path       string
+	PathParams map[string]string
+	Auth       *Auth
+	ClientIP   string
+	// BreakGlassReason is provided by Tidepool support to override the access restrictions of a clinic
+	BreakGlassReason string
+}
+
+// NewRequestAuthorizer returns an authorizer which evaluates the active policy of the store. The embedded policy
+// is used if the store is nil. The access restrictions of clinics are not enforced if the enforcer is nil.
+func NewRequestAuthorizer(clinicians clinicians.Service, patients patients.Service, policies *PolicyStore, decisions *DecisionLogger, restrictions *AccessRestrictionEnforcer, logger *zap.SugaredLogger) (RequestAuthorizer, error) {
+	if policies == nil {
+		var err error
+		if policies, err = NewEmbeddedPolicyStore(); err != nil {
+			return nil, err
+		}
 	}
 
 	return &embeddedOpaAuthorizer{
-		clinicians: clinicians,
-		logger:     logger,
-		policy:     compiler,
+		clinicians:   clinicians,
+		patients:     patients,
+		policies:     policies,
+		decisions:    decisions,
+		restrictions: restrictions,
+		logger:       logger,
 	}, nil
 }
 
 type embeddedOpaAuthorizer struct {
-	clinicians clinicians.Service
-	logger     *zap.SugaredLogger
-	policy     *ast.Compiler
+	clinicians   clinicians.Service
+	patients     patients.Service
+	policies     *PolicyStore
+	decisions    *DecisionLogger
+	restrictions *AccessRestrictionEnforcer
+	logger       *zap.SugaredLogger
 }
 
 func (e *embeddedOpaAuthorizer) Authorize(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
-	clinician, err := e.getClinicianRecord(ctx, input)
+	request := input.RequestValidationInput.Request
+	in, err := e.getPolicyInput(ctx, PolicyRequest{
+		Method:           request.Method,
+		Path:             request.URL.Path,
+		PathParams:       input.RequestValidationInput.PathParams,
+		Auth:             GetAuthData(request.Context()),
+		ClientIP:         GetClientIP(request.Context()),
+		BreakGlassReason: request.Header.Get(BreakGlassReasonHeaderKey),
+	})
 	if err != nil {
 		return err
 	}
 
+	return e.EvaluatePolicy(ctx, in)
+}
+
+func (e *embeddedOpaAuthorizer) Explain(ctx context.Context, request PolicyRequest) (*Decision, error) {
+	in, err := e.getPolicyInput(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.evaluate(ctx, in, true)
+}
+
+func (e *embeddedOpaAuthorizer) getPolicyInput(ctx context.Context, request PolicyRequest) (map[string]interface{}, error) {
+	clinician, err := e.getClinicianRecord(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
 	in := map[string]interface{}{
-		"auth":   GetAuthData(input.RequestValidationInput.Request.Context()),
-		"path":   e.getSplitPath(input),
-		"method": strings.ToUpper(input.RequestValidationInput.Request.Method),
+		"auth":   request.Auth,
+		"path":   getSplitPath(request.Path),
+		"method": strings.ToUpper(request.Method),
+	}
+
+	// The request is denied regardless of the policy if it violates the access restrictions of the clinic
+	restriction, err := e.restrictions.Check(ctx, request, clinician)
+	if err != nil {
+		return nil, err
+	}
+	if restriction != "" {
+		in[accessRestrictionInputKey] = restriction
 	}
 
 	if clinician != nil {
+		permissions, err := e.clinicians.ResolvePermissions(ctx, clinician)
+		if err != nil {
+			return nil, err
+		}
+
 		clinicianStruct := structs.New(*clinician)
 		clinicianStruct.TagName = "bson"
-		in["clinician"] = clinicianStruct.Map()
+		clinicianMap := clinicianStruct.Map()
+		clinicianMap["permissions"] = permissions
+		clinicianMap["sites"] = nil
+		if clinician.Sites != nil {
+			// Access to a site grants access to all of its descendants
+			clinicianSites, err := e.clinicians.ResolveSites(ctx, clinician)
+			if err != nil {
+				return nil, err
+			}
+			clinicianMap["sites"] = sitesInput(clinicianSites)
+		}
+		in["clinician"] = clinicianMap
+
+		if clinician.IsSiteRestricted() {
+			patient, err := e.getPatientRecord(ctx, request)
+			if err != nil {
+				return nil, err
+			}
+			if patient != nil {
+				var patientSites []sites.Site
+				if patient.Sites != nil {
+					patientSites = *patient.Sites
+				}
+				in["patient"] = map[string]interface{}{
+					"sites": sitesInput(patientSites),
+				}
+			}
+		}
 	}
 
-	return e.EvaluatePolicy(ctx, in)
+	return in, nil
 }
 
 func (e *embeddedOpaAuthorizer) EvaluatePolicy(ctx context.Context, input map[string]interface{}) error {
-	r := rego.New(
+	sampled := e.decisions.Sampled()
+	decision, err := e.evaluate(ctx, input, sampled)
+	if err != nil {
+		return err
+	}
+
+	if e.decisions.ShouldLog(decision.Allow, sampled) {
+		if !sampled {
+			// Decisions are only explained when they are sampled, because tracing the evaluation is expensive.
+			// Denied requests are rare, so it's fine to evaluate the policy again to explain the denial.
+			if decision, err = e.evaluate(ctx, input, true); err != nil {
+				return err
+			}
+		}
+		e.decisions.Write(ctx, *decision)
+	}
+
+	e.logger.Debugw("authorization policy eval", zap.Any("input", input), zap.Bool("allow", decision.Allow))
+
+	if !decision.Allow {
+		return ErrUnauthorized
+	}
+
+	return nil
+}
+
+// evaluate evaluates the policy and returns the decision. The rules which matched the input are only included if
+// the decision is explained.
+func (e *embeddedOpaAuthorizer) evaluate(ctx context.Context, input map[string]interface{}, explained bool) (*Decision, error) {
+	policy := e.policies.Get()
+	decision := newDecision(input)
+	decision.PolicyHash = policy.Hash
+	start := time.Now()
+
+	options := []func(*rego.Rego){
 		rego.Package("http.authz.clinic"),
 		rego.Query("allow"),
-		rego.Compiler(e.policy),
+		rego.Compiler(policy.compiler),
 		rego.Input(input),
-	)
+	}
+	var tracer *topdown.BufferTracer
+	if explained {
+		tracer = topdown.NewBufferTracer()
+		options = append(options, rego.QueryTracer(tracer))
+	}
 
-	results, err := r.Eval(ctx)
+	results, err := rego.New(options...).Eval(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to evaluate authorization policy: %w", err)
+		return nil, fmt.Errorf("unable to evaluate authorization policy: %w", err)
 	}
 
 	if len(results) == 0 || len(results[0].Expressions) == 0 {
-		return fmt.Errorf("evaluating authorization policy returned no results")
+		return nil, fmt.Errorf("evaluating authorization policy returned no results")
 	}
 
 	val, ok := results[0].Expressions[0].Value.(bool)
 	if !ok {
-		return fmt.Errorf("unexpected authorization result: %v", results[0].Expressions[0].Value)
+		return nil, fmt.Errorf("unexpected authorization result: %v", results[0].Expressions[0].Value)
 	}
 
-	e.logger.Debugw("authorization policy eval", zap.Any("input", input), zap.Bool("allow", val))
-
-	if !val {
-		return ErrUnauthorized
+	decision.Allow = val
+	if restriction, _ := input[accessRestrictionInputKey].(string); restriction != "" {
+		decision.Allow = false
+		decision.Restriction = restriction
+	}
+	decision.Duration = time.Since(start)
+	if tracer != nil {
+		path, _ := input["path"].([]string)
+		decision.MatchedRules, decision.FailedRules = explain(policy.rules, *tracer, decision.Method, path)
 	}
 
-	return nil
+	return decision, nil
 }
 
-func (e *embeddedOpaAuthorizer) getSplitPath(input *openapi3filter.AuthenticationInput) []string {
-	path := strings.Split(input.RequestValidationInput.Request.URL.Path, "/")
+func newDecision(input map[string]interface{}) *Decision {
+	decision := &Decision{
+		Timestamp:    time.Now(),
+		MatchedRules: []PolicyRule{},
+		FailedRules:  []RuleFailure{},
+	}
+	decision.Method, _ = input["method"].(string)
+	if path, ok := input["path"].([]string); ok {
+		decision.Path = "/" + strings.Join(path, "/")
+	}
+	switch auth := input["auth"].(type) {
+	case *Auth:
+		if auth != nil {
+			decision.SubjectId = auth.SubjectId
+			decision.ServerAccess = auth.ServerAccess
+		}
+	case map[string]interface{}:
+		decision.SubjectId, _ = auth["subjectId"].(string)
+		decision.ServerAccess, _ = auth["serverAccess"].(bool)
+	}
+	return decision
+}
+
+func getSplitPath(requestPath string) []string {
+	path := strings.Split(requestPath, "/")
 	if len(path) > 0 && path[0] == "" {
 		path = path[1:]
 	}
@@ -118,12 +277,12 @@ func (e *embeddedOpaAuthorizer) getSplitPath(input *openapi3filter.Authenticatio
 }
 
 // Get the clinician record for the currently authenticated user
-func (e *embeddedOpaAuthorizer) getClinicianRecord(ctx context.Context, input *openapi3filter.AuthenticationInput) (*clinicians.Clinician, error) {
-	clinicId := input.RequestValidationInput.PathParams[clinicIdPathParameter]
+func (e *embeddedOpaAuthorizer) getClinicianRecord(ctx context.Context, request PolicyRequest) (*clinicians.Clinician, error) {
+	clinicId := request.PathParams[clinicIdPathParameter]
 	if clinicId == "" {
 		return nil, nil
 	}
-	authData := GetAuthData(input.RequestValidationInput.Request.Context())
+	authData := request.Auth
 	if authData == nil {
 		return nil, nil
 	}
@@ -134,3 +293,29 @@ func (e *embeddedOpaAuthorizer) getClinicianRecord(ctx context.Context, input *o
 
 	return clinician, nil
 }
+
+// Get the record of the patient referenced in the request path
+func (e *embeddedOpaAuthorizer) getPatientRecord(ctx context.Context, request PolicyRequest) (*patients.Patient, error) {
+	clinicId := request.PathParams[clinicIdPathParameter]
+	patientId := request.PathParams[patientIdPathParameter]
+	if clinicId == "" || patientId == "" {
+		return nil, nil
+	}
+	patient, err := e.patients.Get(ctx, clinicId, patientId)
+	if err != nil && !errors.Is(err, internalErrs.NotFound) {
+		return nil, err
+	}
+
+	return patient, nil
+}
+
+func sitesInput(sites []sites.Site) []interface{} {
+	result := make([]interface{}, 0, len(sites))
+	for _, site := range sites {
+		result = append(result, map[string]interface{}{
+			"id":   site.Id.Hex(),
+			"name": site.Name,
+		})
+	}
+	return result
+}
@@ -6,15 +6,44 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/tidepool-org/clinic/auth"
+	"github.com/tidepool-org/clinic/clinicians"
 	"go.uber.org/zap"
 )
 
 var clinicAdmin = map[string]interface{}{
-	"roles": []string{"CLINIC_ADMIN"},
+	"roles":       []string{"CLINIC_ADMIN"},
+	"permissions": clinicians.GetPermissions([]string{"CLINIC_ADMIN"}, nil),
 }
 
 var clinicMember = map[string]interface{}{
-	"roles": []string{"CLINIC_MEMBER"},
+	"roles":       []string{"CLINIC_MEMBER"},
+	"permissions": clinicians.GetPermissions([]string{"CLINIC_MEMBER"}, nil),
+}
+
+var patientManager = map[string]interface{}{
+	"roles":       []string{"PATIENT_MANAGER"},
+	"permissions": []string{"patients:read", "patients:write", "patient_tags:write", "patient_tags:manage"},
+}
+
+var billing = map[string]interface{}{
+	"roles":       []string{"BILLING"},
+	"permissions": []string{"billing:read"},
+}
+
+var siteRestrictedMember = map[string]interface{}{
+	"roles":       []string{"CLINIC_MEMBER"},
+	"permissions": clinicians.GetPermissions([]string{"CLINIC_MEMBER"}, nil),
+	"sites": []interface{}{
+		map[string]interface{}{"id": "6066fbabc6f484277200ac70", "name": "North"},
+	},
+}
+
+var siteRestrictedAdmin = map[string]interface{}{
+	"roles":       []string{"CLINIC_ADMIN"},
+	"permissions": clinicians.GetPermissions([]string{"CLINIC_ADMIN"}, nil),
+	"sites": []interface{}{
+		map[string]interface{}{"id": "6066fbabc6f484277200ac70", "name": "North"},
+	},
 }
 
 var _ = Describe("Request Authorizer", func() {
@@ -22,7 +51,7 @@ var _ = Describe("Request Authorizer", func() {
 
 	BeforeEach(func() {
 		var err error
-		authorizer, err = auth.NewRequestAuthorizer(nil, zap.NewNop().Sugar())
+		authorizer, err = auth.NewRequestAuthorizer(nil, nil, nil, nil, nil, zap.NewNop().Sugar())
 		Expect(err).ToNot(HaveOccurred())
 	})
 
@@ -1299,4 +1328,323 @@ var _ = Describe("Request Authorizer", func() {
 		err := authorizer.EvaluatePolicy(context.Background(), input)
 		Expect(err).To(Equal(auth.ErrUnauthorized))
 	})
+
+	It("allows clinic members to list xealth report views", func() {
+		input := map[string]interface{}{
+			"path":   []string{"v1", "clinics", "6066fbabc6f484277200ac64", "xealth", "report_views"},
+			"method": "GET",
+			"auth": map[string]interface{}{
+				"subjectId":    "1234567890",
+				"serverAccess": false,
+			},
+			"clinician": clinicMember,
+		}
+		err := authorizer.EvaluatePolicy(context.Background(), input)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("allows clinic members to fetch xealth report view stats", func() {
+		input := map[string]interface{}{
+			"path":   []string{"v1", "clinics", "6066fbabc6f484277200ac64", "xealth", "report_views", "stats"},
+			"method": "GET",
+			"auth": map[string]interface{}{
+				"subjectId":    "1234567890",
+				"serverAccess": false,
+			},
+			"clinician": clinicMember,
+		}
+		err := authorizer.EvaluatePolicy(context.Background(), input)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("prevents users from listing patients whose xealth reports were not viewed", func() {
+		input := map[string]interface{}{
+			"path":   []string{"v1", "clinics", "6066fbabc6f484277200ac64", "xealth", "patients_not_viewed"},
+			"method": "GET",
+			"auth": map[string]interface{}{
+				"subjectId":    "1234567890",
+				"serverAccess": false,
+			},
+		}
+		err := authorizer.EvaluatePolicy(context.Background(), input)
+		Expect(err).To(Equal(auth.ErrUnauthorized))
+	})
+
+	It("allows clinicians with a custom role to edit patients", func() {
+		input := map[string]interface{}{
+			"path":   []string{"v1", "clinics", "6066fbabc6f484277200ac64", "patients", "1234567890"},
+			"method": "PUT",
+			"auth": map[string]interface{}{
+				"subjectId":    "1234567890",
+				"serverAccess": false,
+			},
+			"clinician": patientManager,
+		}
+		err := authorizer.EvaluatePolicy(context.Background(), input)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("allows clinicians with a custom role to delete patient tags", func() {
+		input := map[string]interface{}{
+			"path":   []string{"v1", "clinics", "6066fbabc6f484277200ac64", "patient_tags", "6066fbabc6f484277200ac65"},
+			"method": "DELETE",
+			"auth": map[string]interface{}{
+				"subjectId":    "1234567890",
+				"serverAccess": false,
+			},
+			"clinician": patientManager,
+		}
+		err := authorizer.EvaluatePolicy(context.Background(), input)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("prevents clinicians without the clinicians write permission from updating clinicians", func() {
+		input := map[string]interface{}{
+			"path":   []string{"v1", "clinics", "6066fbabc6f484277200ac64", "clinicians", "0987654321"},
+			"method": "PUT",
+			"auth": map[string]interface{}{
+				"subjectId":    "1234567890",
+				"serverAccess": false,
+			},
+			"clinician": patientManager,
+		}
+		err := authorizer.EvaluatePolicy(context.Background(), input)
+		Expect(err).To(Equal(auth.ErrUnauthorized))
+	})
+
+	It("allows clinicians with the billing permission to fetch the patient count", func() {
+		input := map[string]interface{}{
+			"path":   []string{"v1", "clinics", "6066fbabc6f484277200ac64", "patient_count"},
+			"method": "GET",
+			"auth": map[string]interface{}{
+				"subjectId":    "1234567890",
+				"serverAccess": false,
+			},
+			"clinician": billing,
+		}
+		err := authorizer.EvaluatePolicy(context.Background(), input)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("prevents clinicians with only the billing permission from listing patients", func() {
+		input := map[string]interface{}{
+			"path":   []string{"v1", "clinics", "6066fbabc6f484277200ac64", "patients"},
+			"method": "GET",
+			"auth": map[string]interface{}{
+				"subjectId":    "1234567890",
+				"serverAccess": false,
+			},
+			"clinician": billing,
+		}
+		err := authorizer.EvaluatePolicy(context.Background(), input)
+		Expect(err).To(Equal(auth.ErrUnauthorized))
+	})
+
+	It("allows clinicians with a custom role to remove themselves from the clinic", func() {
+		input := map[string]interface{}{
+			"path":   []string{"v1", "clinics", "6066fbabc6f484277200ac64", "clinicians", "1234567890"},
+			"method": "DELETE",
+			"auth": map[string]interface{}{
+				"subjectId":    "1234567890",
+				"serverAccess": false,
+			},
+			"clinician": billing,
+		}
+		err := authorizer.EvaluatePolicy(context.Background(), input)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("allows clinic admins to update clinician roles", func() {
+		input := map[string]interface{}{
+			"path":   []string{"v1", "clinics", "6066fbabc6f484277200ac64", "clinician_roles"},
+			"method": "PUT",
+			"auth": map[string]interface{}{
+				"subjectId":    "1234567890",
+				"serverAccess": false,
+			},
+			"clinician": clinicAdmin,
+		}
+		err := authorizer.EvaluatePolicy(context.Background(), input)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("prevents clinic members from updating clinician roles", func() {
+		input := map[string]interface{}{
+			"path":   []string{"v1", "clinics", "6066fbabc6f484277200ac64", "clinician_roles"},
+			"method": "PUT",
+			"auth": map[string]interface{}{
+				"subjectId":    "1234567890",
+				"serverAccess": false,
+			},
+			"clinician": clinicMember,
+		}
+		err := authorizer.EvaluatePolicy(context.Background(), input)
+		Expect(err).To(Equal(auth.ErrUnauthorized))
+	})
+
+	Describe("Site restrictions", func() {
+		var input map[string]interface{}
+
+		BeforeEach(func() {
+			input = map[string]interface{}{
+				"path":   []string{"v1", "clinics", "6066fbabc6f484277200ac64", "patients", "0987654321"},
+				"method": "GET",
+				"auth": map[string]interface{}{
+					"subjectId":    "1234567890",
+					"serverAccess": false,
+				},
+			}
+		})
+
+		It("allows site restricted clinicians to access patients of their sites", func() {
+			input["clinician"] = siteRestrictedMember
+			input["patient"] = map[string]interface{}{
+				"sites": []interface{}{
+					map[string]interface{}{"id": "6066fbabc6f484277200ac70", "name": "North"},
+					map[string]interface{}{"id": "6066fbabc6f484277200ac71", "name": "South"},
+				},
+			}
+			err := authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("prevents site restricted clinicians from accessing patients of other sites", func() {
+			input["method"] = "PUT"
+			input["clinician"] = siteRestrictedMember
+			input["patient"] = map[string]interface{}{
+				"sites": []interface{}{
+					map[string]interface{}{"id": "6066fbabc6f484277200ac71", "name": "South"},
+				},
+			}
+			err := authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).To(Equal(auth.ErrUnauthorized))
+		})
+
+		It("prevents site restricted clinicians from accessing patients without sites", func() {
+			input["clinician"] = siteRestrictedMember
+			input["patient"] = map[string]interface{}{
+				"sites": []interface{}{},
+			}
+			err := authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).To(Equal(auth.ErrUnauthorized))
+		})
+
+		It("doesn't restrict clinic admins", func() {
+			input["clinician"] = siteRestrictedAdmin
+			input["patient"] = map[string]interface{}{
+				"sites": []interface{}{},
+			}
+			err := authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("prevents site restricted clinicians from assigning tags to clinic patients", func() {
+			input["method"] = "POST"
+			input["path"] = []string{"v1", "clinics", "6066fbabc6f484277200ac64", "patients", "assign_tag", "6066fbabc6f484277200ac72"}
+			input["clinician"] = map[string]interface{}{
+				"roles":       []string{"CLINIC_MEMBER"},
+				"permissions": []string{"patient_tags:manage"},
+				"sites":       siteRestrictedMember["sites"],
+			}
+			err := authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).To(Equal(auth.ErrUnauthorized))
+
+			input["clinician"] = patientManager
+			err = authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("prevents site restricted clinicians from accessing the duplicate patients of the clinic", func() {
+			input["path"] = []string{"v1", "clinics", "6066fbabc6f484277200ac64", "patient_duplicates"}
+			input["clinician"] = map[string]interface{}{
+				"roles":       []string{"CLINIC_MEMBER"},
+				"permissions": []string{"patients:delete"},
+				"sites":       siteRestrictedMember["sites"],
+			}
+			err := authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).To(Equal(auth.ErrUnauthorized))
+
+			input["method"] = "POST"
+			input["path"] = []string{"v1", "clinics", "6066fbabc6f484277200ac64", "patient_duplicates", "merge"}
+			err = authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).To(Equal(auth.ErrUnauthorized))
+
+			input["clinician"] = siteRestrictedAdmin
+			err = authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("doesn't restrict clinicians without sites", func() {
+			input["clinician"] = clinicMember
+			err := authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("API keys", func() {
+		var input map[string]interface{}
+
+		apiKeyAuth := func(scopes ...string) map[string]interface{} {
+			return map[string]interface{}{
+				"subjectId":    "1234567890",
+				"serverAccess": false,
+				"scopes":       scopes,
+				"apiKey": map[string]interface{}{
+					"id":       "6066fbabc6f484277200ac68",
+					"clinicId": "6066fbabc6f484277200ac64",
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			input = map[string]interface{}{
+				"path":      []string{"v1", "clinics", "6066fbabc6f484277200ac64", "patients", "0987654321"},
+				"method":    "GET",
+				"auth":      apiKeyAuth("patients:read"),
+				"clinician": clinicAdmin,
+			}
+		})
+
+		It("allows the scopes of the key", func() {
+			err := authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("prevents a read only key of an admin service account from deleting patients", func() {
+			input["method"] = "DELETE"
+			err := authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).To(Equal(auth.ErrUnauthorized))
+		})
+
+		It("doesn't grant permissions the service account doesn't have", func() {
+			input["method"] = "DELETE"
+			input["auth"] = apiKeyAuth("patients:read", "patients:delete")
+			input["clinician"] = clinicMember
+			err := authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).To(Equal(auth.ErrUnauthorized))
+		})
+
+		It("prevents access to other clinics", func() {
+			input["path"] = []string{"v1", "clinics", "6066fbabc6f484277200ac65", "patients", "0987654321"}
+			err := authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).To(Equal(auth.ErrUnauthorized))
+		})
+
+		It("prevents access to routes of authenticated users", func() {
+			input["path"] = []string{"v1", "clinics"}
+			input["method"] = "POST"
+			err := authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).To(Equal(auth.ErrUnauthorized))
+		})
+
+		It("requires the tide:read scope for tide reports", func() {
+			input["path"] = []string{"v1", "clinics", "6066fbabc6f484277200ac64", "tide_report"}
+			err := authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).To(Equal(auth.ErrUnauthorized))
+
+			input["auth"] = apiKeyAuth("tide:read")
+			err = authorizer.EvaluatePolicy(context.Background(), input)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
 })
@@ -0,0 +1,209 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+	"github.com/kelseyhightower/envconfig"
+	"github.com/labstack/echo/v4"
+)
+
+var ErrTooManyFailedAttempts = fmt.Errorf("%w: too many failed attempts", ErrUnauthenticated)
+
+type CacheConfig struct {
+	// Size is the maximum number of cached tokens
+	Size       int           `envconfig:"TIDEPOOL_AUTH_CACHE_SIZE" default:"10000"`
+	Expiration time.Duration `envconfig:"TIDEPOOL_AUTH_CACHE_EXPIRATION" default:"5m"`
+	// NegativeExpiration is how long failed validations are cached, negative caching is disabled if it's zero
+	NegativeExpiration time.Duration `envconfig:"TIDEPOOL_AUTH_NEGATIVE_CACHE_EXPIRATION" default:"10s"`
+	// MaxFailures is the number of failed validations from the same client ip with tokens with the same prefix which are
+	// allowed during the failure window, rate limiting is disabled if it's zero
+	MaxFailures   int           `envconfig:"TIDEPOOL_AUTH_MAX_FAILURES_PER_TOKEN_PREFIX" default:"20"`
+	FailureWindow time.Duration `envconfig:"TIDEPOOL_AUTH_FAILURE_WINDOW" default:"1m"`
+	// TokenPrefixLength is long enough to include the key id of api keys
+	TokenPrefixLength int `envconfig:"TIDEPOOL_AUTH_TOKEN_PREFIX_LENGTH" default:"28"`
+}
+
+func NewCacheConfig() (CacheConfig, error) {
+	cfg := CacheConfig{}
+	err := envconfig.Process("", &cfg)
+	return cfg, err
+}
+
+// TokenCache evicts tokens from the authentication cache, e.g. after the token of a backend service was revoked
+type TokenCache interface {
+	// Invalidate evicts the token and returns true if it was cached
+	Invalidate(token string) bool
+}
+
+type CacheEntry struct {
+	token string
+	// auth is nil if the validation of the token failed
+	auth   *Auth
+	expiry time.Time
+}
+
+func (c CacheEntry) IsExpired() bool {
+	return time.Now().After(c.expiry)
+}
+
+func (c CacheEntry) IsNegative() bool {
+	return c.auth == nil
+}
+
+type failureWindow struct {
+	start    time.Time
+	failures int
+}
+
+// CachingAuthenticator caches the tokens accepted by the delegate for which shouldCache returns true. Failed
+// validations are cached briefly and clients which repeatedly fail the validation of tokens with the same prefix are
+// rate limited, so clients retrying invalid tokens don't overload the delegate. Validations which failed because the
+// delegate was unavailable are neither cached nor counted.
+type CachingAuthenticator struct {
+	delegate    Authenticator
+	config      CacheConfig
+	shouldCache func(*Auth) bool
+	metrics     *cacheMetrics
+
+	mu       sync.Mutex
+	lru      *simplelru.LRU
+	failures *simplelru.LRU
+}
+
+var _ Authenticator = &CachingAuthenticator{}
+var _ TokenCache = &CachingAuthenticator{}
+
+func NewCachingAuthenticator(config CacheConfig, delegate Authenticator, shouldCache func(*Auth) bool) (*CachingAuthenticator, error) {
+	lru, err := simplelru.NewLRU(config.Size, nil)
+	if err != nil {
+		return nil, err
+	}
+	failures, err := simplelru.NewLRU(config.Size, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CachingAuthenticator{
+		delegate:    delegate,
+		config:      config,
+		shouldCache: shouldCache,
+		metrics:     newCacheMetrics(),
+		lru:         lru,
+		failures:    failures,
+	}, nil
+}
+
+func (c *CachingAuthenticator) ValidateAndSetAuthData(token string, ec echo.Context) (bool, error) {
+	entry := c.getCachedEntry(token)
+	if entry != nil && entry.IsNegative() {
+		c.metrics.lookups.WithLabelValues(cacheResultNegativeHit).Inc()
+		return false, ErrUnauthenticated
+	} else if entry != nil {
+		c.metrics.lookups.WithLabelValues(cacheResultHit).Inc()
+		SetAuthData(ec, entry.auth)
+		return true, nil
+	}
+
+	c.metrics.lookups.WithLabelValues(cacheResultMiss).Inc()
+	valid, err := c.delegate.ValidateAndSetAuthData(token, ec)
+	if errors.Is(err, ErrAuthenticationUnavailable) {
+		return false, err
+	} else if err != nil || !valid {
+		if c.recordFailure(token, c.getFailureKey(token, ec)) {
+			c.metrics.lookups.WithLabelValues(cacheResultRateLimited).Inc()
+			return false, ErrTooManyFailedAttempts
+		}
+		return valid, err
+	}
+
+	auth := GetAuthData(ec.Request().Context())
+	if c.shouldCache(auth) {
+		c.setCacheEntry(CacheEntry{
+			token:  token,
+			auth:   auth,
+			expiry: time.Now().Add(c.config.Expiration),
+		})
+	}
+
+	return true, nil
+}
+
+func (c *CachingAuthenticator) Invalidate(token string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.lru.Remove(token) {
+		return false
+	}
+
+	c.metrics.invalidations.Inc()
+	return true
+}
+
+func (c *CachingAuthenticator) getCachedEntry(token string) *CacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.lru.Get(token); ok {
+		entry := e.(CacheEntry)
+		if entry.IsExpired() {
+			c.lru.Remove(token)
+			return nil
+		}
+		return &entry
+	}
+
+	return nil
+}
+
+func (c *CachingAuthenticator) setCacheEntry(entry CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_ = c.lru.Add(entry.token, entry)
+}
+
+// getFailureKey returns the key used to rate limit failed validations, which is the client ip and the prefix of the
+// token. The prefix of api keys includes the key id. The prefixes of other tokens are random, so the failures of
+// jwts are only counted by client ip.
+func (c *CachingAuthenticator) getFailureKey(token string, ec echo.Context) string {
+	if strings.Contains(token, ".") {
+		return ec.RealIP()
+	}
+	if len(token) > c.config.TokenPrefixLength {
+		token = token[:c.config.TokenPrefixLength]
+	}
+	return ec.RealIP() + "/" + token
+}
+
+// recordFailure caches the failed validation and returns true if the client exceeded the maximum number of failures
+func (c *CachingAuthenticator) recordFailure(token string, key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.config.NegativeExpiration > 0 {
+		_ = c.lru.Add(token, CacheEntry{
+			token:  token,
+			expiry: time.Now().Add(c.config.NegativeExpiration),
+		})
+	}
+
+	if c.config.MaxFailures <= 0 {
+		return false
+	}
+
+	now := time.Now()
+	if w, ok := c.failures.Get(key); ok && now.Sub(w.(*failureWindow).start) < c.config.FailureWindow {
+		window := w.(*failureWindow)
+		window.failures++
+		return window.failures > c.config.MaxFailures
+	}
+
+	_ = c.failures.Add(key, &failureWindow{start: now, failures: 1})
+	return false
+}
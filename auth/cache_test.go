@@ -0,0 +1,198 @@
+package auth_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tidepool-org/clinic/auth"
+)
+
+// countingAuthenticator accepts the tokens of the map and counts the validations
+type countingAuthenticator struct {
+	tokens      map[string]*auth.Auth
+	validations int
+	unavailable bool
+}
+
+func (c *countingAuthenticator) ValidateAndSetAuthData(token string, ec echo.Context) (bool, error) {
+	c.validations++
+	if c.unavailable {
+		return false, auth.ErrAuthenticationUnavailable
+	}
+	if a, ok := c.tokens[token]; ok {
+		auth.SetAuthData(ec, a)
+		return true, nil
+	}
+	return false, auth.ErrUnauthenticated
+}
+
+var _ = Describe("Caching Authenticator", func() {
+	var delegate *countingAuthenticator
+	var config auth.CacheConfig
+	var cache *auth.CachingAuthenticator
+
+	newContextFrom := func(ip string) echo.Context {
+		req := httptest.NewRequest(http.MethodGet, "/v1/clinics", nil)
+		req.RemoteAddr = ip + ":1234"
+		return echo.New().NewContext(req, httptest.NewRecorder())
+	}
+	newContext := func() echo.Context {
+		return newContextFrom("192.0.2.1")
+	}
+
+	BeforeEach(func() {
+		delegate = &countingAuthenticator{
+			tokens: map[string]*auth.Auth{
+				"server-token": {SubjectId: "hydrophone", ServerAccess: true},
+				"other-token":  {SubjectId: "hydrophone", ServerAccess: true},
+				"user-token":   {SubjectId: "1234567890"},
+			},
+		}
+		config = auth.CacheConfig{
+			Size:               100,
+			Expiration:         time.Minute,
+			NegativeExpiration: time.Minute,
+			MaxFailures:        3,
+			FailureWindow:      time.Minute,
+			TokenPrefixLength:  8,
+		}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		cache, err = auth.NewCachingAuthenticator(config, delegate, auth.IsServerAuth)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("caches server tokens", func() {
+		for i := 0; i < 2; i++ {
+			ec := newContext()
+			Expect(cache.ValidateAndSetAuthData("server-token", ec)).To(BeTrue())
+			Expect(auth.GetAuthData(ec.Request().Context()).SubjectId).To(Equal("hydrophone"))
+		}
+		Expect(delegate.validations).To(Equal(1))
+	})
+
+	It("doesn't cache user tokens", func() {
+		for i := 0; i < 2; i++ {
+			Expect(cache.ValidateAndSetAuthData("user-token", newContext())).To(BeTrue())
+		}
+		Expect(delegate.validations).To(Equal(2))
+	})
+
+	It("caches failed validations", func() {
+		for i := 0; i < 2; i++ {
+			valid, err := cache.ValidateAndSetAuthData("invalid-token", newContext())
+			Expect(err).To(MatchError(auth.ErrUnauthenticated))
+			Expect(valid).To(BeFalse())
+		}
+		Expect(delegate.validations).To(Equal(1))
+	})
+
+	It("validates the tokens again after the negative cache entry expired", func() {
+		config.NegativeExpiration = time.Millisecond
+		cache, _ = auth.NewCachingAuthenticator(config, delegate, auth.IsServerAuth)
+
+		_, _ = cache.ValidateAndSetAuthData("invalid-token", newContext())
+		time.Sleep(5 * time.Millisecond)
+		_, _ = cache.ValidateAndSetAuthData("invalid-token", newContext())
+		Expect(delegate.validations).To(Equal(2))
+	})
+
+	It("rate limits failed validations of tokens with the same prefix", func() {
+		for _, token := range []string{"invalid-1", "invalid-2", "invalid-3"} {
+			_, err := cache.ValidateAndSetAuthData(token, newContext())
+			Expect(err).To(MatchError(auth.ErrUnauthenticated))
+			Expect(err).ToNot(MatchError(auth.ErrTooManyFailedAttempts))
+		}
+
+		_, err := cache.ValidateAndSetAuthData("invalid-4", newContext())
+		Expect(err).To(MatchError(auth.ErrTooManyFailedAttempts))
+	})
+
+	It("doesn't rate limit valid tokens with the same prefix", func() {
+		config.TokenPrefixLength = 5
+		cache, _ = auth.NewCachingAuthenticator(config, delegate, auth.IsServerAuth)
+
+		for _, token := range []string{"user-1", "user-2", "user-3", "user-4"} {
+			_, _ = cache.ValidateAndSetAuthData(token, newContext())
+		}
+		Expect(cache.ValidateAndSetAuthData("user-token", newContext())).To(BeTrue())
+	})
+
+	It("rate limits failed validations by client ip", func() {
+		for _, token := range []string{"invalid-1", "invalid-2", "invalid-3"} {
+			_, _ = cache.ValidateAndSetAuthData(token, newContext())
+		}
+
+		_, err := cache.ValidateAndSetAuthData("invalid-4", newContextFrom("192.0.2.2"))
+		Expect(err).To(MatchError(auth.ErrUnauthenticated))
+		Expect(err).ToNot(MatchError(auth.ErrTooManyFailedAttempts))
+	})
+
+	It("rate limits failed validations of jwts by client ip", func() {
+		for _, token := range []string{"header.claims.signature-1", "header.claims.signature-2", "header.claims.signature-3"} {
+			_, err := cache.ValidateAndSetAuthData(token, newContext())
+			Expect(err).ToNot(MatchError(auth.ErrTooManyFailedAttempts))
+		}
+
+		_, err := cache.ValidateAndSetAuthData("header.claims.other", newContext())
+		Expect(err).To(MatchError(auth.ErrTooManyFailedAttempts))
+	})
+
+	It("doesn't record validations which failed because the delegate was unavailable", func() {
+		delegate.unavailable = true
+		for _, token := range []string{"invalid-1", "invalid-2", "invalid-3", "invalid-4"} {
+			_, err := cache.ValidateAndSetAuthData(token, newContext())
+			Expect(err).To(MatchError(auth.ErrAuthenticationUnavailable))
+		}
+
+		delegate.unavailable = false
+		_, err := cache.ValidateAndSetAuthData("invalid-1", newContext())
+		Expect(err).To(MatchError(auth.ErrUnauthenticated))
+		Expect(err).ToNot(MatchError(auth.ErrTooManyFailedAttempts))
+		Expect(delegate.validations).To(Equal(5))
+	})
+
+	It("evicts tokens", func() {
+		Expect(cache.ValidateAndSetAuthData("server-token", newContext())).To(BeTrue())
+		Expect(cache.Invalidate("server-token")).To(BeTrue())
+		Expect(cache.Invalidate("server-token")).To(BeFalse())
+
+		Expect(cache.ValidateAndSetAuthData("server-token", newContext())).To(BeTrue())
+		Expect(delegate.validations).To(Equal(2))
+	})
+
+	It("responds with too many requests when rate limited", func() {
+		config.MaxFailures = 1
+		cache, _ = auth.NewCachingAuthenticator(config, delegate, auth.IsServerAuth)
+		middleware := auth.NewAuthMiddleware(cache, auth.AuthMiddlewareOpts{})
+		next := func(c echo.Context) error { return nil }
+
+		for _, token := range []string{"invalid-1", "invalid-2"} {
+			ec := newContext()
+			ec.Request().Header.Set(auth.TidepoolSessionTokenHeaderKey, token)
+			err := middleware(next)(ec)
+			if token == "invalid-1" {
+				Expect(err).To(HaveField("Code", http.StatusUnauthorized))
+			} else {
+				Expect(err).To(HaveField("Code", http.StatusTooManyRequests))
+			}
+		}
+	})
+
+	It("responds with service unavailable if the delegate is unavailable", func() {
+		delegate.unavailable = true
+		middleware := auth.NewAuthMiddleware(cache, auth.AuthMiddlewareOpts{})
+		ec := newContext()
+		ec.Request().Header.Set(auth.TidepoolSessionTokenHeaderKey, "server-token")
+
+		err := middleware(func(c echo.Context) error { return nil })(ec)
+		Expect(err).To(HaveField("Code", http.StatusServiceUnavailable))
+	})
+})
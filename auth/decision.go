@@ -0,0 +1,276 @@
+package auth
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/open-policy-agent/opa/topdown"
+	"go.uber.org/zap"
+)
+
+const (
+	policyModuleName = "policy.rego"
+)
+
+var (
+	inputMethodRef = ast.MustParseRef("input.method")
+	inputPathRef   = ast.MustParseRef("input.path")
+)
+
+// Decision is the result of an evaluation of the authorization policy
+type Decision struct {
+	Timestamp    time.Time     `json:"timestamp"`
+	Method       string        `json:"method"`
+	Path         string        `json:"path"`
+	SubjectId    string        `json:"subjectId,omitempty"`
+	ServerAccess bool          `json:"serverAccess"`
+	Allow        bool          `json:"allow"`
+	MatchedRules []PolicyRule  `json:"matchedRules"`
+	FailedRules  []RuleFailure `json:"failedRules"`
+	Duration     time.Duration `json:"duration"`
+	PolicyHash   string        `json:"policyHash"`
+	// Restriction lists the access restrictions of the clinic which denied the request regardless of the policy
+	Restriction string `json:"restriction,omitempty"`
+}
+
+// PolicyRule references an "allow" rule of the authorization policy
+type PolicyRule struct {
+	Line        int    `json:"line"`
+	Description string `json:"description"`
+}
+
+// RuleFailure explains why an "allow" rule for the requested method and path didn't match
+type RuleFailure struct {
+	Rule        PolicyRule `json:"rule"`
+	Expressions []string   `json:"expressions"`
+}
+
+type DecisionLogConfig struct {
+	Enabled         bool    `envconfig:"TIDEPOOL_AUTHZ_DECISION_LOG_ENABLED" default:"false"`
+	SampleRate      float64 `envconfig:"TIDEPOOL_AUTHZ_DECISION_LOG_SAMPLE_RATE" default:"1"`
+	AlwaysLogDenied bool    `envconfig:"TIDEPOOL_AUTHZ_DECISION_LOG_ALWAYS_LOG_DENIED" default:"true"`
+}
+
+func NewDecisionLogConfig() (DecisionLogConfig, error) {
+	cfg := DecisionLogConfig{}
+	err := envconfig.Process("", &cfg)
+	return cfg, err
+}
+
+// DecisionSink receives the logged authorization decisions
+type DecisionSink interface {
+	Write(ctx context.Context, decision Decision)
+}
+
+type loggerDecisionSink struct {
+	logger *zap.SugaredLogger
+}
+
+// NewLoggerDecisionSink returns a sink which writes the decisions to the service log
+func NewLoggerDecisionSink(logger *zap.SugaredLogger) DecisionSink {
+	return &loggerDecisionSink{
+		logger: logger.Named("authz"),
+	}
+}
+
+func (l *loggerDecisionSink) Write(_ context.Context, decision Decision) {
+	l.logger.Infow("authorization decision",
+		"method", decision.Method,
+		"path", decision.Path,
+		"subjectId", decision.SubjectId,
+		"serverAccess", decision.ServerAccess,
+		"allow", decision.Allow,
+		"restriction", decision.Restriction,
+		"matchedRules", decision.MatchedRules,
+		"failedRules", decision.FailedRules,
+		"duration", decision.Duration,
+		"policyHash", decision.PolicyHash,
+	)
+}
+
+// DecisionLogger decides which authorization decisions are written to the sink. A nil logger doesn't log any decisions.
+type DecisionLogger struct {
+	config DecisionLogConfig
+	sink   DecisionSink
+	sample func() float64
+}
+
+func NewDecisionLogger(config DecisionLogConfig, sink DecisionSink) *DecisionLogger {
+	return &DecisionLogger{
+		config: config,
+		sink:   sink,
+		sample: rand.Float64,
+	}
+}
+
+// Sampled returns true if the next decision should be logged regardless of its result
+func (d *DecisionLogger) Sampled() bool {
+	if d == nil || !d.config.Enabled {
+		return false
+	}
+	return d.sample() < d.config.SampleRate
+}
+
+// ShouldLog returns true if the decision must be logged
+func (d *DecisionLogger) ShouldLog(allow bool, sampled bool) bool {
+	if d == nil || !d.config.Enabled {
+		return false
+	}
+	return sampled || (!allow && d.config.AlwaysLogDenied)
+}
+
+func (d *DecisionLogger) Write(ctx context.Context, decision Decision) {
+	if d == nil {
+		return
+	}
+	d.sink.Write(ctx, decision)
+}
+
+// policyRule is an "allow" rule of the policy with the lines it spans
+type policyRule struct {
+	PolicyRule
+
+	rule    *ast.Rule
+	file    string
+	endLine int
+}
+
+func (p policyRule) contains(location *ast.Location) bool {
+	return location != nil && location.File == p.file && location.Row >= p.Line && location.Row <= p.endLine
+}
+
+// matches returns true if the method and path conditions of the rule are satisfied by the request
+func (p policyRule) matches(method string, path []string) bool {
+	for _, expr := range p.rule.Body {
+		if !expr.IsEquality() && !isComparison(expr) {
+			continue
+		}
+		operands := expr.Operands()
+		if len(operands) != 2 {
+			continue
+		}
+		ref, ok := operands[0].Value.(ast.Ref)
+		if !ok {
+			continue
+		}
+		switch {
+		case ref.Equal(inputMethodRef):
+			if m, ok := operands[1].Value.(ast.String); ok && string(m) != method {
+				return false
+			}
+		case ref.Equal(inputPathRef):
+			if pattern, ok := operands[1].Value.(*ast.Array); ok && !matchesPath(pattern, path) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isComparison(expr *ast.Expr) bool {
+	return expr.IsCall() && expr.Operator().Equal(ast.Equal.Ref())
+}
+
+func matchesPath(pattern *ast.Array, path []string) bool {
+	if pattern.Len() != len(path) {
+		return false
+	}
+	for i := 0; i < pattern.Len(); i++ {
+		if segment, ok := pattern.Elem(i).Value.(ast.String); ok && string(segment) != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// getAllowRules returns the "allow" rules of the policy modules described by the comment preceding them. Rules
+// without a comment share the description of the previous rule (e.g. multiple rules for the same endpoint).
+func getAllowRules(compiler *ast.Compiler) []policyRule {
+	names := make([]string, 0, len(compiler.Modules))
+	for name := range compiler.Modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var rules []policyRule
+	for _, name := range names {
+		module := compiler.Modules[name]
+		comments := make(map[int]string, len(module.Comments))
+		for _, comment := range module.Comments {
+			comments[comment.Location.Row] = strings.TrimSpace(string(comment.Text))
+		}
+
+		var description string
+		for _, rule := range module.Rules {
+			if rule.Default || !rule.Ref().Equal(allowRuleRef) || rule.Location == nil {
+				continue
+			}
+
+			var lines []string
+			for row := rule.Location.Row - 1; row > 0; row-- {
+				text, ok := comments[row]
+				if !ok {
+					break
+				}
+				lines = append([]string{text}, lines...)
+			}
+			if len(lines) > 0 {
+				description = strings.Join(lines, " ")
+			}
+
+			rules = append(rules, policyRule{
+				PolicyRule: PolicyRule{
+					Line:        rule.Location.Row,
+					Description: description,
+				},
+				rule:    rule,
+				file:    name,
+				endLine: rule.Location.Row + strings.Count(string(rule.Location.Text), "\n"),
+			})
+		}
+	}
+
+	return rules
+}
+
+// explain returns the "allow" rules which matched and the failed expressions of the rules for the requested
+// method and path, which were not satisfied by the request
+func explain(rules []policyRule, events []*topdown.Event, method string, path []string) ([]PolicyRule, []RuleFailure) {
+	matched := make([]PolicyRule, 0)
+	failed := make([]RuleFailure, 0)
+
+	for _, rule := range rules {
+		exited := false
+		var expressions []string
+		for _, event := range events {
+			if !rule.contains(event.Location) {
+				continue
+			}
+			switch {
+			case event.Op == topdown.ExitOp && event.HasRule():
+				exited = true
+			case event.Op == topdown.FailOp && event.HasExpr():
+				if expression := string(event.Location.Text); !slices.Contains(expressions, expression) {
+					expressions = append(expressions, expression)
+				}
+			}
+		}
+
+		if exited {
+			matched = append(matched, rule.PolicyRule)
+		} else if rule.matches(method, path) {
+			failed = append(failed, RuleFailure{
+				Rule:        rule.PolicyRule,
+				Expressions: expressions,
+			})
+		}
+	}
+
+	return matched, failed
+}
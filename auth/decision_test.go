@@ -0,0 +1,143 @@
+package auth_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/auth"
+)
+
+type decisionSink struct {
+	decisions []auth.Decision
+}
+
+func (d *decisionSink) Write(_ context.Context, decision auth.Decision) {
+	d.decisions = append(d.decisions, decision)
+}
+
+var _ = Describe("Decisions", func() {
+	var authorizer auth.RequestAuthorizer
+	var sink *decisionSink
+	var config auth.DecisionLogConfig
+
+	BeforeEach(func() {
+		sink = &decisionSink{}
+		config = auth.DecisionLogConfig{
+			Enabled:         true,
+			SampleRate:      0,
+			AlwaysLogDenied: true,
+		}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		authorizer, err = auth.NewRequestAuthorizer(nil, nil, nil, auth.NewDecisionLogger(config, sink), nil, zap.NewNop().Sugar())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Explain", func() {
+		It("returns the matched rule with its description", func() {
+			decision, err := authorizer.Explain(context.Background(), auth.PolicyRequest{
+				Method: "GET",
+				Path:   "/v1/clinics",
+				Auth:   &auth.Auth{SubjectId: "hydrophone", ServerAccess: true},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decision.Allow).To(BeTrue())
+			Expect(decision.Method).To(Equal("GET"))
+			Expect(decision.Path).To(Equal("/v1/clinics"))
+			Expect(decision.SubjectId).To(Equal("hydrophone"))
+			Expect(decision.MatchedRules).To(HaveLen(1))
+			Expect(decision.MatchedRules[0].Description).To(Equal("Allow backend services to list all clinics GET /v1/clinics"))
+			Expect(decision.FailedRules).To(BeEmpty())
+		})
+
+		It("returns the failed expressions of the rules for the method and path", func() {
+			decision, err := authorizer.Explain(context.Background(), auth.PolicyRequest{
+				Method: "GET",
+				Path:   "/v1/clinics",
+				Auth:   &auth.Auth{SubjectId: "1234567890"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decision.Allow).To(BeFalse())
+			Expect(decision.MatchedRules).To(BeEmpty())
+			Expect(decision.FailedRules).To(HaveLen(1))
+			Expect(decision.FailedRules[0].Rule.Description).To(Equal("Allow backend services to list all clinics GET /v1/clinics"))
+			Expect(decision.FailedRules[0].Expressions).To(ConsistOf("is_backend_service"))
+		})
+
+		It("doesn't return rules for other paths", func() {
+			decision, err := authorizer.Explain(context.Background(), auth.PolicyRequest{
+				Method: "DELETE",
+				Path:   "/v1/unknown",
+				Auth:   &auth.Auth{SubjectId: "1234567890"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decision.Allow).To(BeFalse())
+			Expect(decision.MatchedRules).To(BeEmpty())
+			Expect(decision.FailedRules).To(BeEmpty())
+		})
+	})
+
+	Describe("Logging", func() {
+		allowed := map[string]interface{}{
+			"path":   []string{"v1", "clinics"},
+			"method": "GET",
+			"auth": map[string]interface{}{
+				"subjectId":    "hydrophone",
+				"serverAccess": true,
+			},
+		}
+		denied := map[string]interface{}{
+			"path":   []string{"v1", "clinics", "6066fbabc6f484277200ac64", "clinicians", "0987654321"},
+			"method": "DELETE",
+			"auth": map[string]interface{}{
+				"subjectId":    "1234567890",
+				"serverAccess": false,
+			},
+			"clinician": clinicMember,
+		}
+
+		It("explains denied decisions", func() {
+			Expect(authorizer.EvaluatePolicy(context.Background(), denied)).To(Equal(auth.ErrUnauthorized))
+			Expect(sink.decisions).To(HaveLen(1))
+			Expect(sink.decisions[0].Allow).To(BeFalse())
+			Expect(sink.decisions[0].SubjectId).To(Equal("1234567890"))
+			Expect(sink.decisions[0].Path).To(Equal("/v1/clinics/6066fbabc6f484277200ac64/clinicians/0987654321"))
+			Expect(sink.decisions[0].FailedRules).ToNot(BeEmpty())
+		})
+
+		It("doesn't log allowed decisions which are not sampled", func() {
+			Expect(authorizer.EvaluatePolicy(context.Background(), allowed)).To(Succeed())
+			Expect(sink.decisions).To(BeEmpty())
+		})
+
+		When("all decisions are sampled", func() {
+			BeforeEach(func() {
+				config.SampleRate = 1
+			})
+
+			It("logs allowed decisions with the matched rule", func() {
+				Expect(authorizer.EvaluatePolicy(context.Background(), allowed)).To(Succeed())
+				Expect(sink.decisions).To(HaveLen(1))
+				Expect(sink.decisions[0].Allow).To(BeTrue())
+				Expect(sink.decisions[0].ServerAccess).To(BeTrue())
+				Expect(sink.decisions[0].MatchedRules).To(HaveLen(1))
+			})
+		})
+
+		When("logging is disabled", func() {
+			BeforeEach(func() {
+				config.Enabled = false
+			})
+
+			It("doesn't log denied decisions", func() {
+				Expect(authorizer.EvaluatePolicy(context.Background(), denied)).To(Equal(auth.ErrUnauthorized))
+				Expect(sink.decisions).To(BeEmpty())
+			})
+		})
+	})
+})
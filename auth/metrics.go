@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "tidepool"
+	metricsSubsystem = "clinic_auth_cache"
+
+	cacheResultHit         = "hit"
+	cacheResultMiss        = "miss"
+	cacheResultNegativeHit = "negative_hit"
+	cacheResultRateLimited = "rate_limited"
+)
+
+type cacheMetrics struct {
+	lookups       *prometheus.CounterVec
+	invalidations prometheus.Counter
+}
+
+var (
+	defaultCacheMetrics     *cacheMetrics
+	defaultCacheMetricsOnce sync.Once
+)
+
+// newCacheMetrics returns the authentication cache metrics. The collectors are registered with the default
+// prometheus registry only once, because the cache may be instantiated multiple times.
+func newCacheMetrics() *cacheMetrics {
+	defaultCacheMetricsOnce.Do(func() {
+		defaultCacheMetrics = &cacheMetrics{
+			lookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Subsystem: metricsSubsystem,
+				Name:      "lookups_total",
+				Help:      "The total number of token lookups in the authentication cache",
+			}, []string{"result"}),
+			invalidations: prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Subsystem: metricsSubsystem,
+				Name:      "invalidations_total",
+				Help:      "The total number of tokens evicted from the authentication cache",
+			}),
+		}
+
+		prometheus.MustRegister(
+			defaultCacheMetrics.lookups,
+			defaultCacheMetrics.invalidations,
+		)
+	})
+
+	return defaultCacheMetrics
+}
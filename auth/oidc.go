@@ -0,0 +1,324 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/kelseyhightower/envconfig"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/sync/singleflight"
+)
+
+var (
+	ErrUnknownSigningKey = fmt.Errorf("the token signing key is unknown")
+
+	oidcSigningMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}
+)
+
+type OIDCConfig struct {
+	Enabled  bool   `envconfig:"TIDEPOOL_OIDC_ENABLED" default:"false"`
+	Issuer   string `envconfig:"TIDEPOOL_OIDC_ISSUER"`
+	JWKSURL  string `envconfig:"TIDEPOOL_OIDC_JWKS_URL"`
+	Audience string `envconfig:"TIDEPOOL_OIDC_AUDIENCE"`
+	// ServerAccessScope grants server access to tokens with the scope (e.g. tokens of backend service accounts)
+	ServerAccessScope string `envconfig:"TIDEPOOL_OIDC_SERVER_ACCESS_SCOPE"`
+	// JWKSRefreshInterval is the maximum age of the cached signing keys
+	JWKSRefreshInterval time.Duration `envconfig:"TIDEPOOL_OIDC_JWKS_REFRESH_INTERVAL" default:"1h"`
+	// JWKSMinRefreshInterval limits how often the keys are refreshed when a token is signed by an unknown key
+	JWKSMinRefreshInterval time.Duration `envconfig:"TIDEPOOL_OIDC_JWKS_MIN_REFRESH_INTERVAL" default:"1m"`
+}
+
+func NewOIDCConfig() (OIDCConfig, error) {
+	cfg := OIDCConfig{}
+	if err := envconfig.Process("", &cfg); err != nil {
+		return cfg, err
+	}
+	if cfg.Enabled && (cfg.Issuer == "" || cfg.JWKSURL == "") {
+		return cfg, fmt.Errorf("oidc issuer and jwks url are required when oidc authentication is enabled")
+	}
+	return cfg, nil
+}
+
+// OIDCClaims are the claims of the access tokens issued by the identity provider
+type OIDCClaims struct {
+	jwt.RegisteredClaims
+
+	// Scope is a space separated list of scopes
+	Scope string `json:"scope,omitempty"`
+	// AuthenticationMethods are the methods used to authenticate the user (e.g. "pwd" and "otp")
+	AuthenticationMethods []string         `json:"amr,omitempty"`
+	AuthTime              *jwt.NumericDate `json:"auth_time,omitempty"`
+}
+
+func (c OIDCClaims) GetScopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+// OIDCAuthenticator validates bearer tokens signed by the keys of the identity provider
+type OIDCAuthenticator struct {
+	config OIDCConfig
+	keys   *JWKSCache
+}
+
+var _ Authenticator = &OIDCAuthenticator{}
+
+func NewOIDCAuthenticator(config OIDCConfig, httpClient *http.Client) *OIDCAuthenticator {
+	return &OIDCAuthenticator{
+		config: config,
+		keys:   NewJWKSCache(config.JWKSURL, config.JWKSRefreshInterval, config.JWKSMinRefreshInterval, httpClient),
+	}
+}
+
+func (o *OIDCAuthenticator) ValidateAndSetAuthData(token string, ec echo.Context) (bool, error) {
+	claims := OIDCClaims{}
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return o.keys.Get(kid)
+	}, jwt.WithValidMethods(oidcSigningMethods))
+	if errors.Is(err, ErrAuthenticationUnavailable) {
+		return false, err
+	} else if err != nil {
+		return false, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	}
+
+	if !claims.VerifyIssuer(o.config.Issuer, true) {
+		return false, fmt.Errorf("%w: unexpected token issuer", ErrUnauthenticated)
+	}
+	if o.config.Audience != "" && !claims.VerifyAudience(o.config.Audience, true) {
+		return false, fmt.Errorf("%w: unexpected token audience", ErrUnauthenticated)
+	}
+	if claims.Subject == "" {
+		return false, fmt.Errorf("%w: token subject is missing", ErrUnauthenticated)
+	}
+
+	scopes := claims.GetScopes()
+	authData := &Auth{
+		SubjectId:             claims.Subject,
+		ServerAccess:          o.config.ServerAccessScope != "" && slices.Contains(scopes, o.config.ServerAccessScope),
+		Scopes:                scopes,
+		AuthenticationMethods: claims.AuthenticationMethods,
+	}
+	if claims.AuthTime != nil {
+		authData.AuthenticationTime = &claims.AuthTime.Time
+	}
+	SetAuthData(ec, authData)
+
+	return true, nil
+}
+
+// JWKSCache caches the signing keys of a JSON web key set. The keys are refreshed when they are older than the refresh
+// interval or when a token is signed by an unknown key, which happens after the identity provider rotated its keys.
+// Concurrent refreshes are coalesced and are performed outside of the lock, so requests with cached keys are never
+// blocked by a slow identity provider.
+type JWKSCache struct {
+	url                string
+	refreshInterval    time.Duration
+	minRefreshInterval time.Duration
+	httpClient         *http.Client
+	group              singleflight.Group
+
+	mu          sync.Mutex
+	keys        map[string]crypto.PublicKey
+	refreshedAt time.Time
+	attemptedAt time.Time
+}
+
+func NewJWKSCache(url string, refreshInterval, minRefreshInterval time.Duration, httpClient *http.Client) *JWKSCache {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &JWKSCache{
+		url:                url,
+		refreshInterval:    refreshInterval,
+		minRefreshInterval: minRefreshInterval,
+		httpClient:         httpClient,
+	}
+}
+
+// Get returns the key with the given id
+func (j *JWKSCache) Get(kid string) (crypto.PublicKey, error) {
+	j.mu.Lock()
+	key, found := j.keys[kid]
+	fresh := found && time.Since(j.refreshedAt) < j.refreshInterval
+	// Rate limit the refreshes if the identity provider is unavailable or the key is unknown
+	throttled := !j.attemptedAt.IsZero() && time.Since(j.attemptedAt) < j.minRefreshInterval
+	j.mu.Unlock()
+
+	if fresh || (found && throttled) {
+		return key, nil
+	} else if throttled {
+		return nil, ErrUnknownSigningKey
+	}
+
+	_, err, _ := j.group.Do("refresh", func() (interface{}, error) {
+		return nil, j.refresh()
+	})
+	if err != nil {
+		// Keep using the cached keys if the identity provider is temporarily unavailable
+		if found {
+			return key, nil
+		}
+		return nil, err
+	}
+
+	j.mu.Lock()
+	key, found = j.keys[kid]
+	j.mu.Unlock()
+
+	if !found {
+		return nil, ErrUnknownSigningKey
+	}
+	return key, nil
+}
+
+// refresh fetches the keys from the identity provider. The refresh isn't bound to the context of the request which
+// triggered it, because its result is shared with all concurrent requests.
+func (j *JWKSCache) refresh() error {
+	j.mu.Lock()
+	j.attemptedAt = time.Now()
+	j.mu.Unlock()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, j.url, nil)
+	if err != nil {
+		return err
+	}
+	res, err := j.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: unable to fetch jwks: %w", ErrAuthenticationUnavailable, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: unable to fetch jwks: unexpected status code %d", ErrAuthenticationUnavailable, res.StatusCode)
+	}
+
+	set := jsonWebKeySet{}
+	if err := json.NewDecoder(res.Body).Decode(&set); err != nil {
+		return fmt.Errorf("%w: unable to decode jwks: %w", ErrAuthenticationUnavailable, err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.PublicKey()
+		if err != nil {
+			// Ignore unsupported keys, tokens signed by them will be rejected
+			continue
+		}
+		keys[k.Kid] = key
+	}
+
+	j.mu.Lock()
+	j.keys = keys
+	j.refreshedAt = time.Now()
+	j.mu.Unlock()
+
+	return nil
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBase64BigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBase64BigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decodeBase64BigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBase64BigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+func decodeBase64BigInt(value string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// ChainAuthenticator authenticates tokens with the first authenticator which accepts them. If no authenticator accepts
+// the token and one of them was unavailable, the token is not considered invalid.
+type ChainAuthenticator struct {
+	authenticators []Authenticator
+}
+
+var _ Authenticator = &ChainAuthenticator{}
+
+func NewChainAuthenticator(authenticators ...Authenticator) *ChainAuthenticator {
+	return &ChainAuthenticator{authenticators: authenticators}
+}
+
+func (c *ChainAuthenticator) ValidateAndSetAuthData(token string, ec echo.Context) (bool, error) {
+	err := ErrUnauthenticated
+	var unavailable error
+	for _, authenticator := range c.authenticators {
+		var valid bool
+		valid, err = authenticator.ValidateAndSetAuthData(token, ec)
+		if err == nil && valid {
+			return true, nil
+		} else if errors.Is(err, ErrAuthenticationUnavailable) {
+			unavailable = err
+		}
+	}
+	if unavailable != nil {
+		return false, unavailable
+	}
+	return false, err
+}
@@ -0,0 +1,245 @@
+package auth_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tidepool-org/clinic/auth"
+)
+
+const oidcIssuer = "https://auth.tidepool.org/realms/tidepool"
+
+type signingKey struct {
+	kid        string
+	privateKey *rsa.PrivateKey
+}
+
+func newSigningKey(kid string) signingKey {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).ToNot(HaveOccurred())
+	return signingKey{kid: kid, privateKey: privateKey}
+}
+
+func (s signingKey) jwk() map[string]string {
+	return map[string]string{
+		"kid": s.kid,
+		"kty": "RSA",
+		"use": "sig",
+		"alg": "RS256",
+		"n":   base64.RawURLEncoding.EncodeToString(s.privateKey.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.privateKey.E)).Bytes()),
+	}
+}
+
+func (s signingKey) sign(claims auth.OIDCClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = s.kid
+	signed, err := token.SignedString(s.privateKey)
+	Expect(err).ToNot(HaveOccurred())
+	return signed
+}
+
+type staticAuthenticator struct {
+	auth *auth.Auth
+}
+
+func (s staticAuthenticator) ValidateAndSetAuthData(_ string, ec echo.Context) (bool, error) {
+	if s.auth == nil {
+		return false, auth.ErrUnauthenticated
+	}
+	auth.SetAuthData(ec, s.auth)
+	return true, nil
+}
+
+var _ = Describe("OIDC Authenticator", func() {
+	var keys []signingKey
+	var requests atomic.Int32
+	var unavailable atomic.Bool
+	var server *httptest.Server
+	var config auth.OIDCConfig
+	var authenticator *auth.OIDCAuthenticator
+	var claims auth.OIDCClaims
+
+	newContext := func() echo.Context {
+		return echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/v1/clinics", nil), httptest.NewRecorder())
+	}
+
+	BeforeEach(func() {
+		keys = []signingKey{newSigningKey("first")}
+		requests.Store(0)
+		unavailable.Store(false)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			if unavailable.Load() {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			set := map[string]interface{}{"keys": []map[string]string{}}
+			for _, key := range keys {
+				set["keys"] = append(set["keys"].([]map[string]string), key.jwk())
+			}
+			Expect(json.NewEncoder(w).Encode(set)).To(Succeed())
+		}))
+
+		config = auth.OIDCConfig{
+			Enabled:                true,
+			Issuer:                 oidcIssuer,
+			JWKSURL:                server.URL,
+			Audience:               "clinic",
+			ServerAccessScope:      "clinic:server",
+			JWKSRefreshInterval:    time.Hour,
+			JWKSMinRefreshInterval: 0,
+		}
+		claims = auth.OIDCClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    oidcIssuer,
+				Subject:   "1234567890",
+				Audience:  jwt.ClaimStrings{"clinic"},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			Scope: "openid clinics:read",
+		}
+	})
+
+	JustBeforeEach(func() {
+		authenticator = auth.NewOIDCAuthenticator(config, server.Client())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("sets the auth data from the token claims", func() {
+		ec := newContext()
+		valid, err := authenticator.ValidateAndSetAuthData(keys[0].sign(claims), ec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(valid).To(BeTrue())
+		Expect(auth.GetAuthData(ec.Request().Context())).To(Equal(&auth.Auth{
+			SubjectId:    "1234567890",
+			ServerAccess: false,
+			Scopes:       []string{"openid", "clinics:read"},
+		}))
+	})
+
+	It("grants server access to tokens with the server access scope", func() {
+		claims.Scope = "clinic:server"
+		ec := newContext()
+		_, err := authenticator.ValidateAndSetAuthData(keys[0].sign(claims), ec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(auth.GetAuthData(ec.Request().Context()).ServerAccess).To(BeTrue())
+	})
+
+	It("caches the signing keys", func() {
+		for i := 0; i < 3; i++ {
+			_, err := authenticator.ValidateAndSetAuthData(keys[0].sign(claims), newContext())
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(requests.Load()).To(Equal(int32(1)))
+	})
+
+	It("refreshes the signing keys when they are rotated", func() {
+		_, err := authenticator.ValidateAndSetAuthData(keys[0].sign(claims), newContext())
+		Expect(err).ToNot(HaveOccurred())
+
+		keys = []signingKey{newSigningKey("second")}
+		_, err = authenticator.ValidateAndSetAuthData(keys[0].sign(claims), newContext())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests.Load()).To(Equal(int32(2)))
+	})
+
+	It("retries the refresh of expired keys after the identity provider was unavailable", func() {
+		config.JWKSRefreshInterval = 50 * time.Millisecond
+		authenticator = auth.NewOIDCAuthenticator(config, server.Client())
+
+		_, err := authenticator.ValidateAndSetAuthData(keys[0].sign(claims), newContext())
+		Expect(err).ToNot(HaveOccurred())
+		time.Sleep(60 * time.Millisecond)
+
+		unavailable.Store(true)
+		_, err = authenticator.ValidateAndSetAuthData(keys[0].sign(claims), newContext())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests.Load()).To(Equal(int32(2)))
+
+		unavailable.Store(false)
+		_, err = authenticator.ValidateAndSetAuthData(keys[0].sign(claims), newContext())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests.Load()).To(Equal(int32(3)))
+	})
+
+	It("rejects tokens with invalid signatures", func() {
+		_, err := authenticator.ValidateAndSetAuthData(newSigningKey("first").sign(claims), newContext())
+		Expect(err).To(MatchError(auth.ErrUnauthenticated))
+	})
+
+	It("rejects expired tokens", func() {
+		claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+		_, err := authenticator.ValidateAndSetAuthData(keys[0].sign(claims), newContext())
+		Expect(err).To(MatchError(auth.ErrUnauthenticated))
+	})
+
+	It("rejects tokens of other issuers", func() {
+		claims.Issuer = "https://example.com"
+		_, err := authenticator.ValidateAndSetAuthData(keys[0].sign(claims), newContext())
+		Expect(err).To(MatchError(auth.ErrUnauthenticated))
+	})
+
+	It("rejects tokens for other audiences", func() {
+		claims.Audience = jwt.ClaimStrings{"other"}
+		_, err := authenticator.ValidateAndSetAuthData(keys[0].sign(claims), newContext())
+		Expect(err).To(MatchError(auth.ErrUnauthenticated))
+	})
+
+	It("rejects tokens signed with a shared secret", func() {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = authenticator.ValidateAndSetAuthData(token, newContext())
+		Expect(err).To(MatchError(auth.ErrUnauthenticated))
+	})
+
+	Describe("Chain", func() {
+		It("falls back to the next authenticator", func() {
+			chain := auth.NewChainAuthenticator(authenticator, staticAuthenticator{auth: &auth.Auth{SubjectId: "shoreline-user"}})
+			ec := newContext()
+			valid, err := chain.ValidateAndSetAuthData("shoreline-session-token", ec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(valid).To(BeTrue())
+			Expect(auth.GetAuthData(ec.Request().Context()).SubjectId).To(Equal("shoreline-user"))
+		})
+
+		It("fails if no authenticator accepts the token", func() {
+			chain := auth.NewChainAuthenticator(authenticator, staticAuthenticator{})
+			valid, err := chain.ValidateAndSetAuthData("invalid", newContext())
+			Expect(err).To(MatchError(auth.ErrUnauthenticated))
+			Expect(valid).To(BeFalse())
+		})
+	})
+
+	Describe("Middleware", func() {
+		It("accepts bearer tokens", func() {
+			middleware := auth.NewAuthMiddleware(authenticator, auth.AuthMiddlewareOpts{})
+			req := httptest.NewRequest(http.MethodGet, "/v1/clinics", nil)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+keys[0].sign(claims))
+			ec := echo.New().NewContext(req, httptest.NewRecorder())
+
+			var subjectId string
+			err := middleware(func(c echo.Context) error {
+				subjectId = auth.GetAuthData(c.Request().Context()).SubjectId
+				return nil
+			})(ec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(subjectId).To(Equal("1234567890"))
+		})
+	})
+})
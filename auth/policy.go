@@ -0,0 +1,305 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/open-policy-agent/opa/loader"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+const embeddedPolicySource = "embedded"
+
+var allowRuleRef = ast.MustParseRef("data.http.authz.clinic.allow")
+
+type PolicyConfig struct {
+	// Path is a rego file, a bundle directory or a bundle tarball (.tar.gz). The embedded policy is used if it's empty.
+	Path           string        `envconfig:"TIDEPOOL_AUTHZ_POLICY_PATH"`
+	ReloadInterval time.Duration `envconfig:"TIDEPOOL_AUTHZ_POLICY_RELOAD_INTERVAL" default:"30s"`
+}
+
+func NewPolicyConfig() (PolicyConfig, error) {
+	cfg := PolicyConfig{}
+	err := envconfig.Process("", &cfg)
+	return cfg, err
+}
+
+// Policy is a compiled authorization policy
+type Policy struct {
+	Source     string
+	Revision   string
+	Hash       string
+	LoadedTime time.Time
+
+	compiler *ast.Compiler
+	rules    []policyRule
+}
+
+// PolicyStatus describes the active policy and the last failed reload
+type PolicyStatus struct {
+	Source        string     `json:"source"`
+	Revision      string     `json:"revision,omitempty"`
+	Hash          string     `json:"hash"`
+	LoadedTime    time.Time  `json:"loadedTime"`
+	LastError     string     `json:"lastError,omitempty"`
+	LastErrorTime *time.Time `json:"lastErrorTime,omitempty"`
+}
+
+// PolicyStore holds the active authorization policy. If a policy path is configured the policy is reloaded
+// periodically when the files change. A policy which fails to compile is rejected and the last good policy is kept.
+type PolicyStore struct {
+	config PolicyConfig
+	logger *zap.SugaredLogger
+	policy atomic.Pointer[Policy]
+
+	mu            sync.Mutex
+	lastError     error
+	lastErrorTime time.Time
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewPolicyStore(config PolicyConfig, logger *zap.SugaredLogger, lifecycle fx.Lifecycle) (*PolicyStore, error) {
+	store := &PolicyStore{
+		config: config,
+		logger: logger,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+
+	if config.Path == "" {
+		policy, err := NewEmbeddedPolicy()
+		if err != nil {
+			return nil, err
+		}
+		store.policy.Store(policy)
+		return store, nil
+	}
+
+	policy, err := LoadPolicy(config.Path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load authorization policy: %w", err)
+	}
+	store.policy.Store(policy)
+	logger.Infow("loaded authorization policy", "source", policy.Source, "revision", policy.Revision, "hash", policy.Hash)
+
+	if config.ReloadInterval <= 0 {
+		return store, nil
+	}
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go store.run()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			close(store.stop)
+			select {
+			case <-store.done:
+			case <-ctx.Done():
+			}
+			return nil
+		},
+	})
+
+	return store, nil
+}
+
+// NewEmbeddedPolicyStore returns a store which always uses the embedded policy
+func NewEmbeddedPolicyStore() (*PolicyStore, error) {
+	policy, err := NewEmbeddedPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	store := &PolicyStore{}
+	store.policy.Store(policy)
+	return store, nil
+}
+
+func (s *PolicyStore) run() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.config.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			_ = s.Reload()
+		}
+	}
+}
+
+// Get returns the active policy
+func (s *PolicyStore) Get() *Policy {
+	return s.policy.Load()
+}
+
+// Reload loads the policy from the configured path and activates it if it changed and compiles successfully. The
+// policy is only recompiled when the hash of its modules changed.
+func (s *PolicyStore) Reload() error {
+	if s.config.Path == "" {
+		return nil
+	}
+
+	policy, err := s.load()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err != nil {
+		s.lastError = err
+		s.lastErrorTime = time.Now()
+		s.logger.Errorw("unable to reload authorization policy, keeping the active policy", "source", s.config.Path, "hash", s.Get().Hash, zap.Error(err))
+		return err
+	}
+
+	s.lastError = nil
+	if policy == nil {
+		return nil
+	}
+
+	s.policy.Store(policy)
+	s.logger.Infow("reloaded authorization policy", "source", policy.Source, "revision", policy.Revision, "hash", policy.Hash)
+
+	return nil
+}
+
+// load returns the compiled policy from the configured path or nil if the modules didn't change
+func (s *PolicyStore) load() (*Policy, error) {
+	revision, modules, err := loadPolicyModules(s.config.Path)
+	if err != nil {
+		return nil, err
+	}
+	if hashModules(modules) == s.Get().Hash {
+		return nil, nil
+	}
+
+	return compilePolicy(s.config.Path, revision, modules)
+}
+
+func (s *PolicyStore) Status() PolicyStatus {
+	policy := s.Get()
+	status := PolicyStatus{
+		Source:     policy.Source,
+		Revision:   policy.Revision,
+		Hash:       policy.Hash,
+		LoadedTime: policy.LoadedTime,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lastError != nil {
+		lastErrorTime := s.lastErrorTime
+		status.LastError = s.lastError.Error()
+		status.LastErrorTime = &lastErrorTime
+	}
+
+	return status
+}
+
+// NewEmbeddedPolicy compiles the policy embedded in the service
+func NewEmbeddedPolicy() (*Policy, error) {
+	return compilePolicy(embeddedPolicySource, "", map[string]string{
+		policyModuleName: authzPolicy,
+	})
+}
+
+// LoadPolicy loads and compiles the policy from a rego file, a bundle directory or a bundle tarball
+func LoadPolicy(path string) (*Policy, error) {
+	revision, modules, err := loadPolicyModules(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return compilePolicy(path, revision, modules)
+}
+
+// loadPolicyModules returns the revision and the modules of a rego file, a bundle directory or a bundle tarball
+func loadPolicyModules(path string) (string, map[string]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !info.IsDir() && filepath.Ext(path) == ".rego" {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return "", nil, err
+		}
+		return "", map[string]string{
+			filepath.Base(path): string(raw),
+		}, nil
+	}
+
+	bundle, err := loader.NewFileLoader().WithSkipBundleVerification(true).AsBundle(path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	modules := make(map[string]string, len(bundle.Modules))
+	for _, module := range bundle.Modules {
+		modules[strings.TrimPrefix(module.Path, "/")] = string(module.Raw)
+	}
+
+	return bundle.Manifest.Revision, modules, nil
+}
+
+func compilePolicy(source string, revision string, modules map[string]string) (*Policy, error) {
+	if len(modules) == 0 {
+		return nil, fmt.Errorf("the policy doesn't contain any modules")
+	}
+
+	compiler, err := ast.CompileModules(modules)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(compiler.GetRulesExact(allowRuleRef)) == 0 {
+		return nil, fmt.Errorf("the policy doesn't define %v", allowRuleRef)
+	}
+
+	return &Policy{
+		Source:     source,
+		Revision:   revision,
+		Hash:       hashModules(modules),
+		LoadedTime: time.Now(),
+		compiler:   compiler,
+		rules:      getAllowRules(compiler),
+	}, nil
+}
+
+// hashModules returns the sha256 hash of the module names and contents
+func hashModules(modules map[string]string) string {
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	for _, name := range names {
+		hash.Write([]byte(name))
+		hash.Write([]byte{0})
+		hash.Write([]byte(modules[name]))
+		hash.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
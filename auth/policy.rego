@@ -5,27 +5,59 @@ is_backend_service := input.auth.serverAccess == true
 
 is_authenticated_user {
   not is_backend_service
+  not is_api_key
   subject_id
 }
 
-read_access_roles := {
-  "CLINIC_MEMBER",
-  "CLINIC_ADMIN"
+# requests authenticated with a clinic api key act as the service account of the key,
+# but only with the permissions granted by the scopes of the key in the clinic which issued it
+is_api_key {
+  input.auth.apiKey
 }
 
-write_access_roles := {
-  "CLINIC_ADMIN"
+api_key_grants(permission) {
+  not is_api_key
+}
+
+api_key_grants(permission) {
+  input.auth.scopes[_] == permission
+  input.path[2] == input.auth.apiKey.clinicId
 }
 
 # convert clinician roles to set
 clinician_roles := { x | x = input.clinician.roles[_] }
 
-clinician_has_read_access {
-  count(clinician_roles & read_access_roles) > 0
+# the permissions of the built-in and custom roles of the clinician are resolved by the service
+clinician_permissions := { p | p := input.clinician.permissions[_] }
+
+clinician_has_permission(permission) {
+  clinician_permissions[permission]
+  api_key_grants(permission)
+}
+
+is_clinic_member {
+  count(clinician_roles) > 0
+}
+
+is_clinic_admin {
+  clinician_roles["CLINIC_ADMIN"]
 }
 
-clinician_has_write_access {
-  count(clinician_roles & write_access_roles) > 0
+# clinicians with assigned sites can only access the patients of these sites, admins are never restricted
+clinician_is_site_restricted {
+  not is_clinic_admin
+  is_array(input.clinician.sites)
+}
+
+clinician_site_ids := { s.id | s := input.clinician.sites[_] }
+patient_site_ids := { s.id | s := input.patient.sites[_] }
+
+clinician_has_patient_site_access {
+  not clinician_is_site_restricted
+}
+
+clinician_has_patient_site_access {
+  count(clinician_site_ids & patient_site_ids) > 0
 }
 
 default allow = false
@@ -38,6 +70,14 @@ allow {
   input.path = ["v1", "clinics"]
 }
 
+# Allow backend services to evict tokens from the authentication cache
+# POST /v1/auth/cache/invalidate
+allow {
+  is_backend_service
+  input.method == "POST"
+  input.path = ["v1", "auth", "cache", "invalidate"]
+}
+
 # Allow authenticated users to create a new clinic
 # POST /v1/clinics
 allow {
@@ -179,7 +219,7 @@ allow {
 allow {
   input.method == "PUT"
   input.path = ["v1", "clinics", _]
-  clinician_has_write_access
+  clinician_has_permission("clinic:write")
 }
 
 # Allow currently authenticated clinician to delete clinic
@@ -187,7 +227,7 @@ allow {
 allow {
   input.method == "DELETE"
   input.path = ["v1", "clinics", _]
-  clinician_has_write_access
+  clinician_has_permission("clinic:write")
 }
 
 # Allow backend services to update clinic tiers
@@ -211,7 +251,7 @@ allow {
 allow {
   input.method == "POST"
   input.path = ["v1", "clinics", _, "suppressed_notifications"]
-  clinician_has_write_access
+  clinician_has_permission("clinic:write")
 }
 
 # Allow backend services to add service accounts to clinics
@@ -222,12 +262,40 @@ allow {
   is_backend_service
 }
 
+# Allow backend services and clinic admins to list, create and revoke api keys
+# GET /v1/clinics/:clinicId/api_keys
+# POST /v1/clinics/:clinicId/api_keys
+allow {
+  allowed_methods := {"GET", "POST"}
+  allowed_methods[input.method]
+  input.path = ["v1", "clinics", _, "api_keys"]
+  is_backend_service
+}
+allow {
+  allowed_methods := {"GET", "POST"}
+  allowed_methods[input.method]
+  input.path = ["v1", "clinics", _, "api_keys"]
+  clinician_has_permission("clinicians:write")
+}
+
+# DELETE /v1/clinics/:clinicId/api_keys/:apiKeyId
+allow {
+  input.method == "DELETE"
+  input.path = ["v1", "clinics", _, "api_keys", _]
+  is_backend_service
+}
+allow {
+  input.method == "DELETE"
+  input.path = ["v1", "clinics", _, "api_keys", _]
+  clinician_has_permission("clinicians:write")
+}
+
 # Allow currently authenticated clinician to list clinicians
 # GET /v1/clinics/:clinicId/clinicians
 allow {
   input.method == "GET"
   input.path = ["v1", "clinics", _, "clinicians"]
-  clinician_has_read_access
+  clinician_has_permission("clinicians:read")
 }
 
 # Allow backend services to list clinicians
@@ -251,7 +319,7 @@ allow {
 allow {
   input.method == "GET"
   input.path = ["v1", "clinics", _, "clinicians", _]
-  clinician_has_read_access
+  clinician_has_permission("clinicians:read")
 }
 
 # Allow backend services to fetch clinician records
@@ -277,7 +345,7 @@ allow {
   allowed_methods := {"PUT", "DELETE"}
   allowed_methods[input.method]
   input.path = ["v1", "clinics", _, "clinicians", _]
-  clinician_has_write_access
+  clinician_has_permission("clinicians:write")
 }
 
 # Allow backend service to update or delete a clinician
@@ -298,7 +366,7 @@ allow {
   input.method == "DELETE"
   input.path = ["v1", "clinics", _, "clinicians", clinician_id]
   clinician_id == subject_id
-  clinician_has_read_access
+  is_clinic_member
 }
 
 # Allow currently authenticated patient to remove themselves from the clinic
@@ -316,7 +384,54 @@ allow {
 allow {
   input.method == "DELETE"
   input.path = ["v1", "clinics", _, "patients", _]
-  clinician_has_write_access
+  clinician_has_permission("patients:delete")
+  clinician_has_patient_site_access
+}
+
+# Allow backend services and clinic admins to get and run the duplicate patient scans of a clinic. The scans include
+# patients of all sites, so site restricted clinicians cannot access them.
+# GET /v1/clinics/:clinicId/patient_duplicates
+# POST /v1/clinics/:clinicId/patient_duplicates/scan
+allow {
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "patient_duplicates"]
+  is_backend_service
+}
+
+allow {
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "patient_duplicates"]
+  clinician_has_permission("patients:delete")
+  not clinician_is_site_restricted
+}
+
+allow {
+  input.method == "POST"
+  input.path = ["v1", "clinics", _, "patient_duplicates", "scan"]
+  is_backend_service
+}
+
+allow {
+  input.method == "POST"
+  input.path = ["v1", "clinics", _, "patient_duplicates", "scan"]
+  clinician_has_permission("patients:delete")
+  not clinician_is_site_restricted
+}
+
+# Allow backend services and clinic admins without site restrictions to merge custodial duplicates into claimed
+# patient accounts
+# POST /v1/clinics/:clinicId/patient_duplicates/merge
+allow {
+  input.method == "POST"
+  input.path = ["v1", "clinics", _, "patient_duplicates", "merge"]
+  is_backend_service
+}
+
+allow {
+  input.method == "POST"
+  input.path = ["v1", "clinics", _, "patient_duplicates", "merge"]
+  clinician_has_permission("patients:delete")
+  not clinician_is_site_restricted
 }
 
 # Allow backend services to fetch, update and delete invites
@@ -335,7 +450,7 @@ allow {
 allow {
   input.method == "GET"
   input.path = ["v1", "clinics", _, "patients"]
-  clinician_has_read_access
+  clinician_has_permission("patients:read")
 }
 
 # Allow backend services to list patients
@@ -346,12 +461,13 @@ allow {
   input.path = ["v1", "clinics", _, "patients"]
 }
 
-# Allow currently authenticated clinician to get tide reports
+# Allow currently authenticated clinician to get tide reports, api keys require the tide:read scope
 # GET /v1/clinics/:clinicId/tide_report
 allow {
   input.method == "GET"
   input.path = ["v1", "clinics", _, "tide_report"]
-  clinician_has_read_access
+  clinician_permissions["patients:read"]
+  api_key_grants("tide:read")
 }
 
 # Allow backend services to get tide reports
@@ -362,12 +478,13 @@ allow {
   input.path = ["v1", "clinics", _, "tide_report"]
 }
 
-# Allow currently authenticated clinician to create a custodial account
+# Allow currently authenticated clinician to create a custodial account. Site restricted clinicians can only
+# create patients in their sites, which is enforced by the handler, because the sites are in the request body.
 # POST /v1/clinics/:clinicId/patients
 allow {
   input.method == "POST"
   input.path = ["v1", "clinics", _, "patients"]
-  clinician_has_read_access
+  clinician_has_permission("patients:write")
 }
 
 # Allow backend service create a custodial accounts
@@ -383,7 +500,8 @@ allow {
 allow {
   input.method == "POST"
   input.path = ["v1", "clinics", _, "patients", _, "upload_reminder"]
-  clinician_has_read_access
+  clinician_has_permission("patients:write")
+  clinician_has_patient_site_access
 }
 
 # Allow currently authenticated clinician to send a dexcom connect reminder
@@ -391,7 +509,8 @@ allow {
 allow {
   input.method == "POST"
   input.path = ["v1", "clinics", _, "patients", _, "send_dexcom_connect_request"]
-  clinician_has_read_access
+  clinician_has_permission("patients:write")
+  clinician_has_patient_site_access
 }
 
 # Allow currently authenticated clinician to send a provider connection request
@@ -399,7 +518,8 @@ allow {
 allow {
   input.method == "POST"
   input.path = ["v1", "clinics", _, "patients", _, "connect", _]
-  clinician_has_read_access
+  clinician_has_permission("patients:write")
+  clinician_has_patient_site_access
 }
 
 # Allow backend services to send a provider connection request
@@ -415,7 +535,8 @@ allow {
 allow {
   input.method == "GET"
   input.path = ["v1", "clinics", _, "patients", _]
-  clinician_has_read_access
+  clinician_has_permission("patients:read")
+  clinician_has_patient_site_access
 }
 
 # Allow backend services to fetch patient by id
@@ -439,7 +560,8 @@ allow {
 allow {
   input.method == "PUT"
   input.path = ["v1", "clinics", _, "patients", _]
-  clinician_has_read_access
+  clinician_has_permission("patients:write")
+  clinician_has_patient_site_access
 }
 
 # Allow backend services to update patient account
@@ -463,7 +585,7 @@ allow {
 allow {
   input.method == "POST"
   input.path = ["v1", "clinics", _, "migrate"]
-  clinician_has_write_access
+  clinician_has_permission("clinic:write")
 }
 
 # Allow backend services to migrate users of a legacy clinician account to the clinic
@@ -482,6 +604,94 @@ allow {
   input.path = ["v1", "clinics", _, "merge"]
 }
 
+# Allow backend services to generate clinic consolidation reports
+# POST /v1/clinics/:clinicId/reports/consolidation
+allow {
+  is_backend_service
+  input.method == "POST"
+  input.path = ["v1", "clinics", _, "reports", "consolidation"]
+}
+
+# Allow backend services to consolidate clinics
+# POST /v1/clinics/:clinicId/consolidate
+allow {
+  is_backend_service
+  input.method == "POST"
+  input.path = ["v1", "clinics", _, "consolidate"]
+}
+
+# Allow backend services to get the progress of clinic consolidations
+# GET /v1/clinics/:clinicId/consolidations/:consolidationId
+allow {
+  is_backend_service
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "consolidations", _]
+}
+
+# Allow backend services to generate clinic split reports
+# POST /v1/clinics/:clinicId/reports/split
+allow {
+  is_backend_service
+  input.method == "POST"
+  input.path = ["v1", "clinics", _, "reports", "split"]
+}
+
+# Allow backend services to split clinics
+# POST /v1/clinics/:clinicId/split
+allow {
+  is_backend_service
+  input.method == "POST"
+  input.path = ["v1", "clinics", _, "split"]
+}
+
+# Allow backend services to get the progress of clinic merges
+# GET /v1/clinics/:clinicId/merges/:planId
+allow {
+  is_backend_service
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "merges", _]
+}
+
+# Allow backend services to check whether a clinic merge can be rolled back
+# GET /v1/clinics/:clinicId/merges/:planId/rollback
+allow {
+  is_backend_service
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "merges", _, "rollback"]
+}
+
+# Allow backend services to rollback clinic merges
+# POST /v1/clinics/:clinicId/merges/:planId/rollback
+allow {
+  is_backend_service
+  input.method == "POST"
+  input.path = ["v1", "clinics", _, "merges", _, "rollback"]
+}
+
+# Allow backend services to list the duplicate patients of clinic merges
+# GET /v1/clinics/:clinicId/merges/:planId/duplicates
+allow {
+  is_backend_service
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "merges", _, "duplicates"]
+}
+
+# Allow backend services to resolve the duplicate patients of clinic merges
+# PUT /v1/clinics/:clinicId/merges/:planId/duplicates/:duplicateId
+allow {
+  is_backend_service
+  input.method == "PUT"
+  input.path = ["v1", "clinics", _, "merges", _, "duplicates", _]
+}
+
+# Allow backend services to start clinic merges after the review of the duplicates
+# POST /v1/clinics/:clinicId/merges/:planId/start
+allow {
+  is_backend_service
+  input.method == "POST"
+  input.path = ["v1", "clinics", _, "merges", _, "start"]
+}
+
 # Allow backend services to access the list of migrations for a given clinic
 # GET /v1/clinics/:clinicId/migrations
 allow {
@@ -503,7 +713,7 @@ allow {
 allow {
   input.method == "GET"
   input.path = ["v1", "clinics", _, "migrations", _]
-  clinician_has_read_access
+  clinician_has_permission("migrations:read")
 }
 
 # Allow clinicians to list all migrations
@@ -511,7 +721,7 @@ allow {
 allow {
   input.method == "GET"
   input.path = ["v1", "clinics", _, "migrations"]
-  clinician_has_read_access
+  clinician_has_permission("migrations:read")
 }
 
 # Allow backend services to update the status of migrations
@@ -522,6 +732,22 @@ allow {
   input.path = ["v1", "clinics", _, "migrations", _]
 }
 
+# Allow backend services to retry failed migrations
+# POST /v1/clinics/:clinicId/migrations/:userId/retry
+allow {
+  is_backend_service
+  input.method == "POST"
+  input.path = ["v1", "clinics", _, "migrations", _, "retry"]
+}
+
+# Allow backend services to list the incomplete migrations of all clinics
+# GET /v1/migrations
+allow {
+  is_backend_service
+  input.method == "GET"
+  input.path = ["v1", "migrations"]
+}
+
 # Allow backend services to update the status of migrations
 # PATCH /v1/users/:clinicId/clinics
 allow {
@@ -550,7 +776,7 @@ allow {
 allow {
   input.method == "POST"
   input.path = ["v1", "clinics", _, "patient_tags"]
-  clinician_has_read_access
+  clinician_has_permission("patient_tags:write")
 }
 
 # Allow backend services to create a patient tag
@@ -566,7 +792,7 @@ allow {
 allow {
   input.method == "PUT"
   input.path = ["v1", "clinics", _, "patient_tags", _]
-  clinician_has_read_access
+  clinician_has_permission("patient_tags:write")
 }
 
 # Allow backend services to update patient tags
@@ -582,7 +808,7 @@ allow {
 allow {
   input.method == "DELETE"
   input.path = ["v1", "clinics", _, "patient_tags", _]
-  clinician_has_write_access
+  clinician_has_permission("patient_tags:manage")
 }
 
 # Allow backend services to delete patient tags
@@ -593,7 +819,8 @@ allow {
   input.path = ["v1", "clinics", _, "patient_tags", _]
 }
 
-# Allow backend services or clinic admins to delete a patient tag from all clinic patients
+# Allow backend services or clinic admins to delete a patient tag from all clinic patients. The operation isn't limited
+# to the patients of a site, so it's not allowed for site restricted clinicians.
 # POST /v1/clinics/:clinicId/patients/delete_tag/:patientTagId
 allow {
   input.method == "POST"
@@ -603,10 +830,12 @@ allow {
 allow {
   input.method == "POST"
   input.path = ["v1", "clinics", _, "patients", "delete_tag", _]
-  clinician_has_write_access
+  clinician_has_permission("patient_tags:manage")
+  clinician_has_patient_site_access
 }
 
-# Allow backend services or clinic admins to assign a patient tag to a subset of clinic patients
+# Allow backend services or clinic admins to assign a patient tag to a subset of clinic patients. The operation isn't
+# limited to the patients of a site, so it's not allowed for site restricted clinicians.
 # POST /v1/clinics/:clinicId/patients/assign_tag/:patientTagId
 allow {
   input.method == "POST"
@@ -616,7 +845,8 @@ allow {
 allow {
   input.method == "POST"
   input.path = ["v1", "clinics", _, "patients", "assign_tag", _]
-  clinician_has_write_access
+  clinician_has_permission("patient_tags:manage")
+  clinician_has_patient_site_access
 }
 
 # Allow backend services to update a user data source for all associated clinic patient records
@@ -648,7 +878,41 @@ allow {
 allow {
   input.method == "GET"
   input.path = ["v1", "clinics", _, "membership_restrictions"]
-  clinician_has_write_access
+  clinician_has_permission("clinic:write")
+}
+
+# Allow backend services and clinic admins to fetch clinic access restrictions
+# GET /v1/clinics/:clinicId/access_restrictions
+allow {
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "access_restrictions"]
+  is_backend_service
+}
+allow {
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "access_restrictions"]
+  clinician_has_permission("clinic:write")
+}
+
+# Allow backend services to update clinic access restrictions
+# PUT /v1/clinics/:clinicId/access_restrictions
+allow {
+  input.method == "PUT"
+  input.path = ["v1", "clinics", _, "access_restrictions"]
+  is_backend_service
+}
+
+# Allow backend services and clinic admins to list the audit trail of a clinic
+# GET /v1/clinics/:clinicId/audit_events
+allow {
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "audit_events"]
+  is_backend_service
+}
+allow {
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "audit_events"]
+  clinician_has_permission("clinic:write")
 }
 
 # Allow services to fetch clinics settings
@@ -664,7 +928,7 @@ allow {
 allow {
   input.method == "GET"
   input.path = ["v1", "clinics", _, "settings", "mrn"]
-  clinician_has_read_access
+  clinician_has_permission("settings:read")
 }
 
 # Allow clinic members to fetch ehr settings
@@ -672,7 +936,7 @@ allow {
 allow {
   input.method == "GET"
   input.path = ["v1", "clinics", _, "settings", "ehr"]
-  clinician_has_read_access
+  clinician_has_permission("settings:read")
 }
 
 # Allow clinic members to fetch patient count settings
@@ -680,7 +944,7 @@ allow {
 allow {
   input.method == "GET"
   input.path = ["v1", "clinics", _, "settings", "patient_count"]
-  clinician_has_read_access
+  clinician_has_permission("billing:read")
 }
 
 # Allow services to update clinics settings
@@ -704,7 +968,7 @@ allow {
 allow {
   input.method == "GET"
   input.path = ["v1", "clinics", _, "patient_count"]
-  clinician_has_read_access
+  clinician_has_permission("billing:read")
 }
 
 # Allow services to fetch patient count
@@ -760,7 +1024,8 @@ allow {
 allow {
   input.method == "PUT"
   input.path = ["v1", "clinics", _, "patients", _, "reviews"]
-  clinician_has_read_access
+  clinician_has_permission("patients:write")
+  clinician_has_patient_site_access
 }
 
 # Allow currently authenticated clinician to revert review
@@ -768,7 +1033,8 @@ allow {
 allow {
   input.method == "DELETE"
   input.path = ["v1", "clinics", _, "patients", _, "reviews"]
-  clinician_has_read_access
+  clinician_has_permission("patients:write")
+  clinician_has_patient_site_access
 }
 
 # Allow backend services or clinic admins to create a site
@@ -781,7 +1047,7 @@ allow {
 allow {
   input.method == "POST"
   input.path = ["v1", "clinics", _, "sites"]
-  clinician_has_write_access
+  clinician_has_permission("clinic:write")
 }
 
 # Allow backend services or clinic admins to delete a site
@@ -794,7 +1060,7 @@ allow {
 allow {
   input.method == "DELETE"
   input.path = ["v1", "clinics", _, "sites", _]
-  clinician_has_write_access
+  clinician_has_permission("clinic:write")
 }
 
 # Allow backend services or clinic admins to update a site
@@ -807,7 +1073,33 @@ allow {
 allow {
   input.method == "PUT"
   input.path = ["v1", "clinics", _, "sites", _]
-  clinician_has_write_access
+  clinician_has_permission("clinic:write")
+}
+
+# Allow backend services or clinic admins to move a site in the hierarchy
+# PUT /v1/clinics/:clinicId/sites/:siteId/parent
+allow {
+  input.method == "PUT"
+  input.path = ["v1", "clinics", _, "sites", _, "parent"]
+  is_backend_service
+}
+allow {
+  input.method == "PUT"
+  input.path = ["v1", "clinics", _, "sites", _, "parent"]
+  clinician_has_permission("clinic:write")
+}
+
+# Allow backend services or clinic admins to override the clinic settings for a site
+# PUT /v1/clinics/:clinicId/sites/:siteId/settings
+allow {
+  input.method == "PUT"
+  input.path = ["v1", "clinics", _, "sites", _, "settings"]
+  is_backend_service
+}
+allow {
+  input.method == "PUT"
+  input.path = ["v1", "clinics", _, "sites", _, "settings"]
+  clinician_has_permission("clinic:write")
 }
 
 # Allow backend services to merge two sites
@@ -825,3 +1117,92 @@ allow {
   input.path = ["v1", "clinics", _, "patient_tags", _, "site"]
   is_backend_service
 }
+
+# Allow backend services and clinicians to list the xealth report views of a clinic. The views of site restricted
+# clinicians are filtered by the handler.
+# GET /v1/clinics/:clinicId/xealth/report_views
+allow {
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "xealth", "report_views"]
+  is_backend_service
+}
+allow {
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "xealth", "report_views"]
+  clinician_has_permission("patients:read")
+}
+
+# Allow backend services and clinicians to fetch aggregated xealth report view stats. The stats of site restricted
+# clinicians only include the views of patients of their sites.
+# GET /v1/clinics/:clinicId/xealth/report_views/stats
+allow {
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "xealth", "report_views", "stats"]
+  is_backend_service
+}
+allow {
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "xealth", "report_views", "stats"]
+  clinician_has_permission("patients:read")
+}
+
+# Allow backend services and clinicians to list patients whose reports were not viewed since the last upload. The
+# patients of site restricted clinicians are filtered by the handler.
+# GET /v1/clinics/:clinicId/xealth/patients_not_viewed
+allow {
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "xealth", "patients_not_viewed"]
+  is_backend_service
+}
+allow {
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "xealth", "patients_not_viewed"]
+  clinician_has_permission("patients:read")
+}
+
+# Allow backend services and clinicians to list the clinician roles of a clinic
+# GET /v1/clinics/:clinicId/clinician_roles
+allow {
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "clinician_roles"]
+  is_backend_service
+}
+allow {
+  input.method == "GET"
+  input.path = ["v1", "clinics", _, "clinician_roles"]
+  clinician_has_permission("clinicians:read")
+}
+
+# Allow backend services and clinic admins to update the custom clinician roles of a clinic
+# PUT /v1/clinics/:clinicId/clinician_roles
+allow {
+  input.method == "PUT"
+  input.path = ["v1", "clinics", _, "clinician_roles"]
+  is_backend_service
+}
+allow {
+  input.method == "PUT"
+  input.path = ["v1", "clinics", _, "clinician_roles"]
+  clinician_has_permission("clinicians:write")
+}
+
+# Allow backend services and clinic admins to update the sites a clinician is restricted to
+# PUT /v1/clinics/:clinicId/clinicians/:clinicianId/sites
+allow {
+  input.method == "PUT"
+  input.path = ["v1", "clinics", _, "clinicians", _, "sites"]
+  is_backend_service
+}
+allow {
+  input.method == "PUT"
+  input.path = ["v1", "clinics", _, "clinicians", _, "sites"]
+  clinician_has_permission("clinicians:write")
+}
+
+# Allow backend services to evaluate the policy for hypothetical requests
+# POST /v1/authz/evaluate
+allow {
+  is_backend_service
+  input.method == "POST"
+  input.path = ["v1", "authz", "evaluate"]
+}
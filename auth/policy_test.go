@@ -0,0 +1,148 @@
+package auth_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/fx/fxtest"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/auth"
+)
+
+const backendOnlyPolicy = `package http.authz.clinic
+
+default allow = false
+
+# Allow backend services to do anything
+allow {
+  input.auth.serverAccess == true
+}
+`
+
+var _ = Describe("Policies", func() {
+	var dir string
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	writeFile := func(name string, content string) string {
+		path := filepath.Join(dir, name)
+		Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0o644)).To(Succeed())
+		return path
+	}
+
+	Describe("LoadPolicy", func() {
+		It("loads a policy file", func() {
+			policy, err := auth.LoadPolicy(writeFile("policy.rego", backendOnlyPolicy))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy.Hash).To(HaveLen(64))
+			Expect(policy.Source).To(HaveSuffix("policy.rego"))
+		})
+
+		It("loads a bundle directory with its revision", func() {
+			writeFile("bundle/authz/policy.rego", backendOnlyPolicy)
+			writeFile("bundle/.manifest", `{"revision": "v2"}`)
+
+			policy, err := auth.LoadPolicy(filepath.Join(dir, "bundle"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy.Revision).To(Equal("v2"))
+		})
+
+		It("returns an error if the policy doesn't compile", func() {
+			_, err := auth.LoadPolicy(writeFile("policy.rego", "package http.authz.clinic\n\nallow {"))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("returns an error if the policy doesn't define allow", func() {
+			_, err := auth.LoadPolicy(writeFile("policy.rego", "package http.authz.other\n\nallow = true\n"))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("returns the same hash for the same policy", func() {
+			first, err := auth.LoadPolicy(writeFile("first.rego", backendOnlyPolicy))
+			Expect(err).ToNot(HaveOccurred())
+			second, err := auth.LoadPolicy(writeFile("first.rego", backendOnlyPolicy))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(second.Hash).To(Equal(first.Hash))
+		})
+	})
+
+	Describe("PolicyStore", func() {
+		var path string
+		var store *auth.PolicyStore
+		var authorizer auth.RequestAuthorizer
+
+		userInput := map[string]interface{}{
+			"path":   []string{"v1", "clinics"},
+			"method": "POST",
+			"auth": map[string]interface{}{
+				"subjectId":    "1234567890",
+				"serverAccess": false,
+			},
+		}
+
+		BeforeEach(func() {
+			path = writeFile("policy.rego", backendOnlyPolicy)
+
+			var err error
+			store, err = auth.NewPolicyStore(auth.PolicyConfig{Path: path}, zap.NewNop().Sugar(), fxtest.NewLifecycle(GinkgoT()))
+			Expect(err).ToNot(HaveOccurred())
+			authorizer, err = auth.NewRequestAuthorizer(nil, nil, store, nil, nil, zap.NewNop().Sugar())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("uses the embedded policy by default", func() {
+			embedded, err := auth.NewPolicyStore(auth.PolicyConfig{}, zap.NewNop().Sugar(), fxtest.NewLifecycle(GinkgoT()))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(embedded.Status().Source).To(Equal("embedded"))
+		})
+
+		It("fails if the configured policy can't be loaded", func() {
+			_, err := auth.NewPolicyStore(auth.PolicyConfig{Path: filepath.Join(dir, "missing.rego")}, zap.NewNop().Sugar(), fxtest.NewLifecycle(GinkgoT()))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("evaluates the loaded policy", func() {
+			Expect(authorizer.EvaluatePolicy(context.Background(), userInput)).To(Equal(auth.ErrUnauthorized))
+		})
+
+		It("activates the changed policy on reload", func() {
+			hash := store.Status().Hash
+			writeFile("policy.rego", strings.Replace(backendOnlyPolicy, "input.auth.serverAccess == true", "input.method == \"POST\"", 1))
+
+			Expect(store.Reload()).To(Succeed())
+			Expect(store.Status().Hash).ToNot(Equal(hash))
+			Expect(authorizer.EvaluatePolicy(context.Background(), userInput)).To(Succeed())
+		})
+
+		It("doesn't recompile the policy on reload if it didn't change", func() {
+			policy := store.Get()
+
+			Expect(store.Reload()).To(Succeed())
+			Expect(store.Get()).To(BeIdenticalTo(policy))
+		})
+
+		It("keeps the last good policy if the changed policy doesn't compile", func() {
+			hash := store.Status().Hash
+			writeFile("policy.rego", "package http.authz.clinic\n\nallow {")
+
+			Expect(store.Reload()).ToNot(Succeed())
+			status := store.Status()
+			Expect(status.Hash).To(Equal(hash))
+			Expect(status.LastError).ToNot(BeEmpty())
+			Expect(status.LastErrorTime).ToNot(BeNil())
+			Expect(authorizer.EvaluatePolicy(context.Background(), userInput)).To(Equal(auth.ErrUnauthorized))
+
+			writeFile("policy.rego", backendOnlyPolicy)
+			Expect(store.Reload()).To(Succeed())
+			Expect(store.Status().LastError).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,208 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/labstack/echo/v4"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/audit"
+	"github.com/tidepool-org/clinic/clinicians"
+	"github.com/tidepool-org/clinic/clinics"
+)
+
+const (
+	BreakGlassReasonHeaderKey = "x-tidepool-break-glass-reason"
+
+	restrictionAllowedNetworks   = "allowed_networks"
+	restrictionMFA               = "mfa"
+	restrictionAuthenticationAge = "authentication_age"
+	accessRestrictionInputKey    = "accessRestriction"
+	breakGlassReasonMaxLength    = 512
+)
+
+type AccessConfig struct {
+	// BreakGlassUserIds are the Tidepool support users who can override the access restrictions of clinics
+	BreakGlassUserIds []string `envconfig:"TIDEPOOL_ACCESS_BREAK_GLASS_USER_IDS"`
+	// MFAMethods are the authentication methods (amr) which satisfy the multi-factor authentication requirement
+	MFAMethods []string `envconfig:"TIDEPOOL_ACCESS_MFA_METHODS" default:"mfa,otp,hwk,swk"`
+	// TrustedProxies are the networks (in CIDR notation) of the load balancers which set the X-Forwarded-For header.
+	// The header is ignored if it's not set by a trusted proxy.
+	TrustedProxies []string `envconfig:"TIDEPOOL_ACCESS_TRUSTED_PROXIES"`
+}
+
+func NewAccessConfig() (AccessConfig, error) {
+	cfg := AccessConfig{}
+	if err := envconfig.Process("", &cfg); err != nil {
+		return cfg, err
+	}
+	for _, network := range cfg.TrustedProxies {
+		if _, _, err := net.ParseCIDR(network); err != nil {
+			return cfg, fmt.Errorf("invalid trusted proxy network %q: %w", network, err)
+		}
+	}
+	return cfg, nil
+}
+
+// NewIPExtractor returns the extractor of the client ip, which is used to enforce the allowed networks of clinics. The
+// X-Forwarded-For header is only trusted if it's set by one of the trusted proxies, the loopback, link local and
+// private networks are not trusted by default.
+func NewIPExtractor(config AccessConfig) echo.IPExtractor {
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, network := range config.TrustedProxies {
+		// The networks are validated when the config is loaded
+		_, ipNet, _ := net.ParseCIDR(network)
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+	return echo.ExtractIPFromXFFHeader(options...)
+}
+
+// AccessRestrictionEnforcer checks the access restrictions of clinics. The restrictions apply to the clinicians of the
+// clinic, backend services and other users (e.g. patients managing their own data) are never restricted.
+type AccessRestrictionEnforcer struct {
+	config  AccessConfig
+	clinics clinics.Service
+	audit   audit.Repository
+	logger  *zap.SugaredLogger
+	now     func() time.Time
+}
+
+func NewAccessRestrictionEnforcer(config AccessConfig, clinics clinics.Service, audit audit.Repository, logger *zap.SugaredLogger) *AccessRestrictionEnforcer {
+	return &AccessRestrictionEnforcer{
+		config:  config,
+		clinics: clinics,
+		audit:   audit,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// Check returns the access restrictions of the clinic which are violated by the request of the clinician, or an
+// empty string if the request is not restricted. Tidepool support can override the restrictions by providing a
+// reason, which is recorded in the audit trail.
+func (a *AccessRestrictionEnforcer) Check(ctx context.Context, request PolicyRequest, clinician *clinicians.Clinician) (string, error) {
+	if a == nil || clinician == nil || request.Auth == nil || request.Auth.ServerAccess {
+		return "", nil
+	}
+
+	clinicId := request.PathParams[clinicIdPathParameter]
+	restrictions, err := a.clinics.GetAccessRestrictions(ctx, clinicId)
+	if errors.Is(err, clinics.ErrNotFound) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	violations := a.getViolations(restrictions, request, clinician)
+	if len(violations) == 0 {
+		return "", nil
+	}
+
+	if !a.canBreakGlass(request) {
+		return strings.Join(violations, ","), nil
+	}
+
+	// The override is denied if it can't be audited
+	if err := a.recordBreakGlass(ctx, clinicId, request, violations); err != nil {
+		return "", fmt.Errorf("unable to record break glass access: %w", err)
+	}
+
+	return "", nil
+}
+
+func (a *AccessRestrictionEnforcer) getViolations(restrictions *clinics.AccessRestrictions, request PolicyRequest, clinician *clinicians.Clinician) []string {
+	if restrictions.IsEmpty() {
+		return nil
+	}
+
+	var violations []string
+	if len(restrictions.AllowedNetworks) > 0 && !isAllowedNetwork(restrictions.AllowedNetworks, request.ClientIP) {
+		violations = append(violations, restrictionAllowedNetworks)
+	}
+
+	// Service accounts authenticate with client credentials or api keys, so they can't satisfy the authentication
+	// context requirements
+	if clinician.IsServiceAccount {
+		return violations
+	}
+	if restrictions.RequireMFA && !slices.ContainsFunc(request.Auth.AuthenticationMethods, func(method string) bool {
+		return slices.Contains(a.config.MFAMethods, method)
+	}) {
+		violations = append(violations, restrictionMFA)
+	}
+	if restrictions.MaxAuthenticationAge > 0 {
+		authTime := request.Auth.AuthenticationTime
+		if authTime == nil || a.now().Sub(*authTime) > restrictions.MaxAuthenticationAge {
+			violations = append(violations, restrictionAuthenticationAge)
+		}
+	}
+
+	return violations
+}
+
+func (a *AccessRestrictionEnforcer) canBreakGlass(request PolicyRequest) bool {
+	return request.BreakGlassReason != "" && slices.Contains(a.config.BreakGlassUserIds, request.Auth.SubjectId)
+}
+
+func (a *AccessRestrictionEnforcer) recordBreakGlass(ctx context.Context, clinicId string, request PolicyRequest, violations []string) error {
+	reason := request.BreakGlassReason
+	if len(reason) > breakGlassReasonMaxLength {
+		reason = reason[:breakGlassReasonMaxLength]
+	}
+
+	event := &audit.Event{
+		Type:      audit.EventTypeBreakGlass,
+		SubjectId: request.Auth.SubjectId,
+		Method:    request.Method,
+		Path:      request.Path,
+		ClientIP:  request.ClientIP,
+		Reason:    reason,
+		Details:   violations,
+	}
+	if id, err := primitive.ObjectIDFromHex(clinicId); err == nil {
+		event.ClinicId = &id
+	}
+
+	if _, err := a.audit.Create(ctx, event); err != nil {
+		return err
+	}
+
+	a.logger.Warnw("access restrictions overridden by break glass access",
+		"clinicId", clinicId,
+		"subjectId", request.Auth.SubjectId,
+		"method", request.Method,
+		"path", request.Path,
+		"restrictions", violations,
+		"reason", reason,
+	)
+
+	return nil
+}
+
+func isAllowedNetwork(networks []string, clientIP string) bool {
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range networks {
+		_, ipNet, err := net.ParseCIDR(network)
+		if err == nil && ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,188 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/audit"
+	auditTest "github.com/tidepool-org/clinic/audit/test"
+	"github.com/tidepool-org/clinic/auth"
+	"github.com/tidepool-org/clinic/clinicians"
+	cliniciansTest "github.com/tidepool-org/clinic/clinicians/test"
+	"github.com/tidepool-org/clinic/clinics"
+	clinicsTest "github.com/tidepool-org/clinic/clinics/test"
+)
+
+var _ = Describe("Access Restrictions", func() {
+	const clinicId = "6066fbabc6f484277200ac64"
+	const supportUserId = "9999999999"
+
+	var ctrl *gomock.Controller
+	var cliniciansSvc *cliniciansTest.MockService
+	var clinicsSvc *clinicsTest.MockService
+	var auditRepository *auditTest.MockRepository
+	var authorizer auth.RequestAuthorizer
+	var restrictions *clinics.AccessRestrictions
+	var clinician *clinicians.Clinician
+	var request auth.PolicyRequest
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		cliniciansSvc = cliniciansTest.NewMockService(ctrl)
+		clinicsSvc = clinicsTest.NewMockService(ctrl)
+		auditRepository = auditTest.NewMockRepository(ctrl)
+
+		clinicObjId, _ := primitive.ObjectIDFromHex(clinicId)
+		userId := "1234567890"
+		clinician = &clinicians.Clinician{
+			ClinicId: &clinicObjId,
+			UserId:   &userId,
+			Roles:    []string{clinicians.RoleClinicMember},
+		}
+		restrictions = &clinics.AccessRestrictions{
+			AllowedNetworks: []string{"192.0.2.0/24"},
+		}
+		authTime := time.Now().Add(-time.Minute)
+		request = auth.PolicyRequest{
+			Method:     "GET",
+			Path:       "/v1/clinics/" + clinicId + "/patients",
+			PathParams: map[string]string{"clinicId": clinicId},
+			Auth: &auth.Auth{
+				SubjectId:             userId,
+				AuthenticationMethods: []string{"pwd", "otp"},
+				AuthenticationTime:    &authTime,
+			},
+			ClientIP: "192.0.2.10",
+		}
+
+		cliniciansSvc.EXPECT().Get(gomock.Any(), clinicId, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, _ string) (*clinicians.Clinician, error) {
+			return clinician, nil
+		}).AnyTimes()
+		cliniciansSvc.EXPECT().ResolvePermissions(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *clinicians.Clinician) ([]string, error) {
+			return clinicians.GetPermissions(c.Roles, nil), nil
+		}).AnyTimes()
+		clinicsSvc.EXPECT().GetAccessRestrictions(gomock.Any(), clinicId).DoAndReturn(func(_ context.Context, _ string) (*clinics.AccessRestrictions, error) {
+			return restrictions, nil
+		}).AnyTimes()
+
+		config := auth.AccessConfig{
+			BreakGlassUserIds: []string{supportUserId},
+			MFAMethods:        []string{"mfa", "otp"},
+		}
+		enforcer := auth.NewAccessRestrictionEnforcer(config, clinicsSvc, auditRepository, zap.NewNop().Sugar())
+
+		var err error
+		authorizer, err = auth.NewRequestAuthorizer(cliniciansSvc, nil, nil, nil, enforcer, zap.NewNop().Sugar())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("allows clinicians in the allowed networks", func() {
+		decision, err := authorizer.Explain(context.Background(), request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decision.Allow).To(BeTrue())
+	})
+
+	It("denies clinicians outside of the allowed networks", func() {
+		request.ClientIP = "198.51.100.10"
+		decision, err := authorizer.Explain(context.Background(), request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decision.Allow).To(BeFalse())
+		Expect(decision.Restriction).To(Equal("allowed_networks"))
+	})
+
+	It("denies clinicians without multi-factor authentication", func() {
+		restrictions.RequireMFA = true
+		request.Auth.AuthenticationMethods = []string{"pwd"}
+		decision, err := authorizer.Explain(context.Background(), request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decision.Allow).To(BeFalse())
+		Expect(decision.Restriction).To(Equal("mfa"))
+	})
+
+	It("denies clinicians who didn't authenticate recently", func() {
+		restrictions.MaxAuthenticationAge = time.Second
+		decision, err := authorizer.Explain(context.Background(), request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decision.Allow).To(BeFalse())
+		Expect(decision.Restriction).To(Equal("authentication_age"))
+	})
+
+	It("doesn't require service accounts to satisfy the authentication context", func() {
+		restrictions.RequireMFA = true
+		clinician.IsServiceAccount = true
+		request.Auth.AuthenticationMethods = nil
+		decision, err := authorizer.Explain(context.Background(), request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decision.Allow).To(BeTrue())
+	})
+
+	It("doesn't restrict backend services", func() {
+		request.Auth = &auth.Auth{SubjectId: "hydrophone", ServerAccess: true}
+		request.ClientIP = "198.51.100.10"
+		decision, err := authorizer.Explain(context.Background(), request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decision.Allow).To(BeTrue())
+	})
+
+	It("allows support to break glass and records it in the audit trail", func() {
+		request.Auth.SubjectId = supportUserId
+		request.ClientIP = "198.51.100.10"
+		request.BreakGlassReason = "ticket 1234"
+
+		var event *audit.Event
+		auditRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e *audit.Event) (*audit.Event, error) {
+			event = e
+			return e, nil
+		})
+
+		decision, err := authorizer.Explain(context.Background(), request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decision.Allow).To(BeTrue())
+		Expect(event.Type).To(Equal(audit.EventTypeBreakGlass))
+		Expect(event.SubjectId).To(Equal(supportUserId))
+		Expect(event.ClinicId.Hex()).To(Equal(clinicId))
+		Expect(event.Reason).To(Equal("ticket 1234"))
+		Expect(event.Details).To(ConsistOf("allowed_networks"))
+	})
+
+	It("doesn't allow other users to break glass", func() {
+		request.ClientIP = "198.51.100.10"
+		request.BreakGlassReason = "urgent"
+		decision, err := authorizer.Explain(context.Background(), request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decision.Allow).To(BeFalse())
+	})
+})
+
+var _ = Describe("IP Extractor", func() {
+	var extractor echo.IPExtractor
+
+	newRequest := func(remoteAddr string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/v1/clinics", nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set(echo.HeaderXForwardedFor, "203.0.113.10")
+		return req
+	}
+
+	BeforeEach(func() {
+		extractor = auth.NewIPExtractor(auth.AccessConfig{TrustedProxies: []string{"10.1.0.0/16"}})
+	})
+
+	It("uses the forwarded ip if the request is from a trusted proxy", func() {
+		Expect(extractor(newRequest("10.1.2.3:1234"))).To(Equal("203.0.113.10"))
+	})
+
+	It("ignores the forwarded ip if the request isn't from a trusted proxy", func() {
+		Expect(extractor(newRequest("10.2.2.3:1234"))).To(Equal("10.2.2.3"))
+		Expect(extractor(newRequest("127.0.0.1:1234"))).To(Equal("127.0.0.1"))
+	})
+})
@@ -0,0 +1,287 @@
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/api"
+	"github.com/tidepool-org/clinic/auth"
+)
+
+// persona is a set of subjects the policy is evaluated for
+type persona int
+
+const (
+	backendService persona = 1 << iota
+	clinicAdminPersona
+	clinicMemberPersona
+	siteRestrictedMemberPersona
+	patientSelf
+	unrelatedUser
+
+	anyUser             = clinicAdminPersona | clinicMemberPersona | siteRestrictedMemberPersona | patientSelf | unrelatedUser
+	clinicMembers       = unrestrictedMembers | siteRestrictedMemberPersona
+	unrestrictedMembers = clinicAdminPersona | clinicMemberPersona
+
+	// nobody is the expectation of routes which are not accessible through the api
+	nobody persona = 0
+
+	// external routes are authenticated by the handlers (e.g. redox and xealth) and are not authorized by the policy
+	external persona = -1
+)
+
+// otherSitePatient is the patient resolved for site restricted clinicians. The patient belongs to a site which is
+// not assigned to the clinician.
+var otherSitePatient = map[string]interface{}{
+	"sites": []interface{}{
+		map[string]interface{}{"id": "6066fbabc6f484277200ac71", "name": "South"},
+	},
+}
+
+var personas = []struct {
+	persona   persona
+	name      string
+	auth      map[string]interface{}
+	clinician map[string]interface{}
+	patient   map[string]interface{}
+}{
+	{backendService, "backend service", map[string]interface{}{"subjectId": "hydrophone", "serverAccess": true}, nil, nil},
+	{clinicAdminPersona, "clinic admin", map[string]interface{}{"subjectId": "3333333333", "serverAccess": false}, clinicAdmin, nil},
+	{clinicMemberPersona, "clinic member", map[string]interface{}{"subjectId": "4444444444", "serverAccess": false}, clinicMember, nil},
+	{siteRestrictedMemberPersona, "site restricted member", map[string]interface{}{"subjectId": "6666666666", "serverAccess": false}, siteRestrictedMember, otherSitePatient},
+	{patientSelf, "patient", map[string]interface{}{"subjectId": patientUserId, "serverAccess": false}, nil, nil},
+	{unrelatedUser, "unrelated user", map[string]interface{}{"subjectId": "5555555555", "serverAccess": false}, nil, nil},
+}
+
+const patientUserId = "1111111111"
+
+// pathParameters are used to expand the route templates. The patient persona is the patient referenced in the path.
+var pathParameters = map[string]string{
+	"clinicId":        "6066fbabc6f484277200ac64",
+	"apiKeyId":        "6066fbabc6f484277200ac68",
+	"clinicianId":     "2222222222",
+	"consolidationId": "6066fbabc6f484277200ac6b",
+	"inviteId":        "invite",
+	"patientId":       patientUserId,
+	"patientTagId":    "6066fbabc6f484277200ac65",
+	"permission":      "upload",
+	"planId":          "6066fbabc6f484277200ac69",
+	"duplicateId":     "6066fbabc6f484277200ac6a",
+	"providerId":      "dexcom",
+	"shareCode":       "ACMECLINIC",
+	"siteId":          "6066fbabc6f484277200ac66",
+	"summaryId":       "6066fbabc6f484277200ac67",
+	"userId":          patientUserId,
+}
+
+// routeExpectations declare which personas are allowed to access each route of the api spec. Every route
+// must have an expectation, new routes must be added here together with their policy rules. The patient
+// resolved for the site restricted member belongs to another site, so patient routes are limited to
+// unrestricted members.
+var routeExpectations = map[string]persona{
+	"DELETE /v1/clinics/{clinicId}":                                               backendService | clinicAdminPersona,
+	"DELETE /v1/clinics/{clinicId}/clinicians/{clinicianId}":                      backendService | clinicAdminPersona,
+	"DELETE /v1/clinics/{clinicId}/invites/clinicians/{inviteId}/clinician":       backendService,
+	"DELETE /v1/clinics/{clinicId}/patient_tags/{patientTagId}":                   backendService | clinicAdminPersona,
+	"DELETE /v1/clinics/{clinicId}/patients/{patientId}":                          clinicAdminPersona | patientSelf,
+	"DELETE /v1/clinics/{clinicId}/patients/{patientId}/permissions/{permission}": patientSelf,
+	"DELETE /v1/clinics/{clinicId}/patients/{patientId}/reviews":                  unrestrictedMembers,
+	"DELETE /v1/clinics/{clinicId}/sites/{siteId}":                                backendService | clinicAdminPersona,
+	"DELETE /v1/summaries/{summaryId}/clinics":                                    backendService,
+	"DELETE /v1/users/{userId}/clinics":                                           backendService,
+	"GET /v1/clinicians":                                                          nobody,
+	"GET /v1/clinicians/{userId}/clinics":                                         backendService | patientSelf,
+	"GET /v1/clinics":                                                             backendService,
+	"GET /v1/clinics/share_code/{shareCode}":                                      backendService | anyUser,
+	"GET /v1/clinics/{clinicId}":                                                  backendService | anyUser,
+	"GET /v1/clinics/{clinicId}/clinician_roles":                                  backendService | clinicMembers,
+	"GET /v1/clinics/{clinicId}/clinicians":                                       backendService | clinicMembers,
+	"GET /v1/clinics/{clinicId}/clinicians/{clinicianId}":                         backendService | clinicMembers,
+	"GET /v1/clinics/{clinicId}/consolidations/{consolidationId}":                 backendService,
+	"GET /v1/clinics/{clinicId}/invites/clinicians/{inviteId}/clinician":          backendService,
+	"GET /v1/clinics/{clinicId}/membership_restrictions":                          backendService | clinicAdminPersona,
+	"GET /v1/clinics/{clinicId}/merges/{planId}":                                  backendService,
+	"GET /v1/clinics/{clinicId}/merges/{planId}/duplicates":                       backendService,
+	"GET /v1/clinics/{clinicId}/merges/{planId}/rollback":                         backendService,
+	"GET /v1/clinics/{clinicId}/migrations":                                       backendService | clinicMembers,
+	"GET /v1/clinics/{clinicId}/migrations/{userId}":                              backendService | clinicMembers,
+	"GET /v1/clinics/{clinicId}/patient_count":                                    backendService | clinicMembers,
+	"GET /v1/clinics/{clinicId}/patient_duplicates":                               backendService | clinicAdminPersona,
+	"GET /v1/clinics/{clinicId}/patients":                                         backendService | clinicMembers,
+	"GET /v1/clinics/{clinicId}/patients/{patientId}":                             backendService | unrestrictedMembers,
+	"GET /v1/clinics/{clinicId}/settings/ehr":                                     backendService | clinicMembers,
+	"GET /v1/clinics/{clinicId}/settings/mrn":                                     backendService | clinicMembers,
+	"GET /v1/clinics/{clinicId}/settings/patient_count":                           backendService | clinicMembers,
+	"GET /v1/clinics/{clinicId}/tide_report":                                      backendService | clinicMembers,
+	"GET /v1/clinics/{clinicId}/xealth/patients_not_viewed":                       backendService | clinicMembers,
+	"GET /v1/clinics/{clinicId}/xealth/report_views":                              backendService | clinicMembers,
+	"GET /v1/clinics/{clinicId}/xealth/report_views/stats":                        backendService | clinicMembers,
+	"GET /v1/patients":                                                      anyUser,
+	"GET /v1/patients/{userId}/clinics":                                     backendService | patientSelf,
+	"GET /v1/xealth/report/web/viewer.html":                                 external,
+	"PATCH /v1/clinics/{clinicId}/invites/clinicians/{inviteId}/clinician":  backendService,
+	"PATCH /v1/clinics/{clinicId}/migrations/{userId}":                      backendService,
+	"GET /v1/migrations":                                                    backendService,
+	"POST /v1/authz/evaluate":                                               backendService,
+	"POST /v1/auth/cache/invalidate":                                        backendService,
+	"POST /v1/clinicians/{userId}/migrate":                                  backendService,
+	"POST /v1/clinics":                                                      anyUser,
+	"POST /v1/clinics/{clinicId}/clinicians":                                backendService,
+	"POST /v1/clinics/{clinicId}/consolidate":                               backendService,
+	"POST /v1/clinics/{clinicId}/ehr/sync":                                  backendService,
+	"POST /v1/clinics/{clinicId}/merge":                                     backendService,
+	"POST /v1/clinics/{clinicId}/merges/{planId}/start":                     backendService,
+	"POST /v1/clinics/{clinicId}/merges/{planId}/rollback":                  backendService,
+	"POST /v1/clinics/{clinicId}/migrate":                                   clinicAdminPersona,
+	"POST /v1/clinics/{clinicId}/migrations":                                backendService,
+	"POST /v1/clinics/{clinicId}/migrations/{userId}/retry":                 backendService,
+	"POST /v1/clinics/{clinicId}/patient_count/refresh":                     backendService,
+	"POST /v1/clinics/{clinicId}/patient_duplicates/merge":                  backendService | clinicAdminPersona,
+	"POST /v1/clinics/{clinicId}/patient_duplicates/scan":                   backendService | clinicAdminPersona,
+	"POST /v1/clinics/{clinicId}/patient_tags":                              backendService | clinicMembers,
+	"POST /v1/clinics/{clinicId}/patient_tags/{patientTagId}/site":          backendService,
+	"POST /v1/clinics/{clinicId}/patients":                                  backendService | clinicMembers,
+	"POST /v1/clinics/{clinicId}/patients/assign_tag/{patientTagId}":        backendService | clinicAdminPersona,
+	"POST /v1/clinics/{clinicId}/patients/delete_tag/{patientTagId}":        backendService | clinicAdminPersona,
+	"POST /v1/clinics/{clinicId}/patients/{patientId}":                      backendService,
+	"POST /v1/clinics/{clinicId}/patients/{patientId}/connect/{providerId}": backendService | unrestrictedMembers,
+	"POST /v1/clinics/{clinicId}/patients/{patientId}/upload_reminder":      unrestrictedMembers,
+	"POST /v1/clinics/{clinicId}/reports/consolidation":                     backendService,
+	"POST /v1/clinics/{clinicId}/reports/merge":                             backendService,
+	"POST /v1/clinics/{clinicId}/reports/split":                             backendService,
+	"GET /v1/clinics/{clinicId}/access_restrictions":                        backendService | clinicAdminPersona,
+	"PUT /v1/clinics/{clinicId}/access_restrictions":                        backendService,
+	"GET /v1/clinics/{clinicId}/audit_events":                               backendService | clinicAdminPersona,
+	"POST /v1/clinics/{clinicId}/service_accounts":                          backendService,
+	"GET /v1/clinics/{clinicId}/api_keys":                                   backendService | clinicAdminPersona,
+	"POST /v1/clinics/{clinicId}/api_keys":                                  backendService | clinicAdminPersona,
+	"DELETE /v1/clinics/{clinicId}/api_keys/{apiKeyId}":                     backendService | clinicAdminPersona,
+	"POST /v1/clinics/{clinicId}/sites":                                     backendService | clinicAdminPersona,
+	"POST /v1/clinics/{clinicId}/sites/{siteId}/merge":                      backendService,
+	"POST /v1/clinics/{clinicId}/split":                                     backendService,
+	"POST /v1/clinics/{clinicId}/suppressed_notifications":                  clinicAdminPersona,
+	"POST /v1/clinics/{clinicId}/tier":                                      backendService,
+	"POST /v1/patients/{patientId}/ehr/sync":                                backendService,
+	"POST /v1/patients/{patientId}/summary":                                 backendService,
+	"POST /v1/redox":                                                        external,
+	"POST /v1/redox/match":                                                  backendService,
+	"POST /v1/redox/verify":                                                 external,
+	"POST /v1/users/{userId}/clinics":                                       backendService,
+	"POST /v1/xealth/notification":                                          external,
+	"POST /v1/xealth/preorder":                                              external,
+	"PUT /v1/clinics/{clinicId}":                                            backendService | clinicAdminPersona,
+	"PUT /v1/clinics/{clinicId}/clinician_roles":                            backendService | clinicAdminPersona,
+	"PUT /v1/clinics/{clinicId}/clinicians/{clinicianId}":                   backendService | clinicAdminPersona,
+	"PUT /v1/clinics/{clinicId}/clinicians/{clinicianId}/sites":             backendService | clinicAdminPersona,
+	"PUT /v1/clinics/{clinicId}/membership_restrictions":                    backendService,
+	"PUT /v1/clinics/{clinicId}/merges/{planId}/duplicates/{duplicateId}":   backendService,
+	"PUT /v1/clinics/{clinicId}/patient_tags/{patientTagId}":                backendService | clinicMembers,
+	"PUT /v1/clinics/{clinicId}/patients/{patientId}":                       backendService | unrestrictedMembers,
+	"PUT /v1/clinics/{clinicId}/patients/{patientId}/permissions":           patientSelf,
+	"PUT /v1/clinics/{clinicId}/patients/{patientId}/reviews":               unrestrictedMembers,
+	"PUT /v1/clinics/{clinicId}/settings/ehr":                               backendService,
+	"PUT /v1/clinics/{clinicId}/settings/mrn":                               backendService,
+	"PUT /v1/clinics/{clinicId}/settings/patient_count":                     backendService,
+	"PUT /v1/clinics/{clinicId}/sites/{siteId}":                             backendService | clinicAdminPersona,
+	"PUT /v1/clinics/{clinicId}/sites/{siteId}/parent":                      backendService | clinicAdminPersona,
+	"PUT /v1/clinics/{clinicId}/sites/{siteId}/settings":                    backendService | clinicAdminPersona,
+	"PUT /v1/patients/{userId}/data_sources":                                backendService,
+	"PUT /v1/xealth/program":                                                external,
+	"PUT /v1/xealth/programs":                                               external,
+}
+
+func getSpecRoutes() ([]string, error) {
+	swagger, err := api.GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	var routes []string
+	for path, item := range swagger.Paths.Map() {
+		for method := range item.Operations() {
+			routes = append(routes, fmt.Sprintf("%s %s", method, path))
+		}
+	}
+	sort.Strings(routes)
+
+	return routes, nil
+}
+
+// expandRoute returns the method and the split path of the route with the path parameters replaced by their values
+func expandRoute(route string) (string, []string) {
+	method, template, _ := strings.Cut(route, " ")
+	segments := strings.Split(strings.TrimPrefix(template, "/"), "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			value, ok := pathParameters[strings.Trim(segment, "{}")]
+			Expect(ok).To(BeTrue(), "missing value for path parameter %s", segment)
+			segments[i] = value
+		}
+	}
+	return method, segments
+}
+
+var _ = Describe("Route Policies", func() {
+	var authorizer auth.RequestAuthorizer
+
+	BeforeEach(func() {
+		var err error
+		authorizer, err = auth.NewRequestAuthorizer(nil, nil, nil, nil, nil, zap.NewNop().Sugar())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("declares the expected personas for every route of the api spec", func() {
+		routes, err := getSpecRoutes()
+		Expect(err).ToNot(HaveOccurred())
+
+		expected := make([]string, 0, len(routeExpectations))
+		for route := range routeExpectations {
+			expected = append(expected, route)
+		}
+		Expect(routes).To(ConsistOf(expected), "the routes of the api spec must match the route expectations")
+	})
+
+	routes := make([]string, 0, len(routeExpectations))
+	for route := range routeExpectations {
+		routes = append(routes, route)
+	}
+	sort.Strings(routes)
+
+	for _, route := range routes {
+		allowed := routeExpectations[route]
+		if allowed == external {
+			continue
+		}
+
+		It(fmt.Sprintf("authorizes %s", route), func() {
+			method, path := expandRoute(route)
+			for _, p := range personas {
+				input := map[string]interface{}{
+					"path":   path,
+					"method": method,
+					"auth":   p.auth,
+				}
+				// The clinician record is only resolved for requests in the context of a clinic
+				if p.clinician != nil && strings.Contains(route, "{clinicId}") {
+					input["clinician"] = p.clinician
+				}
+				if p.patient != nil {
+					input["patient"] = p.patient
+				}
+
+				err := authorizer.EvaluatePolicy(context.Background(), input)
+				if allowed&p.persona != 0 {
+					Expect(err).ToNot(HaveOccurred(), "expected %s to be allowed", p.name)
+				} else {
+					Expect(err).To(Equal(auth.ErrUnauthorized), "expected %s to be denied", p.name)
+				}
+			}
+		})
+	}
+})
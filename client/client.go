@@ -90,6 +90,16 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// InvalidateAuthenticationCacheWithBody request with any body
+	InvalidateAuthenticationCacheWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	InvalidateAuthenticationCache(ctx context.Context, body InvalidateAuthenticationCacheJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EvaluateAuthorizationPolicyWithBody request with any body
+	EvaluateAuthorizationPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EvaluateAuthorizationPolicy(ctx context.Context, body EvaluateAuthorizationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListAllClinicians request
 	ListAllClinicians(ctx context.Context, params *ListAllCliniciansParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -121,6 +131,36 @@ type ClientInterface interface {
 
 	UpdateClinic(ctx context.Context, clinicId ClinicId, body UpdateClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetAccessRestrictions request
+	GetAccessRestrictions(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAccessRestrictionsWithBody request with any body
+	UpdateAccessRestrictionsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAccessRestrictions(ctx context.Context, clinicId ClinicId, body UpdateAccessRestrictionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAPIKeys request
+	ListAPIKeys(ctx context.Context, clinicId ClinicId, params *ListAPIKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAPIKeyWithBody request with any body
+	CreateAPIKeyWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAPIKey(ctx context.Context, clinicId ClinicId, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeAPIKey request
+	RevokeAPIKey(ctx context.Context, clinicId ClinicId, apiKeyId ApiKeyId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAuditEvents request
+	ListAuditEvents(ctx context.Context, clinicId ClinicId, params *ListAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClinicianRoles request
+	ListClinicianRoles(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateClinicianRolesWithBody request with any body
+	UpdateClinicianRolesWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateClinicianRoles(ctx context.Context, clinicId ClinicId, body UpdateClinicianRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListClinicians request
 	ListClinicians(ctx context.Context, clinicId ClinicId, params *ListCliniciansParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -140,6 +180,19 @@ type ClientInterface interface {
 
 	UpdateClinician(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, body UpdateClinicianJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateClinicianSitesWithBody request with any body
+	UpdateClinicianSitesWithBody(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateClinicianSites(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, body UpdateClinicianSitesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConsolidateClinicsWithBody request with any body
+	ConsolidateClinicsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConsolidateClinics(ctx context.Context, clinicId ClinicId, body ConsolidateClinicsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClinicConsolidation request
+	GetClinicConsolidation(ctx context.Context, clinicId ClinicId, consolidationId ConsolidationId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SyncEHRData request
 	SyncEHRData(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -167,6 +220,26 @@ type ClientInterface interface {
 
 	MergeClinic(ctx context.Context, clinicId ClinicId, body MergeClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetClinicMerge request
+	GetClinicMerge(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClinicMergeDuplicates request
+	ListClinicMergeDuplicates(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResolveClinicMergeDuplicateWithBody request with any body
+	ResolveClinicMergeDuplicateWithBody(ctx context.Context, clinicId ClinicId, planId PlanId, duplicateId DuplicateId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ResolveClinicMergeDuplicate(ctx context.Context, clinicId ClinicId, planId PlanId, duplicateId DuplicateId, body ResolveClinicMergeDuplicateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreflightClinicMergeRollback request
+	PreflightClinicMergeRollback(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RollbackClinicMerge request
+	RollbackClinicMerge(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartClinicMerge request
+	StartClinicMerge(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// TriggerInitialMigrationWithBody request with any body
 	TriggerInitialMigrationWithBody(ctx context.Context, clinicId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -188,12 +261,26 @@ type ClientInterface interface {
 
 	UpdateMigration(ctx context.Context, clinicId ClinicIdV1, userId UserId, body UpdateMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RetryMigration request
+	RetryMigration(ctx context.Context, clinicId ClinicIdV1, userId UserId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetPatientCount request
 	GetPatientCount(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// RefreshPatientCount request
 	RefreshPatientCount(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetPatientDuplicates request
+	GetPatientDuplicates(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MergePatientDuplicateWithBody request with any body
+	MergePatientDuplicateWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MergePatientDuplicate(ctx context.Context, clinicId ClinicId, body MergePatientDuplicateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ScanPatientDuplicates request
+	ScanPatientDuplicates(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreatePatientTagWithBody request with any body
 	CreatePatientTagWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -264,11 +351,21 @@ type ClientInterface interface {
 	// SendUploadReminder request
 	SendUploadReminder(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GenerateConsolidationReportWithBody request with any body
+	GenerateConsolidationReportWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GenerateConsolidationReport(ctx context.Context, clinicId ClinicId, body GenerateConsolidationReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GenerateMergeReportWithBody request with any body
 	GenerateMergeReportWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	GenerateMergeReport(ctx context.Context, clinicId ClinicId, body GenerateMergeReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GenerateSplitReportWithBody request with any body
+	GenerateSplitReportWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GenerateSplitReport(ctx context.Context, clinicId ClinicId, body GenerateSplitReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AddServiceAccountWithBody request with any body
 	AddServiceAccountWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -316,6 +413,21 @@ type ClientInterface interface {
 
 	MergeSite(ctx context.Context, clinicId ClinicId, siteId SiteId, body MergeSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateSiteParentWithBody request with any body
+	UpdateSiteParentWithBody(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSiteParent(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteParentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSiteSettingsWithBody request with any body
+	UpdateSiteSettingsWithBody(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSiteSettings(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SplitClinicWithBody request with any body
+	SplitClinicWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SplitClinic(ctx context.Context, clinicId ClinicId, body SplitClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UpdateSuppressedNotificationsWithBody request with any body
 	UpdateSuppressedNotificationsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -329,6 +441,18 @@ type ClientInterface interface {
 
 	UpdateTier(ctx context.Context, clinicId ClinicId, body UpdateTierJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListXealthPatientsNotViewed request
+	ListXealthPatientsNotViewed(ctx context.Context, clinicId ClinicId, params *ListXealthPatientsNotViewedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListXealthReportViews request
+	ListXealthReportViews(ctx context.Context, clinicId ClinicId, params *ListXealthReportViewsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetXealthReportViewStats request
+	GetXealthReportViewStats(ctx context.Context, clinicId ClinicId, params *GetXealthReportViewStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListIncompleteMigrations request
+	ListIncompleteMigrations(ctx context.Context, params *ListIncompleteMigrationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FindPatients request
 	FindPatients(ctx context.Context, params *FindPatientsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -388,6 +512,54 @@ type ClientInterface interface {
 	ViewPDFReport(ctx context.Context, params *ViewPDFReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) InvalidateAuthenticationCacheWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInvalidateAuthenticationCacheRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InvalidateAuthenticationCache(ctx context.Context, body InvalidateAuthenticationCacheJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInvalidateAuthenticationCacheRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EvaluateAuthorizationPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEvaluateAuthorizationPolicyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EvaluateAuthorizationPolicy(ctx context.Context, body EvaluateAuthorizationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEvaluateAuthorizationPolicyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListAllClinicians(ctx context.Context, params *ListAllCliniciansParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListAllCliniciansRequest(c.Server, params)
 	if err != nil {
@@ -520,8 +692,8 @@ func (c *Client) UpdateClinic(ctx context.Context, clinicId ClinicId, body Updat
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListClinicians(ctx context.Context, clinicId ClinicId, params *ListCliniciansParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListCliniciansRequest(c.Server, clinicId, params)
+func (c *Client) GetAccessRestrictions(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAccessRestrictionsRequest(c.Server, clinicId)
 	if err != nil {
 		return nil, err
 	}
@@ -532,8 +704,8 @@ func (c *Client) ListClinicians(ctx context.Context, clinicId ClinicId, params *
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateClinicianWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateClinicianRequestWithBody(c.Server, clinicId, contentType, body)
+func (c *Client) UpdateAccessRestrictionsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAccessRestrictionsRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -544,8 +716,8 @@ func (c *Client) CreateClinicianWithBody(ctx context.Context, clinicId ClinicId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateClinician(ctx context.Context, clinicId ClinicId, body CreateClinicianJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateClinicianRequest(c.Server, clinicId, body)
+func (c *Client) UpdateAccessRestrictions(ctx context.Context, clinicId ClinicId, body UpdateAccessRestrictionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAccessRestrictionsRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -556,8 +728,8 @@ func (c *Client) CreateClinician(ctx context.Context, clinicId ClinicId, body Cr
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteClinician(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteClinicianRequest(c.Server, clinicId, clinicianId)
+func (c *Client) ListAPIKeys(ctx context.Context, clinicId ClinicId, params *ListAPIKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAPIKeysRequest(c.Server, clinicId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -568,8 +740,8 @@ func (c *Client) DeleteClinician(ctx context.Context, clinicId ClinicId, clinici
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetClinician(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetClinicianRequest(c.Server, clinicId, clinicianId)
+func (c *Client) CreateAPIKeyWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAPIKeyRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -580,8 +752,8 @@ func (c *Client) GetClinician(ctx context.Context, clinicId ClinicId, clinicianI
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateClinicianWithBody(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateClinicianRequestWithBody(c.Server, clinicId, clinicianId, contentType, body)
+func (c *Client) CreateAPIKey(ctx context.Context, clinicId ClinicId, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAPIKeyRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -592,8 +764,8 @@ func (c *Client) UpdateClinicianWithBody(ctx context.Context, clinicId ClinicId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateClinician(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, body UpdateClinicianJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateClinicianRequest(c.Server, clinicId, clinicianId, body)
+func (c *Client) RevokeAPIKey(ctx context.Context, clinicId ClinicId, apiKeyId ApiKeyId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeAPIKeyRequest(c.Server, clinicId, apiKeyId)
 	if err != nil {
 		return nil, err
 	}
@@ -604,8 +776,8 @@ func (c *Client) UpdateClinician(ctx context.Context, clinicId ClinicId, clinici
 	return c.Client.Do(req)
 }
 
-func (c *Client) SyncEHRData(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSyncEHRDataRequest(c.Server, clinicId)
+func (c *Client) ListAuditEvents(ctx context.Context, clinicId ClinicId, params *ListAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAuditEventsRequest(c.Server, clinicId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -616,8 +788,8 @@ func (c *Client) SyncEHRData(ctx context.Context, clinicId ClinicId, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteInvitedClinician(ctx context.Context, clinicId ClinicId, inviteId InviteId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteInvitedClinicianRequest(c.Server, clinicId, inviteId)
+func (c *Client) ListClinicianRoles(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClinicianRolesRequest(c.Server, clinicId)
 	if err != nil {
 		return nil, err
 	}
@@ -628,8 +800,8 @@ func (c *Client) DeleteInvitedClinician(ctx context.Context, clinicId ClinicId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetInvitedClinician(ctx context.Context, clinicId ClinicId, inviteId InviteId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetInvitedClinicianRequest(c.Server, clinicId, inviteId)
+func (c *Client) UpdateClinicianRolesWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClinicianRolesRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -640,8 +812,8 @@ func (c *Client) GetInvitedClinician(ctx context.Context, clinicId ClinicId, inv
 	return c.Client.Do(req)
 }
 
-func (c *Client) AssociateClinicianToUserWithBody(ctx context.Context, clinicId ClinicId, inviteId InviteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAssociateClinicianToUserRequestWithBody(c.Server, clinicId, inviteId, contentType, body)
+func (c *Client) UpdateClinicianRoles(ctx context.Context, clinicId ClinicId, body UpdateClinicianRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClinicianRolesRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -652,8 +824,8 @@ func (c *Client) AssociateClinicianToUserWithBody(ctx context.Context, clinicId
 	return c.Client.Do(req)
 }
 
-func (c *Client) AssociateClinicianToUser(ctx context.Context, clinicId ClinicId, inviteId InviteId, body AssociateClinicianToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAssociateClinicianToUserRequest(c.Server, clinicId, inviteId, body)
+func (c *Client) ListClinicians(ctx context.Context, clinicId ClinicId, params *ListCliniciansParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCliniciansRequest(c.Server, clinicId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -664,8 +836,8 @@ func (c *Client) AssociateClinicianToUser(ctx context.Context, clinicId ClinicId
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListMembershipRestrictions(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListMembershipRestrictionsRequest(c.Server, clinicId)
+func (c *Client) CreateClinicianWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClinicianRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -676,8 +848,8 @@ func (c *Client) ListMembershipRestrictions(ctx context.Context, clinicId Clinic
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMembershipRestrictionsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMembershipRestrictionsRequestWithBody(c.Server, clinicId, contentType, body)
+func (c *Client) CreateClinician(ctx context.Context, clinicId ClinicId, body CreateClinicianJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClinicianRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -688,8 +860,8 @@ func (c *Client) UpdateMembershipRestrictionsWithBody(ctx context.Context, clini
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMembershipRestrictions(ctx context.Context, clinicId ClinicId, body UpdateMembershipRestrictionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMembershipRestrictionsRequest(c.Server, clinicId, body)
+func (c *Client) DeleteClinician(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteClinicianRequest(c.Server, clinicId, clinicianId)
 	if err != nil {
 		return nil, err
 	}
@@ -700,8 +872,8 @@ func (c *Client) UpdateMembershipRestrictions(ctx context.Context, clinicId Clin
 	return c.Client.Do(req)
 }
 
-func (c *Client) MergeClinicWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMergeClinicRequestWithBody(c.Server, clinicId, contentType, body)
+func (c *Client) GetClinician(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClinicianRequest(c.Server, clinicId, clinicianId)
 	if err != nil {
 		return nil, err
 	}
@@ -712,8 +884,8 @@ func (c *Client) MergeClinicWithBody(ctx context.Context, clinicId ClinicId, con
 	return c.Client.Do(req)
 }
 
-func (c *Client) MergeClinic(ctx context.Context, clinicId ClinicId, body MergeClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMergeClinicRequest(c.Server, clinicId, body)
+func (c *Client) UpdateClinicianWithBody(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClinicianRequestWithBody(c.Server, clinicId, clinicianId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -724,8 +896,8 @@ func (c *Client) MergeClinic(ctx context.Context, clinicId ClinicId, body MergeC
 	return c.Client.Do(req)
 }
 
-func (c *Client) TriggerInitialMigrationWithBody(ctx context.Context, clinicId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTriggerInitialMigrationRequestWithBody(c.Server, clinicId, contentType, body)
+func (c *Client) UpdateClinician(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, body UpdateClinicianJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClinicianRequest(c.Server, clinicId, clinicianId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -736,8 +908,8 @@ func (c *Client) TriggerInitialMigrationWithBody(ctx context.Context, clinicId s
 	return c.Client.Do(req)
 }
 
-func (c *Client) TriggerInitialMigration(ctx context.Context, clinicId string, body TriggerInitialMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTriggerInitialMigrationRequest(c.Server, clinicId, body)
+func (c *Client) UpdateClinicianSitesWithBody(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClinicianSitesRequestWithBody(c.Server, clinicId, clinicianId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -748,8 +920,8 @@ func (c *Client) TriggerInitialMigration(ctx context.Context, clinicId string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListMigrations(ctx context.Context, clinicId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListMigrationsRequest(c.Server, clinicId)
+func (c *Client) UpdateClinicianSites(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, body UpdateClinicianSitesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClinicianSitesRequest(c.Server, clinicId, clinicianId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -760,8 +932,8 @@ func (c *Client) ListMigrations(ctx context.Context, clinicId string, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) MigrateLegacyClinicianPatientsWithBody(ctx context.Context, clinicId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMigrateLegacyClinicianPatientsRequestWithBody(c.Server, clinicId, contentType, body)
+func (c *Client) ConsolidateClinicsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConsolidateClinicsRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -772,8 +944,8 @@ func (c *Client) MigrateLegacyClinicianPatientsWithBody(ctx context.Context, cli
 	return c.Client.Do(req)
 }
 
-func (c *Client) MigrateLegacyClinicianPatients(ctx context.Context, clinicId string, body MigrateLegacyClinicianPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMigrateLegacyClinicianPatientsRequest(c.Server, clinicId, body)
+func (c *Client) ConsolidateClinics(ctx context.Context, clinicId ClinicId, body ConsolidateClinicsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConsolidateClinicsRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -784,8 +956,8 @@ func (c *Client) MigrateLegacyClinicianPatients(ctx context.Context, clinicId st
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMigration(ctx context.Context, clinicId ClinicIdV1, userId UserId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMigrationRequest(c.Server, clinicId, userId)
+func (c *Client) GetClinicConsolidation(ctx context.Context, clinicId ClinicId, consolidationId ConsolidationId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClinicConsolidationRequest(c.Server, clinicId, consolidationId)
 	if err != nil {
 		return nil, err
 	}
@@ -796,8 +968,8 @@ func (c *Client) GetMigration(ctx context.Context, clinicId ClinicIdV1, userId U
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMigrationWithBody(ctx context.Context, clinicId ClinicIdV1, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMigrationRequestWithBody(c.Server, clinicId, userId, contentType, body)
+func (c *Client) SyncEHRData(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSyncEHRDataRequest(c.Server, clinicId)
 	if err != nil {
 		return nil, err
 	}
@@ -808,8 +980,8 @@ func (c *Client) UpdateMigrationWithBody(ctx context.Context, clinicId ClinicIdV
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMigration(ctx context.Context, clinicId ClinicIdV1, userId UserId, body UpdateMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMigrationRequest(c.Server, clinicId, userId, body)
+func (c *Client) DeleteInvitedClinician(ctx context.Context, clinicId ClinicId, inviteId InviteId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteInvitedClinicianRequest(c.Server, clinicId, inviteId)
 	if err != nil {
 		return nil, err
 	}
@@ -820,8 +992,8 @@ func (c *Client) UpdateMigration(ctx context.Context, clinicId ClinicIdV1, userI
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPatientCount(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPatientCountRequest(c.Server, clinicId)
+func (c *Client) GetInvitedClinician(ctx context.Context, clinicId ClinicId, inviteId InviteId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInvitedClinicianRequest(c.Server, clinicId, inviteId)
 	if err != nil {
 		return nil, err
 	}
@@ -832,8 +1004,8 @@ func (c *Client) GetPatientCount(ctx context.Context, clinicId ClinicId, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) RefreshPatientCount(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRefreshPatientCountRequest(c.Server, clinicId)
+func (c *Client) AssociateClinicianToUserWithBody(ctx context.Context, clinicId ClinicId, inviteId InviteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssociateClinicianToUserRequestWithBody(c.Server, clinicId, inviteId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -844,8 +1016,8 @@ func (c *Client) RefreshPatientCount(ctx context.Context, clinicId ClinicId, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePatientTagWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePatientTagRequestWithBody(c.Server, clinicId, contentType, body)
+func (c *Client) AssociateClinicianToUser(ctx context.Context, clinicId ClinicId, inviteId InviteId, body AssociateClinicianToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssociateClinicianToUserRequest(c.Server, clinicId, inviteId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -856,8 +1028,8 @@ func (c *Client) CreatePatientTagWithBody(ctx context.Context, clinicId ClinicId
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePatientTag(ctx context.Context, clinicId ClinicId, body CreatePatientTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePatientTagRequest(c.Server, clinicId, body)
+func (c *Client) ListMembershipRestrictions(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMembershipRestrictionsRequest(c.Server, clinicId)
 	if err != nil {
 		return nil, err
 	}
@@ -868,8 +1040,8 @@ func (c *Client) CreatePatientTag(ctx context.Context, clinicId ClinicId, body C
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeletePatientTag(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletePatientTagRequest(c.Server, clinicId, patientTagId)
+func (c *Client) UpdateMembershipRestrictionsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMembershipRestrictionsRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -880,8 +1052,8 @@ func (c *Client) DeletePatientTag(ctx context.Context, clinicId ClinicId, patien
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePatientTagWithBody(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePatientTagRequestWithBody(c.Server, clinicId, patientTagId, contentType, body)
+func (c *Client) UpdateMembershipRestrictions(ctx context.Context, clinicId ClinicId, body UpdateMembershipRestrictionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMembershipRestrictionsRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -892,8 +1064,8 @@ func (c *Client) UpdatePatientTagWithBody(ctx context.Context, clinicId ClinicId
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePatientTag(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body UpdatePatientTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePatientTagRequest(c.Server, clinicId, patientTagId, body)
+func (c *Client) MergeClinicWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergeClinicRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -904,8 +1076,8 @@ func (c *Client) UpdatePatientTag(ctx context.Context, clinicId ClinicId, patien
 	return c.Client.Do(req)
 }
 
-func (c *Client) ConvertPatientTagToSite(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewConvertPatientTagToSiteRequest(c.Server, clinicId, patientTagId)
+func (c *Client) MergeClinic(ctx context.Context, clinicId ClinicId, body MergeClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergeClinicRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -916,8 +1088,8 @@ func (c *Client) ConvertPatientTagToSite(ctx context.Context, clinicId ClinicId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListPatients(ctx context.Context, clinicId ClinicId, params *ListPatientsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListPatientsRequest(c.Server, clinicId, params)
+func (c *Client) GetClinicMerge(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClinicMergeRequest(c.Server, clinicId, planId)
 	if err != nil {
 		return nil, err
 	}
@@ -928,8 +1100,8 @@ func (c *Client) ListPatients(ctx context.Context, clinicId ClinicId, params *Li
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePatientAccountWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePatientAccountRequestWithBody(c.Server, clinicId, contentType, body)
+func (c *Client) ListClinicMergeDuplicates(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClinicMergeDuplicatesRequest(c.Server, clinicId, planId)
 	if err != nil {
 		return nil, err
 	}
@@ -940,8 +1112,8 @@ func (c *Client) CreatePatientAccountWithBody(ctx context.Context, clinicId Clin
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePatientAccount(ctx context.Context, clinicId ClinicId, body CreatePatientAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePatientAccountRequest(c.Server, clinicId, body)
+func (c *Client) ResolveClinicMergeDuplicateWithBody(ctx context.Context, clinicId ClinicId, planId PlanId, duplicateId DuplicateId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResolveClinicMergeDuplicateRequestWithBody(c.Server, clinicId, planId, duplicateId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -952,8 +1124,8 @@ func (c *Client) CreatePatientAccount(ctx context.Context, clinicId ClinicId, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) AssignPatientTagToClinicPatientsWithBody(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAssignPatientTagToClinicPatientsRequestWithBody(c.Server, clinicId, patientTagId, contentType, body)
+func (c *Client) ResolveClinicMergeDuplicate(ctx context.Context, clinicId ClinicId, planId PlanId, duplicateId DuplicateId, body ResolveClinicMergeDuplicateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResolveClinicMergeDuplicateRequest(c.Server, clinicId, planId, duplicateId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -964,8 +1136,8 @@ func (c *Client) AssignPatientTagToClinicPatientsWithBody(ctx context.Context, c
 	return c.Client.Do(req)
 }
 
-func (c *Client) AssignPatientTagToClinicPatients(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body AssignPatientTagToClinicPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAssignPatientTagToClinicPatientsRequest(c.Server, clinicId, patientTagId, body)
+func (c *Client) PreflightClinicMergeRollback(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreflightClinicMergeRollbackRequest(c.Server, clinicId, planId)
 	if err != nil {
 		return nil, err
 	}
@@ -976,8 +1148,8 @@ func (c *Client) AssignPatientTagToClinicPatients(ctx context.Context, clinicId
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeletePatientTagFromClinicPatientsWithBody(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletePatientTagFromClinicPatientsRequestWithBody(c.Server, clinicId, patientTagId, contentType, body)
+func (c *Client) RollbackClinicMerge(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRollbackClinicMergeRequest(c.Server, clinicId, planId)
 	if err != nil {
 		return nil, err
 	}
@@ -988,8 +1160,8 @@ func (c *Client) DeletePatientTagFromClinicPatientsWithBody(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeletePatientTagFromClinicPatients(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body DeletePatientTagFromClinicPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletePatientTagFromClinicPatientsRequest(c.Server, clinicId, patientTagId, body)
+func (c *Client) StartClinicMerge(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartClinicMergeRequest(c.Server, clinicId, planId)
 	if err != nil {
 		return nil, err
 	}
@@ -1000,8 +1172,8 @@ func (c *Client) DeletePatientTagFromClinicPatients(ctx context.Context, clinicI
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeletePatient(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletePatientRequest(c.Server, clinicId, patientId)
+func (c *Client) TriggerInitialMigrationWithBody(ctx context.Context, clinicId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTriggerInitialMigrationRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1012,8 +1184,8 @@ func (c *Client) DeletePatient(ctx context.Context, clinicId ClinicId, patientId
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPatient(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPatientRequest(c.Server, clinicId, patientId)
+func (c *Client) TriggerInitialMigration(ctx context.Context, clinicId string, body TriggerInitialMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTriggerInitialMigrationRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1024,8 +1196,8 @@ func (c *Client) GetPatient(ctx context.Context, clinicId ClinicId, patientId Pa
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePatientFromUserWithBody(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePatientFromUserRequestWithBody(c.Server, clinicId, patientId, contentType, body)
+func (c *Client) ListMigrations(ctx context.Context, clinicId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMigrationsRequest(c.Server, clinicId)
 	if err != nil {
 		return nil, err
 	}
@@ -1036,8 +1208,8 @@ func (c *Client) CreatePatientFromUserWithBody(ctx context.Context, clinicId Cli
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePatientFromUser(ctx context.Context, clinicId ClinicId, patientId PatientId, body CreatePatientFromUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePatientFromUserRequest(c.Server, clinicId, patientId, body)
+func (c *Client) MigrateLegacyClinicianPatientsWithBody(ctx context.Context, clinicId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMigrateLegacyClinicianPatientsRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1048,8 +1220,8 @@ func (c *Client) CreatePatientFromUser(ctx context.Context, clinicId ClinicId, p
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePatientWithBody(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePatientRequestWithBody(c.Server, clinicId, patientId, contentType, body)
+func (c *Client) MigrateLegacyClinicianPatients(ctx context.Context, clinicId string, body MigrateLegacyClinicianPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMigrateLegacyClinicianPatientsRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1060,8 +1232,8 @@ func (c *Client) UpdatePatientWithBody(ctx context.Context, clinicId ClinicId, p
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePatient(ctx context.Context, clinicId ClinicId, patientId PatientId, body UpdatePatientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePatientRequest(c.Server, clinicId, patientId, body)
+func (c *Client) GetMigration(ctx context.Context, clinicId ClinicIdV1, userId UserId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMigrationRequest(c.Server, clinicId, userId)
 	if err != nil {
 		return nil, err
 	}
@@ -1072,8 +1244,8 @@ func (c *Client) UpdatePatient(ctx context.Context, clinicId ClinicId, patientId
 	return c.Client.Do(req)
 }
 
-func (c *Client) ConnectProvider(ctx context.Context, clinicId ClinicId, patientId PatientId, providerId ProviderId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewConnectProviderRequest(c.Server, clinicId, patientId, providerId)
+func (c *Client) UpdateMigrationWithBody(ctx context.Context, clinicId ClinicIdV1, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMigrationRequestWithBody(c.Server, clinicId, userId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1084,8 +1256,8 @@ func (c *Client) ConnectProvider(ctx context.Context, clinicId ClinicId, patient
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePatientPermissionsWithBody(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePatientPermissionsRequestWithBody(c.Server, clinicId, patientId, contentType, body)
+func (c *Client) UpdateMigration(ctx context.Context, clinicId ClinicIdV1, userId UserId, body UpdateMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMigrationRequest(c.Server, clinicId, userId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1096,8 +1268,8 @@ func (c *Client) UpdatePatientPermissionsWithBody(ctx context.Context, clinicId
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePatientPermissions(ctx context.Context, clinicId ClinicId, patientId PatientId, body UpdatePatientPermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePatientPermissionsRequest(c.Server, clinicId, patientId, body)
+func (c *Client) RetryMigration(ctx context.Context, clinicId ClinicIdV1, userId UserId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRetryMigrationRequest(c.Server, clinicId, userId)
 	if err != nil {
 		return nil, err
 	}
@@ -1108,8 +1280,8 @@ func (c *Client) UpdatePatientPermissions(ctx context.Context, clinicId ClinicId
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeletePatientPermission(ctx context.Context, clinicId ClinicId, patientId PatientId, permission string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletePatientPermissionRequest(c.Server, clinicId, patientId, permission)
+func (c *Client) GetPatientCount(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPatientCountRequest(c.Server, clinicId)
 	if err != nil {
 		return nil, err
 	}
@@ -1120,8 +1292,8 @@ func (c *Client) DeletePatientPermission(ctx context.Context, clinicId ClinicId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeletePatientReviews(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletePatientReviewsRequest(c.Server, clinicId, patientId)
+func (c *Client) RefreshPatientCount(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshPatientCountRequest(c.Server, clinicId)
 	if err != nil {
 		return nil, err
 	}
@@ -1132,8 +1304,8 @@ func (c *Client) DeletePatientReviews(ctx context.Context, clinicId ClinicId, pa
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePatientReviews(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePatientReviewsRequest(c.Server, clinicId, patientId)
+func (c *Client) GetPatientDuplicates(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPatientDuplicatesRequest(c.Server, clinicId)
 	if err != nil {
 		return nil, err
 	}
@@ -1144,8 +1316,8 @@ func (c *Client) UpdatePatientReviews(ctx context.Context, clinicId ClinicId, pa
 	return c.Client.Do(req)
 }
 
-func (c *Client) SendUploadReminder(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSendUploadReminderRequest(c.Server, clinicId, patientId)
+func (c *Client) MergePatientDuplicateWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergePatientDuplicateRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1156,8 +1328,8 @@ func (c *Client) SendUploadReminder(ctx context.Context, clinicId ClinicId, pati
 	return c.Client.Do(req)
 }
 
-func (c *Client) GenerateMergeReportWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGenerateMergeReportRequestWithBody(c.Server, clinicId, contentType, body)
+func (c *Client) MergePatientDuplicate(ctx context.Context, clinicId ClinicId, body MergePatientDuplicateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergePatientDuplicateRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1168,8 +1340,8 @@ func (c *Client) GenerateMergeReportWithBody(ctx context.Context, clinicId Clini
 	return c.Client.Do(req)
 }
 
-func (c *Client) GenerateMergeReport(ctx context.Context, clinicId ClinicId, body GenerateMergeReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGenerateMergeReportRequest(c.Server, clinicId, body)
+func (c *Client) ScanPatientDuplicates(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewScanPatientDuplicatesRequest(c.Server, clinicId)
 	if err != nil {
 		return nil, err
 	}
@@ -1180,8 +1352,8 @@ func (c *Client) GenerateMergeReport(ctx context.Context, clinicId ClinicId, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddServiceAccountWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddServiceAccountRequestWithBody(c.Server, clinicId, contentType, body)
+func (c *Client) CreatePatientTagWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePatientTagRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1192,8 +1364,8 @@ func (c *Client) AddServiceAccountWithBody(ctx context.Context, clinicId ClinicI
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddServiceAccount(ctx context.Context, clinicId ClinicId, body AddServiceAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddServiceAccountRequest(c.Server, clinicId, body)
+func (c *Client) CreatePatientTag(ctx context.Context, clinicId ClinicId, body CreatePatientTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePatientTagRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1204,8 +1376,8 @@ func (c *Client) AddServiceAccount(ctx context.Context, clinicId ClinicId, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetEHRSettings(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetEHRSettingsRequest(c.Server, clinicId)
+func (c *Client) DeletePatientTag(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePatientTagRequest(c.Server, clinicId, patientTagId)
 	if err != nil {
 		return nil, err
 	}
@@ -1216,8 +1388,8 @@ func (c *Client) GetEHRSettings(ctx context.Context, clinicId ClinicId, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateEHRSettingsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateEHRSettingsRequestWithBody(c.Server, clinicId, contentType, body)
+func (c *Client) UpdatePatientTagWithBody(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePatientTagRequestWithBody(c.Server, clinicId, patientTagId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1228,8 +1400,8 @@ func (c *Client) UpdateEHRSettingsWithBody(ctx context.Context, clinicId ClinicI
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateEHRSettings(ctx context.Context, clinicId ClinicId, body UpdateEHRSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateEHRSettingsRequest(c.Server, clinicId, body)
+func (c *Client) UpdatePatientTag(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body UpdatePatientTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePatientTagRequest(c.Server, clinicId, patientTagId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1240,8 +1412,8 @@ func (c *Client) UpdateEHRSettings(ctx context.Context, clinicId ClinicId, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMRNSettings(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMRNSettingsRequest(c.Server, clinicId)
+func (c *Client) ConvertPatientTagToSite(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConvertPatientTagToSiteRequest(c.Server, clinicId, patientTagId)
 	if err != nil {
 		return nil, err
 	}
@@ -1252,8 +1424,8 @@ func (c *Client) GetMRNSettings(ctx context.Context, clinicId ClinicId, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMRNSettingsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMRNSettingsRequestWithBody(c.Server, clinicId, contentType, body)
+func (c *Client) ListPatients(ctx context.Context, clinicId ClinicId, params *ListPatientsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPatientsRequest(c.Server, clinicId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1264,8 +1436,8 @@ func (c *Client) UpdateMRNSettingsWithBody(ctx context.Context, clinicId ClinicI
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMRNSettings(ctx context.Context, clinicId ClinicId, body UpdateMRNSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMRNSettingsRequest(c.Server, clinicId, body)
+func (c *Client) CreatePatientAccountWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePatientAccountRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1276,8 +1448,8 @@ func (c *Client) UpdateMRNSettings(ctx context.Context, clinicId ClinicId, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPatientCountSettings(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPatientCountSettingsRequest(c.Server, clinicId)
+func (c *Client) CreatePatientAccount(ctx context.Context, clinicId ClinicId, body CreatePatientAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePatientAccountRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1288,8 +1460,8 @@ func (c *Client) GetPatientCountSettings(ctx context.Context, clinicId ClinicId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePatientCountSettingsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePatientCountSettingsRequestWithBody(c.Server, clinicId, contentType, body)
+func (c *Client) AssignPatientTagToClinicPatientsWithBody(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignPatientTagToClinicPatientsRequestWithBody(c.Server, clinicId, patientTagId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1300,8 +1472,8 @@ func (c *Client) UpdatePatientCountSettingsWithBody(ctx context.Context, clinicI
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePatientCountSettings(ctx context.Context, clinicId ClinicId, body UpdatePatientCountSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePatientCountSettingsRequest(c.Server, clinicId, body)
+func (c *Client) AssignPatientTagToClinicPatients(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body AssignPatientTagToClinicPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignPatientTagToClinicPatientsRequest(c.Server, clinicId, patientTagId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1312,8 +1484,8 @@ func (c *Client) UpdatePatientCountSettings(ctx context.Context, clinicId Clinic
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateSiteWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateSiteRequestWithBody(c.Server, clinicId, contentType, body)
+func (c *Client) DeletePatientTagFromClinicPatientsWithBody(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePatientTagFromClinicPatientsRequestWithBody(c.Server, clinicId, patientTagId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1324,8 +1496,8 @@ func (c *Client) CreateSiteWithBody(ctx context.Context, clinicId ClinicId, cont
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateSite(ctx context.Context, clinicId ClinicId, body CreateSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateSiteRequest(c.Server, clinicId, body)
+func (c *Client) DeletePatientTagFromClinicPatients(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body DeletePatientTagFromClinicPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePatientTagFromClinicPatientsRequest(c.Server, clinicId, patientTagId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1336,8 +1508,8 @@ func (c *Client) CreateSite(ctx context.Context, clinicId ClinicId, body CreateS
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteSite(ctx context.Context, clinicId ClinicId, siteId SiteId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteSiteRequest(c.Server, clinicId, siteId)
+func (c *Client) DeletePatient(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePatientRequest(c.Server, clinicId, patientId)
 	if err != nil {
 		return nil, err
 	}
@@ -1348,8 +1520,8 @@ func (c *Client) DeleteSite(ctx context.Context, clinicId ClinicId, siteId SiteI
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateSiteWithBody(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateSiteRequestWithBody(c.Server, clinicId, siteId, contentType, body)
+func (c *Client) GetPatient(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPatientRequest(c.Server, clinicId, patientId)
 	if err != nil {
 		return nil, err
 	}
@@ -1360,8 +1532,8 @@ func (c *Client) UpdateSiteWithBody(ctx context.Context, clinicId ClinicId, site
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateSite(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateSiteRequest(c.Server, clinicId, siteId, body)
+func (c *Client) CreatePatientFromUserWithBody(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePatientFromUserRequestWithBody(c.Server, clinicId, patientId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1372,8 +1544,8 @@ func (c *Client) UpdateSite(ctx context.Context, clinicId ClinicId, siteId SiteI
 	return c.Client.Do(req)
 }
 
-func (c *Client) MergeSiteWithBody(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMergeSiteRequestWithBody(c.Server, clinicId, siteId, contentType, body)
+func (c *Client) CreatePatientFromUser(ctx context.Context, clinicId ClinicId, patientId PatientId, body CreatePatientFromUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePatientFromUserRequest(c.Server, clinicId, patientId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1384,8 +1556,8 @@ func (c *Client) MergeSiteWithBody(ctx context.Context, clinicId ClinicId, siteI
 	return c.Client.Do(req)
 }
 
-func (c *Client) MergeSite(ctx context.Context, clinicId ClinicId, siteId SiteId, body MergeSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMergeSiteRequest(c.Server, clinicId, siteId, body)
+func (c *Client) UpdatePatientWithBody(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePatientRequestWithBody(c.Server, clinicId, patientId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1396,8 +1568,8 @@ func (c *Client) MergeSite(ctx context.Context, clinicId ClinicId, siteId SiteId
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateSuppressedNotificationsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateSuppressedNotificationsRequestWithBody(c.Server, clinicId, contentType, body)
+func (c *Client) UpdatePatient(ctx context.Context, clinicId ClinicId, patientId PatientId, body UpdatePatientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePatientRequest(c.Server, clinicId, patientId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1408,8 +1580,8 @@ func (c *Client) UpdateSuppressedNotificationsWithBody(ctx context.Context, clin
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateSuppressedNotifications(ctx context.Context, clinicId ClinicId, body UpdateSuppressedNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateSuppressedNotificationsRequest(c.Server, clinicId, body)
+func (c *Client) ConnectProvider(ctx context.Context, clinicId ClinicId, patientId PatientId, providerId ProviderId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConnectProviderRequest(c.Server, clinicId, patientId, providerId)
 	if err != nil {
 		return nil, err
 	}
@@ -1420,8 +1592,8 @@ func (c *Client) UpdateSuppressedNotifications(ctx context.Context, clinicId Cli
 	return c.Client.Do(req)
 }
 
-func (c *Client) TideReport(ctx context.Context, clinicId ClinicId, params *TideReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTideReportRequest(c.Server, clinicId, params)
+func (c *Client) UpdatePatientPermissionsWithBody(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePatientPermissionsRequestWithBody(c.Server, clinicId, patientId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1432,8 +1604,8 @@ func (c *Client) TideReport(ctx context.Context, clinicId ClinicId, params *Tide
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTierWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTierRequestWithBody(c.Server, clinicId, contentType, body)
+func (c *Client) UpdatePatientPermissions(ctx context.Context, clinicId ClinicId, patientId PatientId, body UpdatePatientPermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePatientPermissionsRequest(c.Server, clinicId, patientId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1444,8 +1616,8 @@ func (c *Client) UpdateTierWithBody(ctx context.Context, clinicId ClinicId, cont
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTier(ctx context.Context, clinicId ClinicId, body UpdateTierJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTierRequest(c.Server, clinicId, body)
+func (c *Client) DeletePatientPermission(ctx context.Context, clinicId ClinicId, patientId PatientId, permission string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePatientPermissionRequest(c.Server, clinicId, patientId, permission)
 	if err != nil {
 		return nil, err
 	}
@@ -1456,8 +1628,8 @@ func (c *Client) UpdateTier(ctx context.Context, clinicId ClinicId, body UpdateT
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindPatients(ctx context.Context, params *FindPatientsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindPatientsRequest(c.Server, params)
+func (c *Client) DeletePatientReviews(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePatientReviewsRequest(c.Server, clinicId, patientId)
 	if err != nil {
 		return nil, err
 	}
@@ -1468,8 +1640,8 @@ func (c *Client) FindPatients(ctx context.Context, params *FindPatientsParams, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) SyncEHRDataForPatient(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSyncEHRDataForPatientRequest(c.Server, patientId)
+func (c *Client) UpdatePatientReviews(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePatientReviewsRequest(c.Server, clinicId, patientId)
 	if err != nil {
 		return nil, err
 	}
@@ -1480,8 +1652,8 @@ func (c *Client) SyncEHRDataForPatient(ctx context.Context, patientId PatientId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePatientSummaryWithBody(ctx context.Context, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePatientSummaryRequestWithBody(c.Server, patientId, contentType, body)
+func (c *Client) SendUploadReminder(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSendUploadReminderRequest(c.Server, clinicId, patientId)
 	if err != nil {
 		return nil, err
 	}
@@ -1492,8 +1664,8 @@ func (c *Client) UpdatePatientSummaryWithBody(ctx context.Context, patientId Pat
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePatientSummary(ctx context.Context, patientId PatientId, body UpdatePatientSummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePatientSummaryRequest(c.Server, patientId, body)
+func (c *Client) GenerateConsolidationReportWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGenerateConsolidationReportRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1504,8 +1676,8 @@ func (c *Client) UpdatePatientSummary(ctx context.Context, patientId PatientId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListClinicsForPatient(ctx context.Context, userId UserId, params *ListClinicsForPatientParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListClinicsForPatientRequest(c.Server, userId, params)
+func (c *Client) GenerateConsolidationReport(ctx context.Context, clinicId ClinicId, body GenerateConsolidationReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGenerateConsolidationReportRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1516,8 +1688,8 @@ func (c *Client) ListClinicsForPatient(ctx context.Context, userId UserId, param
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePatientDataSourcesWithBody(ctx context.Context, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePatientDataSourcesRequestWithBody(c.Server, userId, contentType, body)
+func (c *Client) GenerateMergeReportWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGenerateMergeReportRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1528,8 +1700,8 @@ func (c *Client) UpdatePatientDataSourcesWithBody(ctx context.Context, userId Us
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePatientDataSources(ctx context.Context, userId UserId, body UpdatePatientDataSourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePatientDataSourcesRequest(c.Server, userId, body)
+func (c *Client) GenerateMergeReport(ctx context.Context, clinicId ClinicId, body GenerateMergeReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGenerateMergeReportRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1540,8 +1712,8 @@ func (c *Client) UpdatePatientDataSources(ctx context.Context, userId UserId, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) ProcessEHRMessageWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewProcessEHRMessageRequestWithBody(c.Server, contentType, body)
+func (c *Client) GenerateSplitReportWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGenerateSplitReportRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1552,8 +1724,8 @@ func (c *Client) ProcessEHRMessageWithBody(ctx context.Context, contentType stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) ProcessEHRMessage(ctx context.Context, body ProcessEHRMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewProcessEHRMessageRequest(c.Server, body)
+func (c *Client) GenerateSplitReport(ctx context.Context, clinicId ClinicId, body GenerateSplitReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGenerateSplitReportRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1564,8 +1736,8 @@ func (c *Client) ProcessEHRMessage(ctx context.Context, body ProcessEHRMessageJS
 	return c.Client.Do(req)
 }
 
-func (c *Client) MatchClinicAndPatientWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMatchClinicAndPatientRequestWithBody(c.Server, contentType, body)
+func (c *Client) AddServiceAccountWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddServiceAccountRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1576,8 +1748,8 @@ func (c *Client) MatchClinicAndPatientWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) MatchClinicAndPatient(ctx context.Context, body MatchClinicAndPatientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMatchClinicAndPatientRequest(c.Server, body)
+func (c *Client) AddServiceAccount(ctx context.Context, clinicId ClinicId, body AddServiceAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddServiceAccountRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1588,8 +1760,8 @@ func (c *Client) MatchClinicAndPatient(ctx context.Context, body MatchClinicAndP
 	return c.Client.Do(req)
 }
 
-func (c *Client) VerifyEndpoint(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVerifyEndpointRequest(c.Server)
+func (c *Client) GetEHRSettings(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEHRSettingsRequest(c.Server, clinicId)
 	if err != nil {
 		return nil, err
 	}
@@ -1600,8 +1772,8 @@ func (c *Client) VerifyEndpoint(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeletePatientSummary(ctx context.Context, summaryId SummaryId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletePatientSummaryRequest(c.Server, summaryId)
+func (c *Client) UpdateEHRSettingsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEHRSettingsRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1612,8 +1784,8 @@ func (c *Client) DeletePatientSummary(ctx context.Context, summaryId SummaryId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteUserFromClinics(ctx context.Context, userId UserId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteUserFromClinicsRequest(c.Server, userId)
+func (c *Client) UpdateEHRSettings(ctx context.Context, clinicId ClinicId, body UpdateEHRSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEHRSettingsRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1624,8 +1796,8 @@ func (c *Client) DeleteUserFromClinics(ctx context.Context, userId UserId, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateClinicUserDetailsWithBody(ctx context.Context, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateClinicUserDetailsRequestWithBody(c.Server, userId, contentType, body)
+func (c *Client) GetMRNSettings(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMRNSettingsRequest(c.Server, clinicId)
 	if err != nil {
 		return nil, err
 	}
@@ -1636,8 +1808,8 @@ func (c *Client) UpdateClinicUserDetailsWithBody(ctx context.Context, userId Use
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateClinicUserDetails(ctx context.Context, userId UserId, body UpdateClinicUserDetailsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateClinicUserDetailsRequest(c.Server, userId, body)
+func (c *Client) UpdateMRNSettingsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMRNSettingsRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1648,8 +1820,8 @@ func (c *Client) UpdateClinicUserDetails(ctx context.Context, userId UserId, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) XealthNotification(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewXealthNotificationRequest(c.Server)
+func (c *Client) UpdateMRNSettings(ctx context.Context, clinicId ClinicId, body UpdateMRNSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMRNSettingsRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1660,8 +1832,8 @@ func (c *Client) XealthNotification(ctx context.Context, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) XealthPreorder(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewXealthPreorderRequest(c.Server)
+func (c *Client) GetPatientCountSettings(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPatientCountSettingsRequest(c.Server, clinicId)
 	if err != nil {
 		return nil, err
 	}
@@ -1672,8 +1844,8 @@ func (c *Client) XealthPreorder(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) XealthGetProgramUrl(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewXealthGetProgramUrlRequest(c.Server)
+func (c *Client) UpdatePatientCountSettingsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePatientCountSettingsRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1684,8 +1856,8 @@ func (c *Client) XealthGetProgramUrl(ctx context.Context, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) XealthGetPrograms(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewXealthGetProgramsRequest(c.Server)
+func (c *Client) UpdatePatientCountSettings(ctx context.Context, clinicId ClinicId, body UpdatePatientCountSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePatientCountSettingsRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1696,8 +1868,8 @@ func (c *Client) XealthGetPrograms(ctx context.Context, reqEditors ...RequestEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) ViewPDFReport(ctx context.Context, params *ViewPDFReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewViewPDFReportRequest(c.Server, params)
+func (c *Client) CreateSiteWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSiteRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1708,508 +1880,531 @@ func (c *Client) ViewPDFReport(ctx context.Context, params *ViewPDFReportParams,
 	return c.Client.Do(req)
 }
 
-// NewListAllCliniciansRequest generates requests for ListAllClinicians
-func NewListAllCliniciansRequest(server string, params *ListAllCliniciansParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateSite(ctx context.Context, clinicId ClinicId, body CreateSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSiteRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/clinicians")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteSite(ctx context.Context, clinicId ClinicId, siteId SiteId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSiteRequest(c.Server, clinicId, siteId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+func (c *Client) UpdateSiteWithBody(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSiteRequestWithBody(c.Server, clinicId, siteId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.Offset != nil {
+func (c *Client) UpdateSite(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSiteRequest(c.Server, clinicId, siteId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (c *Client) MergeSiteWithBody(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergeSiteRequestWithBody(c.Server, clinicId, siteId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		}
+func (c *Client) MergeSite(ctx context.Context, clinicId ClinicId, siteId SiteId, body MergeSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergeSiteRequest(c.Server, clinicId, siteId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.Limit != nil {
+func (c *Client) UpdateSiteParentWithBody(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSiteParentRequestWithBody(c.Server, clinicId, siteId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.CreatedTimeStart != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdTimeStart", runtime.ParamLocationQuery, *params.CreatedTimeStart); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.CreatedTimeEnd != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdTimeEnd", runtime.ParamLocationQuery, *params.CreatedTimeEnd); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+func (c *Client) UpdateSiteParent(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteParentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSiteParentRequest(c.Server, clinicId, siteId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) UpdateSiteSettingsWithBody(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSiteSettingsRequestWithBody(c.Server, clinicId, siteId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListClinicsForClinicianRequest generates requests for ListClinicsForClinician
-func NewListClinicsForClinicianRequest(server string, userId UserId, params *ListClinicsForClinicianParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+func (c *Client) UpdateSiteSettings(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSiteSettingsRequest(c.Server, clinicId, siteId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) SplitClinicWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSplitClinicRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/clinicians/%s/clinics", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SplitClinic(ctx context.Context, clinicId ClinicId, body SplitClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSplitClinicRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) UpdateSuppressedNotificationsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSuppressedNotificationsRequestWithBody(c.Server, clinicId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewEnableNewClinicExperienceRequest generates requests for EnableNewClinicExperience
-func NewEnableNewClinicExperienceRequest(server string, userId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+func (c *Client) UpdateSuppressedNotifications(ctx context.Context, clinicId ClinicId, body UpdateSuppressedNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSuppressedNotificationsRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) TideReport(ctx context.Context, clinicId ClinicId, params *TideReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTideReportRequest(c.Server, clinicId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/v1/clinicians/%s/migrate", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) UpdateTierWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTierRequestWithBody(c.Server, clinicId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateTier(ctx context.Context, clinicId ClinicId, body UpdateTierJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTierRequest(c.Server, clinicId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) ListXealthPatientsNotViewed(ctx context.Context, clinicId ClinicId, params *ListXealthPatientsNotViewedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListXealthPatientsNotViewedRequest(c.Server, clinicId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) ListXealthReportViews(ctx context.Context, clinicId ClinicId, params *ListXealthReportViewsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListXealthReportViewsRequest(c.Server, clinicId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListClinicsRequest generates requests for ListClinics
-func NewListClinicsRequest(server string, params *ListClinicsParams) (*http.Request, error) {
-	var err error
+func (c *Client) GetXealthReportViewStats(ctx context.Context, clinicId ClinicId, params *GetXealthReportViewStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetXealthReportViewStatsRequest(c.Server, clinicId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListIncompleteMigrations(ctx context.Context, params *ListIncompleteMigrationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListIncompleteMigrationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/v1/clinics")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) FindPatients(ctx context.Context, params *FindPatientsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindPatientsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SyncEHRDataForPatient(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSyncEHRDataForPatientRequest(c.Server, patientId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+func (c *Client) UpdatePatientSummaryWithBody(ctx context.Context, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePatientSummaryRequestWithBody(c.Server, patientId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.ShareCode != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "shareCode", runtime.ParamLocationQuery, *params.ShareCode); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.CreatedTimeStart != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdTimeStart", runtime.ParamLocationQuery, *params.CreatedTimeStart); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.CreatedTimeEnd != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdTimeEnd", runtime.ParamLocationQuery, *params.CreatedTimeEnd); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.EhrEnabled != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ehrEnabled", runtime.ParamLocationQuery, *params.EhrEnabled); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) UpdatePatientSummary(ctx context.Context, patientId PatientId, body UpdatePatientSummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePatientSummaryRequest(c.Server, patientId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateClinicRequest calls the generic CreateClinic builder with application/json body
-func NewCreateClinicRequest(server string, body CreateClinicJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListClinicsForPatient(ctx context.Context, userId UserId, params *ListClinicsForPatientParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClinicsForPatientRequest(c.Server, userId, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateClinicRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateClinicRequestWithBody generates requests for CreateClinic with any type of body
-func NewCreateClinicRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdatePatientDataSourcesWithBody(ctx context.Context, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePatientDataSourcesRequestWithBody(c.Server, userId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/clinics")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdatePatientDataSources(ctx context.Context, userId UserId, body UpdatePatientDataSourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePatientDataSourcesRequest(c.Server, userId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetClinicByShareCodeRequest generates requests for GetClinicByShareCode
-func NewGetClinicByShareCodeRequest(server string, shareCode string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "shareCode", runtime.ParamLocationPath, shareCode)
+func (c *Client) ProcessEHRMessageWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcessEHRMessageRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ProcessEHRMessage(ctx context.Context, body ProcessEHRMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcessEHRMessageRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/clinics/share_code/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) MatchClinicAndPatientWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMatchClinicAndPatientRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteClinicRequest generates requests for DeleteClinic
-func NewDeleteClinicRequest(server string, clinicId ClinicId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+func (c *Client) MatchClinicAndPatient(ctx context.Context, body MatchClinicAndPatientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMatchClinicAndPatientRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) VerifyEndpoint(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyEndpointRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/clinics/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeletePatientSummary(ctx context.Context, summaryId SummaryId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePatientSummaryRequest(c.Server, summaryId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetClinicRequest generates requests for GetClinic
-func NewGetClinicRequest(server string, clinicId ClinicId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+func (c *Client) DeleteUserFromClinics(ctx context.Context, userId UserId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserFromClinicsRequest(c.Server, userId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateClinicUserDetailsWithBody(ctx context.Context, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClinicUserDetailsRequestWithBody(c.Server, userId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/clinics/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateClinicUserDetails(ctx context.Context, userId UserId, body UpdateClinicUserDetailsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClinicUserDetailsRequest(c.Server, userId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) XealthNotification(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewXealthNotificationRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) XealthPreorder(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewXealthPreorderRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateClinicRequest calls the generic UpdateClinic builder with application/json body
-func NewUpdateClinicRequest(server string, clinicId ClinicId, body UpdateClinicJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) XealthGetProgramUrl(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewXealthGetProgramUrlRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateClinicRequestWithBody(server, clinicId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateClinicRequestWithBody generates requests for UpdateClinic with any type of body
-func NewUpdateClinicRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) XealthGetPrograms(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewXealthGetProgramsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) ViewPDFReport(ctx context.Context, params *ViewPDFReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewViewPDFReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+// NewInvalidateAuthenticationCacheRequest calls the generic InvalidateAuthenticationCache builder with application/json body
+func NewInvalidateAuthenticationCacheRequest(server string, body InvalidateAuthenticationCacheJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewInvalidateAuthenticationCacheRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewInvalidateAuthenticationCacheRequestWithBody generates requests for InvalidateAuthenticationCache with any type of body
+func NewInvalidateAuthenticationCacheRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/auth/cache/invalidate")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2219,7 +2414,7 @@ func NewUpdateClinicRequestWithBody(server string, clinicId ClinicId, contentTyp
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2229,23 +2424,56 @@ func NewUpdateClinicRequestWithBody(server string, clinicId ClinicId, contentTyp
 	return req, nil
 }
 
-// NewListCliniciansRequest generates requests for ListClinicians
-func NewListCliniciansRequest(server string, clinicId ClinicId, params *ListCliniciansParams) (*http.Request, error) {
+// NewEvaluateAuthorizationPolicyRequest calls the generic EvaluateAuthorizationPolicy builder with application/json body
+func NewEvaluateAuthorizationPolicyRequest(server string, body EvaluateAuthorizationPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEvaluateAuthorizationPolicyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewEvaluateAuthorizationPolicyRequestWithBody generates requests for EvaluateAuthorizationPolicy with any type of body
+func NewEvaluateAuthorizationPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	operationPath := fmt.Sprintf("/v1/authz/evaluate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListAllCliniciansRequest generates requests for ListAllClinicians
+func NewListAllCliniciansRequest(server string, params *ListAllCliniciansParams) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/clinicians", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clinicians")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2258,22 +2486,6 @@ func NewListCliniciansRequest(server string, clinicId ClinicId, params *ListClin
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Search != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
 		if params.Offset != nil {
 
 			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
@@ -2306,9 +2518,9 @@ func NewListCliniciansRequest(server string, clinicId ClinicId, params *ListClin
 
 		}
 
-		if params.Email != nil {
+		if params.CreatedTimeStart != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "email", runtime.ParamLocationQuery, *params.Email); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdTimeStart", runtime.ParamLocationQuery, *params.CreatedTimeStart); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2322,9 +2534,9 @@ func NewListCliniciansRequest(server string, clinicId ClinicId, params *ListClin
 
 		}
 
-		if params.Role != nil {
+		if params.CreatedTimeEnd != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "role", runtime.ParamLocationQuery, *params.Role); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdTimeEnd", runtime.ParamLocationQuery, *params.CreatedTimeEnd); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2349,24 +2561,13 @@ func NewListCliniciansRequest(server string, clinicId ClinicId, params *ListClin
 	return req, nil
 }
 
-// NewCreateClinicianRequest calls the generic CreateClinician builder with application/json body
-func NewCreateClinicianRequest(server string, clinicId ClinicId, body CreateClinicianJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateClinicianRequestWithBody(server, clinicId, "application/json", bodyReader)
-}
-
-// NewCreateClinicianRequestWithBody generates requests for CreateClinician with any type of body
-func NewCreateClinicianRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+// NewListClinicsForClinicianRequest generates requests for ListClinicsForClinician
+func NewListClinicsForClinicianRequest(server string, userId UserId, params *ListClinicsForClinicianParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
 	if err != nil {
 		return nil, err
 	}
@@ -2376,7 +2577,7 @@ func NewCreateClinicianRequestWithBody(server string, clinicId ClinicId, content
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/clinicians", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clinicians/%s/clinics", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2386,30 +2587,59 @@ func NewCreateClinicianRequestWithBody(server string, clinicId ClinicId, content
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteClinicianRequest generates requests for DeleteClinician
-func NewDeleteClinicianRequest(server string, clinicId ClinicId, clinicianId ClinicianId) (*http.Request, error) {
+// NewEnableNewClinicExperienceRequest generates requests for EnableNewClinicExperience
+func NewEnableNewClinicExperienceRequest(server string, userId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clinicianId", runtime.ParamLocationPath, clinicianId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
 	if err != nil {
 		return nil, err
 	}
@@ -2419,7 +2649,7 @@ func NewDeleteClinicianRequest(server string, clinicId ClinicId, clinicianId Cli
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/clinicians/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinicians/%s/migrate", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2429,7 +2659,7 @@ func NewDeleteClinicianRequest(server string, clinicId ClinicId, clinicianId Cli
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2437,30 +2667,16 @@ func NewDeleteClinicianRequest(server string, clinicId ClinicId, clinicianId Cli
 	return req, nil
 }
 
-// NewGetClinicianRequest generates requests for GetClinician
-func NewGetClinicianRequest(server string, clinicId ClinicId, clinicianId ClinicianId) (*http.Request, error) {
+// NewListClinicsRequest generates requests for ListClinics
+func NewListClinicsRequest(server string, params *ListClinicsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clinicianId", runtime.ParamLocationPath, clinicianId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/clinicians/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2470,49 +2686,137 @@ func NewGetClinicianRequest(server string, clinicId ClinicId, clinicianId Clinic
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// NewUpdateClinicianRequest calls the generic UpdateClinician builder with application/json body
-func NewUpdateClinicianRequest(server string, clinicId ClinicId, clinicianId ClinicianId, body UpdateClinicianJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateClinicianRequestWithBody(server, clinicId, clinicianId, "application/json", bodyReader)
-}
+		if params.Limit != nil {
 
-// NewUpdateClinicianRequestWithBody generates requests for UpdateClinician with any type of body
-func NewUpdateClinicianRequestWithBody(server string, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam0 string
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ShareCode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "shareCode", runtime.ParamLocationQuery, *params.ShareCode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedTimeStart != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdTimeStart", runtime.ParamLocationQuery, *params.CreatedTimeStart); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedTimeEnd != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdTimeEnd", runtime.ParamLocationQuery, *params.CreatedTimeEnd); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EhrEnabled != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ehrEnabled", runtime.ParamLocationQuery, *params.EhrEnabled); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	return req, nil
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clinicianId", runtime.ParamLocationPath, clinicianId)
+// NewCreateClinicRequest calls the generic CreateClinic builder with application/json body
+func NewCreateClinicRequest(server string, body CreateClinicJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateClinicRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateClinicRequestWithBody generates requests for CreateClinic with any type of body
+func NewCreateClinicRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/clinicians/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2522,7 +2826,7 @@ func NewUpdateClinicianRequestWithBody(server string, clinicId ClinicId, clinici
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2532,13 +2836,13 @@ func NewUpdateClinicianRequestWithBody(server string, clinicId ClinicId, clinici
 	return req, nil
 }
 
-// NewSyncEHRDataRequest generates requests for SyncEHRData
-func NewSyncEHRDataRequest(server string, clinicId ClinicId) (*http.Request, error) {
+// NewGetClinicByShareCodeRequest generates requests for GetClinicByShareCode
+func NewGetClinicByShareCodeRequest(server string, shareCode string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "shareCode", runtime.ParamLocationPath, shareCode)
 	if err != nil {
 		return nil, err
 	}
@@ -2548,7 +2852,7 @@ func NewSyncEHRDataRequest(server string, clinicId ClinicId) (*http.Request, err
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/ehr/sync", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clinics/share_code/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2558,7 +2862,7 @@ func NewSyncEHRDataRequest(server string, clinicId ClinicId) (*http.Request, err
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2566,8 +2870,8 @@ func NewSyncEHRDataRequest(server string, clinicId ClinicId) (*http.Request, err
 	return req, nil
 }
 
-// NewDeleteInvitedClinicianRequest generates requests for DeleteInvitedClinician
-func NewDeleteInvitedClinicianRequest(server string, clinicId ClinicId, inviteId InviteId) (*http.Request, error) {
+// NewDeleteClinicRequest generates requests for DeleteClinic
+func NewDeleteClinicRequest(server string, clinicId ClinicId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2577,19 +2881,12 @@ func NewDeleteInvitedClinicianRequest(server string, clinicId ClinicId, inviteId
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "inviteId", runtime.ParamLocationPath, inviteId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/invites/clinicians/%s/clinician", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2607,8 +2904,8 @@ func NewDeleteInvitedClinicianRequest(server string, clinicId ClinicId, inviteId
 	return req, nil
 }
 
-// NewGetInvitedClinicianRequest generates requests for GetInvitedClinician
-func NewGetInvitedClinicianRequest(server string, clinicId ClinicId, inviteId InviteId) (*http.Request, error) {
+// NewGetClinicRequest generates requests for GetClinic
+func NewGetClinicRequest(server string, clinicId ClinicId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2618,19 +2915,12 @@ func NewGetInvitedClinicianRequest(server string, clinicId ClinicId, inviteId In
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "inviteId", runtime.ParamLocationPath, inviteId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/invites/clinicians/%s/clinician", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2648,19 +2938,19 @@ func NewGetInvitedClinicianRequest(server string, clinicId ClinicId, inviteId In
 	return req, nil
 }
 
-// NewAssociateClinicianToUserRequest calls the generic AssociateClinicianToUser builder with application/json body
-func NewAssociateClinicianToUserRequest(server string, clinicId ClinicId, inviteId InviteId, body AssociateClinicianToUserJSONRequestBody) (*http.Request, error) {
+// NewUpdateClinicRequest calls the generic UpdateClinic builder with application/json body
+func NewUpdateClinicRequest(server string, clinicId ClinicId, body UpdateClinicJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewAssociateClinicianToUserRequestWithBody(server, clinicId, inviteId, "application/json", bodyReader)
+	return NewUpdateClinicRequestWithBody(server, clinicId, "application/json", bodyReader)
 }
 
-// NewAssociateClinicianToUserRequestWithBody generates requests for AssociateClinicianToUser with any type of body
-func NewAssociateClinicianToUserRequestWithBody(server string, clinicId ClinicId, inviteId InviteId, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateClinicRequestWithBody generates requests for UpdateClinic with any type of body
+func NewUpdateClinicRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2670,19 +2960,12 @@ func NewAssociateClinicianToUserRequestWithBody(server string, clinicId ClinicId
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "inviteId", runtime.ParamLocationPath, inviteId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/invites/clinicians/%s/clinician", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2692,7 +2975,7 @@ func NewAssociateClinicianToUserRequestWithBody(server string, clinicId ClinicId
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2702,8 +2985,8 @@ func NewAssociateClinicianToUserRequestWithBody(server string, clinicId ClinicId
 	return req, nil
 }
 
-// NewListMembershipRestrictionsRequest generates requests for ListMembershipRestrictions
-func NewListMembershipRestrictionsRequest(server string, clinicId ClinicId) (*http.Request, error) {
+// NewGetAccessRestrictionsRequest generates requests for GetAccessRestrictions
+func NewGetAccessRestrictionsRequest(server string, clinicId ClinicId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2718,7 +3001,7 @@ func NewListMembershipRestrictionsRequest(server string, clinicId ClinicId) (*ht
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/membership_restrictions", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/access_restrictions", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2736,19 +3019,19 @@ func NewListMembershipRestrictionsRequest(server string, clinicId ClinicId) (*ht
 	return req, nil
 }
 
-// NewUpdateMembershipRestrictionsRequest calls the generic UpdateMembershipRestrictions builder with application/json body
-func NewUpdateMembershipRestrictionsRequest(server string, clinicId ClinicId, body UpdateMembershipRestrictionsJSONRequestBody) (*http.Request, error) {
+// NewUpdateAccessRestrictionsRequest calls the generic UpdateAccessRestrictions builder with application/json body
+func NewUpdateAccessRestrictionsRequest(server string, clinicId ClinicId, body UpdateAccessRestrictionsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateMembershipRestrictionsRequestWithBody(server, clinicId, "application/json", bodyReader)
+	return NewUpdateAccessRestrictionsRequestWithBody(server, clinicId, "application/json", bodyReader)
 }
 
-// NewUpdateMembershipRestrictionsRequestWithBody generates requests for UpdateMembershipRestrictions with any type of body
-func NewUpdateMembershipRestrictionsRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateAccessRestrictionsRequestWithBody generates requests for UpdateAccessRestrictions with any type of body
+func NewUpdateAccessRestrictionsRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2763,7 +3046,7 @@ func NewUpdateMembershipRestrictionsRequestWithBody(server string, clinicId Clin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/membership_restrictions", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/access_restrictions", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2783,19 +3066,8 @@ func NewUpdateMembershipRestrictionsRequestWithBody(server string, clinicId Clin
 	return req, nil
 }
 
-// NewMergeClinicRequest calls the generic MergeClinic builder with application/json body
-func NewMergeClinicRequest(server string, clinicId ClinicId, body MergeClinicJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewMergeClinicRequestWithBody(server, clinicId, "application/json", bodyReader)
-}
-
-// NewMergeClinicRequestWithBody generates requests for MergeClinic with any type of body
-func NewMergeClinicRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+// NewListAPIKeysRequest generates requests for ListAPIKeys
+func NewListAPIKeysRequest(server string, clinicId ClinicId, params *ListAPIKeysParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2810,7 +3082,7 @@ func NewMergeClinicRequestWithBody(server string, clinicId ClinicId, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/merge", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/api_keys", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2820,29 +3092,49 @@ func NewMergeClinicRequestWithBody(server string, clinicId ClinicId, contentType
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Active != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, *params.Active); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewTriggerInitialMigrationRequest calls the generic TriggerInitialMigration builder with application/json body
-func NewTriggerInitialMigrationRequest(server string, clinicId string, body TriggerInitialMigrationJSONRequestBody) (*http.Request, error) {
+// NewCreateAPIKeyRequest calls the generic CreateAPIKey builder with application/json body
+func NewCreateAPIKeyRequest(server string, clinicId ClinicId, body CreateAPIKeyJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewTriggerInitialMigrationRequestWithBody(server, clinicId, "application/json", bodyReader)
+	return NewCreateAPIKeyRequestWithBody(server, clinicId, "application/json", bodyReader)
 }
 
-// NewTriggerInitialMigrationRequestWithBody generates requests for TriggerInitialMigration with any type of body
-func NewTriggerInitialMigrationRequestWithBody(server string, clinicId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateAPIKeyRequestWithBody generates requests for CreateAPIKey with any type of body
+func NewCreateAPIKeyRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2857,7 +3149,7 @@ func NewTriggerInitialMigrationRequestWithBody(server string, clinicId string, c
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/migrate", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/api_keys", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2877,8 +3169,8 @@ func NewTriggerInitialMigrationRequestWithBody(server string, clinicId string, c
 	return req, nil
 }
 
-// NewListMigrationsRequest generates requests for ListMigrations
-func NewListMigrationsRequest(server string, clinicId string) (*http.Request, error) {
+// NewRevokeAPIKeyRequest generates requests for RevokeAPIKey
+func NewRevokeAPIKeyRequest(server string, clinicId ClinicId, apiKeyId ApiKeyId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2888,12 +3180,19 @@ func NewListMigrationsRequest(server string, clinicId string) (*http.Request, er
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "apiKeyId", runtime.ParamLocationPath, apiKeyId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/migrations", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/api_keys/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2903,7 +3202,7 @@ func NewListMigrationsRequest(server string, clinicId string) (*http.Request, er
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2911,19 +3210,8 @@ func NewListMigrationsRequest(server string, clinicId string) (*http.Request, er
 	return req, nil
 }
 
-// NewMigrateLegacyClinicianPatientsRequest calls the generic MigrateLegacyClinicianPatients builder with application/json body
-func NewMigrateLegacyClinicianPatientsRequest(server string, clinicId string, body MigrateLegacyClinicianPatientsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewMigrateLegacyClinicianPatientsRequestWithBody(server, clinicId, "application/json", bodyReader)
-}
-
-// NewMigrateLegacyClinicianPatientsRequestWithBody generates requests for MigrateLegacyClinicianPatients with any type of body
-func NewMigrateLegacyClinicianPatientsRequestWithBody(server string, clinicId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewListAuditEventsRequest generates requests for ListAuditEvents
+func NewListAuditEventsRequest(server string, clinicId ClinicId, params *ListAuditEventsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2938,7 +3226,7 @@ func NewMigrateLegacyClinicianPatientsRequestWithBody(server string, clinicId st
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/migrations", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/audit_events", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2948,18 +3236,70 @@ func NewMigrateLegacyClinicianPatientsRequestWithBody(server string, clinicId st
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
-// NewGetMigrationRequest generates requests for GetMigration
-func NewGetMigrationRequest(server string, clinicId ClinicIdV1, userId UserId) (*http.Request, error) {
+// NewListClinicianRolesRequest generates requests for ListClinicianRoles
+func NewListClinicianRolesRequest(server string, clinicId ClinicId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2969,19 +3309,12 @@ func NewGetMigrationRequest(server string, clinicId ClinicIdV1, userId UserId) (
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/migrations/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/clinician_roles", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2999,19 +3332,19 @@ func NewGetMigrationRequest(server string, clinicId ClinicIdV1, userId UserId) (
 	return req, nil
 }
 
-// NewUpdateMigrationRequest calls the generic UpdateMigration builder with application/json body
-func NewUpdateMigrationRequest(server string, clinicId ClinicIdV1, userId UserId, body UpdateMigrationJSONRequestBody) (*http.Request, error) {
+// NewUpdateClinicianRolesRequest calls the generic UpdateClinicianRoles builder with application/json body
+func NewUpdateClinicianRolesRequest(server string, clinicId ClinicId, body UpdateClinicianRolesJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateMigrationRequestWithBody(server, clinicId, userId, "application/json", bodyReader)
+	return NewUpdateClinicianRolesRequestWithBody(server, clinicId, "application/json", bodyReader)
 }
 
-// NewUpdateMigrationRequestWithBody generates requests for UpdateMigration with any type of body
-func NewUpdateMigrationRequestWithBody(server string, clinicId ClinicIdV1, userId UserId, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateClinicianRolesRequestWithBody generates requests for UpdateClinicianRoles with any type of body
+func NewUpdateClinicianRolesRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3021,19 +3354,12 @@ func NewUpdateMigrationRequestWithBody(server string, clinicId ClinicIdV1, userI
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/migrations/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/clinician_roles", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3043,7 +3369,7 @@ func NewUpdateMigrationRequestWithBody(server string, clinicId ClinicIdV1, userI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3053,8 +3379,8 @@ func NewUpdateMigrationRequestWithBody(server string, clinicId ClinicIdV1, userI
 	return req, nil
 }
 
-// NewGetPatientCountRequest generates requests for GetPatientCount
-func NewGetPatientCountRequest(server string, clinicId ClinicId) (*http.Request, error) {
+// NewListCliniciansRequest generates requests for ListClinicians
+func NewListCliniciansRequest(server string, clinicId ClinicId, params *ListCliniciansParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3069,7 +3395,7 @@ func NewGetPatientCountRequest(server string, clinicId ClinicId) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patient_count", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/clinicians", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3079,41 +3405,93 @@ func NewGetPatientCountRequest(server string, clinicId ClinicId) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Search != nil {
 
-// NewRefreshPatientCountRequest generates requests for RefreshPatientCount
-func NewRefreshPatientCountRequest(server string, clinicId ClinicId) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam0 string
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
-	if err != nil {
-		return nil, err
-	}
+		if params.Offset != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patient_count/refresh", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Email != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "email", runtime.ParamLocationQuery, *params.Email); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Role != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "role", runtime.ParamLocationQuery, *params.Role); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3121,19 +3499,19 @@ func NewRefreshPatientCountRequest(server string, clinicId ClinicId) (*http.Requ
 	return req, nil
 }
 
-// NewCreatePatientTagRequest calls the generic CreatePatientTag builder with application/json body
-func NewCreatePatientTagRequest(server string, clinicId ClinicId, body CreatePatientTagJSONRequestBody) (*http.Request, error) {
+// NewCreateClinicianRequest calls the generic CreateClinician builder with application/json body
+func NewCreateClinicianRequest(server string, clinicId ClinicId, body CreateClinicianJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreatePatientTagRequestWithBody(server, clinicId, "application/json", bodyReader)
+	return NewCreateClinicianRequestWithBody(server, clinicId, "application/json", bodyReader)
 }
 
-// NewCreatePatientTagRequestWithBody generates requests for CreatePatientTag with any type of body
-func NewCreatePatientTagRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateClinicianRequestWithBody generates requests for CreateClinician with any type of body
+func NewCreateClinicianRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3148,7 +3526,7 @@ func NewCreatePatientTagRequestWithBody(server string, clinicId ClinicId, conten
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patient_tags", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/clinicians", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3168,8 +3546,8 @@ func NewCreatePatientTagRequestWithBody(server string, clinicId ClinicId, conten
 	return req, nil
 }
 
-// NewDeletePatientTagRequest generates requests for DeletePatientTag
-func NewDeletePatientTagRequest(server string, clinicId ClinicId, patientTagId PatientTagId) (*http.Request, error) {
+// NewDeleteClinicianRequest generates requests for DeleteClinician
+func NewDeleteClinicianRequest(server string, clinicId ClinicId, clinicianId ClinicianId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3181,7 +3559,7 @@ func NewDeletePatientTagRequest(server string, clinicId ClinicId, patientTagId P
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientTagId", runtime.ParamLocationPath, patientTagId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clinicianId", runtime.ParamLocationPath, clinicianId)
 	if err != nil {
 		return nil, err
 	}
@@ -3191,7 +3569,7 @@ func NewDeletePatientTagRequest(server string, clinicId ClinicId, patientTagId P
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patient_tags/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/clinicians/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3209,19 +3587,8 @@ func NewDeletePatientTagRequest(server string, clinicId ClinicId, patientTagId P
 	return req, nil
 }
 
-// NewUpdatePatientTagRequest calls the generic UpdatePatientTag builder with application/json body
-func NewUpdatePatientTagRequest(server string, clinicId ClinicId, patientTagId PatientTagId, body UpdatePatientTagJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePatientTagRequestWithBody(server, clinicId, patientTagId, "application/json", bodyReader)
-}
-
-// NewUpdatePatientTagRequestWithBody generates requests for UpdatePatientTag with any type of body
-func NewUpdatePatientTagRequestWithBody(server string, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetClinicianRequest generates requests for GetClinician
+func NewGetClinicianRequest(server string, clinicId ClinicId, clinicianId ClinicianId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3233,7 +3600,7 @@ func NewUpdatePatientTagRequestWithBody(server string, clinicId ClinicId, patien
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientTagId", runtime.ParamLocationPath, patientTagId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clinicianId", runtime.ParamLocationPath, clinicianId)
 	if err != nil {
 		return nil, err
 	}
@@ -3243,7 +3610,7 @@ func NewUpdatePatientTagRequestWithBody(server string, clinicId ClinicId, patien
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patient_tags/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/clinicians/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3253,18 +3620,27 @@ func NewUpdatePatientTagRequestWithBody(server string, clinicId ClinicId, patien
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewConvertPatientTagToSiteRequest generates requests for ConvertPatientTagToSite
-func NewConvertPatientTagToSiteRequest(server string, clinicId ClinicId, patientTagId PatientTagId) (*http.Request, error) {
+// NewUpdateClinicianRequest calls the generic UpdateClinician builder with application/json body
+func NewUpdateClinicianRequest(server string, clinicId ClinicId, clinicianId ClinicianId, body UpdateClinicianJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateClinicianRequestWithBody(server, clinicId, clinicianId, "application/json", bodyReader)
+}
+
+// NewUpdateClinicianRequestWithBody generates requests for UpdateClinician with any type of body
+func NewUpdateClinicianRequestWithBody(server string, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3276,7 +3652,7 @@ func NewConvertPatientTagToSiteRequest(server string, clinicId ClinicId, patient
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientTagId", runtime.ParamLocationPath, patientTagId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clinicianId", runtime.ParamLocationPath, clinicianId)
 	if err != nil {
 		return nil, err
 	}
@@ -3286,7 +3662,7 @@ func NewConvertPatientTagToSiteRequest(server string, clinicId ClinicId, patient
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patient_tags/%s/site", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/clinicians/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3296,16 +3672,29 @@ func NewConvertPatientTagToSiteRequest(server string, clinicId ClinicId, patient
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewListPatientsRequest generates requests for ListPatients
-func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatientsParams) (*http.Request, error) {
+// NewUpdateClinicianSitesRequest calls the generic UpdateClinicianSites builder with application/json body
+func NewUpdateClinicianSitesRequest(server string, clinicId ClinicId, clinicianId ClinicianId, body UpdateClinicianSitesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateClinicianSitesRequestWithBody(server, clinicId, clinicianId, "application/json", bodyReader)
+}
+
+// NewUpdateClinicianSitesRequestWithBody generates requests for UpdateClinicianSites with any type of body
+func NewUpdateClinicianSitesRequestWithBody(server string, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3315,12 +3704,19 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clinicianId", runtime.ParamLocationPath, clinicianId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patients", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/clinicians/%s/sites", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3330,1468 +3726,1330 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Search != nil {
+	req.Header.Add("Content-Type", contentType)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewConsolidateClinicsRequest calls the generic ConsolidateClinics builder with application/json body
+func NewConsolidateClinicsRequest(server string, clinicId ClinicId, body ConsolidateClinicsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConsolidateClinicsRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
 
-		if params.Offset != nil {
+// NewConsolidateClinicsRequestWithBody generates requests for ConsolidateClinics with any type of body
+func NewConsolidateClinicsRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam0 string
 
-		}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Limit != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/consolidate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Sort != nil {
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req.Header.Add("Content-Type", contentType)
 
-		}
+	return req, nil
+}
 
-		if params.SortType != nil {
+// NewGetClinicConsolidationRequest generates requests for GetClinicConsolidation
+func NewGetClinicConsolidationRequest(server string, clinicId ClinicId, consolidationId ConsolidationId) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortType", runtime.ParamLocationQuery, *params.SortType); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam0 string
 
-		}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Period != nil {
+	var pathParam1 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "consolidationId", runtime.ParamLocationPath, consolidationId)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.LastReviewed != nil {
+	operationPath := fmt.Sprintf("/v1/clinics/%s/consolidations/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastReviewed", runtime.ParamLocationQuery, *params.LastReviewed); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmMax != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.max", runtime.ParamLocationQuery, *params.CgmMax); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewSyncEHRDataRequest generates requests for SyncEHRData
+func NewSyncEHRDataRequest(server string, clinicId ClinicId) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.CgmMin != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.min", runtime.ParamLocationQuery, *params.CgmMin); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/ehr/sync", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.CgmAverageGlucoseMmol != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.averageGlucoseMmol", runtime.ParamLocationQuery, *params.CgmAverageGlucoseMmol); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.CgmGlucoseManagementIndicator != nil {
+// NewDeleteInvitedClinicianRequest generates requests for DeleteInvitedClinician
+func NewDeleteInvitedClinicianRequest(server string, clinicId ClinicId, inviteId InviteId) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.glucoseManagementIndicator", runtime.ParamLocationQuery, *params.CgmGlucoseManagementIndicator); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.CgmTimeCGMUsePercent != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeCGMUsePercent", runtime.ParamLocationQuery, *params.CgmTimeCGMUsePercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	var pathParam1 string
 
-		if params.CgmTimeInVeryLowPercent != nil {
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "inviteId", runtime.ParamLocationPath, inviteId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryLowPercent", runtime.ParamLocationQuery, *params.CgmTimeInVeryLowPercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/invites/clinicians/%s/clinician", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.CgmTimeInAnyLowPercent != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyLowPercent", runtime.ParamLocationQuery, *params.CgmTimeInAnyLowPercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.CgmTimeInLowPercent != nil {
+// NewGetInvitedClinicianRequest generates requests for GetInvitedClinician
+func NewGetInvitedClinicianRequest(server string, clinicId ClinicId, inviteId InviteId) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInLowPercent", runtime.ParamLocationQuery, *params.CgmTimeInLowPercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam0 string
 
-		}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmTimeInTargetPercent != nil {
+	var pathParam1 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInTargetPercent", runtime.ParamLocationQuery, *params.CgmTimeInTargetPercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "inviteId", runtime.ParamLocationPath, inviteId)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmTimeInHighPercent != nil {
+	operationPath := fmt.Sprintf("/v1/clinics/%s/invites/clinicians/%s/clinician", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInHighPercent", runtime.ParamLocationQuery, *params.CgmTimeInHighPercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmTimeInVeryHighPercent != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryHighPercent", runtime.ParamLocationQuery, *params.CgmTimeInVeryHighPercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewAssociateClinicianToUserRequest calls the generic AssociateClinicianToUser builder with application/json body
+func NewAssociateClinicianToUserRequest(server string, clinicId ClinicId, inviteId InviteId, body AssociateClinicianToUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAssociateClinicianToUserRequestWithBody(server, clinicId, inviteId, "application/json", bodyReader)
+}
 
-		}
+// NewAssociateClinicianToUserRequestWithBody generates requests for AssociateClinicianToUser with any type of body
+func NewAssociateClinicianToUserRequestWithBody(server string, clinicId ClinicId, inviteId InviteId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		if params.CgmTimeInExtremeHighPercent != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInExtremeHighPercent", runtime.ParamLocationQuery, *params.CgmTimeInExtremeHighPercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	var pathParam1 string
 
-		if params.CgmTimeInAnyHighPercent != nil {
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "inviteId", runtime.ParamLocationPath, inviteId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyHighPercent", runtime.ParamLocationQuery, *params.CgmTimeInAnyHighPercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/invites/clinicians/%s/clinician", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.CgmTimeCGMUseMinutes != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeCGMUseMinutes", runtime.ParamLocationQuery, *params.CgmTimeCGMUseMinutes); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req.Header.Add("Content-Type", contentType)
 
-		if params.CgmTimeInVeryLowMinutes != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryLowMinutes", runtime.ParamLocationQuery, *params.CgmTimeInVeryLowMinutes); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewListMembershipRestrictionsRequest generates requests for ListMembershipRestrictions
+func NewListMembershipRestrictionsRequest(server string, clinicId ClinicId) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.CgmTimeInAnyLowMinutes != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyLowMinutes", runtime.ParamLocationQuery, *params.CgmTimeInAnyLowMinutes); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/membership_restrictions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.CgmTimeInLowMinutes != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInLowMinutes", runtime.ParamLocationQuery, *params.CgmTimeInLowMinutes); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.CgmTimeInTargetMinutes != nil {
+// NewUpdateMembershipRestrictionsRequest calls the generic UpdateMembershipRestrictions builder with application/json body
+func NewUpdateMembershipRestrictionsRequest(server string, clinicId ClinicId, body UpdateMembershipRestrictionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMembershipRestrictionsRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInTargetMinutes", runtime.ParamLocationQuery, *params.CgmTimeInTargetMinutes); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewUpdateMembershipRestrictionsRequestWithBody generates requests for UpdateMembershipRestrictions with any type of body
+func NewUpdateMembershipRestrictionsRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.CgmTimeInHighMinutes != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInHighMinutes", runtime.ParamLocationQuery, *params.CgmTimeInHighMinutes); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/membership_restrictions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.CgmTimeInVeryHighMinutes != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryHighMinutes", runtime.ParamLocationQuery, *params.CgmTimeInVeryHighMinutes); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req.Header.Add("Content-Type", contentType)
 
-		if params.CgmTimeInExtremeHighMinutes != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInExtremeHighMinutes", runtime.ParamLocationQuery, *params.CgmTimeInExtremeHighMinutes); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewMergeClinicRequest calls the generic MergeClinic builder with application/json body
+func NewMergeClinicRequest(server string, clinicId ClinicId, body MergeClinicJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMergeClinicRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
 
-		}
+// NewMergeClinicRequestWithBody generates requests for MergeClinic with any type of body
+func NewMergeClinicRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		if params.CgmTimeInAnyHighMinutes != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyHighMinutes", runtime.ParamLocationQuery, *params.CgmTimeInAnyHighMinutes); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmTimeCGMUseRecords != nil {
+	operationPath := fmt.Sprintf("/v1/clinics/%s/merge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeCGMUseRecords", runtime.ParamLocationQuery, *params.CgmTimeCGMUseRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmTimeInVeryLowRecords != nil {
+	req.Header.Add("Content-Type", contentType)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryLowRecords", runtime.ParamLocationQuery, *params.CgmTimeInVeryLowRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewGetClinicMergeRequest generates requests for GetClinicMerge
+func NewGetClinicMergeRequest(server string, clinicId ClinicId, planId PlanId) (*http.Request, error) {
+	var err error
 
-		if params.CgmTimeInAnyLowRecords != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyLowRecords", runtime.ParamLocationQuery, *params.CgmTimeInAnyLowRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	var pathParam1 string
 
-		if params.CgmTimeInLowRecords != nil {
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "planId", runtime.ParamLocationPath, planId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInLowRecords", runtime.ParamLocationQuery, *params.CgmTimeInLowRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/merges/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.CgmTimeInTargetRecords != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInTargetRecords", runtime.ParamLocationQuery, *params.CgmTimeInTargetRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.CgmTimeInHighRecords != nil {
+// NewListClinicMergeDuplicatesRequest generates requests for ListClinicMergeDuplicates
+func NewListClinicMergeDuplicatesRequest(server string, clinicId ClinicId, planId PlanId) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInHighRecords", runtime.ParamLocationQuery, *params.CgmTimeInHighRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam0 string
 
-		}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmTimeInVeryHighRecords != nil {
+	var pathParam1 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryHighRecords", runtime.ParamLocationQuery, *params.CgmTimeInVeryHighRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "planId", runtime.ParamLocationPath, planId)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmTimeInAnyHighRecords != nil {
+	operationPath := fmt.Sprintf("/v1/clinics/%s/merges/%s/duplicates", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyHighRecords", runtime.ParamLocationQuery, *params.CgmTimeInAnyHighRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmAverageDailyRecords != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.averageDailyRecords", runtime.ParamLocationQuery, *params.CgmAverageDailyRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewResolveClinicMergeDuplicateRequest calls the generic ResolveClinicMergeDuplicate builder with application/json body
+func NewResolveClinicMergeDuplicateRequest(server string, clinicId ClinicId, planId PlanId, duplicateId DuplicateId, body ResolveClinicMergeDuplicateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewResolveClinicMergeDuplicateRequestWithBody(server, clinicId, planId, duplicateId, "application/json", bodyReader)
+}
 
-		}
+// NewResolveClinicMergeDuplicateRequestWithBody generates requests for ResolveClinicMergeDuplicate with any type of body
+func NewResolveClinicMergeDuplicateRequestWithBody(server string, clinicId ClinicId, planId PlanId, duplicateId DuplicateId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		if params.CgmTotalRecords != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.totalRecords", runtime.ParamLocationQuery, *params.CgmTotalRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	var pathParam1 string
 
-		if params.CgmHoursWithData != nil {
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "planId", runtime.ParamLocationPath, planId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.hoursWithData", runtime.ParamLocationQuery, *params.CgmHoursWithData); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam2 string
 
-		}
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "duplicateId", runtime.ParamLocationPath, duplicateId)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmDaysWithData != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.daysWithData", runtime.ParamLocationQuery, *params.CgmDaysWithData); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/merges/%s/duplicates/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmStandardDeviation != nil {
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.standardDeviation", runtime.ParamLocationQuery, *params.CgmStandardDeviation); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req.Header.Add("Content-Type", contentType)
 
-		}
+	return req, nil
+}
 
-		if params.CgmCoefficientOfVariation != nil {
+// NewPreflightClinicMergeRollbackRequest generates requests for PreflightClinicMergeRollback
+func NewPreflightClinicMergeRollbackRequest(server string, clinicId ClinicId, planId PlanId) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.coefficientOfVariation", runtime.ParamLocationQuery, *params.CgmCoefficientOfVariation); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam0 string
 
-		}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.BgmMax != nil {
+	var pathParam1 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.max", runtime.ParamLocationQuery, *params.BgmMax); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "planId", runtime.ParamLocationPath, planId)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.BgmMin != nil {
+	operationPath := fmt.Sprintf("/v1/clinics/%s/merges/%s/rollback", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.min", runtime.ParamLocationQuery, *params.BgmMin); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.BgmAverageGlucoseMmol != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.averageGlucoseMmol", runtime.ParamLocationQuery, *params.BgmAverageGlucoseMmol); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewRollbackClinicMergeRequest generates requests for RollbackClinicMerge
+func NewRollbackClinicMergeRequest(server string, clinicId ClinicId, planId PlanId) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.BgmTimeInVeryLowPercent != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInVeryLowPercent", runtime.ParamLocationQuery, *params.BgmTimeInVeryLowPercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam1 string
 
-		}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "planId", runtime.ParamLocationPath, planId)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.BgmTimeInAnyLowPercent != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInAnyLowPercent", runtime.ParamLocationQuery, *params.BgmTimeInAnyLowPercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/merges/%s/rollback", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.BgmTimeInLowPercent != nil {
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInLowPercent", runtime.ParamLocationQuery, *params.BgmTimeInLowPercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewStartClinicMergeRequest generates requests for StartClinicMerge
+func NewStartClinicMergeRequest(server string, clinicId ClinicId, planId PlanId) (*http.Request, error) {
+	var err error
 
-		if params.BgmTimeInTargetPercent != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInTargetPercent", runtime.ParamLocationQuery, *params.BgmTimeInTargetPercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	var pathParam1 string
 
-		if params.BgmTimeInHighPercent != nil {
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "planId", runtime.ParamLocationPath, planId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInHighPercent", runtime.ParamLocationQuery, *params.BgmTimeInHighPercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/merges/%s/start", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.BgmTimeInVeryHighPercent != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInVeryHighPercent", runtime.ParamLocationQuery, *params.BgmTimeInVeryHighPercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.BgmTimeInExtremeHighPercent != nil {
+// NewTriggerInitialMigrationRequest calls the generic TriggerInitialMigration builder with application/json body
+func NewTriggerInitialMigrationRequest(server string, clinicId string, body TriggerInitialMigrationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTriggerInitialMigrationRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInExtremeHighPercent", runtime.ParamLocationQuery, *params.BgmTimeInExtremeHighPercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewTriggerInitialMigrationRequestWithBody generates requests for TriggerInitialMigration with any type of body
+func NewTriggerInitialMigrationRequestWithBody(server string, clinicId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.BgmTimeInAnyHighPercent != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInAnyHighPercent", runtime.ParamLocationQuery, *params.BgmTimeInAnyHighPercent); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/migrate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.BgmTimeInVeryLowRecords != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInVeryLowRecords", runtime.ParamLocationQuery, *params.BgmTimeInVeryLowRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req.Header.Add("Content-Type", contentType)
 
-		if params.BgmTimeInAnyLowRecords != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInAnyLowRecords", runtime.ParamLocationQuery, *params.BgmTimeInAnyLowRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewListMigrationsRequest generates requests for ListMigrations
+func NewListMigrationsRequest(server string, clinicId string) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.BgmTimeInLowRecords != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInLowRecords", runtime.ParamLocationQuery, *params.BgmTimeInLowRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/migrations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.BgmTimeInTargetRecords != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInTargetRecords", runtime.ParamLocationQuery, *params.BgmTimeInTargetRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.BgmTimeInHighRecords != nil {
+// NewMigrateLegacyClinicianPatientsRequest calls the generic MigrateLegacyClinicianPatients builder with application/json body
+func NewMigrateLegacyClinicianPatientsRequest(server string, clinicId string, body MigrateLegacyClinicianPatientsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMigrateLegacyClinicianPatientsRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInHighRecords", runtime.ParamLocationQuery, *params.BgmTimeInHighRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewMigrateLegacyClinicianPatientsRequestWithBody generates requests for MigrateLegacyClinicianPatients with any type of body
+func NewMigrateLegacyClinicianPatientsRequestWithBody(server string, clinicId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.BgmTimeInVeryHighRecords != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInVeryHighRecords", runtime.ParamLocationQuery, *params.BgmTimeInVeryHighRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/migrations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.BgmTimeInAnyHighRecords != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInAnyHighRecords", runtime.ParamLocationQuery, *params.BgmTimeInAnyHighRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req.Header.Add("Content-Type", contentType)
 
-		if params.BgmAverageDailyRecords != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.averageDailyRecords", runtime.ParamLocationQuery, *params.BgmAverageDailyRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewGetMigrationRequest generates requests for GetMigration
+func NewGetMigrationRequest(server string, clinicId ClinicIdV1, userId UserId) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.BgmTotalRecords != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.totalRecords", runtime.ParamLocationQuery, *params.BgmTotalRecords); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam1 string
 
-		}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmMaxDelta != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.maxDelta", runtime.ParamLocationQuery, *params.CgmMaxDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/migrations/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmMinDelta != nil {
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.minDelta", runtime.ParamLocationQuery, *params.CgmMinDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewUpdateMigrationRequest calls the generic UpdateMigration builder with application/json body
+func NewUpdateMigrationRequest(server string, clinicId ClinicIdV1, userId UserId, body UpdateMigrationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMigrationRequestWithBody(server, clinicId, userId, "application/json", bodyReader)
+}
 
-		if params.CgmAverageGlucoseMmolDelta != nil {
+// NewUpdateMigrationRequestWithBody generates requests for UpdateMigration with any type of body
+func NewUpdateMigrationRequestWithBody(server string, clinicId ClinicIdV1, userId UserId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.averageGlucoseMmolDelta", runtime.ParamLocationQuery, *params.CgmAverageGlucoseMmolDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam0 string
 
-		}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmGlucoseManagementIndicatorDelta != nil {
+	var pathParam1 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.glucoseManagementIndicatorDelta", runtime.ParamLocationQuery, *params.CgmGlucoseManagementIndicatorDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmTimeCGMUsePercentDelta != nil {
+	operationPath := fmt.Sprintf("/v1/clinics/%s/migrations/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeCGMUsePercentDelta", runtime.ParamLocationQuery, *params.CgmTimeCGMUsePercentDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmTimeInVeryLowPercentDelta != nil {
+	req.Header.Add("Content-Type", contentType)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryLowPercentDelta", runtime.ParamLocationQuery, *params.CgmTimeInVeryLowPercentDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewRetryMigrationRequest generates requests for RetryMigration
+func NewRetryMigrationRequest(server string, clinicId ClinicIdV1, userId UserId) (*http.Request, error) {
+	var err error
 
-		if params.CgmTimeInAnyLowPercentDelta != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyLowPercentDelta", runtime.ParamLocationQuery, *params.CgmTimeInAnyLowPercentDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	var pathParam1 string
 
-		if params.CgmTimeInLowPercentDelta != nil {
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInLowPercentDelta", runtime.ParamLocationQuery, *params.CgmTimeInLowPercentDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/migrations/%s/retry", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.CgmTimeInTargetPercentDelta != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInTargetPercentDelta", runtime.ParamLocationQuery, *params.CgmTimeInTargetPercentDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.CgmTimeInHighPercentDelta != nil {
+// NewGetPatientCountRequest generates requests for GetPatientCount
+func NewGetPatientCountRequest(server string, clinicId ClinicId) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInHighPercentDelta", runtime.ParamLocationQuery, *params.CgmTimeInHighPercentDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam0 string
 
-		}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmTimeInVeryHighPercentDelta != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryHighPercentDelta", runtime.ParamLocationQuery, *params.CgmTimeInVeryHighPercentDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patient_count", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmTimeInExtremeHighPercentDelta != nil {
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInExtremeHighPercentDelta", runtime.ParamLocationQuery, *params.CgmTimeInExtremeHighPercentDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewRefreshPatientCountRequest generates requests for RefreshPatientCount
+func NewRefreshPatientCountRequest(server string, clinicId ClinicId) (*http.Request, error) {
+	var err error
 
-		if params.CgmTimeInAnyHighPercentDelta != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyHighPercentDelta", runtime.ParamLocationQuery, *params.CgmTimeInAnyHighPercentDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmTimeCGMUseMinutesDelta != nil {
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patient_count/refresh", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeCGMUseMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeCGMUseMinutesDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmTimeInVeryLowMinutesDelta != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryLowMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeInVeryLowMinutesDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewGetPatientDuplicatesRequest generates requests for GetPatientDuplicates
+func NewGetPatientDuplicatesRequest(server string, clinicId ClinicId) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.CgmTimeInAnyLowMinutesDelta != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyLowMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeInAnyLowMinutesDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patient_duplicates", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.CgmTimeInLowMinutesDelta != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInLowMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeInLowMinutesDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.CgmTimeInTargetMinutesDelta != nil {
+// NewMergePatientDuplicateRequest calls the generic MergePatientDuplicate builder with application/json body
+func NewMergePatientDuplicateRequest(server string, clinicId ClinicId, body MergePatientDuplicateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMergePatientDuplicateRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInTargetMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeInTargetMinutesDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewMergePatientDuplicateRequestWithBody generates requests for MergePatientDuplicate with any type of body
+func NewMergePatientDuplicateRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.CgmTimeInHighMinutesDelta != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInHighMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeInHighMinutesDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patient_duplicates/merge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.CgmTimeInVeryHighMinutesDelta != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryHighMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeInVeryHighMinutesDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req.Header.Add("Content-Type", contentType)
 
-		if params.CgmTimeInExtremeHighMinutesDelta != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInExtremeHighMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeInExtremeHighMinutesDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewScanPatientDuplicatesRequest generates requests for ScanPatientDuplicates
+func NewScanPatientDuplicatesRequest(server string, clinicId ClinicId) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.CgmTimeInAnyHighMinutesDelta != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyHighMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeInAnyHighMinutesDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patient_duplicates/scan", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.CgmTimeCGMUseRecordsDelta != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeCGMUseRecordsDelta", runtime.ParamLocationQuery, *params.CgmTimeCGMUseRecordsDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.CgmTimeInVeryLowRecordsDelta != nil {
+// NewCreatePatientTagRequest calls the generic CreatePatientTag builder with application/json body
+func NewCreatePatientTagRequest(server string, clinicId ClinicId, body CreatePatientTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePatientTagRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryLowRecordsDelta", runtime.ParamLocationQuery, *params.CgmTimeInVeryLowRecordsDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewCreatePatientTagRequestWithBody generates requests for CreatePatientTag with any type of body
+func NewCreatePatientTagRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.CgmTimeInAnyLowRecordsDelta != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyLowRecordsDelta", runtime.ParamLocationQuery, *params.CgmTimeInAnyLowRecordsDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patient_tags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.CgmTimeInLowRecordsDelta != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInLowRecordsDelta", runtime.ParamLocationQuery, *params.CgmTimeInLowRecordsDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req.Header.Add("Content-Type", contentType)
 
-		if params.CgmTimeInTargetRecordsDelta != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInTargetRecordsDelta", runtime.ParamLocationQuery, *params.CgmTimeInTargetRecordsDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewDeletePatientTagRequest generates requests for DeletePatientTag
+func NewDeletePatientTagRequest(server string, clinicId ClinicId, patientTagId PatientTagId) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.CgmTimeInHighRecordsDelta != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInHighRecordsDelta", runtime.ParamLocationQuery, *params.CgmTimeInHighRecordsDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam1 string
 
-		}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientTagId", runtime.ParamLocationPath, patientTagId)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CgmTimeInVeryHighRecordsDelta != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryHighRecordsDelta", runtime.ParamLocationQuery, *params.CgmTimeInVeryHighRecordsDelta); err != nil {
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patient_tags/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePatientTagRequest calls the generic UpdatePatientTag builder with application/json body
+func NewUpdatePatientTagRequest(server string, clinicId ClinicId, patientTagId PatientTagId, body UpdatePatientTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePatientTagRequestWithBody(server, clinicId, patientTagId, "application/json", bodyReader)
+}
+
+// NewUpdatePatientTagRequestWithBody generates requests for UpdatePatientTag with any type of body
+func NewUpdatePatientTagRequestWithBody(server string, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientTagId", runtime.ParamLocationPath, patientTagId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patient_tags/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewConvertPatientTagToSiteRequest generates requests for ConvertPatientTagToSite
+func NewConvertPatientTagToSiteRequest(server string, clinicId ClinicId, patientTagId PatientTagId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientTagId", runtime.ParamLocationPath, patientTagId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patient_tags/%s/site", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPatientsRequest generates requests for ListPatients
+func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatientsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patients", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4805,9 +5063,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.CgmTimeInAnyHighRecordsDelta != nil {
+		if params.Offset != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyHighRecordsDelta", runtime.ParamLocationQuery, *params.CgmTimeInAnyHighRecordsDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4821,9 +5079,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.CgmAverageDailyRecordsDelta != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.averageDailyRecordsDelta", runtime.ParamLocationQuery, *params.CgmAverageDailyRecordsDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4837,9 +5095,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.CgmTotalRecordsDelta != nil {
+		if params.Sort != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.totalRecordsDelta", runtime.ParamLocationQuery, *params.CgmTotalRecordsDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4853,9 +5111,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.CgmHoursWithDataDelta != nil {
+		if params.SortType != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.hoursWithDataDelta", runtime.ParamLocationQuery, *params.CgmHoursWithDataDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortType", runtime.ParamLocationQuery, *params.SortType); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4869,9 +5127,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.CgmDaysWithDataDelta != nil {
+		if params.Period != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.daysWithDataDelta", runtime.ParamLocationQuery, *params.CgmDaysWithDataDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4885,9 +5143,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.CgmStandardDeviationDelta != nil {
+		if params.LastReviewed != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.standardDeviationDelta", runtime.ParamLocationQuery, *params.CgmStandardDeviationDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastReviewed", runtime.ParamLocationQuery, *params.LastReviewed); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4901,9 +5159,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.CgmCoefficientOfVariationDelta != nil {
+		if params.CgmMax != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.coefficientOfVariationDelta", runtime.ParamLocationQuery, *params.CgmCoefficientOfVariationDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.max", runtime.ParamLocationQuery, *params.CgmMax); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4917,9 +5175,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.CgmLastDataFrom != nil {
+		if params.CgmMin != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.lastDataFrom", runtime.ParamLocationQuery, *params.CgmLastDataFrom); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.min", runtime.ParamLocationQuery, *params.CgmMin); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4933,9 +5191,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.CgmLastDataTo != nil {
+		if params.CgmAverageGlucoseMmol != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.lastDataTo", runtime.ParamLocationQuery, *params.CgmLastDataTo); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.averageGlucoseMmol", runtime.ParamLocationQuery, *params.CgmAverageGlucoseMmol); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4949,9 +5207,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.BgmMaxDelta != nil {
+		if params.CgmGlucoseManagementIndicator != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.maxDelta", runtime.ParamLocationQuery, *params.BgmMaxDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.glucoseManagementIndicator", runtime.ParamLocationQuery, *params.CgmGlucoseManagementIndicator); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4965,9 +5223,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.BgmMinDelta != nil {
+		if params.CgmTimeCGMUsePercent != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.minDelta", runtime.ParamLocationQuery, *params.BgmMinDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeCGMUsePercent", runtime.ParamLocationQuery, *params.CgmTimeCGMUsePercent); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4981,9 +5239,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.BgmAverageGlucoseMmolDelta != nil {
+		if params.CgmTimeInVeryLowPercent != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.averageGlucoseMmolDelta", runtime.ParamLocationQuery, *params.BgmAverageGlucoseMmolDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryLowPercent", runtime.ParamLocationQuery, *params.CgmTimeInVeryLowPercent); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4997,9 +5255,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.BgmTimeInVeryLowPercentDelta != nil {
+		if params.CgmTimeInAnyLowPercent != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInVeryLowPercentDelta", runtime.ParamLocationQuery, *params.BgmTimeInVeryLowPercentDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyLowPercent", runtime.ParamLocationQuery, *params.CgmTimeInAnyLowPercent); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5013,9 +5271,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.BgmTimeInAnyLowPercentDelta != nil {
+		if params.CgmTimeInLowPercent != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInAnyLowPercentDelta", runtime.ParamLocationQuery, *params.BgmTimeInAnyLowPercentDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInLowPercent", runtime.ParamLocationQuery, *params.CgmTimeInLowPercent); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5029,9 +5287,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.BgmTimeInLowPercentDelta != nil {
+		if params.CgmTimeInTargetPercent != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInLowPercentDelta", runtime.ParamLocationQuery, *params.BgmTimeInLowPercentDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInTargetPercent", runtime.ParamLocationQuery, *params.CgmTimeInTargetPercent); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5045,9 +5303,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.BgmTimeInTargetPercentDelta != nil {
+		if params.CgmTimeInHighPercent != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInTargetPercentDelta", runtime.ParamLocationQuery, *params.BgmTimeInTargetPercentDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInHighPercent", runtime.ParamLocationQuery, *params.CgmTimeInHighPercent); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5061,9 +5319,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.BgmTimeInHighPercentDelta != nil {
+		if params.CgmTimeInVeryHighPercent != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInHighPercentDelta", runtime.ParamLocationQuery, *params.BgmTimeInHighPercentDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryHighPercent", runtime.ParamLocationQuery, *params.CgmTimeInVeryHighPercent); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5077,9 +5335,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.BgmTimeInVeryHighPercentDelta != nil {
+		if params.CgmTimeInExtremeHighPercent != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInVeryHighPercentDelta", runtime.ParamLocationQuery, *params.BgmTimeInVeryHighPercentDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInExtremeHighPercent", runtime.ParamLocationQuery, *params.CgmTimeInExtremeHighPercent); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5093,9 +5351,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.BgmTimeInExtremeHighPercentDelta != nil {
+		if params.CgmTimeInAnyHighPercent != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInExtremeHighPercentDelta", runtime.ParamLocationQuery, *params.BgmTimeInExtremeHighPercentDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyHighPercent", runtime.ParamLocationQuery, *params.CgmTimeInAnyHighPercent); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5109,9 +5367,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.BgmTimeInAnyHighPercentDelta != nil {
+		if params.CgmTimeCGMUseMinutes != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInAnyHighPercentDelta", runtime.ParamLocationQuery, *params.BgmTimeInAnyHighPercentDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeCGMUseMinutes", runtime.ParamLocationQuery, *params.CgmTimeCGMUseMinutes); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5125,9 +5383,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.BgmTimeInVeryLowRecordsDelta != nil {
+		if params.CgmTimeInVeryLowMinutes != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInVeryLowRecordsDelta", runtime.ParamLocationQuery, *params.BgmTimeInVeryLowRecordsDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryLowMinutes", runtime.ParamLocationQuery, *params.CgmTimeInVeryLowMinutes); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5141,9 +5399,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.BgmTimeInAnyLowRecordsDelta != nil {
+		if params.CgmTimeInAnyLowMinutes != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInAnyLowRecordsDelta", runtime.ParamLocationQuery, *params.BgmTimeInAnyLowRecordsDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyLowMinutes", runtime.ParamLocationQuery, *params.CgmTimeInAnyLowMinutes); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5157,9 +5415,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.BgmTimeInLowRecordsDelta != nil {
+		if params.CgmTimeInLowMinutes != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInLowRecordsDelta", runtime.ParamLocationQuery, *params.BgmTimeInLowRecordsDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInLowMinutes", runtime.ParamLocationQuery, *params.CgmTimeInLowMinutes); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5173,9 +5431,9 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.BgmTimeInTargetRecordsDelta != nil {
+		if params.CgmTimeInTargetMinutes != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInTargetRecordsDelta", runtime.ParamLocationQuery, *params.BgmTimeInTargetRecordsDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInTargetMinutes", runtime.ParamLocationQuery, *params.CgmTimeInTargetMinutes); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5189,9 +5447,249 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 
 		}
 
-		if params.BgmTimeInHighRecordsDelta != nil {
+		if params.CgmTimeInHighMinutes != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInHighRecordsDelta", runtime.ParamLocationQuery, *params.BgmTimeInHighRecordsDelta); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInHighMinutes", runtime.ParamLocationQuery, *params.CgmTimeInHighMinutes); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInVeryHighMinutes != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryHighMinutes", runtime.ParamLocationQuery, *params.CgmTimeInVeryHighMinutes); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInExtremeHighMinutes != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInExtremeHighMinutes", runtime.ParamLocationQuery, *params.CgmTimeInExtremeHighMinutes); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInAnyHighMinutes != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyHighMinutes", runtime.ParamLocationQuery, *params.CgmTimeInAnyHighMinutes); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeCGMUseRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeCGMUseRecords", runtime.ParamLocationQuery, *params.CgmTimeCGMUseRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInVeryLowRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryLowRecords", runtime.ParamLocationQuery, *params.CgmTimeInVeryLowRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInAnyLowRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyLowRecords", runtime.ParamLocationQuery, *params.CgmTimeInAnyLowRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInLowRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInLowRecords", runtime.ParamLocationQuery, *params.CgmTimeInLowRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInTargetRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInTargetRecords", runtime.ParamLocationQuery, *params.CgmTimeInTargetRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInHighRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInHighRecords", runtime.ParamLocationQuery, *params.CgmTimeInHighRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInVeryHighRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryHighRecords", runtime.ParamLocationQuery, *params.CgmTimeInVeryHighRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInAnyHighRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyHighRecords", runtime.ParamLocationQuery, *params.CgmTimeInAnyHighRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmAverageDailyRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.averageDailyRecords", runtime.ParamLocationQuery, *params.CgmAverageDailyRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTotalRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.totalRecords", runtime.ParamLocationQuery, *params.CgmTotalRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmHoursWithData != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.hoursWithData", runtime.ParamLocationQuery, *params.CgmHoursWithData); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmDaysWithData != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.daysWithData", runtime.ParamLocationQuery, *params.CgmDaysWithData); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5203,176 +5701,2583 @@ func NewListPatientsRequest(server string, clinicId ClinicId, params *ListPatien
 				}
 			}
 
-		}
+		}
+
+		if params.CgmStandardDeviation != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.standardDeviation", runtime.ParamLocationQuery, *params.CgmStandardDeviation); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmCoefficientOfVariation != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.coefficientOfVariation", runtime.ParamLocationQuery, *params.CgmCoefficientOfVariation); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmMax != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.max", runtime.ParamLocationQuery, *params.BgmMax); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmMin != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.min", runtime.ParamLocationQuery, *params.BgmMin); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmAverageGlucoseMmol != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.averageGlucoseMmol", runtime.ParamLocationQuery, *params.BgmAverageGlucoseMmol); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInVeryLowPercent != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInVeryLowPercent", runtime.ParamLocationQuery, *params.BgmTimeInVeryLowPercent); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInAnyLowPercent != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInAnyLowPercent", runtime.ParamLocationQuery, *params.BgmTimeInAnyLowPercent); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInLowPercent != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInLowPercent", runtime.ParamLocationQuery, *params.BgmTimeInLowPercent); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInTargetPercent != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInTargetPercent", runtime.ParamLocationQuery, *params.BgmTimeInTargetPercent); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInHighPercent != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInHighPercent", runtime.ParamLocationQuery, *params.BgmTimeInHighPercent); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInVeryHighPercent != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInVeryHighPercent", runtime.ParamLocationQuery, *params.BgmTimeInVeryHighPercent); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInExtremeHighPercent != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInExtremeHighPercent", runtime.ParamLocationQuery, *params.BgmTimeInExtremeHighPercent); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInAnyHighPercent != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInAnyHighPercent", runtime.ParamLocationQuery, *params.BgmTimeInAnyHighPercent); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInVeryLowRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInVeryLowRecords", runtime.ParamLocationQuery, *params.BgmTimeInVeryLowRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInAnyLowRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInAnyLowRecords", runtime.ParamLocationQuery, *params.BgmTimeInAnyLowRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInLowRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInLowRecords", runtime.ParamLocationQuery, *params.BgmTimeInLowRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInTargetRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInTargetRecords", runtime.ParamLocationQuery, *params.BgmTimeInTargetRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInHighRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInHighRecords", runtime.ParamLocationQuery, *params.BgmTimeInHighRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInVeryHighRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInVeryHighRecords", runtime.ParamLocationQuery, *params.BgmTimeInVeryHighRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInAnyHighRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInAnyHighRecords", runtime.ParamLocationQuery, *params.BgmTimeInAnyHighRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmAverageDailyRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.averageDailyRecords", runtime.ParamLocationQuery, *params.BgmAverageDailyRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTotalRecords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.totalRecords", runtime.ParamLocationQuery, *params.BgmTotalRecords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmMaxDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.maxDelta", runtime.ParamLocationQuery, *params.CgmMaxDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmMinDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.minDelta", runtime.ParamLocationQuery, *params.CgmMinDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmAverageGlucoseMmolDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.averageGlucoseMmolDelta", runtime.ParamLocationQuery, *params.CgmAverageGlucoseMmolDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmGlucoseManagementIndicatorDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.glucoseManagementIndicatorDelta", runtime.ParamLocationQuery, *params.CgmGlucoseManagementIndicatorDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeCGMUsePercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeCGMUsePercentDelta", runtime.ParamLocationQuery, *params.CgmTimeCGMUsePercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInVeryLowPercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryLowPercentDelta", runtime.ParamLocationQuery, *params.CgmTimeInVeryLowPercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInAnyLowPercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyLowPercentDelta", runtime.ParamLocationQuery, *params.CgmTimeInAnyLowPercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInLowPercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInLowPercentDelta", runtime.ParamLocationQuery, *params.CgmTimeInLowPercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInTargetPercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInTargetPercentDelta", runtime.ParamLocationQuery, *params.CgmTimeInTargetPercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInHighPercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInHighPercentDelta", runtime.ParamLocationQuery, *params.CgmTimeInHighPercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInVeryHighPercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryHighPercentDelta", runtime.ParamLocationQuery, *params.CgmTimeInVeryHighPercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInExtremeHighPercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInExtremeHighPercentDelta", runtime.ParamLocationQuery, *params.CgmTimeInExtremeHighPercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInAnyHighPercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyHighPercentDelta", runtime.ParamLocationQuery, *params.CgmTimeInAnyHighPercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeCGMUseMinutesDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeCGMUseMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeCGMUseMinutesDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInVeryLowMinutesDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryLowMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeInVeryLowMinutesDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInAnyLowMinutesDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyLowMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeInAnyLowMinutesDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInLowMinutesDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInLowMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeInLowMinutesDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInTargetMinutesDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInTargetMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeInTargetMinutesDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInHighMinutesDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInHighMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeInHighMinutesDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInVeryHighMinutesDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryHighMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeInVeryHighMinutesDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInExtremeHighMinutesDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInExtremeHighMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeInExtremeHighMinutesDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInAnyHighMinutesDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyHighMinutesDelta", runtime.ParamLocationQuery, *params.CgmTimeInAnyHighMinutesDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeCGMUseRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeCGMUseRecordsDelta", runtime.ParamLocationQuery, *params.CgmTimeCGMUseRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInVeryLowRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryLowRecordsDelta", runtime.ParamLocationQuery, *params.CgmTimeInVeryLowRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInAnyLowRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyLowRecordsDelta", runtime.ParamLocationQuery, *params.CgmTimeInAnyLowRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInLowRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInLowRecordsDelta", runtime.ParamLocationQuery, *params.CgmTimeInLowRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInTargetRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInTargetRecordsDelta", runtime.ParamLocationQuery, *params.CgmTimeInTargetRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInHighRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInHighRecordsDelta", runtime.ParamLocationQuery, *params.CgmTimeInHighRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInVeryHighRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInVeryHighRecordsDelta", runtime.ParamLocationQuery, *params.CgmTimeInVeryHighRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTimeInAnyHighRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.timeInAnyHighRecordsDelta", runtime.ParamLocationQuery, *params.CgmTimeInAnyHighRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmAverageDailyRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.averageDailyRecordsDelta", runtime.ParamLocationQuery, *params.CgmAverageDailyRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmTotalRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.totalRecordsDelta", runtime.ParamLocationQuery, *params.CgmTotalRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmHoursWithDataDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.hoursWithDataDelta", runtime.ParamLocationQuery, *params.CgmHoursWithDataDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmDaysWithDataDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.daysWithDataDelta", runtime.ParamLocationQuery, *params.CgmDaysWithDataDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmStandardDeviationDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.standardDeviationDelta", runtime.ParamLocationQuery, *params.CgmStandardDeviationDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmCoefficientOfVariationDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.coefficientOfVariationDelta", runtime.ParamLocationQuery, *params.CgmCoefficientOfVariationDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmLastDataFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.lastDataFrom", runtime.ParamLocationQuery, *params.CgmLastDataFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CgmLastDataTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cgm.lastDataTo", runtime.ParamLocationQuery, *params.CgmLastDataTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmMaxDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.maxDelta", runtime.ParamLocationQuery, *params.BgmMaxDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmMinDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.minDelta", runtime.ParamLocationQuery, *params.BgmMinDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmAverageGlucoseMmolDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.averageGlucoseMmolDelta", runtime.ParamLocationQuery, *params.BgmAverageGlucoseMmolDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInVeryLowPercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInVeryLowPercentDelta", runtime.ParamLocationQuery, *params.BgmTimeInVeryLowPercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInAnyLowPercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInAnyLowPercentDelta", runtime.ParamLocationQuery, *params.BgmTimeInAnyLowPercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInLowPercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInLowPercentDelta", runtime.ParamLocationQuery, *params.BgmTimeInLowPercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInTargetPercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInTargetPercentDelta", runtime.ParamLocationQuery, *params.BgmTimeInTargetPercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInHighPercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInHighPercentDelta", runtime.ParamLocationQuery, *params.BgmTimeInHighPercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInVeryHighPercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInVeryHighPercentDelta", runtime.ParamLocationQuery, *params.BgmTimeInVeryHighPercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInExtremeHighPercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInExtremeHighPercentDelta", runtime.ParamLocationQuery, *params.BgmTimeInExtremeHighPercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInAnyHighPercentDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInAnyHighPercentDelta", runtime.ParamLocationQuery, *params.BgmTimeInAnyHighPercentDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInVeryLowRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInVeryLowRecordsDelta", runtime.ParamLocationQuery, *params.BgmTimeInVeryLowRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInAnyLowRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInAnyLowRecordsDelta", runtime.ParamLocationQuery, *params.BgmTimeInAnyLowRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInLowRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInLowRecordsDelta", runtime.ParamLocationQuery, *params.BgmTimeInLowRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInTargetRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInTargetRecordsDelta", runtime.ParamLocationQuery, *params.BgmTimeInTargetRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInHighRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInHighRecordsDelta", runtime.ParamLocationQuery, *params.BgmTimeInHighRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInVeryHighRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInVeryHighRecordsDelta", runtime.ParamLocationQuery, *params.BgmTimeInVeryHighRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTimeInAnyHighRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInAnyHighRecordsDelta", runtime.ParamLocationQuery, *params.BgmTimeInAnyHighRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmAverageDailyRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.averageDailyRecordsDelta", runtime.ParamLocationQuery, *params.BgmAverageDailyRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmTotalRecordsDelta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.totalRecordsDelta", runtime.ParamLocationQuery, *params.BgmTotalRecordsDelta); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmLastDataFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.lastDataFrom", runtime.ParamLocationQuery, *params.BgmLastDataFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BgmLastDataTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.lastDataTo", runtime.ParamLocationQuery, *params.BgmLastDataTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tags != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sites != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "sites", runtime.ParamLocationQuery, *params.Sites); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OmitNonStandardRanges != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "omitNonStandardRanges", runtime.ParamLocationQuery, *params.OmitNonStandardRanges); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePatientAccountRequest calls the generic CreatePatientAccount builder with application/json body
+func NewCreatePatientAccountRequest(server string, clinicId ClinicId, body CreatePatientAccountJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePatientAccountRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
+
+// NewCreatePatientAccountRequestWithBody generates requests for CreatePatientAccount with any type of body
+func NewCreatePatientAccountRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patients", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAssignPatientTagToClinicPatientsRequest calls the generic AssignPatientTagToClinicPatients builder with application/json body
+func NewAssignPatientTagToClinicPatientsRequest(server string, clinicId ClinicId, patientTagId PatientTagId, body AssignPatientTagToClinicPatientsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAssignPatientTagToClinicPatientsRequestWithBody(server, clinicId, patientTagId, "application/json", bodyReader)
+}
+
+// NewAssignPatientTagToClinicPatientsRequestWithBody generates requests for AssignPatientTagToClinicPatients with any type of body
+func NewAssignPatientTagToClinicPatientsRequestWithBody(server string, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientTagId", runtime.ParamLocationPath, patientTagId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/assign_tag/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePatientTagFromClinicPatientsRequest calls the generic DeletePatientTagFromClinicPatients builder with application/json body
+func NewDeletePatientTagFromClinicPatientsRequest(server string, clinicId ClinicId, patientTagId PatientTagId, body DeletePatientTagFromClinicPatientsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeletePatientTagFromClinicPatientsRequestWithBody(server, clinicId, patientTagId, "application/json", bodyReader)
+}
+
+// NewDeletePatientTagFromClinicPatientsRequestWithBody generates requests for DeletePatientTagFromClinicPatients with any type of body
+func NewDeletePatientTagFromClinicPatientsRequestWithBody(server string, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientTagId", runtime.ParamLocationPath, patientTagId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/delete_tag/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePatientRequest generates requests for DeletePatient
+func NewDeletePatientRequest(server string, clinicId ClinicId, patientId PatientId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPatientRequest generates requests for GetPatient
+func NewGetPatientRequest(server string, clinicId ClinicId, patientId PatientId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePatientFromUserRequest calls the generic CreatePatientFromUser builder with application/json body
+func NewCreatePatientFromUserRequest(server string, clinicId ClinicId, patientId PatientId, body CreatePatientFromUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePatientFromUserRequestWithBody(server, clinicId, patientId, "application/json", bodyReader)
+}
+
+// NewCreatePatientFromUserRequestWithBody generates requests for CreatePatientFromUser with any type of body
+func NewCreatePatientFromUserRequestWithBody(server string, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdatePatientRequest calls the generic UpdatePatient builder with application/json body
+func NewUpdatePatientRequest(server string, clinicId ClinicId, patientId PatientId, body UpdatePatientJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePatientRequestWithBody(server, clinicId, patientId, "application/json", bodyReader)
+}
+
+// NewUpdatePatientRequestWithBody generates requests for UpdatePatient with any type of body
+func NewUpdatePatientRequestWithBody(server string, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewConnectProviderRequest generates requests for ConnectProvider
+func NewConnectProviderRequest(server string, clinicId ClinicId, patientId PatientId, providerId ProviderId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "providerId", runtime.ParamLocationPath, providerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s/connect/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePatientPermissionsRequest calls the generic UpdatePatientPermissions builder with application/json body
+func NewUpdatePatientPermissionsRequest(server string, clinicId ClinicId, patientId PatientId, body UpdatePatientPermissionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePatientPermissionsRequestWithBody(server, clinicId, patientId, "application/json", bodyReader)
+}
+
+// NewUpdatePatientPermissionsRequestWithBody generates requests for UpdatePatientPermissions with any type of body
+func NewUpdatePatientPermissionsRequestWithBody(server string, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s/permissions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePatientPermissionRequest generates requests for DeletePatientPermission
+func NewDeletePatientPermissionRequest(server string, clinicId ClinicId, patientId PatientId, permission string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "permission", runtime.ParamLocationPath, permission)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s/permissions/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeletePatientReviewsRequest generates requests for DeletePatientReviews
+func NewDeletePatientReviewsRequest(server string, clinicId ClinicId, patientId PatientId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s/reviews", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePatientReviewsRequest generates requests for UpdatePatientReviews
+func NewUpdatePatientReviewsRequest(server string, clinicId ClinicId, patientId PatientId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s/reviews", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSendUploadReminderRequest generates requests for SendUploadReminder
+func NewSendUploadReminderRequest(server string, clinicId ClinicId, patientId PatientId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s/upload_reminder", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGenerateConsolidationReportRequest calls the generic GenerateConsolidationReport builder with application/json body
+func NewGenerateConsolidationReportRequest(server string, clinicId ClinicId, body GenerateConsolidationReportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGenerateConsolidationReportRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
+
+// NewGenerateConsolidationReportRequestWithBody generates requests for GenerateConsolidationReport with any type of body
+func NewGenerateConsolidationReportRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/reports/consolidation", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGenerateMergeReportRequest calls the generic GenerateMergeReport builder with application/json body
+func NewGenerateMergeReportRequest(server string, clinicId ClinicId, body GenerateMergeReportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGenerateMergeReportRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
+
+// NewGenerateMergeReportRequestWithBody generates requests for GenerateMergeReport with any type of body
+func NewGenerateMergeReportRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/reports/merge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGenerateSplitReportRequest calls the generic GenerateSplitReport builder with application/json body
+func NewGenerateSplitReportRequest(server string, clinicId ClinicId, body GenerateSplitReportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGenerateSplitReportRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
+
+// NewGenerateSplitReportRequestWithBody generates requests for GenerateSplitReport with any type of body
+func NewGenerateSplitReportRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/reports/split", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAddServiceAccountRequest calls the generic AddServiceAccount builder with application/json body
+func NewAddServiceAccountRequest(server string, clinicId ClinicId, body AddServiceAccountJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddServiceAccountRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
+
+// NewAddServiceAccountRequestWithBody generates requests for AddServiceAccount with any type of body
+func NewAddServiceAccountRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/service_accounts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetEHRSettingsRequest generates requests for GetEHRSettings
+func NewGetEHRSettingsRequest(server string, clinicId ClinicId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/settings/ehr", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateEHRSettingsRequest calls the generic UpdateEHRSettings builder with application/json body
+func NewUpdateEHRSettingsRequest(server string, clinicId ClinicId, body UpdateEHRSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateEHRSettingsRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
+
+// NewUpdateEHRSettingsRequestWithBody generates requests for UpdateEHRSettings with any type of body
+func NewUpdateEHRSettingsRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/settings/ehr", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMRNSettingsRequest generates requests for GetMRNSettings
+func NewGetMRNSettingsRequest(server string, clinicId ClinicId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/settings/mrn", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateMRNSettingsRequest calls the generic UpdateMRNSettings builder with application/json body
+func NewUpdateMRNSettingsRequest(server string, clinicId ClinicId, body UpdateMRNSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMRNSettingsRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
+
+// NewUpdateMRNSettingsRequestWithBody generates requests for UpdateMRNSettings with any type of body
+func NewUpdateMRNSettingsRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/settings/mrn", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPatientCountSettingsRequest generates requests for GetPatientCountSettings
+func NewGetPatientCountSettingsRequest(server string, clinicId ClinicId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/settings/patient_count", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePatientCountSettingsRequest calls the generic UpdatePatientCountSettings builder with application/json body
+func NewUpdatePatientCountSettingsRequest(server string, clinicId ClinicId, body UpdatePatientCountSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePatientCountSettingsRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
+
+// NewUpdatePatientCountSettingsRequestWithBody generates requests for UpdatePatientCountSettings with any type of body
+func NewUpdatePatientCountSettingsRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/clinics/%s/settings/patient_count", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
 
-		if params.BgmTimeInVeryHighRecordsDelta != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInVeryHighRecordsDelta", runtime.ParamLocationQuery, *params.BgmTimeInVeryHighRecordsDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewCreateSiteRequest calls the generic CreateSite builder with application/json body
+func NewCreateSiteRequest(server string, clinicId ClinicId, body CreateSiteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSiteRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
 
-		}
+// NewCreateSiteRequestWithBody generates requests for CreateSite with any type of body
+func NewCreateSiteRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		if params.BgmTimeInAnyHighRecordsDelta != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.timeInAnyHighRecordsDelta", runtime.ParamLocationQuery, *params.BgmTimeInAnyHighRecordsDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.BgmAverageDailyRecordsDelta != nil {
+	operationPath := fmt.Sprintf("/v1/clinics/%s/sites", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.averageDailyRecordsDelta", runtime.ParamLocationQuery, *params.BgmAverageDailyRecordsDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.BgmTotalRecordsDelta != nil {
+	req.Header.Add("Content-Type", contentType)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.totalRecordsDelta", runtime.ParamLocationQuery, *params.BgmTotalRecordsDelta); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewDeleteSiteRequest generates requests for DeleteSite
+func NewDeleteSiteRequest(server string, clinicId ClinicId, siteId SiteId) (*http.Request, error) {
+	var err error
 
-		if params.BgmLastDataFrom != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.lastDataFrom", runtime.ParamLocationQuery, *params.BgmLastDataFrom); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	var pathParam1 string
 
-		if params.BgmLastDataTo != nil {
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bgm.lastDataTo", runtime.ParamLocationQuery, *params.BgmLastDataTo); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/v1/clinics/%s/sites/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.Tags != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.Sites != nil {
+// NewUpdateSiteRequest calls the generic UpdateSite builder with application/json body
+func NewUpdateSiteRequest(server string, clinicId ClinicId, siteId SiteId, body UpdateSiteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSiteRequestWithBody(server, clinicId, siteId, "application/json", bodyReader)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "sites", runtime.ParamLocationQuery, *params.Sites); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewUpdateSiteRequestWithBody generates requests for UpdateSite with any type of body
+func NewUpdateSiteRequestWithBody(server string, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.OmitNonStandardRanges != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "omitNonStandardRanges", runtime.ParamLocationQuery, *params.OmitNonStandardRanges); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam1 string
 
-		}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+	if err != nil {
+		return nil, err
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/sites/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewCreatePatientAccountRequest calls the generic CreatePatientAccount builder with application/json body
-func NewCreatePatientAccountRequest(server string, clinicId ClinicId, body CreatePatientAccountJSONRequestBody) (*http.Request, error) {
+// NewMergeSiteRequest calls the generic MergeSite builder with application/json body
+func NewMergeSiteRequest(server string, clinicId ClinicId, siteId SiteId, body MergeSiteJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreatePatientAccountRequestWithBody(server, clinicId, "application/json", bodyReader)
+	return NewMergeSiteRequestWithBody(server, clinicId, siteId, "application/json", bodyReader)
 }
 
-// NewCreatePatientAccountRequestWithBody generates requests for CreatePatientAccount with any type of body
-func NewCreatePatientAccountRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+// NewMergeSiteRequestWithBody generates requests for MergeSite with any type of body
+func NewMergeSiteRequestWithBody(server string, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5382,12 +8287,19 @@ func NewCreatePatientAccountRequestWithBody(server string, clinicId ClinicId, co
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patients", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/sites/%s/merge", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5407,19 +8319,19 @@ func NewCreatePatientAccountRequestWithBody(server string, clinicId ClinicId, co
 	return req, nil
 }
 
-// NewAssignPatientTagToClinicPatientsRequest calls the generic AssignPatientTagToClinicPatients builder with application/json body
-func NewAssignPatientTagToClinicPatientsRequest(server string, clinicId ClinicId, patientTagId PatientTagId, body AssignPatientTagToClinicPatientsJSONRequestBody) (*http.Request, error) {
+// NewUpdateSiteParentRequest calls the generic UpdateSiteParent builder with application/json body
+func NewUpdateSiteParentRequest(server string, clinicId ClinicId, siteId SiteId, body UpdateSiteParentJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewAssignPatientTagToClinicPatientsRequestWithBody(server, clinicId, patientTagId, "application/json", bodyReader)
+	return NewUpdateSiteParentRequestWithBody(server, clinicId, siteId, "application/json", bodyReader)
 }
 
-// NewAssignPatientTagToClinicPatientsRequestWithBody generates requests for AssignPatientTagToClinicPatients with any type of body
-func NewAssignPatientTagToClinicPatientsRequestWithBody(server string, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateSiteParentRequestWithBody generates requests for UpdateSiteParent with any type of body
+func NewUpdateSiteParentRequestWithBody(server string, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5431,7 +8343,7 @@ func NewAssignPatientTagToClinicPatientsRequestWithBody(server string, clinicId
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientTagId", runtime.ParamLocationPath, patientTagId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
 	if err != nil {
 		return nil, err
 	}
@@ -5441,7 +8353,7 @@ func NewAssignPatientTagToClinicPatientsRequestWithBody(server string, clinicId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/assign_tag/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/sites/%s/parent", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5451,7 +8363,7 @@ func NewAssignPatientTagToClinicPatientsRequestWithBody(server string, clinicId
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -5461,19 +8373,19 @@ func NewAssignPatientTagToClinicPatientsRequestWithBody(server string, clinicId
 	return req, nil
 }
 
-// NewDeletePatientTagFromClinicPatientsRequest calls the generic DeletePatientTagFromClinicPatients builder with application/json body
-func NewDeletePatientTagFromClinicPatientsRequest(server string, clinicId ClinicId, patientTagId PatientTagId, body DeletePatientTagFromClinicPatientsJSONRequestBody) (*http.Request, error) {
+// NewUpdateSiteSettingsRequest calls the generic UpdateSiteSettings builder with application/json body
+func NewUpdateSiteSettingsRequest(server string, clinicId ClinicId, siteId SiteId, body UpdateSiteSettingsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDeletePatientTagFromClinicPatientsRequestWithBody(server, clinicId, patientTagId, "application/json", bodyReader)
+	return NewUpdateSiteSettingsRequestWithBody(server, clinicId, siteId, "application/json", bodyReader)
 }
 
-// NewDeletePatientTagFromClinicPatientsRequestWithBody generates requests for DeletePatientTagFromClinicPatients with any type of body
-func NewDeletePatientTagFromClinicPatientsRequestWithBody(server string, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateSiteSettingsRequestWithBody generates requests for UpdateSiteSettings with any type of body
+func NewUpdateSiteSettingsRequestWithBody(server string, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5485,7 +8397,7 @@ func NewDeletePatientTagFromClinicPatientsRequestWithBody(server string, clinicI
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientTagId", runtime.ParamLocationPath, patientTagId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
 	if err != nil {
 		return nil, err
 	}
@@ -5495,7 +8407,7 @@ func NewDeletePatientTagFromClinicPatientsRequestWithBody(server string, clinicI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/delete_tag/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/sites/%s/settings", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5505,7 +8417,7 @@ func NewDeletePatientTagFromClinicPatientsRequestWithBody(server string, clinicI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -5515,20 +8427,24 @@ func NewDeletePatientTagFromClinicPatientsRequestWithBody(server string, clinicI
 	return req, nil
 }
 
-// NewDeletePatientRequest generates requests for DeletePatient
-func NewDeletePatientRequest(server string, clinicId ClinicId, patientId PatientId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+// NewSplitClinicRequest calls the generic SplitClinic builder with application/json body
+func NewSplitClinicRequest(server string, clinicId ClinicId, body SplitClinicJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSplitClinicRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewSplitClinicRequestWithBody generates requests for SplitClinic with any type of body
+func NewSplitClinicRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
 	if err != nil {
 		return nil, err
 	}
@@ -5538,7 +8454,7 @@ func NewDeletePatientRequest(server string, clinicId ClinicId, patientId Patient
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/split", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5548,28 +8464,34 @@ func NewDeletePatientRequest(server string, clinicId ClinicId, patientId Patient
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetPatientRequest generates requests for GetPatient
-func NewGetPatientRequest(server string, clinicId ClinicId, patientId PatientId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+// NewUpdateSuppressedNotificationsRequest calls the generic UpdateSuppressedNotifications builder with application/json body
+func NewUpdateSuppressedNotificationsRequest(server string, clinicId ClinicId, body UpdateSuppressedNotificationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSuppressedNotificationsRequestWithBody(server, clinicId, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewUpdateSuppressedNotificationsRequestWithBody generates requests for UpdateSuppressedNotifications with any type of body
+func NewUpdateSuppressedNotificationsRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
 	if err != nil {
 		return nil, err
 	}
@@ -5579,7 +8501,7 @@ func NewGetPatientRequest(server string, clinicId ClinicId, patientId PatientId)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/suppressed_notifications", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5589,27 +8511,18 @@ func NewGetPatientRequest(server string, clinicId ClinicId, patientId PatientId)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	req.Header.Add("Content-Type", contentType)
 
-// NewCreatePatientFromUserRequest calls the generic CreatePatientFromUser builder with application/json body
-func NewCreatePatientFromUserRequest(server string, clinicId ClinicId, patientId PatientId, body CreatePatientFromUserJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreatePatientFromUserRequestWithBody(server, clinicId, patientId, "application/json", bodyReader)
+	return req, nil
 }
 
-// NewCreatePatientFromUserRequestWithBody generates requests for CreatePatientFromUser with any type of body
-func NewCreatePatientFromUserRequestWithBody(server string, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader) (*http.Request, error) {
+// NewTideReportRequest generates requests for TideReport
+func NewTideReportRequest(server string, clinicId ClinicId, params *TideReportParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5619,19 +8532,12 @@ func NewCreatePatientFromUserRequestWithBody(server string, clinicId ClinicId, p
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/tide_report", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5641,29 +8547,93 @@ func NewCreatePatientFromUserRequestWithBody(server string, clinicId ClinicId, p
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, params.Period); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "tags", runtime.ParamLocationQuery, params.Tags); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastDataCutoff", runtime.ParamLocationQuery, params.LastDataCutoff); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "categories", runtime.ParamLocationQuery, params.Categories); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "excludeNoData", runtime.ParamLocationQuery, params.ExcludeNoData); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewUpdatePatientRequest calls the generic UpdatePatient builder with application/json body
-func NewUpdatePatientRequest(server string, clinicId ClinicId, patientId PatientId, body UpdatePatientJSONRequestBody) (*http.Request, error) {
+// NewUpdateTierRequest calls the generic UpdateTier builder with application/json body
+func NewUpdateTierRequest(server string, clinicId ClinicId, body UpdateTierJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePatientRequestWithBody(server, clinicId, patientId, "application/json", bodyReader)
+	return NewUpdateTierRequestWithBody(server, clinicId, "application/json", bodyReader)
 }
 
-// NewUpdatePatientRequestWithBody generates requests for UpdatePatient with any type of body
-func NewUpdatePatientRequestWithBody(server string, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateTierRequestWithBody generates requests for UpdateTier with any type of body
+func NewUpdateTierRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5673,19 +8643,12 @@ func NewUpdatePatientRequestWithBody(server string, clinicId ClinicId, patientId
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/tier", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5695,7 +8658,7 @@ func NewUpdatePatientRequestWithBody(server string, clinicId ClinicId, patientId
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -5705,8 +8668,8 @@ func NewUpdatePatientRequestWithBody(server string, clinicId ClinicId, patientId
 	return req, nil
 }
 
-// NewConnectProviderRequest generates requests for ConnectProvider
-func NewConnectProviderRequest(server string, clinicId ClinicId, patientId PatientId, providerId ProviderId) (*http.Request, error) {
+// NewListXealthPatientsNotViewedRequest generates requests for ListXealthPatientsNotViewed
+func NewListXealthPatientsNotViewedRequest(server string, clinicId ClinicId, params *ListXealthPatientsNotViewedParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5716,26 +8679,12 @@ func NewConnectProviderRequest(server string, clinicId ClinicId, patientId Patie
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "providerId", runtime.ParamLocationPath, providerId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s/connect/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/xealth/patients_not_viewed", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5745,39 +8694,59 @@ func NewConnectProviderRequest(server string, clinicId ClinicId, patientId Patie
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Offset != nil {
 
-// NewUpdatePatientPermissionsRequest calls the generic UpdatePatientPermissions builder with application/json body
-func NewUpdatePatientPermissionsRequest(server string, clinicId ClinicId, patientId PatientId, body UpdatePatientPermissionsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePatientPermissionsRequestWithBody(server, clinicId, patientId, "application/json", bodyReader)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewUpdatePatientPermissionsRequestWithBody generates requests for UpdatePatientPermissions with any type of body
-func NewUpdatePatientPermissionsRequestWithBody(server string, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+		}
 
-	var pathParam0 string
+		if params.Limit != nil {
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	return req, nil
+}
+
+// NewListXealthReportViewsRequest generates requests for ListXealthReportViews
+func NewListXealthReportViewsRequest(server string, clinicId ClinicId, params *ListXealthReportViewsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
 	if err != nil {
 		return nil, err
 	}
@@ -5787,7 +8756,7 @@ func NewUpdatePatientPermissionsRequestWithBody(server string, clinicId ClinicId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s/permissions", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/xealth/report_views", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5797,57 +8766,109 @@ func NewUpdatePatientPermissionsRequestWithBody(server string, clinicId ClinicId
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	req.Header.Add("Content-Type", contentType)
+		if params.Offset != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewDeletePatientPermissionRequest generates requests for DeletePatientPermission
-func NewDeletePatientPermissionRequest(server string, clinicId ClinicId, patientId PatientId, permission string) (*http.Request, error) {
-	var err error
+		}
 
-	var pathParam0 string
+		if params.Limit != nil {
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam1 string
+		}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
-	if err != nil {
-		return nil, err
-	}
+		if params.CreatedTimeStart != nil {
 
-	var pathParam2 string
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdTimeStart", runtime.ParamLocationQuery, *params.CreatedTimeStart); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "permission", runtime.ParamLocationPath, permission)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.CreatedTimeEnd != nil {
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s/permissions/%s", pathParam0, pathParam1, pathParam2)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdTimeEnd", runtime.ParamLocationQuery, *params.CreatedTimeEnd); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		}
+
+		if params.PatientUserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "patientUserId", runtime.ParamLocationQuery, *params.PatientUserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SystemLogin != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "systemLogin", runtime.ParamLocationQuery, *params.SystemLogin); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5855,8 +8876,8 @@ func NewDeletePatientPermissionRequest(server string, clinicId ClinicId, patient
 	return req, nil
 }
 
-// NewDeletePatientReviewsRequest generates requests for DeletePatientReviews
-func NewDeletePatientReviewsRequest(server string, clinicId ClinicId, patientId PatientId) (*http.Request, error) {
+// NewGetXealthReportViewStatsRequest generates requests for GetXealthReportViewStats
+func NewGetXealthReportViewStatsRequest(server string, clinicId ClinicId, params *GetXealthReportViewStatsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5866,19 +8887,12 @@ func NewDeletePatientReviewsRequest(server string, clinicId ClinicId, patientId
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s/reviews", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/clinics/%s/xealth/report_views/stats", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5888,38 +8902,78 @@ func NewDeletePatientReviewsRequest(server string, clinicId ClinicId, patientId
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.CreatedTimeStart != nil {
 
-// NewUpdatePatientReviewsRequest generates requests for UpdatePatientReviews
-func NewUpdatePatientReviewsRequest(server string, clinicId ClinicId, patientId PatientId) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdTimeStart", runtime.ParamLocationQuery, *params.CreatedTimeStart); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam0 string
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
-	if err != nil {
-		return nil, err
-	}
+		if params.CreatedTimeEnd != nil {
 
-	var pathParam1 string
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdTimeEnd", runtime.ParamLocationQuery, *params.CreatedTimeEnd); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
+		}
+
+		if params.Interval != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "interval", runtime.ParamLocationQuery, *params.Interval); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewListIncompleteMigrationsRequest generates requests for ListIncompleteMigrations
+func NewListIncompleteMigrationsRequest(server string, params *ListIncompleteMigrationsParams) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s/reviews", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/migrations")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5929,7 +8983,61 @@ func NewUpdatePatientReviewsRequest(server string, clinicId ClinicId, patientId
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UpdatedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updatedBefore", runtime.ParamLocationQuery, *params.UpdatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5937,30 +9045,16 @@ func NewUpdatePatientReviewsRequest(server string, clinicId ClinicId, patientId
 	return req, nil
 }
 
-// NewSendUploadReminderRequest generates requests for SendUploadReminder
-func NewSendUploadReminderRequest(server string, clinicId ClinicId, patientId PatientId) (*http.Request, error) {
+// NewFindPatientsRequest generates requests for FindPatients
+func NewFindPatientsRequest(server string, params *FindPatientsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/patients/%s/upload_reminder", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/patients")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5970,115 +9064,123 @@ func NewSendUploadReminderRequest(server string, clinicId ClinicId, patientId Pa
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Mrn != nil {
 
-// NewGenerateMergeReportRequest calls the generic GenerateMergeReport builder with application/json body
-func NewGenerateMergeReportRequest(server string, clinicId ClinicId, body GenerateMergeReportJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewGenerateMergeReportRequestWithBody(server, clinicId, "application/json", bodyReader)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "mrn", runtime.ParamLocationQuery, *params.Mrn); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewGenerateMergeReportRequestWithBody generates requests for GenerateMergeReport with any type of body
-func NewGenerateMergeReportRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+		}
 
-	var pathParam0 string
+		if params.BirthDate != nil {
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "birthDate", runtime.ParamLocationQuery, *params.BirthDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/reports/merge", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if params.WorkspaceId != nil {
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workspaceId", runtime.ParamLocationQuery, *params.WorkspaceId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	req.Header.Add("Content-Type", contentType)
+		if params.WorkspaceIdType != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workspaceIdType", runtime.ParamLocationQuery, *params.WorkspaceIdType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewAddServiceAccountRequest calls the generic AddServiceAccount builder with application/json body
-func NewAddServiceAccountRequest(server string, clinicId ClinicId, body AddServiceAccountJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddServiceAccountRequestWithBody(server, clinicId, "application/json", bodyReader)
-}
+		}
 
-// NewAddServiceAccountRequestWithBody generates requests for AddServiceAccount with any type of body
-func NewAddServiceAccountRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+		if params.Offset != nil {
 
-	var pathParam0 string
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.Limit != nil {
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/service_accounts", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetEHRSettingsRequest generates requests for GetEHRSettings
-func NewGetEHRSettingsRequest(server string, clinicId ClinicId) (*http.Request, error) {
+// NewSyncEHRDataForPatientRequest generates requests for SyncEHRDataForPatient
+func NewSyncEHRDataForPatientRequest(server string, patientId PatientId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
 	if err != nil {
 		return nil, err
 	}
@@ -6088,7 +9190,7 @@ func NewGetEHRSettingsRequest(server string, clinicId ClinicId) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/settings/ehr", pathParam0)
+	operationPath := fmt.Sprintf("/v1/patients/%s/ehr/sync", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6098,7 +9200,7 @@ func NewGetEHRSettingsRequest(server string, clinicId ClinicId) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6106,24 +9208,24 @@ func NewGetEHRSettingsRequest(server string, clinicId ClinicId) (*http.Request,
 	return req, nil
 }
 
-// NewUpdateEHRSettingsRequest calls the generic UpdateEHRSettings builder with application/json body
-func NewUpdateEHRSettingsRequest(server string, clinicId ClinicId, body UpdateEHRSettingsJSONRequestBody) (*http.Request, error) {
+// NewUpdatePatientSummaryRequest calls the generic UpdatePatientSummary builder with application/json body
+func NewUpdatePatientSummaryRequest(server string, patientId PatientId, body UpdatePatientSummaryJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateEHRSettingsRequestWithBody(server, clinicId, "application/json", bodyReader)
+	return NewUpdatePatientSummaryRequestWithBody(server, patientId, "application/json", bodyReader)
 }
 
-// NewUpdateEHRSettingsRequestWithBody generates requests for UpdateEHRSettings with any type of body
-func NewUpdateEHRSettingsRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdatePatientSummaryRequestWithBody generates requests for UpdatePatientSummary with any type of body
+func NewUpdatePatientSummaryRequestWithBody(server string, patientId PatientId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
 	if err != nil {
 		return nil, err
 	}
@@ -6133,7 +9235,7 @@ func NewUpdateEHRSettingsRequestWithBody(server string, clinicId ClinicId, conte
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/settings/ehr", pathParam0)
+	operationPath := fmt.Sprintf("/v1/patients/%s/summary", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6143,7 +9245,7 @@ func NewUpdateEHRSettingsRequestWithBody(server string, clinicId ClinicId, conte
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -6153,13 +9255,13 @@ func NewUpdateEHRSettingsRequestWithBody(server string, clinicId ClinicId, conte
 	return req, nil
 }
 
-// NewGetMRNSettingsRequest generates requests for GetMRNSettings
-func NewGetMRNSettingsRequest(server string, clinicId ClinicId) (*http.Request, error) {
+// NewListClinicsForPatientRequest generates requests for ListClinicsForPatient
+func NewListClinicsForPatientRequest(server string, userId UserId, params *ListClinicsForPatientParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
 	if err != nil {
 		return nil, err
 	}
@@ -6169,7 +9271,7 @@ func NewGetMRNSettingsRequest(server string, clinicId ClinicId) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/settings/mrn", pathParam0)
+	operationPath := fmt.Sprintf("/v1/patients/%s/clinics", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6179,6 +9281,44 @@ func NewGetMRNSettingsRequest(server string, clinicId ClinicId) (*http.Request,
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -6187,24 +9327,24 @@ func NewGetMRNSettingsRequest(server string, clinicId ClinicId) (*http.Request,
 	return req, nil
 }
 
-// NewUpdateMRNSettingsRequest calls the generic UpdateMRNSettings builder with application/json body
-func NewUpdateMRNSettingsRequest(server string, clinicId ClinicId, body UpdateMRNSettingsJSONRequestBody) (*http.Request, error) {
+// NewUpdatePatientDataSourcesRequest calls the generic UpdatePatientDataSources builder with application/json body
+func NewUpdatePatientDataSourcesRequest(server string, userId UserId, body UpdatePatientDataSourcesJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateMRNSettingsRequestWithBody(server, clinicId, "application/json", bodyReader)
+	return NewUpdatePatientDataSourcesRequestWithBody(server, userId, "application/json", bodyReader)
 }
 
-// NewUpdateMRNSettingsRequestWithBody generates requests for UpdateMRNSettings with any type of body
-func NewUpdateMRNSettingsRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdatePatientDataSourcesRequestWithBody generates requests for UpdatePatientDataSources with any type of body
+func NewUpdatePatientDataSourcesRequestWithBody(server string, userId UserId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
 	if err != nil {
 		return nil, err
 	}
@@ -6214,7 +9354,7 @@ func NewUpdateMRNSettingsRequestWithBody(server string, clinicId ClinicId, conte
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/settings/mrn", pathParam0)
+	operationPath := fmt.Sprintf("/v1/patients/%s/data_sources", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6234,23 +9374,27 @@ func NewUpdateMRNSettingsRequestWithBody(server string, clinicId ClinicId, conte
 	return req, nil
 }
 
-// NewGetPatientCountSettingsRequest generates requests for GetPatientCountSettings
-func NewGetPatientCountSettingsRequest(server string, clinicId ClinicId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+// NewProcessEHRMessageRequest calls the generic ProcessEHRMessage builder with application/json body
+func NewProcessEHRMessageRequest(server string, body ProcessEHRMessageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewProcessEHRMessageRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewProcessEHRMessageRequestWithBody generates requests for ProcessEHRMessage with any type of body
+func NewProcessEHRMessageRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/settings/patient_count", pathParam0)
+	operationPath := fmt.Sprintf("/v1/redox")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6260,42 +9404,37 @@ func NewGetPatientCountSettingsRequest(server string, clinicId ClinicId) (*http.
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewUpdatePatientCountSettingsRequest calls the generic UpdatePatientCountSettings builder with application/json body
-func NewUpdatePatientCountSettingsRequest(server string, clinicId ClinicId, body UpdatePatientCountSettingsJSONRequestBody) (*http.Request, error) {
+// NewMatchClinicAndPatientRequest calls the generic MatchClinicAndPatient builder with application/json body
+func NewMatchClinicAndPatientRequest(server string, body MatchClinicAndPatientJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePatientCountSettingsRequestWithBody(server, clinicId, "application/json", bodyReader)
+	return NewMatchClinicAndPatientRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUpdatePatientCountSettingsRequestWithBody generates requests for UpdatePatientCountSettings with any type of body
-func NewUpdatePatientCountSettingsRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+// NewMatchClinicAndPatientRequestWithBody generates requests for MatchClinicAndPatient with any type of body
+func NewMatchClinicAndPatientRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/settings/patient_count", pathParam0)
+	operationPath := fmt.Sprintf("/v1/redox/match")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6305,7 +9444,7 @@ func NewUpdatePatientCountSettingsRequestWithBody(server string, clinicId Clinic
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -6315,24 +9454,40 @@ func NewUpdatePatientCountSettingsRequestWithBody(server string, clinicId Clinic
 	return req, nil
 }
 
-// NewCreateSiteRequest calls the generic CreateSite builder with application/json body
-func NewCreateSiteRequest(server string, clinicId ClinicId, body CreateSiteJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewVerifyEndpointRequest generates requests for VerifyEndpoint
+func NewVerifyEndpointRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateSiteRequestWithBody(server, clinicId, "application/json", bodyReader)
+
+	operationPath := fmt.Sprintf("/v1/redox/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewCreateSiteRequestWithBody generates requests for CreateSite with any type of body
-func NewCreateSiteRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeletePatientSummaryRequest generates requests for DeletePatientSummary
+func NewDeletePatientSummaryRequest(server string, summaryId SummaryId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "summaryId", runtime.ParamLocationPath, summaryId)
 	if err != nil {
 		return nil, err
 	}
@@ -6342,7 +9497,7 @@ func NewCreateSiteRequestWithBody(server string, clinicId ClinicId, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/sites", pathParam0)
+	operationPath := fmt.Sprintf("/v1/summaries/%s/clinics", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6352,30 +9507,21 @@ func NewCreateSiteRequestWithBody(server string, clinicId ClinicId, contentType
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteSiteRequest generates requests for DeleteSite
-func NewDeleteSiteRequest(server string, clinicId ClinicId, siteId SiteId) (*http.Request, error) {
+// NewDeleteUserFromClinicsRequest generates requests for DeleteUserFromClinics
+func NewDeleteUserFromClinicsRequest(server string, userId UserId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
 	if err != nil {
 		return nil, err
 	}
@@ -6385,7 +9531,7 @@ func NewDeleteSiteRequest(server string, clinicId ClinicId, siteId SiteId) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/sites/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/users/%s/clinics", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6403,31 +9549,24 @@ func NewDeleteSiteRequest(server string, clinicId ClinicId, siteId SiteId) (*htt
 	return req, nil
 }
 
-// NewUpdateSiteRequest calls the generic UpdateSite builder with application/json body
-func NewUpdateSiteRequest(server string, clinicId ClinicId, siteId SiteId, body UpdateSiteJSONRequestBody) (*http.Request, error) {
+// NewUpdateClinicUserDetailsRequest calls the generic UpdateClinicUserDetails builder with application/json body
+func NewUpdateClinicUserDetailsRequest(server string, userId UserId, body UpdateClinicUserDetailsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateSiteRequestWithBody(server, clinicId, siteId, "application/json", bodyReader)
+	return NewUpdateClinicUserDetailsRequestWithBody(server, userId, "application/json", bodyReader)
 }
 
-// NewUpdateSiteRequestWithBody generates requests for UpdateSite with any type of body
-func NewUpdateSiteRequestWithBody(server string, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateClinicUserDetailsRequestWithBody generates requests for UpdateClinicUserDetails with any type of body
+func NewUpdateClinicUserDetailsRequestWithBody(server string, userId UserId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
 	if err != nil {
 		return nil, err
 	}
@@ -6437,7 +9576,7 @@ func NewUpdateSiteRequestWithBody(server string, clinicId ClinicId, siteId SiteI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/sites/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/users/%s/clinics", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6447,7 +9586,7 @@ func NewUpdateSiteRequestWithBody(server string, clinicId ClinicId, siteId SiteI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -6457,41 +9596,43 @@ func NewUpdateSiteRequestWithBody(server string, clinicId ClinicId, siteId SiteI
 	return req, nil
 }
 
-// NewMergeSiteRequest calls the generic MergeSite builder with application/json body
-func NewMergeSiteRequest(server string, clinicId ClinicId, siteId SiteId, body MergeSiteJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewXealthNotificationRequest generates requests for XealthNotification
+func NewXealthNotificationRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewMergeSiteRequestWithBody(server, clinicId, siteId, "application/json", bodyReader)
-}
-
-// NewMergeSiteRequestWithBody generates requests for MergeSite with any type of body
-func NewMergeSiteRequestWithBody(server string, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
-	var pathParam0 string
+	operationPath := fmt.Sprintf("/v1/xealth/notification")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewXealthPreorderRequest generates requests for XealthPreorder
+func NewXealthPreorderRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/sites/%s/merge", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/xealth/preorder")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6501,44 +9642,51 @@ func NewMergeSiteRequestWithBody(server string, clinicId ClinicId, siteId SiteId
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewUpdateSuppressedNotificationsRequest calls the generic UpdateSuppressedNotifications builder with application/json body
-func NewUpdateSuppressedNotificationsRequest(server string, clinicId ClinicId, body UpdateSuppressedNotificationsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewXealthGetProgramUrlRequest generates requests for XealthGetProgramUrl
+func NewXealthGetProgramUrlRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateSuppressedNotificationsRequestWithBody(server, clinicId, "application/json", bodyReader)
-}
 
-// NewUpdateSuppressedNotificationsRequestWithBody generates requests for UpdateSuppressedNotifications with any type of body
-func NewUpdateSuppressedNotificationsRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	operationPath := fmt.Sprintf("/v1/xealth/program")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	var pathParam0 string
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewXealthGetProgramsRequest generates requests for XealthGetPrograms
+func NewXealthGetProgramsRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/suppressed_notifications", pathParam0)
+	operationPath := fmt.Sprintf("/v1/xealth/programs")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6548,70 +9696,37 @@ func NewUpdateSuppressedNotificationsRequestWithBody(server string, clinicId Cli
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewTideReportRequest generates requests for TideReport
-func NewTideReportRequest(server string, clinicId ClinicId, params *TideReportParams) (*http.Request, error) {
+// NewViewPDFReportRequest generates requests for ViewPDFReport
+func NewViewPDFReportRequest(server string, params *ViewPDFReportParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/tide_report", pathParam0)
+	operationPath := fmt.Sprintf("/v1/xealth/report/web/viewer.html")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
 
 	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
-		return nil, err
-	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, params.Period); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "tags", runtime.ParamLocationQuery, params.Tags); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+		return nil, err
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastDataCutoff", runtime.ParamLocationQuery, params.LastDataCutoff); err != nil {
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "clinicId", runtime.ParamLocationQuery, params.ClinicId); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -6623,7 +9738,7 @@ func NewTideReportRequest(server string, clinicId ClinicId, params *TideReportPa
 			}
 		}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "categories", runtime.ParamLocationQuery, params.Categories); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "patientId", runtime.ParamLocationQuery, params.PatientId); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -6635,7 +9750,7 @@ func NewTideReportRequest(server string, clinicId ClinicId, params *TideReportPa
 			}
 		}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "excludeNoData", runtime.ParamLocationQuery, params.ExcludeNoData); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "restricted_token", runtime.ParamLocationQuery, params.RestrictedToken); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -6658,1130 +9773,1750 @@ func NewTideReportRequest(server string, clinicId ClinicId, params *TideReportPa
 	return req, nil
 }
 
-// NewUpdateTierRequest calls the generic UpdateTier builder with application/json body
-func NewUpdateTierRequest(server string, clinicId ClinicId, body UpdateTierJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTierRequestWithBody(server, clinicId, "application/json", bodyReader)
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// NewUpdateTierRequestWithBody generates requests for UpdateTier with any type of body
-func NewUpdateTierRequestWithBody(server string, clinicId ClinicId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clinicId", runtime.ParamLocationPath, clinicId)
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
 	if err != nil {
 		return nil, err
 	}
+	return &ClientWithResponses{client}, nil
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
 	}
+}
 
-	operationPath := fmt.Sprintf("/v1/clinics/%s/tier", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// InvalidateAuthenticationCacheWithBodyWithResponse request with any body
+	InvalidateAuthenticationCacheWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InvalidateAuthenticationCacheResponse, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	InvalidateAuthenticationCacheWithResponse(ctx context.Context, body InvalidateAuthenticationCacheJSONRequestBody, reqEditors ...RequestEditorFn) (*InvalidateAuthenticationCacheResponse, error)
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// EvaluateAuthorizationPolicyWithBodyWithResponse request with any body
+	EvaluateAuthorizationPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EvaluateAuthorizationPolicyResponse, error)
 
-	req.Header.Add("Content-Type", contentType)
+	EvaluateAuthorizationPolicyWithResponse(ctx context.Context, body EvaluateAuthorizationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*EvaluateAuthorizationPolicyResponse, error)
 
-	return req, nil
-}
+	// ListAllCliniciansWithResponse request
+	ListAllCliniciansWithResponse(ctx context.Context, params *ListAllCliniciansParams, reqEditors ...RequestEditorFn) (*ListAllCliniciansResponse, error)
 
-// NewFindPatientsRequest generates requests for FindPatients
-func NewFindPatientsRequest(server string, params *FindPatientsParams) (*http.Request, error) {
-	var err error
+	// ListClinicsForClinicianWithResponse request
+	ListClinicsForClinicianWithResponse(ctx context.Context, userId UserId, params *ListClinicsForClinicianParams, reqEditors ...RequestEditorFn) (*ListClinicsForClinicianResponse, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// EnableNewClinicExperienceWithResponse request
+	EnableNewClinicExperienceWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*EnableNewClinicExperienceResponse, error)
 
-	operationPath := fmt.Sprintf("/v1/patients")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// ListClinicsWithResponse request
+	ListClinicsWithResponse(ctx context.Context, params *ListClinicsParams, reqEditors ...RequestEditorFn) (*ListClinicsResponse, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// CreateClinicWithBodyWithResponse request with any body
+	CreateClinicWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClinicResponse, error)
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	CreateClinicWithResponse(ctx context.Context, body CreateClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClinicResponse, error)
 
-		if params.Mrn != nil {
+	// GetClinicByShareCodeWithResponse request
+	GetClinicByShareCodeWithResponse(ctx context.Context, shareCode string, reqEditors ...RequestEditorFn) (*GetClinicByShareCodeResponse, error)
+
+	// DeleteClinicWithResponse request
+	DeleteClinicWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*DeleteClinicResponse, error)
+
+	// GetClinicWithResponse request
+	GetClinicWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetClinicResponse, error)
+
+	// UpdateClinicWithBodyWithResponse request with any body
+	UpdateClinicWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicResponse, error)
+
+	UpdateClinicWithResponse(ctx context.Context, clinicId ClinicId, body UpdateClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicResponse, error)
+
+	// GetAccessRestrictionsWithResponse request
+	GetAccessRestrictionsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetAccessRestrictionsResponse, error)
+
+	// UpdateAccessRestrictionsWithBodyWithResponse request with any body
+	UpdateAccessRestrictionsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAccessRestrictionsResponse, error)
+
+	UpdateAccessRestrictionsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateAccessRestrictionsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAccessRestrictionsResponse, error)
+
+	// ListAPIKeysWithResponse request
+	ListAPIKeysWithResponse(ctx context.Context, clinicId ClinicId, params *ListAPIKeysParams, reqEditors ...RequestEditorFn) (*ListAPIKeysResponse, error)
+
+	// CreateAPIKeyWithBodyWithResponse request with any body
+	CreateAPIKeyWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error)
+
+	CreateAPIKeyWithResponse(ctx context.Context, clinicId ClinicId, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error)
+
+	// RevokeAPIKeyWithResponse request
+	RevokeAPIKeyWithResponse(ctx context.Context, clinicId ClinicId, apiKeyId ApiKeyId, reqEditors ...RequestEditorFn) (*RevokeAPIKeyResponse, error)
+
+	// ListAuditEventsWithResponse request
+	ListAuditEventsWithResponse(ctx context.Context, clinicId ClinicId, params *ListAuditEventsParams, reqEditors ...RequestEditorFn) (*ListAuditEventsResponse, error)
+
+	// ListClinicianRolesWithResponse request
+	ListClinicianRolesWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*ListClinicianRolesResponse, error)
+
+	// UpdateClinicianRolesWithBodyWithResponse request with any body
+	UpdateClinicianRolesWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicianRolesResponse, error)
+
+	UpdateClinicianRolesWithResponse(ctx context.Context, clinicId ClinicId, body UpdateClinicianRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicianRolesResponse, error)
+
+	// ListCliniciansWithResponse request
+	ListCliniciansWithResponse(ctx context.Context, clinicId ClinicId, params *ListCliniciansParams, reqEditors ...RequestEditorFn) (*ListCliniciansResponse, error)
+
+	// CreateClinicianWithBodyWithResponse request with any body
+	CreateClinicianWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClinicianResponse, error)
+
+	CreateClinicianWithResponse(ctx context.Context, clinicId ClinicId, body CreateClinicianJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClinicianResponse, error)
+
+	// DeleteClinicianWithResponse request
+	DeleteClinicianWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, reqEditors ...RequestEditorFn) (*DeleteClinicianResponse, error)
+
+	// GetClinicianWithResponse request
+	GetClinicianWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, reqEditors ...RequestEditorFn) (*GetClinicianResponse, error)
+
+	// UpdateClinicianWithBodyWithResponse request with any body
+	UpdateClinicianWithBodyWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicianResponse, error)
+
+	UpdateClinicianWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, body UpdateClinicianJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicianResponse, error)
+
+	// UpdateClinicianSitesWithBodyWithResponse request with any body
+	UpdateClinicianSitesWithBodyWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicianSitesResponse, error)
+
+	UpdateClinicianSitesWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, body UpdateClinicianSitesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicianSitesResponse, error)
+
+	// ConsolidateClinicsWithBodyWithResponse request with any body
+	ConsolidateClinicsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConsolidateClinicsResponse, error)
+
+	ConsolidateClinicsWithResponse(ctx context.Context, clinicId ClinicId, body ConsolidateClinicsJSONRequestBody, reqEditors ...RequestEditorFn) (*ConsolidateClinicsResponse, error)
+
+	// GetClinicConsolidationWithResponse request
+	GetClinicConsolidationWithResponse(ctx context.Context, clinicId ClinicId, consolidationId ConsolidationId, reqEditors ...RequestEditorFn) (*GetClinicConsolidationResponse, error)
+
+	// SyncEHRDataWithResponse request
+	SyncEHRDataWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*SyncEHRDataResponse, error)
+
+	// DeleteInvitedClinicianWithResponse request
+	DeleteInvitedClinicianWithResponse(ctx context.Context, clinicId ClinicId, inviteId InviteId, reqEditors ...RequestEditorFn) (*DeleteInvitedClinicianResponse, error)
+
+	// GetInvitedClinicianWithResponse request
+	GetInvitedClinicianWithResponse(ctx context.Context, clinicId ClinicId, inviteId InviteId, reqEditors ...RequestEditorFn) (*GetInvitedClinicianResponse, error)
+
+	// AssociateClinicianToUserWithBodyWithResponse request with any body
+	AssociateClinicianToUserWithBodyWithResponse(ctx context.Context, clinicId ClinicId, inviteId InviteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssociateClinicianToUserResponse, error)
+
+	AssociateClinicianToUserWithResponse(ctx context.Context, clinicId ClinicId, inviteId InviteId, body AssociateClinicianToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AssociateClinicianToUserResponse, error)
+
+	// ListMembershipRestrictionsWithResponse request
+	ListMembershipRestrictionsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*ListMembershipRestrictionsResponse, error)
+
+	// UpdateMembershipRestrictionsWithBodyWithResponse request with any body
+	UpdateMembershipRestrictionsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMembershipRestrictionsResponse, error)
+
+	UpdateMembershipRestrictionsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateMembershipRestrictionsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMembershipRestrictionsResponse, error)
+
+	// MergeClinicWithBodyWithResponse request with any body
+	MergeClinicWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeClinicResponse, error)
+
+	MergeClinicWithResponse(ctx context.Context, clinicId ClinicId, body MergeClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeClinicResponse, error)
+
+	// GetClinicMergeWithResponse request
+	GetClinicMergeWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*GetClinicMergeResponse, error)
+
+	// ListClinicMergeDuplicatesWithResponse request
+	ListClinicMergeDuplicatesWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*ListClinicMergeDuplicatesResponse, error)
+
+	// ResolveClinicMergeDuplicateWithBodyWithResponse request with any body
+	ResolveClinicMergeDuplicateWithBodyWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, duplicateId DuplicateId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResolveClinicMergeDuplicateResponse, error)
+
+	ResolveClinicMergeDuplicateWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, duplicateId DuplicateId, body ResolveClinicMergeDuplicateJSONRequestBody, reqEditors ...RequestEditorFn) (*ResolveClinicMergeDuplicateResponse, error)
+
+	// PreflightClinicMergeRollbackWithResponse request
+	PreflightClinicMergeRollbackWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*PreflightClinicMergeRollbackResponse, error)
+
+	// RollbackClinicMergeWithResponse request
+	RollbackClinicMergeWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*RollbackClinicMergeResponse, error)
+
+	// StartClinicMergeWithResponse request
+	StartClinicMergeWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*StartClinicMergeResponse, error)
+
+	// TriggerInitialMigrationWithBodyWithResponse request with any body
+	TriggerInitialMigrationWithBodyWithResponse(ctx context.Context, clinicId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TriggerInitialMigrationResponse, error)
+
+	TriggerInitialMigrationWithResponse(ctx context.Context, clinicId string, body TriggerInitialMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*TriggerInitialMigrationResponse, error)
+
+	// ListMigrationsWithResponse request
+	ListMigrationsWithResponse(ctx context.Context, clinicId string, reqEditors ...RequestEditorFn) (*ListMigrationsResponse, error)
+
+	// MigrateLegacyClinicianPatientsWithBodyWithResponse request with any body
+	MigrateLegacyClinicianPatientsWithBodyWithResponse(ctx context.Context, clinicId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MigrateLegacyClinicianPatientsResponse, error)
+
+	MigrateLegacyClinicianPatientsWithResponse(ctx context.Context, clinicId string, body MigrateLegacyClinicianPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*MigrateLegacyClinicianPatientsResponse, error)
+
+	// GetMigrationWithResponse request
+	GetMigrationWithResponse(ctx context.Context, clinicId ClinicIdV1, userId UserId, reqEditors ...RequestEditorFn) (*GetMigrationResponse, error)
+
+	// UpdateMigrationWithBodyWithResponse request with any body
+	UpdateMigrationWithBodyWithResponse(ctx context.Context, clinicId ClinicIdV1, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMigrationResponse, error)
+
+	UpdateMigrationWithResponse(ctx context.Context, clinicId ClinicIdV1, userId UserId, body UpdateMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMigrationResponse, error)
+
+	// RetryMigrationWithResponse request
+	RetryMigrationWithResponse(ctx context.Context, clinicId ClinicIdV1, userId UserId, reqEditors ...RequestEditorFn) (*RetryMigrationResponse, error)
+
+	// GetPatientCountWithResponse request
+	GetPatientCountWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetPatientCountResponse, error)
+
+	// RefreshPatientCountWithResponse request
+	RefreshPatientCountWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*RefreshPatientCountResponse, error)
+
+	// GetPatientDuplicatesWithResponse request
+	GetPatientDuplicatesWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetPatientDuplicatesResponse, error)
+
+	// MergePatientDuplicateWithBodyWithResponse request with any body
+	MergePatientDuplicateWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergePatientDuplicateResponse, error)
+
+	MergePatientDuplicateWithResponse(ctx context.Context, clinicId ClinicId, body MergePatientDuplicateJSONRequestBody, reqEditors ...RequestEditorFn) (*MergePatientDuplicateResponse, error)
+
+	// ScanPatientDuplicatesWithResponse request
+	ScanPatientDuplicatesWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*ScanPatientDuplicatesResponse, error)
+
+	// CreatePatientTagWithBodyWithResponse request with any body
+	CreatePatientTagWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePatientTagResponse, error)
+
+	CreatePatientTagWithResponse(ctx context.Context, clinicId ClinicId, body CreatePatientTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePatientTagResponse, error)
+
+	// DeletePatientTagWithResponse request
+	DeletePatientTagWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, reqEditors ...RequestEditorFn) (*DeletePatientTagResponse, error)
+
+	// UpdatePatientTagWithBodyWithResponse request with any body
+	UpdatePatientTagWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientTagResponse, error)
+
+	UpdatePatientTagWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body UpdatePatientTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientTagResponse, error)
+
+	// ConvertPatientTagToSiteWithResponse request
+	ConvertPatientTagToSiteWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, reqEditors ...RequestEditorFn) (*ConvertPatientTagToSiteResponse, error)
+
+	// ListPatientsWithResponse request
+	ListPatientsWithResponse(ctx context.Context, clinicId ClinicId, params *ListPatientsParams, reqEditors ...RequestEditorFn) (*ListPatientsResponse, error)
+
+	// CreatePatientAccountWithBodyWithResponse request with any body
+	CreatePatientAccountWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePatientAccountResponse, error)
+
+	CreatePatientAccountWithResponse(ctx context.Context, clinicId ClinicId, body CreatePatientAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePatientAccountResponse, error)
+
+	// AssignPatientTagToClinicPatientsWithBodyWithResponse request with any body
+	AssignPatientTagToClinicPatientsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignPatientTagToClinicPatientsResponse, error)
+
+	AssignPatientTagToClinicPatientsWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body AssignPatientTagToClinicPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignPatientTagToClinicPatientsResponse, error)
+
+	// DeletePatientTagFromClinicPatientsWithBodyWithResponse request with any body
+	DeletePatientTagFromClinicPatientsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeletePatientTagFromClinicPatientsResponse, error)
+
+	DeletePatientTagFromClinicPatientsWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body DeletePatientTagFromClinicPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*DeletePatientTagFromClinicPatientsResponse, error)
+
+	// DeletePatientWithResponse request
+	DeletePatientWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*DeletePatientResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "mrn", runtime.ParamLocationQuery, *params.Mrn); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	// GetPatientWithResponse request
+	GetPatientWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*GetPatientResponse, error)
 
-		}
+	// CreatePatientFromUserWithBodyWithResponse request with any body
+	CreatePatientFromUserWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePatientFromUserResponse, error)
 
-		if params.BirthDate != nil {
+	CreatePatientFromUserWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, body CreatePatientFromUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePatientFromUserResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "birthDate", runtime.ParamLocationQuery, *params.BirthDate); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	// UpdatePatientWithBodyWithResponse request with any body
+	UpdatePatientWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientResponse, error)
 
-		}
+	UpdatePatientWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, body UpdatePatientJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientResponse, error)
 
-		if params.WorkspaceId != nil {
+	// ConnectProviderWithResponse request
+	ConnectProviderWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, providerId ProviderId, reqEditors ...RequestEditorFn) (*ConnectProviderResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workspaceId", runtime.ParamLocationQuery, *params.WorkspaceId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	// UpdatePatientPermissionsWithBodyWithResponse request with any body
+	UpdatePatientPermissionsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientPermissionsResponse, error)
 
-		}
+	UpdatePatientPermissionsWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, body UpdatePatientPermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientPermissionsResponse, error)
 
-		if params.WorkspaceIdType != nil {
+	// DeletePatientPermissionWithResponse request
+	DeletePatientPermissionWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, permission string, reqEditors ...RequestEditorFn) (*DeletePatientPermissionResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workspaceIdType", runtime.ParamLocationQuery, *params.WorkspaceIdType); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	// DeletePatientReviewsWithResponse request
+	DeletePatientReviewsWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*DeletePatientReviewsResponse, error)
 
-		}
+	// UpdatePatientReviewsWithResponse request
+	UpdatePatientReviewsWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*UpdatePatientReviewsResponse, error)
 
-		if params.Offset != nil {
+	// SendUploadReminderWithResponse request
+	SendUploadReminderWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*SendUploadReminderResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	// GenerateConsolidationReportWithBodyWithResponse request with any body
+	GenerateConsolidationReportWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GenerateConsolidationReportResponse, error)
 
-		}
+	GenerateConsolidationReportWithResponse(ctx context.Context, clinicId ClinicId, body GenerateConsolidationReportJSONRequestBody, reqEditors ...RequestEditorFn) (*GenerateConsolidationReportResponse, error)
 
-		if params.Limit != nil {
+	// GenerateMergeReportWithBodyWithResponse request with any body
+	GenerateMergeReportWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GenerateMergeReportResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	GenerateMergeReportWithResponse(ctx context.Context, clinicId ClinicId, body GenerateMergeReportJSONRequestBody, reqEditors ...RequestEditorFn) (*GenerateMergeReportResponse, error)
 
-		}
+	// GenerateSplitReportWithBodyWithResponse request with any body
+	GenerateSplitReportWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GenerateSplitReportResponse, error)
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+	GenerateSplitReportWithResponse(ctx context.Context, clinicId ClinicId, body GenerateSplitReportJSONRequestBody, reqEditors ...RequestEditorFn) (*GenerateSplitReportResponse, error)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// AddServiceAccountWithBodyWithResponse request with any body
+	AddServiceAccountWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddServiceAccountResponse, error)
 
-	return req, nil
-}
+	AddServiceAccountWithResponse(ctx context.Context, clinicId ClinicId, body AddServiceAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*AddServiceAccountResponse, error)
 
-// NewSyncEHRDataForPatientRequest generates requests for SyncEHRDataForPatient
-func NewSyncEHRDataForPatientRequest(server string, patientId PatientId) (*http.Request, error) {
-	var err error
+	// GetEHRSettingsWithResponse request
+	GetEHRSettingsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetEHRSettingsResponse, error)
 
-	var pathParam0 string
+	// UpdateEHRSettingsWithBodyWithResponse request with any body
+	UpdateEHRSettingsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEHRSettingsResponse, error)
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
-	if err != nil {
-		return nil, err
-	}
+	UpdateEHRSettingsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateEHRSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEHRSettingsResponse, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// GetMRNSettingsWithResponse request
+	GetMRNSettingsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetMRNSettingsResponse, error)
 
-	operationPath := fmt.Sprintf("/v1/patients/%s/ehr/sync", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// UpdateMRNSettingsWithBodyWithResponse request with any body
+	UpdateMRNSettingsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMRNSettingsResponse, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	UpdateMRNSettingsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateMRNSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMRNSettingsResponse, error)
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// GetPatientCountSettingsWithResponse request
+	GetPatientCountSettingsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetPatientCountSettingsResponse, error)
 
-	return req, nil
-}
+	// UpdatePatientCountSettingsWithBodyWithResponse request with any body
+	UpdatePatientCountSettingsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientCountSettingsResponse, error)
 
-// NewUpdatePatientSummaryRequest calls the generic UpdatePatientSummary builder with application/json body
-func NewUpdatePatientSummaryRequest(server string, patientId PatientId, body UpdatePatientSummaryJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePatientSummaryRequestWithBody(server, patientId, "application/json", bodyReader)
-}
+	UpdatePatientCountSettingsWithResponse(ctx context.Context, clinicId ClinicId, body UpdatePatientCountSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientCountSettingsResponse, error)
 
-// NewUpdatePatientSummaryRequestWithBody generates requests for UpdatePatientSummary with any type of body
-func NewUpdatePatientSummaryRequestWithBody(server string, patientId PatientId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	// CreateSiteWithBodyWithResponse request with any body
+	CreateSiteWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSiteResponse, error)
 
-	var pathParam0 string
+	CreateSiteWithResponse(ctx context.Context, clinicId ClinicId, body CreateSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSiteResponse, error)
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "patientId", runtime.ParamLocationPath, patientId)
-	if err != nil {
-		return nil, err
-	}
+	// DeleteSiteWithResponse request
+	DeleteSiteWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, reqEditors ...RequestEditorFn) (*DeleteSiteResponse, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// UpdateSiteWithBodyWithResponse request with any body
+	UpdateSiteWithBodyWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSiteResponse, error)
 
-	operationPath := fmt.Sprintf("/v1/patients/%s/summary", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	UpdateSiteWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSiteResponse, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// MergeSiteWithBodyWithResponse request with any body
+	MergeSiteWithBodyWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeSiteResponse, error)
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	MergeSiteWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, body MergeSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeSiteResponse, error)
 
-	req.Header.Add("Content-Type", contentType)
+	// UpdateSiteParentWithBodyWithResponse request with any body
+	UpdateSiteParentWithBodyWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSiteParentResponse, error)
 
-	return req, nil
-}
+	UpdateSiteParentWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteParentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSiteParentResponse, error)
 
-// NewListClinicsForPatientRequest generates requests for ListClinicsForPatient
-func NewListClinicsForPatientRequest(server string, userId UserId, params *ListClinicsForPatientParams) (*http.Request, error) {
-	var err error
+	// UpdateSiteSettingsWithBodyWithResponse request with any body
+	UpdateSiteSettingsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSiteSettingsResponse, error)
 
-	var pathParam0 string
+	UpdateSiteSettingsWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSiteSettingsResponse, error)
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
-	if err != nil {
-		return nil, err
-	}
+	// SplitClinicWithBodyWithResponse request with any body
+	SplitClinicWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SplitClinicResponse, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	SplitClinicWithResponse(ctx context.Context, clinicId ClinicId, body SplitClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*SplitClinicResponse, error)
 
-	operationPath := fmt.Sprintf("/v1/patients/%s/clinics", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// UpdateSuppressedNotificationsWithBodyWithResponse request with any body
+	UpdateSuppressedNotificationsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSuppressedNotificationsResponse, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	UpdateSuppressedNotificationsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateSuppressedNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSuppressedNotificationsResponse, error)
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	// TideReportWithResponse request
+	TideReportWithResponse(ctx context.Context, clinicId ClinicId, params *TideReportParams, reqEditors ...RequestEditorFn) (*TideReportResponse, error)
 
-		if params.Offset != nil {
+	// UpdateTierWithBodyWithResponse request with any body
+	UpdateTierWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTierResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	UpdateTierWithResponse(ctx context.Context, clinicId ClinicId, body UpdateTierJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTierResponse, error)
 
-		}
+	// ListXealthPatientsNotViewedWithResponse request
+	ListXealthPatientsNotViewedWithResponse(ctx context.Context, clinicId ClinicId, params *ListXealthPatientsNotViewedParams, reqEditors ...RequestEditorFn) (*ListXealthPatientsNotViewedResponse, error)
 
-		if params.Limit != nil {
+	// ListXealthReportViewsWithResponse request
+	ListXealthReportViewsWithResponse(ctx context.Context, clinicId ClinicId, params *ListXealthReportViewsParams, reqEditors ...RequestEditorFn) (*ListXealthReportViewsResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	// GetXealthReportViewStatsWithResponse request
+	GetXealthReportViewStatsWithResponse(ctx context.Context, clinicId ClinicId, params *GetXealthReportViewStatsParams, reqEditors ...RequestEditorFn) (*GetXealthReportViewStatsResponse, error)
 
-		}
+	// ListIncompleteMigrationsWithResponse request
+	ListIncompleteMigrationsWithResponse(ctx context.Context, params *ListIncompleteMigrationsParams, reqEditors ...RequestEditorFn) (*ListIncompleteMigrationsResponse, error)
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+	// FindPatientsWithResponse request
+	FindPatientsWithResponse(ctx context.Context, params *FindPatientsParams, reqEditors ...RequestEditorFn) (*FindPatientsResponse, error)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// SyncEHRDataForPatientWithResponse request
+	SyncEHRDataForPatientWithResponse(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*SyncEHRDataForPatientResponse, error)
 
-	return req, nil
-}
+	// UpdatePatientSummaryWithBodyWithResponse request with any body
+	UpdatePatientSummaryWithBodyWithResponse(ctx context.Context, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientSummaryResponse, error)
 
-// NewUpdatePatientDataSourcesRequest calls the generic UpdatePatientDataSources builder with application/json body
-func NewUpdatePatientDataSourcesRequest(server string, userId UserId, body UpdatePatientDataSourcesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePatientDataSourcesRequestWithBody(server, userId, "application/json", bodyReader)
-}
+	UpdatePatientSummaryWithResponse(ctx context.Context, patientId PatientId, body UpdatePatientSummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientSummaryResponse, error)
 
-// NewUpdatePatientDataSourcesRequestWithBody generates requests for UpdatePatientDataSources with any type of body
-func NewUpdatePatientDataSourcesRequestWithBody(server string, userId UserId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	// ListClinicsForPatientWithResponse request
+	ListClinicsForPatientWithResponse(ctx context.Context, userId UserId, params *ListClinicsForPatientParams, reqEditors ...RequestEditorFn) (*ListClinicsForPatientResponse, error)
 
-	var pathParam0 string
+	// UpdatePatientDataSourcesWithBodyWithResponse request with any body
+	UpdatePatientDataSourcesWithBodyWithResponse(ctx context.Context, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientDataSourcesResponse, error)
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
-	if err != nil {
-		return nil, err
-	}
+	UpdatePatientDataSourcesWithResponse(ctx context.Context, userId UserId, body UpdatePatientDataSourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientDataSourcesResponse, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// ProcessEHRMessageWithBodyWithResponse request with any body
+	ProcessEHRMessageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessEHRMessageResponse, error)
 
-	operationPath := fmt.Sprintf("/v1/patients/%s/data_sources", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	ProcessEHRMessageWithResponse(ctx context.Context, body ProcessEHRMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessEHRMessageResponse, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// MatchClinicAndPatientWithBodyWithResponse request with any body
+	MatchClinicAndPatientWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MatchClinicAndPatientResponse, error)
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	MatchClinicAndPatientWithResponse(ctx context.Context, body MatchClinicAndPatientJSONRequestBody, reqEditors ...RequestEditorFn) (*MatchClinicAndPatientResponse, error)
 
-	req.Header.Add("Content-Type", contentType)
+	// VerifyEndpointWithResponse request
+	VerifyEndpointWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VerifyEndpointResponse, error)
 
-	return req, nil
-}
+	// DeletePatientSummaryWithResponse request
+	DeletePatientSummaryWithResponse(ctx context.Context, summaryId SummaryId, reqEditors ...RequestEditorFn) (*DeletePatientSummaryResponse, error)
 
-// NewProcessEHRMessageRequest calls the generic ProcessEHRMessage builder with application/json body
-func NewProcessEHRMessageRequest(server string, body ProcessEHRMessageJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewProcessEHRMessageRequestWithBody(server, "application/json", bodyReader)
-}
+	// DeleteUserFromClinicsWithResponse request
+	DeleteUserFromClinicsWithResponse(ctx context.Context, userId UserId, reqEditors ...RequestEditorFn) (*DeleteUserFromClinicsResponse, error)
 
-// NewProcessEHRMessageRequestWithBody generates requests for ProcessEHRMessage with any type of body
-func NewProcessEHRMessageRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	// UpdateClinicUserDetailsWithBodyWithResponse request with any body
+	UpdateClinicUserDetailsWithBodyWithResponse(ctx context.Context, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicUserDetailsResponse, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	UpdateClinicUserDetailsWithResponse(ctx context.Context, userId UserId, body UpdateClinicUserDetailsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicUserDetailsResponse, error)
 
-	operationPath := fmt.Sprintf("/v1/redox")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// XealthNotificationWithResponse request
+	XealthNotificationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*XealthNotificationResponse, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// XealthPreorderWithResponse request
+	XealthPreorderWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*XealthPreorderResponse, error)
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// XealthGetProgramUrlWithResponse request
+	XealthGetProgramUrlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*XealthGetProgramUrlResponse, error)
 
-	req.Header.Add("Content-Type", contentType)
+	// XealthGetProgramsWithResponse request
+	XealthGetProgramsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*XealthGetProgramsResponse, error)
 
-	return req, nil
+	// ViewPDFReportWithResponse request
+	ViewPDFReportWithResponse(ctx context.Context, params *ViewPDFReportParams, reqEditors ...RequestEditorFn) (*ViewPDFReportResponse, error)
 }
 
-// NewMatchClinicAndPatientRequest calls the generic MatchClinicAndPatient builder with application/json body
-func NewMatchClinicAndPatientRequest(server string, body MatchClinicAndPatientJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewMatchClinicAndPatientRequestWithBody(server, "application/json", bodyReader)
+type InvalidateAuthenticationCacheResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthenticationCacheInvalidationResultV1
 }
 
-// NewMatchClinicAndPatientRequestWithBody generates requests for MatchClinicAndPatient with any type of body
-func NewMatchClinicAndPatientRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r InvalidateAuthenticationCacheResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	operationPath := fmt.Sprintf("/v1/redox/match")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// StatusCode returns HTTPResponse.StatusCode
+func (r InvalidateAuthenticationCacheResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+type EvaluateAuthorizationPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthorizationDecisionV1
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r EvaluateAuthorizationPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return http.StatusText(0)
 }
 
-// NewVerifyEndpointRequest generates requests for VerifyEndpoint
-func NewVerifyEndpointRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r EvaluateAuthorizationPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	operationPath := fmt.Sprintf("/v1/redox/verify")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+type ListAllCliniciansResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ClinicianClinicRelationshipV1
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r ListAllCliniciansResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAllCliniciansResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-
-	return req, nil
+	return 0
 }
 
-// NewDeletePatientSummaryRequest generates requests for DeletePatientSummary
-func NewDeletePatientSummaryRequest(server string, summaryId SummaryId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+type ListClinicsForClinicianResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicianClinicRelationshipsV1
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "summaryId", runtime.ParamLocationPath, summaryId)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r ListClinicsForClinicianResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClinicsForClinicianResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	operationPath := fmt.Sprintf("/v1/summaries/%s/clinics", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+type EnableNewClinicExperienceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicV1
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r EnableNewClinicExperienceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnableNewClinicExperienceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-
-	return req, nil
+	return 0
 }
 
-// NewDeleteUserFromClinicsRequest generates requests for DeleteUserFromClinics
-func NewDeleteUserFromClinicsRequest(server string, userId UserId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+type ListClinicsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicsV1
+	JSON400      *ErrorV1
+	JSON403      *ErrorV1
+	JSON500      *ErrorV1
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r ListClinicsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClinicsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	operationPath := fmt.Sprintf("/v1/users/%s/clinics", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+type CreateClinicResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicV1
+	XML200       *map[string]interface{}
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r CreateClinicResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateClinicResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return req, nil
+type GetClinicByShareCodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicV1
 }
 
-// NewUpdateClinicUserDetailsRequest calls the generic UpdateClinicUserDetails builder with application/json body
-func NewUpdateClinicUserDetailsRequest(server string, userId UserId, body UpdateClinicUserDetailsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetClinicByShareCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateClinicUserDetailsRequestWithBody(server, userId, "application/json", bodyReader)
+	return http.StatusText(0)
 }
 
-// NewUpdateClinicUserDetailsRequestWithBody generates requests for UpdateClinicUserDetails with any type of body
-func NewUpdateClinicUserDetailsRequestWithBody(server string, userId UserId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClinicByShareCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	var pathParam0 string
+type DeleteClinicResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r DeleteClinicResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteClinicResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	operationPath := fmt.Sprintf("/v1/users/%s/clinics", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+type GetClinicResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicV1
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetClinicResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClinicResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return 0
 }
 
-// NewXealthNotificationRequest generates requests for XealthNotification
-func NewXealthNotificationRequest(server string) (*http.Request, error) {
-	var err error
+type UpdateClinicResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicV1
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r UpdateClinicResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	operationPath := fmt.Sprintf("/v1/xealth/notification")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateClinicResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+type GetAccessRestrictionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AccessRestrictionsV1
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetAccessRestrictionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-
-	return req, nil
+	return http.StatusText(0)
 }
 
-// NewXealthPreorderRequest generates requests for XealthPreorder
-func NewXealthPreorderRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAccessRestrictionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	operationPath := fmt.Sprintf("/v1/xealth/preorder")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+type UpdateAccessRestrictionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AccessRestrictionsV1
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r UpdateAccessRestrictionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAccessRestrictionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-
-	return req, nil
+	return 0
 }
 
-// NewXealthGetProgramUrlRequest generates requests for XealthGetProgramUrl
-func NewXealthGetProgramUrlRequest(server string) (*http.Request, error) {
-	var err error
+type ListAPIKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiKeysV1
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r ListAPIKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	operationPath := fmt.Sprintf("/v1/xealth/program")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAPIKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+type CreateAPIKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreatedApiKeyV1
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r CreateAPIKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-
-	return req, nil
+	return http.StatusText(0)
 }
 
-// NewXealthGetProgramsRequest generates requests for XealthGetPrograms
-func NewXealthGetProgramsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAPIKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	operationPath := fmt.Sprintf("/v1/xealth/programs")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+type RevokeAPIKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiKeyV1
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r RevokeAPIKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeAPIKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return req, nil
+type ListAuditEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditEventsV1
 }
 
-// NewViewPDFReportRequest generates requests for ViewPDFReport
-func NewViewPDFReportRequest(server string, params *ViewPDFReportParams) (*http.Request, error) {
-	var err error
+// Status returns HTTPResponse.Status
+func (r ListAuditEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAuditEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	operationPath := fmt.Sprintf("/v1/xealth/report/web/viewer.html")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+type ListClinicianRolesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicianRoleListV1
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClinicianRolesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClinicianRolesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+type UpdateClinicianRolesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicianRoleListV1
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "clinicId", runtime.ParamLocationQuery, params.ClinicId); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+// Status returns HTTPResponse.Status
+func (r UpdateClinicianRolesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "patientId", runtime.ParamLocationQuery, params.PatientId); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateClinicianRolesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "restricted_token", runtime.ParamLocationQuery, params.RestrictedToken); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+type ListCliniciansResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CliniciansV1
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+// Status returns HTTPResponse.Status
+func (r ListCliniciansResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCliniciansResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return req, nil
+type CreateClinicianResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// Status returns HTTPResponse.Status
+func (r CreateClinicianResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateClinicianResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return nil
+	return 0
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+type DeleteClinicianResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r DeleteClinicianResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return &ClientWithResponses{client}, nil
+	return http.StatusText(0)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteClinicianResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ListAllCliniciansWithResponse request
-	ListAllCliniciansWithResponse(ctx context.Context, params *ListAllCliniciansParams, reqEditors ...RequestEditorFn) (*ListAllCliniciansResponse, error)
-
-	// ListClinicsForClinicianWithResponse request
-	ListClinicsForClinicianWithResponse(ctx context.Context, userId UserId, params *ListClinicsForClinicianParams, reqEditors ...RequestEditorFn) (*ListClinicsForClinicianResponse, error)
-
-	// EnableNewClinicExperienceWithResponse request
-	EnableNewClinicExperienceWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*EnableNewClinicExperienceResponse, error)
+type GetClinicianResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicianV1
+}
 
-	// ListClinicsWithResponse request
-	ListClinicsWithResponse(ctx context.Context, params *ListClinicsParams, reqEditors ...RequestEditorFn) (*ListClinicsResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetClinicianResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// CreateClinicWithBodyWithResponse request with any body
-	CreateClinicWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClinicResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClinicianResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	CreateClinicWithResponse(ctx context.Context, body CreateClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClinicResponse, error)
+type UpdateClinicianResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-	// GetClinicByShareCodeWithResponse request
-	GetClinicByShareCodeWithResponse(ctx context.Context, shareCode string, reqEditors ...RequestEditorFn) (*GetClinicByShareCodeResponse, error)
+// Status returns HTTPResponse.Status
+func (r UpdateClinicianResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DeleteClinicWithResponse request
-	DeleteClinicWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*DeleteClinicResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateClinicianResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// GetClinicWithResponse request
-	GetClinicWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetClinicResponse, error)
+type UpdateClinicianSitesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicianV1
+}
 
-	// UpdateClinicWithBodyWithResponse request with any body
-	UpdateClinicWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicResponse, error)
+// Status returns HTTPResponse.Status
+func (r UpdateClinicianSitesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	UpdateClinicWithResponse(ctx context.Context, clinicId ClinicId, body UpdateClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateClinicianSitesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// ListCliniciansWithResponse request
-	ListCliniciansWithResponse(ctx context.Context, clinicId ClinicId, params *ListCliniciansParams, reqEditors ...RequestEditorFn) (*ListCliniciansResponse, error)
+type ConsolidateClinicsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *ClinicConsolidationV1
+}
 
-	// CreateClinicianWithBodyWithResponse request with any body
-	CreateClinicianWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClinicianResponse, error)
+// Status returns HTTPResponse.Status
+func (r ConsolidateClinicsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	CreateClinicianWithResponse(ctx context.Context, clinicId ClinicId, body CreateClinicianJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClinicianResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConsolidateClinicsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// DeleteClinicianWithResponse request
-	DeleteClinicianWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, reqEditors ...RequestEditorFn) (*DeleteClinicianResponse, error)
+type GetClinicConsolidationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicConsolidationV1
+}
 
-	// GetClinicianWithResponse request
-	GetClinicianWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, reqEditors ...RequestEditorFn) (*GetClinicianResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetClinicConsolidationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UpdateClinicianWithBodyWithResponse request with any body
-	UpdateClinicianWithBodyWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicianResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClinicConsolidationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	UpdateClinicianWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, body UpdateClinicianJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicianResponse, error)
+type SyncEHRDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-	// SyncEHRDataWithResponse request
-	SyncEHRDataWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*SyncEHRDataResponse, error)
+// Status returns HTTPResponse.Status
+func (r SyncEHRDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DeleteInvitedClinicianWithResponse request
-	DeleteInvitedClinicianWithResponse(ctx context.Context, clinicId ClinicId, inviteId InviteId, reqEditors ...RequestEditorFn) (*DeleteInvitedClinicianResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r SyncEHRDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// GetInvitedClinicianWithResponse request
-	GetInvitedClinicianWithResponse(ctx context.Context, clinicId ClinicId, inviteId InviteId, reqEditors ...RequestEditorFn) (*GetInvitedClinicianResponse, error)
+type DeleteInvitedClinicianResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-	// AssociateClinicianToUserWithBodyWithResponse request with any body
-	AssociateClinicianToUserWithBodyWithResponse(ctx context.Context, clinicId ClinicId, inviteId InviteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssociateClinicianToUserResponse, error)
+// Status returns HTTPResponse.Status
+func (r DeleteInvitedClinicianResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	AssociateClinicianToUserWithResponse(ctx context.Context, clinicId ClinicId, inviteId InviteId, body AssociateClinicianToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AssociateClinicianToUserResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteInvitedClinicianResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// ListMembershipRestrictionsWithResponse request
-	ListMembershipRestrictionsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*ListMembershipRestrictionsResponse, error)
+type GetInvitedClinicianResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicianV1
+}
 
-	// UpdateMembershipRestrictionsWithBodyWithResponse request with any body
-	UpdateMembershipRestrictionsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMembershipRestrictionsResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetInvitedClinicianResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	UpdateMembershipRestrictionsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateMembershipRestrictionsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMembershipRestrictionsResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInvitedClinicianResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// MergeClinicWithBodyWithResponse request with any body
-	MergeClinicWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeClinicResponse, error)
+type AssociateClinicianToUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicianV1
+}
 
-	MergeClinicWithResponse(ctx context.Context, clinicId ClinicId, body MergeClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeClinicResponse, error)
+// Status returns HTTPResponse.Status
+func (r AssociateClinicianToUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// TriggerInitialMigrationWithBodyWithResponse request with any body
-	TriggerInitialMigrationWithBodyWithResponse(ctx context.Context, clinicId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TriggerInitialMigrationResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r AssociateClinicianToUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	TriggerInitialMigrationWithResponse(ctx context.Context, clinicId string, body TriggerInitialMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*TriggerInitialMigrationResponse, error)
+type ListMembershipRestrictionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MembershipRestrictionsV1
+}
 
-	// ListMigrationsWithResponse request
-	ListMigrationsWithResponse(ctx context.Context, clinicId string, reqEditors ...RequestEditorFn) (*ListMigrationsResponse, error)
+// Status returns HTTPResponse.Status
+func (r ListMembershipRestrictionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// MigrateLegacyClinicianPatientsWithBodyWithResponse request with any body
-	MigrateLegacyClinicianPatientsWithBodyWithResponse(ctx context.Context, clinicId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MigrateLegacyClinicianPatientsResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMembershipRestrictionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	MigrateLegacyClinicianPatientsWithResponse(ctx context.Context, clinicId string, body MigrateLegacyClinicianPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*MigrateLegacyClinicianPatientsResponse, error)
+type UpdateMembershipRestrictionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MembershipRestrictionsV1
+}
 
-	// GetMigrationWithResponse request
-	GetMigrationWithResponse(ctx context.Context, clinicId ClinicIdV1, userId UserId, reqEditors ...RequestEditorFn) (*GetMigrationResponse, error)
+// Status returns HTTPResponse.Status
+func (r UpdateMembershipRestrictionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UpdateMigrationWithBodyWithResponse request with any body
-	UpdateMigrationWithBodyWithResponse(ctx context.Context, clinicId ClinicIdV1, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMigrationResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMembershipRestrictionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	UpdateMigrationWithResponse(ctx context.Context, clinicId ClinicIdV1, userId UserId, body UpdateMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMigrationResponse, error)
+type MergeClinicResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *ClinicMergeJobV1
+}
 
-	// GetPatientCountWithResponse request
-	GetPatientCountWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetPatientCountResponse, error)
+// Status returns HTTPResponse.Status
+func (r MergeClinicResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// RefreshPatientCountWithResponse request
-	RefreshPatientCountWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*RefreshPatientCountResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r MergeClinicResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// CreatePatientTagWithBodyWithResponse request with any body
-	CreatePatientTagWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePatientTagResponse, error)
+type GetClinicMergeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicMergeJobV1
+}
 
-	CreatePatientTagWithResponse(ctx context.Context, clinicId ClinicId, body CreatePatientTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePatientTagResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetClinicMergeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DeletePatientTagWithResponse request
-	DeletePatientTagWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, reqEditors ...RequestEditorFn) (*DeletePatientTagResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClinicMergeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// UpdatePatientTagWithBodyWithResponse request with any body
-	UpdatePatientTagWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientTagResponse, error)
+type ListClinicMergeDuplicatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicMergeDuplicatesV1
+}
 
-	UpdatePatientTagWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body UpdatePatientTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientTagResponse, error)
+// Status returns HTTPResponse.Status
+func (r ListClinicMergeDuplicatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// ConvertPatientTagToSiteWithResponse request
-	ConvertPatientTagToSiteWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, reqEditors ...RequestEditorFn) (*ConvertPatientTagToSiteResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClinicMergeDuplicatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// ListPatientsWithResponse request
-	ListPatientsWithResponse(ctx context.Context, clinicId ClinicId, params *ListPatientsParams, reqEditors ...RequestEditorFn) (*ListPatientsResponse, error)
+type ResolveClinicMergeDuplicateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicMergeDuplicateV1
+}
 
-	// CreatePatientAccountWithBodyWithResponse request with any body
-	CreatePatientAccountWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePatientAccountResponse, error)
+// Status returns HTTPResponse.Status
+func (r ResolveClinicMergeDuplicateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	CreatePatientAccountWithResponse(ctx context.Context, clinicId ClinicId, body CreatePatientAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePatientAccountResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResolveClinicMergeDuplicateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// AssignPatientTagToClinicPatientsWithBodyWithResponse request with any body
-	AssignPatientTagToClinicPatientsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignPatientTagToClinicPatientsResponse, error)
+type PreflightClinicMergeRollbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClinicMergeRollbackReportV1
+}
 
-	AssignPatientTagToClinicPatientsWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body AssignPatientTagToClinicPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignPatientTagToClinicPatientsResponse, error)
+// Status returns HTTPResponse.Status
+func (r PreflightClinicMergeRollbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DeletePatientTagFromClinicPatientsWithBodyWithResponse request with any body
-	DeletePatientTagFromClinicPatientsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeletePatientTagFromClinicPatientsResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreflightClinicMergeRollbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	DeletePatientTagFromClinicPatientsWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body DeletePatientTagFromClinicPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*DeletePatientTagFromClinicPatientsResponse, error)
+type RollbackClinicMergeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *ClinicMergeJobV1
+	JSON422      *ClinicMergeRollbackReportV1
+}
 
-	// DeletePatientWithResponse request
-	DeletePatientWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*DeletePatientResponse, error)
+// Status returns HTTPResponse.Status
+func (r RollbackClinicMergeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetPatientWithResponse request
-	GetPatientWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*GetPatientResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r RollbackClinicMergeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// CreatePatientFromUserWithBodyWithResponse request with any body
-	CreatePatientFromUserWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePatientFromUserResponse, error)
+type StartClinicMergeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *ClinicMergeJobV1
+}
 
-	CreatePatientFromUserWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, body CreatePatientFromUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePatientFromUserResponse, error)
+// Status returns HTTPResponse.Status
+func (r StartClinicMergeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UpdatePatientWithBodyWithResponse request with any body
-	UpdatePatientWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartClinicMergeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	UpdatePatientWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, body UpdatePatientJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientResponse, error)
+type TriggerInitialMigrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MigrationV1
+}
 
-	// ConnectProviderWithResponse request
-	ConnectProviderWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, providerId ProviderId, reqEditors ...RequestEditorFn) (*ConnectProviderResponse, error)
+// Status returns HTTPResponse.Status
+func (r TriggerInitialMigrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UpdatePatientPermissionsWithBodyWithResponse request with any body
-	UpdatePatientPermissionsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientPermissionsResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r TriggerInitialMigrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	UpdatePatientPermissionsWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, body UpdatePatientPermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientPermissionsResponse, error)
+type ListMigrationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MigrationsV1
+}
 
-	// DeletePatientPermissionWithResponse request
-	DeletePatientPermissionWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, permission string, reqEditors ...RequestEditorFn) (*DeletePatientPermissionResponse, error)
+// Status returns HTTPResponse.Status
+func (r ListMigrationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DeletePatientReviewsWithResponse request
-	DeletePatientReviewsWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*DeletePatientReviewsResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMigrationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// UpdatePatientReviewsWithResponse request
-	UpdatePatientReviewsWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*UpdatePatientReviewsResponse, error)
+type MigrateLegacyClinicianPatientsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *MigrationV1
+}
 
-	// SendUploadReminderWithResponse request
-	SendUploadReminderWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*SendUploadReminderResponse, error)
+// Status returns HTTPResponse.Status
+func (r MigrateLegacyClinicianPatientsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GenerateMergeReportWithBodyWithResponse request with any body
-	GenerateMergeReportWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GenerateMergeReportResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r MigrateLegacyClinicianPatientsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	GenerateMergeReportWithResponse(ctx context.Context, clinicId ClinicId, body GenerateMergeReportJSONRequestBody, reqEditors ...RequestEditorFn) (*GenerateMergeReportResponse, error)
+type GetMigrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MigrationV1
+}
 
-	// AddServiceAccountWithBodyWithResponse request with any body
-	AddServiceAccountWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddServiceAccountResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetMigrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	AddServiceAccountWithResponse(ctx context.Context, clinicId ClinicId, body AddServiceAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*AddServiceAccountResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMigrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// GetEHRSettingsWithResponse request
-	GetEHRSettingsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetEHRSettingsResponse, error)
+type UpdateMigrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MigrationV1
+}
 
-	// UpdateEHRSettingsWithBodyWithResponse request with any body
-	UpdateEHRSettingsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEHRSettingsResponse, error)
+// Status returns HTTPResponse.Status
+func (r UpdateMigrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	UpdateEHRSettingsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateEHRSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEHRSettingsResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMigrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// GetMRNSettingsWithResponse request
-	GetMRNSettingsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetMRNSettingsResponse, error)
+type RetryMigrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MigrationV1
+}
 
-	// UpdateMRNSettingsWithBodyWithResponse request with any body
-	UpdateMRNSettingsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMRNSettingsResponse, error)
+// Status returns HTTPResponse.Status
+func (r RetryMigrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	UpdateMRNSettingsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateMRNSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMRNSettingsResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r RetryMigrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// GetPatientCountSettingsWithResponse request
-	GetPatientCountSettingsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetPatientCountSettingsResponse, error)
+type GetPatientCountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PatientCountV1
+}
 
-	// UpdatePatientCountSettingsWithBodyWithResponse request with any body
-	UpdatePatientCountSettingsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientCountSettingsResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetPatientCountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	UpdatePatientCountSettingsWithResponse(ctx context.Context, clinicId ClinicId, body UpdatePatientCountSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientCountSettingsResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPatientCountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// CreateSiteWithBodyWithResponse request with any body
-	CreateSiteWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSiteResponse, error)
+type RefreshPatientCountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-	CreateSiteWithResponse(ctx context.Context, clinicId ClinicId, body CreateSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSiteResponse, error)
+// Status returns HTTPResponse.Status
+func (r RefreshPatientCountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DeleteSiteWithResponse request
-	DeleteSiteWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, reqEditors ...RequestEditorFn) (*DeleteSiteResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefreshPatientCountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// UpdateSiteWithBodyWithResponse request with any body
-	UpdateSiteWithBodyWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSiteResponse, error)
+type GetPatientDuplicatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PatientDuplicateScanV1
+}
 
-	UpdateSiteWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSiteResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetPatientDuplicatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// MergeSiteWithBodyWithResponse request with any body
-	MergeSiteWithBodyWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeSiteResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPatientDuplicatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	MergeSiteWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, body MergeSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeSiteResponse, error)
+type MergePatientDuplicateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PatientV1
+}
 
-	// UpdateSuppressedNotificationsWithBodyWithResponse request with any body
-	UpdateSuppressedNotificationsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSuppressedNotificationsResponse, error)
+// Status returns HTTPResponse.Status
+func (r MergePatientDuplicateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	UpdateSuppressedNotificationsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateSuppressedNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSuppressedNotificationsResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r MergePatientDuplicateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// TideReportWithResponse request
-	TideReportWithResponse(ctx context.Context, clinicId ClinicId, params *TideReportParams, reqEditors ...RequestEditorFn) (*TideReportResponse, error)
+type ScanPatientDuplicatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PatientDuplicateScanV1
+}
 
-	// UpdateTierWithBodyWithResponse request with any body
-	UpdateTierWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTierResponse, error)
+// Status returns HTTPResponse.Status
+func (r ScanPatientDuplicatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	UpdateTierWithResponse(ctx context.Context, clinicId ClinicId, body UpdateTierJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTierResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ScanPatientDuplicatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// FindPatientsWithResponse request
-	FindPatientsWithResponse(ctx context.Context, params *FindPatientsParams, reqEditors ...RequestEditorFn) (*FindPatientsResponse, error)
+type CreatePatientTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PatientTagV1
+}
 
-	// SyncEHRDataForPatientWithResponse request
-	SyncEHRDataForPatientWithResponse(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*SyncEHRDataForPatientResponse, error)
+// Status returns HTTPResponse.Status
+func (r CreatePatientTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UpdatePatientSummaryWithBodyWithResponse request with any body
-	UpdatePatientSummaryWithBodyWithResponse(ctx context.Context, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientSummaryResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePatientTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	UpdatePatientSummaryWithResponse(ctx context.Context, patientId PatientId, body UpdatePatientSummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientSummaryResponse, error)
+type DeletePatientTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-	// ListClinicsForPatientWithResponse request
-	ListClinicsForPatientWithResponse(ctx context.Context, userId UserId, params *ListClinicsForPatientParams, reqEditors ...RequestEditorFn) (*ListClinicsForPatientResponse, error)
+// Status returns HTTPResponse.Status
+func (r DeletePatientTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UpdatePatientDataSourcesWithBodyWithResponse request with any body
-	UpdatePatientDataSourcesWithBodyWithResponse(ctx context.Context, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientDataSourcesResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePatientTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	UpdatePatientDataSourcesWithResponse(ctx context.Context, userId UserId, body UpdatePatientDataSourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientDataSourcesResponse, error)
+type UpdatePatientTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PatientTagV1
+}
 
-	// ProcessEHRMessageWithBodyWithResponse request with any body
-	ProcessEHRMessageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessEHRMessageResponse, error)
+// Status returns HTTPResponse.Status
+func (r UpdatePatientTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	ProcessEHRMessageWithResponse(ctx context.Context, body ProcessEHRMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessEHRMessageResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePatientTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// MatchClinicAndPatientWithBodyWithResponse request with any body
-	MatchClinicAndPatientWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MatchClinicAndPatientResponse, error)
+type ConvertPatientTagToSiteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SiteV1
+}
 
-	MatchClinicAndPatientWithResponse(ctx context.Context, body MatchClinicAndPatientJSONRequestBody, reqEditors ...RequestEditorFn) (*MatchClinicAndPatientResponse, error)
+// Status returns HTTPResponse.Status
+func (r ConvertPatientTagToSiteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// VerifyEndpointWithResponse request
-	VerifyEndpointWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VerifyEndpointResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConvertPatientTagToSiteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// DeletePatientSummaryWithResponse request
-	DeletePatientSummaryWithResponse(ctx context.Context, summaryId SummaryId, reqEditors ...RequestEditorFn) (*DeletePatientSummaryResponse, error)
+type ListPatientsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PatientsResponseV1
+}
 
-	// DeleteUserFromClinicsWithResponse request
-	DeleteUserFromClinicsWithResponse(ctx context.Context, userId UserId, reqEditors ...RequestEditorFn) (*DeleteUserFromClinicsResponse, error)
+// Status returns HTTPResponse.Status
+func (r ListPatientsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UpdateClinicUserDetailsWithBodyWithResponse request with any body
-	UpdateClinicUserDetailsWithBodyWithResponse(ctx context.Context, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicUserDetailsResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPatientsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	UpdateClinicUserDetailsWithResponse(ctx context.Context, userId UserId, body UpdateClinicUserDetailsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicUserDetailsResponse, error)
+type CreatePatientAccountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PatientV1
+}
 
-	// XealthNotificationWithResponse request
-	XealthNotificationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*XealthNotificationResponse, error)
+// Status returns HTTPResponse.Status
+func (r CreatePatientAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// XealthPreorderWithResponse request
-	XealthPreorderWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*XealthPreorderResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePatientAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// XealthGetProgramUrlWithResponse request
-	XealthGetProgramUrlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*XealthGetProgramUrlResponse, error)
+type AssignPatientTagToClinicPatientsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-	// XealthGetProgramsWithResponse request
-	XealthGetProgramsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*XealthGetProgramsResponse, error)
+// Status returns HTTPResponse.Status
+func (r AssignPatientTagToClinicPatientsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// ViewPDFReportWithResponse request
-	ViewPDFReportWithResponse(ctx context.Context, params *ViewPDFReportParams, reqEditors ...RequestEditorFn) (*ViewPDFReportResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r AssignPatientTagToClinicPatientsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type ListAllCliniciansResponse struct {
+type DeletePatientTagFromClinicPatientsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]ClinicianClinicRelationshipV1
 }
 
 // Status returns HTTPResponse.Status
-func (r ListAllCliniciansResponse) Status() string {
+func (r DeletePatientTagFromClinicPatientsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7789,21 +11524,20 @@ func (r ListAllCliniciansResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListAllCliniciansResponse) StatusCode() int {
+func (r DeletePatientTagFromClinicPatientsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ListClinicsForClinicianResponse struct {
+type DeletePatientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ClinicianClinicRelationshipsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r ListClinicsForClinicianResponse) Status() string {
+func (r DeletePatientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7811,21 +11545,21 @@ func (r ListClinicsForClinicianResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListClinicsForClinicianResponse) StatusCode() int {
+func (r DeletePatientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EnableNewClinicExperienceResponse struct {
+type GetPatientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ClinicV1
+	JSON200      *PatientV1
 }
 
 // Status returns HTTPResponse.Status
-func (r EnableNewClinicExperienceResponse) Status() string {
+func (r GetPatientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7833,24 +11567,21 @@ func (r EnableNewClinicExperienceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EnableNewClinicExperienceResponse) StatusCode() int {
+func (r GetPatientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ListClinicsResponse struct {
+type CreatePatientFromUserResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ClinicsV1
-	JSON400      *ErrorV1
-	JSON403      *ErrorV1
-	JSON500      *ErrorV1
+	JSON200      *PatientV1
 }
 
 // Status returns HTTPResponse.Status
-func (r ListClinicsResponse) Status() string {
+func (r CreatePatientFromUserResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7858,22 +11589,21 @@ func (r ListClinicsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListClinicsResponse) StatusCode() int {
+func (r CreatePatientFromUserResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateClinicResponse struct {
+type UpdatePatientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ClinicV1
-	XML200       *map[string]interface{}
+	JSON200      *PatientV1
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateClinicResponse) Status() string {
+func (r UpdatePatientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7881,21 +11611,20 @@ func (r CreateClinicResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateClinicResponse) StatusCode() int {
+func (r UpdatePatientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetClinicByShareCodeResponse struct {
+type ConnectProviderResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ClinicV1
 }
 
 // Status returns HTTPResponse.Status
-func (r GetClinicByShareCodeResponse) Status() string {
+func (r ConnectProviderResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7903,20 +11632,21 @@ func (r GetClinicByShareCodeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetClinicByShareCodeResponse) StatusCode() int {
+func (r ConnectProviderResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteClinicResponse struct {
+type UpdatePatientPermissionsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *PatientPermissionsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteClinicResponse) Status() string {
+func (r UpdatePatientPermissionsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7924,21 +11654,20 @@ func (r DeleteClinicResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteClinicResponse) StatusCode() int {
+func (r UpdatePatientPermissionsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetClinicResponse struct {
+type DeletePatientPermissionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ClinicV1
 }
 
 // Status returns HTTPResponse.Status
-func (r GetClinicResponse) Status() string {
+func (r DeletePatientPermissionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7946,21 +11675,21 @@ func (r GetClinicResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetClinicResponse) StatusCode() int {
+func (r DeletePatientPermissionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateClinicResponse struct {
+type DeletePatientReviewsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ClinicV1
+	JSON200      *PatientReviewsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateClinicResponse) Status() string {
+func (r DeletePatientReviewsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7968,21 +11697,21 @@ func (r UpdateClinicResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateClinicResponse) StatusCode() int {
+func (r DeletePatientReviewsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ListCliniciansResponse struct {
+type UpdatePatientReviewsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *CliniciansV1
+	JSON200      *PatientReviewsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r ListCliniciansResponse) Status() string {
+func (r UpdatePatientReviewsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7990,20 +11719,20 @@ func (r ListCliniciansResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListCliniciansResponse) StatusCode() int {
+func (r UpdatePatientReviewsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateClinicianResponse struct {
+type SendUploadReminderResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateClinicianResponse) Status() string {
+func (r SendUploadReminderResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8011,20 +11740,22 @@ func (r CreateClinicianResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateClinicianResponse) StatusCode() int {
+func (r SendUploadReminderResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteClinicianResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+type GenerateConsolidationReportResponse struct {
+	Body                                             []byte
+	HTTPResponse                                     *http.Response
+	JSON200                                          *map[string]interface{}
+	ApplicationvndTidepoolClinicMergeReportV1JSON200 *ClinicMergeReportV1
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteClinicianResponse) Status() string {
+func (r GenerateConsolidationReportResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8032,21 +11763,22 @@ func (r DeleteClinicianResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteClinicianResponse) StatusCode() int {
+func (r GenerateConsolidationReportResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetClinicianResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ClinicianV1
+type GenerateMergeReportResponse struct {
+	Body                                             []byte
+	HTTPResponse                                     *http.Response
+	JSON200                                          *map[string]interface{}
+	ApplicationvndTidepoolClinicMergeReportV1JSON200 *ClinicMergeReportV1
 }
 
 // Status returns HTTPResponse.Status
-func (r GetClinicianResponse) Status() string {
+func (r GenerateMergeReportResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8054,20 +11786,20 @@ func (r GetClinicianResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetClinicianResponse) StatusCode() int {
+func (r GenerateMergeReportResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateClinicianResponse struct {
+type GenerateSplitReportResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateClinicianResponse) Status() string {
+func (r GenerateSplitReportResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8075,20 +11807,20 @@ func (r UpdateClinicianResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateClinicianResponse) StatusCode() int {
+func (r GenerateSplitReportResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SyncEHRDataResponse struct {
+type AddServiceAccountResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r SyncEHRDataResponse) Status() string {
+func (r AddServiceAccountResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8096,20 +11828,21 @@ func (r SyncEHRDataResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SyncEHRDataResponse) StatusCode() int {
+func (r AddServiceAccountResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteInvitedClinicianResponse struct {
+type GetEHRSettingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *EhrSettingsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteInvitedClinicianResponse) Status() string {
+func (r GetEHRSettingsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8117,21 +11850,20 @@ func (r DeleteInvitedClinicianResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteInvitedClinicianResponse) StatusCode() int {
+func (r GetEHRSettingsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetInvitedClinicianResponse struct {
+type UpdateEHRSettingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ClinicianV1
 }
 
 // Status returns HTTPResponse.Status
-func (r GetInvitedClinicianResponse) Status() string {
+func (r UpdateEHRSettingsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8139,21 +11871,21 @@ func (r GetInvitedClinicianResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetInvitedClinicianResponse) StatusCode() int {
+func (r UpdateEHRSettingsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AssociateClinicianToUserResponse struct {
+type GetMRNSettingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ClinicianV1
+	JSON200      *MrnSettingsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r AssociateClinicianToUserResponse) Status() string {
+func (r GetMRNSettingsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8161,21 +11893,20 @@ func (r AssociateClinicianToUserResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AssociateClinicianToUserResponse) StatusCode() int {
+func (r GetMRNSettingsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ListMembershipRestrictionsResponse struct {
+type UpdateMRNSettingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *MembershipRestrictionsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r ListMembershipRestrictionsResponse) Status() string {
+func (r UpdateMRNSettingsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8183,21 +11914,21 @@ func (r ListMembershipRestrictionsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListMembershipRestrictionsResponse) StatusCode() int {
+func (r UpdateMRNSettingsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateMembershipRestrictionsResponse struct {
+type GetPatientCountSettingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *MembershipRestrictionsV1
+	JSON200      *PatientCountSettingsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateMembershipRestrictionsResponse) Status() string {
+func (r GetPatientCountSettingsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8205,20 +11936,20 @@ func (r UpdateMembershipRestrictionsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMembershipRestrictionsResponse) StatusCode() int {
+func (r GetPatientCountSettingsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type MergeClinicResponse struct {
+type UpdatePatientCountSettingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r MergeClinicResponse) Status() string {
+func (r UpdatePatientCountSettingsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8226,21 +11957,21 @@ func (r MergeClinicResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r MergeClinicResponse) StatusCode() int {
+func (r UpdatePatientCountSettingsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TriggerInitialMigrationResponse struct {
+type CreateSiteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *MigrationV1
+	JSON200      *SiteV1
 }
 
 // Status returns HTTPResponse.Status
-func (r TriggerInitialMigrationResponse) Status() string {
+func (r CreateSiteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8248,21 +11979,20 @@ func (r TriggerInitialMigrationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TriggerInitialMigrationResponse) StatusCode() int {
+func (r CreateSiteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ListMigrationsResponse struct {
+type DeleteSiteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *MigrationsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r ListMigrationsResponse) Status() string {
+func (r DeleteSiteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8270,21 +12000,21 @@ func (r ListMigrationsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListMigrationsResponse) StatusCode() int {
+func (r DeleteSiteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type MigrateLegacyClinicianPatientsResponse struct {
+type UpdateSiteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON202      *MigrationV1
+	JSON200      *SiteV1
 }
 
 // Status returns HTTPResponse.Status
-func (r MigrateLegacyClinicianPatientsResponse) Status() string {
+func (r UpdateSiteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8292,21 +12022,21 @@ func (r MigrateLegacyClinicianPatientsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r MigrateLegacyClinicianPatientsResponse) StatusCode() int {
+func (r UpdateSiteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetMigrationResponse struct {
+type MergeSiteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *MigrationV1
+	JSON200      *SiteV1
 }
 
 // Status returns HTTPResponse.Status
-func (r GetMigrationResponse) Status() string {
+func (r MergeSiteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8314,21 +12044,21 @@ func (r GetMigrationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetMigrationResponse) StatusCode() int {
+func (r MergeSiteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateMigrationResponse struct {
+type UpdateSiteParentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *MigrationV1
+	JSON200      *SiteV1
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateMigrationResponse) Status() string {
+func (r UpdateSiteParentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8336,21 +12066,21 @@ func (r UpdateMigrationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMigrationResponse) StatusCode() int {
+func (r UpdateSiteParentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetPatientCountResponse struct {
+type UpdateSiteSettingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PatientCountV1
+	JSON200      *SiteV1
 }
 
 // Status returns HTTPResponse.Status
-func (r GetPatientCountResponse) Status() string {
+func (r UpdateSiteSettingsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8358,20 +12088,21 @@ func (r GetPatientCountResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetPatientCountResponse) StatusCode() int {
+func (r UpdateSiteSettingsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type RefreshPatientCountResponse struct {
+type SplitClinicResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON201      *ClinicV1
 }
 
 // Status returns HTTPResponse.Status
-func (r RefreshPatientCountResponse) Status() string {
+func (r SplitClinicResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8379,21 +12110,20 @@ func (r RefreshPatientCountResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r RefreshPatientCountResponse) StatusCode() int {
+func (r SplitClinicResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreatePatientTagResponse struct {
+type UpdateSuppressedNotificationsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PatientTagV1
 }
 
 // Status returns HTTPResponse.Status
-func (r CreatePatientTagResponse) Status() string {
+func (r UpdateSuppressedNotificationsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8401,20 +12131,21 @@ func (r CreatePatientTagResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreatePatientTagResponse) StatusCode() int {
+func (r UpdateSuppressedNotificationsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeletePatientTagResponse struct {
+type TideReportResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *TideResponseV1
 }
 
 // Status returns HTTPResponse.Status
-func (r DeletePatientTagResponse) Status() string {
+func (r TideReportResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8422,21 +12153,20 @@ func (r DeletePatientTagResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeletePatientTagResponse) StatusCode() int {
+func (r TideReportResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdatePatientTagResponse struct {
+type UpdateTierResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PatientTagV1
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdatePatientTagResponse) Status() string {
+func (r UpdateTierResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8444,21 +12174,21 @@ func (r UpdatePatientTagResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePatientTagResponse) StatusCode() int {
+func (r UpdateTierResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ConvertPatientTagToSiteResponse struct {
+type ListXealthPatientsNotViewedResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SiteV1
+	JSON200      *XealthPatientsNotViewedResponseV1
 }
 
 // Status returns HTTPResponse.Status
-func (r ConvertPatientTagToSiteResponse) Status() string {
+func (r ListXealthPatientsNotViewedResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8466,21 +12196,21 @@ func (r ConvertPatientTagToSiteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ConvertPatientTagToSiteResponse) StatusCode() int {
+func (r ListXealthPatientsNotViewedResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ListPatientsResponse struct {
+type ListXealthReportViewsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PatientsResponseV1
+	JSON200      *XealthReportViewsResponseV1
 }
 
 // Status returns HTTPResponse.Status
-func (r ListPatientsResponse) Status() string {
+func (r ListXealthReportViewsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8488,21 +12218,21 @@ func (r ListPatientsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListPatientsResponse) StatusCode() int {
+func (r ListXealthReportViewsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreatePatientAccountResponse struct {
+type GetXealthReportViewStatsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PatientV1
+	JSON200      *XealthReportViewStatsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r CreatePatientAccountResponse) Status() string {
+func (r GetXealthReportViewStatsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8510,20 +12240,21 @@ func (r CreatePatientAccountResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreatePatientAccountResponse) StatusCode() int {
+func (r GetXealthReportViewStatsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AssignPatientTagToClinicPatientsResponse struct {
+type ListIncompleteMigrationsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *MigrationsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r AssignPatientTagToClinicPatientsResponse) Status() string {
+func (r ListIncompleteMigrationsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8531,20 +12262,21 @@ func (r AssignPatientTagToClinicPatientsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AssignPatientTagToClinicPatientsResponse) StatusCode() int {
+func (r ListIncompleteMigrationsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeletePatientTagFromClinicPatientsResponse struct {
+type FindPatientsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *PatientClinicRelationshipsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r DeletePatientTagFromClinicPatientsResponse) Status() string {
+func (r FindPatientsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8552,20 +12284,20 @@ func (r DeletePatientTagFromClinicPatientsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeletePatientTagFromClinicPatientsResponse) StatusCode() int {
+func (r FindPatientsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeletePatientResponse struct {
+type SyncEHRDataForPatientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r DeletePatientResponse) Status() string {
+func (r SyncEHRDataForPatientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8573,21 +12305,20 @@ func (r DeletePatientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeletePatientResponse) StatusCode() int {
+func (r SyncEHRDataForPatientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetPatientResponse struct {
+type UpdatePatientSummaryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PatientV1
 }
 
 // Status returns HTTPResponse.Status
-func (r GetPatientResponse) Status() string {
+func (r UpdatePatientSummaryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8595,21 +12326,21 @@ func (r GetPatientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetPatientResponse) StatusCode() int {
+func (r UpdatePatientSummaryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreatePatientFromUserResponse struct {
+type ListClinicsForPatientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PatientV1
+	JSON200      *PatientClinicRelationshipsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r CreatePatientFromUserResponse) Status() string {
+func (r ListClinicsForPatientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8617,21 +12348,20 @@ func (r CreatePatientFromUserResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreatePatientFromUserResponse) StatusCode() int {
+func (r ListClinicsForPatientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdatePatientResponse struct {
+type UpdatePatientDataSourcesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PatientV1
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdatePatientResponse) Status() string {
+func (r UpdatePatientDataSourcesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8639,20 +12369,20 @@ func (r UpdatePatientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePatientResponse) StatusCode() int {
+func (r UpdatePatientDataSourcesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ConnectProviderResponse struct {
+type ProcessEHRMessageResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r ConnectProviderResponse) Status() string {
+func (r ProcessEHRMessageResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8660,21 +12390,21 @@ func (r ConnectProviderResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ConnectProviderResponse) StatusCode() int {
+func (r ProcessEHRMessageResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdatePatientPermissionsResponse struct {
+type MatchClinicAndPatientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PatientPermissionsV1
+	JSON200      *EhrMatchResponseV1
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdatePatientPermissionsResponse) Status() string {
+func (r MatchClinicAndPatientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8682,20 +12412,20 @@ func (r UpdatePatientPermissionsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePatientPermissionsResponse) StatusCode() int {
+func (r MatchClinicAndPatientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeletePatientPermissionResponse struct {
+type VerifyEndpointResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r DeletePatientPermissionResponse) Status() string {
+func (r VerifyEndpointResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8703,21 +12433,20 @@ func (r DeletePatientPermissionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeletePatientPermissionResponse) StatusCode() int {
+func (r VerifyEndpointResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeletePatientReviewsResponse struct {
+type DeletePatientSummaryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PatientReviewsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r DeletePatientReviewsResponse) Status() string {
+func (r DeletePatientSummaryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8725,21 +12454,20 @@ func (r DeletePatientReviewsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeletePatientReviewsResponse) StatusCode() int {
+func (r DeletePatientSummaryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdatePatientReviewsResponse struct {
+type DeleteUserFromClinicsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PatientReviewsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdatePatientReviewsResponse) Status() string {
+func (r DeleteUserFromClinicsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8747,20 +12475,20 @@ func (r UpdatePatientReviewsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePatientReviewsResponse) StatusCode() int {
+func (r DeleteUserFromClinicsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SendUploadReminderResponse struct {
+type UpdateClinicUserDetailsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r SendUploadReminderResponse) Status() string {
+func (r UpdateClinicUserDetailsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8768,20 +12496,20 @@ func (r SendUploadReminderResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SendUploadReminderResponse) StatusCode() int {
+func (r UpdateClinicUserDetailsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GenerateMergeReportResponse struct {
+type XealthNotificationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r GenerateMergeReportResponse) Status() string {
+func (r XealthNotificationResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8789,20 +12517,20 @@ func (r GenerateMergeReportResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GenerateMergeReportResponse) StatusCode() int {
+func (r XealthNotificationResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AddServiceAccountResponse struct {
+type XealthPreorderResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r AddServiceAccountResponse) Status() string {
+func (r XealthPreorderResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8810,21 +12538,20 @@ func (r AddServiceAccountResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AddServiceAccountResponse) StatusCode() int {
+func (r XealthPreorderResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetEHRSettingsResponse struct {
+type XealthGetProgramUrlResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EhrSettingsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r GetEHRSettingsResponse) Status() string {
+func (r XealthGetProgramUrlResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8832,20 +12559,20 @@ func (r GetEHRSettingsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetEHRSettingsResponse) StatusCode() int {
+func (r XealthGetProgramUrlResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateEHRSettingsResponse struct {
+type XealthGetProgramsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateEHRSettingsResponse) Status() string {
+func (r XealthGetProgramsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8853,21 +12580,20 @@ func (r UpdateEHRSettingsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateEHRSettingsResponse) StatusCode() int {
+func (r XealthGetProgramsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetMRNSettingsResponse struct {
+type ViewPDFReportResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *MrnSettingsV1
 }
 
 // Status returns HTTPResponse.Status
-func (r GetMRNSettingsResponse) Status() string {
+func (r ViewPDFReportResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8875,1540 +12601,1998 @@ func (r GetMRNSettingsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetMRNSettingsResponse) StatusCode() int {
+func (r ViewPDFReportResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
-	return 0
+	return 0
+}
+
+// InvalidateAuthenticationCacheWithBodyWithResponse request with arbitrary body returning *InvalidateAuthenticationCacheResponse
+func (c *ClientWithResponses) InvalidateAuthenticationCacheWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InvalidateAuthenticationCacheResponse, error) {
+	rsp, err := c.InvalidateAuthenticationCacheWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInvalidateAuthenticationCacheResponse(rsp)
+}
+
+func (c *ClientWithResponses) InvalidateAuthenticationCacheWithResponse(ctx context.Context, body InvalidateAuthenticationCacheJSONRequestBody, reqEditors ...RequestEditorFn) (*InvalidateAuthenticationCacheResponse, error) {
+	rsp, err := c.InvalidateAuthenticationCache(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInvalidateAuthenticationCacheResponse(rsp)
+}
+
+// EvaluateAuthorizationPolicyWithBodyWithResponse request with arbitrary body returning *EvaluateAuthorizationPolicyResponse
+func (c *ClientWithResponses) EvaluateAuthorizationPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EvaluateAuthorizationPolicyResponse, error) {
+	rsp, err := c.EvaluateAuthorizationPolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEvaluateAuthorizationPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) EvaluateAuthorizationPolicyWithResponse(ctx context.Context, body EvaluateAuthorizationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*EvaluateAuthorizationPolicyResponse, error) {
+	rsp, err := c.EvaluateAuthorizationPolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEvaluateAuthorizationPolicyResponse(rsp)
+}
+
+// ListAllCliniciansWithResponse request returning *ListAllCliniciansResponse
+func (c *ClientWithResponses) ListAllCliniciansWithResponse(ctx context.Context, params *ListAllCliniciansParams, reqEditors ...RequestEditorFn) (*ListAllCliniciansResponse, error) {
+	rsp, err := c.ListAllClinicians(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAllCliniciansResponse(rsp)
+}
+
+// ListClinicsForClinicianWithResponse request returning *ListClinicsForClinicianResponse
+func (c *ClientWithResponses) ListClinicsForClinicianWithResponse(ctx context.Context, userId UserId, params *ListClinicsForClinicianParams, reqEditors ...RequestEditorFn) (*ListClinicsForClinicianResponse, error) {
+	rsp, err := c.ListClinicsForClinician(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClinicsForClinicianResponse(rsp)
+}
+
+// EnableNewClinicExperienceWithResponse request returning *EnableNewClinicExperienceResponse
+func (c *ClientWithResponses) EnableNewClinicExperienceWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*EnableNewClinicExperienceResponse, error) {
+	rsp, err := c.EnableNewClinicExperience(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnableNewClinicExperienceResponse(rsp)
+}
+
+// ListClinicsWithResponse request returning *ListClinicsResponse
+func (c *ClientWithResponses) ListClinicsWithResponse(ctx context.Context, params *ListClinicsParams, reqEditors ...RequestEditorFn) (*ListClinicsResponse, error) {
+	rsp, err := c.ListClinics(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClinicsResponse(rsp)
+}
+
+// CreateClinicWithBodyWithResponse request with arbitrary body returning *CreateClinicResponse
+func (c *ClientWithResponses) CreateClinicWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClinicResponse, error) {
+	rsp, err := c.CreateClinicWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClinicResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateClinicWithResponse(ctx context.Context, body CreateClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClinicResponse, error) {
+	rsp, err := c.CreateClinic(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClinicResponse(rsp)
+}
+
+// GetClinicByShareCodeWithResponse request returning *GetClinicByShareCodeResponse
+func (c *ClientWithResponses) GetClinicByShareCodeWithResponse(ctx context.Context, shareCode string, reqEditors ...RequestEditorFn) (*GetClinicByShareCodeResponse, error) {
+	rsp, err := c.GetClinicByShareCode(ctx, shareCode, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClinicByShareCodeResponse(rsp)
+}
+
+// DeleteClinicWithResponse request returning *DeleteClinicResponse
+func (c *ClientWithResponses) DeleteClinicWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*DeleteClinicResponse, error) {
+	rsp, err := c.DeleteClinic(ctx, clinicId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteClinicResponse(rsp)
+}
+
+// GetClinicWithResponse request returning *GetClinicResponse
+func (c *ClientWithResponses) GetClinicWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetClinicResponse, error) {
+	rsp, err := c.GetClinic(ctx, clinicId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClinicResponse(rsp)
+}
+
+// UpdateClinicWithBodyWithResponse request with arbitrary body returning *UpdateClinicResponse
+func (c *ClientWithResponses) UpdateClinicWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicResponse, error) {
+	rsp, err := c.UpdateClinicWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClinicResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateClinicWithResponse(ctx context.Context, clinicId ClinicId, body UpdateClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicResponse, error) {
+	rsp, err := c.UpdateClinic(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClinicResponse(rsp)
+}
+
+// GetAccessRestrictionsWithResponse request returning *GetAccessRestrictionsResponse
+func (c *ClientWithResponses) GetAccessRestrictionsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetAccessRestrictionsResponse, error) {
+	rsp, err := c.GetAccessRestrictions(ctx, clinicId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAccessRestrictionsResponse(rsp)
+}
+
+// UpdateAccessRestrictionsWithBodyWithResponse request with arbitrary body returning *UpdateAccessRestrictionsResponse
+func (c *ClientWithResponses) UpdateAccessRestrictionsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAccessRestrictionsResponse, error) {
+	rsp, err := c.UpdateAccessRestrictionsWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAccessRestrictionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAccessRestrictionsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateAccessRestrictionsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAccessRestrictionsResponse, error) {
+	rsp, err := c.UpdateAccessRestrictions(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAccessRestrictionsResponse(rsp)
+}
+
+// ListAPIKeysWithResponse request returning *ListAPIKeysResponse
+func (c *ClientWithResponses) ListAPIKeysWithResponse(ctx context.Context, clinicId ClinicId, params *ListAPIKeysParams, reqEditors ...RequestEditorFn) (*ListAPIKeysResponse, error) {
+	rsp, err := c.ListAPIKeys(ctx, clinicId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAPIKeysResponse(rsp)
+}
+
+// CreateAPIKeyWithBodyWithResponse request with arbitrary body returning *CreateAPIKeyResponse
+func (c *ClientWithResponses) CreateAPIKeyWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error) {
+	rsp, err := c.CreateAPIKeyWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAPIKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAPIKeyWithResponse(ctx context.Context, clinicId ClinicId, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error) {
+	rsp, err := c.CreateAPIKey(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAPIKeyResponse(rsp)
+}
+
+// RevokeAPIKeyWithResponse request returning *RevokeAPIKeyResponse
+func (c *ClientWithResponses) RevokeAPIKeyWithResponse(ctx context.Context, clinicId ClinicId, apiKeyId ApiKeyId, reqEditors ...RequestEditorFn) (*RevokeAPIKeyResponse, error) {
+	rsp, err := c.RevokeAPIKey(ctx, clinicId, apiKeyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeAPIKeyResponse(rsp)
+}
+
+// ListAuditEventsWithResponse request returning *ListAuditEventsResponse
+func (c *ClientWithResponses) ListAuditEventsWithResponse(ctx context.Context, clinicId ClinicId, params *ListAuditEventsParams, reqEditors ...RequestEditorFn) (*ListAuditEventsResponse, error) {
+	rsp, err := c.ListAuditEvents(ctx, clinicId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAuditEventsResponse(rsp)
+}
+
+// ListClinicianRolesWithResponse request returning *ListClinicianRolesResponse
+func (c *ClientWithResponses) ListClinicianRolesWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*ListClinicianRolesResponse, error) {
+	rsp, err := c.ListClinicianRoles(ctx, clinicId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClinicianRolesResponse(rsp)
+}
+
+// UpdateClinicianRolesWithBodyWithResponse request with arbitrary body returning *UpdateClinicianRolesResponse
+func (c *ClientWithResponses) UpdateClinicianRolesWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicianRolesResponse, error) {
+	rsp, err := c.UpdateClinicianRolesWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClinicianRolesResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateClinicianRolesWithResponse(ctx context.Context, clinicId ClinicId, body UpdateClinicianRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicianRolesResponse, error) {
+	rsp, err := c.UpdateClinicianRoles(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClinicianRolesResponse(rsp)
 }
 
-type UpdateMRNSettingsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// ListCliniciansWithResponse request returning *ListCliniciansResponse
+func (c *ClientWithResponses) ListCliniciansWithResponse(ctx context.Context, clinicId ClinicId, params *ListCliniciansParams, reqEditors ...RequestEditorFn) (*ListCliniciansResponse, error) {
+	rsp, err := c.ListClinicians(ctx, clinicId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCliniciansResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateMRNSettingsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CreateClinicianWithBodyWithResponse request with arbitrary body returning *CreateClinicianResponse
+func (c *ClientWithResponses) CreateClinicianWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClinicianResponse, error) {
+	rsp, err := c.CreateClinicianWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateClinicianResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMRNSettingsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) CreateClinicianWithResponse(ctx context.Context, clinicId ClinicId, body CreateClinicianJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClinicianResponse, error) {
+	rsp, err := c.CreateClinician(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateClinicianResponse(rsp)
 }
 
-type GetPatientCountSettingsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PatientCountSettingsV1
+// DeleteClinicianWithResponse request returning *DeleteClinicianResponse
+func (c *ClientWithResponses) DeleteClinicianWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, reqEditors ...RequestEditorFn) (*DeleteClinicianResponse, error) {
+	rsp, err := c.DeleteClinician(ctx, clinicId, clinicianId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteClinicianResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetPatientCountSettingsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetClinicianWithResponse request returning *GetClinicianResponse
+func (c *ClientWithResponses) GetClinicianWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, reqEditors ...RequestEditorFn) (*GetClinicianResponse, error) {
+	rsp, err := c.GetClinician(ctx, clinicId, clinicianId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetClinicianResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPatientCountSettingsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UpdateClinicianWithBodyWithResponse request with arbitrary body returning *UpdateClinicianResponse
+func (c *ClientWithResponses) UpdateClinicianWithBodyWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicianResponse, error) {
+	rsp, err := c.UpdateClinicianWithBody(ctx, clinicId, clinicianId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateClinicianResponse(rsp)
 }
 
-type UpdatePatientCountSettingsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) UpdateClinicianWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, body UpdateClinicianJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicianResponse, error) {
+	rsp, err := c.UpdateClinician(ctx, clinicId, clinicianId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClinicianResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdatePatientCountSettingsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdateClinicianSitesWithBodyWithResponse request with arbitrary body returning *UpdateClinicianSitesResponse
+func (c *ClientWithResponses) UpdateClinicianSitesWithBodyWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicianSitesResponse, error) {
+	rsp, err := c.UpdateClinicianSitesWithBody(ctx, clinicId, clinicianId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateClinicianSitesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePatientCountSettingsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdateClinicianSitesWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, body UpdateClinicianSitesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicianSitesResponse, error) {
+	rsp, err := c.UpdateClinicianSites(ctx, clinicId, clinicianId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateClinicianSitesResponse(rsp)
 }
 
-type CreateSiteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SiteV1
+// ConsolidateClinicsWithBodyWithResponse request with arbitrary body returning *ConsolidateClinicsResponse
+func (c *ClientWithResponses) ConsolidateClinicsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConsolidateClinicsResponse, error) {
+	rsp, err := c.ConsolidateClinicsWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConsolidateClinicsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateSiteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) ConsolidateClinicsWithResponse(ctx context.Context, clinicId ClinicId, body ConsolidateClinicsJSONRequestBody, reqEditors ...RequestEditorFn) (*ConsolidateClinicsResponse, error) {
+	rsp, err := c.ConsolidateClinics(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseConsolidateClinicsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateSiteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetClinicConsolidationWithResponse request returning *GetClinicConsolidationResponse
+func (c *ClientWithResponses) GetClinicConsolidationWithResponse(ctx context.Context, clinicId ClinicId, consolidationId ConsolidationId, reqEditors ...RequestEditorFn) (*GetClinicConsolidationResponse, error) {
+	rsp, err := c.GetClinicConsolidation(ctx, clinicId, consolidationId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetClinicConsolidationResponse(rsp)
 }
 
-type DeleteSiteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// SyncEHRDataWithResponse request returning *SyncEHRDataResponse
+func (c *ClientWithResponses) SyncEHRDataWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*SyncEHRDataResponse, error) {
+	rsp, err := c.SyncEHRData(ctx, clinicId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSyncEHRDataResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteSiteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteInvitedClinicianWithResponse request returning *DeleteInvitedClinicianResponse
+func (c *ClientWithResponses) DeleteInvitedClinicianWithResponse(ctx context.Context, clinicId ClinicId, inviteId InviteId, reqEditors ...RequestEditorFn) (*DeleteInvitedClinicianResponse, error) {
+	rsp, err := c.DeleteInvitedClinician(ctx, clinicId, inviteId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteInvitedClinicianResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteSiteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetInvitedClinicianWithResponse request returning *GetInvitedClinicianResponse
+func (c *ClientWithResponses) GetInvitedClinicianWithResponse(ctx context.Context, clinicId ClinicId, inviteId InviteId, reqEditors ...RequestEditorFn) (*GetInvitedClinicianResponse, error) {
+	rsp, err := c.GetInvitedClinician(ctx, clinicId, inviteId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetInvitedClinicianResponse(rsp)
 }
 
-type UpdateSiteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SiteV1
+// AssociateClinicianToUserWithBodyWithResponse request with arbitrary body returning *AssociateClinicianToUserResponse
+func (c *ClientWithResponses) AssociateClinicianToUserWithBodyWithResponse(ctx context.Context, clinicId ClinicId, inviteId InviteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssociateClinicianToUserResponse, error) {
+	rsp, err := c.AssociateClinicianToUserWithBody(ctx, clinicId, inviteId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssociateClinicianToUserResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateSiteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) AssociateClinicianToUserWithResponse(ctx context.Context, clinicId ClinicId, inviteId InviteId, body AssociateClinicianToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AssociateClinicianToUserResponse, error) {
+	rsp, err := c.AssociateClinicianToUser(ctx, clinicId, inviteId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseAssociateClinicianToUserResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateSiteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ListMembershipRestrictionsWithResponse request returning *ListMembershipRestrictionsResponse
+func (c *ClientWithResponses) ListMembershipRestrictionsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*ListMembershipRestrictionsResponse, error) {
+	rsp, err := c.ListMembershipRestrictions(ctx, clinicId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseListMembershipRestrictionsResponse(rsp)
 }
 
-type MergeSiteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SiteV1
+// UpdateMembershipRestrictionsWithBodyWithResponse request with arbitrary body returning *UpdateMembershipRestrictionsResponse
+func (c *ClientWithResponses) UpdateMembershipRestrictionsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMembershipRestrictionsResponse, error) {
+	rsp, err := c.UpdateMembershipRestrictionsWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMembershipRestrictionsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r MergeSiteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UpdateMembershipRestrictionsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateMembershipRestrictionsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMembershipRestrictionsResponse, error) {
+	rsp, err := c.UpdateMembershipRestrictions(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateMembershipRestrictionsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MergeSiteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// MergeClinicWithBodyWithResponse request with arbitrary body returning *MergeClinicResponse
+func (c *ClientWithResponses) MergeClinicWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeClinicResponse, error) {
+	rsp, err := c.MergeClinicWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseMergeClinicResponse(rsp)
 }
 
-type UpdateSuppressedNotificationsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) MergeClinicWithResponse(ctx context.Context, clinicId ClinicId, body MergeClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeClinicResponse, error) {
+	rsp, err := c.MergeClinic(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMergeClinicResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateSuppressedNotificationsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetClinicMergeWithResponse request returning *GetClinicMergeResponse
+func (c *ClientWithResponses) GetClinicMergeWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*GetClinicMergeResponse, error) {
+	rsp, err := c.GetClinicMerge(ctx, clinicId, planId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetClinicMergeResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateSuppressedNotificationsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ListClinicMergeDuplicatesWithResponse request returning *ListClinicMergeDuplicatesResponse
+func (c *ClientWithResponses) ListClinicMergeDuplicatesWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*ListClinicMergeDuplicatesResponse, error) {
+	rsp, err := c.ListClinicMergeDuplicates(ctx, clinicId, planId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseListClinicMergeDuplicatesResponse(rsp)
 }
 
-type TideReportResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TideResponseV1
+// ResolveClinicMergeDuplicateWithBodyWithResponse request with arbitrary body returning *ResolveClinicMergeDuplicateResponse
+func (c *ClientWithResponses) ResolveClinicMergeDuplicateWithBodyWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, duplicateId DuplicateId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResolveClinicMergeDuplicateResponse, error) {
+	rsp, err := c.ResolveClinicMergeDuplicateWithBody(ctx, clinicId, planId, duplicateId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResolveClinicMergeDuplicateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r TideReportResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) ResolveClinicMergeDuplicateWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, duplicateId DuplicateId, body ResolveClinicMergeDuplicateJSONRequestBody, reqEditors ...RequestEditorFn) (*ResolveClinicMergeDuplicateResponse, error) {
+	rsp, err := c.ResolveClinicMergeDuplicate(ctx, clinicId, planId, duplicateId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseResolveClinicMergeDuplicateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TideReportResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PreflightClinicMergeRollbackWithResponse request returning *PreflightClinicMergeRollbackResponse
+func (c *ClientWithResponses) PreflightClinicMergeRollbackWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*PreflightClinicMergeRollbackResponse, error) {
+	rsp, err := c.PreflightClinicMergeRollback(ctx, clinicId, planId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePreflightClinicMergeRollbackResponse(rsp)
 }
 
-type UpdateTierResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// RollbackClinicMergeWithResponse request returning *RollbackClinicMergeResponse
+func (c *ClientWithResponses) RollbackClinicMergeWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*RollbackClinicMergeResponse, error) {
+	rsp, err := c.RollbackClinicMerge(ctx, clinicId, planId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRollbackClinicMergeResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateTierResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// StartClinicMergeWithResponse request returning *StartClinicMergeResponse
+func (c *ClientWithResponses) StartClinicMergeWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*StartClinicMergeResponse, error) {
+	rsp, err := c.StartClinicMerge(ctx, clinicId, planId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseStartClinicMergeResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTierResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TriggerInitialMigrationWithBodyWithResponse request with arbitrary body returning *TriggerInitialMigrationResponse
+func (c *ClientWithResponses) TriggerInitialMigrationWithBodyWithResponse(ctx context.Context, clinicId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TriggerInitialMigrationResponse, error) {
+	rsp, err := c.TriggerInitialMigrationWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTriggerInitialMigrationResponse(rsp)
 }
 
-type FindPatientsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PatientClinicRelationshipsV1
+func (c *ClientWithResponses) TriggerInitialMigrationWithResponse(ctx context.Context, clinicId string, body TriggerInitialMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*TriggerInitialMigrationResponse, error) {
+	rsp, err := c.TriggerInitialMigration(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTriggerInitialMigrationResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r FindPatientsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ListMigrationsWithResponse request returning *ListMigrationsResponse
+func (c *ClientWithResponses) ListMigrationsWithResponse(ctx context.Context, clinicId string, reqEditors ...RequestEditorFn) (*ListMigrationsResponse, error) {
+	rsp, err := c.ListMigrations(ctx, clinicId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseListMigrationsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindPatientsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// MigrateLegacyClinicianPatientsWithBodyWithResponse request with arbitrary body returning *MigrateLegacyClinicianPatientsResponse
+func (c *ClientWithResponses) MigrateLegacyClinicianPatientsWithBodyWithResponse(ctx context.Context, clinicId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MigrateLegacyClinicianPatientsResponse, error) {
+	rsp, err := c.MigrateLegacyClinicianPatientsWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseMigrateLegacyClinicianPatientsResponse(rsp)
 }
 
-type SyncEHRDataForPatientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) MigrateLegacyClinicianPatientsWithResponse(ctx context.Context, clinicId string, body MigrateLegacyClinicianPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*MigrateLegacyClinicianPatientsResponse, error) {
+	rsp, err := c.MigrateLegacyClinicianPatients(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMigrateLegacyClinicianPatientsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r SyncEHRDataForPatientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetMigrationWithResponse request returning *GetMigrationResponse
+func (c *ClientWithResponses) GetMigrationWithResponse(ctx context.Context, clinicId ClinicIdV1, userId UserId, reqEditors ...RequestEditorFn) (*GetMigrationResponse, error) {
+	rsp, err := c.GetMigration(ctx, clinicId, userId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetMigrationResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SyncEHRDataForPatientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UpdateMigrationWithBodyWithResponse request with arbitrary body returning *UpdateMigrationResponse
+func (c *ClientWithResponses) UpdateMigrationWithBodyWithResponse(ctx context.Context, clinicId ClinicIdV1, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMigrationResponse, error) {
+	rsp, err := c.UpdateMigrationWithBody(ctx, clinicId, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateMigrationResponse(rsp)
 }
 
-type UpdatePatientSummaryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) UpdateMigrationWithResponse(ctx context.Context, clinicId ClinicIdV1, userId UserId, body UpdateMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMigrationResponse, error) {
+	rsp, err := c.UpdateMigration(ctx, clinicId, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMigrationResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdatePatientSummaryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// RetryMigrationWithResponse request returning *RetryMigrationResponse
+func (c *ClientWithResponses) RetryMigrationWithResponse(ctx context.Context, clinicId ClinicIdV1, userId UserId, reqEditors ...RequestEditorFn) (*RetryMigrationResponse, error) {
+	rsp, err := c.RetryMigration(ctx, clinicId, userId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseRetryMigrationResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePatientSummaryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetPatientCountWithResponse request returning *GetPatientCountResponse
+func (c *ClientWithResponses) GetPatientCountWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetPatientCountResponse, error) {
+	rsp, err := c.GetPatientCount(ctx, clinicId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetPatientCountResponse(rsp)
 }
 
-type ListClinicsForPatientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PatientClinicRelationshipsV1
+// RefreshPatientCountWithResponse request returning *RefreshPatientCountResponse
+func (c *ClientWithResponses) RefreshPatientCountWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*RefreshPatientCountResponse, error) {
+	rsp, err := c.RefreshPatientCount(ctx, clinicId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshPatientCountResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListClinicsForPatientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetPatientDuplicatesWithResponse request returning *GetPatientDuplicatesResponse
+func (c *ClientWithResponses) GetPatientDuplicatesWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetPatientDuplicatesResponse, error) {
+	rsp, err := c.GetPatientDuplicates(ctx, clinicId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetPatientDuplicatesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListClinicsForPatientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// MergePatientDuplicateWithBodyWithResponse request with arbitrary body returning *MergePatientDuplicateResponse
+func (c *ClientWithResponses) MergePatientDuplicateWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergePatientDuplicateResponse, error) {
+	rsp, err := c.MergePatientDuplicateWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseMergePatientDuplicateResponse(rsp)
 }
 
-type UpdatePatientDataSourcesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) MergePatientDuplicateWithResponse(ctx context.Context, clinicId ClinicId, body MergePatientDuplicateJSONRequestBody, reqEditors ...RequestEditorFn) (*MergePatientDuplicateResponse, error) {
+	rsp, err := c.MergePatientDuplicate(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMergePatientDuplicateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdatePatientDataSourcesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ScanPatientDuplicatesWithResponse request returning *ScanPatientDuplicatesResponse
+func (c *ClientWithResponses) ScanPatientDuplicatesWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*ScanPatientDuplicatesResponse, error) {
+	rsp, err := c.ScanPatientDuplicates(ctx, clinicId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseScanPatientDuplicatesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePatientDataSourcesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreatePatientTagWithBodyWithResponse request with arbitrary body returning *CreatePatientTagResponse
+func (c *ClientWithResponses) CreatePatientTagWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePatientTagResponse, error) {
+	rsp, err := c.CreatePatientTagWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreatePatientTagResponse(rsp)
 }
 
-type ProcessEHRMessageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) CreatePatientTagWithResponse(ctx context.Context, clinicId ClinicId, body CreatePatientTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePatientTagResponse, error) {
+	rsp, err := c.CreatePatientTag(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePatientTagResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ProcessEHRMessageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeletePatientTagWithResponse request returning *DeletePatientTagResponse
+func (c *ClientWithResponses) DeletePatientTagWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, reqEditors ...RequestEditorFn) (*DeletePatientTagResponse, error) {
+	rsp, err := c.DeletePatientTag(ctx, clinicId, patientTagId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeletePatientTagResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ProcessEHRMessageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UpdatePatientTagWithBodyWithResponse request with arbitrary body returning *UpdatePatientTagResponse
+func (c *ClientWithResponses) UpdatePatientTagWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientTagResponse, error) {
+	rsp, err := c.UpdatePatientTagWithBody(ctx, clinicId, patientTagId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdatePatientTagResponse(rsp)
 }
 
-type MatchClinicAndPatientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *EhrMatchResponseV1
+func (c *ClientWithResponses) UpdatePatientTagWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body UpdatePatientTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientTagResponse, error) {
+	rsp, err := c.UpdatePatientTag(ctx, clinicId, patientTagId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePatientTagResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r MatchClinicAndPatientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ConvertPatientTagToSiteWithResponse request returning *ConvertPatientTagToSiteResponse
+func (c *ClientWithResponses) ConvertPatientTagToSiteWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, reqEditors ...RequestEditorFn) (*ConvertPatientTagToSiteResponse, error) {
+	rsp, err := c.ConvertPatientTagToSite(ctx, clinicId, patientTagId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseConvertPatientTagToSiteResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MatchClinicAndPatientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ListPatientsWithResponse request returning *ListPatientsResponse
+func (c *ClientWithResponses) ListPatientsWithResponse(ctx context.Context, clinicId ClinicId, params *ListPatientsParams, reqEditors ...RequestEditorFn) (*ListPatientsResponse, error) {
+	rsp, err := c.ListPatients(ctx, clinicId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseListPatientsResponse(rsp)
 }
 
-type VerifyEndpointResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// CreatePatientAccountWithBodyWithResponse request with arbitrary body returning *CreatePatientAccountResponse
+func (c *ClientWithResponses) CreatePatientAccountWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePatientAccountResponse, error) {
+	rsp, err := c.CreatePatientAccountWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePatientAccountResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r VerifyEndpointResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CreatePatientAccountWithResponse(ctx context.Context, clinicId ClinicId, body CreatePatientAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePatientAccountResponse, error) {
+	rsp, err := c.CreatePatientAccount(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreatePatientAccountResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r VerifyEndpointResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// AssignPatientTagToClinicPatientsWithBodyWithResponse request with arbitrary body returning *AssignPatientTagToClinicPatientsResponse
+func (c *ClientWithResponses) AssignPatientTagToClinicPatientsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignPatientTagToClinicPatientsResponse, error) {
+	rsp, err := c.AssignPatientTagToClinicPatientsWithBody(ctx, clinicId, patientTagId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseAssignPatientTagToClinicPatientsResponse(rsp)
 }
 
-type DeletePatientSummaryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) AssignPatientTagToClinicPatientsWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body AssignPatientTagToClinicPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignPatientTagToClinicPatientsResponse, error) {
+	rsp, err := c.AssignPatientTagToClinicPatients(ctx, clinicId, patientTagId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssignPatientTagToClinicPatientsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeletePatientSummaryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeletePatientTagFromClinicPatientsWithBodyWithResponse request with arbitrary body returning *DeletePatientTagFromClinicPatientsResponse
+func (c *ClientWithResponses) DeletePatientTagFromClinicPatientsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeletePatientTagFromClinicPatientsResponse, error) {
+	rsp, err := c.DeletePatientTagFromClinicPatientsWithBody(ctx, clinicId, patientTagId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeletePatientTagFromClinicPatientsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeletePatientSummaryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) DeletePatientTagFromClinicPatientsWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body DeletePatientTagFromClinicPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*DeletePatientTagFromClinicPatientsResponse, error) {
+	rsp, err := c.DeletePatientTagFromClinicPatients(ctx, clinicId, patientTagId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDeletePatientTagFromClinicPatientsResponse(rsp)
 }
 
-type DeleteUserFromClinicsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// DeletePatientWithResponse request returning *DeletePatientResponse
+func (c *ClientWithResponses) DeletePatientWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*DeletePatientResponse, error) {
+	rsp, err := c.DeletePatient(ctx, clinicId, patientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePatientResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteUserFromClinicsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetPatientWithResponse request returning *GetPatientResponse
+func (c *ClientWithResponses) GetPatientWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*GetPatientResponse, error) {
+	rsp, err := c.GetPatient(ctx, clinicId, patientId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetPatientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteUserFromClinicsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreatePatientFromUserWithBodyWithResponse request with arbitrary body returning *CreatePatientFromUserResponse
+func (c *ClientWithResponses) CreatePatientFromUserWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePatientFromUserResponse, error) {
+	rsp, err := c.CreatePatientFromUserWithBody(ctx, clinicId, patientId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreatePatientFromUserResponse(rsp)
 }
 
-type UpdateClinicUserDetailsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) CreatePatientFromUserWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, body CreatePatientFromUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePatientFromUserResponse, error) {
+	rsp, err := c.CreatePatientFromUser(ctx, clinicId, patientId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePatientFromUserResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateClinicUserDetailsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdatePatientWithBodyWithResponse request with arbitrary body returning *UpdatePatientResponse
+func (c *ClientWithResponses) UpdatePatientWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientResponse, error) {
+	rsp, err := c.UpdatePatientWithBody(ctx, clinicId, patientId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdatePatientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateClinicUserDetailsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdatePatientWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, body UpdatePatientJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientResponse, error) {
+	rsp, err := c.UpdatePatient(ctx, clinicId, patientId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdatePatientResponse(rsp)
 }
 
-type XealthNotificationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// ConnectProviderWithResponse request returning *ConnectProviderResponse
+func (c *ClientWithResponses) ConnectProviderWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, providerId ProviderId, reqEditors ...RequestEditorFn) (*ConnectProviderResponse, error) {
+	rsp, err := c.ConnectProvider(ctx, clinicId, patientId, providerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConnectProviderResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r XealthNotificationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdatePatientPermissionsWithBodyWithResponse request with arbitrary body returning *UpdatePatientPermissionsResponse
+func (c *ClientWithResponses) UpdatePatientPermissionsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientPermissionsResponse, error) {
+	rsp, err := c.UpdatePatientPermissionsWithBody(ctx, clinicId, patientId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdatePatientPermissionsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r XealthNotificationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdatePatientPermissionsWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, body UpdatePatientPermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientPermissionsResponse, error) {
+	rsp, err := c.UpdatePatientPermissions(ctx, clinicId, patientId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdatePatientPermissionsResponse(rsp)
 }
 
-type XealthPreorderResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// DeletePatientPermissionWithResponse request returning *DeletePatientPermissionResponse
+func (c *ClientWithResponses) DeletePatientPermissionWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, permission string, reqEditors ...RequestEditorFn) (*DeletePatientPermissionResponse, error) {
+	rsp, err := c.DeletePatientPermission(ctx, clinicId, patientId, permission, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePatientPermissionResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r XealthPreorderResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeletePatientReviewsWithResponse request returning *DeletePatientReviewsResponse
+func (c *ClientWithResponses) DeletePatientReviewsWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*DeletePatientReviewsResponse, error) {
+	rsp, err := c.DeletePatientReviews(ctx, clinicId, patientId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeletePatientReviewsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r XealthPreorderResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UpdatePatientReviewsWithResponse request returning *UpdatePatientReviewsResponse
+func (c *ClientWithResponses) UpdatePatientReviewsWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*UpdatePatientReviewsResponse, error) {
+	rsp, err := c.UpdatePatientReviews(ctx, clinicId, patientId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdatePatientReviewsResponse(rsp)
 }
 
-type XealthGetProgramUrlResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// SendUploadReminderWithResponse request returning *SendUploadReminderResponse
+func (c *ClientWithResponses) SendUploadReminderWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*SendUploadReminderResponse, error) {
+	rsp, err := c.SendUploadReminder(ctx, clinicId, patientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSendUploadReminderResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r XealthGetProgramUrlResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GenerateConsolidationReportWithBodyWithResponse request with arbitrary body returning *GenerateConsolidationReportResponse
+func (c *ClientWithResponses) GenerateConsolidationReportWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GenerateConsolidationReportResponse, error) {
+	rsp, err := c.GenerateConsolidationReportWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGenerateConsolidationReportResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r XealthGetProgramUrlResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) GenerateConsolidationReportWithResponse(ctx context.Context, clinicId ClinicId, body GenerateConsolidationReportJSONRequestBody, reqEditors ...RequestEditorFn) (*GenerateConsolidationReportResponse, error) {
+	rsp, err := c.GenerateConsolidationReport(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGenerateConsolidationReportResponse(rsp)
 }
 
-type XealthGetProgramsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GenerateMergeReportWithBodyWithResponse request with arbitrary body returning *GenerateMergeReportResponse
+func (c *ClientWithResponses) GenerateMergeReportWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GenerateMergeReportResponse, error) {
+	rsp, err := c.GenerateMergeReportWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGenerateMergeReportResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r XealthGetProgramsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) GenerateMergeReportWithResponse(ctx context.Context, clinicId ClinicId, body GenerateMergeReportJSONRequestBody, reqEditors ...RequestEditorFn) (*GenerateMergeReportResponse, error) {
+	rsp, err := c.GenerateMergeReport(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGenerateMergeReportResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r XealthGetProgramsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GenerateSplitReportWithBodyWithResponse request with arbitrary body returning *GenerateSplitReportResponse
+func (c *ClientWithResponses) GenerateSplitReportWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GenerateSplitReportResponse, error) {
+	rsp, err := c.GenerateSplitReportWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGenerateSplitReportResponse(rsp)
 }
 
-type ViewPDFReportResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) GenerateSplitReportWithResponse(ctx context.Context, clinicId ClinicId, body GenerateSplitReportJSONRequestBody, reqEditors ...RequestEditorFn) (*GenerateSplitReportResponse, error) {
+	rsp, err := c.GenerateSplitReport(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGenerateSplitReportResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ViewPDFReportResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// AddServiceAccountWithBodyWithResponse request with arbitrary body returning *AddServiceAccountResponse
+func (c *ClientWithResponses) AddServiceAccountWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddServiceAccountResponse, error) {
+	rsp, err := c.AddServiceAccountWithBody(ctx, clinicId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseAddServiceAccountResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ViewPDFReportResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) AddServiceAccountWithResponse(ctx context.Context, clinicId ClinicId, body AddServiceAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*AddServiceAccountResponse, error) {
+	rsp, err := c.AddServiceAccount(ctx, clinicId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseAddServiceAccountResponse(rsp)
 }
 
-// ListAllCliniciansWithResponse request returning *ListAllCliniciansResponse
-func (c *ClientWithResponses) ListAllCliniciansWithResponse(ctx context.Context, params *ListAllCliniciansParams, reqEditors ...RequestEditorFn) (*ListAllCliniciansResponse, error) {
-	rsp, err := c.ListAllClinicians(ctx, params, reqEditors...)
+// GetEHRSettingsWithResponse request returning *GetEHRSettingsResponse
+func (c *ClientWithResponses) GetEHRSettingsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetEHRSettingsResponse, error) {
+	rsp, err := c.GetEHRSettings(ctx, clinicId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListAllCliniciansResponse(rsp)
+	return ParseGetEHRSettingsResponse(rsp)
 }
 
-// ListClinicsForClinicianWithResponse request returning *ListClinicsForClinicianResponse
-func (c *ClientWithResponses) ListClinicsForClinicianWithResponse(ctx context.Context, userId UserId, params *ListClinicsForClinicianParams, reqEditors ...RequestEditorFn) (*ListClinicsForClinicianResponse, error) {
-	rsp, err := c.ListClinicsForClinician(ctx, userId, params, reqEditors...)
+// UpdateEHRSettingsWithBodyWithResponse request with arbitrary body returning *UpdateEHRSettingsResponse
+func (c *ClientWithResponses) UpdateEHRSettingsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEHRSettingsResponse, error) {
+	rsp, err := c.UpdateEHRSettingsWithBody(ctx, clinicId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListClinicsForClinicianResponse(rsp)
+	return ParseUpdateEHRSettingsResponse(rsp)
 }
 
-// EnableNewClinicExperienceWithResponse request returning *EnableNewClinicExperienceResponse
-func (c *ClientWithResponses) EnableNewClinicExperienceWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*EnableNewClinicExperienceResponse, error) {
-	rsp, err := c.EnableNewClinicExperience(ctx, userId, reqEditors...)
+func (c *ClientWithResponses) UpdateEHRSettingsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateEHRSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEHRSettingsResponse, error) {
+	rsp, err := c.UpdateEHRSettings(ctx, clinicId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEnableNewClinicExperienceResponse(rsp)
+	return ParseUpdateEHRSettingsResponse(rsp)
 }
 
-// ListClinicsWithResponse request returning *ListClinicsResponse
-func (c *ClientWithResponses) ListClinicsWithResponse(ctx context.Context, params *ListClinicsParams, reqEditors ...RequestEditorFn) (*ListClinicsResponse, error) {
-	rsp, err := c.ListClinics(ctx, params, reqEditors...)
+// GetMRNSettingsWithResponse request returning *GetMRNSettingsResponse
+func (c *ClientWithResponses) GetMRNSettingsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetMRNSettingsResponse, error) {
+	rsp, err := c.GetMRNSettings(ctx, clinicId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListClinicsResponse(rsp)
+	return ParseGetMRNSettingsResponse(rsp)
 }
 
-// CreateClinicWithBodyWithResponse request with arbitrary body returning *CreateClinicResponse
-func (c *ClientWithResponses) CreateClinicWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClinicResponse, error) {
-	rsp, err := c.CreateClinicWithBody(ctx, contentType, body, reqEditors...)
+// UpdateMRNSettingsWithBodyWithResponse request with arbitrary body returning *UpdateMRNSettingsResponse
+func (c *ClientWithResponses) UpdateMRNSettingsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMRNSettingsResponse, error) {
+	rsp, err := c.UpdateMRNSettingsWithBody(ctx, clinicId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateClinicResponse(rsp)
+	return ParseUpdateMRNSettingsResponse(rsp)
 }
 
-func (c *ClientWithResponses) CreateClinicWithResponse(ctx context.Context, body CreateClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClinicResponse, error) {
-	rsp, err := c.CreateClinic(ctx, body, reqEditors...)
+func (c *ClientWithResponses) UpdateMRNSettingsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateMRNSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMRNSettingsResponse, error) {
+	rsp, err := c.UpdateMRNSettings(ctx, clinicId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateClinicResponse(rsp)
+	return ParseUpdateMRNSettingsResponse(rsp)
 }
 
-// GetClinicByShareCodeWithResponse request returning *GetClinicByShareCodeResponse
-func (c *ClientWithResponses) GetClinicByShareCodeWithResponse(ctx context.Context, shareCode string, reqEditors ...RequestEditorFn) (*GetClinicByShareCodeResponse, error) {
-	rsp, err := c.GetClinicByShareCode(ctx, shareCode, reqEditors...)
+// GetPatientCountSettingsWithResponse request returning *GetPatientCountSettingsResponse
+func (c *ClientWithResponses) GetPatientCountSettingsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetPatientCountSettingsResponse, error) {
+	rsp, err := c.GetPatientCountSettings(ctx, clinicId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetClinicByShareCodeResponse(rsp)
+	return ParseGetPatientCountSettingsResponse(rsp)
 }
 
-// DeleteClinicWithResponse request returning *DeleteClinicResponse
-func (c *ClientWithResponses) DeleteClinicWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*DeleteClinicResponse, error) {
-	rsp, err := c.DeleteClinic(ctx, clinicId, reqEditors...)
+// UpdatePatientCountSettingsWithBodyWithResponse request with arbitrary body returning *UpdatePatientCountSettingsResponse
+func (c *ClientWithResponses) UpdatePatientCountSettingsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientCountSettingsResponse, error) {
+	rsp, err := c.UpdatePatientCountSettingsWithBody(ctx, clinicId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteClinicResponse(rsp)
+	return ParseUpdatePatientCountSettingsResponse(rsp)
 }
 
-// GetClinicWithResponse request returning *GetClinicResponse
-func (c *ClientWithResponses) GetClinicWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetClinicResponse, error) {
-	rsp, err := c.GetClinic(ctx, clinicId, reqEditors...)
+func (c *ClientWithResponses) UpdatePatientCountSettingsWithResponse(ctx context.Context, clinicId ClinicId, body UpdatePatientCountSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientCountSettingsResponse, error) {
+	rsp, err := c.UpdatePatientCountSettings(ctx, clinicId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetClinicResponse(rsp)
+	return ParseUpdatePatientCountSettingsResponse(rsp)
 }
 
-// UpdateClinicWithBodyWithResponse request with arbitrary body returning *UpdateClinicResponse
-func (c *ClientWithResponses) UpdateClinicWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicResponse, error) {
-	rsp, err := c.UpdateClinicWithBody(ctx, clinicId, contentType, body, reqEditors...)
+// CreateSiteWithBodyWithResponse request with arbitrary body returning *CreateSiteResponse
+func (c *ClientWithResponses) CreateSiteWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSiteResponse, error) {
+	rsp, err := c.CreateSiteWithBody(ctx, clinicId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateClinicResponse(rsp)
+	return ParseCreateSiteResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdateClinicWithResponse(ctx context.Context, clinicId ClinicId, body UpdateClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicResponse, error) {
-	rsp, err := c.UpdateClinic(ctx, clinicId, body, reqEditors...)
+func (c *ClientWithResponses) CreateSiteWithResponse(ctx context.Context, clinicId ClinicId, body CreateSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSiteResponse, error) {
+	rsp, err := c.CreateSite(ctx, clinicId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateClinicResponse(rsp)
+	return ParseCreateSiteResponse(rsp)
 }
 
-// ListCliniciansWithResponse request returning *ListCliniciansResponse
-func (c *ClientWithResponses) ListCliniciansWithResponse(ctx context.Context, clinicId ClinicId, params *ListCliniciansParams, reqEditors ...RequestEditorFn) (*ListCliniciansResponse, error) {
-	rsp, err := c.ListClinicians(ctx, clinicId, params, reqEditors...)
+// DeleteSiteWithResponse request returning *DeleteSiteResponse
+func (c *ClientWithResponses) DeleteSiteWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, reqEditors ...RequestEditorFn) (*DeleteSiteResponse, error) {
+	rsp, err := c.DeleteSite(ctx, clinicId, siteId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListCliniciansResponse(rsp)
+	return ParseDeleteSiteResponse(rsp)
 }
 
-// CreateClinicianWithBodyWithResponse request with arbitrary body returning *CreateClinicianResponse
-func (c *ClientWithResponses) CreateClinicianWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClinicianResponse, error) {
-	rsp, err := c.CreateClinicianWithBody(ctx, clinicId, contentType, body, reqEditors...)
+// UpdateSiteWithBodyWithResponse request with arbitrary body returning *UpdateSiteResponse
+func (c *ClientWithResponses) UpdateSiteWithBodyWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSiteResponse, error) {
+	rsp, err := c.UpdateSiteWithBody(ctx, clinicId, siteId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateClinicianResponse(rsp)
+	return ParseUpdateSiteResponse(rsp)
 }
 
-func (c *ClientWithResponses) CreateClinicianWithResponse(ctx context.Context, clinicId ClinicId, body CreateClinicianJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClinicianResponse, error) {
-	rsp, err := c.CreateClinician(ctx, clinicId, body, reqEditors...)
+func (c *ClientWithResponses) UpdateSiteWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSiteResponse, error) {
+	rsp, err := c.UpdateSite(ctx, clinicId, siteId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateClinicianResponse(rsp)
+	return ParseUpdateSiteResponse(rsp)
 }
 
-// DeleteClinicianWithResponse request returning *DeleteClinicianResponse
-func (c *ClientWithResponses) DeleteClinicianWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, reqEditors ...RequestEditorFn) (*DeleteClinicianResponse, error) {
-	rsp, err := c.DeleteClinician(ctx, clinicId, clinicianId, reqEditors...)
+// MergeSiteWithBodyWithResponse request with arbitrary body returning *MergeSiteResponse
+func (c *ClientWithResponses) MergeSiteWithBodyWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeSiteResponse, error) {
+	rsp, err := c.MergeSiteWithBody(ctx, clinicId, siteId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteClinicianResponse(rsp)
+	return ParseMergeSiteResponse(rsp)
 }
 
-// GetClinicianWithResponse request returning *GetClinicianResponse
-func (c *ClientWithResponses) GetClinicianWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, reqEditors ...RequestEditorFn) (*GetClinicianResponse, error) {
-	rsp, err := c.GetClinician(ctx, clinicId, clinicianId, reqEditors...)
+func (c *ClientWithResponses) MergeSiteWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, body MergeSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeSiteResponse, error) {
+	rsp, err := c.MergeSite(ctx, clinicId, siteId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetClinicianResponse(rsp)
+	return ParseMergeSiteResponse(rsp)
 }
 
-// UpdateClinicianWithBodyWithResponse request with arbitrary body returning *UpdateClinicianResponse
-func (c *ClientWithResponses) UpdateClinicianWithBodyWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicianResponse, error) {
-	rsp, err := c.UpdateClinicianWithBody(ctx, clinicId, clinicianId, contentType, body, reqEditors...)
+// UpdateSiteParentWithBodyWithResponse request with arbitrary body returning *UpdateSiteParentResponse
+func (c *ClientWithResponses) UpdateSiteParentWithBodyWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSiteParentResponse, error) {
+	rsp, err := c.UpdateSiteParentWithBody(ctx, clinicId, siteId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateClinicianResponse(rsp)
+	return ParseUpdateSiteParentResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdateClinicianWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, body UpdateClinicianJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicianResponse, error) {
-	rsp, err := c.UpdateClinician(ctx, clinicId, clinicianId, body, reqEditors...)
+func (c *ClientWithResponses) UpdateSiteParentWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteParentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSiteParentResponse, error) {
+	rsp, err := c.UpdateSiteParent(ctx, clinicId, siteId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateClinicianResponse(rsp)
+	return ParseUpdateSiteParentResponse(rsp)
 }
 
-// SyncEHRDataWithResponse request returning *SyncEHRDataResponse
-func (c *ClientWithResponses) SyncEHRDataWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*SyncEHRDataResponse, error) {
-	rsp, err := c.SyncEHRData(ctx, clinicId, reqEditors...)
+// UpdateSiteSettingsWithBodyWithResponse request with arbitrary body returning *UpdateSiteSettingsResponse
+func (c *ClientWithResponses) UpdateSiteSettingsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSiteSettingsResponse, error) {
+	rsp, err := c.UpdateSiteSettingsWithBody(ctx, clinicId, siteId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSyncEHRDataResponse(rsp)
+	return ParseUpdateSiteSettingsResponse(rsp)
 }
 
-// DeleteInvitedClinicianWithResponse request returning *DeleteInvitedClinicianResponse
-func (c *ClientWithResponses) DeleteInvitedClinicianWithResponse(ctx context.Context, clinicId ClinicId, inviteId InviteId, reqEditors ...RequestEditorFn) (*DeleteInvitedClinicianResponse, error) {
-	rsp, err := c.DeleteInvitedClinician(ctx, clinicId, inviteId, reqEditors...)
+func (c *ClientWithResponses) UpdateSiteSettingsWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSiteSettingsResponse, error) {
+	rsp, err := c.UpdateSiteSettings(ctx, clinicId, siteId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteInvitedClinicianResponse(rsp)
+	return ParseUpdateSiteSettingsResponse(rsp)
 }
 
-// GetInvitedClinicianWithResponse request returning *GetInvitedClinicianResponse
-func (c *ClientWithResponses) GetInvitedClinicianWithResponse(ctx context.Context, clinicId ClinicId, inviteId InviteId, reqEditors ...RequestEditorFn) (*GetInvitedClinicianResponse, error) {
-	rsp, err := c.GetInvitedClinician(ctx, clinicId, inviteId, reqEditors...)
+// SplitClinicWithBodyWithResponse request with arbitrary body returning *SplitClinicResponse
+func (c *ClientWithResponses) SplitClinicWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SplitClinicResponse, error) {
+	rsp, err := c.SplitClinicWithBody(ctx, clinicId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetInvitedClinicianResponse(rsp)
+	return ParseSplitClinicResponse(rsp)
 }
 
-// AssociateClinicianToUserWithBodyWithResponse request with arbitrary body returning *AssociateClinicianToUserResponse
-func (c *ClientWithResponses) AssociateClinicianToUserWithBodyWithResponse(ctx context.Context, clinicId ClinicId, inviteId InviteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssociateClinicianToUserResponse, error) {
-	rsp, err := c.AssociateClinicianToUserWithBody(ctx, clinicId, inviteId, contentType, body, reqEditors...)
+func (c *ClientWithResponses) SplitClinicWithResponse(ctx context.Context, clinicId ClinicId, body SplitClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*SplitClinicResponse, error) {
+	rsp, err := c.SplitClinic(ctx, clinicId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAssociateClinicianToUserResponse(rsp)
+	return ParseSplitClinicResponse(rsp)
 }
 
-func (c *ClientWithResponses) AssociateClinicianToUserWithResponse(ctx context.Context, clinicId ClinicId, inviteId InviteId, body AssociateClinicianToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AssociateClinicianToUserResponse, error) {
-	rsp, err := c.AssociateClinicianToUser(ctx, clinicId, inviteId, body, reqEditors...)
+// UpdateSuppressedNotificationsWithBodyWithResponse request with arbitrary body returning *UpdateSuppressedNotificationsResponse
+func (c *ClientWithResponses) UpdateSuppressedNotificationsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSuppressedNotificationsResponse, error) {
+	rsp, err := c.UpdateSuppressedNotificationsWithBody(ctx, clinicId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAssociateClinicianToUserResponse(rsp)
+	return ParseUpdateSuppressedNotificationsResponse(rsp)
 }
 
-// ListMembershipRestrictionsWithResponse request returning *ListMembershipRestrictionsResponse
-func (c *ClientWithResponses) ListMembershipRestrictionsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*ListMembershipRestrictionsResponse, error) {
-	rsp, err := c.ListMembershipRestrictions(ctx, clinicId, reqEditors...)
+func (c *ClientWithResponses) UpdateSuppressedNotificationsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateSuppressedNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSuppressedNotificationsResponse, error) {
+	rsp, err := c.UpdateSuppressedNotifications(ctx, clinicId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListMembershipRestrictionsResponse(rsp)
+	return ParseUpdateSuppressedNotificationsResponse(rsp)
 }
 
-// UpdateMembershipRestrictionsWithBodyWithResponse request with arbitrary body returning *UpdateMembershipRestrictionsResponse
-func (c *ClientWithResponses) UpdateMembershipRestrictionsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMembershipRestrictionsResponse, error) {
-	rsp, err := c.UpdateMembershipRestrictionsWithBody(ctx, clinicId, contentType, body, reqEditors...)
+// TideReportWithResponse request returning *TideReportResponse
+func (c *ClientWithResponses) TideReportWithResponse(ctx context.Context, clinicId ClinicId, params *TideReportParams, reqEditors ...RequestEditorFn) (*TideReportResponse, error) {
+	rsp, err := c.TideReport(ctx, clinicId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateMembershipRestrictionsResponse(rsp)
+	return ParseTideReportResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdateMembershipRestrictionsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateMembershipRestrictionsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMembershipRestrictionsResponse, error) {
-	rsp, err := c.UpdateMembershipRestrictions(ctx, clinicId, body, reqEditors...)
+// UpdateTierWithBodyWithResponse request with arbitrary body returning *UpdateTierResponse
+func (c *ClientWithResponses) UpdateTierWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTierResponse, error) {
+	rsp, err := c.UpdateTierWithBody(ctx, clinicId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateMembershipRestrictionsResponse(rsp)
+	return ParseUpdateTierResponse(rsp)
 }
 
-// MergeClinicWithBodyWithResponse request with arbitrary body returning *MergeClinicResponse
-func (c *ClientWithResponses) MergeClinicWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeClinicResponse, error) {
-	rsp, err := c.MergeClinicWithBody(ctx, clinicId, contentType, body, reqEditors...)
+func (c *ClientWithResponses) UpdateTierWithResponse(ctx context.Context, clinicId ClinicId, body UpdateTierJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTierResponse, error) {
+	rsp, err := c.UpdateTier(ctx, clinicId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseMergeClinicResponse(rsp)
+	return ParseUpdateTierResponse(rsp)
 }
 
-func (c *ClientWithResponses) MergeClinicWithResponse(ctx context.Context, clinicId ClinicId, body MergeClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeClinicResponse, error) {
-	rsp, err := c.MergeClinic(ctx, clinicId, body, reqEditors...)
+// ListXealthPatientsNotViewedWithResponse request returning *ListXealthPatientsNotViewedResponse
+func (c *ClientWithResponses) ListXealthPatientsNotViewedWithResponse(ctx context.Context, clinicId ClinicId, params *ListXealthPatientsNotViewedParams, reqEditors ...RequestEditorFn) (*ListXealthPatientsNotViewedResponse, error) {
+	rsp, err := c.ListXealthPatientsNotViewed(ctx, clinicId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseMergeClinicResponse(rsp)
+	return ParseListXealthPatientsNotViewedResponse(rsp)
 }
 
-// TriggerInitialMigrationWithBodyWithResponse request with arbitrary body returning *TriggerInitialMigrationResponse
-func (c *ClientWithResponses) TriggerInitialMigrationWithBodyWithResponse(ctx context.Context, clinicId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TriggerInitialMigrationResponse, error) {
-	rsp, err := c.TriggerInitialMigrationWithBody(ctx, clinicId, contentType, body, reqEditors...)
+// ListXealthReportViewsWithResponse request returning *ListXealthReportViewsResponse
+func (c *ClientWithResponses) ListXealthReportViewsWithResponse(ctx context.Context, clinicId ClinicId, params *ListXealthReportViewsParams, reqEditors ...RequestEditorFn) (*ListXealthReportViewsResponse, error) {
+	rsp, err := c.ListXealthReportViews(ctx, clinicId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTriggerInitialMigrationResponse(rsp)
+	return ParseListXealthReportViewsResponse(rsp)
 }
 
-func (c *ClientWithResponses) TriggerInitialMigrationWithResponse(ctx context.Context, clinicId string, body TriggerInitialMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*TriggerInitialMigrationResponse, error) {
-	rsp, err := c.TriggerInitialMigration(ctx, clinicId, body, reqEditors...)
+// GetXealthReportViewStatsWithResponse request returning *GetXealthReportViewStatsResponse
+func (c *ClientWithResponses) GetXealthReportViewStatsWithResponse(ctx context.Context, clinicId ClinicId, params *GetXealthReportViewStatsParams, reqEditors ...RequestEditorFn) (*GetXealthReportViewStatsResponse, error) {
+	rsp, err := c.GetXealthReportViewStats(ctx, clinicId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTriggerInitialMigrationResponse(rsp)
+	return ParseGetXealthReportViewStatsResponse(rsp)
 }
 
-// ListMigrationsWithResponse request returning *ListMigrationsResponse
-func (c *ClientWithResponses) ListMigrationsWithResponse(ctx context.Context, clinicId string, reqEditors ...RequestEditorFn) (*ListMigrationsResponse, error) {
-	rsp, err := c.ListMigrations(ctx, clinicId, reqEditors...)
+// ListIncompleteMigrationsWithResponse request returning *ListIncompleteMigrationsResponse
+func (c *ClientWithResponses) ListIncompleteMigrationsWithResponse(ctx context.Context, params *ListIncompleteMigrationsParams, reqEditors ...RequestEditorFn) (*ListIncompleteMigrationsResponse, error) {
+	rsp, err := c.ListIncompleteMigrations(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListMigrationsResponse(rsp)
+	return ParseListIncompleteMigrationsResponse(rsp)
 }
 
-// MigrateLegacyClinicianPatientsWithBodyWithResponse request with arbitrary body returning *MigrateLegacyClinicianPatientsResponse
-func (c *ClientWithResponses) MigrateLegacyClinicianPatientsWithBodyWithResponse(ctx context.Context, clinicId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MigrateLegacyClinicianPatientsResponse, error) {
-	rsp, err := c.MigrateLegacyClinicianPatientsWithBody(ctx, clinicId, contentType, body, reqEditors...)
+// FindPatientsWithResponse request returning *FindPatientsResponse
+func (c *ClientWithResponses) FindPatientsWithResponse(ctx context.Context, params *FindPatientsParams, reqEditors ...RequestEditorFn) (*FindPatientsResponse, error) {
+	rsp, err := c.FindPatients(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseMigrateLegacyClinicianPatientsResponse(rsp)
+	return ParseFindPatientsResponse(rsp)
 }
 
-func (c *ClientWithResponses) MigrateLegacyClinicianPatientsWithResponse(ctx context.Context, clinicId string, body MigrateLegacyClinicianPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*MigrateLegacyClinicianPatientsResponse, error) {
-	rsp, err := c.MigrateLegacyClinicianPatients(ctx, clinicId, body, reqEditors...)
+// SyncEHRDataForPatientWithResponse request returning *SyncEHRDataForPatientResponse
+func (c *ClientWithResponses) SyncEHRDataForPatientWithResponse(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*SyncEHRDataForPatientResponse, error) {
+	rsp, err := c.SyncEHRDataForPatient(ctx, patientId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseMigrateLegacyClinicianPatientsResponse(rsp)
+	return ParseSyncEHRDataForPatientResponse(rsp)
 }
 
-// GetMigrationWithResponse request returning *GetMigrationResponse
-func (c *ClientWithResponses) GetMigrationWithResponse(ctx context.Context, clinicId ClinicIdV1, userId UserId, reqEditors ...RequestEditorFn) (*GetMigrationResponse, error) {
-	rsp, err := c.GetMigration(ctx, clinicId, userId, reqEditors...)
+// UpdatePatientSummaryWithBodyWithResponse request with arbitrary body returning *UpdatePatientSummaryResponse
+func (c *ClientWithResponses) UpdatePatientSummaryWithBodyWithResponse(ctx context.Context, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientSummaryResponse, error) {
+	rsp, err := c.UpdatePatientSummaryWithBody(ctx, patientId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetMigrationResponse(rsp)
+	return ParseUpdatePatientSummaryResponse(rsp)
 }
 
-// UpdateMigrationWithBodyWithResponse request with arbitrary body returning *UpdateMigrationResponse
-func (c *ClientWithResponses) UpdateMigrationWithBodyWithResponse(ctx context.Context, clinicId ClinicIdV1, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMigrationResponse, error) {
-	rsp, err := c.UpdateMigrationWithBody(ctx, clinicId, userId, contentType, body, reqEditors...)
+func (c *ClientWithResponses) UpdatePatientSummaryWithResponse(ctx context.Context, patientId PatientId, body UpdatePatientSummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientSummaryResponse, error) {
+	rsp, err := c.UpdatePatientSummary(ctx, patientId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateMigrationResponse(rsp)
+	return ParseUpdatePatientSummaryResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdateMigrationWithResponse(ctx context.Context, clinicId ClinicIdV1, userId UserId, body UpdateMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMigrationResponse, error) {
-	rsp, err := c.UpdateMigration(ctx, clinicId, userId, body, reqEditors...)
+// ListClinicsForPatientWithResponse request returning *ListClinicsForPatientResponse
+func (c *ClientWithResponses) ListClinicsForPatientWithResponse(ctx context.Context, userId UserId, params *ListClinicsForPatientParams, reqEditors ...RequestEditorFn) (*ListClinicsForPatientResponse, error) {
+	rsp, err := c.ListClinicsForPatient(ctx, userId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateMigrationResponse(rsp)
+	return ParseListClinicsForPatientResponse(rsp)
 }
 
-// GetPatientCountWithResponse request returning *GetPatientCountResponse
-func (c *ClientWithResponses) GetPatientCountWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetPatientCountResponse, error) {
-	rsp, err := c.GetPatientCount(ctx, clinicId, reqEditors...)
+// UpdatePatientDataSourcesWithBodyWithResponse request with arbitrary body returning *UpdatePatientDataSourcesResponse
+func (c *ClientWithResponses) UpdatePatientDataSourcesWithBodyWithResponse(ctx context.Context, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientDataSourcesResponse, error) {
+	rsp, err := c.UpdatePatientDataSourcesWithBody(ctx, userId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetPatientCountResponse(rsp)
+	return ParseUpdatePatientDataSourcesResponse(rsp)
 }
 
-// RefreshPatientCountWithResponse request returning *RefreshPatientCountResponse
-func (c *ClientWithResponses) RefreshPatientCountWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*RefreshPatientCountResponse, error) {
-	rsp, err := c.RefreshPatientCount(ctx, clinicId, reqEditors...)
+func (c *ClientWithResponses) UpdatePatientDataSourcesWithResponse(ctx context.Context, userId UserId, body UpdatePatientDataSourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientDataSourcesResponse, error) {
+	rsp, err := c.UpdatePatientDataSources(ctx, userId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRefreshPatientCountResponse(rsp)
+	return ParseUpdatePatientDataSourcesResponse(rsp)
 }
 
-// CreatePatientTagWithBodyWithResponse request with arbitrary body returning *CreatePatientTagResponse
-func (c *ClientWithResponses) CreatePatientTagWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePatientTagResponse, error) {
-	rsp, err := c.CreatePatientTagWithBody(ctx, clinicId, contentType, body, reqEditors...)
+// ProcessEHRMessageWithBodyWithResponse request with arbitrary body returning *ProcessEHRMessageResponse
+func (c *ClientWithResponses) ProcessEHRMessageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessEHRMessageResponse, error) {
+	rsp, err := c.ProcessEHRMessageWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreatePatientTagResponse(rsp)
+	return ParseProcessEHRMessageResponse(rsp)
 }
 
-func (c *ClientWithResponses) CreatePatientTagWithResponse(ctx context.Context, clinicId ClinicId, body CreatePatientTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePatientTagResponse, error) {
-	rsp, err := c.CreatePatientTag(ctx, clinicId, body, reqEditors...)
+func (c *ClientWithResponses) ProcessEHRMessageWithResponse(ctx context.Context, body ProcessEHRMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessEHRMessageResponse, error) {
+	rsp, err := c.ProcessEHRMessage(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreatePatientTagResponse(rsp)
+	return ParseProcessEHRMessageResponse(rsp)
 }
 
-// DeletePatientTagWithResponse request returning *DeletePatientTagResponse
-func (c *ClientWithResponses) DeletePatientTagWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, reqEditors ...RequestEditorFn) (*DeletePatientTagResponse, error) {
-	rsp, err := c.DeletePatientTag(ctx, clinicId, patientTagId, reqEditors...)
+// MatchClinicAndPatientWithBodyWithResponse request with arbitrary body returning *MatchClinicAndPatientResponse
+func (c *ClientWithResponses) MatchClinicAndPatientWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MatchClinicAndPatientResponse, error) {
+	rsp, err := c.MatchClinicAndPatientWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeletePatientTagResponse(rsp)
+	return ParseMatchClinicAndPatientResponse(rsp)
 }
 
-// UpdatePatientTagWithBodyWithResponse request with arbitrary body returning *UpdatePatientTagResponse
-func (c *ClientWithResponses) UpdatePatientTagWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientTagResponse, error) {
-	rsp, err := c.UpdatePatientTagWithBody(ctx, clinicId, patientTagId, contentType, body, reqEditors...)
+func (c *ClientWithResponses) MatchClinicAndPatientWithResponse(ctx context.Context, body MatchClinicAndPatientJSONRequestBody, reqEditors ...RequestEditorFn) (*MatchClinicAndPatientResponse, error) {
+	rsp, err := c.MatchClinicAndPatient(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePatientTagResponse(rsp)
+	return ParseMatchClinicAndPatientResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdatePatientTagWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body UpdatePatientTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientTagResponse, error) {
-	rsp, err := c.UpdatePatientTag(ctx, clinicId, patientTagId, body, reqEditors...)
+// VerifyEndpointWithResponse request returning *VerifyEndpointResponse
+func (c *ClientWithResponses) VerifyEndpointWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VerifyEndpointResponse, error) {
+	rsp, err := c.VerifyEndpoint(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePatientTagResponse(rsp)
+	return ParseVerifyEndpointResponse(rsp)
 }
-
-// ConvertPatientTagToSiteWithResponse request returning *ConvertPatientTagToSiteResponse
-func (c *ClientWithResponses) ConvertPatientTagToSiteWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, reqEditors ...RequestEditorFn) (*ConvertPatientTagToSiteResponse, error) {
-	rsp, err := c.ConvertPatientTagToSite(ctx, clinicId, patientTagId, reqEditors...)
+
+// DeletePatientSummaryWithResponse request returning *DeletePatientSummaryResponse
+func (c *ClientWithResponses) DeletePatientSummaryWithResponse(ctx context.Context, summaryId SummaryId, reqEditors ...RequestEditorFn) (*DeletePatientSummaryResponse, error) {
+	rsp, err := c.DeletePatientSummary(ctx, summaryId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseConvertPatientTagToSiteResponse(rsp)
+	return ParseDeletePatientSummaryResponse(rsp)
 }
 
-// ListPatientsWithResponse request returning *ListPatientsResponse
-func (c *ClientWithResponses) ListPatientsWithResponse(ctx context.Context, clinicId ClinicId, params *ListPatientsParams, reqEditors ...RequestEditorFn) (*ListPatientsResponse, error) {
-	rsp, err := c.ListPatients(ctx, clinicId, params, reqEditors...)
+// DeleteUserFromClinicsWithResponse request returning *DeleteUserFromClinicsResponse
+func (c *ClientWithResponses) DeleteUserFromClinicsWithResponse(ctx context.Context, userId UserId, reqEditors ...RequestEditorFn) (*DeleteUserFromClinicsResponse, error) {
+	rsp, err := c.DeleteUserFromClinics(ctx, userId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListPatientsResponse(rsp)
+	return ParseDeleteUserFromClinicsResponse(rsp)
 }
 
-// CreatePatientAccountWithBodyWithResponse request with arbitrary body returning *CreatePatientAccountResponse
-func (c *ClientWithResponses) CreatePatientAccountWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePatientAccountResponse, error) {
-	rsp, err := c.CreatePatientAccountWithBody(ctx, clinicId, contentType, body, reqEditors...)
+// UpdateClinicUserDetailsWithBodyWithResponse request with arbitrary body returning *UpdateClinicUserDetailsResponse
+func (c *ClientWithResponses) UpdateClinicUserDetailsWithBodyWithResponse(ctx context.Context, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicUserDetailsResponse, error) {
+	rsp, err := c.UpdateClinicUserDetailsWithBody(ctx, userId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreatePatientAccountResponse(rsp)
+	return ParseUpdateClinicUserDetailsResponse(rsp)
 }
 
-func (c *ClientWithResponses) CreatePatientAccountWithResponse(ctx context.Context, clinicId ClinicId, body CreatePatientAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePatientAccountResponse, error) {
-	rsp, err := c.CreatePatientAccount(ctx, clinicId, body, reqEditors...)
+func (c *ClientWithResponses) UpdateClinicUserDetailsWithResponse(ctx context.Context, userId UserId, body UpdateClinicUserDetailsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicUserDetailsResponse, error) {
+	rsp, err := c.UpdateClinicUserDetails(ctx, userId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreatePatientAccountResponse(rsp)
+	return ParseUpdateClinicUserDetailsResponse(rsp)
 }
 
-// AssignPatientTagToClinicPatientsWithBodyWithResponse request with arbitrary body returning *AssignPatientTagToClinicPatientsResponse
-func (c *ClientWithResponses) AssignPatientTagToClinicPatientsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignPatientTagToClinicPatientsResponse, error) {
-	rsp, err := c.AssignPatientTagToClinicPatientsWithBody(ctx, clinicId, patientTagId, contentType, body, reqEditors...)
+// XealthNotificationWithResponse request returning *XealthNotificationResponse
+func (c *ClientWithResponses) XealthNotificationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*XealthNotificationResponse, error) {
+	rsp, err := c.XealthNotification(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAssignPatientTagToClinicPatientsResponse(rsp)
+	return ParseXealthNotificationResponse(rsp)
 }
 
-func (c *ClientWithResponses) AssignPatientTagToClinicPatientsWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body AssignPatientTagToClinicPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignPatientTagToClinicPatientsResponse, error) {
-	rsp, err := c.AssignPatientTagToClinicPatients(ctx, clinicId, patientTagId, body, reqEditors...)
+// XealthPreorderWithResponse request returning *XealthPreorderResponse
+func (c *ClientWithResponses) XealthPreorderWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*XealthPreorderResponse, error) {
+	rsp, err := c.XealthPreorder(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAssignPatientTagToClinicPatientsResponse(rsp)
+	return ParseXealthPreorderResponse(rsp)
 }
 
-// DeletePatientTagFromClinicPatientsWithBodyWithResponse request with arbitrary body returning *DeletePatientTagFromClinicPatientsResponse
-func (c *ClientWithResponses) DeletePatientTagFromClinicPatientsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeletePatientTagFromClinicPatientsResponse, error) {
-	rsp, err := c.DeletePatientTagFromClinicPatientsWithBody(ctx, clinicId, patientTagId, contentType, body, reqEditors...)
+// XealthGetProgramUrlWithResponse request returning *XealthGetProgramUrlResponse
+func (c *ClientWithResponses) XealthGetProgramUrlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*XealthGetProgramUrlResponse, error) {
+	rsp, err := c.XealthGetProgramUrl(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeletePatientTagFromClinicPatientsResponse(rsp)
+	return ParseXealthGetProgramUrlResponse(rsp)
 }
 
-func (c *ClientWithResponses) DeletePatientTagFromClinicPatientsWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, body DeletePatientTagFromClinicPatientsJSONRequestBody, reqEditors ...RequestEditorFn) (*DeletePatientTagFromClinicPatientsResponse, error) {
-	rsp, err := c.DeletePatientTagFromClinicPatients(ctx, clinicId, patientTagId, body, reqEditors...)
+// XealthGetProgramsWithResponse request returning *XealthGetProgramsResponse
+func (c *ClientWithResponses) XealthGetProgramsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*XealthGetProgramsResponse, error) {
+	rsp, err := c.XealthGetPrograms(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeletePatientTagFromClinicPatientsResponse(rsp)
+	return ParseXealthGetProgramsResponse(rsp)
 }
 
-// DeletePatientWithResponse request returning *DeletePatientResponse
-func (c *ClientWithResponses) DeletePatientWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*DeletePatientResponse, error) {
-	rsp, err := c.DeletePatient(ctx, clinicId, patientId, reqEditors...)
+// ViewPDFReportWithResponse request returning *ViewPDFReportResponse
+func (c *ClientWithResponses) ViewPDFReportWithResponse(ctx context.Context, params *ViewPDFReportParams, reqEditors ...RequestEditorFn) (*ViewPDFReportResponse, error) {
+	rsp, err := c.ViewPDFReport(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeletePatientResponse(rsp)
+	return ParseViewPDFReportResponse(rsp)
 }
 
-// GetPatientWithResponse request returning *GetPatientResponse
-func (c *ClientWithResponses) GetPatientWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*GetPatientResponse, error) {
-	rsp, err := c.GetPatient(ctx, clinicId, patientId, reqEditors...)
+// ParseInvalidateAuthenticationCacheResponse parses an HTTP response from a InvalidateAuthenticationCacheWithResponse call
+func ParseInvalidateAuthenticationCacheResponse(rsp *http.Response) (*InvalidateAuthenticationCacheResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetPatientResponse(rsp)
+
+	response := &InvalidateAuthenticationCacheResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthenticationCacheInvalidationResultV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// CreatePatientFromUserWithBodyWithResponse request with arbitrary body returning *CreatePatientFromUserResponse
-func (c *ClientWithResponses) CreatePatientFromUserWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePatientFromUserResponse, error) {
-	rsp, err := c.CreatePatientFromUserWithBody(ctx, clinicId, patientId, contentType, body, reqEditors...)
+// ParseEvaluateAuthorizationPolicyResponse parses an HTTP response from a EvaluateAuthorizationPolicyWithResponse call
+func ParseEvaluateAuthorizationPolicyResponse(rsp *http.Response) (*EvaluateAuthorizationPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreatePatientFromUserResponse(rsp)
+
+	response := &EvaluateAuthorizationPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthorizationDecisionV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreatePatientFromUserWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, body CreatePatientFromUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePatientFromUserResponse, error) {
-	rsp, err := c.CreatePatientFromUser(ctx, clinicId, patientId, body, reqEditors...)
+// ParseListAllCliniciansResponse parses an HTTP response from a ListAllCliniciansWithResponse call
+func ParseListAllCliniciansResponse(rsp *http.Response) (*ListAllCliniciansResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreatePatientFromUserResponse(rsp)
+
+	response := &ListAllCliniciansResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ClinicianClinicRelationshipV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// UpdatePatientWithBodyWithResponse request with arbitrary body returning *UpdatePatientResponse
-func (c *ClientWithResponses) UpdatePatientWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientResponse, error) {
-	rsp, err := c.UpdatePatientWithBody(ctx, clinicId, patientId, contentType, body, reqEditors...)
+// ParseListClinicsForClinicianResponse parses an HTTP response from a ListClinicsForClinicianWithResponse call
+func ParseListClinicsForClinicianResponse(rsp *http.Response) (*ListClinicsForClinicianResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePatientResponse(rsp)
+
+	response := &ListClinicsForClinicianResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClinicianClinicRelationshipsV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdatePatientWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, body UpdatePatientJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientResponse, error) {
-	rsp, err := c.UpdatePatient(ctx, clinicId, patientId, body, reqEditors...)
+// ParseEnableNewClinicExperienceResponse parses an HTTP response from a EnableNewClinicExperienceWithResponse call
+func ParseEnableNewClinicExperienceResponse(rsp *http.Response) (*EnableNewClinicExperienceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePatientResponse(rsp)
+
+	response := &EnableNewClinicExperienceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClinicV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ConnectProviderWithResponse request returning *ConnectProviderResponse
-func (c *ClientWithResponses) ConnectProviderWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, providerId ProviderId, reqEditors ...RequestEditorFn) (*ConnectProviderResponse, error) {
-	rsp, err := c.ConnectProvider(ctx, clinicId, patientId, providerId, reqEditors...)
+// ParseListClinicsResponse parses an HTTP response from a ListClinicsWithResponse call
+func ParseListClinicsResponse(rsp *http.Response) (*ListClinicsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseConnectProviderResponse(rsp)
+
+	response := &ListClinicsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClinicsV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// UpdatePatientPermissionsWithBodyWithResponse request with arbitrary body returning *UpdatePatientPermissionsResponse
-func (c *ClientWithResponses) UpdatePatientPermissionsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientPermissionsResponse, error) {
-	rsp, err := c.UpdatePatientPermissionsWithBody(ctx, clinicId, patientId, contentType, body, reqEditors...)
+// ParseCreateClinicResponse parses an HTTP response from a CreateClinicWithResponse call
+func ParseCreateClinicResponse(rsp *http.Response) (*CreateClinicResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePatientPermissionsResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdatePatientPermissionsWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, body UpdatePatientPermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientPermissionsResponse, error) {
-	rsp, err := c.UpdatePatientPermissions(ctx, clinicId, patientId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateClinicResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClinicV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML200 = &dest
+
 	}
-	return ParseUpdatePatientPermissionsResponse(rsp)
-}
 
-// DeletePatientPermissionWithResponse request returning *DeletePatientPermissionResponse
-func (c *ClientWithResponses) DeletePatientPermissionWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, permission string, reqEditors ...RequestEditorFn) (*DeletePatientPermissionResponse, error) {
-	rsp, err := c.DeletePatientPermission(ctx, clinicId, patientId, permission, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeletePatientPermissionResponse(rsp)
+	return response, nil
 }
 
-// DeletePatientReviewsWithResponse request returning *DeletePatientReviewsResponse
-func (c *ClientWithResponses) DeletePatientReviewsWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*DeletePatientReviewsResponse, error) {
-	rsp, err := c.DeletePatientReviews(ctx, clinicId, patientId, reqEditors...)
+// ParseGetClinicByShareCodeResponse parses an HTTP response from a GetClinicByShareCodeWithResponse call
+func ParseGetClinicByShareCodeResponse(rsp *http.Response) (*GetClinicByShareCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeletePatientReviewsResponse(rsp)
-}
 
-// UpdatePatientReviewsWithResponse request returning *UpdatePatientReviewsResponse
-func (c *ClientWithResponses) UpdatePatientReviewsWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*UpdatePatientReviewsResponse, error) {
-	rsp, err := c.UpdatePatientReviews(ctx, clinicId, patientId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetClinicByShareCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdatePatientReviewsResponse(rsp)
-}
 
-// SendUploadReminderWithResponse request returning *SendUploadReminderResponse
-func (c *ClientWithResponses) SendUploadReminderWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*SendUploadReminderResponse, error) {
-	rsp, err := c.SendUploadReminder(ctx, clinicId, patientId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseSendUploadReminderResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClinicV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// GenerateMergeReportWithBodyWithResponse request with arbitrary body returning *GenerateMergeReportResponse
-func (c *ClientWithResponses) GenerateMergeReportWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GenerateMergeReportResponse, error) {
-	rsp, err := c.GenerateMergeReportWithBody(ctx, clinicId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGenerateMergeReportResponse(rsp)
-}
 
-func (c *ClientWithResponses) GenerateMergeReportWithResponse(ctx context.Context, clinicId ClinicId, body GenerateMergeReportJSONRequestBody, reqEditors ...RequestEditorFn) (*GenerateMergeReportResponse, error) {
-	rsp, err := c.GenerateMergeReport(ctx, clinicId, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGenerateMergeReportResponse(rsp)
+	return response, nil
 }
 
-// AddServiceAccountWithBodyWithResponse request with arbitrary body returning *AddServiceAccountResponse
-func (c *ClientWithResponses) AddServiceAccountWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddServiceAccountResponse, error) {
-	rsp, err := c.AddServiceAccountWithBody(ctx, clinicId, contentType, body, reqEditors...)
+// ParseDeleteClinicResponse parses an HTTP response from a DeleteClinicWithResponse call
+func ParseDeleteClinicResponse(rsp *http.Response) (*DeleteClinicResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddServiceAccountResponse(rsp)
-}
 
-func (c *ClientWithResponses) AddServiceAccountWithResponse(ctx context.Context, clinicId ClinicId, body AddServiceAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*AddServiceAccountResponse, error) {
-	rsp, err := c.AddServiceAccount(ctx, clinicId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteClinicResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAddServiceAccountResponse(rsp)
+
+	return response, nil
 }
 
-// GetEHRSettingsWithResponse request returning *GetEHRSettingsResponse
-func (c *ClientWithResponses) GetEHRSettingsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetEHRSettingsResponse, error) {
-	rsp, err := c.GetEHRSettings(ctx, clinicId, reqEditors...)
+// ParseGetClinicResponse parses an HTTP response from a GetClinicWithResponse call
+func ParseGetClinicResponse(rsp *http.Response) (*GetClinicResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetEHRSettingsResponse(rsp)
-}
 
-// UpdateEHRSettingsWithBodyWithResponse request with arbitrary body returning *UpdateEHRSettingsResponse
-func (c *ClientWithResponses) UpdateEHRSettingsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEHRSettingsResponse, error) {
-	rsp, err := c.UpdateEHRSettingsWithBody(ctx, clinicId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetClinicResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateEHRSettingsResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateEHRSettingsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateEHRSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEHRSettingsResponse, error) {
-	rsp, err := c.UpdateEHRSettings(ctx, clinicId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClinicV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateEHRSettingsResponse(rsp)
+
+	return response, nil
 }
 
-// GetMRNSettingsWithResponse request returning *GetMRNSettingsResponse
-func (c *ClientWithResponses) GetMRNSettingsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetMRNSettingsResponse, error) {
-	rsp, err := c.GetMRNSettings(ctx, clinicId, reqEditors...)
+// ParseUpdateClinicResponse parses an HTTP response from a UpdateClinicWithResponse call
+func ParseUpdateClinicResponse(rsp *http.Response) (*UpdateClinicResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetMRNSettingsResponse(rsp)
-}
 
-// UpdateMRNSettingsWithBodyWithResponse request with arbitrary body returning *UpdateMRNSettingsResponse
-func (c *ClientWithResponses) UpdateMRNSettingsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMRNSettingsResponse, error) {
-	rsp, err := c.UpdateMRNSettingsWithBody(ctx, clinicId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateClinicResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateMRNSettingsResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateMRNSettingsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateMRNSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMRNSettingsResponse, error) {
-	rsp, err := c.UpdateMRNSettings(ctx, clinicId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClinicV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateMRNSettingsResponse(rsp)
+
+	return response, nil
 }
 
-// GetPatientCountSettingsWithResponse request returning *GetPatientCountSettingsResponse
-func (c *ClientWithResponses) GetPatientCountSettingsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetPatientCountSettingsResponse, error) {
-	rsp, err := c.GetPatientCountSettings(ctx, clinicId, reqEditors...)
+// ParseGetAccessRestrictionsResponse parses an HTTP response from a GetAccessRestrictionsWithResponse call
+func ParseGetAccessRestrictionsResponse(rsp *http.Response) (*GetAccessRestrictionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetPatientCountSettingsResponse(rsp)
-}
 
-// UpdatePatientCountSettingsWithBodyWithResponse request with arbitrary body returning *UpdatePatientCountSettingsResponse
-func (c *ClientWithResponses) UpdatePatientCountSettingsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientCountSettingsResponse, error) {
-	rsp, err := c.UpdatePatientCountSettingsWithBody(ctx, clinicId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAccessRestrictionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdatePatientCountSettingsResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdatePatientCountSettingsWithResponse(ctx context.Context, clinicId ClinicId, body UpdatePatientCountSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientCountSettingsResponse, error) {
-	rsp, err := c.UpdatePatientCountSettings(ctx, clinicId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccessRestrictionsV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdatePatientCountSettingsResponse(rsp)
+
+	return response, nil
 }
 
-// CreateSiteWithBodyWithResponse request with arbitrary body returning *CreateSiteResponse
-func (c *ClientWithResponses) CreateSiteWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSiteResponse, error) {
-	rsp, err := c.CreateSiteWithBody(ctx, clinicId, contentType, body, reqEditors...)
+// ParseUpdateAccessRestrictionsResponse parses an HTTP response from a UpdateAccessRestrictionsWithResponse call
+func ParseUpdateAccessRestrictionsResponse(rsp *http.Response) (*UpdateAccessRestrictionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateSiteResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateSiteWithResponse(ctx context.Context, clinicId ClinicId, body CreateSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSiteResponse, error) {
-	rsp, err := c.CreateSite(ctx, clinicId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateAccessRestrictionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateSiteResponse(rsp)
-}
 
-// DeleteSiteWithResponse request returning *DeleteSiteResponse
-func (c *ClientWithResponses) DeleteSiteWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, reqEditors ...RequestEditorFn) (*DeleteSiteResponse, error) {
-	rsp, err := c.DeleteSite(ctx, clinicId, siteId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccessRestrictionsV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseDeleteSiteResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateSiteWithBodyWithResponse request with arbitrary body returning *UpdateSiteResponse
-func (c *ClientWithResponses) UpdateSiteWithBodyWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSiteResponse, error) {
-	rsp, err := c.UpdateSiteWithBody(ctx, clinicId, siteId, contentType, body, reqEditors...)
+// ParseListAPIKeysResponse parses an HTTP response from a ListAPIKeysWithResponse call
+func ParseListAPIKeysResponse(rsp *http.Response) (*ListAPIKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateSiteResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateSiteWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSiteResponse, error) {
-	rsp, err := c.UpdateSite(ctx, clinicId, siteId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListAPIKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateSiteResponse(rsp)
-}
 
-// MergeSiteWithBodyWithResponse request with arbitrary body returning *MergeSiteResponse
-func (c *ClientWithResponses) MergeSiteWithBodyWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeSiteResponse, error) {
-	rsp, err := c.MergeSiteWithBody(ctx, clinicId, siteId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiKeysV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseMergeSiteResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) MergeSiteWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, body MergeSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeSiteResponse, error) {
-	rsp, err := c.MergeSite(ctx, clinicId, siteId, body, reqEditors...)
+// ParseCreateAPIKeyResponse parses an HTTP response from a CreateAPIKeyWithResponse call
+func ParseCreateAPIKeyResponse(rsp *http.Response) (*CreateAPIKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseMergeSiteResponse(rsp)
-}
 
-// UpdateSuppressedNotificationsWithBodyWithResponse request with arbitrary body returning *UpdateSuppressedNotificationsResponse
-func (c *ClientWithResponses) UpdateSuppressedNotificationsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSuppressedNotificationsResponse, error) {
-	rsp, err := c.UpdateSuppressedNotificationsWithBody(ctx, clinicId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateAPIKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateSuppressedNotificationsResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateSuppressedNotificationsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateSuppressedNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSuppressedNotificationsResponse, error) {
-	rsp, err := c.UpdateSuppressedNotifications(ctx, clinicId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreatedApiKeyV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateSuppressedNotificationsResponse(rsp)
+
+	return response, nil
 }
 
-// TideReportWithResponse request returning *TideReportResponse
-func (c *ClientWithResponses) TideReportWithResponse(ctx context.Context, clinicId ClinicId, params *TideReportParams, reqEditors ...RequestEditorFn) (*TideReportResponse, error) {
-	rsp, err := c.TideReport(ctx, clinicId, params, reqEditors...)
+// ParseRevokeAPIKeyResponse parses an HTTP response from a RevokeAPIKeyWithResponse call
+func ParseRevokeAPIKeyResponse(rsp *http.Response) (*RevokeAPIKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTideReportResponse(rsp)
-}
 
-// UpdateTierWithBodyWithResponse request with arbitrary body returning *UpdateTierResponse
-func (c *ClientWithResponses) UpdateTierWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTierResponse, error) {
-	rsp, err := c.UpdateTierWithBody(ctx, clinicId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &RevokeAPIKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateTierResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateTierWithResponse(ctx context.Context, clinicId ClinicId, body UpdateTierJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTierResponse, error) {
-	rsp, err := c.UpdateTier(ctx, clinicId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiKeyV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateTierResponse(rsp)
+
+	return response, nil
 }
 
-// FindPatientsWithResponse request returning *FindPatientsResponse
-func (c *ClientWithResponses) FindPatientsWithResponse(ctx context.Context, params *FindPatientsParams, reqEditors ...RequestEditorFn) (*FindPatientsResponse, error) {
-	rsp, err := c.FindPatients(ctx, params, reqEditors...)
+// ParseListAuditEventsResponse parses an HTTP response from a ListAuditEventsWithResponse call
+func ParseListAuditEventsResponse(rsp *http.Response) (*ListAuditEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFindPatientsResponse(rsp)
-}
 
-// SyncEHRDataForPatientWithResponse request returning *SyncEHRDataForPatientResponse
-func (c *ClientWithResponses) SyncEHRDataForPatientWithResponse(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*SyncEHRDataForPatientResponse, error) {
-	rsp, err := c.SyncEHRDataForPatient(ctx, patientId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListAuditEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSyncEHRDataForPatientResponse(rsp)
-}
 
-// UpdatePatientSummaryWithBodyWithResponse request with arbitrary body returning *UpdatePatientSummaryResponse
-func (c *ClientWithResponses) UpdatePatientSummaryWithBodyWithResponse(ctx context.Context, patientId PatientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientSummaryResponse, error) {
-	rsp, err := c.UpdatePatientSummaryWithBody(ctx, patientId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditEventsV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdatePatientSummaryResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdatePatientSummaryWithResponse(ctx context.Context, patientId PatientId, body UpdatePatientSummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientSummaryResponse, error) {
-	rsp, err := c.UpdatePatientSummary(ctx, patientId, body, reqEditors...)
+// ParseListClinicianRolesResponse parses an HTTP response from a ListClinicianRolesWithResponse call
+func ParseListClinicianRolesResponse(rsp *http.Response) (*ListClinicianRolesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePatientSummaryResponse(rsp)
-}
 
-// ListClinicsForPatientWithResponse request returning *ListClinicsForPatientResponse
-func (c *ClientWithResponses) ListClinicsForPatientWithResponse(ctx context.Context, userId UserId, params *ListClinicsForPatientParams, reqEditors ...RequestEditorFn) (*ListClinicsForPatientResponse, error) {
-	rsp, err := c.ListClinicsForPatient(ctx, userId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListClinicianRolesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListClinicsForPatientResponse(rsp)
-}
 
-// UpdatePatientDataSourcesWithBodyWithResponse request with arbitrary body returning *UpdatePatientDataSourcesResponse
-func (c *ClientWithResponses) UpdatePatientDataSourcesWithBodyWithResponse(ctx context.Context, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePatientDataSourcesResponse, error) {
-	rsp, err := c.UpdatePatientDataSourcesWithBody(ctx, userId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClinicianRoleListV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdatePatientDataSourcesResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdatePatientDataSourcesWithResponse(ctx context.Context, userId UserId, body UpdatePatientDataSourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePatientDataSourcesResponse, error) {
-	rsp, err := c.UpdatePatientDataSources(ctx, userId, body, reqEditors...)
+// ParseUpdateClinicianRolesResponse parses an HTTP response from a UpdateClinicianRolesWithResponse call
+func ParseUpdateClinicianRolesResponse(rsp *http.Response) (*UpdateClinicianRolesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePatientDataSourcesResponse(rsp)
-}
 
-// ProcessEHRMessageWithBodyWithResponse request with arbitrary body returning *ProcessEHRMessageResponse
-func (c *ClientWithResponses) ProcessEHRMessageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessEHRMessageResponse, error) {
-	rsp, err := c.ProcessEHRMessageWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateClinicianRolesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseProcessEHRMessageResponse(rsp)
-}
 
-func (c *ClientWithResponses) ProcessEHRMessageWithResponse(ctx context.Context, body ProcessEHRMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessEHRMessageResponse, error) {
-	rsp, err := c.ProcessEHRMessage(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClinicianRoleListV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseProcessEHRMessageResponse(rsp)
+
+	return response, nil
 }
 
-// MatchClinicAndPatientWithBodyWithResponse request with arbitrary body returning *MatchClinicAndPatientResponse
-func (c *ClientWithResponses) MatchClinicAndPatientWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MatchClinicAndPatientResponse, error) {
-	rsp, err := c.MatchClinicAndPatientWithBody(ctx, contentType, body, reqEditors...)
+// ParseListCliniciansResponse parses an HTTP response from a ListCliniciansWithResponse call
+func ParseListCliniciansResponse(rsp *http.Response) (*ListCliniciansResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseMatchClinicAndPatientResponse(rsp)
-}
 
-func (c *ClientWithResponses) MatchClinicAndPatientWithResponse(ctx context.Context, body MatchClinicAndPatientJSONRequestBody, reqEditors ...RequestEditorFn) (*MatchClinicAndPatientResponse, error) {
-	rsp, err := c.MatchClinicAndPatient(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListCliniciansResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseMatchClinicAndPatientResponse(rsp)
-}
 
-// VerifyEndpointWithResponse request returning *VerifyEndpointResponse
-func (c *ClientWithResponses) VerifyEndpointWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VerifyEndpointResponse, error) {
-	rsp, err := c.VerifyEndpoint(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CliniciansV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseVerifyEndpointResponse(rsp)
+
+	return response, nil
 }
 
-// DeletePatientSummaryWithResponse request returning *DeletePatientSummaryResponse
-func (c *ClientWithResponses) DeletePatientSummaryWithResponse(ctx context.Context, summaryId SummaryId, reqEditors ...RequestEditorFn) (*DeletePatientSummaryResponse, error) {
-	rsp, err := c.DeletePatientSummary(ctx, summaryId, reqEditors...)
+// ParseCreateClinicianResponse parses an HTTP response from a CreateClinicianWithResponse call
+func ParseCreateClinicianResponse(rsp *http.Response) (*CreateClinicianResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeletePatientSummaryResponse(rsp)
-}
 
-// DeleteUserFromClinicsWithResponse request returning *DeleteUserFromClinicsResponse
-func (c *ClientWithResponses) DeleteUserFromClinicsWithResponse(ctx context.Context, userId UserId, reqEditors ...RequestEditorFn) (*DeleteUserFromClinicsResponse, error) {
-	rsp, err := c.DeleteUserFromClinics(ctx, userId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateClinicianResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteUserFromClinicsResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateClinicUserDetailsWithBodyWithResponse request with arbitrary body returning *UpdateClinicUserDetailsResponse
-func (c *ClientWithResponses) UpdateClinicUserDetailsWithBodyWithResponse(ctx context.Context, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicUserDetailsResponse, error) {
-	rsp, err := c.UpdateClinicUserDetailsWithBody(ctx, userId, contentType, body, reqEditors...)
+// ParseDeleteClinicianResponse parses an HTTP response from a DeleteClinicianWithResponse call
+func ParseDeleteClinicianResponse(rsp *http.Response) (*DeleteClinicianResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateClinicUserDetailsResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateClinicUserDetailsWithResponse(ctx context.Context, userId UserId, body UpdateClinicUserDetailsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicUserDetailsResponse, error) {
-	rsp, err := c.UpdateClinicUserDetails(ctx, userId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteClinicianResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateClinicUserDetailsResponse(rsp)
+
+	return response, nil
 }
 
-// XealthNotificationWithResponse request returning *XealthNotificationResponse
-func (c *ClientWithResponses) XealthNotificationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*XealthNotificationResponse, error) {
-	rsp, err := c.XealthNotification(ctx, reqEditors...)
+// ParseGetClinicianResponse parses an HTTP response from a GetClinicianWithResponse call
+func ParseGetClinicianResponse(rsp *http.Response) (*GetClinicianResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseXealthNotificationResponse(rsp)
-}
 
-// XealthPreorderWithResponse request returning *XealthPreorderResponse
-func (c *ClientWithResponses) XealthPreorderWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*XealthPreorderResponse, error) {
-	rsp, err := c.XealthPreorder(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetClinicianResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseXealthPreorderResponse(rsp)
-}
 
-// XealthGetProgramUrlWithResponse request returning *XealthGetProgramUrlResponse
-func (c *ClientWithResponses) XealthGetProgramUrlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*XealthGetProgramUrlResponse, error) {
-	rsp, err := c.XealthGetProgramUrl(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClinicianV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseXealthGetProgramUrlResponse(rsp)
+
+	return response, nil
 }
 
-// XealthGetProgramsWithResponse request returning *XealthGetProgramsResponse
-func (c *ClientWithResponses) XealthGetProgramsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*XealthGetProgramsResponse, error) {
-	rsp, err := c.XealthGetPrograms(ctx, reqEditors...)
+// ParseUpdateClinicianResponse parses an HTTP response from a UpdateClinicianWithResponse call
+func ParseUpdateClinicianResponse(rsp *http.Response) (*UpdateClinicianResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseXealthGetProgramsResponse(rsp)
-}
 
-// ViewPDFReportWithResponse request returning *ViewPDFReportResponse
-func (c *ClientWithResponses) ViewPDFReportWithResponse(ctx context.Context, params *ViewPDFReportParams, reqEditors ...RequestEditorFn) (*ViewPDFReportResponse, error) {
-	rsp, err := c.ViewPDFReport(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateClinicianResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseViewPDFReportResponse(rsp)
+
+	return response, nil
 }
 
-// ParseListAllCliniciansResponse parses an HTTP response from a ListAllCliniciansWithResponse call
-func ParseListAllCliniciansResponse(rsp *http.Response) (*ListAllCliniciansResponse, error) {
+// ParseUpdateClinicianSitesResponse parses an HTTP response from a UpdateClinicianSitesWithResponse call
+func ParseUpdateClinicianSitesResponse(rsp *http.Response) (*UpdateClinicianSitesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListAllCliniciansResponse{
+	response := &UpdateClinicianSitesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ClinicianClinicRelationshipV1
+		var dest ClinicianV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10419,48 +14603,48 @@ func ParseListAllCliniciansResponse(rsp *http.Response) (*ListAllCliniciansRespo
 	return response, nil
 }
 
-// ParseListClinicsForClinicianResponse parses an HTTP response from a ListClinicsForClinicianWithResponse call
-func ParseListClinicsForClinicianResponse(rsp *http.Response) (*ListClinicsForClinicianResponse, error) {
+// ParseConsolidateClinicsResponse parses an HTTP response from a ConsolidateClinicsWithResponse call
+func ParseConsolidateClinicsResponse(rsp *http.Response) (*ConsolidateClinicsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListClinicsForClinicianResponse{
+	response := &ConsolidateClinicsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClinicianClinicRelationshipsV1
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ClinicConsolidationV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON202 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseEnableNewClinicExperienceResponse parses an HTTP response from a EnableNewClinicExperienceWithResponse call
-func ParseEnableNewClinicExperienceResponse(rsp *http.Response) (*EnableNewClinicExperienceResponse, error) {
+// ParseGetClinicConsolidationResponse parses an HTTP response from a GetClinicConsolidationWithResponse call
+func ParseGetClinicConsolidationResponse(rsp *http.Response) (*GetClinicConsolidationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EnableNewClinicExperienceResponse{
+	response := &GetClinicConsolidationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClinicV1
+		var dest ClinicConsolidationV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10471,102 +14655,80 @@ func ParseEnableNewClinicExperienceResponse(rsp *http.Response) (*EnableNewClini
 	return response, nil
 }
 
-// ParseListClinicsResponse parses an HTTP response from a ListClinicsWithResponse call
-func ParseListClinicsResponse(rsp *http.Response) (*ListClinicsResponse, error) {
+// ParseSyncEHRDataResponse parses an HTTP response from a SyncEHRDataWithResponse call
+func ParseSyncEHRDataResponse(rsp *http.Response) (*SyncEHRDataResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListClinicsResponse{
+	response := &SyncEHRDataResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClinicsV1
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorV1
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest ErrorV1
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	return response, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorV1
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// ParseDeleteInvitedClinicianResponse parses an HTTP response from a DeleteInvitedClinicianWithResponse call
+func ParseDeleteInvitedClinicianResponse(rsp *http.Response) (*DeleteInvitedClinicianResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
+	response := &DeleteInvitedClinicianResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseCreateClinicResponse parses an HTTP response from a CreateClinicWithResponse call
-func ParseCreateClinicResponse(rsp *http.Response) (*CreateClinicResponse, error) {
+// ParseGetInvitedClinicianResponse parses an HTTP response from a GetInvitedClinicianWithResponse call
+func ParseGetInvitedClinicianResponse(rsp *http.Response) (*GetInvitedClinicianResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateClinicResponse{
+	response := &GetInvitedClinicianResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClinicV1
+		var dest ClinicianV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
-		var dest map[string]interface{}
-		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.XML200 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseGetClinicByShareCodeResponse parses an HTTP response from a GetClinicByShareCodeWithResponse call
-func ParseGetClinicByShareCodeResponse(rsp *http.Response) (*GetClinicByShareCodeResponse, error) {
+// ParseAssociateClinicianToUserResponse parses an HTTP response from a AssociateClinicianToUserWithResponse call
+func ParseAssociateClinicianToUserResponse(rsp *http.Response) (*AssociateClinicianToUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClinicByShareCodeResponse{
+	response := &AssociateClinicianToUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClinicV1
+		var dest ClinicianV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10577,38 +14739,48 @@ func ParseGetClinicByShareCodeResponse(rsp *http.Response) (*GetClinicByShareCod
 	return response, nil
 }
 
-// ParseDeleteClinicResponse parses an HTTP response from a DeleteClinicWithResponse call
-func ParseDeleteClinicResponse(rsp *http.Response) (*DeleteClinicResponse, error) {
+// ParseListMembershipRestrictionsResponse parses an HTTP response from a ListMembershipRestrictionsWithResponse call
+func ParseListMembershipRestrictionsResponse(rsp *http.Response) (*ListMembershipRestrictionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteClinicResponse{
+	response := &ListMembershipRestrictionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MembershipRestrictionsV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetClinicResponse parses an HTTP response from a GetClinicWithResponse call
-func ParseGetClinicResponse(rsp *http.Response) (*GetClinicResponse, error) {
+// ParseUpdateMembershipRestrictionsResponse parses an HTTP response from a UpdateMembershipRestrictionsWithResponse call
+func ParseUpdateMembershipRestrictionsResponse(rsp *http.Response) (*UpdateMembershipRestrictionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClinicResponse{
+	response := &UpdateMembershipRestrictionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClinicV1
+		var dest MembershipRestrictionsV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10619,48 +14791,48 @@ func ParseGetClinicResponse(rsp *http.Response) (*GetClinicResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateClinicResponse parses an HTTP response from a UpdateClinicWithResponse call
-func ParseUpdateClinicResponse(rsp *http.Response) (*UpdateClinicResponse, error) {
+// ParseMergeClinicResponse parses an HTTP response from a MergeClinicWithResponse call
+func ParseMergeClinicResponse(rsp *http.Response) (*MergeClinicResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateClinicResponse{
+	response := &MergeClinicResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClinicV1
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ClinicMergeJobV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON202 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseListCliniciansResponse parses an HTTP response from a ListCliniciansWithResponse call
-func ParseListCliniciansResponse(rsp *http.Response) (*ListCliniciansResponse, error) {
+// ParseGetClinicMergeResponse parses an HTTP response from a GetClinicMergeWithResponse call
+func ParseGetClinicMergeResponse(rsp *http.Response) (*GetClinicMergeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListCliniciansResponse{
+	response := &GetClinicMergeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CliniciansV1
+		var dest ClinicMergeJobV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10671,54 +14843,48 @@ func ParseListCliniciansResponse(rsp *http.Response) (*ListCliniciansResponse, e
 	return response, nil
 }
 
-// ParseCreateClinicianResponse parses an HTTP response from a CreateClinicianWithResponse call
-func ParseCreateClinicianResponse(rsp *http.Response) (*CreateClinicianResponse, error) {
+// ParseListClinicMergeDuplicatesResponse parses an HTTP response from a ListClinicMergeDuplicatesWithResponse call
+func ParseListClinicMergeDuplicatesResponse(rsp *http.Response) (*ListClinicMergeDuplicatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateClinicianResponse{
+	response := &ListClinicMergeDuplicatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	return response, nil
-}
-
-// ParseDeleteClinicianResponse parses an HTTP response from a DeleteClinicianWithResponse call
-func ParseDeleteClinicianResponse(rsp *http.Response) (*DeleteClinicianResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClinicMergeDuplicatesV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	response := &DeleteClinicianResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseGetClinicianResponse parses an HTTP response from a GetClinicianWithResponse call
-func ParseGetClinicianResponse(rsp *http.Response) (*GetClinicianResponse, error) {
+// ParseResolveClinicMergeDuplicateResponse parses an HTTP response from a ResolveClinicMergeDuplicateWithResponse call
+func ParseResolveClinicMergeDuplicateResponse(rsp *http.Response) (*ResolveClinicMergeDuplicateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClinicianResponse{
+	response := &ResolveClinicMergeDuplicateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClinicianV1
+		var dest ClinicMergeDuplicateV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10729,96 +14895,107 @@ func ParseGetClinicianResponse(rsp *http.Response) (*GetClinicianResponse, error
 	return response, nil
 }
 
-// ParseUpdateClinicianResponse parses an HTTP response from a UpdateClinicianWithResponse call
-func ParseUpdateClinicianResponse(rsp *http.Response) (*UpdateClinicianResponse, error) {
+// ParsePreflightClinicMergeRollbackResponse parses an HTTP response from a PreflightClinicMergeRollbackWithResponse call
+func ParsePreflightClinicMergeRollbackResponse(rsp *http.Response) (*PreflightClinicMergeRollbackResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateClinicianResponse{
+	response := &PreflightClinicMergeRollbackResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClinicMergeRollbackReportV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseSyncEHRDataResponse parses an HTTP response from a SyncEHRDataWithResponse call
-func ParseSyncEHRDataResponse(rsp *http.Response) (*SyncEHRDataResponse, error) {
+// ParseRollbackClinicMergeResponse parses an HTTP response from a RollbackClinicMergeWithResponse call
+func ParseRollbackClinicMergeResponse(rsp *http.Response) (*RollbackClinicMergeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SyncEHRDataResponse{
+	response := &RollbackClinicMergeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	return response, nil
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ClinicMergeJobV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
 
-// ParseDeleteInvitedClinicianResponse parses an HTTP response from a DeleteInvitedClinicianWithResponse call
-func ParseDeleteInvitedClinicianResponse(rsp *http.Response) (*DeleteInvitedClinicianResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ClinicMergeRollbackReportV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
 
-	response := &DeleteInvitedClinicianResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseGetInvitedClinicianResponse parses an HTTP response from a GetInvitedClinicianWithResponse call
-func ParseGetInvitedClinicianResponse(rsp *http.Response) (*GetInvitedClinicianResponse, error) {
+// ParseStartClinicMergeResponse parses an HTTP response from a StartClinicMergeWithResponse call
+func ParseStartClinicMergeResponse(rsp *http.Response) (*StartClinicMergeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetInvitedClinicianResponse{
+	response := &StartClinicMergeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClinicianV1
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ClinicMergeJobV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON202 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAssociateClinicianToUserResponse parses an HTTP response from a AssociateClinicianToUserWithResponse call
-func ParseAssociateClinicianToUserResponse(rsp *http.Response) (*AssociateClinicianToUserResponse, error) {
+// ParseTriggerInitialMigrationResponse parses an HTTP response from a TriggerInitialMigrationWithResponse call
+func ParseTriggerInitialMigrationResponse(rsp *http.Response) (*TriggerInitialMigrationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AssociateClinicianToUserResponse{
+	response := &TriggerInitialMigrationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClinicianV1
+		var dest MigrationV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10829,22 +15006,22 @@ func ParseAssociateClinicianToUserResponse(rsp *http.Response) (*AssociateClinic
 	return response, nil
 }
 
-// ParseListMembershipRestrictionsResponse parses an HTTP response from a ListMembershipRestrictionsWithResponse call
-func ParseListMembershipRestrictionsResponse(rsp *http.Response) (*ListMembershipRestrictionsResponse, error) {
+// ParseListMigrationsResponse parses an HTTP response from a ListMigrationsWithResponse call
+func ParseListMigrationsResponse(rsp *http.Response) (*ListMigrationsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListMembershipRestrictionsResponse{
+	response := &ListMigrationsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MembershipRestrictionsV1
+		var dest MigrationsV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10855,57 +15032,67 @@ func ParseListMembershipRestrictionsResponse(rsp *http.Response) (*ListMembershi
 	return response, nil
 }
 
-// ParseUpdateMembershipRestrictionsResponse parses an HTTP response from a UpdateMembershipRestrictionsWithResponse call
-func ParseUpdateMembershipRestrictionsResponse(rsp *http.Response) (*UpdateMembershipRestrictionsResponse, error) {
+// ParseMigrateLegacyClinicianPatientsResponse parses an HTTP response from a MigrateLegacyClinicianPatientsWithResponse call
+func ParseMigrateLegacyClinicianPatientsResponse(rsp *http.Response) (*MigrateLegacyClinicianPatientsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateMembershipRestrictionsResponse{
+	response := &MigrateLegacyClinicianPatientsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MembershipRestrictionsV1
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest MigrationV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON202 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseMergeClinicResponse parses an HTTP response from a MergeClinicWithResponse call
-func ParseMergeClinicResponse(rsp *http.Response) (*MergeClinicResponse, error) {
+// ParseGetMigrationResponse parses an HTTP response from a GetMigrationWithResponse call
+func ParseGetMigrationResponse(rsp *http.Response) (*GetMigrationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MergeClinicResponse{
+	response := &GetMigrationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MigrationV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseTriggerInitialMigrationResponse parses an HTTP response from a TriggerInitialMigrationWithResponse call
-func ParseTriggerInitialMigrationResponse(rsp *http.Response) (*TriggerInitialMigrationResponse, error) {
+// ParseUpdateMigrationResponse parses an HTTP response from a UpdateMigrationWithResponse call
+func ParseUpdateMigrationResponse(rsp *http.Response) (*UpdateMigrationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TriggerInitialMigrationResponse{
+	response := &UpdateMigrationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -10923,22 +15110,22 @@ func ParseTriggerInitialMigrationResponse(rsp *http.Response) (*TriggerInitialMi
 	return response, nil
 }
 
-// ParseListMigrationsResponse parses an HTTP response from a ListMigrationsWithResponse call
-func ParseListMigrationsResponse(rsp *http.Response) (*ListMigrationsResponse, error) {
+// ParseRetryMigrationResponse parses an HTTP response from a RetryMigrationWithResponse call
+func ParseRetryMigrationResponse(rsp *http.Response) (*RetryMigrationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListMigrationsResponse{
+	response := &RetryMigrationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MigrationsV1
+		var dest MigrationV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10949,74 +15136,64 @@ func ParseListMigrationsResponse(rsp *http.Response) (*ListMigrationsResponse, e
 	return response, nil
 }
 
-// ParseMigrateLegacyClinicianPatientsResponse parses an HTTP response from a MigrateLegacyClinicianPatientsWithResponse call
-func ParseMigrateLegacyClinicianPatientsResponse(rsp *http.Response) (*MigrateLegacyClinicianPatientsResponse, error) {
+// ParseGetPatientCountResponse parses an HTTP response from a GetPatientCountWithResponse call
+func ParseGetPatientCountResponse(rsp *http.Response) (*GetPatientCountResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MigrateLegacyClinicianPatientsResponse{
+	response := &GetPatientCountResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest MigrationV1
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PatientCountV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON202 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetMigrationResponse parses an HTTP response from a GetMigrationWithResponse call
-func ParseGetMigrationResponse(rsp *http.Response) (*GetMigrationResponse, error) {
+// ParseRefreshPatientCountResponse parses an HTTP response from a RefreshPatientCountWithResponse call
+func ParseRefreshPatientCountResponse(rsp *http.Response) (*RefreshPatientCountResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetMigrationResponse{
+	response := &RefreshPatientCountResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MigrationV1
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseUpdateMigrationResponse parses an HTTP response from a UpdateMigrationWithResponse call
-func ParseUpdateMigrationResponse(rsp *http.Response) (*UpdateMigrationResponse, error) {
+// ParseGetPatientDuplicatesResponse parses an HTTP response from a GetPatientDuplicatesWithResponse call
+func ParseGetPatientDuplicatesResponse(rsp *http.Response) (*GetPatientDuplicatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateMigrationResponse{
+	response := &GetPatientDuplicatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MigrationV1
+		var dest PatientDuplicateScanV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11027,22 +15204,22 @@ func ParseUpdateMigrationResponse(rsp *http.Response) (*UpdateMigrationResponse,
 	return response, nil
 }
 
-// ParseGetPatientCountResponse parses an HTTP response from a GetPatientCountWithResponse call
-func ParseGetPatientCountResponse(rsp *http.Response) (*GetPatientCountResponse, error) {
+// ParseMergePatientDuplicateResponse parses an HTTP response from a MergePatientDuplicateWithResponse call
+func ParseMergePatientDuplicateResponse(rsp *http.Response) (*MergePatientDuplicateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetPatientCountResponse{
+	response := &MergePatientDuplicateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PatientCountV1
+		var dest PatientV1
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11053,19 +15230,29 @@ func ParseGetPatientCountResponse(rsp *http.Response) (*GetPatientCountResponse,
 	return response, nil
 }
 
-// ParseRefreshPatientCountResponse parses an HTTP response from a RefreshPatientCountWithResponse call
-func ParseRefreshPatientCountResponse(rsp *http.Response) (*RefreshPatientCountResponse, error) {
+// ParseScanPatientDuplicatesResponse parses an HTTP response from a ScanPatientDuplicatesWithResponse call
+func ParseScanPatientDuplicatesResponse(rsp *http.Response) (*ScanPatientDuplicatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RefreshPatientCountResponse{
+	response := &ScanPatientDuplicatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PatientDuplicateScanV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -11467,6 +15654,42 @@ func ParseSendUploadReminderResponse(rsp *http.Response) (*SendUploadReminderRes
 	return response, nil
 }
 
+// ParseGenerateConsolidationReportResponse parses an HTTP response from a GenerateConsolidationReportWithResponse call
+func ParseGenerateConsolidationReportResponse(rsp *http.Response) (*GenerateConsolidationReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GenerateConsolidationReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/vnd.tidepool.clinic-merge-report.v1+json" && rsp.StatusCode == 200:
+		var dest ClinicMergeReportV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationvndTidepoolClinicMergeReportV1JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/html) unsupported
+
+	}
+
+	return response, nil
+}
+
 // ParseGenerateMergeReportResponse parses an HTTP response from a GenerateMergeReportWithResponse call
 func ParseGenerateMergeReportResponse(rsp *http.Response) (*GenerateMergeReportResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -11480,6 +15703,42 @@ func ParseGenerateMergeReportResponse(rsp *http.Response) (*GenerateMergeReportR
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/vnd.tidepool.clinic-merge-report.v1+json" && rsp.StatusCode == 200:
+		var dest ClinicMergeReportV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationvndTidepoolClinicMergeReportV1JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/html) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGenerateSplitReportResponse parses an HTTP response from a GenerateSplitReportWithResponse call
+func ParseGenerateSplitReportResponse(rsp *http.Response) (*GenerateSplitReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GenerateSplitReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
 	return response, nil
 }
 
@@ -11719,6 +15978,84 @@ func ParseMergeSiteResponse(rsp *http.Response) (*MergeSiteResponse, error) {
 	return response, nil
 }
 
+// ParseUpdateSiteParentResponse parses an HTTP response from a UpdateSiteParentWithResponse call
+func ParseUpdateSiteParentResponse(rsp *http.Response) (*UpdateSiteParentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSiteParentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SiteV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSiteSettingsResponse parses an HTTP response from a UpdateSiteSettingsWithResponse call
+func ParseUpdateSiteSettingsResponse(rsp *http.Response) (*UpdateSiteSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSiteSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SiteV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSplitClinicResponse parses an HTTP response from a SplitClinicWithResponse call
+func ParseSplitClinicResponse(rsp *http.Response) (*SplitClinicResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SplitClinicResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ClinicV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseUpdateSuppressedNotificationsResponse parses an HTTP response from a UpdateSuppressedNotificationsWithResponse call
 func ParseUpdateSuppressedNotificationsResponse(rsp *http.Response) (*UpdateSuppressedNotificationsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -11777,6 +16114,110 @@ func ParseUpdateTierResponse(rsp *http.Response) (*UpdateTierResponse, error) {
 	return response, nil
 }
 
+// ParseListXealthPatientsNotViewedResponse parses an HTTP response from a ListXealthPatientsNotViewedWithResponse call
+func ParseListXealthPatientsNotViewedResponse(rsp *http.Response) (*ListXealthPatientsNotViewedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListXealthPatientsNotViewedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest XealthPatientsNotViewedResponseV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListXealthReportViewsResponse parses an HTTP response from a ListXealthReportViewsWithResponse call
+func ParseListXealthReportViewsResponse(rsp *http.Response) (*ListXealthReportViewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListXealthReportViewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest XealthReportViewsResponseV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetXealthReportViewStatsResponse parses an HTTP response from a GetXealthReportViewStatsWithResponse call
+func ParseGetXealthReportViewStatsResponse(rsp *http.Response) (*GetXealthReportViewStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetXealthReportViewStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest XealthReportViewStatsV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListIncompleteMigrationsResponse parses an HTTP response from a ListIncompleteMigrationsWithResponse call
+func ParseListIncompleteMigrationsResponse(rsp *http.Response) (*ListIncompleteMigrationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListIncompleteMigrationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MigrationsV1
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseFindPatientsResponse parses an HTTP response from a FindPatientsWithResponse call
 func ParseFindPatientsResponse(rsp *http.Response) (*FindPatientsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
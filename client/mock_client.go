@@ -221,6 +221,46 @@ func (mr *MockClientInterfaceMockRecorder) ConnectProvider(ctx, clinicId, patien
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectProvider", reflect.TypeOf((*MockClientInterface)(nil).ConnectProvider), varargs...)
 }
 
+// ConsolidateClinics mocks base method.
+func (m *MockClientInterface) ConsolidateClinics(ctx context.Context, clinicId ClinicId, body ConsolidateClinicsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConsolidateClinics", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsolidateClinics indicates an expected call of ConsolidateClinics.
+func (mr *MockClientInterfaceMockRecorder) ConsolidateClinics(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsolidateClinics", reflect.TypeOf((*MockClientInterface)(nil).ConsolidateClinics), varargs...)
+}
+
+// ConsolidateClinicsWithBody mocks base method.
+func (m *MockClientInterface) ConsolidateClinicsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConsolidateClinicsWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsolidateClinicsWithBody indicates an expected call of ConsolidateClinicsWithBody.
+func (mr *MockClientInterfaceMockRecorder) ConsolidateClinicsWithBody(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsolidateClinicsWithBody", reflect.TypeOf((*MockClientInterface)(nil).ConsolidateClinicsWithBody), varargs...)
+}
+
 // ConvertPatientTagToSite mocks base method.
 func (m *MockClientInterface) ConvertPatientTagToSite(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -241,6 +281,46 @@ func (mr *MockClientInterfaceMockRecorder) ConvertPatientTagToSite(ctx, clinicId
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertPatientTagToSite", reflect.TypeOf((*MockClientInterface)(nil).ConvertPatientTagToSite), varargs...)
 }
 
+// CreateAPIKey mocks base method.
+func (m *MockClientInterface) CreateAPIKey(ctx context.Context, clinicId ClinicId, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAPIKey", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockClientInterfaceMockRecorder) CreateAPIKey(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockClientInterface)(nil).CreateAPIKey), varargs...)
+}
+
+// CreateAPIKeyWithBody mocks base method.
+func (m *MockClientInterface) CreateAPIKeyWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAPIKeyWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKeyWithBody indicates an expected call of CreateAPIKeyWithBody.
+func (mr *MockClientInterfaceMockRecorder) CreateAPIKeyWithBody(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKeyWithBody", reflect.TypeOf((*MockClientInterface)(nil).CreateAPIKeyWithBody), varargs...)
+}
+
 // CreateClinic mocks base method.
 func (m *MockClientInterface) CreateClinic(ctx context.Context, body CreateClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -741,6 +821,46 @@ func (mr *MockClientInterfaceMockRecorder) EnableNewClinicExperience(ctx, userId
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableNewClinicExperience", reflect.TypeOf((*MockClientInterface)(nil).EnableNewClinicExperience), varargs...)
 }
 
+// EvaluateAuthorizationPolicy mocks base method.
+func (m *MockClientInterface) EvaluateAuthorizationPolicy(ctx context.Context, body EvaluateAuthorizationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EvaluateAuthorizationPolicy", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateAuthorizationPolicy indicates an expected call of EvaluateAuthorizationPolicy.
+func (mr *MockClientInterfaceMockRecorder) EvaluateAuthorizationPolicy(ctx, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateAuthorizationPolicy", reflect.TypeOf((*MockClientInterface)(nil).EvaluateAuthorizationPolicy), varargs...)
+}
+
+// EvaluateAuthorizationPolicyWithBody mocks base method.
+func (m *MockClientInterface) EvaluateAuthorizationPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EvaluateAuthorizationPolicyWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateAuthorizationPolicyWithBody indicates an expected call of EvaluateAuthorizationPolicyWithBody.
+func (mr *MockClientInterfaceMockRecorder) EvaluateAuthorizationPolicyWithBody(ctx, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateAuthorizationPolicyWithBody", reflect.TypeOf((*MockClientInterface)(nil).EvaluateAuthorizationPolicyWithBody), varargs...)
+}
+
 // FindPatients mocks base method.
 func (m *MockClientInterface) FindPatients(ctx context.Context, params *FindPatientsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -761,6 +881,46 @@ func (mr *MockClientInterfaceMockRecorder) FindPatients(ctx, params any, reqEdit
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPatients", reflect.TypeOf((*MockClientInterface)(nil).FindPatients), varargs...)
 }
 
+// GenerateConsolidationReport mocks base method.
+func (m *MockClientInterface) GenerateConsolidationReport(ctx context.Context, clinicId ClinicId, body GenerateConsolidationReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenerateConsolidationReport", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateConsolidationReport indicates an expected call of GenerateConsolidationReport.
+func (mr *MockClientInterfaceMockRecorder) GenerateConsolidationReport(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateConsolidationReport", reflect.TypeOf((*MockClientInterface)(nil).GenerateConsolidationReport), varargs...)
+}
+
+// GenerateConsolidationReportWithBody mocks base method.
+func (m *MockClientInterface) GenerateConsolidationReportWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenerateConsolidationReportWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateConsolidationReportWithBody indicates an expected call of GenerateConsolidationReportWithBody.
+func (mr *MockClientInterfaceMockRecorder) GenerateConsolidationReportWithBody(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateConsolidationReportWithBody", reflect.TypeOf((*MockClientInterface)(nil).GenerateConsolidationReportWithBody), varargs...)
+}
+
 // GenerateMergeReport mocks base method.
 func (m *MockClientInterface) GenerateMergeReport(ctx context.Context, clinicId ClinicId, body GenerateMergeReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -801,6 +961,66 @@ func (mr *MockClientInterfaceMockRecorder) GenerateMergeReportWithBody(ctx, clin
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateMergeReportWithBody", reflect.TypeOf((*MockClientInterface)(nil).GenerateMergeReportWithBody), varargs...)
 }
 
+// GenerateSplitReport mocks base method.
+func (m *MockClientInterface) GenerateSplitReport(ctx context.Context, clinicId ClinicId, body GenerateSplitReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenerateSplitReport", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSplitReport indicates an expected call of GenerateSplitReport.
+func (mr *MockClientInterfaceMockRecorder) GenerateSplitReport(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSplitReport", reflect.TypeOf((*MockClientInterface)(nil).GenerateSplitReport), varargs...)
+}
+
+// GenerateSplitReportWithBody mocks base method.
+func (m *MockClientInterface) GenerateSplitReportWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenerateSplitReportWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSplitReportWithBody indicates an expected call of GenerateSplitReportWithBody.
+func (mr *MockClientInterfaceMockRecorder) GenerateSplitReportWithBody(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSplitReportWithBody", reflect.TypeOf((*MockClientInterface)(nil).GenerateSplitReportWithBody), varargs...)
+}
+
+// GetAccessRestrictions mocks base method.
+func (m *MockClientInterface) GetAccessRestrictions(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAccessRestrictions", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessRestrictions indicates an expected call of GetAccessRestrictions.
+func (mr *MockClientInterfaceMockRecorder) GetAccessRestrictions(ctx, clinicId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessRestrictions", reflect.TypeOf((*MockClientInterface)(nil).GetAccessRestrictions), varargs...)
+}
+
 // GetClinic mocks base method.
 func (m *MockClientInterface) GetClinic(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -841,6 +1061,46 @@ func (mr *MockClientInterfaceMockRecorder) GetClinicByShareCode(ctx, shareCode a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClinicByShareCode", reflect.TypeOf((*MockClientInterface)(nil).GetClinicByShareCode), varargs...)
 }
 
+// GetClinicConsolidation mocks base method.
+func (m *MockClientInterface) GetClinicConsolidation(ctx context.Context, clinicId ClinicId, consolidationId ConsolidationId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, consolidationId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClinicConsolidation", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClinicConsolidation indicates an expected call of GetClinicConsolidation.
+func (mr *MockClientInterfaceMockRecorder) GetClinicConsolidation(ctx, clinicId, consolidationId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, consolidationId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClinicConsolidation", reflect.TypeOf((*MockClientInterface)(nil).GetClinicConsolidation), varargs...)
+}
+
+// GetClinicMerge mocks base method.
+func (m *MockClientInterface) GetClinicMerge(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, planId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClinicMerge", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClinicMerge indicates an expected call of GetClinicMerge.
+func (mr *MockClientInterfaceMockRecorder) GetClinicMerge(ctx, clinicId, planId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, planId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClinicMerge", reflect.TypeOf((*MockClientInterface)(nil).GetClinicMerge), varargs...)
+}
+
 // GetClinician mocks base method.
 func (m *MockClientInterface) GetClinician(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -1001,166 +1261,386 @@ func (mr *MockClientInterfaceMockRecorder) GetPatientCountSettings(ctx, clinicId
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatientCountSettings", reflect.TypeOf((*MockClientInterface)(nil).GetPatientCountSettings), varargs...)
 }
 
-// ListAllClinicians mocks base method.
-func (m *MockClientInterface) ListAllClinicians(ctx context.Context, params *ListAllCliniciansParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// GetPatientDuplicates mocks base method.
+func (m *MockClientInterface) GetPatientDuplicates(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, params}
+	varargs := []any{ctx, clinicId}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListAllClinicians", varargs...)
+	ret := m.ctrl.Call(m, "GetPatientDuplicates", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAllClinicians indicates an expected call of ListAllClinicians.
-func (mr *MockClientInterfaceMockRecorder) ListAllClinicians(ctx, params any, reqEditors ...any) *gomock.Call {
+// GetPatientDuplicates indicates an expected call of GetPatientDuplicates.
+func (mr *MockClientInterfaceMockRecorder) GetPatientDuplicates(ctx, clinicId any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllClinicians", reflect.TypeOf((*MockClientInterface)(nil).ListAllClinicians), varargs...)
+	varargs := append([]any{ctx, clinicId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatientDuplicates", reflect.TypeOf((*MockClientInterface)(nil).GetPatientDuplicates), varargs...)
 }
 
-// ListClinicians mocks base method.
-func (m *MockClientInterface) ListClinicians(ctx context.Context, clinicId ClinicId, params *ListCliniciansParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// GetXealthReportViewStats mocks base method.
+func (m *MockClientInterface) GetXealthReportViewStats(ctx context.Context, clinicId ClinicId, params *GetXealthReportViewStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, clinicId, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListClinicians", varargs...)
+	ret := m.ctrl.Call(m, "GetXealthReportViewStats", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListClinicians indicates an expected call of ListClinicians.
-func (mr *MockClientInterfaceMockRecorder) ListClinicians(ctx, clinicId, params any, reqEditors ...any) *gomock.Call {
+// GetXealthReportViewStats indicates an expected call of GetXealthReportViewStats.
+func (mr *MockClientInterfaceMockRecorder) GetXealthReportViewStats(ctx, clinicId, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, clinicId, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClinicians", reflect.TypeOf((*MockClientInterface)(nil).ListClinicians), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetXealthReportViewStats", reflect.TypeOf((*MockClientInterface)(nil).GetXealthReportViewStats), varargs...)
 }
 
-// ListClinics mocks base method.
-func (m *MockClientInterface) ListClinics(ctx context.Context, params *ListClinicsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// InvalidateAuthenticationCache mocks base method.
+func (m *MockClientInterface) InvalidateAuthenticationCache(ctx context.Context, body InvalidateAuthenticationCacheJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, params}
+	varargs := []any{ctx, body}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListClinics", varargs...)
+	ret := m.ctrl.Call(m, "InvalidateAuthenticationCache", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListClinics indicates an expected call of ListClinics.
-func (mr *MockClientInterfaceMockRecorder) ListClinics(ctx, params any, reqEditors ...any) *gomock.Call {
+// InvalidateAuthenticationCache indicates an expected call of InvalidateAuthenticationCache.
+func (mr *MockClientInterfaceMockRecorder) InvalidateAuthenticationCache(ctx, body any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClinics", reflect.TypeOf((*MockClientInterface)(nil).ListClinics), varargs...)
+	varargs := append([]any{ctx, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateAuthenticationCache", reflect.TypeOf((*MockClientInterface)(nil).InvalidateAuthenticationCache), varargs...)
 }
 
-// ListClinicsForClinician mocks base method.
-func (m *MockClientInterface) ListClinicsForClinician(ctx context.Context, userId UserId, params *ListClinicsForClinicianParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// InvalidateAuthenticationCacheWithBody mocks base method.
+func (m *MockClientInterface) InvalidateAuthenticationCacheWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, userId, params}
+	varargs := []any{ctx, contentType, body}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListClinicsForClinician", varargs...)
+	ret := m.ctrl.Call(m, "InvalidateAuthenticationCacheWithBody", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListClinicsForClinician indicates an expected call of ListClinicsForClinician.
-func (mr *MockClientInterfaceMockRecorder) ListClinicsForClinician(ctx, userId, params any, reqEditors ...any) *gomock.Call {
+// InvalidateAuthenticationCacheWithBody indicates an expected call of InvalidateAuthenticationCacheWithBody.
+func (mr *MockClientInterfaceMockRecorder) InvalidateAuthenticationCacheWithBody(ctx, contentType, body any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, userId, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClinicsForClinician", reflect.TypeOf((*MockClientInterface)(nil).ListClinicsForClinician), varargs...)
+	varargs := append([]any{ctx, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateAuthenticationCacheWithBody", reflect.TypeOf((*MockClientInterface)(nil).InvalidateAuthenticationCacheWithBody), varargs...)
 }
 
-// ListClinicsForPatient mocks base method.
-func (m *MockClientInterface) ListClinicsForPatient(ctx context.Context, userId UserId, params *ListClinicsForPatientParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// ListAPIKeys mocks base method.
+func (m *MockClientInterface) ListAPIKeys(ctx context.Context, clinicId ClinicId, params *ListAPIKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, userId, params}
+	varargs := []any{ctx, clinicId, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListClinicsForPatient", varargs...)
+	ret := m.ctrl.Call(m, "ListAPIKeys", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListClinicsForPatient indicates an expected call of ListClinicsForPatient.
-func (mr *MockClientInterfaceMockRecorder) ListClinicsForPatient(ctx, userId, params any, reqEditors ...any) *gomock.Call {
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockClientInterfaceMockRecorder) ListAPIKeys(ctx, clinicId, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, userId, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClinicsForPatient", reflect.TypeOf((*MockClientInterface)(nil).ListClinicsForPatient), varargs...)
+	varargs := append([]any{ctx, clinicId, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockClientInterface)(nil).ListAPIKeys), varargs...)
 }
 
-// ListMembershipRestrictions mocks base method.
-func (m *MockClientInterface) ListMembershipRestrictions(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// ListAllClinicians mocks base method.
+func (m *MockClientInterface) ListAllClinicians(ctx context.Context, params *ListAllCliniciansParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, clinicId}
+	varargs := []any{ctx, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListMembershipRestrictions", varargs...)
+	ret := m.ctrl.Call(m, "ListAllClinicians", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListMembershipRestrictions indicates an expected call of ListMembershipRestrictions.
-func (mr *MockClientInterfaceMockRecorder) ListMembershipRestrictions(ctx, clinicId any, reqEditors ...any) *gomock.Call {
+// ListAllClinicians indicates an expected call of ListAllClinicians.
+func (mr *MockClientInterfaceMockRecorder) ListAllClinicians(ctx, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, clinicId}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembershipRestrictions", reflect.TypeOf((*MockClientInterface)(nil).ListMembershipRestrictions), varargs...)
+	varargs := append([]any{ctx, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllClinicians", reflect.TypeOf((*MockClientInterface)(nil).ListAllClinicians), varargs...)
 }
 
-// ListMigrations mocks base method.
-func (m *MockClientInterface) ListMigrations(ctx context.Context, clinicId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// ListAuditEvents mocks base method.
+func (m *MockClientInterface) ListAuditEvents(ctx context.Context, clinicId ClinicId, params *ListAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, clinicId}
+	varargs := []any{ctx, clinicId, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListMigrations", varargs...)
+	ret := m.ctrl.Call(m, "ListAuditEvents", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListMigrations indicates an expected call of ListMigrations.
-func (mr *MockClientInterfaceMockRecorder) ListMigrations(ctx, clinicId any, reqEditors ...any) *gomock.Call {
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockClientInterfaceMockRecorder) ListAuditEvents(ctx, clinicId, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, clinicId}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMigrations", reflect.TypeOf((*MockClientInterface)(nil).ListMigrations), varargs...)
+	varargs := append([]any{ctx, clinicId, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockClientInterface)(nil).ListAuditEvents), varargs...)
 }
 
-// ListPatients mocks base method.
-func (m *MockClientInterface) ListPatients(ctx context.Context, clinicId ClinicId, params *ListPatientsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// ListClinicMergeDuplicates mocks base method.
+func (m *MockClientInterface) ListClinicMergeDuplicates(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, clinicId, params}
+	varargs := []any{ctx, clinicId, planId}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListPatients", varargs...)
+	ret := m.ctrl.Call(m, "ListClinicMergeDuplicates", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListPatients indicates an expected call of ListPatients.
-func (mr *MockClientInterfaceMockRecorder) ListPatients(ctx, clinicId, params any, reqEditors ...any) *gomock.Call {
+// ListClinicMergeDuplicates indicates an expected call of ListClinicMergeDuplicates.
+func (mr *MockClientInterfaceMockRecorder) ListClinicMergeDuplicates(ctx, clinicId, planId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, planId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClinicMergeDuplicates", reflect.TypeOf((*MockClientInterface)(nil).ListClinicMergeDuplicates), varargs...)
+}
+
+// ListClinicianRoles mocks base method.
+func (m *MockClientInterface) ListClinicianRoles(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClinicianRoles", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClinicianRoles indicates an expected call of ListClinicianRoles.
+func (mr *MockClientInterfaceMockRecorder) ListClinicianRoles(ctx, clinicId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClinicianRoles", reflect.TypeOf((*MockClientInterface)(nil).ListClinicianRoles), varargs...)
+}
+
+// ListClinicians mocks base method.
+func (m *MockClientInterface) ListClinicians(ctx context.Context, clinicId ClinicId, params *ListCliniciansParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClinicians", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClinicians indicates an expected call of ListClinicians.
+func (mr *MockClientInterfaceMockRecorder) ListClinicians(ctx, clinicId, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClinicians", reflect.TypeOf((*MockClientInterface)(nil).ListClinicians), varargs...)
+}
+
+// ListClinics mocks base method.
+func (m *MockClientInterface) ListClinics(ctx context.Context, params *ListClinicsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClinics", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClinics indicates an expected call of ListClinics.
+func (mr *MockClientInterfaceMockRecorder) ListClinics(ctx, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClinics", reflect.TypeOf((*MockClientInterface)(nil).ListClinics), varargs...)
+}
+
+// ListClinicsForClinician mocks base method.
+func (m *MockClientInterface) ListClinicsForClinician(ctx context.Context, userId UserId, params *ListClinicsForClinicianParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userId, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClinicsForClinician", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClinicsForClinician indicates an expected call of ListClinicsForClinician.
+func (mr *MockClientInterfaceMockRecorder) ListClinicsForClinician(ctx, userId, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userId, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClinicsForClinician", reflect.TypeOf((*MockClientInterface)(nil).ListClinicsForClinician), varargs...)
+}
+
+// ListClinicsForPatient mocks base method.
+func (m *MockClientInterface) ListClinicsForPatient(ctx context.Context, userId UserId, params *ListClinicsForPatientParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userId, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClinicsForPatient", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClinicsForPatient indicates an expected call of ListClinicsForPatient.
+func (mr *MockClientInterfaceMockRecorder) ListClinicsForPatient(ctx, userId, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userId, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClinicsForPatient", reflect.TypeOf((*MockClientInterface)(nil).ListClinicsForPatient), varargs...)
+}
+
+// ListIncompleteMigrations mocks base method.
+func (m *MockClientInterface) ListIncompleteMigrations(ctx context.Context, params *ListIncompleteMigrationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIncompleteMigrations", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIncompleteMigrations indicates an expected call of ListIncompleteMigrations.
+func (mr *MockClientInterfaceMockRecorder) ListIncompleteMigrations(ctx, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIncompleteMigrations", reflect.TypeOf((*MockClientInterface)(nil).ListIncompleteMigrations), varargs...)
+}
+
+// ListMembershipRestrictions mocks base method.
+func (m *MockClientInterface) ListMembershipRestrictions(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListMembershipRestrictions", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembershipRestrictions indicates an expected call of ListMembershipRestrictions.
+func (mr *MockClientInterfaceMockRecorder) ListMembershipRestrictions(ctx, clinicId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembershipRestrictions", reflect.TypeOf((*MockClientInterface)(nil).ListMembershipRestrictions), varargs...)
+}
+
+// ListMigrations mocks base method.
+func (m *MockClientInterface) ListMigrations(ctx context.Context, clinicId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListMigrations", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMigrations indicates an expected call of ListMigrations.
+func (mr *MockClientInterfaceMockRecorder) ListMigrations(ctx, clinicId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMigrations", reflect.TypeOf((*MockClientInterface)(nil).ListMigrations), varargs...)
+}
+
+// ListPatients mocks base method.
+func (m *MockClientInterface) ListPatients(ctx context.Context, clinicId ClinicId, params *ListPatientsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPatients", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPatients indicates an expected call of ListPatients.
+func (mr *MockClientInterfaceMockRecorder) ListPatients(ctx, clinicId, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, clinicId, params}, reqEditors...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPatients", reflect.TypeOf((*MockClientInterface)(nil).ListPatients), varargs...)
 }
 
+// ListXealthPatientsNotViewed mocks base method.
+func (m *MockClientInterface) ListXealthPatientsNotViewed(ctx context.Context, clinicId ClinicId, params *ListXealthPatientsNotViewedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListXealthPatientsNotViewed", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListXealthPatientsNotViewed indicates an expected call of ListXealthPatientsNotViewed.
+func (mr *MockClientInterfaceMockRecorder) ListXealthPatientsNotViewed(ctx, clinicId, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListXealthPatientsNotViewed", reflect.TypeOf((*MockClientInterface)(nil).ListXealthPatientsNotViewed), varargs...)
+}
+
+// ListXealthReportViews mocks base method.
+func (m *MockClientInterface) ListXealthReportViews(ctx context.Context, clinicId ClinicId, params *ListXealthReportViewsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListXealthReportViews", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListXealthReportViews indicates an expected call of ListXealthReportViews.
+func (mr *MockClientInterfaceMockRecorder) ListXealthReportViews(ctx, clinicId, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListXealthReportViews", reflect.TypeOf((*MockClientInterface)(nil).ListXealthReportViews), varargs...)
+}
+
 // MatchClinicAndPatient mocks base method.
 func (m *MockClientInterface) MatchClinicAndPatient(ctx context.Context, body MatchClinicAndPatientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -1241,6 +1721,46 @@ func (mr *MockClientInterfaceMockRecorder) MergeClinicWithBody(ctx, clinicId, co
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeClinicWithBody", reflect.TypeOf((*MockClientInterface)(nil).MergeClinicWithBody), varargs...)
 }
 
+// MergePatientDuplicate mocks base method.
+func (m *MockClientInterface) MergePatientDuplicate(ctx context.Context, clinicId ClinicId, body MergePatientDuplicateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MergePatientDuplicate", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergePatientDuplicate indicates an expected call of MergePatientDuplicate.
+func (mr *MockClientInterfaceMockRecorder) MergePatientDuplicate(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePatientDuplicate", reflect.TypeOf((*MockClientInterface)(nil).MergePatientDuplicate), varargs...)
+}
+
+// MergePatientDuplicateWithBody mocks base method.
+func (m *MockClientInterface) MergePatientDuplicateWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MergePatientDuplicateWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergePatientDuplicateWithBody indicates an expected call of MergePatientDuplicateWithBody.
+func (mr *MockClientInterfaceMockRecorder) MergePatientDuplicateWithBody(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePatientDuplicateWithBody", reflect.TypeOf((*MockClientInterface)(nil).MergePatientDuplicateWithBody), varargs...)
+}
+
 // MergeSite mocks base method.
 func (m *MockClientInterface) MergeSite(ctx context.Context, clinicId ClinicId, siteId SiteId, body MergeSiteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -1321,6 +1841,26 @@ func (mr *MockClientInterfaceMockRecorder) MigrateLegacyClinicianPatientsWithBod
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateLegacyClinicianPatientsWithBody", reflect.TypeOf((*MockClientInterface)(nil).MigrateLegacyClinicianPatientsWithBody), varargs...)
 }
 
+// PreflightClinicMergeRollback mocks base method.
+func (m *MockClientInterface) PreflightClinicMergeRollback(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, planId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PreflightClinicMergeRollback", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreflightClinicMergeRollback indicates an expected call of PreflightClinicMergeRollback.
+func (mr *MockClientInterfaceMockRecorder) PreflightClinicMergeRollback(ctx, clinicId, planId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, planId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreflightClinicMergeRollback", reflect.TypeOf((*MockClientInterface)(nil).PreflightClinicMergeRollback), varargs...)
+}
+
 // ProcessEHRMessage mocks base method.
 func (m *MockClientInterface) ProcessEHRMessage(ctx context.Context, body ProcessEHRMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -1381,6 +1921,126 @@ func (mr *MockClientInterfaceMockRecorder) RefreshPatientCount(ctx, clinicId any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshPatientCount", reflect.TypeOf((*MockClientInterface)(nil).RefreshPatientCount), varargs...)
 }
 
+// ResolveClinicMergeDuplicate mocks base method.
+func (m *MockClientInterface) ResolveClinicMergeDuplicate(ctx context.Context, clinicId ClinicId, planId PlanId, duplicateId DuplicateId, body ResolveClinicMergeDuplicateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, planId, duplicateId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResolveClinicMergeDuplicate", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveClinicMergeDuplicate indicates an expected call of ResolveClinicMergeDuplicate.
+func (mr *MockClientInterfaceMockRecorder) ResolveClinicMergeDuplicate(ctx, clinicId, planId, duplicateId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, planId, duplicateId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveClinicMergeDuplicate", reflect.TypeOf((*MockClientInterface)(nil).ResolveClinicMergeDuplicate), varargs...)
+}
+
+// ResolveClinicMergeDuplicateWithBody mocks base method.
+func (m *MockClientInterface) ResolveClinicMergeDuplicateWithBody(ctx context.Context, clinicId ClinicId, planId PlanId, duplicateId DuplicateId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, planId, duplicateId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResolveClinicMergeDuplicateWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveClinicMergeDuplicateWithBody indicates an expected call of ResolveClinicMergeDuplicateWithBody.
+func (mr *MockClientInterfaceMockRecorder) ResolveClinicMergeDuplicateWithBody(ctx, clinicId, planId, duplicateId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, planId, duplicateId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveClinicMergeDuplicateWithBody", reflect.TypeOf((*MockClientInterface)(nil).ResolveClinicMergeDuplicateWithBody), varargs...)
+}
+
+// RetryMigration mocks base method.
+func (m *MockClientInterface) RetryMigration(ctx context.Context, clinicId ClinicIdV1, userId UserId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, userId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RetryMigration", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryMigration indicates an expected call of RetryMigration.
+func (mr *MockClientInterfaceMockRecorder) RetryMigration(ctx, clinicId, userId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, userId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryMigration", reflect.TypeOf((*MockClientInterface)(nil).RetryMigration), varargs...)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockClientInterface) RevokeAPIKey(ctx context.Context, clinicId ClinicId, apiKeyId ApiKeyId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, apiKeyId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeAPIKey", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockClientInterfaceMockRecorder) RevokeAPIKey(ctx, clinicId, apiKeyId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, apiKeyId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockClientInterface)(nil).RevokeAPIKey), varargs...)
+}
+
+// RollbackClinicMerge mocks base method.
+func (m *MockClientInterface) RollbackClinicMerge(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, planId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RollbackClinicMerge", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackClinicMerge indicates an expected call of RollbackClinicMerge.
+func (mr *MockClientInterfaceMockRecorder) RollbackClinicMerge(ctx, clinicId, planId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, planId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackClinicMerge", reflect.TypeOf((*MockClientInterface)(nil).RollbackClinicMerge), varargs...)
+}
+
+// ScanPatientDuplicates mocks base method.
+func (m *MockClientInterface) ScanPatientDuplicates(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScanPatientDuplicates", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanPatientDuplicates indicates an expected call of ScanPatientDuplicates.
+func (mr *MockClientInterfaceMockRecorder) ScanPatientDuplicates(ctx, clinicId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanPatientDuplicates", reflect.TypeOf((*MockClientInterface)(nil).ScanPatientDuplicates), varargs...)
+}
+
 // SendUploadReminder mocks base method.
 func (m *MockClientInterface) SendUploadReminder(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -1401,6 +2061,66 @@ func (mr *MockClientInterfaceMockRecorder) SendUploadReminder(ctx, clinicId, pat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendUploadReminder", reflect.TypeOf((*MockClientInterface)(nil).SendUploadReminder), varargs...)
 }
 
+// SplitClinic mocks base method.
+func (m *MockClientInterface) SplitClinic(ctx context.Context, clinicId ClinicId, body SplitClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SplitClinic", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SplitClinic indicates an expected call of SplitClinic.
+func (mr *MockClientInterfaceMockRecorder) SplitClinic(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitClinic", reflect.TypeOf((*MockClientInterface)(nil).SplitClinic), varargs...)
+}
+
+// SplitClinicWithBody mocks base method.
+func (m *MockClientInterface) SplitClinicWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SplitClinicWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SplitClinicWithBody indicates an expected call of SplitClinicWithBody.
+func (mr *MockClientInterfaceMockRecorder) SplitClinicWithBody(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitClinicWithBody", reflect.TypeOf((*MockClientInterface)(nil).SplitClinicWithBody), varargs...)
+}
+
+// StartClinicMerge mocks base method.
+func (m *MockClientInterface) StartClinicMerge(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, planId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartClinicMerge", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartClinicMerge indicates an expected call of StartClinicMerge.
+func (mr *MockClientInterfaceMockRecorder) StartClinicMerge(ctx, clinicId, planId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, planId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartClinicMerge", reflect.TypeOf((*MockClientInterface)(nil).StartClinicMerge), varargs...)
+}
+
 // SyncEHRData mocks base method.
 func (m *MockClientInterface) SyncEHRData(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -1501,6 +2221,46 @@ func (mr *MockClientInterfaceMockRecorder) TriggerInitialMigrationWithBody(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerInitialMigrationWithBody", reflect.TypeOf((*MockClientInterface)(nil).TriggerInitialMigrationWithBody), varargs...)
 }
 
+// UpdateAccessRestrictions mocks base method.
+func (m *MockClientInterface) UpdateAccessRestrictions(ctx context.Context, clinicId ClinicId, body UpdateAccessRestrictionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAccessRestrictions", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccessRestrictions indicates an expected call of UpdateAccessRestrictions.
+func (mr *MockClientInterfaceMockRecorder) UpdateAccessRestrictions(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccessRestrictions", reflect.TypeOf((*MockClientInterface)(nil).UpdateAccessRestrictions), varargs...)
+}
+
+// UpdateAccessRestrictionsWithBody mocks base method.
+func (m *MockClientInterface) UpdateAccessRestrictionsWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAccessRestrictionsWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccessRestrictionsWithBody indicates an expected call of UpdateAccessRestrictionsWithBody.
+func (mr *MockClientInterfaceMockRecorder) UpdateAccessRestrictionsWithBody(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccessRestrictionsWithBody", reflect.TypeOf((*MockClientInterface)(nil).UpdateAccessRestrictionsWithBody), varargs...)
+}
+
 // UpdateClinic mocks base method.
 func (m *MockClientInterface) UpdateClinic(ctx context.Context, clinicId ClinicId, body UpdateClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -1601,6 +2361,86 @@ func (mr *MockClientInterfaceMockRecorder) UpdateClinician(ctx, clinicId, clinic
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClinician", reflect.TypeOf((*MockClientInterface)(nil).UpdateClinician), varargs...)
 }
 
+// UpdateClinicianRoles mocks base method.
+func (m *MockClientInterface) UpdateClinicianRoles(ctx context.Context, clinicId ClinicId, body UpdateClinicianRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateClinicianRoles", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClinicianRoles indicates an expected call of UpdateClinicianRoles.
+func (mr *MockClientInterfaceMockRecorder) UpdateClinicianRoles(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClinicianRoles", reflect.TypeOf((*MockClientInterface)(nil).UpdateClinicianRoles), varargs...)
+}
+
+// UpdateClinicianRolesWithBody mocks base method.
+func (m *MockClientInterface) UpdateClinicianRolesWithBody(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateClinicianRolesWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClinicianRolesWithBody indicates an expected call of UpdateClinicianRolesWithBody.
+func (mr *MockClientInterfaceMockRecorder) UpdateClinicianRolesWithBody(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClinicianRolesWithBody", reflect.TypeOf((*MockClientInterface)(nil).UpdateClinicianRolesWithBody), varargs...)
+}
+
+// UpdateClinicianSites mocks base method.
+func (m *MockClientInterface) UpdateClinicianSites(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, body UpdateClinicianSitesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, clinicianId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateClinicianSites", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClinicianSites indicates an expected call of UpdateClinicianSites.
+func (mr *MockClientInterfaceMockRecorder) UpdateClinicianSites(ctx, clinicId, clinicianId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, clinicianId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClinicianSites", reflect.TypeOf((*MockClientInterface)(nil).UpdateClinicianSites), varargs...)
+}
+
+// UpdateClinicianSitesWithBody mocks base method.
+func (m *MockClientInterface) UpdateClinicianSitesWithBody(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, clinicianId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateClinicianSitesWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClinicianSitesWithBody indicates an expected call of UpdateClinicianSitesWithBody.
+func (mr *MockClientInterfaceMockRecorder) UpdateClinicianSitesWithBody(ctx, clinicId, clinicianId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, clinicianId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClinicianSitesWithBody", reflect.TypeOf((*MockClientInterface)(nil).UpdateClinicianSitesWithBody), varargs...)
+}
+
 // UpdateClinicianWithBody mocks base method.
 func (m *MockClientInterface) UpdateClinicianWithBody(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -2061,6 +2901,86 @@ func (mr *MockClientInterfaceMockRecorder) UpdateSite(ctx, clinicId, siteId, bod
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSite", reflect.TypeOf((*MockClientInterface)(nil).UpdateSite), varargs...)
 }
 
+// UpdateSiteParent mocks base method.
+func (m *MockClientInterface) UpdateSiteParent(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteParentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, siteId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSiteParent", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSiteParent indicates an expected call of UpdateSiteParent.
+func (mr *MockClientInterfaceMockRecorder) UpdateSiteParent(ctx, clinicId, siteId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, siteId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSiteParent", reflect.TypeOf((*MockClientInterface)(nil).UpdateSiteParent), varargs...)
+}
+
+// UpdateSiteParentWithBody mocks base method.
+func (m *MockClientInterface) UpdateSiteParentWithBody(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, siteId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSiteParentWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSiteParentWithBody indicates an expected call of UpdateSiteParentWithBody.
+func (mr *MockClientInterfaceMockRecorder) UpdateSiteParentWithBody(ctx, clinicId, siteId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, siteId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSiteParentWithBody", reflect.TypeOf((*MockClientInterface)(nil).UpdateSiteParentWithBody), varargs...)
+}
+
+// UpdateSiteSettings mocks base method.
+func (m *MockClientInterface) UpdateSiteSettings(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, siteId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSiteSettings", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSiteSettings indicates an expected call of UpdateSiteSettings.
+func (mr *MockClientInterfaceMockRecorder) UpdateSiteSettings(ctx, clinicId, siteId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, siteId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSiteSettings", reflect.TypeOf((*MockClientInterface)(nil).UpdateSiteSettings), varargs...)
+}
+
+// UpdateSiteSettingsWithBody mocks base method.
+func (m *MockClientInterface) UpdateSiteSettingsWithBody(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, siteId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSiteSettingsWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSiteSettingsWithBody indicates an expected call of UpdateSiteSettingsWithBody.
+func (mr *MockClientInterfaceMockRecorder) UpdateSiteSettingsWithBody(ctx, clinicId, siteId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, siteId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSiteSettingsWithBody", reflect.TypeOf((*MockClientInterface)(nil).UpdateSiteSettingsWithBody), varargs...)
+}
+
 // UpdateSiteWithBody mocks base method.
 func (m *MockClientInterface) UpdateSiteWithBody(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -2445,6 +3365,46 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) ConnectProviderWithRespo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectProviderWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ConnectProviderWithResponse), varargs...)
 }
 
+// ConsolidateClinicsWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ConsolidateClinicsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConsolidateClinicsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConsolidateClinicsWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*ConsolidateClinicsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsolidateClinicsWithBodyWithResponse indicates an expected call of ConsolidateClinicsWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ConsolidateClinicsWithBodyWithResponse(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsolidateClinicsWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ConsolidateClinicsWithBodyWithResponse), varargs...)
+}
+
+// ConsolidateClinicsWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ConsolidateClinicsWithResponse(ctx context.Context, clinicId ClinicId, body ConsolidateClinicsJSONRequestBody, reqEditors ...RequestEditorFn) (*ConsolidateClinicsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConsolidateClinicsWithResponse", varargs...)
+	ret0, _ := ret[0].(*ConsolidateClinicsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsolidateClinicsWithResponse indicates an expected call of ConsolidateClinicsWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ConsolidateClinicsWithResponse(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsolidateClinicsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ConsolidateClinicsWithResponse), varargs...)
+}
+
 // ConvertPatientTagToSiteWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) ConvertPatientTagToSiteWithResponse(ctx context.Context, clinicId ClinicId, patientTagId PatientTagId, reqEditors ...RequestEditorFn) (*ConvertPatientTagToSiteResponse, error) {
 	m.ctrl.T.Helper()
@@ -2465,6 +3425,46 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) ConvertPatientTagToSiteW
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertPatientTagToSiteWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ConvertPatientTagToSiteWithResponse), varargs...)
 }
 
+// CreateAPIKeyWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) CreateAPIKeyWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAPIKeyWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*CreateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKeyWithBodyWithResponse indicates an expected call of CreateAPIKeyWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateAPIKeyWithBodyWithResponse(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKeyWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateAPIKeyWithBodyWithResponse), varargs...)
+}
+
+// CreateAPIKeyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) CreateAPIKeyWithResponse(ctx context.Context, clinicId ClinicId, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAPIKeyWithResponse", varargs...)
+	ret0, _ := ret[0].(*CreateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKeyWithResponse indicates an expected call of CreateAPIKeyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateAPIKeyWithResponse(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKeyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateAPIKeyWithResponse), varargs...)
+}
+
 // CreateClinicWithBodyWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) CreateClinicWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClinicResponse, error) {
 	m.ctrl.T.Helper()
@@ -2948,41 +3948,121 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) DeleteUserFromClinicsWit
 // EnableNewClinicExperienceWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) EnableNewClinicExperienceWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*EnableNewClinicExperienceResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, userId}
+	varargs := []any{ctx, userId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnableNewClinicExperienceWithResponse", varargs...)
+	ret0, _ := ret[0].(*EnableNewClinicExperienceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableNewClinicExperienceWithResponse indicates an expected call of EnableNewClinicExperienceWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) EnableNewClinicExperienceWithResponse(ctx, userId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableNewClinicExperienceWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).EnableNewClinicExperienceWithResponse), varargs...)
+}
+
+// EvaluateAuthorizationPolicyWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) EvaluateAuthorizationPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EvaluateAuthorizationPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EvaluateAuthorizationPolicyWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*EvaluateAuthorizationPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateAuthorizationPolicyWithBodyWithResponse indicates an expected call of EvaluateAuthorizationPolicyWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) EvaluateAuthorizationPolicyWithBodyWithResponse(ctx, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateAuthorizationPolicyWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).EvaluateAuthorizationPolicyWithBodyWithResponse), varargs...)
+}
+
+// EvaluateAuthorizationPolicyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) EvaluateAuthorizationPolicyWithResponse(ctx context.Context, body EvaluateAuthorizationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*EvaluateAuthorizationPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EvaluateAuthorizationPolicyWithResponse", varargs...)
+	ret0, _ := ret[0].(*EvaluateAuthorizationPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateAuthorizationPolicyWithResponse indicates an expected call of EvaluateAuthorizationPolicyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) EvaluateAuthorizationPolicyWithResponse(ctx, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateAuthorizationPolicyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).EvaluateAuthorizationPolicyWithResponse), varargs...)
+}
+
+// FindPatientsWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) FindPatientsWithResponse(ctx context.Context, params *FindPatientsParams, reqEditors ...RequestEditorFn) (*FindPatientsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindPatientsWithResponse", varargs...)
+	ret0, _ := ret[0].(*FindPatientsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPatientsWithResponse indicates an expected call of FindPatientsWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) FindPatientsWithResponse(ctx, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPatientsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).FindPatientsWithResponse), varargs...)
+}
+
+// GenerateConsolidationReportWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) GenerateConsolidationReportWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GenerateConsolidationReportResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, contentType, body}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "EnableNewClinicExperienceWithResponse", varargs...)
-	ret0, _ := ret[0].(*EnableNewClinicExperienceResponse)
+	ret := m.ctrl.Call(m, "GenerateConsolidationReportWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*GenerateConsolidationReportResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EnableNewClinicExperienceWithResponse indicates an expected call of EnableNewClinicExperienceWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) EnableNewClinicExperienceWithResponse(ctx, userId any, reqEditors ...any) *gomock.Call {
+// GenerateConsolidationReportWithBodyWithResponse indicates an expected call of GenerateConsolidationReportWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GenerateConsolidationReportWithBodyWithResponse(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, userId}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableNewClinicExperienceWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).EnableNewClinicExperienceWithResponse), varargs...)
+	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateConsolidationReportWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GenerateConsolidationReportWithBodyWithResponse), varargs...)
 }
 
-// FindPatientsWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) FindPatientsWithResponse(ctx context.Context, params *FindPatientsParams, reqEditors ...RequestEditorFn) (*FindPatientsResponse, error) {
+// GenerateConsolidationReportWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) GenerateConsolidationReportWithResponse(ctx context.Context, clinicId ClinicId, body GenerateConsolidationReportJSONRequestBody, reqEditors ...RequestEditorFn) (*GenerateConsolidationReportResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, params}
+	varargs := []any{ctx, clinicId, body}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "FindPatientsWithResponse", varargs...)
-	ret0, _ := ret[0].(*FindPatientsResponse)
+	ret := m.ctrl.Call(m, "GenerateConsolidationReportWithResponse", varargs...)
+	ret0, _ := ret[0].(*GenerateConsolidationReportResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindPatientsWithResponse indicates an expected call of FindPatientsWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) FindPatientsWithResponse(ctx, params any, reqEditors ...any) *gomock.Call {
+// GenerateConsolidationReportWithResponse indicates an expected call of GenerateConsolidationReportWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GenerateConsolidationReportWithResponse(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPatientsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).FindPatientsWithResponse), varargs...)
+	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateConsolidationReportWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GenerateConsolidationReportWithResponse), varargs...)
 }
 
 // GenerateMergeReportWithBodyWithResponse mocks base method.
@@ -3025,6 +4105,66 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) GenerateMergeReportWithR
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateMergeReportWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GenerateMergeReportWithResponse), varargs...)
 }
 
+// GenerateSplitReportWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) GenerateSplitReportWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GenerateSplitReportResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenerateSplitReportWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*GenerateSplitReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSplitReportWithBodyWithResponse indicates an expected call of GenerateSplitReportWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GenerateSplitReportWithBodyWithResponse(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSplitReportWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GenerateSplitReportWithBodyWithResponse), varargs...)
+}
+
+// GenerateSplitReportWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) GenerateSplitReportWithResponse(ctx context.Context, clinicId ClinicId, body GenerateSplitReportJSONRequestBody, reqEditors ...RequestEditorFn) (*GenerateSplitReportResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenerateSplitReportWithResponse", varargs...)
+	ret0, _ := ret[0].(*GenerateSplitReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSplitReportWithResponse indicates an expected call of GenerateSplitReportWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GenerateSplitReportWithResponse(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSplitReportWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GenerateSplitReportWithResponse), varargs...)
+}
+
+// GetAccessRestrictionsWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) GetAccessRestrictionsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetAccessRestrictionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAccessRestrictionsWithResponse", varargs...)
+	ret0, _ := ret[0].(*GetAccessRestrictionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessRestrictionsWithResponse indicates an expected call of GetAccessRestrictionsWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GetAccessRestrictionsWithResponse(ctx, clinicId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessRestrictionsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetAccessRestrictionsWithResponse), varargs...)
+}
+
 // GetClinicByShareCodeWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) GetClinicByShareCodeWithResponse(ctx context.Context, shareCode string, reqEditors ...RequestEditorFn) (*GetClinicByShareCodeResponse, error) {
 	m.ctrl.T.Helper()
@@ -3045,6 +4185,46 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) GetClinicByShareCodeWith
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClinicByShareCodeWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetClinicByShareCodeWithResponse), varargs...)
 }
 
+// GetClinicConsolidationWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) GetClinicConsolidationWithResponse(ctx context.Context, clinicId ClinicId, consolidationId ConsolidationId, reqEditors ...RequestEditorFn) (*GetClinicConsolidationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, consolidationId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClinicConsolidationWithResponse", varargs...)
+	ret0, _ := ret[0].(*GetClinicConsolidationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClinicConsolidationWithResponse indicates an expected call of GetClinicConsolidationWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GetClinicConsolidationWithResponse(ctx, clinicId, consolidationId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, consolidationId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClinicConsolidationWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetClinicConsolidationWithResponse), varargs...)
+}
+
+// GetClinicMergeWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) GetClinicMergeWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*GetClinicMergeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, planId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClinicMergeWithResponse", varargs...)
+	ret0, _ := ret[0].(*GetClinicMergeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClinicMergeWithResponse indicates an expected call of GetClinicMergeWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GetClinicMergeWithResponse(ctx, clinicId, planId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, planId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClinicMergeWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetClinicMergeWithResponse), varargs...)
+}
+
 // GetClinicWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) GetClinicWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetClinicResponse, error) {
 	m.ctrl.T.Helper()
@@ -3205,6 +4385,26 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) GetPatientCountWithRespo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatientCountWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetPatientCountWithResponse), varargs...)
 }
 
+// GetPatientDuplicatesWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) GetPatientDuplicatesWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*GetPatientDuplicatesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPatientDuplicatesWithResponse", varargs...)
+	ret0, _ := ret[0].(*GetPatientDuplicatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPatientDuplicatesWithResponse indicates an expected call of GetPatientDuplicatesWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GetPatientDuplicatesWithResponse(ctx, clinicId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatientDuplicatesWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetPatientDuplicatesWithResponse), varargs...)
+}
+
 // GetPatientWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) GetPatientWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*GetPatientResponse, error) {
 	m.ctrl.T.Helper()
@@ -3225,6 +4425,86 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) GetPatientWithResponse(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatientWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetPatientWithResponse), varargs...)
 }
 
+// GetXealthReportViewStatsWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) GetXealthReportViewStatsWithResponse(ctx context.Context, clinicId ClinicId, params *GetXealthReportViewStatsParams, reqEditors ...RequestEditorFn) (*GetXealthReportViewStatsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetXealthReportViewStatsWithResponse", varargs...)
+	ret0, _ := ret[0].(*GetXealthReportViewStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetXealthReportViewStatsWithResponse indicates an expected call of GetXealthReportViewStatsWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GetXealthReportViewStatsWithResponse(ctx, clinicId, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetXealthReportViewStatsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetXealthReportViewStatsWithResponse), varargs...)
+}
+
+// InvalidateAuthenticationCacheWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) InvalidateAuthenticationCacheWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InvalidateAuthenticationCacheResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InvalidateAuthenticationCacheWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*InvalidateAuthenticationCacheResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvalidateAuthenticationCacheWithBodyWithResponse indicates an expected call of InvalidateAuthenticationCacheWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) InvalidateAuthenticationCacheWithBodyWithResponse(ctx, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateAuthenticationCacheWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).InvalidateAuthenticationCacheWithBodyWithResponse), varargs...)
+}
+
+// InvalidateAuthenticationCacheWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) InvalidateAuthenticationCacheWithResponse(ctx context.Context, body InvalidateAuthenticationCacheJSONRequestBody, reqEditors ...RequestEditorFn) (*InvalidateAuthenticationCacheResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InvalidateAuthenticationCacheWithResponse", varargs...)
+	ret0, _ := ret[0].(*InvalidateAuthenticationCacheResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvalidateAuthenticationCacheWithResponse indicates an expected call of InvalidateAuthenticationCacheWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) InvalidateAuthenticationCacheWithResponse(ctx, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateAuthenticationCacheWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).InvalidateAuthenticationCacheWithResponse), varargs...)
+}
+
+// ListAPIKeysWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListAPIKeysWithResponse(ctx context.Context, clinicId ClinicId, params *ListAPIKeysParams, reqEditors ...RequestEditorFn) (*ListAPIKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAPIKeysWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListAPIKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeysWithResponse indicates an expected call of ListAPIKeysWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListAPIKeysWithResponse(ctx, clinicId, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeysWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListAPIKeysWithResponse), varargs...)
+}
+
 // ListAllCliniciansWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) ListAllCliniciansWithResponse(ctx context.Context, params *ListAllCliniciansParams, reqEditors ...RequestEditorFn) (*ListAllCliniciansResponse, error) {
 	m.ctrl.T.Helper()
@@ -3245,6 +4525,66 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) ListAllCliniciansWithRes
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllCliniciansWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListAllCliniciansWithResponse), varargs...)
 }
 
+// ListAuditEventsWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListAuditEventsWithResponse(ctx context.Context, clinicId ClinicId, params *ListAuditEventsParams, reqEditors ...RequestEditorFn) (*ListAuditEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAuditEventsWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListAuditEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEventsWithResponse indicates an expected call of ListAuditEventsWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListAuditEventsWithResponse(ctx, clinicId, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEventsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListAuditEventsWithResponse), varargs...)
+}
+
+// ListClinicMergeDuplicatesWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListClinicMergeDuplicatesWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*ListClinicMergeDuplicatesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, planId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClinicMergeDuplicatesWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListClinicMergeDuplicatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClinicMergeDuplicatesWithResponse indicates an expected call of ListClinicMergeDuplicatesWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListClinicMergeDuplicatesWithResponse(ctx, clinicId, planId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, planId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClinicMergeDuplicatesWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListClinicMergeDuplicatesWithResponse), varargs...)
+}
+
+// ListClinicianRolesWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListClinicianRolesWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*ListClinicianRolesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClinicianRolesWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListClinicianRolesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClinicianRolesWithResponse indicates an expected call of ListClinicianRolesWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListClinicianRolesWithResponse(ctx, clinicId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClinicianRolesWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListClinicianRolesWithResponse), varargs...)
+}
+
 // ListCliniciansWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) ListCliniciansWithResponse(ctx context.Context, clinicId ClinicId, params *ListCliniciansParams, reqEditors ...RequestEditorFn) (*ListCliniciansResponse, error) {
 	m.ctrl.T.Helper()
@@ -3325,6 +4665,26 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) ListClinicsWithResponse(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClinicsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListClinicsWithResponse), varargs...)
 }
 
+// ListIncompleteMigrationsWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListIncompleteMigrationsWithResponse(ctx context.Context, params *ListIncompleteMigrationsParams, reqEditors ...RequestEditorFn) (*ListIncompleteMigrationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIncompleteMigrationsWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListIncompleteMigrationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIncompleteMigrationsWithResponse indicates an expected call of ListIncompleteMigrationsWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListIncompleteMigrationsWithResponse(ctx, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIncompleteMigrationsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListIncompleteMigrationsWithResponse), varargs...)
+}
+
 // ListMembershipRestrictionsWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) ListMembershipRestrictionsWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*ListMembershipRestrictionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -3385,6 +4745,46 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) ListPatientsWithResponse
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPatientsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListPatientsWithResponse), varargs...)
 }
 
+// ListXealthPatientsNotViewedWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListXealthPatientsNotViewedWithResponse(ctx context.Context, clinicId ClinicId, params *ListXealthPatientsNotViewedParams, reqEditors ...RequestEditorFn) (*ListXealthPatientsNotViewedResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListXealthPatientsNotViewedWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListXealthPatientsNotViewedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListXealthPatientsNotViewedWithResponse indicates an expected call of ListXealthPatientsNotViewedWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListXealthPatientsNotViewedWithResponse(ctx, clinicId, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListXealthPatientsNotViewedWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListXealthPatientsNotViewedWithResponse), varargs...)
+}
+
+// ListXealthReportViewsWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListXealthReportViewsWithResponse(ctx context.Context, clinicId ClinicId, params *ListXealthReportViewsParams, reqEditors ...RequestEditorFn) (*ListXealthReportViewsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListXealthReportViewsWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListXealthReportViewsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListXealthReportViewsWithResponse indicates an expected call of ListXealthReportViewsWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListXealthReportViewsWithResponse(ctx, clinicId, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListXealthReportViewsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListXealthReportViewsWithResponse), varargs...)
+}
+
 // MatchClinicAndPatientWithBodyWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) MatchClinicAndPatientWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MatchClinicAndPatientResponse, error) {
 	m.ctrl.T.Helper()
@@ -3442,27 +4842,67 @@ func (m *MockClientWithResponsesInterface) MergeClinicWithBodyWithResponse(ctx c
 func (mr *MockClientWithResponsesInterfaceMockRecorder) MergeClinicWithBodyWithResponse(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeClinicWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).MergeClinicWithBodyWithResponse), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeClinicWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).MergeClinicWithBodyWithResponse), varargs...)
+}
+
+// MergeClinicWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) MergeClinicWithResponse(ctx context.Context, clinicId ClinicId, body MergeClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeClinicResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MergeClinicWithResponse", varargs...)
+	ret0, _ := ret[0].(*MergeClinicResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeClinicWithResponse indicates an expected call of MergeClinicWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) MergeClinicWithResponse(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeClinicWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).MergeClinicWithResponse), varargs...)
+}
+
+// MergePatientDuplicateWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) MergePatientDuplicateWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergePatientDuplicateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MergePatientDuplicateWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*MergePatientDuplicateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergePatientDuplicateWithBodyWithResponse indicates an expected call of MergePatientDuplicateWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) MergePatientDuplicateWithBodyWithResponse(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePatientDuplicateWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).MergePatientDuplicateWithBodyWithResponse), varargs...)
 }
 
-// MergeClinicWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) MergeClinicWithResponse(ctx context.Context, clinicId ClinicId, body MergeClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeClinicResponse, error) {
+// MergePatientDuplicateWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) MergePatientDuplicateWithResponse(ctx context.Context, clinicId ClinicId, body MergePatientDuplicateJSONRequestBody, reqEditors ...RequestEditorFn) (*MergePatientDuplicateResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, clinicId, body}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "MergeClinicWithResponse", varargs...)
-	ret0, _ := ret[0].(*MergeClinicResponse)
+	ret := m.ctrl.Call(m, "MergePatientDuplicateWithResponse", varargs...)
+	ret0, _ := ret[0].(*MergePatientDuplicateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MergeClinicWithResponse indicates an expected call of MergeClinicWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) MergeClinicWithResponse(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
+// MergePatientDuplicateWithResponse indicates an expected call of MergePatientDuplicateWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) MergePatientDuplicateWithResponse(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeClinicWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).MergeClinicWithResponse), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePatientDuplicateWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).MergePatientDuplicateWithResponse), varargs...)
 }
 
 // MergeSiteWithBodyWithResponse mocks base method.
@@ -3545,6 +4985,26 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) MigrateLegacyClinicianPa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateLegacyClinicianPatientsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).MigrateLegacyClinicianPatientsWithResponse), varargs...)
 }
 
+// PreflightClinicMergeRollbackWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) PreflightClinicMergeRollbackWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*PreflightClinicMergeRollbackResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, planId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PreflightClinicMergeRollbackWithResponse", varargs...)
+	ret0, _ := ret[0].(*PreflightClinicMergeRollbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreflightClinicMergeRollbackWithResponse indicates an expected call of PreflightClinicMergeRollbackWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) PreflightClinicMergeRollbackWithResponse(ctx, clinicId, planId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, planId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreflightClinicMergeRollbackWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).PreflightClinicMergeRollbackWithResponse), varargs...)
+}
+
 // ProcessEHRMessageWithBodyWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) ProcessEHRMessageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessEHRMessageResponse, error) {
 	m.ctrl.T.Helper()
@@ -3605,6 +5065,126 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) RefreshPatientCountWithR
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshPatientCountWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).RefreshPatientCountWithResponse), varargs...)
 }
 
+// ResolveClinicMergeDuplicateWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ResolveClinicMergeDuplicateWithBodyWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, duplicateId DuplicateId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResolveClinicMergeDuplicateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, planId, duplicateId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResolveClinicMergeDuplicateWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*ResolveClinicMergeDuplicateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveClinicMergeDuplicateWithBodyWithResponse indicates an expected call of ResolveClinicMergeDuplicateWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ResolveClinicMergeDuplicateWithBodyWithResponse(ctx, clinicId, planId, duplicateId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, planId, duplicateId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveClinicMergeDuplicateWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ResolveClinicMergeDuplicateWithBodyWithResponse), varargs...)
+}
+
+// ResolveClinicMergeDuplicateWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ResolveClinicMergeDuplicateWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, duplicateId DuplicateId, body ResolveClinicMergeDuplicateJSONRequestBody, reqEditors ...RequestEditorFn) (*ResolveClinicMergeDuplicateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, planId, duplicateId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResolveClinicMergeDuplicateWithResponse", varargs...)
+	ret0, _ := ret[0].(*ResolveClinicMergeDuplicateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveClinicMergeDuplicateWithResponse indicates an expected call of ResolveClinicMergeDuplicateWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ResolveClinicMergeDuplicateWithResponse(ctx, clinicId, planId, duplicateId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, planId, duplicateId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveClinicMergeDuplicateWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ResolveClinicMergeDuplicateWithResponse), varargs...)
+}
+
+// RetryMigrationWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) RetryMigrationWithResponse(ctx context.Context, clinicId ClinicIdV1, userId UserId, reqEditors ...RequestEditorFn) (*RetryMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, userId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RetryMigrationWithResponse", varargs...)
+	ret0, _ := ret[0].(*RetryMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryMigrationWithResponse indicates an expected call of RetryMigrationWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) RetryMigrationWithResponse(ctx, clinicId, userId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, userId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryMigrationWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).RetryMigrationWithResponse), varargs...)
+}
+
+// RevokeAPIKeyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) RevokeAPIKeyWithResponse(ctx context.Context, clinicId ClinicId, apiKeyId ApiKeyId, reqEditors ...RequestEditorFn) (*RevokeAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, apiKeyId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeAPIKeyWithResponse", varargs...)
+	ret0, _ := ret[0].(*RevokeAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPIKeyWithResponse indicates an expected call of RevokeAPIKeyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) RevokeAPIKeyWithResponse(ctx, clinicId, apiKeyId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, apiKeyId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKeyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).RevokeAPIKeyWithResponse), varargs...)
+}
+
+// RollbackClinicMergeWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) RollbackClinicMergeWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*RollbackClinicMergeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, planId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RollbackClinicMergeWithResponse", varargs...)
+	ret0, _ := ret[0].(*RollbackClinicMergeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackClinicMergeWithResponse indicates an expected call of RollbackClinicMergeWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) RollbackClinicMergeWithResponse(ctx, clinicId, planId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, planId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackClinicMergeWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).RollbackClinicMergeWithResponse), varargs...)
+}
+
+// ScanPatientDuplicatesWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ScanPatientDuplicatesWithResponse(ctx context.Context, clinicId ClinicId, reqEditors ...RequestEditorFn) (*ScanPatientDuplicatesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScanPatientDuplicatesWithResponse", varargs...)
+	ret0, _ := ret[0].(*ScanPatientDuplicatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanPatientDuplicatesWithResponse indicates an expected call of ScanPatientDuplicatesWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ScanPatientDuplicatesWithResponse(ctx, clinicId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanPatientDuplicatesWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ScanPatientDuplicatesWithResponse), varargs...)
+}
+
 // SendUploadReminderWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) SendUploadReminderWithResponse(ctx context.Context, clinicId ClinicId, patientId PatientId, reqEditors ...RequestEditorFn) (*SendUploadReminderResponse, error) {
 	m.ctrl.T.Helper()
@@ -3625,6 +5205,66 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) SendUploadReminderWithRe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendUploadReminderWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).SendUploadReminderWithResponse), varargs...)
 }
 
+// SplitClinicWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) SplitClinicWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SplitClinicResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SplitClinicWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*SplitClinicResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SplitClinicWithBodyWithResponse indicates an expected call of SplitClinicWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) SplitClinicWithBodyWithResponse(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitClinicWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).SplitClinicWithBodyWithResponse), varargs...)
+}
+
+// SplitClinicWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) SplitClinicWithResponse(ctx context.Context, clinicId ClinicId, body SplitClinicJSONRequestBody, reqEditors ...RequestEditorFn) (*SplitClinicResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SplitClinicWithResponse", varargs...)
+	ret0, _ := ret[0].(*SplitClinicResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SplitClinicWithResponse indicates an expected call of SplitClinicWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) SplitClinicWithResponse(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitClinicWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).SplitClinicWithResponse), varargs...)
+}
+
+// StartClinicMergeWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) StartClinicMergeWithResponse(ctx context.Context, clinicId ClinicId, planId PlanId, reqEditors ...RequestEditorFn) (*StartClinicMergeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, planId}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartClinicMergeWithResponse", varargs...)
+	ret0, _ := ret[0].(*StartClinicMergeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartClinicMergeWithResponse indicates an expected call of StartClinicMergeWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) StartClinicMergeWithResponse(ctx, clinicId, planId any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, planId}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartClinicMergeWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).StartClinicMergeWithResponse), varargs...)
+}
+
 // SyncEHRDataForPatientWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) SyncEHRDataForPatientWithResponse(ctx context.Context, patientId PatientId, reqEditors ...RequestEditorFn) (*SyncEHRDataForPatientResponse, error) {
 	m.ctrl.T.Helper()
@@ -3725,6 +5365,46 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) TriggerInitialMigrationW
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerInitialMigrationWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).TriggerInitialMigrationWithResponse), varargs...)
 }
 
+// UpdateAccessRestrictionsWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateAccessRestrictionsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAccessRestrictionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAccessRestrictionsWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateAccessRestrictionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccessRestrictionsWithBodyWithResponse indicates an expected call of UpdateAccessRestrictionsWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateAccessRestrictionsWithBodyWithResponse(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccessRestrictionsWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateAccessRestrictionsWithBodyWithResponse), varargs...)
+}
+
+// UpdateAccessRestrictionsWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateAccessRestrictionsWithResponse(ctx context.Context, clinicId ClinicId, body UpdateAccessRestrictionsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAccessRestrictionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAccessRestrictionsWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateAccessRestrictionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccessRestrictionsWithResponse indicates an expected call of UpdateAccessRestrictionsWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateAccessRestrictionsWithResponse(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccessRestrictionsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateAccessRestrictionsWithResponse), varargs...)
+}
+
 // UpdateClinicUserDetailsWithBodyWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) UpdateClinicUserDetailsWithBodyWithResponse(ctx context.Context, userId UserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicUserDetailsResponse, error) {
 	m.ctrl.T.Helper()
@@ -3805,6 +5485,86 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateClinicWithResponse
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClinicWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateClinicWithResponse), varargs...)
 }
 
+// UpdateClinicianRolesWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateClinicianRolesWithBodyWithResponse(ctx context.Context, clinicId ClinicId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicianRolesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateClinicianRolesWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateClinicianRolesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClinicianRolesWithBodyWithResponse indicates an expected call of UpdateClinicianRolesWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateClinicianRolesWithBodyWithResponse(ctx, clinicId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClinicianRolesWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateClinicianRolesWithBodyWithResponse), varargs...)
+}
+
+// UpdateClinicianRolesWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateClinicianRolesWithResponse(ctx context.Context, clinicId ClinicId, body UpdateClinicianRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicianRolesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateClinicianRolesWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateClinicianRolesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClinicianRolesWithResponse indicates an expected call of UpdateClinicianRolesWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateClinicianRolesWithResponse(ctx, clinicId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClinicianRolesWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateClinicianRolesWithResponse), varargs...)
+}
+
+// UpdateClinicianSitesWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateClinicianSitesWithBodyWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicianSitesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, clinicianId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateClinicianSitesWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateClinicianSitesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClinicianSitesWithBodyWithResponse indicates an expected call of UpdateClinicianSitesWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateClinicianSitesWithBodyWithResponse(ctx, clinicId, clinicianId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, clinicianId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClinicianSitesWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateClinicianSitesWithBodyWithResponse), varargs...)
+}
+
+// UpdateClinicianSitesWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateClinicianSitesWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, body UpdateClinicianSitesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClinicianSitesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, clinicianId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateClinicianSitesWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateClinicianSitesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClinicianSitesWithResponse indicates an expected call of UpdateClinicianSitesWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateClinicianSitesWithResponse(ctx, clinicId, clinicianId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, clinicianId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClinicianSitesWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateClinicianSitesWithResponse), varargs...)
+}
+
 // UpdateClinicianWithBodyWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) UpdateClinicianWithBodyWithResponse(ctx context.Context, clinicId ClinicId, clinicianId ClinicianId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClinicianResponse, error) {
 	m.ctrl.T.Helper()
@@ -4265,6 +6025,86 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdatePatientWithRespons
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePatientWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdatePatientWithResponse), varargs...)
 }
 
+// UpdateSiteParentWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateSiteParentWithBodyWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSiteParentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, siteId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSiteParentWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateSiteParentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSiteParentWithBodyWithResponse indicates an expected call of UpdateSiteParentWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateSiteParentWithBodyWithResponse(ctx, clinicId, siteId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, siteId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSiteParentWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateSiteParentWithBodyWithResponse), varargs...)
+}
+
+// UpdateSiteParentWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateSiteParentWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteParentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSiteParentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, siteId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSiteParentWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateSiteParentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSiteParentWithResponse indicates an expected call of UpdateSiteParentWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateSiteParentWithResponse(ctx, clinicId, siteId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, siteId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSiteParentWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateSiteParentWithResponse), varargs...)
+}
+
+// UpdateSiteSettingsWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateSiteSettingsWithBodyWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSiteSettingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, siteId, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSiteSettingsWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateSiteSettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSiteSettingsWithBodyWithResponse indicates an expected call of UpdateSiteSettingsWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateSiteSettingsWithBodyWithResponse(ctx, clinicId, siteId, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, siteId, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSiteSettingsWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateSiteSettingsWithBodyWithResponse), varargs...)
+}
+
+// UpdateSiteSettingsWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateSiteSettingsWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, body UpdateSiteSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSiteSettingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clinicId, siteId, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSiteSettingsWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateSiteSettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSiteSettingsWithResponse indicates an expected call of UpdateSiteSettingsWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateSiteSettingsWithResponse(ctx, clinicId, siteId, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clinicId, siteId, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSiteSettingsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateSiteSettingsWithResponse), varargs...)
+}
+
 // UpdateSiteWithBodyWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) UpdateSiteWithBodyWithResponse(ctx context.Context, clinicId ClinicId, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSiteResponse, error) {
 	m.ctrl.T.Helper()
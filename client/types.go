@@ -13,6 +13,29 @@ const (
 	SessionTokenScopes = "sessionToken.Scopes"
 )
 
+// Defines values for ApiKeyScopeV1.
+const (
+	ApiKeyScopeV1BillingRead       ApiKeyScopeV1 = "billing:read"
+	ApiKeyScopeV1CliniciansRead    ApiKeyScopeV1 = "clinicians:read"
+	ApiKeyScopeV1MigrationsRead    ApiKeyScopeV1 = "migrations:read"
+	ApiKeyScopeV1PatientTagsManage ApiKeyScopeV1 = "patient_tags:manage"
+	ApiKeyScopeV1PatientTagsWrite  ApiKeyScopeV1 = "patient_tags:write"
+	ApiKeyScopeV1PatientsDelete    ApiKeyScopeV1 = "patients:delete"
+	ApiKeyScopeV1PatientsRead      ApiKeyScopeV1 = "patients:read"
+	ApiKeyScopeV1PatientsWrite     ApiKeyScopeV1 = "patients:write"
+	ApiKeyScopeV1SettingsRead      ApiKeyScopeV1 = "settings:read"
+	ApiKeyScopeV1TideRead          ApiKeyScopeV1 = "tide:read"
+)
+
+// Defines values for AuthorizationRequestV1Method.
+const (
+	DELETE AuthorizationRequestV1Method = "DELETE"
+	GET    AuthorizationRequestV1Method = "GET"
+	PATCH  AuthorizationRequestV1Method = "PATCH"
+	POST   AuthorizationRequestV1Method = "POST"
+	PUT    AuthorizationRequestV1Method = "PUT"
+)
+
 // Defines values for ClinicV1ClinicSize.
 const (
 	N0249   ClinicV1ClinicSize = "0-249"
@@ -36,6 +59,79 @@ const (
 	ClinicV1PreferredBgUnitsMmolL ClinicV1PreferredBgUnits = "mmol/L"
 )
 
+// Defines values for ClinicConsolidationV1Status.
+const (
+	ClinicConsolidationV1StatusCompleted   ClinicConsolidationV1Status = "completed"
+	ClinicConsolidationV1StatusFailed      ClinicConsolidationV1Status = "failed"
+	ClinicConsolidationV1StatusPending     ClinicConsolidationV1Status = "pending"
+	ClinicConsolidationV1StatusRolledBack  ClinicConsolidationV1Status = "rolled_back"
+	ClinicConsolidationV1StatusRollingBack ClinicConsolidationV1Status = "rolling_back"
+	ClinicConsolidationV1StatusRunning     ClinicConsolidationV1Status = "running"
+)
+
+// Defines values for ClinicMergeDuplicatePatientV1ConflictCategories.
+const (
+	ClinicMergeDuplicatePatientV1ConflictCategoriesDuplicateAccounts       ClinicMergeDuplicatePatientV1ConflictCategories = "Duplicate Accounts"
+	ClinicMergeDuplicatePatientV1ConflictCategoriesLikelyDuplicateAccounts ClinicMergeDuplicatePatientV1ConflictCategories = "Likely Duplicate Accounts"
+	ClinicMergeDuplicatePatientV1ConflictCategoriesMRNOnlyMatch            ClinicMergeDuplicatePatientV1ConflictCategories = "MRN Only Match"
+	ClinicMergeDuplicatePatientV1ConflictCategoriesNameOnlyMatch           ClinicMergeDuplicatePatientV1ConflictCategories = "Name Only Match"
+)
+
+// Defines values for ClinicMergeDuplicateResolutionV1Type.
+const (
+	DiscardCustodial ClinicMergeDuplicateResolutionV1Type = "discardCustodial"
+	KeepBoth         ClinicMergeDuplicateResolutionV1Type = "keepBoth"
+	Merge            ClinicMergeDuplicateResolutionV1Type = "merge"
+)
+
+// Defines values for ClinicMergeJobV1Status.
+const (
+	ClinicMergeJobV1StatusAwaitingReview ClinicMergeJobV1Status = "awaiting_review"
+	ClinicMergeJobV1StatusCanceled       ClinicMergeJobV1Status = "canceled"
+	ClinicMergeJobV1StatusCompleted      ClinicMergeJobV1Status = "completed"
+	ClinicMergeJobV1StatusFailed         ClinicMergeJobV1Status = "failed"
+	ClinicMergeJobV1StatusPending        ClinicMergeJobV1Status = "pending"
+	ClinicMergeJobV1StatusRolledBack     ClinicMergeJobV1Status = "rolled_back"
+	ClinicMergeJobV1StatusRollingBack    ClinicMergeJobV1Status = "rolling_back"
+	ClinicMergeJobV1StatusRunning        ClinicMergeJobV1Status = "running"
+	ClinicMergeJobV1StatusWaiting        ClinicMergeJobV1Status = "waiting"
+)
+
+// Defines values for ClinicMergePhaseV1Type.
+const (
+	ClinicMergePhaseV1TypeClinic    ClinicMergePhaseV1Type = "clinic"
+	ClinicMergePhaseV1TypeClinician ClinicMergePhaseV1Type = "clinician"
+	ClinicMergePhaseV1TypePatient   ClinicMergePhaseV1Type = "patient"
+	ClinicMergePhaseV1TypeSite      ClinicMergePhaseV1Type = "site"
+	ClinicMergePhaseV1TypeTag       ClinicMergePhaseV1Type = "tag"
+)
+
+// Defines values for ClinicMergeReportConflictV1Category.
+const (
+	ClinicMergeReportConflictV1CategoryDuplicateAccounts       ClinicMergeReportConflictV1Category = "Duplicate Accounts"
+	ClinicMergeReportConflictV1CategoryLikelyDuplicateAccounts ClinicMergeReportConflictV1Category = "Likely Duplicate Accounts"
+	ClinicMergeReportConflictV1CategoryMRNOnlyMatch            ClinicMergeReportConflictV1Category = "MRN Only Match"
+	ClinicMergeReportConflictV1CategoryNameOnlyMatch           ClinicMergeReportConflictV1Category = "Name Only Match"
+)
+
+// Defines values for ClinicMergeReportSiteV1Action.
+const (
+	MOVE   ClinicMergeReportSiteV1Action = "MOVE"
+	RENAME ClinicMergeReportSiteV1Action = "RENAME"
+	RETAIN ClinicMergeReportSiteV1Action = "RETAIN"
+)
+
+// Defines values for ClinicMergeRollbackIssueV1Type.
+const (
+	ClinicMergeRollbackIssueV1TypeClinic    ClinicMergeRollbackIssueV1Type = "clinic"
+	ClinicMergeRollbackIssueV1TypeClinician ClinicMergeRollbackIssueV1Type = "clinician"
+	ClinicMergeRollbackIssueV1TypeJob       ClinicMergeRollbackIssueV1Type = "job"
+	ClinicMergeRollbackIssueV1TypePatient   ClinicMergeRollbackIssueV1Type = "patient"
+	ClinicMergeRollbackIssueV1TypeShareCode ClinicMergeRollbackIssueV1Type = "shareCode"
+	ClinicMergeRollbackIssueV1TypeSite      ClinicMergeRollbackIssueV1Type = "site"
+	ClinicMergeRollbackIssueV1TypeTag       ClinicMergeRollbackIssueV1Type = "tag"
+)
+
 // Defines values for ClinicTimezoneV1.
 const (
 	AfricaAbidjan                  ClinicTimezoneV1 = "Africa/Abidjan"
@@ -635,6 +731,21 @@ const (
 	Zulu                           ClinicTimezoneV1 = "Zulu"
 )
 
+// Defines values for ClinicianRoleV1Permissions.
+const (
+	ClinicianRoleV1PermissionsBillingRead       ClinicianRoleV1Permissions = "billing:read"
+	ClinicianRoleV1PermissionsClinicWrite       ClinicianRoleV1Permissions = "clinic:write"
+	ClinicianRoleV1PermissionsCliniciansRead    ClinicianRoleV1Permissions = "clinicians:read"
+	ClinicianRoleV1PermissionsCliniciansWrite   ClinicianRoleV1Permissions = "clinicians:write"
+	ClinicianRoleV1PermissionsMigrationsRead    ClinicianRoleV1Permissions = "migrations:read"
+	ClinicianRoleV1PermissionsPatientTagsManage ClinicianRoleV1Permissions = "patient_tags:manage"
+	ClinicianRoleV1PermissionsPatientTagsWrite  ClinicianRoleV1Permissions = "patient_tags:write"
+	ClinicianRoleV1PermissionsPatientsDelete    ClinicianRoleV1Permissions = "patients:delete"
+	ClinicianRoleV1PermissionsPatientsRead      ClinicianRoleV1Permissions = "patients:read"
+	ClinicianRoleV1PermissionsPatientsWrite     ClinicianRoleV1Permissions = "patients:write"
+	ClinicianRoleV1PermissionsSettingsRead      ClinicianRoleV1Permissions = "settings:read"
+)
+
 // Defines values for DataSourceV1State.
 const (
 	Connected        DataSourceV1State = "connected"
@@ -710,10 +821,18 @@ const (
 // Defines values for MigrationStatusV1.
 const (
 	COMPLETED MigrationStatusV1 = "COMPLETED"
+	FAILED    MigrationStatusV1 = "FAILED"
 	PENDING   MigrationStatusV1 = "PENDING"
 	RUNNING   MigrationStatusV1 = "RUNNING"
 )
 
+// Defines values for PatientDuplicateMatchV1ConflictCategory.
+const (
+	LikelyDuplicateAccounts PatientDuplicateMatchV1ConflictCategory = "Likely Duplicate Accounts"
+	MRNOnlyMatch            PatientDuplicateMatchV1ConflictCategory = "MRN Only Match"
+	NameOnlyMatch           PatientDuplicateMatchV1ConflictCategory = "Name Only Match"
+)
+
 // Defines values for ProviderIdV1.
 const (
 	Abbott ProviderIdV1 = "abbott"
@@ -736,6 +855,12 @@ const (
 	ScheduledReportsV1OnUploadNoteEventTypeReplace ScheduledReportsV1OnUploadNoteEventType = "Replace"
 )
 
+// Defines values for SiteSettingsV1PreferredBgUnits.
+const (
+	MgdL  SiteSettingsV1PreferredBgUnits = "mg/dL"
+	MmolL SiteSettingsV1PreferredBgUnits = "mmol/L"
+)
+
 // Defines values for TierV1.
 const (
 	Tier0100 TierV1 = "tier0100"
@@ -744,6 +869,13 @@ const (
 	Tier0400 TierV1 = "tier0400"
 )
 
+// Defines values for ReportViewInterval.
+const (
+	ReportViewIntervalDay   ReportViewInterval = "day"
+	ReportViewIntervalMonth ReportViewInterval = "month"
+	ReportViewIntervalWeek  ReportViewInterval = "week"
+)
+
 // Defines values for TideReportParamsCategories.
 const (
 	DropInTimeInTargetPercent TideReportParamsCategories = "dropInTimeInTargetPercent"
@@ -757,6 +889,13 @@ const (
 	TimeInVeryLowPercent      TideReportParamsCategories = "timeInVeryLowPercent"
 )
 
+// Defines values for GetXealthReportViewStatsParamsInterval.
+const (
+	GetXealthReportViewStatsParamsIntervalDay   GetXealthReportViewStatsParamsInterval = "day"
+	GetXealthReportViewStatsParamsIntervalMonth GetXealthReportViewStatsParamsInterval = "month"
+	GetXealthReportViewStatsParamsIntervalWeek  GetXealthReportViewStatsParamsInterval = "week"
+)
+
 // Defines values for FindPatientsParamsWorkspaceIdType.
 const (
 	FindPatientsParamsWorkspaceIdTypeClinicId    FindPatientsParamsWorkspaceIdType = "clinicId"
@@ -779,6 +918,19 @@ type UpdateTier struct {
 	Tier TierV1 `json:"tier"`
 }
 
+// AccessRestrictionsV1 Restrictions which apply when clinicians access the clinic. Requests which violate the restrictions are
+// denied unless Tidepool support overrides them with a break glass reason.
+type AccessRestrictionsV1 struct {
+	// AllowedNetworks The CIDR ranges from which clinicians can access the clinic. Any network is allowed if empty.
+	AllowedNetworks *[]string `json:"allowedNetworks,omitempty"`
+
+	// MaxAuthenticationAgeSeconds Require clinicians to have authenticated within the given number of seconds
+	MaxAuthenticationAgeSeconds *int `json:"maxAuthenticationAgeSeconds,omitempty"`
+
+	// RequireMfa Require clinicians to authenticate with multiple factors
+	RequireMfa *bool `json:"requireMfa,omitempty"`
+}
+
 // AddServiceAccountV1 defines model for addServiceAccount.v1.
 type AddServiceAccountV1 struct {
 	ClientId     string `json:"client_id"`
@@ -786,11 +938,130 @@ type AddServiceAccountV1 struct {
 	Name         string `json:"name"`
 }
 
+// ApiKeyV1 defines model for apiKey.v1.
+type ApiKeyV1 struct {
+	// ClinicId String representation of a resource id
+	ClinicId       ObjectIdV1 `json:"clinicId"`
+	CreatedBy      string     `json:"createdBy"`
+	CreatedTime    time.Time  `json:"createdTime"`
+	ExpirationTime time.Time  `json:"expirationTime"`
+
+	// Id String representation of a resource id
+	Id           ObjectIdV1      `json:"id"`
+	LastUsedTime *time.Time      `json:"lastUsedTime,omitempty"`
+	Name         string          `json:"name"`
+	RevokedBy    *string         `json:"revokedBy,omitempty"`
+	RevokedTime  *time.Time      `json:"revokedTime,omitempty"`
+	Scopes       []ApiKeyScopeV1 `json:"scopes"`
+
+	// UserId String representation of a Tidepool User ID. Old style IDs are 10-digit strings consisting of only hexadeximcal digits. New style IDs are 36-digit [UUID v4](https://en.wikipedia.org/wiki/Universally_unique_identifier#Version_4_(random))
+	UserId *Tidepooluserid `json:"userId,omitempty"`
+}
+
+// ApiKeyCreationV1 defines model for apiKeyCreation.v1.
+type ApiKeyCreationV1 struct {
+	// ExpirationTime Defaults to 90 days from now, the maximum is one year
+	ExpirationTime *time.Time      `json:"expirationTime,omitempty"`
+	Name           string          `json:"name"`
+	Scopes         []ApiKeyScopeV1 `json:"scopes"`
+
+	// UserId String representation of a Tidepool User ID. Old style IDs are 10-digit strings consisting of only hexadeximcal digits. New style IDs are 36-digit [UUID v4](https://en.wikipedia.org/wiki/Universally_unique_identifier#Version_4_(random))
+	UserId *Tidepooluserid `json:"userId,omitempty"`
+}
+
+// ApiKeyScopeV1 defines model for apiKeyScope.v1.
+type ApiKeyScopeV1 string
+
+// ApiKeysV1 defines model for apiKeys.v1.
+type ApiKeysV1 = []ApiKeyV1
+
 // AssociateClinicianToUserV1 defines model for associateClinicianToUser.v1.
 type AssociateClinicianToUserV1 struct {
 	UserId string `json:"userId"`
 }
 
+// AuditEventV1 defines model for auditEvent.v1.
+type AuditEventV1 struct {
+	ClientIp    *string   `json:"clientIp,omitempty"`
+	CreatedTime time.Time `json:"createdTime"`
+	Details     *[]string `json:"details,omitempty"`
+
+	// Id String representation of a resource id
+	Id        ObjectIdV1 `json:"id"`
+	Method    *string    `json:"method,omitempty"`
+	Path      *string    `json:"path,omitempty"`
+	Reason    *string    `json:"reason,omitempty"`
+	SubjectId string     `json:"subjectId"`
+	Type      string     `json:"type"`
+}
+
+// AuditEventsV1 defines model for auditEvents.v1.
+type AuditEventsV1 = []AuditEventV1
+
+// AuthenticationCacheInvalidationV1 defines model for authenticationCacheInvalidation.v1.
+type AuthenticationCacheInvalidationV1 struct {
+	// Token The session token to evict
+	Token string `json:"token"`
+}
+
+// AuthenticationCacheInvalidationResultV1 defines model for authenticationCacheInvalidationResult.v1.
+type AuthenticationCacheInvalidationResultV1 struct {
+	// Invalidated The number of evicted tokens
+	Invalidated int `json:"invalidated"`
+}
+
+// AuthorizationDecisionV1 defines model for authorizationDecision.v1.
+type AuthorizationDecisionV1 struct {
+	Allow bool `json:"allow"`
+
+	// FailedRules The rules for the requested method and path which didn't match
+	FailedRules  []AuthorizationPolicyRuleFailureV1 `json:"failedRules"`
+	MatchedRules []AuthorizationPolicyRuleV1        `json:"matchedRules"`
+	Method       string                             `json:"method"`
+	Path         string                             `json:"path"`
+
+	// PolicyHash The sha256 hash of the evaluated policy
+	PolicyHash *string `json:"policyHash,omitempty"`
+
+	// Restriction The access restrictions of the clinic which denied the request regardless of the policy
+	Restriction  *string `json:"restriction,omitempty"`
+	ServerAccess bool    `json:"serverAccess"`
+	SubjectId    *string `json:"subjectId,omitempty"`
+}
+
+// AuthorizationPolicyRuleV1 defines model for authorizationPolicyRule.v1.
+type AuthorizationPolicyRuleV1 struct {
+	// Description The comment preceding the rule
+	Description string `json:"description"`
+
+	// Line The line of the rule in the policy
+	Line int `json:"line"`
+}
+
+// AuthorizationPolicyRuleFailureV1 defines model for authorizationPolicyRuleFailure.v1.
+type AuthorizationPolicyRuleFailureV1 struct {
+	// Expressions The expressions of the rule which weren't satisfied
+	Expressions []string                  `json:"expressions"`
+	Rule        AuthorizationPolicyRuleV1 `json:"rule"`
+}
+
+// AuthorizationRequestV1 defines model for authorizationRequest.v1.
+type AuthorizationRequestV1 struct {
+	// ClientIp The ip address of the client, which is checked against the network restrictions of the clinic. Defaults to the ip address of the caller.
+	ClientIp *string                      `json:"clientIp,omitempty"`
+	Method   AuthorizationRequestV1Method `json:"method"`
+	Path     string                       `json:"path"`
+
+	// ServerAccess Whether the request is performed by a backend service
+	ServerAccess *bool `json:"serverAccess,omitempty"`
+
+	// SubjectId The user id of the subject performing the request
+	SubjectId *string `json:"subjectId,omitempty"`
+}
+
+// AuthorizationRequestV1Method defines model for AuthorizationRequestV1.Method.
+type AuthorizationRequestV1Method string
+
 // BgmPeriodV1 Summary of a specific BGM time period (currently: 1d, 7d, 14d, 30d)
 type BgmPeriodV1 struct {
 	// AverageDailyRecords Average daily readings
@@ -1261,9 +1532,267 @@ type ClinicV1ClinicType string
 // ClinicV1PreferredBgUnits defines model for ClinicV1.PreferredBgUnits.
 type ClinicV1PreferredBgUnits string
 
+// ClinicConsolidationV1 defines model for clinicConsolidation.v1.
+type ClinicConsolidationV1 struct {
+	// ConsolidationId String representation of a resource id
+	ConsolidationId ObjectIdV1 `json:"consolidationId"`
+
+	// Merges The merges of the source clinics in the order in which they are executed
+	Merges []ClinicMergeJobV1          `json:"merges"`
+	Status ClinicConsolidationV1Status `json:"status"`
+
+	// TargetClinicId Clinic identifier.
+	TargetClinicId *ClinicIdV1 `json:"targetClinicId,omitempty"`
+}
+
+// ClinicConsolidationV1Status defines model for ClinicConsolidationV1.Status.
+type ClinicConsolidationV1Status string
+
 // ClinicIdV1 Clinic identifier.
 type ClinicIdV1 = string
 
+// ClinicMergeDuplicateV1 A patient of the source clinic and the patients of the target clinic it likely duplicates
+type ClinicMergeDuplicateV1 struct {
+	Duplicates []ClinicMergeDuplicatePatientV1 `json:"duplicates"`
+
+	// Id String representation of a resource id
+	Id            ObjectIdV1                        `json:"id"`
+	Resolution    *ClinicMergeDuplicateResolutionV1 `json:"resolution,omitempty"`
+	SourcePatient PatientV1                         `json:"sourcePatient"`
+}
+
+// ClinicMergeDuplicatePatientV1 defines model for clinicMergeDuplicatePatient.v1.
+type ClinicMergeDuplicatePatientV1 struct {
+	ConflictCategories []ClinicMergeDuplicatePatientV1ConflictCategories `json:"conflictCategories"`
+	Patient            PatientV1                                         `json:"patient"`
+}
+
+// ClinicMergeDuplicatePatientV1ConflictCategories defines model for ClinicMergeDuplicatePatientV1.ConflictCategories.
+type ClinicMergeDuplicatePatientV1ConflictCategories string
+
+// ClinicMergeDuplicateResolutionV1 defines model for clinicMergeDuplicateResolution.v1.
+type ClinicMergeDuplicateResolutionV1 struct {
+	ResolvedTime *time.Time `json:"resolvedTime,omitempty"`
+
+	// TargetUserId String representation of a Tidepool User ID. Old style IDs are 10-digit strings consisting of only hexadeximcal digits. New style IDs are 36-digit [UUID v4](https://en.wikipedia.org/wiki/Universally_unique_identifier#Version_4_(random))
+	TargetUserId *Tidepooluserid `json:"targetUserId,omitempty"`
+
+	// Type `keepBoth` moves the source patient to the target clinic, `merge` merges the source patient into the record of the target patient and `discardCustodial` removes the record of the custodial patient
+	Type ClinicMergeDuplicateResolutionV1Type `json:"type"`
+}
+
+// ClinicMergeDuplicateResolutionV1Type `keepBoth` moves the source patient to the target clinic, `merge` merges the source patient into the record of the target patient and `discardCustodial` removes the record of the custodial patient
+type ClinicMergeDuplicateResolutionV1Type string
+
+// ClinicMergeDuplicatesV1 defines model for clinicMergeDuplicates.v1.
+type ClinicMergeDuplicatesV1 = []ClinicMergeDuplicateV1
+
+// ClinicMergeJobV1 defines model for clinicMergeJob.v1.
+type ClinicMergeJobV1 struct {
+	Attempts      int        `json:"attempts"`
+	CompletedTime *time.Time `json:"completedTime,omitempty"`
+
+	// ConsolidationId String representation of a resource id
+	ConsolidationId *ObjectIdV1          `json:"consolidationId,omitempty"`
+	CreatedTime     time.Time            `json:"createdTime"`
+	LastError       *string              `json:"lastError,omitempty"`
+	ModifiedTime    time.Time            `json:"modifiedTime"`
+	Phases          []ClinicMergePhaseV1 `json:"phases"`
+
+	// PlanId String representation of a resource id
+	PlanId         ObjectIdV1 `json:"planId"`
+	RolledBackTime *time.Time `json:"rolledBackTime,omitempty"`
+
+	// SourceClinicId Clinic identifier.
+	SourceClinicId *ClinicIdV1 `json:"sourceClinicId,omitempty"`
+
+	// Status The merge is retried after a failure until the status is `failed`. Merges which are `awaiting_review` are executed after they are started. Merges of a consolidation are `waiting` until the previous merge is completed, and `canceled` when it failed.
+	Status ClinicMergeJobV1Status `json:"status"`
+
+	// TargetClinicId Clinic identifier.
+	TargetClinicId *ClinicIdV1 `json:"targetClinicId,omitempty"`
+}
+
+// ClinicMergeJobV1Status The merge is retried after a failure until the status is `failed`. Merges which are `awaiting_review` are executed after they are started. Merges of a consolidation are `waiting` until the previous merge is completed, and `canceled` when it failed.
+type ClinicMergeJobV1Status string
+
+// ClinicMergePhaseV1 defines model for clinicMergePhase.v1.
+type ClinicMergePhaseV1 struct {
+	Completed int `json:"completed"`
+
+	// Reverted The number of plans reverted by the rollback of the merge
+	Reverted int                    `json:"reverted"`
+	Total    int                    `json:"total"`
+	Type     ClinicMergePhaseV1Type `json:"type"`
+}
+
+// ClinicMergePhaseV1Type defines model for ClinicMergePhaseV1.Type.
+type ClinicMergePhaseV1Type string
+
+// ClinicMergeReportV1 defines model for clinicMergeReport.v1.
+type ClinicMergeReportV1 struct {
+	CanMerge       bool                                `json:"canMerge"`
+	Clinicians     []ClinicMergeReportClinicianV1      `json:"clinicians"`
+	CreatedTime    time.Time                           `json:"createdTime"`
+	Errors         []string                            `json:"errors"`
+	Measures       ClinicMergeReportMeasuresV1         `json:"measures"`
+	Patients       []ClinicMergeReportPatientPlanV1    `json:"patients"`
+	PendingInvites []ClinicMergeReportPendingInvitesV1 `json:"pendingInvites"`
+	Settings       []ClinicMergeReportSettingV1        `json:"settings"`
+	Sites          []ClinicMergeReportSiteV1           `json:"sites"`
+	Source         ClinicMergeReportClinicV1           `json:"source"`
+	SourceClusters []ClinicMergeReportClusterV1        `json:"sourceClusters"`
+	Tags           []ClinicMergeReportTagV1            `json:"tags"`
+	Target         ClinicMergeReportClinicV1           `json:"target"`
+	TargetClusters []ClinicMergeReportClusterV1        `json:"targetClusters"`
+}
+
+// ClinicMergeReportClinicV1 defines model for clinicMergeReportClinic.v1.
+type ClinicMergeReportClinicV1 struct {
+	// Id Clinic identifier.
+	Id   *ClinicIdV1 `json:"id,omitempty"`
+	Name string      `json:"name"`
+}
+
+// ClinicMergeReportClinicianV1 defines model for clinicMergeReportClinician.v1.
+type ClinicMergeReportClinicianV1 struct {
+	Admin      bool     `json:"admin"`
+	Downgraded bool     `json:"downgraded"`
+	Email      string   `json:"email"`
+	Name       string   `json:"name"`
+	Workspaces []string `json:"workspaces"`
+}
+
+// ClinicMergeReportClusterV1 defines model for clinicMergeReportCluster.v1.
+type ClinicMergeReportClusterV1 struct {
+	Patients []ClinicMergeReportClusterPatientV1 `json:"patients"`
+}
+
+// ClinicMergeReportClusterPatientV1 defines model for clinicMergeReportClusterPatient.v1.
+type ClinicMergeReportClusterPatientV1 struct {
+	LikelyDuplicates []Tidepooluserid           `json:"likelyDuplicates"`
+	MrnOnlyMatches   []Tidepooluserid           `json:"mrnOnlyMatches"`
+	NameOnlyMatches  []Tidepooluserid           `json:"nameOnlyMatches"`
+	Patient          ClinicMergeReportPatientV1 `json:"patient"`
+}
+
+// ClinicMergeReportConflictV1 defines model for clinicMergeReportConflict.v1.
+type ClinicMergeReportConflictV1 struct {
+	Category ClinicMergeReportConflictV1Category `json:"category"`
+	Patient  ClinicMergeReportPatientV1          `json:"patient"`
+}
+
+// ClinicMergeReportConflictV1Category defines model for ClinicMergeReportConflictV1.Category.
+type ClinicMergeReportConflictV1Category string
+
+// ClinicMergeReportMeasuresV1 defines model for clinicMergeReportMeasures.v1.
+type ClinicMergeReportMeasuresV1 struct {
+	// Clinicians The number of resulting members and admins
+	Clinicians int `json:"clinicians"`
+
+	// DowngradedClinicians The number of members downgraded from admin
+	DowngradedClinicians int `json:"downgradedClinicians"`
+	DuplicateAccounts    int `json:"duplicateAccounts"`
+
+	// DuplicateTags The number of duplicate tags that will be merged
+	DuplicateTags           int `json:"duplicateTags"`
+	LikelyDuplicateAccounts int `json:"likelyDuplicateAccounts"`
+	MrnOnlyMatches          int `json:"mrnOnlyMatches"`
+	NameOnlyMatches         int `json:"nameOnlyMatches"`
+
+	// Patients The number of resulting patient accounts
+	Patients int `json:"patients"`
+
+	// RenamedSites The number of duplicate sites that will be renamed
+	RenamedSites int `json:"renamedSites"`
+
+	// Sites The number of resulting sites
+	Sites int `json:"sites"`
+
+	// Tags The number of resulting tags
+	Tags int `json:"tags"`
+}
+
+// ClinicMergeReportPatientV1 defines model for clinicMergeReportPatient.v1.
+type ClinicMergeReportPatientV1 struct {
+	BirthDate string `json:"birthDate"`
+	Claimed   bool   `json:"claimed"`
+	FullName  string `json:"fullName"`
+
+	// Id String representation of a Tidepool User ID. Old style IDs are 10-digit strings consisting of only hexadeximcal digits. New style IDs are 36-digit [UUID v4](https://en.wikipedia.org/wiki/Universally_unique_identifier#Version_4_(random))
+	Id             *Tidepooluserid `json:"id,omitempty"`
+	LastUploadTime *time.Time      `json:"lastUploadTime,omitempty"`
+	Mrn            string          `json:"mrn"`
+	Sites          []string        `json:"sites"`
+	Tags           []string        `json:"tags"`
+}
+
+// ClinicMergeReportPatientPlanV1 defines model for clinicMergeReportPatientPlan.v1.
+type ClinicMergeReportPatientPlanV1 struct {
+	// Action The planned action for the patient, e.g. MOVE, MERGE or RETAIN
+	Action    string                        `json:"action"`
+	Conflicts []ClinicMergeReportConflictV1 `json:"conflicts"`
+	Errors    []string                      `json:"errors"`
+	Patient   ClinicMergeReportPatientV1    `json:"patient"`
+	Result    *ClinicMergeReportPatientV1   `json:"result,omitempty"`
+	Workspace string                        `json:"workspace"`
+}
+
+// ClinicMergeReportPendingInvitesV1 defines model for clinicMergeReportPendingInvites.v1.
+type ClinicMergeReportPendingInvitesV1 struct {
+	Count     int    `json:"count"`
+	Workspace string `json:"workspace"`
+}
+
+// ClinicMergeReportSettingV1 defines model for clinicMergeReportSetting.v1.
+type ClinicMergeReportSettingV1 struct {
+	Match       bool   `json:"match"`
+	Name        string `json:"name"`
+	SourceValue string `json:"sourceValue"`
+	TargetValue string `json:"targetValue"`
+}
+
+// ClinicMergeReportSiteV1 defines model for clinicMergeReportSite.v1.
+type ClinicMergeReportSiteV1 struct {
+	Action    ClinicMergeReportSiteV1Action `json:"action"`
+	Name      string                        `json:"name"`
+	Workspace string                        `json:"workspace"`
+}
+
+// ClinicMergeReportSiteV1Action defines model for ClinicMergeReportSiteV1.Action.
+type ClinicMergeReportSiteV1Action string
+
+// ClinicMergeReportTagV1 defines model for clinicMergeReportTag.v1.
+type ClinicMergeReportTagV1 struct {
+	Merge      bool     `json:"merge"`
+	Name       string   `json:"name"`
+	Workspaces []string `json:"workspaces"`
+}
+
+// ClinicMergeRollbackIssueV1 defines model for clinicMergeRollbackIssue.v1.
+type ClinicMergeRollbackIssueV1 struct {
+	Id      string                         `json:"id"`
+	Message string                         `json:"message"`
+	Type    ClinicMergeRollbackIssueV1Type `json:"type"`
+}
+
+// ClinicMergeRollbackIssueV1Type defines model for ClinicMergeRollbackIssueV1.Type.
+type ClinicMergeRollbackIssueV1Type string
+
+// ClinicMergeRollbackReportV1 defines model for clinicMergeRollbackReport.v1.
+type ClinicMergeRollbackReportV1 struct {
+	CanRollback bool `json:"canRollback"`
+
+	// Conflicts Changes made after the merge which prevent the rollback
+	Conflicts []ClinicMergeRollbackIssueV1 `json:"conflicts"`
+
+	// PlanId String representation of a resource id
+	PlanId ObjectIdV1 `json:"planId"`
+
+	// Warnings Changes made after the merge which are overwritten by the rollback
+	Warnings []ClinicMergeRollbackIssueV1 `json:"warnings"`
+}
+
 // ClinicTimezoneV1 defines model for clinicTimezone.v1.
 type ClinicTimezoneV1 string
 
@@ -1279,9 +1808,14 @@ type ClinicianV1 struct {
 	InviteId *string `json:"inviteId,omitempty"`
 
 	// Name The name of the clinician
-	Name        *string          `json:"name,omitempty"`
-	Roles       ClinicianRolesV1 `json:"roles"`
-	UpdatedTime *time.Time       `json:"updatedTime,omitempty"`
+	Name *string `json:"name,omitempty"`
+
+	// Roles The built-in roles `CLINIC_ADMIN`, `CLINIC_MEMBER` and `PRESCRIBER`, or the names of custom roles defined by the clinic
+	Roles ClinicianRolesV1 `json:"roles"`
+
+	// Sites The sites the clinician is restricted to. The clinician has access to all patients when not set.
+	Sites       []SiteV1   `json:"sites,omitzero"`
+	UpdatedTime *time.Time `json:"updatedTime,omitempty"`
 }
 
 // ClinicianClinicRelationshipV1 defines model for clinicianClinicRelationship.v1.
@@ -1296,15 +1830,46 @@ type ClinicianClinicRelationshipV1 struct {
 // ClinicianClinicRelationshipsV1 defines model for clinicianClinicRelationships.v1.
 type ClinicianClinicRelationshipsV1 = []ClinicianClinicRelationshipV1
 
-// ClinicianRolesV1 defines model for clinicianRoles.v1.
+// ClinicianRoleV1 A named set of permissions which can be assigned to clinicians
+type ClinicianRoleV1 struct {
+	// BuiltIn Built-in roles are available in all clinics and can't be changed
+	BuiltIn     *bool                        `json:"builtIn,omitempty"`
+	Name        string                       `json:"name"`
+	Permissions []ClinicianRoleV1Permissions `json:"permissions"`
+}
+
+// ClinicianRoleV1Permissions defines model for ClinicianRoleV1.Permissions.
+type ClinicianRoleV1Permissions string
+
+// ClinicianRoleListV1 defines model for clinicianRoleList.v1.
+type ClinicianRoleListV1 struct {
+	Roles []ClinicianRoleV1 `json:"roles"`
+}
+
+// ClinicianRolesV1 The built-in roles `CLINIC_ADMIN`, `CLINIC_MEMBER` and `PRESCRIBER`, or the names of custom roles defined by the clinic
 type ClinicianRolesV1 = []string
 
+// ClinicianSitesV1 defines model for clinicianSites.v1.
+type ClinicianSitesV1 struct {
+	// Sites The ids of the sites the clinician is restricted to, or `null` to give the clinician access to all patients
+	Sites *[]ObjectIdV1 `json:"sites"`
+}
+
 // CliniciansV1 defines model for clinicians.v1.
 type CliniciansV1 = []ClinicianV1
 
 // ClinicsV1 defines model for clinics.v1.
 type ClinicsV1 = []ClinicV1
 
+// ConsolidateClinicsV1 defines model for consolidateClinics.v1.
+type ConsolidateClinicsV1 struct {
+	// SourceIds The clinics which are merged into the target clinic, in the order in which they are merged
+	SourceIds []ClinicIdV1 `json:"sourceIds"`
+
+	// SourceSites When true, the patients of each source clinic are assigned to a new site of the target clinic named after the source clinic
+	SourceSites *bool `json:"sourceSites,omitempty"`
+}
+
 // CountryV1 Country name.
 type CountryV1 = string
 
@@ -1329,6 +1894,14 @@ type CreatePatientV1 struct {
 	Tags        *PatientTagIdsV1      `json:"tags"`
 }
 
+// CreatedApiKeyV1 defines model for createdApiKey.v1.
+type CreatedApiKeyV1 struct {
+	ApiKey ApiKeyV1 `json:"apiKey"`
+
+	// Token The token must be sent as a bearer token in the authorization header
+	Token string `json:"token"`
+}
+
 // DataSourceV1 defines model for dataSource.v1.
 type DataSourceV1 struct {
 	// DataSourceId String representation of a resource id
@@ -1529,10 +2102,22 @@ type MembershipRestrictionsV1 struct {
 
 // MergeClinicV1 defines model for mergeClinic.v1.
 type MergeClinicV1 struct {
+	// ReviewDuplicates When true, the merge is not executed until the duplicate patients are reviewed and the merge is started
+	ReviewDuplicates *bool `json:"reviewDuplicates,omitempty"`
+
 	// SourceId Clinic identifier.
 	SourceId *ClinicIdV1 `json:"sourceId,omitempty"`
 }
 
+// MergePatientDuplicateV1 defines model for mergePatientDuplicate.v1.
+type MergePatientDuplicateV1 struct {
+	// DuplicateId String representation of a Tidepool User ID. Old style IDs are 10-digit strings consisting of only hexadeximcal digits. New style IDs are 36-digit [UUID v4](https://en.wikipedia.org/wiki/Universally_unique_identifier#Version_4_(random))
+	DuplicateId *Tidepooluserid `json:"duplicateId,omitempty"`
+
+	// PatientId String representation of a Tidepool User ID. Old style IDs are 10-digit strings consisting of only hexadeximcal digits. New style IDs are 36-digit [UUID v4](https://en.wikipedia.org/wiki/Universally_unique_identifier#Version_4_(random))
+	PatientId *Tidepooluserid `json:"patientId,omitempty"`
+}
+
 // MetaV1 defines model for meta.v1.
 type MetaV1 struct {
 	// Count The number of items matching the filter
@@ -1545,7 +2130,19 @@ type MetaV1 struct {
 // MigrationV1 defines model for migration.v1.
 type MigrationV1 struct {
 	AttestationTime *time.Time `json:"attestationTime,omitempty"`
-	CreatedTime     *time.Time `json:"createdTime,omitempty"`
+
+	// ClinicId String representation of a resource id
+	ClinicId    *ObjectIdV1 `json:"clinicId,omitempty"`
+	CreatedTime *time.Time  `json:"createdTime,omitempty"`
+
+	// Error The reason of the failure of a failed migration.
+	Error *string `json:"error,omitempty"`
+
+	// PatientsFailed The number of patients which couldn't be migrated.
+	PatientsFailed *int `json:"patientsFailed,omitempty"`
+
+	// PatientsMigrated The number of patients migrated so far.
+	PatientsMigrated *int `json:"patientsMigrated,omitempty"`
 
 	// Status The current status of the migration
 	Status      *MigrationStatusV1 `json:"status,omitempty"`
@@ -1558,8 +2155,17 @@ type MigrationV1 struct {
 // MigrationStatusV1 The current status of the migration
 type MigrationStatusV1 string
 
-// MigrationUpdateV1 defines model for migrationUpdate.v1.
+// MigrationUpdateV1 Transitions a migration to the status. Pending migrations can start running or fail, and running migrations can complete or fail. Failed migrations are retried with the retry endpoint. Running migrations can be updated to report their progress.
 type MigrationUpdateV1 struct {
+	// Error The reason of the failure. Required when the status is FAILED.
+	Error *string `json:"error,omitempty"`
+
+	// PatientsFailed The number of patients which couldn't be migrated.
+	PatientsFailed *int `json:"patientsFailed,omitempty"`
+
+	// PatientsMigrated The number of patients migrated so far.
+	PatientsMigrated *int `json:"patientsMigrated,omitempty"`
+
 	// Status The current status of the migration
 	Status MigrationStatusV1 `json:"status"`
 }
@@ -1665,6 +2271,47 @@ type PatientCountSettingsV1 struct {
 	SoftLimit *PatientCountLimitV1 `json:"softLimit,omitempty"`
 }
 
+// PatientDuplicateV1 defines model for patientDuplicate.v1.
+type PatientDuplicateV1 struct {
+	BirthDate  *openapi_types.Date       `json:"birthDate,omitempty"`
+	Duplicates []PatientDuplicateMatchV1 `json:"duplicates"`
+	Email      *string                   `json:"email,omitempty"`
+	FullName   *string                   `json:"fullName,omitempty"`
+
+	// Id String representation of a Tidepool User ID. Old style IDs are 10-digit strings consisting of only hexadeximcal digits. New style IDs are 36-digit [UUID v4](https://en.wikipedia.org/wiki/Universally_unique_identifier#Version_4_(random))
+	Id          *Tidepooluserid `json:"id,omitempty"`
+	IsCustodial bool            `json:"isCustodial"`
+	Mrn         *string         `json:"mrn,omitempty"`
+}
+
+// PatientDuplicateClusterV1 A group of patients which are likely duplicates of each other
+type PatientDuplicateClusterV1 struct {
+	Patients []PatientDuplicateV1 `json:"patients"`
+}
+
+// PatientDuplicateMatchV1 defines model for patientDuplicateMatch.v1.
+type PatientDuplicateMatchV1 struct {
+	ConflictCategory PatientDuplicateMatchV1ConflictCategory `json:"conflictCategory"`
+
+	// Id String representation of a Tidepool User ID. Old style IDs are 10-digit strings consisting of only hexadeximcal digits. New style IDs are 36-digit [UUID v4](https://en.wikipedia.org/wiki/Universally_unique_identifier#Version_4_(random))
+	Id *Tidepooluserid `json:"id,omitempty"`
+}
+
+// PatientDuplicateMatchV1ConflictCategory defines model for PatientDuplicateMatchV1.ConflictCategory.
+type PatientDuplicateMatchV1ConflictCategory string
+
+// PatientDuplicateScanV1 defines model for patientDuplicateScan.v1.
+type PatientDuplicateScanV1 struct {
+	// ClinicId Clinic identifier.
+	ClinicId     *ClinicIdV1                 `json:"clinicId,omitempty"`
+	Clusters     []PatientDuplicateClusterV1 `json:"clusters"`
+	NextScanTime *time.Time                  `json:"nextScanTime,omitempty"`
+
+	// PatientCount The number of patients which were scanned
+	PatientCount int       `json:"patientCount"`
+	ScanTime     time.Time `json:"scanTime"`
+}
+
 // PatientPermissionsV1 defines model for patientPermissions.v1.
 type PatientPermissionsV1 struct {
 	Custodian *map[string]interface{} `json:"custodian,omitempty"`
@@ -1781,6 +2428,15 @@ type SiteV1 struct {
 	// Name The site's name.
 	Name        SiteNameV1 `json:"name"`
 	NumPatients int        `json:"numPatients,omitempty,omitzero"`
+
+	// ParentId The id of the site which contains this site, e.g. the region of a clinic. Only set on the sites of clinics.
+	ParentId *string `json:"parentId,omitempty"`
+
+	// Settings Overrides of the clinic settings for the patients assigned to the site. Unset values are inherited from the closest ancestor of the site which sets them, or from the clinic. When a patient is assigned to multiple sites, the first of the sites which sets a value takes precedence.
+	Settings *SiteSettingsV1 `json:"settings,omitempty"`
+
+	// TotalPatients The number of patients assigned to the site or to any of its descendants.
+	TotalPatients int `json:"totalPatients,omitempty,omitzero"`
 }
 
 // SiteByIdV1 A clinic's physical or logical location—id only.
@@ -1793,6 +2449,9 @@ type SiteByIdV1 struct {
 type SiteCreationV1 struct {
 	// Name The site's name.
 	Name SiteNameV1 `json:"name"`
+
+	// ParentId String representation of a resource id
+	ParentId *ObjectidV1 `json:"parentId,omitempty"`
 }
 
 // SiteIdV1 defines model for siteId.v1.
@@ -1801,6 +2460,40 @@ type SiteIdV1 = string
 // SiteNameV1 The site's name.
 type SiteNameV1 = string
 
+// SiteParentV1 The site which contains a site. A site without a parent is at the root of the hierarchy.
+type SiteParentV1 struct {
+	ParentId *ObjectidV1 `json:"parentId"`
+}
+
+// SiteSettingsV1 Overrides of the clinic settings for the patients assigned to the site. Unset values are inherited from the closest ancestor of the site which sets them, or from the clinic. When a patient is assigned to multiple sites, the first of the sites which sets a value takes precedence.
+type SiteSettingsV1 struct {
+	EhrDestinationIds *EhrDestinationsV1              `json:"ehrDestinationIds,omitempty"`
+	PreferredBgUnits  *SiteSettingsV1PreferredBgUnits `json:"preferredBgUnits,omitempty"`
+
+	// ScheduledReports Scheduled Report Settings
+	ScheduledReports *ScheduledReportsV1 `json:"scheduledReports,omitempty"`
+	Timezone         *ClinicTimezoneV1   `json:"timezone,omitempty"`
+}
+
+// SiteSettingsV1PreferredBgUnits defines model for SiteSettingsV1.PreferredBgUnits.
+type SiteSettingsV1PreferredBgUnits string
+
+// SplitClinicV1 The selection of the patients and clinicians moved to the new clinic. The moved patients are the union of the patients assigned to the site, the patients with the tag and the listed patients.
+type SplitClinicV1 struct {
+	// ClinicianIds The user ids of the clinicians moved to the new clinic. At least one of them must be an admin.
+	ClinicianIds []Tidepooluserid `json:"clinicianIds"`
+
+	// Name The name of the new clinic
+	Name       string            `json:"name"`
+	PatientIds *[]Tidepooluserid `json:"patientIds,omitempty"`
+
+	// PatientTagId String representation of a resource id
+	PatientTagId *ObjectIdV1 `json:"patientTagId,omitempty"`
+
+	// SiteId String representation of a resource id
+	SiteId *ObjectIdV1 `json:"siteId,omitempty"`
+}
+
 // StateV1 State or province. In the U.S., typically something like `CA` or `California`.
 type StateV1 = string
 
@@ -1992,18 +2685,104 @@ type UpdateUserDetailsV1 struct {
 	Email *openapi_types.Email `json:"email,omitempty"`
 }
 
+// XealthPatientReportViewStatusV1 defines model for xealthPatientReportViewStatus.v1.
+type XealthPatientReportViewStatusV1 struct {
+	FullName       *string   `json:"fullName,omitempty"`
+	LastUploadTime time.Time `json:"lastUploadTime"`
+
+	// LastViewedTime The time the report was last viewed by any user. Not set if the report was never viewed.
+	LastViewedTime *time.Time `json:"lastViewedTime,omitempty"`
+	Mrn            *string    `json:"mrn,omitempty"`
+	PatientUserId  string     `json:"patientUserId"`
+}
+
+// XealthPatientsNotViewedResponseV1 defines model for xealthPatientsNotViewedResponse.v1.
+type XealthPatientsNotViewedResponseV1 struct {
+	Data []XealthPatientReportViewStatusV1 `json:"data"`
+	Meta MetaV1                            `json:"meta"`
+}
+
+// XealthReportViewV1 defines model for xealthReportView.v1.
+type XealthReportViewV1 struct {
+	CreatedTime time.Time `json:"createdTime"`
+
+	// Deployment The Xealth deployment
+	Deployment string `json:"deployment"`
+
+	// Id String representation of a resource id
+	Id ObjectIdV1 `json:"id"`
+
+	// PatientUserId The user id of the patient whose report was viewed
+	PatientUserId string `json:"patientUserId"`
+
+	// ProgramId The Xealth program id
+	ProgramId string `json:"programId"`
+
+	// SystemLogin The EHR system login of the user who viewed the report
+	SystemLogin *string `json:"systemLogin,omitempty"`
+
+	// UserId The id of the EHR user who viewed the report
+	UserId string `json:"userId"`
+}
+
+// XealthReportViewPeriodStatsV1 defines model for xealthReportViewPeriodStats.v1.
+type XealthReportViewPeriodStatsV1 struct {
+	// StartTime The start of the period
+	StartTime      time.Time `json:"startTime"`
+	UniquePatients int       `json:"uniquePatients"`
+	UniqueViewers  int       `json:"uniqueViewers"`
+	Views          int       `json:"views"`
+}
+
+// XealthReportViewStatsV1 defines model for xealthReportViewStats.v1.
+type XealthReportViewStatsV1 struct {
+	Periods        []XealthReportViewPeriodStatsV1 `json:"periods"`
+	UniquePatients int                             `json:"uniquePatients"`
+	UniqueViewers  int                             `json:"uniqueViewers"`
+	Viewers        []XealthReportViewerStatsV1     `json:"viewers"`
+	Views          int                             `json:"views"`
+}
+
+// XealthReportViewerStatsV1 defines model for xealthReportViewerStats.v1.
+type XealthReportViewerStatsV1 struct {
+	LastViewedTime time.Time `json:"lastViewedTime"`
+
+	// SystemLogin The most recent EHR system login of the user
+	SystemLogin    *string `json:"systemLogin,omitempty"`
+	UniquePatients int     `json:"uniquePatients"`
+
+	// UserId The id of the EHR user
+	UserId string `json:"userId"`
+	Views  int    `json:"views"`
+}
+
+// XealthReportViewsResponseV1 defines model for xealthReportViewsResponse.v1.
+type XealthReportViewsResponseV1 struct {
+	Data []XealthReportViewV1 `json:"data"`
+	Meta MetaV1               `json:"meta"`
+}
+
+// ApiKeyId String representation of a resource id
+type ApiKeyId = ObjectIdV1
+
 // ClinicId defines model for clinicId.
 type ClinicId = string
 
 // ClinicianId defines model for clinicianId.
 type ClinicianId = string
 
+// ConsolidationId String representation of a resource id
+type ConsolidationId = ObjectIdV1
+
 // CreatedTimeEnd defines model for createdTimeEnd.
 type CreatedTimeEnd = time.Time
 
 // CreatedTimeStart defines model for createdTimeStart.
 type CreatedTimeStart = time.Time
 
+// DuplicateId String representation of a resource id
+type DuplicateId = ObjectIdV1
+
 // EhrEnabled defines model for ehrEnabled.
 type EhrEnabled = bool
 
@@ -2025,9 +2804,18 @@ type PatientId = string
 // PatientTagId defines model for patientTagId.
 type PatientTagId = string
 
+// PatientUserId defines model for patientUserId.
+type PatientUserId = string
+
+// PlanId String representation of a resource id
+type PlanId = ObjectIdV1
+
 // ProviderId defines model for providerId.
 type ProviderId = ProviderIdV1
 
+// ReportViewInterval defines model for reportViewInterval.
+type ReportViewInterval string
+
 // Role defines model for role.
 type Role = string
 
@@ -2046,6 +2834,9 @@ type Sort = string
 // SummaryId Summary Unique Identifier
 type SummaryId = SummaryIdV1
 
+// SystemLogin defines model for systemLogin.
+type SystemLogin = string
+
 // UserId String representation of a Tidepool User ID. Old style IDs are 10-digit strings consisting of only hexadeximcal digits. New style IDs are 36-digit [UUID v4](https://en.wikipedia.org/wiki/Universally_unique_identifier#Version_4_(random))
 type UserId = Tidepooluserid
 
@@ -2083,6 +2874,21 @@ type ListClinicsParams struct {
 	EhrEnabled *EhrEnabled `form:"ehrEnabled,omitempty" json:"ehrEnabled,omitempty"`
 }
 
+// ListAPIKeysParams defines parameters for ListAPIKeys.
+type ListAPIKeysParams struct {
+	// Active Return only the keys which are not revoked or expired
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+}
+
+// ListAuditEventsParams defines parameters for ListAuditEvents.
+type ListAuditEventsParams struct {
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Type Return only the events of the type
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+}
+
 // ListCliniciansParams defines parameters for ListClinicians.
 type ListCliniciansParams struct {
 	// Search Full text search query
@@ -2493,6 +3299,53 @@ type TideReportParams struct {
 // TideReportParamsCategories defines parameters for TideReport.
 type TideReportParamsCategories string
 
+// ListXealthPatientsNotViewedParams defines parameters for ListXealthPatientsNotViewed.
+type ListXealthPatientsNotViewedParams struct {
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListXealthReportViewsParams defines parameters for ListXealthReportViews.
+type ListXealthReportViewsParams struct {
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// CreatedTimeStart Return records created after the given date (inclusive)
+	CreatedTimeStart *CreatedTimeStart `form:"createdTimeStart,omitempty" json:"createdTimeStart,omitempty"`
+
+	// CreatedTimeEnd Return records created before the given date (exclusive)
+	CreatedTimeEnd *CreatedTimeEnd `form:"createdTimeEnd,omitempty" json:"createdTimeEnd,omitempty"`
+
+	// PatientUserId Return only records of the patient with the given user id
+	PatientUserId *PatientUserId `form:"patientUserId,omitempty" json:"patientUserId,omitempty"`
+
+	// SystemLogin Return only records of the EHR user with the given system login
+	SystemLogin *SystemLogin `form:"systemLogin,omitempty" json:"systemLogin,omitempty"`
+}
+
+// GetXealthReportViewStatsParams defines parameters for GetXealthReportViewStats.
+type GetXealthReportViewStatsParams struct {
+	// CreatedTimeStart Return records created after the given date (inclusive)
+	CreatedTimeStart *CreatedTimeStart `form:"createdTimeStart,omitempty" json:"createdTimeStart,omitempty"`
+
+	// CreatedTimeEnd Return records created before the given date (exclusive)
+	CreatedTimeEnd *CreatedTimeEnd `form:"createdTimeEnd,omitempty" json:"createdTimeEnd,omitempty"`
+
+	// Interval The length of the periods report views are aggregated by
+	Interval *GetXealthReportViewStatsParamsInterval `form:"interval,omitempty" json:"interval,omitempty"`
+}
+
+// GetXealthReportViewStatsParamsInterval defines parameters for GetXealthReportViewStats.
+type GetXealthReportViewStatsParamsInterval string
+
+// ListIncompleteMigrationsParams defines parameters for ListIncompleteMigrations.
+type ListIncompleteMigrationsParams struct {
+	// UpdatedBefore Only return the migrations which haven't been updated since this time.
+	UpdatedBefore *time.Time `form:"updatedBefore,omitempty" json:"updatedBefore,omitempty"`
+	Offset        *Offset    `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit         *Limit     `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // FindPatientsParams defines parameters for FindPatients.
 type FindPatientsParams struct {
 	Mrn       *string `form:"mrn,omitempty" json:"mrn,omitempty"`
@@ -2526,18 +3379,39 @@ type ViewPDFReportParams struct {
 	RestrictedToken string `form:"restricted_token" json:"restricted_token"`
 }
 
+// InvalidateAuthenticationCacheJSONRequestBody defines body for InvalidateAuthenticationCache for application/json ContentType.
+type InvalidateAuthenticationCacheJSONRequestBody = AuthenticationCacheInvalidationV1
+
+// EvaluateAuthorizationPolicyJSONRequestBody defines body for EvaluateAuthorizationPolicy for application/json ContentType.
+type EvaluateAuthorizationPolicyJSONRequestBody = AuthorizationRequestV1
+
 // CreateClinicJSONRequestBody defines body for CreateClinic for application/json ContentType.
 type CreateClinicJSONRequestBody = ClinicV1
 
 // UpdateClinicJSONRequestBody defines body for UpdateClinic for application/json ContentType.
 type UpdateClinicJSONRequestBody = ClinicV1
 
+// UpdateAccessRestrictionsJSONRequestBody defines body for UpdateAccessRestrictions for application/json ContentType.
+type UpdateAccessRestrictionsJSONRequestBody = AccessRestrictionsV1
+
+// CreateAPIKeyJSONRequestBody defines body for CreateAPIKey for application/json ContentType.
+type CreateAPIKeyJSONRequestBody = ApiKeyCreationV1
+
+// UpdateClinicianRolesJSONRequestBody defines body for UpdateClinicianRoles for application/json ContentType.
+type UpdateClinicianRolesJSONRequestBody = ClinicianRoleListV1
+
 // CreateClinicianJSONRequestBody defines body for CreateClinician for application/json ContentType.
 type CreateClinicianJSONRequestBody = ClinicianV1
 
 // UpdateClinicianJSONRequestBody defines body for UpdateClinician for application/json ContentType.
 type UpdateClinicianJSONRequestBody = ClinicianV1
 
+// UpdateClinicianSitesJSONRequestBody defines body for UpdateClinicianSites for application/json ContentType.
+type UpdateClinicianSitesJSONRequestBody = ClinicianSitesV1
+
+// ConsolidateClinicsJSONRequestBody defines body for ConsolidateClinics for application/json ContentType.
+type ConsolidateClinicsJSONRequestBody = ConsolidateClinicsV1
+
 // AssociateClinicianToUserJSONRequestBody defines body for AssociateClinicianToUser for application/json ContentType.
 type AssociateClinicianToUserJSONRequestBody = AssociateClinicianToUserV1
 
@@ -2547,6 +3421,9 @@ type UpdateMembershipRestrictionsJSONRequestBody = MembershipRestrictionsV1
 // MergeClinicJSONRequestBody defines body for MergeClinic for application/json ContentType.
 type MergeClinicJSONRequestBody = MergeClinicV1
 
+// ResolveClinicMergeDuplicateJSONRequestBody defines body for ResolveClinicMergeDuplicate for application/json ContentType.
+type ResolveClinicMergeDuplicateJSONRequestBody = ClinicMergeDuplicateResolutionV1
+
 // TriggerInitialMigrationJSONRequestBody defines body for TriggerInitialMigration for application/json ContentType.
 type TriggerInitialMigrationJSONRequestBody = TriggerMigrationV1
 
@@ -2556,6 +3433,9 @@ type MigrateLegacyClinicianPatientsJSONRequestBody = MigrationV1
 // UpdateMigrationJSONRequestBody defines body for UpdateMigration for application/json ContentType.
 type UpdateMigrationJSONRequestBody = MigrationUpdateV1
 
+// MergePatientDuplicateJSONRequestBody defines body for MergePatientDuplicate for application/json ContentType.
+type MergePatientDuplicateJSONRequestBody = MergePatientDuplicateV1
+
 // CreatePatientTagJSONRequestBody defines body for CreatePatientTag for application/json ContentType.
 type CreatePatientTagJSONRequestBody = PatientTagV1
 
@@ -2580,9 +3460,15 @@ type UpdatePatientJSONRequestBody = PatientV1
 // UpdatePatientPermissionsJSONRequestBody defines body for UpdatePatientPermissions for application/json ContentType.
 type UpdatePatientPermissionsJSONRequestBody = PatientPermissionsV1
 
+// GenerateConsolidationReportJSONRequestBody defines body for GenerateConsolidationReport for application/json ContentType.
+type GenerateConsolidationReportJSONRequestBody = ConsolidateClinicsV1
+
 // GenerateMergeReportJSONRequestBody defines body for GenerateMergeReport for application/json ContentType.
 type GenerateMergeReportJSONRequestBody = GenerateMergeReportV1
 
+// GenerateSplitReportJSONRequestBody defines body for GenerateSplitReport for application/json ContentType.
+type GenerateSplitReportJSONRequestBody = SplitClinicV1
+
 // AddServiceAccountJSONRequestBody defines body for AddServiceAccount for application/json ContentType.
 type AddServiceAccountJSONRequestBody = AddServiceAccountV1
 
@@ -2604,6 +3490,15 @@ type UpdateSiteJSONRequestBody = SiteV1
 // MergeSiteJSONRequestBody defines body for MergeSite for application/json ContentType.
 type MergeSiteJSONRequestBody = SiteByIdV1
 
+// UpdateSiteParentJSONRequestBody defines body for UpdateSiteParent for application/json ContentType.
+type UpdateSiteParentJSONRequestBody = SiteParentV1
+
+// UpdateSiteSettingsJSONRequestBody defines body for UpdateSiteSettings for application/json ContentType.
+type UpdateSiteSettingsJSONRequestBody = SiteSettingsV1
+
+// SplitClinicJSONRequestBody defines body for SplitClinic for application/json ContentType.
+type SplitClinicJSONRequestBody = SplitClinicV1
+
 // UpdateSuppressedNotificationsJSONRequestBody defines body for UpdateSuppressedNotifications for application/json ContentType.
 type UpdateSuppressedNotificationsJSONRequestBody = UpdateSuppressedNotifications
 
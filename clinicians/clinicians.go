@@ -10,6 +10,7 @@ import (
 
 	"github.com/tidepool-org/clinic/deletions"
 	"github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/sites"
 	"github.com/tidepool-org/clinic/store"
 )
 
@@ -37,6 +38,11 @@ type Service interface {
 	GetInvite(ctx context.Context, clinicId, inviteId string) (*Clinician, error)
 	DeleteInvite(ctx context.Context, clinicId, inviteId string) error
 	AssociateInvite(ctx context.Context, associate AssociateInvite) (*Clinician, error)
+	ListRoles(ctx context.Context, clinicId string) ([]Role, error)
+	UpdateCustomRoles(ctx context.Context, clinicId string, roles []Role) ([]Role, error)
+	ResolvePermissions(ctx context.Context, clinician *Clinician) ([]string, error)
+	AssignSites(ctx context.Context, clinicId, clinicianId string, siteIds *[]string) (*Clinician, error)
+	ResolveSites(ctx context.Context, clinician *Clinician) ([]sites.Site, error)
 }
 
 type Repository interface {
@@ -50,6 +56,10 @@ type Repository interface {
 	GetInvite(ctx context.Context, clinicId, inviteId string) (*Clinician, error)
 	DeleteInvite(ctx context.Context, clinicId, inviteId string) error
 	AssociateInvite(ctx context.Context, associate AssociateInvite) (*Clinician, error)
+	AssignSites(ctx context.Context, clinicId, clinicianId string, sites *[]sites.Site) (*Clinician, error)
+	DeleteSites(ctx context.Context, clinicId, siteId string) error
+	MergeSites(ctx context.Context, clinicId, sourceSiteId string, targetSite *sites.Site) error
+	UpdateSites(ctx context.Context, clinicId, siteId string, site *sites.Site) error
 }
 
 type AssociateInvite struct {
@@ -82,8 +92,11 @@ type Clinician struct {
 	Roles            []string            `bson:"roles"`
 	RolesUpdates     []RolesUpdate       `bson:"rolesUpdates,omitempty"`
 	IsServiceAccount bool                `bson:"isServiceAccount,omitempty"`
-	CreatedTime      time.Time           `bson:"createdTime"`
-	UpdatedTime      time.Time           `bson:"updatedTime"`
+	// Sites restricts the patients the clinician can access to the patients assigned to at least one of the
+	// sites. The clinician has access to all patients when the sites are not set. Admins are never restricted.
+	Sites       *[]sites.Site `bson:"sites,omitempty"`
+	CreatedTime time.Time     `bson:"createdTime"`
+	UpdatedTime time.Time     `bson:"updatedTime"`
 }
 
 type RolesUpdate struct {
@@ -102,6 +115,25 @@ func (c *Clinician) IsAdmin() bool {
 	return isAdmin
 }
 
+// IsSiteRestricted returns true if the clinician can only access the patients assigned to at least one of the
+// clinician's sites. Clinicians without assigned sites have access to all patients and admins are never restricted.
+func (c *Clinician) IsSiteRestricted() bool {
+	return c.Sites != nil && !c.IsAdmin()
+}
+
+// GetSiteIds returns the ids of the sites assigned to the clinician
+func (c *Clinician) GetSiteIds() []string {
+	if c.Sites == nil {
+		return nil
+	}
+
+	ids := make([]string, 0, len(*c.Sites))
+	for _, site := range *c.Sites {
+		ids = append(ids, site.Id.Hex())
+	}
+	return ids
+}
+
 func (c *Clinician) RolesChanged(newRoles []string) bool {
 	if len(c.Roles) != len(newRoles) {
 		return true
@@ -0,0 +1,44 @@
+package clinicians_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tidepool-org/clinic/clinicians"
+	"github.com/tidepool-org/clinic/sites"
+)
+
+var _ = Describe("Clinician", func() {
+	Describe("IsSiteRestricted", func() {
+		It("doesn't restrict clinicians without sites", func() {
+			clinician := clinicians.Clinician{Roles: []string{clinicians.RoleClinicMember}}
+			Expect(clinician.IsSiteRestricted()).To(BeFalse())
+		})
+
+		It("restricts clinicians with sites", func() {
+			site := sites.New("North")
+			clinician := clinicians.Clinician{
+				Roles: []string{clinicians.RoleClinicMember},
+				Sites: &[]sites.Site{*site},
+			}
+			Expect(clinician.IsSiteRestricted()).To(BeTrue())
+			Expect(clinician.GetSiteIds()).To(ConsistOf(site.Id.Hex()))
+		})
+
+		It("restricts clinicians whose sites were all deleted", func() {
+			clinician := clinicians.Clinician{
+				Roles: []string{clinicians.RoleClinicMember},
+				Sites: &[]sites.Site{},
+			}
+			Expect(clinician.IsSiteRestricted()).To(BeTrue())
+		})
+
+		It("doesn't restrict clinic admins", func() {
+			clinician := clinicians.Clinician{
+				Roles: []string{clinicians.RoleClinicAdmin},
+				Sites: &[]sites.Site{*sites.New("North")},
+			}
+			Expect(clinician.IsSiteRestricted()).To(BeFalse())
+		})
+	})
+})
@@ -15,6 +15,7 @@ import (
 	"github.com/tidepool-org/clinic/clinicians"
 	"github.com/tidepool-org/clinic/deletions"
 	"github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/sites"
 	"github.com/tidepool-org/clinic/store"
 )
 
@@ -340,6 +341,105 @@ func (r *Repository) AssociateInvite(ctx context.Context, associate clinicians.A
 	return r.updateOne(ctx, idSelector, update)
 }
 
+func (r *Repository) AssignSites(ctx context.Context, clinicId, clinicianId string, sites *[]sites.Site) (*clinicians.Clinician, error) {
+	selector := clinicianSelector(clinicId, clinicianId)
+
+	var update bson.M
+	if sites == nil {
+		update = bson.M{
+			"$unset": bson.M{"sites": ""},
+			"$set":   bson.M{"updatedTime": time.Now()},
+		}
+	} else {
+		update = bson.M{
+			"$set": bson.M{
+				"sites":       sites,
+				"updatedTime": time.Now(),
+			},
+		}
+	}
+
+	return r.updateOne(ctx, selector, update)
+}
+
+// DeleteSites removes the site from the assignments of all clinicians of the clinic. Clinicians
+// which were only assigned to the deleted site remain restricted and lose access to all patients.
+func (r *Repository) DeleteSites(ctx context.Context, clinicId, siteId string) error {
+	clinicOID, err := primitive.ObjectIDFromHex(clinicId)
+	if err != nil {
+		return fmt.Errorf("parsing clinic's ObjectId (%s): %w", clinicId, err)
+	}
+	siteOID, err := primitive.ObjectIDFromHex(siteId)
+	if err != nil {
+		return fmt.Errorf("parsing site's ObjectId (%s): %w", siteId, err)
+	}
+	selector := bson.M{
+		"clinicId": clinicOID,
+		"sites.id": siteOID,
+	}
+	update := bson.M{
+		"$pull": bson.M{"sites": bson.M{"id": siteOID}},
+		"$set":  bson.M{"updatedTime": time.Now()},
+	}
+	if _, err := r.collection.UpdateMany(ctx, selector, update); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MergeSites assigns the target site to all clinicians assigned to the source site and removes the source site
+func (r *Repository) MergeSites(ctx context.Context, clinicId, sourceSiteId string, targetSite *sites.Site) error {
+	clinicOID, err := primitive.ObjectIDFromHex(clinicId)
+	if err != nil {
+		return fmt.Errorf("parsing clinic's ObjectId: %w", err)
+	}
+	sourceSiteOID, err := primitive.ObjectIDFromHex(sourceSiteId)
+	if err != nil {
+		return fmt.Errorf("parsing source site's ObjectId: %w", err)
+	}
+
+	selector := bson.M{
+		"clinicId": clinicOID,
+		"$and": bson.A{
+			bson.M{"sites.id": bson.M{"$eq": sourceSiteOID}},
+			bson.M{"sites.id": bson.M{"$nin": bson.A{targetSite.Id}}},
+		},
+	}
+	update := bson.M{
+		"$push":        bson.M{"sites": sites.Site{Id: targetSite.Id, Name: targetSite.Name}},
+		"$currentDate": bson.M{"updatedTime": true},
+	}
+	if _, err := r.collection.UpdateMany(ctx, selector, update); err != nil {
+		return err
+	}
+
+	return r.DeleteSites(ctx, clinicId, sourceSiteId)
+}
+
+// UpdateSites updates the denormalized name of the site in the assignments of all clinicians of the clinic
+func (r *Repository) UpdateSites(ctx context.Context, clinicId, siteId string, site *sites.Site) error {
+	clinicOID, err := primitive.ObjectIDFromHex(clinicId)
+	if err != nil {
+		return fmt.Errorf("parsing clinic's ObjectId: %w", err)
+	}
+	siteOID, err := primitive.ObjectIDFromHex(siteId)
+	if err != nil {
+		return fmt.Errorf("parsing site's ObjectId: %w", err)
+	}
+	selector := bson.M{
+		"clinicId": clinicOID,
+		"sites.id": siteOID,
+	}
+	update := bson.M{
+		"$set":         bson.M{"sites.$.name": site.Name},
+		"$currentDate": bson.M{"updatedTime": true},
+	}
+	if _, err := r.collection.UpdateMany(ctx, selector, update); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (r *Repository) getOne(ctx context.Context, selector bson.M) (*clinicians.Clinician, error) {
 	clinician := &clinicians.Clinician{}
 	err := r.collection.FindOne(ctx, selector).Decode(clinician)
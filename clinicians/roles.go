@@ -0,0 +1,179 @@
+package clinicians
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/tidepool-org/clinic/errors"
+)
+
+const (
+	RolePrescriber = "PRESCRIBER"
+
+	PermissionClinicWrite       = "clinic:write"
+	PermissionCliniciansRead    = "clinicians:read"
+	PermissionCliniciansWrite   = "clinicians:write"
+	PermissionPatientsRead      = "patients:read"
+	PermissionPatientsWrite     = "patients:write"
+	PermissionPatientsDelete    = "patients:delete"
+	PermissionPatientTagsWrite  = "patient_tags:write"
+	PermissionPatientTagsManage = "patient_tags:manage"
+	PermissionSettingsRead      = "settings:read"
+	PermissionBillingRead       = "billing:read"
+	PermissionMigrationsRead    = "migrations:read"
+
+	MaxCustomRolesPerClinic = 20
+	customRoleNameFormat    = "^[A-Z][A-Z0-9_]{0,63}$"
+)
+
+var (
+	ErrInvalidRole  = fmt.Errorf("%w: invalid clinician role", errors.BadRequest)
+	ErrRoleInUse    = fmt.Errorf("%w: the role is assigned to clinicians", errors.ConstraintViolation)
+	ErrRoleRequired = fmt.Errorf("%w: the clinician must have at least one role", errors.BadRequest)
+
+	customRoleNamePattern = regexp.MustCompile(customRoleNameFormat)
+)
+
+// Permissions are all permissions which can be granted to clinicians
+var Permissions = []string{
+	PermissionClinicWrite,
+	PermissionCliniciansRead,
+	PermissionCliniciansWrite,
+	PermissionPatientsRead,
+	PermissionPatientsWrite,
+	PermissionPatientsDelete,
+	PermissionPatientTagsWrite,
+	PermissionPatientTagsManage,
+	PermissionSettingsRead,
+	PermissionBillingRead,
+	PermissionMigrationsRead,
+}
+
+// AdminOnlyPermissions can't be granted by custom roles. Changing the clinic or its clinicians is reserved
+// for clinic admins, which guarantees that every clinic keeps at least one admin who can manage it.
+var AdminOnlyPermissions = []string{
+	PermissionClinicWrite,
+	PermissionCliniciansWrite,
+}
+
+// Role is a named set of permissions which can be assigned to clinicians
+type Role struct {
+	Name        string   `bson:"name"`
+	Permissions []string `bson:"permissions"`
+}
+
+// BuiltInRoles are available in all clinics and can't be changed
+var BuiltInRoles = []Role{
+	{
+		Name:        RoleClinicAdmin,
+		Permissions: Permissions,
+	},
+	{
+		Name: RoleClinicMember,
+		Permissions: []string{
+			PermissionCliniciansRead,
+			PermissionPatientsRead,
+			PermissionPatientsWrite,
+			PermissionPatientTagsWrite,
+			PermissionSettingsRead,
+			PermissionBillingRead,
+			PermissionMigrationsRead,
+		},
+	},
+	{
+		// Prescribers are always clinic members as well, but can also create prescriptions for the patients of the clinic
+		Name: RolePrescriber,
+		Permissions: []string{
+			PermissionPatientsRead,
+			PermissionPatientsWrite,
+			PermissionSettingsRead,
+		},
+	},
+}
+
+func IsBuiltInRole(name string) bool {
+	return slices.ContainsFunc(BuiltInRoles, func(role Role) bool {
+		return role.Name == name
+	})
+}
+
+// ValidateCustomRoles makes sure the custom roles of a clinic have unique names which don't clash with built-in roles
+// and only grant known permissions which are not reserved for admins
+func ValidateCustomRoles(roles []Role) error {
+	if len(roles) > MaxCustomRolesPerClinic {
+		return fmt.Errorf("%w: a clinic can have at most %d custom roles", ErrInvalidRole, MaxCustomRolesPerClinic)
+	}
+
+	names := make(map[string]struct{}, len(roles))
+	for _, role := range roles {
+		if !customRoleNamePattern.MatchString(role.Name) {
+			return fmt.Errorf("%w: role name %q must match %s", ErrInvalidRole, role.Name, customRoleNameFormat)
+		}
+		if IsBuiltInRole(role.Name) {
+			return fmt.Errorf("%w: %s is a built-in role", ErrInvalidRole, role.Name)
+		}
+		if _, ok := names[role.Name]; ok {
+			return fmt.Errorf("%w: duplicate role %s", ErrInvalidRole, role.Name)
+		}
+		names[role.Name] = struct{}{}
+
+		for _, permission := range role.Permissions {
+			if slices.Contains(AdminOnlyPermissions, permission) {
+				return fmt.Errorf("%w: permission %s can only be granted to %s", ErrInvalidRole, permission, RoleClinicAdmin)
+			}
+			if !slices.Contains(Permissions, permission) {
+				return fmt.Errorf("%w: unknown permission %s", ErrInvalidRole, permission)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateRoles makes sure the clinician has at least one role and all roles are either built-in or custom roles of the clinic
+func ValidateRoles(roles []string, customRoles []Role) error {
+	if len(roles) == 0 {
+		return ErrRoleRequired
+	}
+
+	for _, name := range roles {
+		if IsBuiltInRole(name) {
+			continue
+		}
+		if !slices.ContainsFunc(customRoles, func(role Role) bool { return role.Name == name }) {
+			return fmt.Errorf("%w: %s", ErrInvalidRole, name)
+		}
+	}
+
+	return nil
+}
+
+// HasCustomRoles returns true if any of the roles is not a built-in role
+func HasCustomRoles(roles []string) bool {
+	return slices.ContainsFunc(roles, func(name string) bool {
+		return !IsBuiltInRole(name)
+	})
+}
+
+// GetPermissions returns the sorted union of the permissions granted by the roles. Unknown roles don't grant any permissions.
+func GetPermissions(roles []string, customRoles []Role) []string {
+	unique := make(map[string]struct{})
+	for _, role := range append(slices.Clone(BuiltInRoles), customRoles...) {
+		if !slices.Contains(roles, role.Name) {
+			continue
+		}
+		for _, permission := range role.Permissions {
+			unique[permission] = struct{}{}
+		}
+	}
+
+	permissions := make([]string, 0, len(unique))
+	for permission := range unique {
+		permissions = append(permissions, permission)
+	}
+	sort.Strings(permissions)
+
+	return permissions
+}
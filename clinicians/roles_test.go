@@ -0,0 +1,97 @@
+package clinicians_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tidepool-org/clinic/clinicians"
+	"github.com/tidepool-org/clinic/errors"
+)
+
+var _ = Describe("Roles", func() {
+	var patientManager clinicians.Role
+
+	BeforeEach(func() {
+		patientManager = clinicians.Role{
+			Name: "PATIENT_MANAGER",
+			Permissions: []string{
+				clinicians.PermissionPatientsRead,
+				clinicians.PermissionPatientsWrite,
+				clinicians.PermissionPatientTagsWrite,
+			},
+		}
+	})
+
+	Describe("ValidateCustomRoles", func() {
+		It("accepts valid custom roles", func() {
+			Expect(clinicians.ValidateCustomRoles([]clinicians.Role{patientManager})).To(Succeed())
+		})
+
+		It("rejects custom roles with the name of a built-in role", func() {
+			patientManager.Name = clinicians.RoleClinicMember
+			Expect(clinicians.ValidateCustomRoles([]clinicians.Role{patientManager})).To(MatchError(errors.BadRequest))
+		})
+
+		It("rejects duplicate custom roles", func() {
+			Expect(clinicians.ValidateCustomRoles([]clinicians.Role{patientManager, patientManager})).To(MatchError(clinicians.ErrInvalidRole))
+		})
+
+		It("rejects invalid role names", func() {
+			patientManager.Name = "Patient Manager"
+			Expect(clinicians.ValidateCustomRoles([]clinicians.Role{patientManager})).To(MatchError(clinicians.ErrInvalidRole))
+		})
+
+		It("rejects unknown permissions", func() {
+			patientManager.Permissions = append(patientManager.Permissions, "patients:export")
+			Expect(clinicians.ValidateCustomRoles([]clinicians.Role{patientManager})).To(MatchError(clinicians.ErrInvalidRole))
+		})
+
+		It("rejects permissions reserved for clinic admins", func() {
+			patientManager.Permissions = append(patientManager.Permissions, clinicians.PermissionCliniciansWrite)
+			Expect(clinicians.ValidateCustomRoles([]clinicians.Role{patientManager})).To(MatchError(clinicians.ErrInvalidRole))
+		})
+	})
+
+	Describe("ValidateRoles", func() {
+		It("accepts built-in roles", func() {
+			Expect(clinicians.ValidateRoles([]string{clinicians.RoleClinicAdmin, clinicians.RolePrescriber}, nil)).To(Succeed())
+		})
+
+		It("accepts custom roles of the clinic", func() {
+			Expect(clinicians.ValidateRoles([]string{"PATIENT_MANAGER"}, []clinicians.Role{patientManager})).To(Succeed())
+		})
+
+		It("rejects unknown roles", func() {
+			Expect(clinicians.ValidateRoles([]string{"AUDITOR"}, []clinicians.Role{patientManager})).To(MatchError(clinicians.ErrInvalidRole))
+		})
+
+		It("requires at least one role", func() {
+			Expect(clinicians.ValidateRoles(nil, nil)).To(MatchError(clinicians.ErrRoleRequired))
+		})
+	})
+
+	Describe("GetPermissions", func() {
+		It("returns the union of the permissions of built-in and custom roles", func() {
+			billing := clinicians.Role{
+				Name:        "BILLING",
+				Permissions: []string{clinicians.PermissionBillingRead},
+			}
+			permissions := clinicians.GetPermissions([]string{"PATIENT_MANAGER", "BILLING", clinicians.RolePrescriber}, []clinicians.Role{patientManager, billing})
+			Expect(permissions).To(Equal([]string{
+				clinicians.PermissionBillingRead,
+				clinicians.PermissionPatientTagsWrite,
+				clinicians.PermissionPatientsRead,
+				clinicians.PermissionPatientsWrite,
+				clinicians.PermissionSettingsRead,
+			}))
+		})
+
+		It("grants all permissions to clinic admins", func() {
+			Expect(clinicians.GetPermissions([]string{clinicians.RoleClinicAdmin}, nil)).To(ConsistOf(clinicians.Permissions))
+		})
+
+		It("doesn't grant permissions for unknown roles", func() {
+			Expect(clinicians.GetPermissions([]string{"AUDITOR"}, []clinicians.Role{patientManager})).To(BeEmpty())
+		})
+	})
+})
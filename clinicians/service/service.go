@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
@@ -11,6 +12,7 @@ import (
 	"github.com/tidepool-org/clinic/clinics"
 	"github.com/tidepool-org/clinic/deletions"
 	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/sites"
 	"github.com/tidepool-org/clinic/store"
 )
 
@@ -37,6 +39,10 @@ func NewService(dbClient *mongo.Client, clinicsService clinics.Service, reposito
 }
 
 func (s *service) Create(ctx context.Context, clinician *clinicians.Clinician) (*clinicians.Clinician, error) {
+	if err := s.validateRoles(ctx, clinician.ClinicId.Hex(), clinician.Roles); err != nil {
+		return nil, err
+	}
+
 	result, err := store.WithTransaction(ctx, s.dbClient, func(sessionCtx mongo.SessionContext) (interface{}, error) {
 		created, err := s.repository.Create(sessionCtx, clinician)
 		if err != nil {
@@ -63,6 +69,10 @@ func (s *service) Create(ctx context.Context, clinician *clinicians.Clinician) (
 }
 
 func (s *service) Update(ctx context.Context, update *clinicians.ClinicianUpdate) (*clinicians.Clinician, error) {
+	if err := s.validateRoles(ctx, update.ClinicId, update.Clinician.Roles); err != nil {
+		return nil, err
+	}
+
 	result, err := store.WithTransaction(ctx, s.dbClient, func(sessionCtx mongo.SessionContext) (interface{}, error) {
 		updated, err := s.repository.Update(sessionCtx, update)
 		if err != nil {
@@ -225,6 +235,130 @@ func (s *service) onUpdate(ctx context.Context, updated *clinicians.Clinician, a
 	return err
 }
 
+// ListRoles returns the built-in roles followed by the custom roles of the clinic
+func (s *service) ListRoles(ctx context.Context, clinicId string) ([]clinicians.Role, error) {
+	custom, err := s.clinicsService.ListClinicianRoles(ctx, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(slices.Clone(clinicians.BuiltInRoles), custom...), nil
+}
+
+// UpdateCustomRoles replaces the custom roles of the clinic. Roles which are still assigned to clinicians can't be removed.
+func (s *service) UpdateCustomRoles(ctx context.Context, clinicId string, roles []clinicians.Role) ([]clinicians.Role, error) {
+	if err := clinicians.ValidateCustomRoles(roles); err != nil {
+		return nil, err
+	}
+
+	// The roles which are in use are checked in the same transaction as the update
+	_, err := store.WithTransaction(ctx, s.dbClient, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		current, err := s.clinicsService.ListClinicianRoles(sessionCtx, clinicId)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, role := range current {
+			if slices.ContainsFunc(roles, func(r clinicians.Role) bool { return r.Name == role.Name }) {
+				continue
+			}
+
+			name := role.Name
+			filter := clinicians.Filter{
+				ClinicId: &clinicId,
+				Role:     &name,
+			}
+			assigned, err := s.repository.List(sessionCtx, &filter, store.Pagination{Limit: 1})
+			if err != nil {
+				return nil, err
+			}
+			if len(assigned) > 0 {
+				return nil, fmt.Errorf("%w: %s", clinicians.ErrRoleInUse, name)
+			}
+		}
+
+		return nil, s.clinicsService.UpdateClinicianRoles(sessionCtx, clinicId, roles)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ListRoles(ctx, clinicId)
+}
+
+// ResolvePermissions returns the permissions granted to the clinician by its roles
+func (s *service) ResolvePermissions(ctx context.Context, clinician *clinicians.Clinician) ([]string, error) {
+	if !clinicians.HasCustomRoles(clinician.Roles) {
+		return clinicians.GetPermissions(clinician.Roles, nil), nil
+	}
+
+	custom, err := s.clinicsService.ListClinicianRoles(ctx, clinician.ClinicId.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	return clinicians.GetPermissions(clinician.Roles, custom), nil
+}
+
+// ResolveSites returns the sites to which the clinician's access is restricted, including
+// the descendants of the assigned sites. It returns nil when the access isn't restricted.
+func (s *service) ResolveSites(ctx context.Context, clinician *clinicians.Clinician) ([]sites.Site, error) {
+	if clinician.Sites == nil {
+		return nil, nil
+	}
+	if len(*clinician.Sites) == 0 {
+		return []sites.Site{}, nil
+	}
+
+	clinicSites, err := s.clinicsService.ListSites(ctx, clinician.ClinicId.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	return sites.NewHierarchy(clinicSites).ExpandSites(*clinician.Sites), nil
+}
+
+// AssignSites restricts the clinician's access to the patients of the given sites. The restrictions are removed when
+// the site ids are nil.
+func (s *service) AssignSites(ctx context.Context, clinicId, clinicianId string, siteIds *[]string) (*clinicians.Clinician, error) {
+	if siteIds == nil {
+		return s.repository.AssignSites(ctx, clinicId, clinicianId, nil)
+	}
+
+	clinic, err := s.clinicsService.Get(ctx, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	assigned := make([]sites.Site, 0, len(*siteIds))
+	for _, siteId := range *siteIds {
+		idx := slices.IndexFunc(clinic.Sites, func(site sites.Site) bool { return site.Id.Hex() == siteId })
+		if idx < 0 {
+			return nil, fmt.Errorf("%w: %s", clinics.ErrSiteNotFound, siteId)
+		}
+		if slices.ContainsFunc(assigned, clinic.Sites[idx].Equals) {
+			continue
+		}
+		// The number of patients is only maintained on the clinic sites
+		assigned = append(assigned, sites.Site{Id: clinic.Sites[idx].Id, Name: clinic.Sites[idx].Name})
+	}
+
+	return s.repository.AssignSites(ctx, clinicId, clinicianId, &assigned)
+}
+
+// validateRoles makes sure the roles exist. The clinic is only fetched when custom roles are assigned.
+func (s *service) validateRoles(ctx context.Context, clinicId string, roles []string) error {
+	var custom []clinicians.Role
+	if clinicians.HasCustomRoles(roles) {
+		var err error
+		if custom, err = s.clinicsService.ListClinicianRoles(ctx, clinicId); err != nil {
+			return err
+		}
+	}
+
+	return clinicians.ValidateRoles(roles, custom)
+}
+
 func (s *service) GetInvite(ctx context.Context, clinicId, inviteId string) (*clinicians.Clinician, error) {
 	return s.repository.GetInvite(ctx, clinicId, inviteId)
 }
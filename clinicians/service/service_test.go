@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gstruct"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/fx"
 	"go.uber.org/fx/fxtest"
@@ -557,4 +558,138 @@ var _ = Describe("Clinicians Service", func() {
 		})
 	})
 
+	Describe("Custom roles", func() {
+		var clinic *clinics.Clinic
+		var admin *clinicians.Clinician
+		var patientManager clinicians.Role
+
+		BeforeEach(func() {
+			clinic = clinicsTest.RandomClinic()
+
+			var err error
+			clinic, err = clinicsSvc.Create(context.Background(), clinic)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(clinic).ToNot(BeNil())
+
+			adminId := (*clinic.Admins)[0]
+			admin = cliniciansTest.RandomClinician()
+			admin.UserId = &adminId
+			admin.ClinicId = clinic.Id
+			admin.Roles = []string{"CLINIC_ADMIN"}
+
+			admin, err = cliniciansSvc.Create(context.Background(), admin)
+			Expect(err).ToNot(HaveOccurred())
+
+			patientManager = clinicians.Role{
+				Name:        "PATIENT_MANAGER",
+				Permissions: []string{clinicians.PermissionPatientsRead, clinicians.PermissionPatientsWrite},
+			}
+		})
+
+		It("Lists the built-in and custom roles", func() {
+			roles, err := cliniciansSvc.UpdateCustomRoles(context.Background(), clinic.Id.Hex(), []clinicians.Role{patientManager})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(roles).To(HaveLen(len(clinicians.BuiltInRoles) + 1))
+			Expect(roles).To(ContainElement(patientManager))
+		})
+
+		It("Prevents assigning roles which don't exist in the clinic", func() {
+			clinician := cliniciansTest.RandomClinician()
+			clinician.ClinicId = clinic.Id
+			clinician.Roles = []string{"PATIENT_MANAGER"}
+
+			_, err := cliniciansSvc.Create(context.Background(), clinician)
+			Expect(err).To(MatchError(clinicians.ErrInvalidRole))
+		})
+
+		It("Resolves the permissions of custom roles", func() {
+			_, err := cliniciansSvc.UpdateCustomRoles(context.Background(), clinic.Id.Hex(), []clinicians.Role{patientManager})
+			Expect(err).ToNot(HaveOccurred())
+
+			clinician := cliniciansTest.RandomClinician()
+			clinician.ClinicId = clinic.Id
+			clinician.Roles = []string{"PATIENT_MANAGER"}
+			clinician, err = cliniciansSvc.Create(context.Background(), clinician)
+			Expect(err).ToNot(HaveOccurred())
+
+			permissions, err := cliniciansSvc.ResolvePermissions(context.Background(), clinician)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(permissions).To(ConsistOf(patientManager.Permissions))
+		})
+
+		It("Prevents removing roles which are assigned to clinicians", func() {
+			_, err := cliniciansSvc.UpdateCustomRoles(context.Background(), clinic.Id.Hex(), []clinicians.Role{patientManager})
+			Expect(err).ToNot(HaveOccurred())
+
+			clinician := cliniciansTest.RandomClinician()
+			clinician.ClinicId = clinic.Id
+			clinician.Roles = []string{"PATIENT_MANAGER"}
+			_, err = cliniciansSvc.Create(context.Background(), clinician)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = cliniciansSvc.UpdateCustomRoles(context.Background(), clinic.Id.Hex(), nil)
+			Expect(err).To(MatchError(clinicians.ErrRoleInUse))
+		})
+
+		It("Prevents orphaning a clinic when the last admin is assigned a custom role", func() {
+			_, err := cliniciansSvc.UpdateCustomRoles(context.Background(), clinic.Id.Hex(), []clinicians.Role{patientManager})
+			Expect(err).ToNot(HaveOccurred())
+
+			clinicianUpdate := &clinicians.ClinicianUpdate{
+				UpdatedBy:   test.Faker.UUID().V4(),
+				ClinicId:    clinic.Id.Hex(),
+				ClinicianId: *admin.UserId,
+				Clinician: clinicians.Clinician{
+					Roles: []string{"PATIENT_MANAGER"},
+				},
+			}
+			_, err = cliniciansSvc.Update(context.Background(), clinicianUpdate)
+			Expect(err).To(MatchError(clinics.ErrAdminRequired))
+		})
+	})
+
+	Describe("Assign sites", func() {
+		var clinic *clinics.Clinic
+		var clinician *clinicians.Clinician
+
+		BeforeEach(func() {
+			clinic = clinicsTest.RandomClinic()
+
+			var err error
+			clinic, err = clinicsSvc.Create(context.Background(), clinic)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(clinic.Sites).ToNot(BeEmpty())
+
+			clinician = cliniciansTest.RandomClinician()
+			clinician.ClinicId = clinic.Id
+			clinician.Roles = []string{"CLINIC_MEMBER"}
+			clinician, err = cliniciansSvc.Create(context.Background(), clinician)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Restricts the clinician to the sites of the clinic", func() {
+			siteIds := []string{clinic.Sites[0].Id.Hex()}
+			updated, err := cliniciansSvc.AssignSites(context.Background(), clinic.Id.Hex(), *clinician.UserId, &siteIds)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.IsSiteRestricted()).To(BeTrue())
+			Expect(updated.GetSiteIds()).To(ConsistOf(siteIds))
+			Expect((*updated.Sites)[0].Name).To(Equal(clinic.Sites[0].Name))
+		})
+
+		It("Removes the restrictions", func() {
+			siteIds := []string{clinic.Sites[0].Id.Hex()}
+			_, err := cliniciansSvc.AssignSites(context.Background(), clinic.Id.Hex(), *clinician.UserId, &siteIds)
+			Expect(err).ToNot(HaveOccurred())
+
+			updated, err := cliniciansSvc.AssignSites(context.Background(), clinic.Id.Hex(), *clinician.UserId, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.Sites).To(BeNil())
+		})
+
+		It("Prevents assigning sites which don't exist in the clinic", func() {
+			siteIds := []string{primitive.NewObjectID().Hex()}
+			_, err := cliniciansSvc.AssignSites(context.Background(), clinic.Id.Hex(), *clinician.UserId, &siteIds)
+			Expect(err).To(MatchError(clinics.ErrSiteNotFound))
+		})
+	})
 })
@@ -15,6 +15,7 @@ import (
 
 	clinicians "github.com/tidepool-org/clinic/clinicians"
 	deletions "github.com/tidepool-org/clinic/deletions"
+	sites "github.com/tidepool-org/clinic/sites"
 	store "github.com/tidepool-org/clinic/store"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -43,6 +44,21 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AssignSites mocks base method.
+func (m *MockService) AssignSites(ctx context.Context, clinicId, clinicianId string, siteIds *[]string) (*clinicians.Clinician, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignSites", ctx, clinicId, clinicianId, siteIds)
+	ret0, _ := ret[0].(*clinicians.Clinician)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignSites indicates an expected call of AssignSites.
+func (mr *MockServiceMockRecorder) AssignSites(ctx, clinicId, clinicianId, siteIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignSites", reflect.TypeOf((*MockService)(nil).AssignSites), ctx, clinicId, clinicianId, siteIds)
+}
+
 // AssociateInvite mocks base method.
 func (m *MockService) AssociateInvite(ctx context.Context, associate clinicians.AssociateInvite) (*clinicians.Clinician, error) {
 	m.ctrl.T.Helper()
@@ -174,6 +190,51 @@ func (mr *MockServiceMockRecorder) List(ctx, filter, pagination any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockService)(nil).List), ctx, filter, pagination)
 }
 
+// ListRoles mocks base method.
+func (m *MockService) ListRoles(ctx context.Context, clinicId string) ([]clinicians.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", ctx, clinicId)
+	ret0, _ := ret[0].([]clinicians.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockServiceMockRecorder) ListRoles(ctx, clinicId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockService)(nil).ListRoles), ctx, clinicId)
+}
+
+// ResolvePermissions mocks base method.
+func (m *MockService) ResolvePermissions(ctx context.Context, clinician *clinicians.Clinician) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePermissions", ctx, clinician)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePermissions indicates an expected call of ResolvePermissions.
+func (mr *MockServiceMockRecorder) ResolvePermissions(ctx, clinician any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePermissions", reflect.TypeOf((*MockService)(nil).ResolvePermissions), ctx, clinician)
+}
+
+// ResolveSites mocks base method.
+func (m *MockService) ResolveSites(ctx context.Context, clinician *clinicians.Clinician) ([]sites.Site, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveSites", ctx, clinician)
+	ret0, _ := ret[0].([]sites.Site)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveSites indicates an expected call of ResolveSites.
+func (mr *MockServiceMockRecorder) ResolveSites(ctx, clinician any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveSites", reflect.TypeOf((*MockService)(nil).ResolveSites), ctx, clinician)
+}
+
 // Update mocks base method.
 func (m *MockService) Update(ctx context.Context, update *clinicians.ClinicianUpdate) (*clinicians.Clinician, error) {
 	m.ctrl.T.Helper()
@@ -203,6 +264,21 @@ func (mr *MockServiceMockRecorder) UpdateAll(ctx, update any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAll", reflect.TypeOf((*MockService)(nil).UpdateAll), ctx, update)
 }
 
+// UpdateCustomRoles mocks base method.
+func (m *MockService) UpdateCustomRoles(ctx context.Context, clinicId string, roles []clinicians.Role) ([]clinicians.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomRoles", ctx, clinicId, roles)
+	ret0, _ := ret[0].([]clinicians.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomRoles indicates an expected call of UpdateCustomRoles.
+func (mr *MockServiceMockRecorder) UpdateCustomRoles(ctx, clinicId, roles any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomRoles", reflect.TypeOf((*MockService)(nil).UpdateCustomRoles), ctx, clinicId, roles)
+}
+
 // MockRepository is a mock of Repository interface.
 type MockRepository struct {
 	ctrl     *gomock.Controller
@@ -227,6 +303,21 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AssignSites mocks base method.
+func (m *MockRepository) AssignSites(ctx context.Context, clinicId, clinicianId string, sites *[]sites.Site) (*clinicians.Clinician, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignSites", ctx, clinicId, clinicianId, sites)
+	ret0, _ := ret[0].(*clinicians.Clinician)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignSites indicates an expected call of AssignSites.
+func (mr *MockRepositoryMockRecorder) AssignSites(ctx, clinicId, clinicianId, sites any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignSites", reflect.TypeOf((*MockRepository)(nil).AssignSites), ctx, clinicId, clinicianId, sites)
+}
+
 // AssociateInvite mocks base method.
 func (m *MockRepository) AssociateInvite(ctx context.Context, associate clinicians.AssociateInvite) (*clinicians.Clinician, error) {
 	m.ctrl.T.Helper()
@@ -299,6 +390,20 @@ func (mr *MockRepositoryMockRecorder) DeleteInvite(ctx, clinicId, inviteId any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInvite", reflect.TypeOf((*MockRepository)(nil).DeleteInvite), ctx, clinicId, inviteId)
 }
 
+// DeleteSites mocks base method.
+func (m *MockRepository) DeleteSites(ctx context.Context, clinicId, siteId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSites", ctx, clinicId, siteId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSites indicates an expected call of DeleteSites.
+func (mr *MockRepositoryMockRecorder) DeleteSites(ctx, clinicId, siteId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSites", reflect.TypeOf((*MockRepository)(nil).DeleteSites), ctx, clinicId, siteId)
+}
+
 // Get mocks base method.
 func (m *MockRepository) Get(ctx context.Context, clinicId, clinicianId string) (*clinicians.Clinician, error) {
 	m.ctrl.T.Helper()
@@ -344,6 +449,20 @@ func (mr *MockRepositoryMockRecorder) List(ctx, filter, pagination any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, filter, pagination)
 }
 
+// MergeSites mocks base method.
+func (m *MockRepository) MergeSites(ctx context.Context, clinicId, sourceSiteId string, targetSite *sites.Site) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeSites", ctx, clinicId, sourceSiteId, targetSite)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeSites indicates an expected call of MergeSites.
+func (mr *MockRepositoryMockRecorder) MergeSites(ctx, clinicId, sourceSiteId, targetSite any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeSites", reflect.TypeOf((*MockRepository)(nil).MergeSites), ctx, clinicId, sourceSiteId, targetSite)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(ctx context.Context, update *clinicians.ClinicianUpdate) (*clinicians.Clinician, error) {
 	m.ctrl.T.Helper()
@@ -372,3 +491,17 @@ func (mr *MockRepositoryMockRecorder) UpdateAll(ctx, update any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAll", reflect.TypeOf((*MockRepository)(nil).UpdateAll), ctx, update)
 }
+
+// UpdateSites mocks base method.
+func (m *MockRepository) UpdateSites(ctx context.Context, clinicId, siteId string, site *sites.Site) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSites", ctx, clinicId, siteId, site)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSites indicates an expected call of UpdateSites.
+func (mr *MockRepositoryMockRecorder) UpdateSites(ctx, clinicId, siteId, site any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSites", reflect.TypeOf((*MockRepository)(nil).UpdateSites), ctx, clinicId, siteId, site)
+}
@@ -3,11 +3,13 @@ package clinics
 import (
 	"context"
 	"fmt"
+	"net"
 	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
+	"github.com/tidepool-org/clinic/clinicians"
 	"github.com/tidepool-org/clinic/deletions"
 	"github.com/tidepool-org/clinic/errors"
 	"github.com/tidepool-org/clinic/sites"
@@ -40,6 +42,9 @@ var ErrMaximumPatientTagsExceeded = fmt.Errorf("%w: the clinic already has the m
 var ErrDuplicateSiteName = fmt.Errorf("%w site name", errors.Duplicate)
 var ErrMaximumSitesExceeded = fmt.Errorf("%w: the clinic already has the maximum number of %d sites", errors.ConstraintViolation, sites.MaxSitesPerClinic)
 var ErrSiteNotFound = fmt.Errorf("%w: the clinic has no site with that name", errors.ConstraintViolation)
+var ErrInvalidSiteParent = fmt.Errorf("%w: invalid parent site", errors.ConstraintViolation)
+var ErrSitesUpdatedConcurrently = fmt.Errorf("%w: the sites of the clinic were updated concurrently", errors.Conflict)
+var ErrInvalidAccessRestrictions = fmt.Errorf("%w: invalid access restrictions", errors.BadRequest)
 
 //go:generate go tool mockgen -source=./clinics.go -destination=./test/mock_clinics.go -package test
 
@@ -58,6 +63,10 @@ type Service interface {
 	DeletePatientTag(ctx context.Context, clinicId, tagId string) error
 	ListMembershipRestrictions(ctx context.Context, clinicId string) ([]MembershipRestrictions, error)
 	UpdateMembershipRestrictions(ctx context.Context, clinicId string, restrictions []MembershipRestrictions) error
+	GetAccessRestrictions(ctx context.Context, clinicId string) (*AccessRestrictions, error)
+	UpdateAccessRestrictions(ctx context.Context, clinicId string, restrictions *AccessRestrictions) error
+	ListClinicianRoles(ctx context.Context, clinicId string) ([]clinicians.Role, error)
+	UpdateClinicianRoles(ctx context.Context, clinicId string, roles []clinicians.Role) error
 	GetEHRSettings(ctx context.Context, clinicId string) (*EHRSettings, error)
 	UpdateEHRSettings(ctx context.Context, clinicId string, settings *EHRSettings) error
 	GetMRNSettings(ctx context.Context, clinicId string) (*MRNSettings, error)
@@ -71,6 +80,14 @@ type Service interface {
 	CreateSiteIgnoringLimit(ctx context.Context, clinicId string, site *sites.Site) (*sites.Site, error)
 	DeleteSite(ctx context.Context, clinicId, siteId string) error
 	UpdateSite(ctx context.Context, clinicId, siteId string, site *sites.Site) (*sites.Site, error)
+	// UpdateSiteParent moves the site under the parent site, or to the root of the hierarchy when the parent is nil.
+	UpdateSiteParent(ctx context.Context, clinicId, siteId string, parentId *string) (*sites.Site, error)
+	// UpdateSiteSettings replaces the overrides of the clinic settings for the patients of the site.
+	UpdateSiteSettings(ctx context.Context, clinicId, siteId string, settings *sites.Settings) (*sites.Site, error)
+	// MoveChildSites moves the children of the site under the target site.
+	MoveChildSites(ctx context.Context, clinicId, siteId, targetSiteId string) error
+	// ListSites returns the sites of the clinic without patient counts.
+	ListSites(ctx context.Context, clinicId string) ([]sites.Site, error)
 }
 
 type Repository interface {
@@ -87,6 +104,8 @@ type Repository interface {
 	UpdatePatientTag(ctx context.Context, clinicId, tagId, tagName string) (*PatientTag, error)
 	DeletePatientTag(ctx context.Context, clinicId, tagId string) error
 	UpdateMembershipRestrictions(ctx context.Context, clinicId string, restrictions []MembershipRestrictions) error
+	UpdateAccessRestrictions(ctx context.Context, clinicId string, restrictions *AccessRestrictions) error
+	UpdateClinicianRoles(ctx context.Context, clinicId string, roles []clinicians.Role) error
 	UpdateEHRSettings(ctx context.Context, clinicId string, settings *EHRSettings) error
 	UpdateMRNSettings(ctx context.Context, clinicId string, settings *MRNSettings) error
 	UpdatePatientCountSettings(ctx context.Context, clinicId string, settings *PatientCountSettings) error
@@ -96,6 +115,10 @@ type Repository interface {
 	CreateSiteIgnoringLimit(ctx context.Context, clinicId string, site *sites.Site) (*sites.Site, error)
 	DeleteSite(ctx context.Context, clinicId, siteId string) error
 	UpdateSite(ctx context.Context, clinicId, siteId string, site *sites.Site) (*sites.Site, error)
+	UpdateSiteParent(ctx context.Context, clinicId, siteId string, parentId *string) (*sites.Site, error)
+	MoveChildSites(ctx context.Context, clinicId, siteId, targetSiteId string) error
+	UpdateSiteSettings(ctx context.Context, clinicId, siteId string, settings *sites.Settings) (*sites.Site, error)
+	ListSites(ctx context.Context, clinicId string) ([]sites.Site, error)
 }
 
 type Filter struct {
@@ -134,6 +157,8 @@ type Clinic struct {
 	SuppressedNotifications *SuppressedNotifications `bson:"suppressedNotifications,omitempty"`
 	Timezone                *string                  `bson:"timezone"`
 	MembershipRestrictions  []MembershipRestrictions `bson:"membershipRestrictions,omitempty"`
+	AccessRestrictions      *AccessRestrictions      `bson:"accessRestrictions,omitempty"`
+	ClinicianRoles          []clinicians.Role        `bson:"clinicianRoles,omitempty"`
 	EHRSettings             *EHRSettings             `bson:"ehrSettings,omitempty"`
 	MRNSettings             *MRNSettings             `bson:"mrnSettings,omitempty"`
 	PatientCountSettings    *PatientCountSettings    `bson:"patientCountSettings,omitempty"`
@@ -365,6 +390,33 @@ func (m MembershipRestrictions) String() string {
 	return fmt.Sprintf("%s/%s", m.EmailDomain, m.RequiredIdp)
 }
 
+// AccessRestrictions limit the access of clinicians to the routes of the clinic
+type AccessRestrictions struct {
+	// AllowedNetworks are the CIDR ranges from which clinicians can access the clinic. Any network is allowed if empty.
+	AllowedNetworks []string `bson:"allowedNetworks,omitempty"`
+	// RequireMFA requires clinicians to authenticate with multiple factors
+	RequireMFA bool `bson:"requireMfa,omitempty"`
+	// MaxAuthenticationAge requires clinicians to have authenticated recently if it's set
+	MaxAuthenticationAge time.Duration `bson:"maxAuthenticationAge,omitempty"`
+}
+
+func (a *AccessRestrictions) Validate() error {
+	for _, network := range a.AllowedNetworks {
+		if _, _, err := net.ParseCIDR(network); err != nil {
+			return fmt.Errorf("%w: %s is not a valid CIDR range", ErrInvalidAccessRestrictions, network)
+		}
+	}
+	if a.MaxAuthenticationAge < 0 {
+		return fmt.Errorf("%w: the maximum authentication age must not be negative", ErrInvalidAccessRestrictions)
+	}
+	return nil
+}
+
+// IsEmpty returns true if the restrictions don't restrict the access to the clinic
+func (a *AccessRestrictions) IsEmpty() bool {
+	return a == nil || (len(a.AllowedNetworks) == 0 && !a.RequireMFA && a.MaxAuthenticationAge == 0)
+}
+
 type PhoneNumber struct {
 	Type   *string `bson:"type,omitempty"`
 	Number string  `bson:"number,omitempty"`
@@ -5,9 +5,11 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/tidepool-org/clinic/clinics"
 	clinicsTest "github.com/tidepool-org/clinic/clinics/test"
+	"github.com/tidepool-org/clinic/sites"
 	"github.com/tidepool-org/clinic/test"
 )
 
@@ -123,6 +125,98 @@ var _ = Describe("Clinics", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Describe("AccessRestrictions", func() {
+		It("accepts valid restrictions", func() {
+			restrictions := clinics.AccessRestrictions{
+				AllowedNetworks:      []string{"192.0.2.0/24", "2001:db8::/32"},
+				RequireMFA:           true,
+				MaxAuthenticationAge: time.Hour,
+			}
+			Expect(restrictions.Validate()).To(Succeed())
+			Expect(restrictions.IsEmpty()).To(BeFalse())
+		})
+
+		It("rejects invalid networks", func() {
+			restrictions := clinics.AccessRestrictions{AllowedNetworks: []string{"192.0.2.1"}}
+			Expect(restrictions.Validate()).To(MatchError(clinics.ErrInvalidAccessRestrictions))
+		})
+
+		It("is empty without restrictions", func() {
+			var restrictions *clinics.AccessRestrictions
+			Expect(restrictions.IsEmpty()).To(BeTrue())
+			Expect((&clinics.AccessRestrictions{}).IsEmpty()).To(BeTrue())
+		})
+	})
+
+	Describe("ResolveSettings", func() {
+		var clinic clinics.Clinic
+		var region, department, other sites.Site
+
+		BeforeEach(func() {
+			region = *sites.New("Region")
+			region.Settings = &sites.Settings{
+				Timezone:          Ptr("America/Denver"),
+				EHRDestinationIds: &sites.EHRDestinationIds{Flowsheet: "region", Notes: "region", Results: "region"},
+			}
+			department = *sites.New("Department")
+			department.ParentId = &region.Id
+			department.Settings = &sites.Settings{PreferredBgUnits: Ptr("mmol/L")}
+			other = *sites.New("Other")
+			other.Settings = &sites.Settings{Timezone: Ptr("America/Chicago")}
+
+			clinic = clinics.Clinic{
+				Timezone:         Ptr("America/New_York"),
+				PreferredBgUnits: "mg/dL",
+				EHRSettings: &clinics.EHRSettings{
+					DestinationIds:   &clinics.EHRDestinationIds{Flowsheet: "clinic", Notes: "clinic", Results: "clinic"},
+					ScheduledReports: clinics.ScheduledReports{Cadence: "14d"},
+				},
+				Sites: []sites.Site{region, department, other},
+			}
+		})
+
+		It("returns the clinic settings without sites", func() {
+			resolved := clinic.ResolveSettings(nil)
+			Expect(resolved.Timezone).To(HaveValue(Equal("America/New_York")))
+			Expect(resolved.PreferredBgUnits).To(Equal("mg/dL"))
+			Expect(resolved.EHRSettings.DestinationIds.Notes).To(Equal("clinic"))
+		})
+
+		It("inherits the overrides of the ancestors of the site", func() {
+			resolved := clinic.ResolveSettings([]primitive.ObjectID{department.Id})
+			Expect(resolved.Timezone).To(HaveValue(Equal("America/Denver")))
+			Expect(resolved.PreferredBgUnits).To(Equal("mmol/L"))
+			Expect(resolved.EHRSettings.DestinationIds.Notes).To(Equal("region"))
+			Expect(resolved.EHRSettings.ScheduledReports.Cadence).To(Equal("14d"))
+		})
+
+		It("gives precedence to the deepest site", func() {
+			resolved := clinic.ResolveSettings([]primitive.ObjectID{other.Id, department.Id})
+			Expect(resolved.Timezone).To(HaveValue(Equal("America/Denver")))
+			Expect(resolved.PreferredBgUnits).To(Equal("mmol/L"))
+		})
+
+		It("doesn't depend on the order of the sites of the patient", func() {
+			region.Settings.Timezone = nil
+			clinic.Sites = []sites.Site{region, department, other}
+
+			resolved := clinic.ResolveSettings([]primitive.ObjectID{other.Id, region.Id})
+			Expect(resolved.Timezone).To(HaveValue(Equal("America/Chicago")))
+			Expect(clinic.ResolveSettings([]primitive.ObjectID{region.Id, other.Id})).To(Equal(resolved))
+		})
+
+		It("doesn't modify the clinic settings", func() {
+			clinic.ResolveSettings([]primitive.ObjectID{region.Id})
+			Expect(clinic.EHRSettings.DestinationIds.Notes).To(Equal("clinic"))
+		})
+
+		It("ignores EHR overrides when the clinic has no EHR settings", func() {
+			clinic.EHRSettings = nil
+			resolved := clinic.ResolveSettings([]primitive.ObjectID{region.Id})
+			Expect(resolved.EHRSettings).To(BeNil())
+		})
+	})
 })
 
 func Ptr[T any](value T) *T {
@@ -31,6 +31,9 @@ type CreateClinic struct {
 
 type Manager interface {
 	CreateClinic(ctx context.Context, create *CreateClinic) (*clinics.Clinic, error)
+	// CreateClinicInTransaction creates the clinic in the transaction of the session, so the caller can commit
+	// further changes atomically with the new clinic.
+	CreateClinicInTransaction(sessionCtx mongo.SessionContext, create *CreateClinic) (*clinics.Clinic, error)
 	DeleteClinic(ctx context.Context, clinicId string, metadata deletions.Metadata) error
 	GetClinicPatientCount(ctx context.Context, clinicId string) (*clinics.PatientCount, error)
 	RefreshClinicPatientCount(ctx context.Context, clinicId string) error
@@ -97,6 +100,40 @@ func NewManager(cp Params) (Manager, error) {
 }
 
 func (c *manager) CreateClinic(ctx context.Context, create *CreateClinic) (*clinics.Clinic, error) {
+	creator, err := c.getClinicCreator(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := func(sessionCtx mongo.SessionContext) (any, error) {
+		return c.createClinic(sessionCtx, create, creator)
+	}
+
+	result, err := store.WithTransaction(ctx, c.dbClient, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*clinics.Clinic), nil
+}
+
+func (c *manager) CreateClinicInTransaction(sessionCtx mongo.SessionContext, create *CreateClinic) (*clinics.Clinic, error) {
+	creator, err := c.getClinicCreator(sessionCtx, create)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.createClinic(sessionCtx, create, creator)
+}
+
+// clinicCreator holds the details of the creator and the demo patient fetched before the clinic is created
+type clinicCreator struct {
+	email       string
+	name        *string
+	demoPatient *patients.Patient
+}
+
+func (c *manager) getClinicCreator(ctx context.Context, create *CreateClinic) (*clinicCreator, error) {
 	user, err := c.userService.GetUser(create.CreatorUserId)
 	if err != nil {
 		return nil, err
@@ -110,55 +147,52 @@ func (c *manager) CreateClinic(ctx context.Context, create *CreateClinic) (*clin
 		return nil, fmt.Errorf("error fetching user profile of clinician %v", create.CreatorUserId)
 	}
 
-	var demoPatient *patients.Patient
+	creator := &clinicCreator{email: user.Emails[0]}
+	if profile != nil {
+		creator.name = profile.FullName
+	}
 	if create.CreateDemoPatient {
-		demoPatient, err = c.getDemoPatient(ctx)
+		creator.demoPatient, err = c.getDemoPatient(ctx)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	transaction := func(sessionCtx mongo.SessionContext) (any, error) {
-		// Set initial admins
-		create.Clinic.AddAdmin(create.CreatorUserId)
-
-		// Add the clinic to the collection
-		clinic, err := c.createClinicObject(sessionCtx, create)
-		if err != nil {
-			return nil, err
-		}
-
-		// Add the clinician to the collection
-		clinician := &clinicians.Clinician{
-			ClinicId: clinic.Id,
-			UserId:   &create.CreatorUserId,
-			Roles:    []string{clinicians.RoleClinicAdmin},
-			Email:    &user.Emails[0],
-		}
-		if profile != nil {
-			clinician.Name = profile.FullName
-		}
-		if _, err = c.cliniciansRepository.Create(sessionCtx, clinician); err != nil {
-			return nil, err
-		}
+	return creator, nil
+}
 
-		// Add the demo patient account
-		if demoPatient != nil {
-			demoPatient.ClinicId = clinic.Id
-			if _, err = c.patientsService.Create(sessionCtx, *demoPatient); err != nil {
-				return nil, err
-			}
-		}
+func (c *manager) createClinic(sessionCtx mongo.SessionContext, create *CreateClinic, creator *clinicCreator) (*clinics.Clinic, error) {
+	// Set initial admins
+	create.Clinic.AddAdmin(create.CreatorUserId)
 
-		return clinic, nil
+	// Add the clinic to the collection
+	clinic, err := c.createClinicObject(sessionCtx, create)
+	if err != nil {
+		return nil, err
 	}
 
-	result, err := store.WithTransaction(ctx, c.dbClient, transaction)
-	if err != nil {
+	// Add the clinician to the collection
+	clinician := &clinicians.Clinician{
+		ClinicId: clinic.Id,
+		UserId:   &create.CreatorUserId,
+		Roles:    []string{clinicians.RoleClinicAdmin},
+		Email:    &creator.email,
+		Name:     creator.name,
+	}
+	if _, err = c.cliniciansRepository.Create(sessionCtx, clinician); err != nil {
 		return nil, err
 	}
 
-	return result.(*clinics.Clinic), nil
+	// Add the demo patient account
+	if creator.demoPatient != nil {
+		demoPatient := *creator.demoPatient
+		demoPatient.ClinicId = clinic.Id
+		if _, err = c.patientsService.Create(sessionCtx, demoPatient); err != nil {
+			return nil, err
+		}
+	}
+
+	return clinic, nil
 }
 
 func (c *manager) DeleteClinic(ctx context.Context, clinicId string, metadata deletions.Metadata) error {
@@ -307,6 +341,9 @@ func (c *manager) deleteSite(ctx context.Context, clinicId, siteId string) error
 	if err := c.patientsService.DeleteSites(ctx, clinicId, siteId); err != nil {
 		return err
 	}
+	if err := c.cliniciansRepository.DeleteSites(ctx, clinicId, siteId); err != nil {
+		return err
+	}
 	if err := c.clinicsService.DeleteSite(ctx, clinicId, siteId); err != nil {
 		return err
 	}
@@ -364,6 +401,26 @@ func (c *manager) ConvertPatientTagToSite(ctx context.Context,
 func (c *manager) MergeSite(ctx context.Context,
 	clinicId, sourceSiteId, targetSiteId string) (*sites.Site, error) {
 
+	tx := func(sessionCtx mongo.SessionContext) (any, error) {
+		return c.mergeSite(sessionCtx, clinicId, sourceSiteId, targetSiteId)
+	}
+	merged, err := store.WithTransaction(ctx, c.dbClient, tx)
+	if err != nil {
+		return nil, err
+	}
+	site, ok := merged.(*sites.Site)
+	if !ok {
+		return nil, fmt.Errorf("expected a *sites.Site")
+	}
+	return site, nil
+}
+
+// mergeSite and ripple the changes to a clinic's patients and clinicians.
+//
+// This should be run in a transaction to prevent races.
+func (c *manager) mergeSite(ctx context.Context,
+	clinicId, sourceSiteId, targetSiteId string) (*sites.Site, error) {
+
 	if sourceSiteId == targetSiteId {
 		return nil, fmt.Errorf("can't merge a site into itself: %w", errors.BadRequest)
 	}
@@ -375,7 +432,8 @@ func (c *manager) MergeSite(ctx context.Context,
 	var targetSite *sites.Site
 	for _, site := range clinic.Sites {
 		if site.Id.Hex() == targetSiteId {
-			targetSite = &site
+			// The hierarchy is only maintained on the clinic sites
+			targetSite = &sites.Site{Id: site.Id, Name: site.Name}
 			break
 		}
 	}
@@ -386,6 +444,16 @@ func (c *manager) MergeSite(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	err = c.cliniciansRepository.MergeSites(ctx, clinicId, sourceSiteId, targetSite)
+	if err != nil {
+		return nil, err
+	}
+	// The children of the source site become children of the target site. The hierarchy is
+	// validated against the sites read in the transaction.
+	err = c.clinicsService.MoveChildSites(ctx, clinicId, sourceSiteId, targetSiteId)
+	if err != nil {
+		return nil, err
+	}
 	err = c.clinicsService.DeleteSite(ctx, clinicId, sourceSiteId)
 	if err != nil {
 		return nil, err
@@ -444,5 +512,8 @@ func (c *manager) updateSite(ctx context.Context,
 		}
 		return nil, err
 	}
+	if err := c.cliniciansRepository.UpdateSites(ctx, clinicId, siteId, site); err != nil {
+		return nil, err
+	}
 	return updated, nil
 }
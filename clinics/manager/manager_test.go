@@ -78,7 +78,7 @@ var _ = Describe("Clinics Manager", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(patientsRepo).ToNot(BeNil())
 
-		clinicsSvc, err := clinicsService.NewService(clinicsRepo, patientsRepo, lgr)
+		clinicsSvc, err := clinicsService.NewService(clinicsRepo, patientsRepo, lgr, database.Client())
 		Expect(err).ToNot(HaveOccurred())
 		Expect(clinicsSvc).ToNot(BeNil())
 
@@ -390,6 +390,22 @@ var _ = Describe("Clinics Manager", func() {
 		})
 
 		Describe("DeleteSite", func() {
+			It("removes the site from clinician assignments", func() {
+				ctx, mngr, th := newCreateSiteTestHelper(GinkgoTB())
+				siteId := th.Clinic.Sites[0].Id.Hex()
+				clinicId := th.Clinic.Id.Hex()
+				assigned := []sites.Site{th.Clinic.Sites[0]}
+				_, err := th.CliniciansRepo.AssignSites(ctx, clinicId, *th.Clinician.UserId, &assigned)
+				Expect(err).To(Succeed())
+
+				Expect(mngr.DeleteSite(ctx, clinicId, siteId)).To(Succeed())
+
+				clinician, err := th.CliniciansRepo.Get(ctx, clinicId, *th.Clinician.UserId)
+				Expect(err).To(Succeed())
+				Expect(clinician.Sites).ToNot(BeNil())
+				Expect(*clinician.Sites).To(BeEmpty())
+			})
+
 			It("works", func() {
 				ctx, mngr, th := newCreateSiteTestHelper(GinkgoTB())
 				siteId := th.Clinic.Sites[0].Id.Hex()
@@ -431,6 +447,24 @@ var _ = Describe("Clinics Manager", func() {
 		})
 
 		Describe("MergeSite", func() {
+			It("moves clinician assignments to the target site", func() {
+				ctx, mngr, th := newCreateSiteTestHelper(GinkgoTB())
+				targetSiteId := th.Clinic.Sites[0].Id.Hex()
+				clinicId := th.Clinic.Id.Hex()
+				source, err := th.mngr.CreateSite(ctx, clinicId, "source")
+				Expect(err).To(Succeed())
+				assigned := []sites.Site{*source}
+				_, err = th.CliniciansRepo.AssignSites(ctx, clinicId, *th.Clinician.UserId, &assigned)
+				Expect(err).To(Succeed())
+
+				_, err = mngr.MergeSite(ctx, clinicId, source.Id.Hex(), targetSiteId)
+				Expect(err).To(Succeed())
+
+				clinician, err := th.CliniciansRepo.Get(ctx, clinicId, *th.Clinician.UserId)
+				Expect(err).To(Succeed())
+				Expect(clinician.GetSiteIds()).To(ConsistOf(targetSiteId))
+			})
+
 			It("works", func() {
 				ctx, mngr, th := newCreateSiteTestHelper(GinkgoTB())
 				targetSiteId := th.Clinic.Sites[0].Id.Hex()
@@ -627,12 +661,13 @@ var _ = Describe("Clinics Manager", func() {
 })
 
 type createSiteTestHelper struct {
-	Clinician    *clinicians.Clinician
-	Clinic       *clinics.Clinic
-	ClinicsRepo  clinics.Repository
-	PatientsRepo patients.Repository
-	Site         *sites.Site
-	mngr         manager.Manager
+	Clinician      *clinicians.Clinician
+	Clinic         *clinics.Clinic
+	ClinicsRepo    clinics.Repository
+	CliniciansRepo clinicians.Repository
+	PatientsRepo   patients.Repository
+	Site           *sites.Site
+	mngr           manager.Manager
 }
 
 func newCreateSiteTestHelper(t testing.TB) (context.Context, manager.Manager, *createSiteTestHelper) {
@@ -650,7 +685,7 @@ func newCreateSiteTestHelper(t testing.TB) (context.Context, manager.Manager, *c
 	if err != nil {
 		t.Fatalf("failed to create patients repo: %s", err)
 	}
-	clinicsSvc, err := clinicsService.NewService(clinicsRepo, patientsRepo, lgr)
+	clinicsSvc, err := clinicsService.NewService(clinicsRepo, patientsRepo, lgr, db.Client())
 	if err != nil {
 		t.Fatalf("failed to create clinics service: %s", err)
 	}
@@ -709,12 +744,13 @@ func newCreateSiteTestHelper(t testing.TB) (context.Context, manager.Manager, *c
 	}
 
 	return ctx, mngr, &createSiteTestHelper{
-		Clinician:    testClinician,
-		Clinic:       testClinic,
-		ClinicsRepo:  clinicsRepo,
-		PatientsRepo: patientsRepo,
-		Site:         &site,
-		mngr:         mngr,
+		Clinician:      testClinician,
+		Clinic:         testClinic,
+		ClinicsRepo:    clinicsRepo,
+		CliniciansRepo: cliniciansRepo,
+		PatientsRepo:   patientsRepo,
+		Site:           &site,
+		mngr:           mngr,
 	}
 }
 
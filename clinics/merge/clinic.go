@@ -6,7 +6,6 @@ import (
 	"slices"
 	"time"
 
-	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
@@ -14,7 +13,6 @@ import (
 	"github.com/tidepool-org/clinic/clinicians"
 	"github.com/tidepool-org/clinic/clinics"
 	"github.com/tidepool-org/clinic/clinics/manager"
-	errs "github.com/tidepool-org/clinic/errors"
 	"github.com/tidepool-org/clinic/patients"
 	"github.com/tidepool-org/clinic/store"
 )
@@ -90,51 +88,55 @@ func NewClinicMergePlanner(clinicsService clinics.Service, patientsService patie
 }
 
 func (m *ClinicMergePlanner) Plan(ctx context.Context) (plan ClinicMergePlan, err error) {
-	intermediate := &intermediatePlanner{}
-
 	source, err := m.clinics.Get(ctx, m.sourceId)
 	if err != nil {
 		return
 	}
-	intermediate.SourceClinic = *source
-
 	target, err := m.clinics.Get(ctx, m.targetId)
 	if err != nil {
 		return
 	}
-	intermediate.TargetClinic = *target
 
-	intermediate.MembershipRestrictionsMergePlanner, err = m.MembershipRestrictionsMergePlan(*source, *target)
+	sourcePatients, err := m.listAllPatients(ctx, *source)
 	if err != nil {
 		return
 	}
-	intermediate.SettingsPlanners, err = m.SettingsMergePlan(*source, *target)
+	targetPatients, err := m.listAllPatients(ctx, *target)
 	if err != nil {
 		return
 	}
-	intermediate.TagPlanners, err = m.TagsMergePlan(*source, *target)
+
+	return m.plan(ctx, *source, *target, sourcePatients, targetPatients)
+}
+
+func (m *ClinicMergePlanner) plan(ctx context.Context, source, target clinics.Clinic, sourcePatients, targetPatients []patients.Patient) (plan ClinicMergePlan, err error) {
+	intermediate := &intermediatePlanner{
+		SourceClinic: source,
+		TargetClinic: target,
+	}
+
+	intermediate.MembershipRestrictionsMergePlanner, err = m.MembershipRestrictionsMergePlan(source, target)
 	if err != nil {
 		return
 	}
-	intermediate.SitePlanners, err = m.SitesMergePlan(*source, *target)
+	intermediate.SettingsPlanners, err = m.SettingsMergePlan(source, target)
 	if err != nil {
 		return
 	}
-	intermediate.ClinicianPlanners, err = m.CliniciansMergePlan(ctx, *source, *target)
+	intermediate.TagPlanners, err = m.TagsMergePlan(source, target)
 	if err != nil {
 		return
 	}
-
-	sourcePatients, err := m.listAllPatients(ctx, *source)
+	intermediate.SitePlanners, err = m.SitesMergePlan(source, target)
 	if err != nil {
 		return
 	}
-	targetPatients, err := m.listAllPatients(ctx, *target)
+	intermediate.ClinicianPlanners, err = m.CliniciansMergePlan(ctx, source, target)
 	if err != nil {
 		return
 	}
 
-	intermediate.PatientPlanner, err = m.PatientsMergePlan(ctx, *source, *target, sourcePatients, targetPatients)
+	intermediate.PatientPlanner, err = m.PatientsMergePlan(ctx, source, target, sourcePatients, targetPatients)
 	if err != nil {
 		return
 	}
@@ -329,9 +331,11 @@ func (i *intermediatePlanner) Plan(ctx context.Context) (plan ClinicMergePlan, e
 	return
 }
 
+// ClinicPlanExecutor executes clinic merge plans as background jobs
 type ClinicPlanExecutor struct {
 	fx.In
 
+	Config          Config
 	Logger          *zap.SugaredLogger
 	ClinicsService  clinics.Service
 	PatientsService patients.Service
@@ -339,82 +343,3 @@ type ClinicPlanExecutor struct {
 	DBClient        *mongo.Client
 	DB              *mongo.Database
 }
-
-func (c *ClinicPlanExecutor) Execute(ctx context.Context, plan ClinicMergePlan) (primitive.ObjectID, error) {
-	logger := c.Logger.With("clinicId", plan.Source.Id.Hex(), "targetClinicId", plan.Target.Id.Hex())
-	if plan.PreventsMerge() {
-		err := fmt.Errorf("%w: the merge plan does not allow execution", errs.BadRequest)
-		logger.Errorw("cannot merge clinics", "error", err)
-		return primitive.NilObjectID, err
-	}
-
-	planId := primitive.NewObjectID()
-	_, err := store.WithTransaction(ctx, c.DBClient, func(sessionContext mongo.SessionContext) (any, error) {
-		logger.Info("starting tags migration")
-		tpe := NewTagPlanExecutor(logger, c.ClinicsService)
-		for _, p := range plan.TagsPlans {
-			if err := tpe.Execute(sessionContext, p); err != nil {
-				return nil, err
-			}
-			if err := c.persistPlan(sessionContext, NewPersistentPlan(planId, planTypeTag, p)); err != nil {
-				return nil, err
-			}
-		}
-
-		logger.Info("starting patients migration")
-		ppe := NewPatientPlanExecutor(logger, c.ClinicsService, c.DB)
-		for _, p := range plan.PatientPlans {
-			if err := ppe.Execute(sessionContext, p, plan.Source, plan.Target); err != nil {
-				return nil, err
-			}
-			if p.SourcePatient != nil {
-				sanitizePatient(p.SourcePatient)
-			}
-			if p.TargetPatient != nil {
-				sanitizePatient(p.TargetPatient)
-			}
-			if err := c.persistPlan(sessionContext, NewPersistentPlan(planId, planTypePatient, p)); err != nil {
-				return nil, err
-			}
-		}
-
-		logger.Info("starting sites migration")
-		spe := NewSitePlanExecutor(logger, c.ClinicsService, c.PatientsService)
-		for _, plan := range plan.SitesPlans {
-			if err := spe.Execute(sessionContext, plan); err != nil {
-				return nil, err
-			}
-			if err := c.persistPlan(sessionContext, NewPersistentPlan(planId, planTypeSite, plan)); err != nil {
-				return nil, err
-			}
-		}
-
-		logger.Info("starting clinicians migration")
-		cpe := NewClinicianPlanExecutor(logger, c.DB)
-		for _, p := range plan.ClinicianPlans {
-			if err := cpe.Execute(sessionContext, p, plan.Target); err != nil {
-				return nil, err
-			}
-			if err := c.persistPlan(sessionContext, NewPersistentPlan(planId, planTypeClinician, p)); err != nil {
-				return nil, err
-			}
-		}
-
-		logger.Info("finalizing clinic merge")
-		if err := c.ClinicManager.FinalizeMerge(sessionContext, plan.Source.Id.Hex(), plan.Target.Id.Hex()); err != nil {
-			return nil, err
-		}
-		if err := c.persistPlan(sessionContext, NewPersistentPlan(planId, planTypeClinic, plan)); err != nil {
-			return nil, err
-		}
-
-		return nil, nil
-	})
-
-	return planId, err
-}
-
-func (c *ClinicPlanExecutor) persistPlan(ctx context.Context, plan any) error {
-	_, err := c.DB.Collection(plansCollectionName).InsertOne(ctx, plan)
-	return err
-}
@@ -26,6 +26,7 @@ import (
 	"github.com/tidepool-org/clinic/clinicians"
 	cliniciansRepository "github.com/tidepool-org/clinic/clinicians/repository"
 	cliniciansService "github.com/tidepool-org/clinic/clinicians/service"
+	cliniciansTest "github.com/tidepool-org/clinic/clinicians/test"
 	"github.com/tidepool-org/clinic/clinics"
 	"github.com/tidepool-org/clinic/clinics/manager"
 	"github.com/tidepool-org/clinic/clinics/merge"
@@ -107,6 +108,10 @@ func (t *ClinicMergeTest) Init(params mergeTest.Params) {
 			patientsService.NewCustodialService,
 			clinics.NewShareCodeGenerator,
 			manager.NewManager,
+			func() merge.Config {
+				// Use small batches to make sure the merge is executed in multiple transactions
+				return merge.Config{BatchSize: 10, Lease: time.Minute, MaxAttempts: 5}
+			},
 		),
 		fx.Invoke(func(ex merge.ClinicPlanExecutor, cliniciansSvc clinicians.Service, clinicsSvc clinics.Service, patientsSvc patients.Service, cManager manager.Manager, userSvc patients.UserService) {
 			t.cliniciansService = cliniciansSvc
@@ -219,45 +224,63 @@ var _ = Describe("New Clinic Merge Planner", Ordered, func() {
 		Expect(targetFound).To(BeTrue())
 	})
 
-	It("reverts the changes on failure", func() {
+	It("reverts the failed batch and records the failure", func() {
 		// Force a failure by changing a single clinician plan to fail
 		originalAction := t.plan.ClinicianPlans[0].ClinicianAction
 		t.plan.ClinicianPlans[0].ClinicianAction = "INVALID"
-		targetClinicId := t.target.Id.Hex()
 
 		var err error
 		t.planId, err = t.executor.Execute(context.Background(), t.plan)
 		Expect(err).To(HaveOccurred())
 
-		// Clinician plans are executed after patients plans so any patients that were moved during the merge
-		// should not be part of the target clinic if the merge transaction was rolled back
-		count := 0
-		for _, plan := range t.plan.PatientPlans {
-			if plan.PatientAction != merge.PatientActionMove {
-				continue
-			}
-
-			filter := patients.Filter{
-				UserId:   plan.SourcePatient.UserId,
-				ClinicId: &targetClinicId,
-			}
-			page := store.DefaultPagination().WithLimit(2)
-			result, err := t.patientsService.List(context.Background(), &filter, page, nil)
-			Expect(err).ToNot(HaveOccurred())
-			Expect(result.MatchingCount).To(Equal(0))
-
-			count++
+		job, err := t.executor.GetJob(context.Background(), t.planId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(job.Status).To(Equal(merge.JobStatusRunning))
+		Expect(job.Attempts).To(Equal(1))
+		Expect(job.LastError).ToNot(BeNil())
+
+		// Clinician plans are executed after patients plans, so the previous batches were committed and the
+		// batch of the failed clinician plan was rolled back
+		phases := map[string]merge.JobPhase{}
+		for _, phase := range job.Phases {
+			phases[phase.Type] = phase
 		}
-		Expect(count).To(BeNumerically(">", 0))
-
-		// Restore the value of the clinician action to make sure the plan can be executed successfully
+		Expect(phases["patient"].Total).To(Equal(len(t.plan.PatientPlans)))
+		Expect(phases["patient"].Completed).To(BeNumerically(">", 0))
+		Expect(phases["clinician"].Completed).To(BeZero())
+		Expect(phases["clinic"].Completed).To(BeZero())
+
+		// Fix the persisted plan to make sure the merge can be resumed successfully
+		_, err = t.db.Collection("merge_plans").UpdateOne(context.Background(), bson.M{
+			"planId":               t.planId,
+			"type":                 "clinician",
+			"plan.clinicianAction": "INVALID",
+		}, bson.M{
+			"$set": bson.M{"plan.clinicianAction": originalAction},
+		})
+		Expect(err).ToNot(HaveOccurred())
 		t.plan.ClinicianPlans[0].ClinicianAction = originalAction
 	})
 
-	It("successfully executes the plan", func() {
-		var err error
-		t.planId, err = t.executor.Execute(context.Background(), t.plan)
+	It("doesn't schedule another merge of the clinic while the merge is in progress", func() {
+		_, err := t.executor.Schedule(context.Background(), t.plan)
+		Expect(err).To(MatchError(errs.Conflict))
+	})
+
+	It("successfully resumes the merge", func() {
+		Expect(t.executor.Resume(context.Background(), t.planId)).To(Succeed())
+
+		job, err := t.executor.GetJob(context.Background(), t.planId)
 		Expect(err).ToNot(HaveOccurred())
+		Expect(job.Status).To(Equal(merge.JobStatusCompleted))
+		Expect(job.CompletedTime).ToNot(BeNil())
+		for _, phase := range job.Phases {
+			Expect(phase.Completed).To(Equal(phase.Total), "phase %s is not completed", phase.Type)
+		}
+	})
+
+	It("releases the locks of the clinics after the merge is completed", func() {
+		Expect(t.db.Collection("merge_locks").CountDocuments(context.Background(), bson.M{"ownerId": t.planId})).To(BeZero())
 	})
 
 	It("merges sites", func() {
@@ -417,6 +440,261 @@ var _ = Describe("New Clinic Merge Planner", Ordered, func() {
 			"plan.target._id": t.target.Id,
 		}, 1)
 	})
+
+	It("reports that the merge can be rolled back", func() {
+		report, err := t.executor.PreflightRollback(context.Background(), t.planId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.Conflicts).To(BeEmpty())
+		Expect(report.CanRollback()).To(BeTrue())
+	})
+
+	It("successfully rolls back the merge", func() {
+		_, err := t.executor.Rollback(context.Background(), t.planId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t.executor.Resume(context.Background(), t.planId)).To(Succeed())
+
+		job, err := t.executor.GetJob(context.Background(), t.planId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(job.Status).To(Equal(merge.JobStatusRolledBack))
+		for _, phase := range job.Phases {
+			Expect(phase.Reverted).To(Equal(phase.Completed), "phase %s is not reverted", phase.Type)
+		}
+		Expect(t.db.Collection("merge_locks").CountDocuments(context.Background(), bson.M{"ownerId": t.planId})).To(BeZero())
+	})
+
+	It("re-creates the source clinic and restores the share codes", func() {
+		source, err := t.clinicsService.Get(context.Background(), t.source.Id.Hex())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(source.ShareCodes).To(gstruct.PointTo(ConsistOf(*t.source.CanonicalShareCode)))
+
+		target, err := t.clinicsService.Get(context.Background(), t.target.Id.Hex())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(target.ShareCodes).To(gstruct.PointTo(ConsistOf(*t.target.CanonicalShareCode)))
+		Expect(target.Admins).To(gstruct.PointTo(ConsistOf(*t.targetAdmin.UserId)))
+	})
+
+	It("moves the source patients back to the source clinic", func() {
+		clinicId := t.source.Id.Hex()
+		filter := patients.Filter{ClinicId: &clinicId}
+		page := store.DefaultPagination().WithLimit(100000)
+		result, err := t.patientsService.List(context.Background(), &filter, page, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		ids := make([]string, len(result.Patients))
+		for i, p := range result.Patients {
+			ids[i] = *p.UserId
+		}
+		expected := make([]string, 0, len(t.sourcePatients))
+		for _, p := range t.sourcePatients {
+			expected = append(expected, *p.UserId)
+		}
+		Expect(ids).To(ConsistOf(expected))
+	})
+
+	It("moves the source clinician back to the source clinic", func() {
+		_, err := t.cliniciansService.Get(context.Background(), t.source.Id.Hex(), *t.sourceAdmin.UserId)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("doesn't roll back the merge twice", func() {
+		report, err := t.executor.PreflightRollback(context.Background(), t.planId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.CanRollback()).To(BeFalse())
+	})
+})
+
+var _ = Describe("Clinic Merge With Duplicate Review", Ordered, func() {
+	var t *ClinicMergeTest
+	var params = mergeTest.Params{
+		UniquePatientCount:           patientCount,
+		DuplicateAccountsCount:       duplicateAccountsCount,
+		LikelyDuplicateAccountsCount: likelyDuplicateAccountsCount,
+	}
+	var duplicates []merge.DuplicateCluster
+	var sourceUserId string
+	var duplicateUserId string
+
+	BeforeAll(func() {
+		t = NewClinicMergeTest()
+		t.Init(params)
+
+		// Only custodial patients can be merged into a patient with a different account
+		_, err := t.db.Collection("patients").UpdateMany(context.Background(), bson.M{"clinicId": t.source.Id}, bson.M{
+			"$set": bson.M{"permissions": patients.CustodialAccountPermissions},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		t.plan, err = t.planner.Plan(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterAll(func() {
+		t.app.RequireStop()
+		database := dbTest.GetTestDatabase()
+		patientsCollection := database.Collection("patients")
+		_, err := patientsCollection.DeleteMany(context.Background(), bson.M{})
+		Expect(err).To(Succeed())
+	})
+
+	It("schedules the merge for review", func() {
+		var err error
+		t.planId, err = t.executor.ScheduleReview(context.Background(), t.plan)
+		Expect(err).ToNot(HaveOccurred())
+
+		job, err := t.executor.GetJob(context.Background(), t.planId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(job.Status).To(Equal(merge.JobStatusAwaitingReview))
+	})
+
+	It("doesn't execute the merge while it's awaiting review", func() {
+		Expect(t.executor.Resume(context.Background(), t.planId)).To(Succeed())
+
+		_, err := t.executor.ClaimJob(context.Background())
+		Expect(err).To(MatchError(errs.NotFound))
+
+		job, err := t.executor.GetJob(context.Background(), t.planId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(job.Status).To(Equal(merge.JobStatusAwaitingReview))
+		for _, phase := range job.Phases {
+			Expect(phase.Completed).To(BeZero())
+		}
+	})
+
+	It("lists the likely duplicates", func() {
+		var err error
+		duplicates, err = t.executor.ListDuplicates(context.Background(), t.planId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(duplicates).To(HaveLen(likelyDuplicateAccountsCount))
+
+		conflicts := duplicates[0].Plan.Conflicts[merge.PatientConflictCategoryLikelyDuplicateAccounts]
+		Expect(conflicts).To(HaveLen(1))
+		sourceUserId = *duplicates[0].Plan.SourcePatient.UserId
+		duplicateUserId = *conflicts[0].Patient.UserId
+	})
+
+	It("persists the resolution of a duplicate", func() {
+		resolution := merge.DuplicateResolution{Type: merge.DuplicateResolutionMerge, TargetUserId: &duplicateUserId}
+		cluster, err := t.executor.ResolveDuplicate(context.Background(), t.planId, duplicates[0].Id, resolution)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.Plan.Resolution).ToNot(BeNil())
+
+		duplicates, err = t.executor.ListDuplicates(context.Background(), t.planId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(duplicates[0].Plan.Resolution).ToNot(BeNil())
+		Expect(duplicates[0].Plan.Resolution.Type).To(Equal(merge.DuplicateResolutionMerge))
+		Expect(*duplicates[0].Plan.Resolution.TargetUserId).To(Equal(duplicateUserId))
+	})
+
+	It("doesn't resolve another duplicate with the same target patient", func() {
+		resolution := merge.DuplicateResolution{Type: merge.DuplicateResolutionMerge, TargetUserId: &duplicateUserId}
+		_, err := t.executor.ResolveDuplicate(context.Background(), t.planId, duplicates[1].Id, resolution)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("executes the merge after it's started", func() {
+		Expect(t.executor.StartJob(context.Background(), t.planId)).To(Succeed())
+		Expect(t.executor.Resume(context.Background(), t.planId)).To(Succeed())
+
+		job, err := t.executor.GetJob(context.Background(), t.planId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(job.Status).To(Equal(merge.JobStatusCompleted))
+	})
+
+	It("doesn't change the resolution after the merge is started", func() {
+		resolution := merge.DuplicateResolution{Type: merge.DuplicateResolutionKeepBoth}
+		_, err := t.executor.ResolveDuplicate(context.Background(), t.planId, duplicates[0].Id, resolution)
+		Expect(err).To(MatchError(errs.Conflict))
+	})
+
+	It("merges the source patient into the selected duplicate", func() {
+		_, err := t.patientsService.Get(context.Background(), t.target.Id.Hex(), sourceUserId)
+		Expect(err).To(MatchError(errs.NotFound))
+
+		_, err = t.patientsService.Get(context.Background(), t.target.Id.Hex(), duplicateUserId)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("restores the source patient when the merge is rolled back", func() {
+		_, err := t.executor.Rollback(context.Background(), t.planId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t.executor.Resume(context.Background(), t.planId)).To(Succeed())
+
+		patient, err := t.patientsService.Get(context.Background(), t.source.Id.Hex(), sourceUserId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*patient.UserId).To(Equal(sourceUserId))
+	})
+})
+
+var _ = Describe("Clinic Split", Ordered, func() {
+	var t *ClinicMergeTest
+	var params = mergeTest.Params{UniquePatientCount: 25}
+	var admin *clinicians.Clinician
+	var movedPatientIds []string
+	var clinic *clinics.Clinic
+
+	BeforeAll(func() {
+		t = NewClinicMergeTest()
+		t.Init(params)
+
+		admin = cliniciansTest.RandomClinician()
+		admin.ClinicId = t.source.Id
+		admin.Roles = []string{clinicians.RoleClinicAdmin, "PATIENT_MANAGER"}
+		admin.Sites = &[]sites.Site{t.source.Sites[0]}
+		var err error
+		admin, err = t.cliniciansService.Create(context.Background(), admin)
+		Expect(err).ToNot(HaveOccurred())
+
+		// More patients than the batch size are moved to make sure they are moved in multiple transactions
+		for _, patient := range t.sourcePatients[:15] {
+			movedPatientIds = append(movedPatientIds, *patient.UserId)
+		}
+	})
+
+	AfterAll(func() {
+		t.app.RequireStop()
+		_, err := t.db.Collection("patients").DeleteMany(context.Background(), bson.M{})
+		Expect(err).To(Succeed())
+	})
+
+	It("splits the clinic", func() {
+		planner := merge.NewSplitPlanner(t.clinicsService, t.patientsService, t.cliniciansService, t.source.Id.Hex(), "Split", merge.SplitSelection{
+			PatientIds:   movedPatientIds,
+			ClinicianIds: []string{*admin.UserId},
+		})
+		plan, err := planner.Plan(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plan.PreventsSplit()).To(BeFalse())
+
+		expectCreator(t.userService, *admin)
+		clinic, err = t.executor.Split(context.Background(), plan)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("moves the patients to the new clinic", func() {
+		count, err := t.db.Collection("patients").CountDocuments(context.Background(), bson.M{
+			"clinicId": clinic.Id,
+			"userId":   bson.M{"$in": movedPatientIds},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(BeNumerically("==", len(movedPatientIds)))
+	})
+
+	It("keeps the roles and the sites of the creator", func() {
+		creator, err := t.cliniciansService.Get(context.Background(), clinic.Id.Hex(), *admin.UserId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(creator.Id).To(Equal(admin.Id))
+		Expect(creator.Roles).To(Equal(admin.Roles))
+		Expect(creator.Sites).To(gstruct.PointTo(ConsistOf(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+			"Id": Equal(t.source.Sites[0].Id),
+		}))))
+
+		_, err = t.cliniciansService.Get(context.Background(), t.source.Id.Hex(), *admin.UserId)
+		Expect(err).To(MatchError(errs.NotFound))
+	})
+
+	It("releases the lock of the source clinic", func() {
+		Expect(t.db.Collection("merge_locks").CountDocuments(context.Background(), bson.M{"_id": t.source.Id})).To(BeZero())
+	})
 })
 
 var _ = Describe("New Clinic Merge Planner (w/ Large patient populations)", Ordered, Label("slow"), func() {
@@ -448,6 +726,18 @@ func hasMergePlan(db *mongo.Database, filter bson.M, expectedCount int) {
 }
 
 func createClinic(userService *patientsTest.MockUserService, clinicManager manager.Manager, clinic clinics.Clinic, admin clinicians.Clinician) clinics.Clinic {
+	expectCreator(userService, admin)
+
+	clinic.Admins = nil
+	result, err := clinicManager.CreateClinic(context.Background(), &manager.CreateClinic{
+		Clinic:        clinic,
+		CreatorUserId: *admin.UserId,
+	})
+	Expect(err).ToNot(HaveOccurred())
+	return *result
+}
+
+func expectCreator(userService *patientsTest.MockUserService, admin clinicians.Clinician) {
 	userService.EXPECT().GetUser(*admin.UserId).Return(&shoreline.UserData{
 		UserID:         *admin.UserId,
 		Username:       *admin.Email,
@@ -458,14 +748,6 @@ func createClinic(userService *patientsTest.MockUserService, clinicManager manag
 	userService.EXPECT().GetUserProfile(gomock.Any(), *admin.UserId).Return(&patients.Profile{
 		FullName: admin.Name,
 	}, nil)
-
-	clinic.Admins = nil
-	result, err := clinicManager.CreateClinic(context.Background(), &manager.CreateClinic{
-		Clinic:        clinic,
-		CreatorUserId: *admin.UserId,
-	})
-	Expect(err).ToNot(HaveOccurred())
-	return *result
 }
 
 func clinicHasTagWithName(clinic clinics.Clinic, tagName string) bool {
@@ -0,0 +1,424 @@
+package merge
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/tidepool-org/clinic/clinicians"
+	"github.com/tidepool-org/clinic/clinics"
+	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/pointer"
+	"github.com/tidepool-org/clinic/sites"
+)
+
+type ConsolidationOptions struct {
+	// SourceSites assigns the patients of each source clinic to a new site of the target clinic named after the
+	// source clinic
+	SourceSites bool
+}
+
+// ConsolidationPlan is the plan of a merge of multiple source clinics into a single target clinic. The source clinics
+// are merged one after another, each one into the target clinic as it will be after the merges of the previous ones.
+type ConsolidationPlan struct {
+	Target clinics.Clinic
+	Merges []ClinicMergePlan
+
+	// SourcePatientClusters are the likely duplicates across the patients of all source clinics
+	SourcePatientClusters PatientClusters
+	// PatientClusters are the likely duplicates across the patients of all source clinics and the target clinic
+	PatientClusters PatientClusters
+
+	CreatedTime time.Time
+}
+
+func (c ConsolidationPlan) PreventsMerge() bool {
+	for _, merge := range c.Merges {
+		if merge.PreventsMerge() {
+			return true
+		}
+	}
+	return false
+}
+
+func (c ConsolidationPlan) Errors() []ReportError {
+	result := make([]ReportError, 0)
+	for _, merge := range c.Merges {
+		result = append(result, merge.Errors()...)
+	}
+	return result
+}
+
+// Combined returns a single merge plan of all source clinics into the target clinic which is used to generate the
+// report of the consolidation. The plans of the target clinic are the ones of the first merge.
+func (c ConsolidationPlan) Combined() ClinicMergePlan {
+	combined := ClinicMergePlan{
+		Target:                c.Target,
+		SourcePatientClusters: c.SourcePatientClusters,
+		TargetPatientClusters: c.PatientClusters,
+		CreatedTime:           c.CreatedTime,
+	}
+
+	names := make([]string, 0, len(c.Merges))
+	mergedInto := mapset.NewSet[string]()
+	var targetPatientPlans PatientPlans
+	for i, merge := range c.Merges {
+		name := pointer.ToString(merge.Source.Name)
+		names = append(names, name)
+
+		if i == 0 || merge.MembershipRestrictionsMergePlan.PreventsMerge() {
+			combined.MembershipRestrictionsMergePlan = merge.MembershipRestrictionsMergePlan
+		}
+		for _, plan := range merge.SettingsPlans {
+			if len(c.Merges) > 1 {
+				plan.Name = fmt.Sprintf("%s (%s)", plan.Name, name)
+			}
+			combined.SettingsPlans = append(combined.SettingsPlans, plan)
+		}
+		for _, plan := range merge.TagsPlans {
+			if i == 0 || plan.TagAction != TagActionRetain {
+				combined.TagsPlans = append(combined.TagsPlans, plan)
+			}
+		}
+		for _, plan := range merge.SitesPlans {
+			if i == 0 || plan.Action != SiteActionRetain {
+				combined.SitesPlans = append(combined.SitesPlans, plan)
+			}
+		}
+		for _, plan := range merge.ClinicianPlans {
+			if i == 0 || plan.ClinicianAction == ClinicianActionMove || plan.ClinicianAction == ClinicianActionMerge {
+				combined.ClinicianPlans = append(combined.ClinicianPlans, plan)
+			}
+		}
+		for _, plan := range merge.PatientPlans {
+			if plan.SourcePatient != nil {
+				if plan.PatientAction == PatientActionMerge && plan.TargetPatient != nil {
+					mergedInto.Add(getUserId(*plan.TargetPatient))
+				}
+				combined.PatientPlans = append(combined.PatientPlans, plan)
+			} else if i == 0 {
+				targetPatientPlans = append(targetPatientPlans, plan)
+			}
+		}
+	}
+	for _, plan := range targetPatientPlans {
+		if mergedInto.Contains(getUserId(*plan.TargetPatient)) {
+			plan.PatientAction = PatientActionMergeInto
+		}
+		combined.PatientPlans = append(combined.PatientPlans, plan)
+	}
+
+	combined.Source = clinics.Clinic{Name: pointer.FromAny(strings.Join(names, ", "))}
+	return combined
+}
+
+// ConsolidationPlanner plans the merges of multiple source clinics into a single target clinic
+type ConsolidationPlanner struct {
+	clinics    clinics.Service
+	patients   patients.Service
+	clinicians clinicians.Service
+
+	sourceIds []string
+	targetId  string
+	options   ConsolidationOptions
+}
+
+func NewConsolidationPlanner(clinicsService clinics.Service, patientsService patients.Service, cliniciansService clinicians.Service, sourceIds []string, targetId string, options ConsolidationOptions) Planner[ConsolidationPlan] {
+	return &ConsolidationPlanner{
+		clinics:    clinicsService,
+		patients:   patientsService,
+		clinicians: cliniciansService,
+		sourceIds:  sourceIds,
+		targetId:   targetId,
+		options:    options,
+	}
+}
+
+func (c *ConsolidationPlanner) Plan(ctx context.Context) (plan ConsolidationPlan, err error) {
+	if len(c.sourceIds) == 0 {
+		err = fmt.Errorf("%w: at least one source clinic is required", errs.BadRequest)
+		return
+	}
+	for i, sourceId := range c.sourceIds {
+		if sourceId == c.targetId {
+			err = fmt.Errorf("%w: the target clinic cannot be a source clinic", errs.BadRequest)
+			return
+		}
+		if slices.Contains(c.sourceIds[:i], sourceId) {
+			err = fmt.Errorf("%w: duplicate source clinic %s", errs.BadRequest, sourceId)
+			return
+		}
+	}
+
+	target, err := c.clinics.Get(ctx, c.targetId)
+	if err != nil {
+		return
+	}
+
+	projectedClinicians := &projectedCliniciansService{
+		Service:    c.clinicians,
+		targetId:   c.targetId,
+		clinicians: map[string]*clinicians.Clinician{},
+	}
+	planner := &ClinicMergePlanner{
+		clinics:    c.clinics,
+		patients:   c.patients,
+		clinicians: projectedClinicians,
+		targetId:   c.targetId,
+	}
+
+	targetPatients, err := planner.listAllPatients(ctx, *target)
+	if err != nil {
+		return
+	}
+	projection := newConsolidationProjection(*target, targetPatients)
+
+	var sourcePatients []patients.Patient
+	for _, sourceId := range c.sourceIds {
+		var source *clinics.Clinic
+		source, err = c.clinics.Get(ctx, sourceId)
+		if err != nil {
+			return
+		}
+		var patientsList []patients.Patient
+		patientsList, err = planner.listAllPatients(ctx, *source)
+		if err != nil {
+			return
+		}
+		sourcePatients = append(sourcePatients, patientsList...)
+
+		var merge ClinicMergePlan
+		merge, err = planner.plan(ctx, *source, projection.clinic(), patientsList, projection.patients())
+		if err != nil {
+			return
+		}
+		if c.options.SourceSites {
+			if err = addSourceSite(ctx, &merge); err != nil {
+				return
+			}
+		}
+
+		projection.apply(merge)
+		projectedClinicians.apply(merge)
+		plan.Merges = append(plan.Merges, merge)
+	}
+
+	plan.SourcePatientClusters, err = NewPatientClusterReporter(uniquePatients(sourcePatients)).Plan(ctx)
+	if err != nil {
+		return
+	}
+	plan.PatientClusters, err = NewPatientClusterReporter(uniquePatients(slices.Concat(targetPatients, sourcePatients))).Plan(ctx)
+	if err != nil {
+		return
+	}
+
+	plan.Target = *target
+	plan.CreatedTime = time.Now()
+	return
+}
+
+// uniquePatients returns the first of the patients with the same user id, because the same patient can be a member of
+// multiple clinics
+func uniquePatients(pts []patients.Patient) []patients.Patient {
+	userIds := mapset.NewThreadUnsafeSet[string]()
+	result := make([]patients.Patient, 0, len(pts))
+	for _, patient := range pts {
+		if userIds.Add(getUserId(patient)) {
+			result = append(result, patient)
+		}
+	}
+	return result
+}
+
+// addSourceSite adds a site named after the source clinic to the target clinic and assigns it to all patients of the
+// source clinic
+func addSourceSite(ctx context.Context, merge *ClinicMergePlan) error {
+	// The site must not clash with the sites moved from the source clinic
+	target := merge.Target
+	target.Sites = slices.Clone(target.Sites)
+	for _, plan := range merge.SitesPlans {
+		if plan.Action == SiteActionMove || plan.Action == SiteActionRename {
+			site := plan.Site
+			site.Name = plan.Name()
+			target.Sites = append(target.Sites, site)
+		}
+	}
+
+	site := sites.Site{Id: primitive.NewObjectID(), Name: pointer.ToString(merge.Source.Name)}
+	sitePlan, err := NewSourceSiteMergePlanner(site, merge.Source, target).Plan(ctx)
+	if err != nil {
+		return err
+	}
+	merge.SitesPlans = append(merge.SitesPlans, sitePlan)
+
+	for i, plan := range merge.PatientPlans {
+		if plan.SourcePatient == nil || (plan.PatientAction != PatientActionMove && plan.PatientAction != PatientActionMerge) {
+			continue
+		}
+		merge.PatientPlans[i].SourceSite = &site
+		merge.PatientPlans[i].PostMigrationSiteNames = append(slices.Clone(plan.PostMigrationSiteNames), sitePlan.Name())
+		slices.Sort(merge.PatientPlans[i].PostMigrationSiteNames)
+	}
+	return nil
+}
+
+// consolidationProjection is the state of the target clinic and its patients after the merges of the source clinics
+// which were planned so far
+type consolidationProjection struct {
+	target         clinics.Clinic
+	targetPatients []patients.Patient
+}
+
+func newConsolidationProjection(target clinics.Clinic, targetPatients []patients.Patient) *consolidationProjection {
+	return &consolidationProjection{
+		target:         target,
+		targetPatients: slices.Clone(targetPatients),
+	}
+}
+
+func (p *consolidationProjection) clinic() clinics.Clinic {
+	return p.target
+}
+
+func (p *consolidationProjection) patients() []patients.Patient {
+	return slices.Clone(p.targetPatients)
+}
+
+func (p *consolidationProjection) apply(merge ClinicMergePlan) {
+	target := p.target
+	target.PatientTags = slices.Clone(target.PatientTags)
+	for _, plan := range merge.TagsPlans {
+		if plan.TagAction == TagActionCreate {
+			target.PatientTags = append(target.PatientTags, clinics.PatientTag{Id: pointer.FromAny(primitive.NewObjectID()), Name: plan.Name})
+		}
+	}
+
+	// The sites keep their ids when they are moved
+	renamed := map[primitive.ObjectID]string{}
+	target.Sites = slices.Clone(target.Sites)
+	for _, plan := range merge.SitesPlans {
+		if plan.Action == SiteActionMove || plan.Action == SiteActionRename {
+			site := plan.Site
+			site.Name = plan.Name()
+			renamed[site.Id] = site.Name
+			target.Sites = append(target.Sites, site)
+		}
+	}
+
+	// The user ids of the moved clinicians are added to the admins of the target clinic
+	var admins []string
+	if target.Admins != nil {
+		admins = slices.Clone(*target.Admins)
+	}
+	for _, plan := range merge.ClinicianPlans {
+		userId := plan.Clinician.UserId
+		if plan.ClinicianAction == ClinicianActionMove && userId != nil && !slices.Contains(admins, *userId) {
+			admins = append(admins, *userId)
+		}
+	}
+	if admins != nil {
+		target.Admins = &admins
+	}
+	if merge.Source.ShareCodes != nil {
+		var shareCodes []string
+		if target.ShareCodes != nil {
+			shareCodes = slices.Clone(*target.ShareCodes)
+		}
+		for _, shareCode := range *merge.Source.ShareCodes {
+			if !slices.Contains(shareCodes, shareCode) {
+				shareCodes = append(shareCodes, shareCode)
+			}
+		}
+		target.ShareCodes = &shareCodes
+	}
+
+	tagIds := map[string]primitive.ObjectID{}
+	for _, tag := range target.PatientTags {
+		tagIds[tag.Name] = *tag.Id
+	}
+	for _, plan := range merge.PatientPlans {
+		if plan.SourcePatient == nil {
+			continue
+		}
+
+		tags := make([]primitive.ObjectID, 0, len(plan.PostMigrationTagNames))
+		for _, name := range plan.PostMigrationTagNames {
+			if id, ok := tagIds[name]; ok {
+				tags = append(tags, id)
+			}
+		}
+		var patientSites []sites.Site
+		if plan.SourcePatient.Sites != nil {
+			for _, site := range *plan.SourcePatient.Sites {
+				if name, ok := renamed[site.Id]; ok {
+					site.Name = name
+				}
+				patientSites = append(patientSites, site)
+			}
+		}
+		if plan.SourceSite != nil {
+			site := *plan.SourceSite
+			site.Name = renamed[site.Id]
+			patientSites = append(patientSites, site)
+		}
+
+		switch plan.PatientAction {
+		case PatientActionMove:
+			patient := *plan.SourcePatient
+			patient.ClinicId = target.Id
+			patient.Tags = &tags
+			patient.Sites = &patientSites
+			p.targetPatients = append(p.targetPatients, patient)
+		case PatientActionMerge:
+			for i, patient := range p.targetPatients {
+				if getUserId(patient) != getUserId(*plan.TargetPatient) {
+					continue
+				}
+				if patient.Sites != nil {
+					patientSites = slices.Concat(*patient.Sites, patientSites)
+				}
+				patient.Tags = &tags
+				patient.Sites = &patientSites
+				p.targetPatients[i] = patient
+				break
+			}
+		}
+	}
+
+	p.target = target
+}
+
+// projectedCliniciansService returns the clinicians which were moved to the target clinic by the merges planned so
+// far as members of the target clinic
+type projectedCliniciansService struct {
+	clinicians.Service
+
+	targetId   string
+	clinicians map[string]*clinicians.Clinician
+}
+
+func (p *projectedCliniciansService) Get(ctx context.Context, clinicId string, clinicianId string) (*clinicians.Clinician, error) {
+	if clinicId == p.targetId {
+		if clinician, ok := p.clinicians[clinicianId]; ok {
+			return clinician, nil
+		}
+	}
+	return p.Service.Get(ctx, clinicId, clinicianId)
+}
+
+func (p *projectedCliniciansService) apply(merge ClinicMergePlan) {
+	for _, plan := range merge.ClinicianPlans {
+		if plan.ClinicianAction != ClinicianActionMove || plan.Clinician.UserId == nil {
+			continue
+		}
+		clinician := plan.Clinician
+		clinician.ClinicId = merge.Target.Id
+		p.clinicians[*clinician.UserId] = &clinician
+	}
+}
@@ -0,0 +1,144 @@
+package merge_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gstruct"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/clinics/merge"
+	"github.com/tidepool-org/clinic/patients"
+	patientsTest "github.com/tidepool-org/clinic/patients/test"
+	"github.com/tidepool-org/clinic/pointer"
+)
+
+var _ = Describe("Consolidation", func() {
+	Describe("Combined", func() {
+		var plan merge.ConsolidationPlan
+		var targetPatient patients.Patient
+		var first, second patients.Patient
+
+		BeforeEach(func() {
+			target := clinics.Clinic{Id: pointer.FromAny(primitive.NewObjectID()), Name: pointer.FromAny("Target")}
+			sourceA := clinics.Clinic{Id: pointer.FromAny(primitive.NewObjectID()), Name: pointer.FromAny("Source A")}
+			sourceB := clinics.Clinic{Id: pointer.FromAny(primitive.NewObjectID()), Name: pointer.FromAny("Source B")}
+
+			targetPatient = patientsTest.RandomPatient()
+			first = patientsTest.RandomPatient()
+			second = patientsTest.RandomPatient()
+			duplicate := second
+			duplicate.UserId = targetPatient.UserId
+
+			plan = merge.ConsolidationPlan{
+				Target: target,
+				Merges: []merge.ClinicMergePlan{{
+					Source:        sourceA,
+					Target:        target,
+					SettingsPlans: merge.SettingsPlans{{Name: merge.TaskTypeClinicSettingsTimezone}},
+					TagsPlans: merge.TagPlans{
+						{Name: "A", TagAction: merge.TagActionCreate},
+						{Name: "T", TagAction: merge.TagActionRetain},
+					},
+					PatientPlans: merge.PatientPlans{
+						{SourcePatient: &first, PatientAction: merge.PatientActionMove},
+						{TargetPatient: &targetPatient, PatientAction: merge.PatientActionRetain},
+					},
+				}, {
+					Source:        sourceB,
+					Target:        target,
+					SettingsPlans: merge.SettingsPlans{{Name: merge.TaskTypeClinicSettingsTimezone}},
+					TagsPlans: merge.TagPlans{
+						{Name: "B", TagAction: merge.TagActionCreate},
+						{Name: "A", TagAction: merge.TagActionRetain},
+						{Name: "T", TagAction: merge.TagActionRetain},
+					},
+					PatientPlans: merge.PatientPlans{
+						{SourcePatient: &duplicate, TargetPatient: &targetPatient, PatientAction: merge.PatientActionMerge},
+						{TargetPatient: &first, PatientAction: merge.PatientActionRetain},
+						{TargetPatient: &targetPatient, PatientAction: merge.PatientActionMergeInto},
+					},
+				}},
+			}
+		})
+
+		It("names the combined source after all source clinics", func() {
+			combined := plan.Combined()
+			Expect(combined.Source.Name).To(gstruct.PointTo(Equal("Source A, Source B")))
+			Expect(combined.Target.Id).To(Equal(plan.Target.Id))
+		})
+
+		It("reports the settings of each source clinic", func() {
+			combined := plan.Combined()
+			Expect(combined.SettingsPlans).To(HaveLen(2))
+			Expect(combined.SettingsPlans[0].Name).To(ContainSubstring("Source A"))
+			Expect(combined.SettingsPlans[1].Name).To(ContainSubstring("Source B"))
+		})
+
+		It("reports the tags of the target clinic once", func() {
+			var names []string
+			for _, tag := range plan.Combined().TagsPlans {
+				names = append(names, tag.Name)
+			}
+			Expect(names).To(ConsistOf("A", "T", "B"))
+		})
+
+		It("reports the patients of the source clinics and the original patients of the target clinic", func() {
+			combined := plan.Combined()
+			Expect(combined.PatientPlans.GetSourcePatientPlans()).To(HaveLen(2))
+
+			targetPlans := combined.PatientPlans.GetTargetPatientPlans()
+			Expect(targetPlans).To(HaveLen(1))
+			Expect(targetPlans[0].TargetPatient.UserId).To(Equal(targetPatient.UserId))
+			Expect(targetPlans[0].PatientAction).To(Equal(merge.PatientActionMergeInto))
+			Expect(combined.PatientPlans.GetResultingPatientsCount()).To(Equal(2))
+		})
+	})
+
+	Describe("Status", func() {
+		It("is pending until the first merge is started", func() {
+			consolidation := merge.Consolidation{Jobs: []merge.Job{
+				{Status: merge.JobStatusPending},
+				{Status: merge.JobStatusWaiting},
+			}}
+			Expect(consolidation.Status()).To(Equal(merge.JobStatusPending))
+		})
+
+		It("is running while the subsequent merges are executed", func() {
+			consolidation := merge.Consolidation{Jobs: []merge.Job{
+				{Status: merge.JobStatusCompleted},
+				{Status: merge.JobStatusPending},
+			}}
+			Expect(consolidation.Status()).To(Equal(merge.JobStatusRunning))
+		})
+
+		It("is completed when all merges are completed", func() {
+			consolidation := merge.Consolidation{Jobs: []merge.Job{
+				{Status: merge.JobStatusCompleted},
+				{Status: merge.JobStatusCompleted},
+			}}
+			Expect(consolidation.Status()).To(Equal(merge.JobStatusCompleted))
+		})
+
+		It("is failed when a merge failed", func() {
+			consolidation := merge.Consolidation{Jobs: []merge.Job{
+				{Status: merge.JobStatusCompleted},
+				{Status: merge.JobStatusFailed},
+				{Status: merge.JobStatusCanceled},
+			}}
+			Expect(consolidation.Status()).To(Equal(merge.JobStatusFailed))
+		})
+
+		It("is rolling back until all merges are rolled back", func() {
+			consolidation := merge.Consolidation{Jobs: []merge.Job{
+				{Status: merge.JobStatusCompleted},
+				{Status: merge.JobStatusRollingBack},
+			}}
+			Expect(consolidation.Status()).To(Equal(merge.JobStatusRollingBack))
+
+			consolidation.Jobs[0].Status = merge.JobStatusRolledBack
+			consolidation.Jobs[1].Status = merge.JobStatusRolledBack
+			Expect(consolidation.Status()).To(Equal(merge.JobStatusRolledBack))
+		})
+	})
+})
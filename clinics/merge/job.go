@@ -0,0 +1,787 @@
+package merge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/clinicians"
+	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/store"
+)
+
+const (
+	jobsCollectionName  = "merge_jobs"
+	locksCollectionName = "merge_locks"
+
+	JobStatusAwaitingReview = "awaiting_review"
+	JobStatusWaiting        = "waiting"
+	JobStatusPending        = "pending"
+	JobStatusRunning        = "running"
+	JobStatusCompleted      = "completed"
+	JobStatusFailed         = "failed"
+	JobStatusRollingBack    = "rolling_back"
+	JobStatusRolledBack     = "rolled_back"
+	JobStatusCanceled       = "canceled"
+)
+
+// activeJobStatuses are the statuses of jobs which are executed by the worker
+var activeJobStatuses = bson.A{JobStatusPending, JobStatusRunning, JobStatusRollingBack}
+
+// phases are the plan types in the order in which they are executed
+var phases = []string{planTypeTag, planTypePatient, planTypeSite, planTypeClinician, planTypeClinic}
+
+type Config struct {
+	// BatchSize is the number of plans executed in a single transaction
+	BatchSize      int           `envconfig:"TIDEPOOL_CLINIC_MERGE_BATCH_SIZE" default:"100"`
+	WorkerInterval time.Duration `envconfig:"TIDEPOOL_CLINIC_MERGE_WORKER_INTERVAL" default:"5s"`
+	// Lease is how long a job is claimed by a worker without progress, before it can be resumed by another worker
+	Lease       time.Duration `envconfig:"TIDEPOOL_CLINIC_MERGE_LEASE" default:"2m"`
+	RetryDelay  time.Duration `envconfig:"TIDEPOOL_CLINIC_MERGE_RETRY_DELAY" default:"1m"`
+	MaxAttempts int           `envconfig:"TIDEPOOL_CLINIC_MERGE_MAX_ATTEMPTS" default:"5"`
+	// RollbackWindow is how long after the completion a merge can be rolled back
+	RollbackWindow time.Duration `envconfig:"TIDEPOOL_CLINIC_MERGE_ROLLBACK_WINDOW" default:"168h"`
+}
+
+func NewConfig() (Config, error) {
+	cfg := Config{}
+	err := envconfig.Process("", &cfg)
+	return cfg, err
+}
+
+// Job is the execution state of a clinic merge. The id of the job is the id of the merge plan.
+type Job struct {
+	Id              primitive.ObjectID `bson:"_id"`
+	SourceClinicId  primitive.ObjectID `bson:"sourceClinicId"`
+	TargetClinicId  primitive.ObjectID `bson:"targetClinicId"`
+	Status          string             `bson:"status"`
+	Attempts        int                `bson:"attempts"`
+	LastError       *string            `bson:"lastError,omitempty"`
+	NextAttemptTime time.Time          `bson:"nextAttemptTime"`
+	CreatedTime     time.Time          `bson:"createdTime"`
+	ModifiedTime    time.Time          `bson:"modifiedTime"`
+	CompletedTime   *time.Time         `bson:"completedTime,omitempty"`
+	RolledBackTime  *time.Time         `bson:"rolledBackTime,omitempty"`
+
+	// ConsolidationId is the id of the consolidation the merge is part of. The merges of a consolidation are executed
+	// one after another in the order of their sequence.
+	ConsolidationId       *primitive.ObjectID `bson:"consolidationId,omitempty"`
+	ConsolidationSequence int                 `bson:"consolidationSequence,omitempty"`
+
+	// Phases is the progress of each phase of the merge, computed from the persisted plans
+	Phases []JobPhase `bson:"-"`
+}
+
+func (j Job) IsTerminal() bool {
+	return j.Status == JobStatusCompleted || j.Status == JobStatusFailed || j.Status == JobStatusRolledBack || j.Status == JobStatusCanceled
+}
+
+type JobPhase struct {
+	Type      string `bson:"_id"`
+	Total     int    `bson:"total"`
+	Completed int    `bson:"completed"`
+	Reverted  int    `bson:"reverted"`
+}
+
+// lock prevents concurrent merges and splits of a clinic. The clinic id is the id of the lock, so a clinic can only
+// be locked once. The lock is owned by the merge job, or by the consolidation if the merge is part of one, and is held
+// until the job is completed, failed or rolled back.
+type lock struct {
+	ClinicId    primitive.ObjectID `bson:"_id"`
+	OwnerId     primitive.ObjectID `bson:"ownerId"`
+	CreatedTime time.Time          `bson:"createdTime"`
+}
+
+// persistedPlan is used to decode the persisted plans before the type of the plan is known
+type persistedPlan struct {
+	Id   primitive.ObjectID `bson:"_id"`
+	Plan bson.Raw           `bson:"plan"`
+	Type string             `bson:"type"`
+}
+
+// Schedule persists the plans of the merge and creates a job which is executed in the background by the worker.
+// Only one merge of a clinic can be in progress at the same time.
+func (c *ClinicPlanExecutor) Schedule(ctx context.Context, plan ClinicMergePlan) (primitive.ObjectID, error) {
+	return c.schedule(ctx, plan, JobStatusPending)
+}
+
+// ScheduleReview persists the plans of the merge and creates a job which is not executed until the duplicate patients
+// are reviewed and the job is started
+func (c *ClinicPlanExecutor) ScheduleReview(ctx context.Context, plan ClinicMergePlan) (primitive.ObjectID, error) {
+	return c.schedule(ctx, plan, JobStatusAwaitingReview)
+}
+
+func (c *ClinicPlanExecutor) schedule(ctx context.Context, plan ClinicMergePlan, status string) (primitive.ObjectID, error) {
+	logger := c.Logger.With("clinicId", plan.Source.Id.Hex(), "targetClinicId", plan.Target.Id.Hex())
+	if plan.PreventsMerge() {
+		err := fmt.Errorf("%w: the merge plan does not allow execution", errs.BadRequest)
+		logger.Errorw("cannot merge clinics", "error", err)
+		return primitive.NilObjectID, err
+	}
+	clinicIds := []primitive.ObjectID{*plan.Source.Id, *plan.Target.Id}
+	planId := primitive.NewObjectID()
+	job := newJob(planId, plan, status)
+
+	// The clinics are locked, the plans are persisted and the job is created in the same transaction, so the worker
+	// never executes an incomplete plan and no plans are persisted if a merge of the clinics is already in progress.
+	var count int
+	_, err := store.WithTransaction(ctx, c.DBClient, func(sessionCtx mongo.SessionContext) (any, error) {
+		if err := c.acquireLocks(sessionCtx, planId, clinicIds); err != nil {
+			return nil, err
+		}
+		var err error
+		if count, err = c.persistPlans(sessionCtx, planId, plan); err != nil {
+			return nil, err
+		}
+		if _, err := c.jobs().InsertOne(sessionCtx, job); err != nil {
+			return nil, fmt.Errorf("unable to create merge job: %w", err)
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+
+	logger.Infow("scheduled clinic merge", "planId", planId.Hex(), "plans", count, "status", status)
+	return planId, nil
+}
+
+// ScheduleConsolidation persists the plans of the merges of a consolidation and creates a job for each merge. Only
+// the job of the first merge is executed by the worker, the job of each subsequent merge is started when the job of
+// the previous one is completed.
+func (c *ClinicPlanExecutor) ScheduleConsolidation(ctx context.Context, plan ConsolidationPlan) (primitive.ObjectID, error) {
+	logger := c.Logger.With("targetClinicId", plan.Target.Id.Hex())
+	if plan.PreventsMerge() {
+		err := fmt.Errorf("%w: the consolidation plan does not allow execution", errs.BadRequest)
+		logger.Errorw("cannot consolidate clinics", "error", err)
+		return primitive.NilObjectID, err
+	}
+
+	clinicIds := []primitive.ObjectID{*plan.Target.Id}
+	for _, merge := range plan.Merges {
+		clinicIds = append(clinicIds, *merge.Source.Id)
+	}
+
+	consolidationId := primitive.NewObjectID()
+	planIds := make([]primitive.ObjectID, 0, len(plan.Merges))
+	jobs := make([]any, 0, len(plan.Merges))
+	for i, merge := range plan.Merges {
+		planId := primitive.NewObjectID()
+		status := JobStatusWaiting
+		if i == 0 {
+			status = JobStatusPending
+		}
+		job := newJob(planId, merge, status)
+		job.ConsolidationId = &consolidationId
+		job.ConsolidationSequence = i
+		planIds = append(planIds, planId)
+		jobs = append(jobs, job)
+	}
+
+	// The clinics are locked by the consolidation until its last merge is completed. The plans are persisted and the
+	// jobs are created in the same transaction, so the worker never executes an incomplete plan.
+	_, err := store.WithTransaction(ctx, c.DBClient, func(sessionCtx mongo.SessionContext) (any, error) {
+		if err := c.acquireLocks(sessionCtx, consolidationId, clinicIds); err != nil {
+			return nil, err
+		}
+		for i, merge := range plan.Merges {
+			if _, err := c.persistPlans(sessionCtx, planIds[i], merge); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := c.jobs().InsertMany(sessionCtx, jobs); err != nil {
+			return nil, fmt.Errorf("unable to create merge jobs: %w", err)
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+
+	logger.Infow("scheduled clinic consolidation", "consolidationId", consolidationId.Hex(), "merges", len(jobs))
+	return consolidationId, nil
+}
+
+// acquireLocks locks the clinics for the owner. A conflict is returned if any of the clinics is already locked.
+func (c *ClinicPlanExecutor) acquireLocks(ctx context.Context, ownerId primitive.ObjectID, clinicIds []primitive.ObjectID) error {
+	now := time.Now()
+	locks := make([]any, 0, len(clinicIds))
+	for _, clinicId := range clinicIds {
+		locks = append(locks, lock{ClinicId: clinicId, OwnerId: ownerId, CreatedTime: now})
+	}
+
+	if _, err := c.locks().InsertMany(ctx, locks); mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("%w: a merge of the clinic is already in progress", errs.Conflict)
+	} else if err != nil {
+		return fmt.Errorf("unable to lock clinics: %w", err)
+	}
+	return nil
+}
+
+// releaseLocks releases the locks of the owner
+func (c *ClinicPlanExecutor) releaseLocks(ctx context.Context, ownerId primitive.ObjectID) error {
+	if _, err := c.locks().DeleteMany(ctx, bson.M{"ownerId": ownerId}); err != nil {
+		return fmt.Errorf("unable to release clinic locks: %w", err)
+	}
+	return nil
+}
+
+// lockOwnerId returns the id of the owner of the locks of the job
+func lockOwnerId(job Job) primitive.ObjectID {
+	if job.ConsolidationId != nil {
+		return *job.ConsolidationId
+	}
+	return job.Id
+}
+
+// persistPlans persists the plans of the merge in the order in which they are executed and returns their count
+func (c *ClinicPlanExecutor) persistPlans(ctx context.Context, planId primitive.ObjectID, plan ClinicMergePlan) (int, error) {
+	plans := make([]any, 0, len(plan.TagsPlans)+len(plan.PatientPlans)+len(plan.SitesPlans)+len(plan.ClinicianPlans)+1)
+	for _, p := range plan.TagsPlans {
+		plans = append(plans, NewPersistentPlan(planId, planTypeTag, p).WithSequence(len(plans)))
+	}
+	for _, p := range plan.PatientPlans {
+		if p.SourcePatient != nil {
+			sanitizePatient(p.SourcePatient)
+		}
+		if p.TargetPatient != nil {
+			sanitizePatient(p.TargetPatient)
+		}
+		plans = append(plans, NewPersistentPlan(planId, planTypePatient, p).WithSequence(len(plans)))
+	}
+	for _, p := range plan.SitesPlans {
+		plans = append(plans, NewPersistentPlan(planId, planTypeSite, p).WithSequence(len(plans)))
+	}
+	for _, p := range plan.ClinicianPlans {
+		plans = append(plans, NewPersistentPlan(planId, planTypeClinician, p).WithSequence(len(plans)))
+	}
+	plans = append(plans, NewPersistentPlan(planId, planTypeClinic, plan).WithSequence(len(plans)))
+
+	for i := 0; i < len(plans); i += c.Config.BatchSize {
+		batch := plans[i:min(i+c.Config.BatchSize, len(plans))]
+		if _, err := c.plans().InsertMany(ctx, batch); err != nil {
+			return 0, fmt.Errorf("unable to persist merge plans: %w", err)
+		}
+	}
+	return len(plans), nil
+}
+
+func newJob(planId primitive.ObjectID, plan ClinicMergePlan, status string) Job {
+	now := time.Now()
+	return Job{
+		Id:              planId,
+		SourceClinicId:  *plan.Source.Id,
+		TargetClinicId:  *plan.Target.Id,
+		Status:          status,
+		NextAttemptTime: now,
+		CreatedTime:     now,
+		ModifiedTime:    now,
+	}
+}
+
+// Resume executes the pending plans of the merge job in batches. Each batch is executed in a transaction which also
+// marks the plans of the batch as executed, so a merge interrupted by a failure or a crash can be resumed safely.
+// Jobs which are rolled back revert the executed plans in the reverse order instead.
+func (c *ClinicPlanExecutor) Resume(ctx context.Context, planId primitive.ObjectID) error {
+	job, err := c.getJob(ctx, planId)
+	if err != nil {
+		return err
+	}
+	if job.IsTerminal() || job.Status == JobStatusAwaitingReview || job.Status == JobStatusWaiting {
+		return nil
+	}
+
+	logger := c.Logger.With("planId", planId.Hex(), "clinicId", job.SourceClinicId.Hex(), "targetClinicId", job.TargetClinicId.Hex())
+	rollback := job.Status == JobStatusRollingBack
+	if !rollback {
+		if err := c.updateJob(ctx, planId, bson.M{"status": JobStatusRunning}); err != nil {
+			return err
+		}
+	}
+
+	clinicPlan, err := c.getClinicPlan(ctx, planId)
+	if err != nil {
+		return c.recordFailure(ctx, logger, *job, err)
+	}
+
+	nextBatch := c.executeNextBatch
+	if rollback {
+		nextBatch = c.revertNextBatch
+	}
+	for {
+		count, err := nextBatch(ctx, planId, clinicPlan)
+		if err != nil {
+			return c.recordFailure(ctx, logger, *job, err)
+		}
+		if count == 0 {
+			break
+		}
+		logger.Infow("processed clinic merge batch", "plans", count, "rollback", rollback)
+
+		// Extend the lease while the merge makes progress
+		if err := c.updateJob(ctx, planId, bson.M{"nextAttemptTime": time.Now().Add(c.Config.Lease)}); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	if rollback {
+		if err := c.updateJob(ctx, planId, bson.M{"status": JobStatusRolledBack, "rolledBackTime": now}); err != nil {
+			return err
+		}
+		// Rollbacks are locked by the job, even if the merge was part of a consolidation
+		if err := c.releaseLocks(ctx, planId); err != nil {
+			return err
+		}
+
+		// Ignore any error, already logged
+		_ = c.ClinicsService.RefreshPatientCount(ctx, clinicPlan.Source.Id.Hex())
+		_ = c.ClinicsService.RefreshPatientCount(ctx, clinicPlan.Target.Id.Hex())
+
+		logger.Info("clinic merge rolled back")
+		return nil
+	}
+
+	if err := c.updateJob(ctx, planId, bson.M{"status": JobStatusCompleted, "completedTime": now}); err != nil {
+		return err
+	}
+
+	logger.Info("clinic merge completed")
+	if job.ConsolidationId != nil {
+		started, err := c.startNextConsolidationJob(ctx, *job)
+		if err != nil || started {
+			return err
+		}
+	}
+	return c.releaseLocks(ctx, lockOwnerId(*job))
+}
+
+// startNextConsolidationJob starts the job of the next merge of the consolidation after the job is completed and
+// returns false if the job was the last merge of the consolidation
+func (c *ClinicPlanExecutor) startNextConsolidationJob(ctx context.Context, job Job) (bool, error) {
+	now := time.Now()
+	res, err := c.jobs().UpdateOne(ctx, bson.M{
+		"consolidationId":       job.ConsolidationId,
+		"consolidationSequence": job.ConsolidationSequence + 1,
+		"status":                JobStatusWaiting,
+	}, bson.M{
+		"$set": bson.M{
+			"status":          JobStatusPending,
+			"nextAttemptTime": now,
+			"modifiedTime":    now,
+		},
+	})
+	if err != nil {
+		return false, fmt.Errorf("unable to start next consolidation merge job: %w", err)
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+// Execute schedules the merge and executes it synchronously. The job is claimed like it would be by the worker, so
+// the attempt is counted and a failed merge is retried by the worker.
+func (c *ClinicPlanExecutor) Execute(ctx context.Context, plan ClinicMergePlan) (primitive.ObjectID, error) {
+	planId, err := c.Schedule(ctx, plan)
+	if err != nil {
+		return planId, err
+	}
+
+	if _, err := c.claimJob(ctx, bson.M{"_id": planId}); errors.Is(err, errs.NotFound) {
+		return planId, fmt.Errorf("%w: the merge is already executed by the worker", errs.Conflict)
+	} else if err != nil {
+		return planId, err
+	}
+
+	return planId, c.Resume(ctx, planId)
+}
+
+// GetJob returns the merge job with the progress of each phase
+func (c *ClinicPlanExecutor) GetJob(ctx context.Context, planId primitive.ObjectID) (*Job, error) {
+	job, err := c.getJob(ctx, planId)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := c.plans().Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"planId": planId}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   "$type",
+			"total": bson.M{"$sum": 1},
+			"completed": bson.M{"$sum": bson.M{
+				"$cond": bson.A{bson.M{"$ifNull": bson.A{"$executedTime", false}}, 1, 0},
+			}},
+			"reverted": bson.M{"$sum": bson.M{
+				"$cond": bson.A{bson.M{"$ifNull": bson.A{"$revertedTime", false}}, 1, 0},
+			}},
+		}}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get merge progress: %w", err)
+	}
+
+	progress := make([]JobPhase, 0, len(phases))
+	if err := cursor.All(ctx, &progress); err != nil {
+		return nil, fmt.Errorf("unable to decode merge progress: %w", err)
+	}
+
+	job.Phases = make([]JobPhase, 0, len(phases))
+	for _, phase := range phases {
+		p := JobPhase{Type: phase}
+		for _, pr := range progress {
+			if pr.Type == phase {
+				p = pr
+			}
+		}
+		job.Phases = append(job.Phases, p)
+	}
+
+	return job, nil
+}
+
+// Consolidation is the execution state of the merges of multiple source clinics into a single target clinic
+type Consolidation struct {
+	Id             primitive.ObjectID
+	TargetClinicId primitive.ObjectID
+	// Jobs are the jobs of the merges of the consolidation in the order in which they are executed
+	Jobs []Job
+}
+
+// Status returns the status of the consolidation derived from the statuses of its jobs
+func (c Consolidation) Status() string {
+	counts := map[string]int{}
+	for _, job := range c.Jobs {
+		counts[job.Status]++
+	}
+
+	switch {
+	case counts[JobStatusFailed] > 0:
+		return JobStatusFailed
+	case counts[JobStatusCompleted] == len(c.Jobs):
+		return JobStatusCompleted
+	case counts[JobStatusRolledBack] == len(c.Jobs):
+		return JobStatusRolledBack
+	case counts[JobStatusRollingBack] > 0:
+		return JobStatusRollingBack
+	case counts[JobStatusPending]+counts[JobStatusWaiting] == len(c.Jobs) && counts[JobStatusPending] <= 1:
+		return JobStatusPending
+	default:
+		return JobStatusRunning
+	}
+}
+
+// GetConsolidation returns the consolidation with the progress of the job of each merge
+func (c *ClinicPlanExecutor) GetConsolidation(ctx context.Context, consolidationId primitive.ObjectID) (*Consolidation, error) {
+	opts := options.Find().SetSort(bson.M{"consolidationSequence": 1})
+	cursor, err := c.jobs().Find(ctx, bson.M{"consolidationId": consolidationId}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get consolidation merge jobs: %w", err)
+	}
+	var jobs []Job
+	if err := cursor.All(ctx, &jobs); err != nil {
+		return nil, fmt.Errorf("unable to decode consolidation merge jobs: %w", err)
+	}
+	if len(jobs) == 0 {
+		return nil, errs.NotFound
+	}
+
+	consolidation := &Consolidation{
+		Id:             consolidationId,
+		TargetClinicId: jobs[0].TargetClinicId,
+		Jobs:           make([]Job, 0, len(jobs)),
+	}
+	for _, j := range jobs {
+		job, err := c.GetJob(ctx, j.Id)
+		if err != nil {
+			return nil, err
+		}
+		consolidation.Jobs = append(consolidation.Jobs, *job)
+	}
+
+	return consolidation, nil
+}
+
+// cancelConsolidationJobs cancels the jobs of the subsequent merges of the consolidation after the job failed, so they
+// don't prevent other merges of the clinics
+func (c *ClinicPlanExecutor) cancelConsolidationJobs(ctx context.Context, job Job) error {
+	_, err := c.jobs().UpdateMany(ctx, bson.M{
+		"consolidationId":       job.ConsolidationId,
+		"consolidationSequence": bson.M{"$gt": job.ConsolidationSequence},
+		"status":                JobStatusWaiting,
+	}, bson.M{
+		"$set": bson.M{
+			"status":       JobStatusCanceled,
+			"modifiedTime": time.Now(),
+		},
+	})
+	return err
+}
+
+// ClaimJob claims the next job which is due for execution. Running jobs with an expired lease were interrupted and
+// are claimed again.
+func (c *ClinicPlanExecutor) ClaimJob(ctx context.Context) (*Job, error) {
+	return c.claimJob(ctx, bson.M{})
+}
+
+// claimJob claims the next job matching the selector which is due for execution and counts the attempt
+func (c *ClinicPlanExecutor) claimJob(ctx context.Context, selector bson.M) (*Job, error) {
+	now := time.Now()
+	selector["status"] = bson.M{"$in": activeJobStatuses}
+	selector["nextAttemptTime"] = bson.M{"$lte": now}
+	update := bson.M{
+		"$set": bson.M{
+			"nextAttemptTime": now.Add(c.Config.Lease),
+			"modifiedTime":    now,
+		},
+		"$inc": bson.M{
+			"attempts": 1,
+		},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.M{"nextAttemptTime": 1}).
+		SetReturnDocument(options.After)
+
+	job := &Job{}
+	err := c.jobs().FindOneAndUpdate(ctx, selector, update, opts).Decode(job)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, errs.NotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to claim merge job: %w", err)
+	}
+
+	return job, nil
+}
+
+// InitializeJobs creates the indexes used to execute merge jobs
+func (c *ClinicPlanExecutor) InitializeJobs(ctx context.Context) error {
+	_, err := c.plans().Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "planId", Value: 1},
+			{Key: "sequence", Value: 1},
+		},
+		Options: options.Index().
+			SetBackground(true).
+			SetName("MergePlansByPlanIdAndSequence"),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.jobs().Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "nextAttemptTime", Value: 1},
+			},
+			Options: options.Index().
+				SetBackground(true).
+				SetName("MergeJobsByStatusAndNextAttemptTime"),
+		},
+		{
+			Keys: bson.D{
+				{Key: "consolidationId", Value: 1},
+				{Key: "consolidationSequence", Value: 1},
+			},
+			Options: options.Index().
+				SetBackground(true).
+				SetSparse(true).
+				SetName("MergeJobsByConsolidationIdAndSequence"),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.locks().Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "ownerId", Value: 1},
+		},
+		Options: options.Index().
+			SetBackground(true).
+			SetName("MergeLocksByOwnerId"),
+	})
+	return err
+}
+
+func (c *ClinicPlanExecutor) executeNextBatch(ctx context.Context, planId primitive.ObjectID, clinicPlan ClinicMergePlan) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, store.ContextTimeout)
+	defer cancel()
+
+	selector := bson.M{
+		"planId":       planId,
+		"executedTime": bson.M{"$exists": false},
+	}
+	opts := options.Find().
+		SetSort(bson.M{"sequence": 1}).
+		SetLimit(int64(c.Config.BatchSize))
+
+	cursor, err := c.plans().Find(ctx, selector, opts)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get pending merge plans: %w", err)
+	}
+	var batch []persistedPlan
+	if err := cursor.All(ctx, &batch); err != nil {
+		return 0, fmt.Errorf("unable to decode pending merge plans: %w", err)
+	}
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	_, err = store.WithTransaction(ctx, c.DBClient, func(sessionContext mongo.SessionContext) (any, error) {
+		for _, p := range batch {
+			if err := c.executePlan(sessionContext, p, clinicPlan); err != nil {
+				return nil, fmt.Errorf("unable to execute %s plan %s: %w", p.Type, p.Id.Hex(), err)
+			}
+
+			res, err := c.plans().UpdateOne(sessionContext,
+				bson.M{"_id": p.Id, "executedTime": bson.M{"$exists": false}},
+				bson.M{"$set": bson.M{"executedTime": time.Now()}},
+			)
+			if err != nil {
+				return nil, err
+			}
+			// The plan was executed concurrently by another worker
+			if res.ModifiedCount != 1 {
+				return nil, fmt.Errorf("%w: %s plan %s was already executed", errs.Conflict, p.Type, p.Id.Hex())
+			}
+		}
+		return nil, nil
+	})
+
+	return len(batch), err
+}
+
+func (c *ClinicPlanExecutor) executePlan(ctx context.Context, p persistedPlan, clinicPlan ClinicMergePlan) error {
+	logger := c.Logger.With("clinicId", clinicPlan.Source.Id.Hex(), "targetClinicId", clinicPlan.Target.Id.Hex())
+
+	switch p.Type {
+	case planTypeTag:
+		plan := TagPlan{}
+		if err := bson.Unmarshal(p.Plan, &plan); err != nil {
+			return err
+		}
+		return NewTagPlanExecutor(logger, c.ClinicsService).Execute(ctx, plan)
+	case planTypePatient:
+		plan := PatientPlan{}
+		if err := bson.Unmarshal(p.Plan, &plan); err != nil {
+			return err
+		}
+		return NewPatientPlanExecutor(logger, c.ClinicsService, c.PatientsService, c.DB).Execute(ctx, plan, clinicPlan.Source, clinicPlan.Target)
+	case planTypeSite:
+		plan := SitePlan{}
+		if err := bson.Unmarshal(p.Plan, &plan); err != nil {
+			return err
+		}
+		return NewSitePlanExecutor(logger, c.ClinicsService, c.PatientsService).Execute(ctx, plan)
+	case planTypeClinician:
+		plan := ClinicianPlan{}
+		if err := bson.Unmarshal(p.Plan, &plan); err != nil {
+			return err
+		}
+		return NewClinicianPlanExecutor(logger, c.DB).Execute(ctx, plan, clinicPlan.Target)
+	case planTypeClinic:
+		if err := c.checkSourceMerged(ctx, clinicPlan); err != nil {
+			return err
+		}
+		logger.Info("finalizing clinic merge")
+		return c.ClinicManager.FinalizeMerge(ctx, clinicPlan.Source.Id.Hex(), clinicPlan.Target.Id.Hex())
+	default:
+		return fmt.Errorf("unexpected plan type %s", p.Type)
+	}
+}
+
+// checkSourceMerged returns a conflict if clinicians were added to the source clinic after the merge was planned.
+// They are not part of the plan and would be deleted with the source clinic. Patients added after the merge was
+// planned prevent the deletion of the source clinic.
+func (c *ClinicPlanExecutor) checkSourceMerged(ctx context.Context, clinicPlan ClinicMergePlan) error {
+	count, err := c.DB.Collection(clinicians.CollectionName).CountDocuments(ctx, bson.M{"clinicId": clinicPlan.Source.Id})
+	if err != nil {
+		return fmt.Errorf("unable to count the clinicians of the source clinic: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %d clinicians were added to the source clinic after the merge was planned", errs.Conflict, count)
+	}
+	return nil
+}
+
+// recordFailure records the error of the job. The job is failed if it was attempted too many times, otherwise it's
+// retried by the worker after a delay.
+func (c *ClinicPlanExecutor) recordFailure(ctx context.Context, logger *zap.SugaredLogger, job Job, err error) error {
+	logger.Errorw("unable to execute clinic merge", "attempt", job.Attempts, "error", err)
+
+	message := err.Error()
+	update := bson.M{
+		"lastError":       message,
+		"nextAttemptTime": time.Now().Add(c.Config.RetryDelay),
+	}
+	failed := job.Attempts >= c.Config.MaxAttempts
+	if failed {
+		update["status"] = JobStatusFailed
+	}
+	if updateErr := c.updateJob(ctx, job.Id, update); updateErr != nil {
+		logger.Errorw("unable to record clinic merge failure", "error", updateErr)
+	}
+	if failed && job.ConsolidationId != nil {
+		if cancelErr := c.cancelConsolidationJobs(ctx, job); cancelErr != nil {
+			logger.Errorw("unable to cancel consolidation merge jobs", "error", cancelErr)
+		}
+	}
+	if failed {
+		// The locks of a failed rollback are owned by the job
+		ownerId := lockOwnerId(job)
+		if job.Status == JobStatusRollingBack {
+			ownerId = job.Id
+		}
+		if releaseErr := c.releaseLocks(ctx, ownerId); releaseErr != nil {
+			logger.Errorw("unable to release clinic locks", "error", releaseErr)
+		}
+	}
+
+	return err
+}
+
+func (c *ClinicPlanExecutor) getJob(ctx context.Context, planId primitive.ObjectID) (*Job, error) {
+	job := &Job{}
+	err := c.jobs().FindOne(ctx, bson.M{"_id": planId}).Decode(job)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, errs.NotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to get merge job: %w", err)
+	}
+	return job, nil
+}
+
+func (c *ClinicPlanExecutor) getClinicPlan(ctx context.Context, planId primitive.ObjectID) (ClinicMergePlan, error) {
+	plan := PersistentPlan[ClinicMergePlan]{}
+	err := c.plans().FindOne(ctx, bson.M{"planId": planId, "type": planTypeClinic}).Decode(&plan)
+	if err != nil {
+		return ClinicMergePlan{}, fmt.Errorf("unable to get clinic merge plan: %w", err)
+	}
+	return plan.Plan, nil
+}
+
+func (c *ClinicPlanExecutor) updateJob(ctx context.Context, planId primitive.ObjectID, set bson.M) error {
+	set["modifiedTime"] = time.Now()
+	if _, err := c.jobs().UpdateOne(ctx, bson.M{"_id": planId}, bson.M{"$set": set}); err != nil {
+		return fmt.Errorf("unable to update merge job: %w", err)
+	}
+	return nil
+}
+
+func (c *ClinicPlanExecutor) jobs() *mongo.Collection {
+	return c.DB.Collection(jobsCollectionName)
+}
+
+func (c *ClinicPlanExecutor) locks() *mongo.Collection {
+	return c.DB.Collection(locksCollectionName)
+}
+
+func (c *ClinicPlanExecutor) plans() *mongo.Collection {
+	return c.DB.Collection(plansCollectionName)
+}
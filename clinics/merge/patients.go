@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/deletions"
 	"github.com/tidepool-org/clinic/patients"
 	"github.com/tidepool-org/clinic/sites"
 )
@@ -31,6 +32,11 @@ const (
 	PatientActionMergeInto = "MERGE_INTO"
 	// PatientActionMove is used when the source patient will be moved to the target clinic
 	PatientActionMove = "MOVE"
+	// PatientActionDiscard is used when the source patient is a custodial duplicate of a target patient and will be removed
+	PatientActionDiscard = "DISCARD"
+	// PatientActionReplace is used when the source patient will be moved to the target clinic and the custodial duplicate
+	// of the patient in the target clinic will be removed
+	PatientActionReplace = "REPLACE"
 )
 
 type PatientPlans []PatientPlan
@@ -118,8 +124,14 @@ type PatientPlan struct {
 	PostMigrationSiteNames     []string `bson:"postMigrationSiteNames"`
 	PostMigrationMRNUniqueness bool     `bson:"postMigrationMRNUniqueness"`
 
+	// SourceSite is the site of the target clinic which is assigned to the source patient by a consolidation
+	SourceSite *sites.Site `bson:"sourceSite,omitempty"`
+
 	CanExecuteAction bool         `bson:"canExecuteAction"`
 	Error            *ReportError `bson:"error"`
+
+	// Resolution is the reviewed resolution of the duplicates of the source patient
+	Resolution *DuplicateResolution `bson:"resolution,omitempty"`
 }
 
 func (p PatientPlan) HasConflicts() bool {
@@ -371,14 +383,16 @@ func sanitizePatient(patient *patients.Patient) {
 
 type PatientPlanExecutor struct {
 	clinicsService     clinics.Service
+	patientsService    patients.Service
 	patientsCollection *mongo.Collection
 
 	logger *zap.SugaredLogger
 }
 
-func NewPatientPlanExecutor(logger *zap.SugaredLogger, clinicsService clinics.Service, db *mongo.Database) *PatientPlanExecutor {
+func NewPatientPlanExecutor(logger *zap.SugaredLogger, clinicsService clinics.Service, patientsService patients.Service, db *mongo.Database) *PatientPlanExecutor {
 	return &PatientPlanExecutor{
 		clinicsService:     clinicsService,
+		patientsService:    patientsService,
 		patientsCollection: db.Collection(patients.CollectionName),
 
 		logger: logger,
@@ -386,6 +400,11 @@ func NewPatientPlanExecutor(logger *zap.SugaredLogger, clinicsService clinics.Se
 }
 
 func (p *PatientPlanExecutor) Execute(ctx context.Context, plan PatientPlan, source, target clinics.Clinic) error {
+	plan, err := plan.Resolved(target)
+	if err != nil {
+		return err
+	}
+
 	// Fetch the updated clinic object to make sure we are capturing
 	// the tags that were migrated from the source clinic
 	updated, err := p.clinicsService.Get(ctx, target.Id.Hex())
@@ -411,6 +430,31 @@ func (p *PatientPlanExecutor) Execute(ctx context.Context, plan PatientPlan, sou
 			"targetUserId", *plan.TargetPatient.UserId,
 		)
 		return p.mergePatient(ctx, plan, *updated)
+	case PatientActionDiscard:
+		p.logger.Infow(
+			"discarding custodial patient",
+			"clinicId", source.Id.Hex(),
+			"userId", plan.SourcePatient.UserId,
+			"targetClinicId", target.Id.Hex(),
+			"targetUserId", *plan.TargetPatient.UserId,
+		)
+		if err := p.removePatient(ctx, *plan.SourcePatient); err != nil {
+			return fmt.Errorf("error deleting patient %s: %w", *plan.SourcePatient.UserId, err)
+		}
+		return nil
+	case PatientActionReplace:
+		p.logger.Infow(
+			"replacing custodial patient",
+			"clinicId", source.Id.Hex(),
+			"userId", plan.SourcePatient.UserId,
+			"targetClinicId", target.Id.Hex(),
+			"targetUserId", *plan.TargetPatient.UserId,
+		)
+		// The custodial patient is deleted first, because it may have the same MRN
+		if err := p.removePatient(ctx, *plan.TargetPatient); err != nil {
+			return fmt.Errorf("error deleting patient %s: %w", *plan.TargetPatient.UserId, err)
+		}
+		return p.movePatient(ctx, plan, *updated)
 	case PatientActionRetain:
 		p.logger.Infow(
 			"retaining patient",
@@ -456,6 +500,9 @@ func (p *PatientPlanExecutor) movePatient(ctx context.Context, plan PatientPlan,
 			"updatedTime":      time.Now(),
 		},
 	}
+	if plan.SourceSite != nil {
+		update["$addToSet"] = bson.M{"sites": plan.SourceSite}
+	}
 
 	res, err := p.patientsCollection.UpdateOne(ctx, selector, update)
 	if err != nil {
@@ -490,8 +537,14 @@ func (p *PatientPlanExecutor) mergeSites(ctx context.Context, plan PatientPlan,
 		"clinicId": plan.TargetPatient.ClinicId,
 		"userId":   plan.TargetPatient.UserId,
 	}
-	srcSites := plan.SourcePatient.Sites
-	if srcSites == nil || len(*srcSites) == 0 {
+	var srcSites []sites.Site
+	if plan.SourcePatient.Sites != nil {
+		srcSites = *plan.SourcePatient.Sites
+	}
+	if plan.SourceSite != nil {
+		srcSites = append(slices.Clone(srcSites), *plan.SourceSite)
+	}
+	if len(srcSites) == 0 {
 		return nil
 	}
 	update := bson.M{
@@ -564,3 +617,8 @@ func (p *PatientPlanExecutor) deleteSourcePatient(ctx context.Context, plan Pati
 	}
 	return nil
 }
+
+// removePatient removes a discarded custodial patient, so the record is kept in the deletions archive
+func (p *PatientPlanExecutor) removePatient(ctx context.Context, patient patients.Patient) error {
+	return p.patientsService.Remove(ctx, patient.ClinicId.Hex(), *patient.UserId, deletions.Metadata{})
+}
@@ -20,6 +20,7 @@ import (
 	mergeTest "github.com/tidepool-org/clinic/clinics/merge/test"
 	clinicsTest "github.com/tidepool-org/clinic/clinics/test"
 	"github.com/tidepool-org/clinic/patients"
+	patientsTest "github.com/tidepool-org/clinic/patients/test"
 	"github.com/tidepool-org/clinic/sites"
 	"github.com/tidepool-org/clinic/store/test"
 )
@@ -180,8 +181,9 @@ var _ = Describe("New Merge Planner", func() {
 
 			clinicsCtrl := gomock.NewController(GinkgoT())
 			clinicsService := clinicsTest.NewMockService(clinicsCtrl)
+			patientsService := patientsTest.NewMockService(gomock.NewController(GinkgoT()))
 
-			executor = merge.NewPatientPlanExecutor(zap.NewNop().Sugar(), clinicsService, db)
+			executor = merge.NewPatientPlanExecutor(zap.NewNop().Sugar(), clinicsService, patientsService, db)
 			collection = db.Collection("patients")
 			ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
 			defer cancel()
@@ -2,6 +2,7 @@ package merge
 
 import (
 	"context"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -20,6 +21,12 @@ type PersistentPlan[T Plan] struct {
 	Plan   T                   `bson:"plan"`
 	PlanId primitive.ObjectID  `bson:"planId"`
 	Type   string              `bson:"type"`
+	// Sequence is the order in which the plans of a merge are executed
+	Sequence int `bson:"sequence"`
+	// ExecutedTime is set in the same transaction in which the plan is executed
+	ExecutedTime *time.Time `bson:"executedTime,omitempty"`
+	// RevertedTime is set in the same transaction in which the plan is reverted by a rollback
+	RevertedTime *time.Time `bson:"revertedTime,omitempty"`
 }
 
 func NewPersistentPlan[T Plan](planId primitive.ObjectID, typ string, p T) PersistentPlan[T] {
@@ -30,6 +37,11 @@ func NewPersistentPlan[T Plan](planId primitive.ObjectID, typ string, p T) Persi
 	}
 }
 
+func (p PersistentPlan[T]) WithSequence(sequence int) PersistentPlan[T] {
+	p.Sequence = sequence
+	return p
+}
+
 func RunPlanners[T Plan](ctx context.Context, planners []Planner[T]) ([]T, error) {
 	result := make([]T, 0, len(planners))
 	for _, planner := range planners {
@@ -0,0 +1,326 @@
+package merge
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tidepool-org/clinic/clinicians"
+	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/pointer"
+	"github.com/tidepool-org/clinic/sites"
+)
+
+// ReportData is the content of the merge report independent of the format it's rendered in
+type ReportData struct {
+	CreatedTime time.Time
+	Source      ReportClinic
+	Target      ReportClinic
+
+	CanMerge bool
+	Errors   []string
+
+	Measures       ReportMeasures
+	Settings       []ReportSetting
+	Clinicians     []ReportClinician
+	PendingInvites []ReportPendingInvites
+	Tags           []ReportTag
+	Sites          []ReportSite
+	Patients       []ReportPatientPlan
+
+	SourceClusters []ReportCluster
+	TargetClusters []ReportCluster
+}
+
+type ReportClinic struct {
+	Id   string
+	Name string
+}
+
+type ReportMeasures struct {
+	Clinicians           int
+	DowngradedClinicians int
+	Tags                 int
+	DuplicateTags        int
+	Sites                int
+	RenamedSites         int
+	Patients             int
+	DuplicateAccounts    int
+	LikelyDuplicates     int
+	MRNOnlyMatches       int
+	NameOnlyMatches      int
+}
+
+type ReportSetting struct {
+	Name        string
+	SourceValue string
+	TargetValue string
+	Match       bool
+}
+
+type ReportClinician struct {
+	Name       string
+	Email      string
+	Workspaces []string
+	Admin      bool
+	Downgraded bool
+}
+
+type ReportPendingInvites struct {
+	Workspace string
+	Count     int
+}
+
+type ReportTag struct {
+	Name       string
+	Workspaces []string
+	Merge      bool
+}
+
+type ReportSite struct {
+	Name      string
+	Workspace string
+	Action    SiteAction
+}
+
+// ReportPatientPlan describes what happens with a patient of the source or the target clinic
+type ReportPatientPlan struct {
+	Action    string
+	Workspace string
+	Patient   ReportPatient
+	Errors    []string
+
+	// Result is the resulting patient when the source patient is merged into a patient of the target clinic
+	Result *ReportPatient
+
+	// Conflicts are the patients of the target clinic which are possible duplicates of the source patient
+	Conflicts []ReportConflict
+}
+
+type ReportConflict struct {
+	Category string
+	Patient  ReportPatient
+}
+
+type ReportPatient struct {
+	UserId         string
+	FullName       string
+	BirthDate      string
+	Mrn            string
+	Claimed        bool
+	Tags           []string
+	Sites          []string
+	LastUploadTime *time.Time
+}
+
+type ReportCluster struct {
+	Patients []ReportClusterPatient
+}
+
+type ReportClusterPatient struct {
+	ReportPatient
+
+	LikelyDuplicates []string
+	NameOnlyMatches  []string
+	MRNOnlyMatches   []string
+}
+
+// Data returns the content of the report
+func (r Report) Data() ReportData {
+	conflictCounts := r.plan.PatientPlans.GetConflictCounts()
+	data := ReportData{
+		CreatedTime: r.plan.CreatedTime,
+		Source:      newReportClinic(r.plan.Source),
+		Target:      newReportClinic(r.plan.Target),
+		CanMerge:    !r.plan.PreventsMerge(),
+		Errors:      GetUniqueErrorMessages(r.plan.Errors()),
+		Measures: ReportMeasures{
+			DowngradedClinicians: r.plan.ClinicianPlans.GetDowngradedMembersCount(),
+			Tags:                 r.plan.TagsPlans.GetResultingTagsCount(),
+			DuplicateTags:        r.plan.TagsPlans.GetDuplicateTagsCount(),
+			Sites:                r.plan.SitesPlans.GetResultingSitesCount(),
+			RenamedSites:         r.plan.SitesPlans.GetRenamedSitesCount(),
+			Patients:             r.plan.PatientPlans.GetResultingPatientsCount(),
+			DuplicateAccounts:    conflictCounts[PatientConflictCategoryDuplicateAccounts],
+			LikelyDuplicates:     conflictCounts[PatientConflictCategoryLikelyDuplicateAccounts],
+			MRNOnlyMatches:       conflictCounts[PatientConflictCategoryMRNOnlyMatch],
+			NameOnlyMatches:      conflictCounts[PatientConflictCategoryNameOnlyMatch],
+		},
+		Settings:       r.settingsData(),
+		Clinicians:     r.cliniciansData(),
+		PendingInvites: r.pendingInvitesData(),
+		Tags:           r.tagsData(),
+		Sites:          r.sitesData(),
+		Patients:       r.patientsData(),
+		SourceClusters: clustersData(r.plan.SourcePatientClusters, r.plan.Source),
+		TargetClusters: clustersData(r.plan.TargetPatientClusters, r.plan.Target),
+	}
+	data.Measures.Clinicians = len(data.Clinicians)
+
+	return data
+}
+
+func (r Report) settingsData() []ReportSetting {
+	result := []ReportSetting{{
+		Name:        TaskTypeClinicSettingsHasPartialSSO,
+		SourceValue: r.plan.MembershipRestrictionsMergePlan.GetSourceValue(),
+		TargetValue: r.plan.MembershipRestrictionsMergePlan.GetTargetValue(),
+		Match:       r.plan.MembershipRestrictionsMergePlan.ValuesMatch(),
+	}}
+	for _, s := range r.plan.SettingsPlans {
+		result = append(result, ReportSetting{
+			Name:        s.Name,
+			SourceValue: s.SourceValue,
+			TargetValue: s.TargetValue,
+			Match:       s.ValuesMatch(),
+		})
+	}
+	return result
+}
+
+func (r Report) cliniciansData() []ReportClinician {
+	result := make([]ReportClinician, 0, len(r.plan.ClinicianPlans))
+	for _, plan := range r.plan.ClinicianPlans {
+		if plan.ClinicianAction == ClinicianActionMergeInto {
+			// Results will be reported by the corresponding source merge task
+			continue
+		}
+		result = append(result, ReportClinician{
+			Name:       plan.GetClinicianName(),
+			Email:      plan.GetClinicianEmail(),
+			Workspaces: plan.Workspaces,
+			Admin:      slices.Contains(plan.ResultingRoles, clinicians.RoleClinicAdmin),
+			Downgraded: plan.Downgraded,
+		})
+	}
+	return result
+}
+
+func (r Report) pendingInvitesData() []ReportPendingInvites {
+	result := make([]ReportPendingInvites, 0)
+	for workspace, count := range r.plan.ClinicianPlans.PendingInvitesByWorkspace() {
+		result = append(result, ReportPendingInvites{Workspace: workspace, Count: count})
+	}
+	slices.SortFunc(result, func(a, b ReportPendingInvites) int {
+		return cmp.Compare(a.Workspace, b.Workspace)
+	})
+	return result
+}
+
+func (r Report) tagsData() []ReportTag {
+	result := make([]ReportTag, 0, len(r.plan.TagsPlans))
+	for _, plan := range r.plan.TagsPlans {
+		if plan.TagAction == TagActionSkip {
+			continue
+		}
+		result = append(result, ReportTag{
+			Name:       plan.Name,
+			Workspaces: plan.Workspaces,
+			Merge:      plan.Merge,
+		})
+	}
+	return result
+}
+
+func (r Report) sitesData() []ReportSite {
+	result := make([]ReportSite, 0, len(r.plan.SitesPlans))
+	for _, plan := range r.plan.SitesPlans {
+		result = append(result, ReportSite{
+			Name:      plan.Name(),
+			Workspace: plan.SourceWorkspace,
+			Action:    plan.Action,
+		})
+	}
+	slices.SortFunc(result, func(a, b ReportSite) int {
+		return cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+	return result
+}
+
+func (r Report) patientsData() []ReportPatientPlan {
+	targetTags := buildTagsMap(r.plan.Target.PatientTags)
+
+	result := make([]ReportPatientPlan, 0, len(r.plan.PatientPlans))
+	for _, plan := range r.plan.PatientPlans.GetSourcePatientPlans() {
+		patientPlan := ReportPatientPlan{
+			Action:    plan.PatientAction,
+			Workspace: plan.SourceClinicName,
+			Patient:   newReportPatient(*plan.SourcePatient, plan.SourceTagNames, plan.SourceSiteNames),
+			Errors:    GetUniqueErrorMessages(plan.Errors()),
+			Conflicts: make([]ReportConflict, 0),
+		}
+		if plan.PatientAction == PatientActionMerge && plan.TargetPatient != nil {
+			resultingPatient := newReportPatient(*plan.TargetPatient, plan.PostMigrationTagNames, plan.PostMigrationSiteNames)
+			patientPlan.Result = &resultingPatient
+		}
+
+		for _, category := range slices.Sorted(maps.Keys(plan.Conflicts)) {
+			for _, conflict := range plan.Conflicts[category] {
+				patientPlan.Conflicts = append(patientPlan.Conflicts, ReportConflict{
+					Category: category,
+					Patient:  newReportPatient(conflict.Patient, getUniquePatientTagNames(conflict.Patient, targetTags), siteNames(conflict.Patient.Sites, r.plan.Target.Sites)),
+				})
+			}
+		}
+
+		result = append(result, patientPlan)
+	}
+	for _, plan := range r.plan.PatientPlans.GetTargetPatientPlans() {
+		result = append(result, ReportPatientPlan{
+			Action:    plan.PatientAction,
+			Workspace: plan.TargetClinicName,
+			Patient:   newReportPatient(*plan.TargetPatient, plan.TargetTagNames, plan.TargetSiteNames),
+			Errors:    GetUniqueErrorMessages(plan.Errors()),
+			Conflicts: make([]ReportConflict, 0),
+		})
+	}
+
+	return result
+}
+
+func clustersData(clusters PatientClusters, clinic clinics.Clinic) []ReportCluster {
+	tags := buildTagsMap(clinic.PatientTags)
+
+	result := make([]ReportCluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		c := ReportCluster{Patients: make([]ReportClusterPatient, 0, len(cluster.Patients))}
+		for _, p := range cluster.Patients {
+			c.Patients = append(c.Patients, ReportClusterPatient{
+				ReportPatient:    newReportPatient(p.Patient, getUniquePatientTagNames(p.Patient, tags), siteNames(p.Patient.Sites, []sites.Site{})),
+				LikelyDuplicates: p.Conflicts[PatientConflictCategoryLikelyDuplicateAccounts],
+				NameOnlyMatches:  p.Conflicts[PatientConflictCategoryNameOnlyMatch],
+				MRNOnlyMatches:   p.Conflicts[PatientConflictCategoryMRNOnlyMatch],
+			})
+		}
+		result = append(result, c)
+	}
+	return result
+}
+
+func newReportClinic(clinic clinics.Clinic) ReportClinic {
+	result := ReportClinic{Name: pointer.ToString(clinic.Name)}
+	if clinic.Id != nil {
+		result.Id = clinic.Id.Hex()
+	}
+	return result
+}
+
+func newReportPatient(patient patients.Patient, tags []string, sites []string) ReportPatient {
+	result := ReportPatient{
+		UserId:    pointer.ToString(patient.UserId),
+		FullName:  pointer.ToString(patient.FullName),
+		BirthDate: pointer.ToString(patient.BirthDate),
+		Mrn:       pointer.ToString(patient.Mrn),
+		Claimed:   !patient.IsCustodial(),
+		Tags:      tags,
+		Sites:     sites,
+	}
+	if patient.Summary != nil && !patient.Summary.GetLastUploadDate().IsZero() {
+		lastUploadTime := patient.Summary.GetLastUploadDate()
+		result.LastUploadTime = &lastUploadTime
+	}
+	return result
+}
@@ -0,0 +1,41 @@
+package merge
+
+import (
+	_ "embed"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+)
+
+//go:embed templates/report.html.tmpl
+var reportHTMLTemplate string
+
+var reportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"join": func(values []string) string {
+		return strings.Join(values, ", ")
+	},
+	"yesNo": func(value bool) string {
+		if value {
+			return "Yes"
+		}
+		return "No"
+	},
+	"inc": func(i int) int {
+		return i + 1
+	},
+	"reportTime": func(t time.Time) string {
+		return t.Format(ReportTimeFormat)
+	},
+	"lastUploadTime": func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format(LastUploadTimeFormat)
+	},
+}).Parse(reportHTMLTemplate))
+
+// GenerateHTML renders the report as a printable HTML page
+func (r Report) GenerateHTML(w io.Writer) error {
+	return reportTemplate.Execute(w, r.Data())
+}
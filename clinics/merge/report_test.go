@@ -1,12 +1,14 @@
 package merge_test
 
 import (
+	"bytes"
 	"os"
 	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"github.com/tealeg/xlsx/v3"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
@@ -58,6 +60,41 @@ var _ = Describe("merge reports", func() {
 			})
 		})
 	})
+
+	Context("the report data", func() {
+		It("includes the patients of both clinics", func() {
+			at := time.Now().Add(-time.Hour)
+			data := merge.NewReport(newTestPlan(at)).Data()
+			Expect(data.Patients).To(HaveLen(2))
+			Expect(data.Patients[0].Workspace).To(Equal("Test Source Clinic"))
+			Expect(data.Patients[0].Patient.Sites).To(ConsistOf("Some Source Site"))
+			Expect(data.Patients[0].Patient.LastUploadTime).To(PointTo(BeTemporally("==", at)))
+			Expect(data.Patients[1].Workspace).To(Equal("Test Target Clinic"))
+			Expect(data.Patients[1].Patient.Sites).To(ConsistOf("Some Target Site"))
+		})
+
+		It("includes the sites sorted by name", func() {
+			data := merge.NewReport(newTestPlan(time.Now())).Data()
+			Expect(data.Measures.Sites).To(Equal(4))
+			Expect(data.Sites).To(HaveLen(4))
+			Expect(data.Sites[0].Name).To(Equal("Chicago"))
+			Expect(data.Sites[1].Name).To(Equal("Chicago (2)"))
+			Expect(data.Sites[1].Action).To(Equal(merge.SiteActionRename))
+			Expect(data.Sites[2].Name).To(Equal("New York"))
+			Expect(data.Sites[2].Action).To(Equal(merge.SiteActionMove))
+		})
+	})
+
+	Context("the html report", func() {
+		It("includes the escaped patients' names and sites", func() {
+			buf := &bytes.Buffer{}
+			Expect(merge.NewReport(newTestPlan(time.Now())).GenerateHTML(buf)).To(Succeed())
+			Expect(buf.String()).To(ContainSubstring("John &#34;Source&#34; Doe"))
+			Expect(buf.String()).To(ContainSubstring("John &#34;Target&#34; Doe"))
+			Expect(buf.String()).To(ContainSubstring("Some Source Site"))
+			Expect(buf.String()).To(ContainSubstring("Chicago (2)"))
+		})
+	})
 })
 
 func xlsxHasLatestUpload(f *xlsx.File, date time.Time) bool {
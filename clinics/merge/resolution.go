@@ -0,0 +1,249 @@
+package merge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/tidepool-org/clinic/clinics"
+	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/sites"
+)
+
+const (
+	// DuplicateResolutionKeepBoth moves the source patient to the target clinic as planned
+	DuplicateResolutionKeepBoth = "keepBoth"
+	// DuplicateResolutionMerge merges the source patient into the record of the selected target patient
+	DuplicateResolutionMerge = "merge"
+	// DuplicateResolutionDiscardCustodial removes the custodial record of the source or the selected target patient
+	DuplicateResolutionDiscardCustodial = "discardCustodial"
+)
+
+// DuplicateResolution is the decision of a reviewer about a source patient which is a likely duplicate
+// of patients in the target clinic
+type DuplicateResolution struct {
+	Type string `bson:"type"`
+	// TargetUserId is the duplicate target patient, it's required when the patients are merged or one of them is discarded
+	TargetUserId *string   `bson:"targetUserId,omitempty"`
+	ResolvedTime time.Time `bson:"resolvedTime"`
+}
+
+// DuplicateCluster is a source patient and the patients of the target clinic it likely duplicates
+type DuplicateCluster struct {
+	// Id is the id of the persisted patient plan
+	Id   primitive.ObjectID
+	Plan PatientPlan
+}
+
+// IsReviewable returns true if the source patient has duplicates in the target clinic which are not merged automatically
+func (p PatientPlan) IsReviewable() bool {
+	return p.SourcePatient != nil && p.PatientAction == PatientActionMove && p.HasConflicts()
+}
+
+// Resolved returns the plan with the resolution of the duplicates applied
+func (p PatientPlan) Resolved(target clinics.Clinic) (PatientPlan, error) {
+	if p.Resolution == nil || p.Resolution.Type == DuplicateResolutionKeepBoth {
+		return p, nil
+	}
+	if !p.IsReviewable() {
+		return p, fmt.Errorf("%w: the patient doesn't have duplicates in the target clinic", errs.BadRequest)
+	}
+	if p.Resolution.TargetUserId == nil {
+		return p, fmt.Errorf("%w: the duplicate target patient is required", errs.BadRequest)
+	}
+
+	duplicate := p.getDuplicate(*p.Resolution.TargetUserId)
+	if duplicate == nil {
+		return p, fmt.Errorf("%w: patient %s is not a duplicate of the source patient", errs.BadRequest, *p.Resolution.TargetUserId)
+	}
+
+	resolved := p
+	resolved.TargetPatient = duplicate
+	resolved.TargetTagNames = getUniquePatientTagNames(*duplicate, buildTagsMap(target.PatientTags))
+	resolved.TargetSiteNames = siteNames(duplicate.Sites, target.Sites)
+
+	switch p.Resolution.Type {
+	case DuplicateResolutionMerge:
+		// The source record is deleted after the merge, which would remove the account of the source patient from the
+		// clinic if it's not custodial or the same account as the target patient
+		if !p.SourcePatient.IsCustodial() && (p.SourcePatient.UserId == nil || duplicate.UserId == nil || *p.SourcePatient.UserId != *duplicate.UserId) {
+			return p, fmt.Errorf("%w: only custodial patients can be merged into a patient with a different account", errs.BadRequest)
+		}
+		resolved.PatientAction = PatientActionMerge
+
+		uniqueTags := mapset.NewSet(resolved.SourceTagNames...)
+		uniqueTags.Append(resolved.TargetTagNames...)
+		resolved.PostMigrationTagNames = uniqueTags.ToSlice()
+		combinedSites := []sites.Site{}
+		if p.SourcePatient.Sites != nil {
+			combinedSites = slices.Concat(combinedSites, *p.SourcePatient.Sites)
+		}
+		if duplicate.Sites != nil {
+			combinedSites = slices.Concat(combinedSites, *duplicate.Sites)
+		}
+		resolved.PostMigrationSiteNames = siteNames(&combinedSites, target.Sites)
+	case DuplicateResolutionDiscardCustodial:
+		if p.SourcePatient.IsCustodial() {
+			resolved.PatientAction = PatientActionDiscard
+			resolved.PostMigrationTagNames = resolved.TargetTagNames
+			resolved.PostMigrationSiteNames = resolved.TargetSiteNames
+		} else if duplicate.IsCustodial() {
+			resolved.PatientAction = PatientActionReplace
+		} else {
+			return p, fmt.Errorf("%w: neither of the patients is custodial", errs.BadRequest)
+		}
+	default:
+		return p, fmt.Errorf("%w: unknown resolution %s", errs.BadRequest, p.Resolution.Type)
+	}
+
+	return resolved, nil
+}
+
+func (p PatientPlan) getDuplicate(userId string) *patients.Patient {
+	for _, conflicts := range p.Conflicts {
+		for _, conflict := range conflicts {
+			if conflict.Patient.UserId != nil && *conflict.Patient.UserId == userId {
+				duplicate := conflict.Patient
+				sanitizePatient(&duplicate)
+				return &duplicate
+			}
+		}
+	}
+	return nil
+}
+
+// ListDuplicates returns the source patients of the merge which have likely duplicates in the target clinic
+func (c *ClinicPlanExecutor) ListDuplicates(ctx context.Context, planId primitive.ObjectID) ([]DuplicateCluster, error) {
+	selector := bson.M{
+		"planId":             planId,
+		"type":               planTypePatient,
+		"plan.patientAction": PatientActionMove,
+		"plan.conflicts":     bson.M{"$ne": bson.M{}},
+	}
+	opts := options.Find().SetSort(bson.M{"sequence": 1})
+
+	cursor, err := c.plans().Find(ctx, selector, opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list merge duplicates: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	clusters := make([]DuplicateCluster, 0)
+	for cursor.Next(ctx) {
+		plan := PersistentPlan[PatientPlan]{}
+		if err := cursor.Decode(&plan); err != nil {
+			return nil, fmt.Errorf("unable to decode merge duplicates: %w", err)
+		}
+		if plan.Plan.IsReviewable() {
+			clusters = append(clusters, DuplicateCluster{Id: *plan.Id, Plan: plan.Plan})
+		}
+	}
+
+	return clusters, cursor.Err()
+}
+
+// ResolveDuplicate persists the resolution of a duplicate cluster. Resolutions can only be changed while the merge is
+// awaiting review. The resolution is applied when the patient plan is executed.
+func (c *ClinicPlanExecutor) ResolveDuplicate(ctx context.Context, planId, clusterId primitive.ObjectID, resolution DuplicateResolution) (*DuplicateCluster, error) {
+	job, err := c.getJob(ctx, planId)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != JobStatusAwaitingReview {
+		return nil, fmt.Errorf("%w: the merge is not awaiting review", errs.Conflict)
+	}
+
+	plan := PersistentPlan[PatientPlan]{}
+	err = c.plans().FindOne(ctx, bson.M{"_id": clusterId, "planId": planId, "type": planTypePatient}).Decode(&plan)
+	if errors.Is(err, mongo.ErrNoDocuments) || (err == nil && !plan.Plan.IsReviewable()) {
+		return nil, errs.NotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to get merge duplicate: %w", err)
+	}
+
+	clinicPlan, err := c.getClinicPlan(ctx, planId)
+	if err != nil {
+		return nil, err
+	}
+
+	resolution.ResolvedTime = time.Now()
+	if resolution.Type == DuplicateResolutionKeepBoth {
+		resolution.TargetUserId = nil
+	}
+	plan.Plan.Resolution = &resolution
+	if _, err := plan.Plan.Resolved(clinicPlan.Target); err != nil {
+		return nil, err
+	}
+	if resolution.TargetUserId != nil {
+		if err := c.checkDuplicateTarget(ctx, planId, clusterId, *resolution.TargetUserId); err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = c.plans().UpdateOne(ctx, bson.M{"_id": clusterId}, bson.M{"$set": bson.M{"plan.resolution": resolution}})
+	if err != nil {
+		return nil, fmt.Errorf("unable to update merge duplicate: %w", err)
+	}
+
+	return &DuplicateCluster{Id: clusterId, Plan: plan.Plan}, nil
+}
+
+// checkDuplicateTarget makes sure a target patient is not merged into or discarded by more than one source patient
+func (c *ClinicPlanExecutor) checkDuplicateTarget(ctx context.Context, planId, clusterId primitive.ObjectID, userId string) error {
+	count, err := c.plans().CountDocuments(ctx, bson.M{
+		"planId": planId,
+		"type":   planTypePatient,
+		"_id":    bson.M{"$ne": clusterId},
+		"$or": bson.A{
+			bson.M{
+				"plan.resolution.type":         bson.M{"$in": bson.A{DuplicateResolutionMerge, DuplicateResolutionDiscardCustodial}},
+				"plan.resolution.targetUserId": userId,
+			},
+			bson.M{
+				"plan.patientAction":        PatientActionMerge,
+				"plan.targetPatient.userId": userId,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to check merge duplicates: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: patient %s is already resolved as a duplicate of another patient", errs.Conflict, userId)
+	}
+	return nil
+}
+
+// StartJob schedules the execution of a merge after the review of the duplicates. Duplicates without a resolution
+// are moved to the target clinic as planned.
+func (c *ClinicPlanExecutor) StartJob(ctx context.Context, planId primitive.ObjectID) error {
+	now := time.Now()
+	res, err := c.jobs().UpdateOne(ctx, bson.M{"_id": planId, "status": JobStatusAwaitingReview}, bson.M{
+		"$set": bson.M{
+			"status":          JobStatusPending,
+			"nextAttemptTime": now,
+			"modifiedTime":    now,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start merge job: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		if _, err := c.getJob(ctx, planId); err != nil {
+			return err
+		}
+		return fmt.Errorf("%w: the merge is not awaiting review", errs.Conflict)
+	}
+
+	c.Logger.Infow("started clinic merge after review", "planId", planId.Hex())
+	return nil
+}
@@ -0,0 +1,118 @@
+package merge_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/clinics/merge"
+	mergeTest "github.com/tidepool-org/clinic/clinics/merge/test"
+	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+)
+
+var _ = Describe("Duplicate Resolution", func() {
+	var target clinics.Clinic
+	var plan merge.PatientPlan
+	var duplicateUserId string
+
+	BeforeEach(func() {
+		data := mergeTest.RandomData(mergeTest.Params{
+			UniquePatientCount:           patientCount,
+			LikelyDuplicateAccountsCount: likelyDuplicateAccountsCount,
+		})
+		target = data.Target
+
+		planner, err := merge.NewPatientMergePlanner(data.Source, data.Target, data.SourcePatients, data.TargetPatients)
+		Expect(err).ToNot(HaveOccurred())
+		plans, err := planner.Plan(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+
+		var reviewable []merge.PatientPlan
+		for _, p := range plans {
+			if p.IsReviewable() {
+				reviewable = append(reviewable, p)
+			}
+		}
+		Expect(reviewable).To(HaveLen(likelyDuplicateAccountsCount))
+
+		plan = reviewable[0]
+		conflicts := plan.Conflicts[merge.PatientConflictCategoryLikelyDuplicateAccounts]
+		Expect(conflicts).To(HaveLen(1))
+		duplicateUserId = *conflicts[0].Patient.UserId
+	})
+
+	It("keeps the planned action when both patients are kept", func() {
+		plan.Resolution = &merge.DuplicateResolution{Type: merge.DuplicateResolutionKeepBoth}
+
+		resolved, err := plan.Resolved(target)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolved.PatientAction).To(Equal(merge.PatientActionMove))
+		Expect(resolved.TargetPatient).To(BeNil())
+	})
+
+	It("merges the source patient into the selected duplicate", func() {
+		plan.SourcePatient.Permissions = &patients.CustodialAccountPermissions
+		plan.Resolution = &merge.DuplicateResolution{Type: merge.DuplicateResolutionMerge, TargetUserId: &duplicateUserId}
+
+		resolved, err := plan.Resolved(target)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolved.PatientAction).To(Equal(merge.PatientActionMerge))
+		Expect(*resolved.TargetPatient.UserId).To(Equal(duplicateUserId))
+		Expect(resolved.PostMigrationTagNames).To(ContainElements(resolved.SourceTagNames))
+		Expect(resolved.PostMigrationTagNames).To(ContainElements(resolved.TargetTagNames))
+	})
+
+	It("doesn't merge a patient account into a different patient account", func() {
+		plan.SourcePatient.Permissions = nil
+		plan.Resolution = &merge.DuplicateResolution{Type: merge.DuplicateResolutionMerge, TargetUserId: &duplicateUserId}
+
+		_, err := plan.Resolved(target)
+		Expect(err).To(MatchError(errs.BadRequest))
+	})
+
+	It("doesn't merge the source patient into a patient which is not a duplicate", func() {
+		userId := "0000000000"
+		plan.Resolution = &merge.DuplicateResolution{Type: merge.DuplicateResolutionMerge, TargetUserId: &userId}
+
+		_, err := plan.Resolved(target)
+		Expect(err).To(MatchError(errs.BadRequest))
+	})
+
+	It("discards the source patient when it's custodial", func() {
+		plan.SourcePatient.Permissions = &patients.CustodialAccountPermissions
+		plan.Resolution = &merge.DuplicateResolution{Type: merge.DuplicateResolutionDiscardCustodial, TargetUserId: &duplicateUserId}
+
+		resolved, err := plan.Resolved(target)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolved.PatientAction).To(Equal(merge.PatientActionDiscard))
+	})
+
+	It("replaces the target patient when it's custodial", func() {
+		conflicts := plan.Conflicts[merge.PatientConflictCategoryLikelyDuplicateAccounts]
+		for i := range conflicts {
+			conflicts[i].Patient.Permissions = &patients.CustodialAccountPermissions
+		}
+		plan.SourcePatient.Permissions = nil
+		plan.Resolution = &merge.DuplicateResolution{Type: merge.DuplicateResolutionDiscardCustodial, TargetUserId: &duplicateUserId}
+
+		resolved, err := plan.Resolved(target)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolved.PatientAction).To(Equal(merge.PatientActionReplace))
+		Expect(*resolved.TargetPatient.UserId).To(Equal(duplicateUserId))
+	})
+
+	It("doesn't discard any patient when neither of them is custodial", func() {
+		conflicts := plan.Conflicts[merge.PatientConflictCategoryLikelyDuplicateAccounts]
+		for i := range conflicts {
+			conflicts[i].Patient.Permissions = nil
+		}
+		plan.SourcePatient.Permissions = nil
+		plan.Resolution = &merge.DuplicateResolution{Type: merge.DuplicateResolutionDiscardCustodial, TargetUserId: &duplicateUserId}
+
+		_, err := plan.Resolved(target)
+		Expect(err).To(MatchError(errs.BadRequest))
+	})
+})
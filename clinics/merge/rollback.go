@@ -0,0 +1,734 @@
+package merge
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/tidepool-org/clinic/clinicians"
+	"github.com/tidepool-org/clinic/clinics"
+	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/store"
+)
+
+const (
+	RollbackIssueTypeJob       = "job"
+	RollbackIssueTypeClinic    = "clinic"
+	RollbackIssueTypeShareCode = "shareCode"
+	RollbackIssueTypePatient   = "patient"
+	RollbackIssueTypeClinician = "clinician"
+	RollbackIssueTypeTag       = "tag"
+	RollbackIssueTypeSite      = "site"
+
+	// rollbackQueryBatchSize limits the number of ids in the queries used to check the merged records
+	rollbackQueryBatchSize = 1000
+)
+
+var ErrRollbackConflicts = fmt.Errorf("%w: the merge cannot be rolled back cleanly", errs.ConstraintViolation)
+
+// RollbackIssue is a change made after the merge which affects its rollback
+type RollbackIssue struct {
+	Type    string
+	Id      string
+	Message string
+}
+
+// RollbackReport is the preflight report of a merge rollback
+type RollbackReport struct {
+	PlanId primitive.ObjectID
+	// Conflicts are changes made after the merge which prevent the rollback
+	Conflicts []RollbackIssue
+	// Warnings are changes made after the merge which are overwritten by the rollback (e.g. the tags and sites of
+	// patients which were updated after the merge are restored to their state before the merge)
+	Warnings []RollbackIssue
+}
+
+func (r RollbackReport) CanRollback() bool {
+	return len(r.Conflicts) == 0
+}
+
+func (r *RollbackReport) addConflict(typ, id, message string, args ...any) {
+	r.Conflicts = append(r.Conflicts, RollbackIssue{Type: typ, Id: id, Message: fmt.Sprintf(message, args...)})
+}
+
+func (r *RollbackReport) addWarning(typ, id, message string, args ...any) {
+	r.Warnings = append(r.Warnings, RollbackIssue{Type: typ, Id: id, Message: fmt.Sprintf(message, args...)})
+}
+
+// executedPlans are the plans of a merge which were executed, grouped by type
+type executedPlans struct {
+	tags       []TagPlan
+	patients   []PatientPlan
+	sites      []SitePlan
+	clinicians []ClinicianPlan
+}
+
+// PreflightRollback checks whether the merge can be rolled back by reverting the executed plans. Only completed
+// merges can be rolled back within the rollback window.
+func (c *ClinicPlanExecutor) PreflightRollback(ctx context.Context, planId primitive.ObjectID) (*RollbackReport, error) {
+	job, err := c.getJob(ctx, planId)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &RollbackReport{PlanId: planId}
+	if job.Status != JobStatusCompleted {
+		report.addConflict(RollbackIssueTypeJob, planId.Hex(), "only completed merges can be rolled back, the merge is %s", job.Status)
+		return report, nil
+	}
+	if job.CompletedTime == nil || time.Since(*job.CompletedTime) > c.Config.RollbackWindow {
+		report.addConflict(RollbackIssueTypeJob, planId.Hex(), "the merge can only be rolled back within %s after its completion", c.Config.RollbackWindow)
+		return report, nil
+	}
+
+	if job.ConsolidationId != nil {
+		count, err := c.jobs().CountDocuments(ctx, bson.M{
+			"consolidationId":       job.ConsolidationId,
+			"consolidationSequence": bson.M{"$gt": job.ConsolidationSequence},
+			"status":                bson.M{"$nin": bson.A{JobStatusRolledBack, JobStatusCanceled}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to check consolidation merge jobs: %w", err)
+		}
+		if count > 0 {
+			report.addConflict(RollbackIssueTypeJob, planId.Hex(), "the subsequent merges of the consolidation must be rolled back first")
+			return report, nil
+		}
+	}
+
+	clinicPlan, err := c.getClinicPlan(ctx, planId)
+	if err != nil {
+		return nil, err
+	}
+	plans, err := c.listExecutedPlans(ctx, planId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.checkClinics(ctx, report, clinicPlan); err != nil {
+		return nil, err
+	}
+	if !report.CanRollback() {
+		return report, nil
+	}
+	if err := c.checkPatients(ctx, report, clinicPlan, plans, *job.CompletedTime); err != nil {
+		return nil, err
+	}
+	if err := c.checkClinicians(ctx, report, clinicPlan, plans, *job.CompletedTime); err != nil {
+		return nil, err
+	}
+	if err := c.checkTagsAndSites(ctx, report, clinicPlan, plans); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// Rollback schedules the rollback of a completed merge which is executed by the worker. The source clinic is
+// re-created and the patients, clinicians, tags, sites and share codes are moved back to the source clinic. The
+// rollback is not scheduled if the preflight report has conflicts.
+func (c *ClinicPlanExecutor) Rollback(ctx context.Context, planId primitive.ObjectID) (*RollbackReport, error) {
+	report, err := c.PreflightRollback(ctx, planId)
+	if err != nil {
+		return nil, err
+	}
+	if !report.CanRollback() {
+		return report, ErrRollbackConflicts
+	}
+
+	job, err := c.getJob(ctx, planId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The clinics are locked by the job until the rollback is completed
+	now := time.Now()
+	_, err = store.WithTransaction(ctx, c.DBClient, func(sessionCtx mongo.SessionContext) (any, error) {
+		res, err := c.jobs().UpdateOne(sessionCtx, bson.M{"_id": planId, "status": JobStatusCompleted}, bson.M{
+			"$set": bson.M{
+				"status":          JobStatusRollingBack,
+				"attempts":        0,
+				"nextAttemptTime": now,
+				"modifiedTime":    now,
+			},
+			"$unset": bson.M{
+				"lastError": "",
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to schedule merge rollback: %w", err)
+		}
+		if res.ModifiedCount != 1 {
+			return nil, fmt.Errorf("%w: the merge is already rolled back", errs.Conflict)
+		}
+		return nil, c.acquireLocks(sessionCtx, planId, []primitive.ObjectID{job.SourceClinicId, job.TargetClinicId})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Infow("scheduled clinic merge rollback", "planId", planId.Hex(), "warnings", len(report.Warnings))
+	return report, nil
+}
+
+func (c *ClinicPlanExecutor) checkClinics(ctx context.Context, report *RollbackReport, clinicPlan ClinicMergePlan) error {
+	collection := c.DB.Collection(clinics.CollectionName)
+
+	count, err := collection.CountDocuments(ctx, bson.M{"_id": clinicPlan.Source.Id})
+	if err != nil {
+		return fmt.Errorf("unable to check source clinic: %w", err)
+	}
+	if count > 0 {
+		report.addConflict(RollbackIssueTypeClinic, clinicPlan.Source.Id.Hex(), "the source clinic already exists")
+	}
+
+	count, err = collection.CountDocuments(ctx, bson.M{"_id": clinicPlan.Target.Id})
+	if err != nil {
+		return fmt.Errorf("unable to check target clinic: %w", err)
+	}
+	if count == 0 {
+		report.addConflict(RollbackIssueTypeClinic, clinicPlan.Target.Id.Hex(), "the target clinic was deleted")
+	}
+
+	shareCodes := getMergedShareCodes(clinicPlan)
+	if len(shareCodes) == 0 {
+		return nil
+	}
+	cursor, err := collection.Find(ctx, bson.M{
+		"_id":        bson.M{"$ne": clinicPlan.Target.Id},
+		"shareCodes": bson.M{"$in": shareCodes},
+	}, options.Find().SetProjection(bson.M{"shareCodes": 1}))
+	if err != nil {
+		return fmt.Errorf("unable to check share codes: %w", err)
+	}
+	var conflicting []clinics.Clinic
+	if err := cursor.All(ctx, &conflicting); err != nil {
+		return fmt.Errorf("unable to decode clinics: %w", err)
+	}
+	for _, clinic := range conflicting {
+		for _, shareCode := range *clinic.ShareCodes {
+			if slices.Contains(shareCodes, shareCode) {
+				report.addConflict(RollbackIssueTypeShareCode, shareCode, "the share code is used by clinic %s", clinic.Id.Hex())
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *ClinicPlanExecutor) checkPatients(ctx context.Context, report *RollbackReport, clinicPlan ClinicMergePlan, plans executedPlans, completedTime time.Time) error {
+	var userIds []string
+	var replacedUserIds []string
+	for _, plan := range plans.patients {
+		plan, err := plan.Resolved(clinicPlan.Target)
+		if err != nil {
+			return err
+		}
+
+		switch plan.PatientAction {
+		case PatientActionMove:
+			userIds = append(userIds, *plan.SourcePatient.UserId)
+		case PatientActionMerge:
+			userIds = append(userIds, *plan.TargetPatient.UserId)
+		case PatientActionReplace:
+			userIds = append(userIds, *plan.SourcePatient.UserId)
+			replacedUserIds = append(replacedUserIds, *plan.TargetPatient.UserId)
+		}
+	}
+
+	updatedTimes, err := c.getPatientsUpdatedTimes(ctx, *clinicPlan.Target.Id, slices.Concat(userIds, replacedUserIds))
+	if err != nil {
+		return err
+	}
+
+	for _, userId := range replacedUserIds {
+		if _, ok := updatedTimes[userId]; ok {
+			report.addConflict(RollbackIssueTypePatient, userId, "the custodial patient which was replaced by the merge was added to the target clinic again")
+		}
+	}
+
+	for _, userId := range userIds {
+		updatedTime, ok := updatedTimes[userId]
+		if !ok {
+			report.addConflict(RollbackIssueTypePatient, userId, "the patient is no longer in the target clinic")
+		} else if updatedTime.After(completedTime) {
+			report.addWarning(RollbackIssueTypePatient, userId, "the patient was updated after the merge, the tags and sites of the patient will be restored")
+		}
+	}
+
+	return nil
+}
+
+func (c *ClinicPlanExecutor) checkClinicians(ctx context.Context, report *RollbackReport, clinicPlan ClinicMergePlan, plans executedPlans, completedTime time.Time) error {
+	var ids []primitive.ObjectID
+	for _, plan := range plans.clinicians {
+		if plan.ClinicianAction == ClinicianActionMove {
+			ids = append(ids, *plan.Clinician.Id)
+		}
+	}
+
+	for chunk := range slices.Chunk(ids, rollbackQueryBatchSize) {
+		cursor, err := c.DB.Collection(clinicians.CollectionName).Find(ctx, bson.M{
+			"_id":      bson.M{"$in": chunk},
+			"clinicId": clinicPlan.Target.Id,
+		}, options.Find().SetProjection(bson.M{"_id": 1, "updatedTime": 1}))
+		if err != nil {
+			return fmt.Errorf("unable to check clinicians: %w", err)
+		}
+		var result []clinicians.Clinician
+		if err := cursor.All(ctx, &result); err != nil {
+			return fmt.Errorf("unable to decode clinicians: %w", err)
+		}
+
+		updatedTimes := make(map[primitive.ObjectID]time.Time, len(result))
+		for _, clinician := range result {
+			updatedTimes[*clinician.Id] = clinician.UpdatedTime
+		}
+		for _, id := range chunk {
+			updatedTime, ok := updatedTimes[id]
+			if !ok {
+				report.addConflict(RollbackIssueTypeClinician, id.Hex(), "the clinician is no longer a member of the target clinic")
+			} else if updatedTime.After(completedTime) {
+				report.addWarning(RollbackIssueTypeClinician, id.Hex(), "the clinician was updated after the merge")
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkTagsAndSites reports the tags and sites created by the merge which were assigned to patients of the target
+// clinic after the merge. Those tags and sites would be removed by the rollback.
+func (c *ClinicPlanExecutor) checkTagsAndSites(ctx context.Context, report *RollbackReport, clinicPlan ClinicMergePlan, plans executedPlans) error {
+	target, err := c.ClinicsService.Get(ctx, clinicPlan.Target.Id.Hex())
+	if err != nil {
+		return err
+	}
+
+	// The tags and sites of the merged patients are restored by the rollback
+	var mergedUserIds []string
+	for _, plan := range plans.patients {
+		if plan.SourcePatient != nil {
+			mergedUserIds = append(mergedUserIds, *plan.SourcePatient.UserId)
+		}
+		if plan.TargetPatient != nil {
+			mergedUserIds = append(mergedUserIds, *plan.TargetPatient.UserId)
+		}
+	}
+
+	for _, plan := range plans.tags {
+		if plan.TagAction != TagActionCreate {
+			continue
+		}
+		index := slices.IndexFunc(target.PatientTags, func(tag clinics.PatientTag) bool { return tag.Name == plan.Name })
+		if index < 0 {
+			continue
+		}
+		tagId := target.PatientTags[index].Id
+		count, err := c.countOtherPatients(ctx, *target.Id, bson.M{"tags": tagId}, mergedUserIds)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			report.addConflict(RollbackIssueTypeTag, tagId.Hex(), "the tag %q created by the merge is assigned to %d other patients", plan.Name, count)
+		}
+	}
+
+	for _, plan := range plans.sites {
+		if plan.Action != SiteActionMove && plan.Action != SiteActionRename {
+			continue
+		}
+		count, err := c.countOtherPatients(ctx, *target.Id, bson.M{"sites.id": plan.Site.Id}, mergedUserIds)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			report.addConflict(RollbackIssueTypeSite, plan.Site.Id.Hex(), "the site %q created by the merge is assigned to %d other patients", plan.Name(), count)
+		}
+	}
+
+	return nil
+}
+
+func (c *ClinicPlanExecutor) countOtherPatients(ctx context.Context, clinicId primitive.ObjectID, selector bson.M, excludedUserIds []string) (int64, error) {
+	selector["clinicId"] = clinicId
+	selector["userId"] = bson.M{"$nin": excludedUserIds}
+	count, err := c.DB.Collection(patients.CollectionName).CountDocuments(ctx, selector)
+	if err != nil {
+		return 0, fmt.Errorf("unable to count patients: %w", err)
+	}
+	return count, nil
+}
+
+func (c *ClinicPlanExecutor) getPatientsUpdatedTimes(ctx context.Context, clinicId primitive.ObjectID, userIds []string) (map[string]time.Time, error) {
+	updatedTimes := make(map[string]time.Time, len(userIds))
+	for chunk := range slices.Chunk(userIds, rollbackQueryBatchSize) {
+		cursor, err := c.DB.Collection(patients.CollectionName).Find(ctx, bson.M{
+			"clinicId": clinicId,
+			"userId":   bson.M{"$in": chunk},
+		}, options.Find().SetProjection(bson.M{"userId": 1, "updatedTime": 1}))
+		if err != nil {
+			return nil, fmt.Errorf("unable to check patients: %w", err)
+		}
+		var result []patients.Patient
+		if err := cursor.All(ctx, &result); err != nil {
+			return nil, fmt.Errorf("unable to decode patients: %w", err)
+		}
+		for _, patient := range result {
+			updatedTimes[*patient.UserId] = patient.UpdatedTime
+		}
+	}
+	return updatedTimes, nil
+}
+
+func (c *ClinicPlanExecutor) listExecutedPlans(ctx context.Context, planId primitive.ObjectID) (executedPlans, error) {
+	plans := executedPlans{}
+	cursor, err := c.plans().Find(ctx, bson.M{
+		"planId":       planId,
+		"executedTime": bson.M{"$exists": true},
+	}, options.Find().SetSort(bson.M{"sequence": 1}))
+	if err != nil {
+		return plans, fmt.Errorf("unable to list executed merge plans: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		p := persistedPlan{}
+		if err := cursor.Decode(&p); err != nil {
+			return plans, fmt.Errorf("unable to decode merge plan: %w", err)
+		}
+
+		switch p.Type {
+		case planTypeTag:
+			err = appendPlan(p, &plans.tags)
+		case planTypePatient:
+			err = appendPlan(p, &plans.patients)
+		case planTypeSite:
+			err = appendPlan(p, &plans.sites)
+		case planTypeClinician:
+			err = appendPlan(p, &plans.clinicians)
+		}
+		if err != nil {
+			return plans, fmt.Errorf("unable to decode %s merge plan %s: %w", p.Type, p.Id.Hex(), err)
+		}
+	}
+
+	return plans, cursor.Err()
+}
+
+func appendPlan[T Plan](p persistedPlan, plans *[]T) error {
+	var plan T
+	if err := bson.Unmarshal(p.Plan, &plan); err != nil {
+		return err
+	}
+	*plans = append(*plans, plan)
+	return nil
+}
+
+func (c *ClinicPlanExecutor) revertNextBatch(ctx context.Context, planId primitive.ObjectID, clinicPlan ClinicMergePlan) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, store.ContextTimeout)
+	defer cancel()
+
+	selector := bson.M{
+		"planId":       planId,
+		"executedTime": bson.M{"$exists": true},
+		"revertedTime": bson.M{"$exists": false},
+	}
+	opts := options.Find().
+		SetSort(bson.M{"sequence": -1}).
+		SetLimit(int64(c.Config.BatchSize))
+
+	cursor, err := c.plans().Find(ctx, selector, opts)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get executed merge plans: %w", err)
+	}
+	var batch []persistedPlan
+	if err := cursor.All(ctx, &batch); err != nil {
+		return 0, fmt.Errorf("unable to decode executed merge plans: %w", err)
+	}
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	_, err = store.WithTransaction(ctx, c.DBClient, func(sessionContext mongo.SessionContext) (any, error) {
+		for _, p := range batch {
+			if err := c.revertPlan(sessionContext, p, clinicPlan); err != nil {
+				return nil, fmt.Errorf("unable to revert %s plan %s: %w", p.Type, p.Id.Hex(), err)
+			}
+
+			res, err := c.plans().UpdateOne(sessionContext,
+				bson.M{"_id": p.Id, "revertedTime": bson.M{"$exists": false}},
+				bson.M{"$set": bson.M{"revertedTime": time.Now()}},
+			)
+			if err != nil {
+				return nil, err
+			}
+			if res.ModifiedCount != 1 {
+				return nil, fmt.Errorf("%w: %s plan %s was already reverted", errs.Conflict, p.Type, p.Id.Hex())
+			}
+		}
+		return nil, nil
+	})
+
+	return len(batch), err
+}
+
+func (c *ClinicPlanExecutor) revertPlan(ctx context.Context, p persistedPlan, clinicPlan ClinicMergePlan) error {
+	switch p.Type {
+	case planTypeTag:
+		plan := TagPlan{}
+		if err := bson.Unmarshal(p.Plan, &plan); err != nil {
+			return err
+		}
+		return c.revertTag(ctx, plan)
+	case planTypePatient:
+		plan := PatientPlan{}
+		if err := bson.Unmarshal(p.Plan, &plan); err != nil {
+			return err
+		}
+		return c.revertPatient(ctx, plan, clinicPlan)
+	case planTypeSite:
+		plan := SitePlan{}
+		if err := bson.Unmarshal(p.Plan, &plan); err != nil {
+			return err
+		}
+		return c.revertSite(ctx, plan)
+	case planTypeClinician:
+		plan := ClinicianPlan{}
+		if err := bson.Unmarshal(p.Plan, &plan); err != nil {
+			return err
+		}
+		return c.revertClinician(ctx, plan, clinicPlan)
+	case planTypeClinic:
+		return c.revertClinic(ctx, clinicPlan)
+	default:
+		return fmt.Errorf("unexpected plan type %s", p.Type)
+	}
+}
+
+func (c *ClinicPlanExecutor) revertTag(ctx context.Context, plan TagPlan) error {
+	if plan.TagAction != TagActionCreate {
+		return nil
+	}
+
+	_, err := c.DB.Collection(clinics.CollectionName).UpdateOne(ctx, bson.M{"_id": plan.TargetClinicId}, bson.M{
+		"$pull": bson.M{"patientTags": bson.M{"name": plan.Name}},
+		"$set":  bson.M{"updatedTime": time.Now()},
+	})
+	if err != nil {
+		return fmt.Errorf("error removing tag: %w", err)
+	}
+	return nil
+}
+
+func (c *ClinicPlanExecutor) revertPatient(ctx context.Context, plan PatientPlan, clinicPlan ClinicMergePlan) error {
+	collection := c.DB.Collection(patients.CollectionName)
+
+	plan, err := plan.Resolved(clinicPlan.Target)
+	if err != nil {
+		return err
+	}
+
+	switch plan.PatientAction {
+	case PatientActionMove, PatientActionReplace:
+		res, err := collection.UpdateOne(ctx, bson.M{
+			"clinicId": plan.TargetClinicId,
+			"userId":   plan.SourcePatient.UserId,
+		}, bson.M{
+			"$set": bson.M{
+				"clinicId":         plan.SourceClinicId,
+				"requireUniqueMrn": plan.SourcePatient.RequireUniqueMrn,
+				"tags":             getPatientTags(plan.SourcePatient),
+				"sites":            getPatientSites(plan.SourcePatient),
+				"updatedTime":      time.Now(),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error moving patient back: %w", err)
+		}
+		if res.MatchedCount != 1 {
+			return fmt.Errorf("error moving patient back: unexpected matched count %v", res.MatchedCount)
+		}
+		if plan.PatientAction == PatientActionReplace {
+			target := *plan.TargetPatient
+			target.UpdatedTime = time.Now()
+			if _, err := collection.InsertOne(ctx, target); err != nil {
+				return fmt.Errorf("error restoring custodial target patient: %w", err)
+			}
+		}
+		return nil
+	case PatientActionDiscard:
+		source := *plan.SourcePatient
+		source.UpdatedTime = time.Now()
+		if _, err := collection.InsertOne(ctx, source); err != nil {
+			return fmt.Errorf("error restoring custodial source patient: %w", err)
+		}
+		return nil
+	case PatientActionMerge:
+		tags, err := c.getTargetPatientTags(ctx, plan)
+		if err != nil {
+			return err
+		}
+		res, err := collection.UpdateOne(ctx, bson.M{
+			"clinicId": plan.TargetClinicId,
+			"userId":   plan.TargetPatient.UserId,
+		}, bson.M{
+			"$set": bson.M{
+				"tags":        tags,
+				"sites":       getPatientSites(plan.TargetPatient),
+				"updatedTime": time.Now(),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error restoring target patient: %w", err)
+		}
+		if res.MatchedCount != 1 {
+			return fmt.Errorf("error restoring target patient: unexpected matched count %v", res.MatchedCount)
+		}
+
+		// The summary of the source patient is not persisted, it's recalculated by the data service
+		source := *plan.SourcePatient
+		source.UpdatedTime = time.Now()
+		if _, err := collection.InsertOne(ctx, source); err != nil {
+			return fmt.Errorf("error restoring source patient: %w", err)
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
+func (c *ClinicPlanExecutor) revertSite(ctx context.Context, plan SitePlan) error {
+	if plan.Action != SiteActionMove && plan.Action != SiteActionRename {
+		return nil
+	}
+
+	_, err := c.DB.Collection(clinics.CollectionName).UpdateOne(ctx, bson.M{"_id": plan.TargetClinicId}, bson.M{
+		"$pull": bson.M{"sites": bson.M{"id": plan.Site.Id}},
+		"$set":  bson.M{"updatedTime": time.Now()},
+	})
+	if err != nil {
+		return fmt.Errorf("error removing site: %w", err)
+	}
+	return nil
+}
+
+func (c *ClinicPlanExecutor) revertClinician(ctx context.Context, plan ClinicianPlan, clinicPlan ClinicMergePlan) error {
+	collection := c.DB.Collection(clinicians.CollectionName)
+
+	switch plan.ClinicianAction {
+	case ClinicianActionMove:
+		res, err := collection.UpdateOne(ctx, bson.M{
+			"_id":      plan.Clinician.Id,
+			"clinicId": clinicPlan.Target.Id,
+		}, bson.M{
+			"$set": bson.M{
+				"clinicId":    plan.Clinician.ClinicId,
+				"updatedTime": time.Now(),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error moving clinician back: %w", err)
+		}
+		if res.MatchedCount != 1 {
+			return fmt.Errorf("error moving clinician back: unexpected matched count %v", res.MatchedCount)
+		}
+
+		// Only remove the admins which were added by the merge
+		userId := plan.Clinician.UserId
+		if userId != nil && (clinicPlan.Target.Admins == nil || !slices.Contains(*clinicPlan.Target.Admins, *userId)) {
+			_, err = c.DB.Collection(clinics.CollectionName).UpdateOne(ctx, bson.M{"_id": clinicPlan.Target.Id}, bson.M{
+				"$pull": bson.M{"admins": userId},
+			})
+			if err != nil {
+				return fmt.Errorf("error updating clinic admins: %w", err)
+			}
+		}
+		return nil
+	case ClinicianActionMerge:
+		if _, err := collection.InsertOne(ctx, plan.Clinician); err != nil {
+			return fmt.Errorf("error restoring clinician: %w", err)
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
+// revertClinic re-creates the source clinic and removes its share codes from the target clinic. The share codes are
+// removed first, because they must be unique.
+func (c *ClinicPlanExecutor) revertClinic(ctx context.Context, clinicPlan ClinicMergePlan) error {
+	collection := c.DB.Collection(clinics.CollectionName)
+
+	if shareCodes := getMergedShareCodes(clinicPlan); len(shareCodes) > 0 {
+		_, err := collection.UpdateOne(ctx, bson.M{"_id": clinicPlan.Target.Id}, bson.M{
+			"$pull": bson.M{"shareCodes": bson.M{"$in": shareCodes}},
+			"$set":  bson.M{"updatedTime": time.Now()},
+		})
+		if err != nil {
+			return fmt.Errorf("error removing share codes: %w", err)
+		}
+	}
+
+	source := clinicPlan.Source
+	source.UpdatedTime = time.Now()
+	if _, err := collection.InsertOne(ctx, source); err != nil {
+		return fmt.Errorf("error restoring source clinic: %w", err)
+	}
+
+	return nil
+}
+
+// getMergedShareCodes returns the share codes of the source clinic which were appended to the target clinic
+func getMergedShareCodes(clinicPlan ClinicMergePlan) []string {
+	if clinicPlan.Source.ShareCodes == nil {
+		return nil
+	}
+
+	var shareCodes []string
+	for _, shareCode := range *clinicPlan.Source.ShareCodes {
+		if clinicPlan.Target.ShareCodes == nil || !slices.Contains(*clinicPlan.Target.ShareCodes, shareCode) {
+			shareCodes = append(shareCodes, shareCode)
+		}
+	}
+	return shareCodes
+}
+
+// getTargetPatientTags returns the ids of the tags of the target patient before the merge. The tags are matched by
+// name, because the target patient of a consolidation may be a patient moved by the merge of a previous source clinic
+// whose tags were created with different ids than the planned ones.
+func (c *ClinicPlanExecutor) getTargetPatientTags(ctx context.Context, plan PatientPlan) ([]primitive.ObjectID, error) {
+	target, err := c.ClinicsService.Get(ctx, plan.TargetClinicId.Hex())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get target clinic: %w", err)
+	}
+
+	tags := make([]primitive.ObjectID, 0, len(plan.TargetTagNames))
+	for _, tag := range target.PatientTags {
+		if slices.Contains(plan.TargetTagNames, tag.Name) {
+			tags = append(tags, *tag.Id)
+		}
+	}
+	return tags, nil
+}
+
+func getPatientTags(patient *patients.Patient) []primitive.ObjectID {
+	if patient.Tags == nil {
+		return []primitive.ObjectID{}
+	}
+	return *patient.Tags
+}
+
+func getPatientSites(patient *patients.Patient) any {
+	if patient.Sites == nil {
+		return bson.A{}
+	}
+	return *patient.Sites
+}
@@ -0,0 +1,525 @@
+package merge
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/tidepool-org/clinic/clinicians"
+	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/clinics/manager"
+	"github.com/tidepool-org/clinic/deletions"
+	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/sites"
+	"github.com/tidepool-org/clinic/store"
+)
+
+var (
+	ErrorSplitWithoutAdmin             = NewReportError("At least one admin must be moved to the new workspace")
+	ErrorSplitRemovesAllAdminsOfSource = NewReportError("At least one admin must remain in the source workspace")
+)
+
+// SplitSelection selects the patients and clinicians which are moved from the source clinic to the new clinic.
+// The moved patients are the union of the patients assigned to the site, the patients with the tag and the patients
+// in the explicit list.
+type SplitSelection struct {
+	SiteId       *string
+	TagId        *string
+	PatientIds   []string
+	ClinicianIds []string
+}
+
+// SplitPlan is the plan for moving a subset of the patients and clinicians of a clinic to a new clinic
+type SplitPlan struct {
+	Source clinics.Clinic
+	// Clinic is the new clinic. It is created with the profile and the settings of the source clinic and the tags
+	// and sites used by the moved patients and clinicians, which retain their ids.
+	Clinic clinics.Clinic
+	// CreatorUserId is the moved admin recorded as the creator of the new clinic
+	CreatorUserId string
+	// SourceSite is the site by which the patients were selected. It is kept in the source clinic, because it may
+	// be assigned to clinicians which are not moved.
+	SourceSite *sites.Site
+
+	PatientPlans   []SplitPatientPlan
+	ClinicianPlans []SplitClinicianPlan
+
+	CreatedTime time.Time
+
+	errors []ReportError
+}
+
+type SplitPatientPlan struct {
+	Patient   patients.Patient
+	TagNames  []string
+	SiteNames []string
+}
+
+type SplitClinicianPlan struct {
+	Clinician clinicians.Clinician
+	SiteNames []string
+}
+
+func (s SplitPlan) PreventsSplit() bool {
+	return len(s.errors) > 0
+}
+
+func (s SplitPlan) Errors() []ReportError {
+	return s.errors
+}
+
+// MovedAdmins returns the user ids of the moved admins, excluding the creator of the new clinic
+func (s SplitPlan) MovedAdmins() []string {
+	admins := make([]string, 0)
+	for _, plan := range s.ClinicianPlans {
+		if plan.Clinician.IsAdmin() && plan.Clinician.UserId != nil && *plan.Clinician.UserId != s.CreatorUserId {
+			admins = append(admins, *plan.Clinician.UserId)
+		}
+	}
+	return admins
+}
+
+type SplitPlanner struct {
+	clinics    clinics.Service
+	patients   patients.Service
+	clinicians clinicians.Service
+
+	sourceId  string
+	name      string
+	selection SplitSelection
+}
+
+func NewSplitPlanner(clinicsService clinics.Service, patientsService patients.Service, cliniciansService clinicians.Service, sourceId, name string, selection SplitSelection) *SplitPlanner {
+	return &SplitPlanner{
+		clinics:    clinicsService,
+		patients:   patientsService,
+		clinicians: cliniciansService,
+		sourceId:   sourceId,
+		name:       name,
+		selection:  selection,
+	}
+}
+
+func (s *SplitPlanner) Plan(ctx context.Context) (plan SplitPlan, err error) {
+	if strings.TrimSpace(s.name) == "" {
+		err = fmt.Errorf("%w: the name of the new clinic is required", errs.BadRequest)
+		return
+	}
+	if s.selection.SiteId == nil && s.selection.TagId == nil && len(s.selection.PatientIds) == 0 {
+		err = fmt.Errorf("%w: a site, a tag or a list of patients is required", errs.BadRequest)
+		return
+	}
+	if len(s.selection.ClinicianIds) == 0 {
+		err = fmt.Errorf("%w: at least one clinician is required", errs.BadRequest)
+		return
+	}
+
+	source, err := s.clinics.Get(ctx, s.sourceId)
+	if err != nil {
+		return
+	}
+
+	plan.Source = *source
+	plan.CreatedTime = time.Now()
+
+	if s.selection.SiteId != nil {
+		index := slices.IndexFunc(source.Sites, func(site sites.Site) bool { return site.Id.Hex() == *s.selection.SiteId })
+		if index == -1 {
+			err = fmt.Errorf("%w: site %s not found", errs.BadRequest, *s.selection.SiteId)
+			return
+		}
+		site := source.Sites[index]
+		plan.SourceSite = &site
+	}
+	if s.selection.TagId != nil {
+		if !slices.ContainsFunc(source.PatientTags, func(tag clinics.PatientTag) bool { return tag.Id.Hex() == *s.selection.TagId }) {
+			err = fmt.Errorf("%w: patient tag %s not found", errs.BadRequest, *s.selection.TagId)
+			return
+		}
+	}
+
+	lister := &ClinicMergePlanner{patients: s.patients, clinicians: s.clinicians}
+	sourcePatients, err := lister.listAllPatients(ctx, *source)
+	if err != nil {
+		return
+	}
+	sourceClinicians, err := lister.listAllClinicians(ctx, *source)
+	if err != nil {
+		return
+	}
+
+	selectedPatients, err := s.selectPatients(sourcePatients)
+	if err != nil {
+		return
+	}
+	selectedClinicians, err := s.selectClinicians(sourceClinicians)
+	if err != nil {
+		return
+	}
+
+	tags := buildTagsMap(source.PatientTags)
+	usedTagIds := map[string]struct{}{}
+	usedSiteIds := map[string]struct{}{}
+	for _, patient := range selectedPatients {
+		if patient.Tags != nil {
+			for _, tagId := range *patient.Tags {
+				usedTagIds[tagId.Hex()] = struct{}{}
+			}
+		}
+		if patient.Sites != nil {
+			for _, site := range *patient.Sites {
+				usedSiteIds[site.Id.Hex()] = struct{}{}
+			}
+		}
+		plan.PatientPlans = append(plan.PatientPlans, SplitPatientPlan{
+			Patient:   patient,
+			TagNames:  getUniquePatientTagNames(patient, tags),
+			SiteNames: siteNames(patient.Sites, nil),
+		})
+	}
+	for _, clinician := range selectedClinicians {
+		if clinician.Sites != nil {
+			for _, site := range *clinician.Sites {
+				usedSiteIds[site.Id.Hex()] = struct{}{}
+			}
+		}
+		plan.ClinicianPlans = append(plan.ClinicianPlans, SplitClinicianPlan{
+			Clinician: clinician,
+			SiteNames: siteNames(clinician.Sites, nil),
+		})
+	}
+
+	plan.Clinic = newSplitClinic(*source, s.name, usedTagIds, usedSiteIds)
+	plan.CreatorUserId, plan.errors = s.planAdmins(sourceClinicians, selectedClinicians)
+	for _, admin := range plan.MovedAdmins() {
+		plan.Clinic.AddAdmin(admin)
+	}
+
+	return plan, nil
+}
+
+func (s *SplitPlanner) selectPatients(sourcePatients []patients.Patient) ([]patients.Patient, error) {
+	explicit := map[string]bool{}
+	for _, userId := range s.selection.PatientIds {
+		explicit[userId] = false
+	}
+
+	selected := make([]patients.Patient, 0)
+	for _, patient := range sourcePatients {
+		userId := *patient.UserId
+		_, isExplicit := explicit[userId]
+		if isExplicit {
+			explicit[userId] = true
+		}
+		if isExplicit || s.hasSelectedSite(patient) || s.hasSelectedTag(patient) {
+			selected = append(selected, patient)
+		}
+	}
+
+	for userId, found := range explicit {
+		if !found {
+			return nil, fmt.Errorf("%w: patient %s not found", errs.BadRequest, userId)
+		}
+	}
+
+	return selected, nil
+}
+
+func (s *SplitPlanner) hasSelectedSite(patient patients.Patient) bool {
+	if s.selection.SiteId == nil || patient.Sites == nil {
+		return false
+	}
+	return slices.ContainsFunc(*patient.Sites, func(site sites.Site) bool { return site.Id.Hex() == *s.selection.SiteId })
+}
+
+func (s *SplitPlanner) hasSelectedTag(patient patients.Patient) bool {
+	if s.selection.TagId == nil || patient.Tags == nil {
+		return false
+	}
+	return slices.ContainsFunc(*patient.Tags, func(tagId primitive.ObjectID) bool { return tagId.Hex() == *s.selection.TagId })
+}
+
+func (s *SplitPlanner) selectClinicians(sourceClinicians []*clinicians.Clinician) ([]clinicians.Clinician, error) {
+	selected := make([]clinicians.Clinician, 0, len(s.selection.ClinicianIds))
+	for _, userId := range s.selection.ClinicianIds {
+		index := slices.IndexFunc(sourceClinicians, func(clinician *clinicians.Clinician) bool {
+			return clinician != nil && clinician.UserId != nil && *clinician.UserId == userId
+		})
+		if index == -1 {
+			return nil, fmt.Errorf("%w: clinician %s not found", errs.BadRequest, userId)
+		}
+		if !slices.ContainsFunc(selected, func(clinician clinicians.Clinician) bool { return *clinician.UserId == userId }) {
+			selected = append(selected, *sourceClinicians[index])
+		}
+	}
+	return selected, nil
+}
+
+// planAdmins returns the creator of the new clinic and the errors when either clinic would be left without an admin
+func (s *SplitPlanner) planAdmins(sourceClinicians []*clinicians.Clinician, selectedClinicians []clinicians.Clinician) (string, []ReportError) {
+	var creatorUserId string
+	for _, clinician := range selectedClinicians {
+		if clinician.IsAdmin() {
+			creatorUserId = *clinician.UserId
+			break
+		}
+	}
+
+	remainingAdmins := 0
+	for _, clinician := range sourceClinicians {
+		if clinician == nil || !clinician.IsAdmin() || clinician.UserId == nil {
+			continue
+		}
+		if !slices.Contains(s.selection.ClinicianIds, *clinician.UserId) {
+			remainingAdmins++
+		}
+	}
+
+	errors := make([]ReportError, 0)
+	if creatorUserId == "" {
+		errors = append(errors, ErrorSplitWithoutAdmin)
+	}
+	if remainingAdmins == 0 {
+		errors = append(errors, ErrorSplitRemovesAllAdminsOfSource)
+	}
+	return creatorUserId, errors
+}
+
+// newSplitClinic returns the new clinic with the profile and the settings of the source clinic. Membership
+// restrictions and EHR settings are specific to the organization of the source clinic and are not copied.
+func newSplitClinic(source clinics.Clinic, name string, tagIds map[string]struct{}, siteIds map[string]struct{}) clinics.Clinic {
+	clinic := clinics.Clinic{
+		Name:                 &name,
+		Address:              source.Address,
+		City:                 source.City,
+		ClinicType:           source.ClinicType,
+		ClinicSize:           source.ClinicSize,
+		Country:              source.Country,
+		PhoneNumbers:         source.PhoneNumbers,
+		PostalCode:           source.PostalCode,
+		State:                source.State,
+		Website:              source.Website,
+		IsMigrated:           source.IsMigrated,
+		Tier:                 source.Tier,
+		PreferredBgUnits:     source.PreferredBgUnits,
+		Timezone:             source.Timezone,
+		ClinicianRoles:       source.ClinicianRoles,
+		MRNSettings:          source.MRNSettings,
+		PatientCountSettings: source.PatientCountSettings,
+		PatientTags:          make([]clinics.PatientTag, 0),
+		Sites:                make([]sites.Site, 0),
+	}
+	for _, tag := range source.PatientTags {
+		if _, ok := tagIds[tag.Id.Hex()]; ok {
+			clinic.PatientTags = append(clinic.PatientTags, clinics.PatientTag{Id: tag.Id, Name: tag.Name})
+		}
+	}
+	// The ancestors of the used sites are copied to preserve the hierarchy
+	hierarchy := sites.NewHierarchy(source.Sites)
+	copied := map[primitive.ObjectID]struct{}{}
+	for _, site := range source.Sites {
+		if _, ok := siteIds[site.Id.Hex()]; ok {
+			copied[site.Id] = struct{}{}
+			for _, ancestorId := range hierarchy.Ancestors(site.Id) {
+				copied[ancestorId] = struct{}{}
+			}
+		}
+	}
+	for _, site := range source.Sites {
+		if _, ok := copied[site.Id]; ok {
+			clinic.Sites = append(clinic.Sites, sites.Site{Id: site.Id, Name: site.Name, ParentId: site.ParentId, Settings: site.Settings})
+		}
+	}
+	return clinic
+}
+
+// Split creates the new clinic of the plan and moves the patients and clinicians to it. The patient records are
+// moved as they are, preserving their permissions, reviews, summaries and EHR subscriptions. The new clinic is
+// created and the clinicians are moved in a single transaction. The patients are moved in batches, which are moved
+// back to the source clinic before the new clinic is deleted if a batch fails.
+func (c *ClinicPlanExecutor) Split(ctx context.Context, plan SplitPlan) (*clinics.Clinic, error) {
+	logger := c.Logger.With("clinicId", plan.Source.Id.Hex())
+	if plan.PreventsSplit() {
+		err := fmt.Errorf("%w: the split plan does not allow execution", errs.BadRequest)
+		logger.Errorw("cannot split clinic", "error", err)
+		return nil, err
+	}
+	// The source clinic is locked during the split, so it can't be merged concurrently
+	lockId := primitive.NewObjectID()
+	if err := c.acquireLocks(ctx, lockId, []primitive.ObjectID{*plan.Source.Id}); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := c.releaseLocks(ctx, lockId); err != nil {
+			logger.Errorw("unable to release clinic lock after split", "error", err)
+		}
+	}()
+
+	result, err := store.WithTransaction(ctx, c.DBClient, func(sessionCtx mongo.SessionContext) (any, error) {
+		clinic, err := c.ClinicManager.CreateClinicInTransaction(sessionCtx, &manager.CreateClinic{
+			Clinic:        plan.Clinic,
+			CreatorUserId: plan.CreatorUserId,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to create clinic: %w", err)
+		}
+		if err := c.splitClinicians(sessionCtx, plan, *clinic); err != nil {
+			return nil, err
+		}
+		return clinic, nil
+	})
+	if err != nil {
+		logger.Errorw("unable to split clinic", "error", err)
+		return nil, err
+	}
+	clinic := result.(*clinics.Clinic)
+	logger = logger.With("newClinicId", clinic.Id.Hex())
+
+	if err := c.splitPatients(ctx, plan, *clinic); err != nil {
+		logger.Errorw("unable to split clinic", "error", err)
+		if revertErr := c.revertSplit(ctx, plan, *clinic); revertErr != nil {
+			logger.Errorw("unable to revert failed split", "error", revertErr)
+		}
+		return nil, err
+	}
+
+	for _, clinicId := range []string{plan.Source.Id.Hex(), clinic.Id.Hex()} {
+		if err := c.ClinicManager.RefreshClinicPatientCount(ctx, clinicId); err != nil {
+			logger.Warnw("unable to refresh patient count after split", "refreshedClinicId", clinicId, "error", err)
+		}
+	}
+
+	logger.Infow("split clinic", "patients", len(plan.PatientPlans), "clinicians", len(plan.ClinicianPlans))
+	return c.ClinicsService.Get(ctx, clinic.Id.Hex())
+}
+
+// splitPatients moves the patients of the plan to the new clinic in batches. Each batch is moved in a transaction.
+func (c *ClinicPlanExecutor) splitPatients(ctx context.Context, plan SplitPlan, clinic clinics.Clinic) error {
+	collection := c.DB.Collection(patients.CollectionName)
+	for i := 0; i < len(plan.PatientPlans); i += c.Config.BatchSize {
+		batch := plan.PatientPlans[i:min(i+c.Config.BatchSize, len(plan.PatientPlans))]
+		userIds := make(bson.A, 0, len(batch))
+		for _, p := range batch {
+			userIds = append(userIds, *p.Patient.UserId)
+		}
+
+		_, err := store.WithTransaction(ctx, c.DBClient, func(sessionCtx mongo.SessionContext) (any, error) {
+			selector := bson.M{
+				"clinicId": plan.Source.Id,
+				"userId":   bson.M{"$in": userIds},
+			}
+			update := bson.M{
+				"$set": bson.M{
+					"clinicId":    clinic.Id,
+					"updatedTime": time.Now(),
+				},
+			}
+			res, err := collection.UpdateMany(sessionCtx, selector, update)
+			if err != nil {
+				return nil, fmt.Errorf("error moving patients: %w", err)
+			}
+			if res.ModifiedCount != int64(len(userIds)) {
+				return nil, fmt.Errorf("%w: unexpected moved patients count %v", errs.Conflict, res.ModifiedCount)
+			}
+			return nil, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// revertSplit moves the patients and the clinicians of the plan back to the source clinic and deletes the new clinic
+func (c *ClinicPlanExecutor) revertSplit(ctx context.Context, plan SplitPlan, clinic clinics.Clinic) error {
+	userIds := make(bson.A, 0, len(plan.PatientPlans))
+	for _, p := range plan.PatientPlans {
+		userIds = append(userIds, *p.Patient.UserId)
+	}
+	_, err := c.DB.Collection(patients.CollectionName).UpdateMany(ctx,
+		bson.M{"clinicId": clinic.Id, "userId": bson.M{"$in": userIds}},
+		bson.M{"$set": bson.M{"clinicId": plan.Source.Id, "updatedTime": time.Now()}},
+	)
+	if err != nil {
+		return fmt.Errorf("error moving patients back: %w", err)
+	}
+
+	_, err = store.WithTransaction(ctx, c.DBClient, func(sessionCtx mongo.SessionContext) (any, error) {
+		clinicianIds := make(bson.A, 0, len(plan.ClinicianPlans))
+		for _, p := range plan.ClinicianPlans {
+			clinicianIds = append(clinicianIds, p.Clinician.Id)
+		}
+		_, err := c.DB.Collection(clinicians.CollectionName).UpdateMany(sessionCtx,
+			bson.M{"clinicId": clinic.Id, "_id": bson.M{"$in": clinicianIds}},
+			bson.M{"$set": bson.M{"clinicId": plan.Source.Id, "updatedTime": time.Now()}},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error moving clinicians back: %w", err)
+		}
+
+		_, err = c.DB.Collection(clinics.CollectionName).UpdateOne(sessionCtx, bson.M{"_id": plan.Source.Id}, bson.M{
+			"$addToSet": bson.M{"admins": bson.M{"$each": splitAdmins(plan)}},
+			"$set":      bson.M{"updatedTime": time.Now()},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error restoring clinic admins: %w", err)
+		}
+
+		return nil, c.ClinicsService.Delete(sessionCtx, clinic.Id.Hex(), deletions.Metadata{})
+	})
+	return err
+}
+
+// splitAdmins returns the user ids of the admins moved to the new clinic
+func splitAdmins(plan SplitPlan) bson.A {
+	admins := bson.A{plan.CreatorUserId}
+	for _, admin := range plan.MovedAdmins() {
+		admins = append(admins, admin)
+	}
+	return admins
+}
+
+// splitClinicians moves the clinicians of the plan to the new clinic with their roles and sites. The record of the
+// creator added when the clinic was created is replaced by the moved record of the creator.
+func (c *ClinicPlanExecutor) splitClinicians(ctx context.Context, plan SplitPlan, clinic clinics.Clinic) error {
+	collection := c.DB.Collection(clinicians.CollectionName)
+	res, err := collection.DeleteOne(ctx, bson.M{"clinicId": clinic.Id, "userId": plan.CreatorUserId})
+	if err != nil {
+		return fmt.Errorf("error removing creator of new clinic: %w", err)
+	}
+	if res.DeletedCount != 1 {
+		return fmt.Errorf("error removing creator of new clinic: unexpected deleted count %v", res.DeletedCount)
+	}
+
+	for _, p := range plan.ClinicianPlans {
+		update := bson.M{
+			"$set": bson.M{
+				"clinicId":    clinic.Id,
+				"updatedTime": time.Now(),
+			},
+		}
+		res, err := collection.UpdateOne(ctx, bson.M{"_id": p.Clinician.Id, "clinicId": plan.Source.Id}, update)
+		if err != nil {
+			return fmt.Errorf("error moving clinician: %w", err)
+		}
+		if res.ModifiedCount != 1 {
+			return fmt.Errorf("error moving clinician: unexpected modified count %v", res.ModifiedCount)
+		}
+	}
+
+	_, err = c.DB.Collection(clinics.CollectionName).UpdateOne(ctx, bson.M{"_id": plan.Source.Id}, bson.M{
+		"$pull": bson.M{"admins": bson.M{"$in": splitAdmins(plan)}},
+		"$set":  bson.M{"updatedTime": time.Now()},
+	})
+	if err != nil {
+		return fmt.Errorf("error updating clinic admins: %w", err)
+	}
+	return nil
+}
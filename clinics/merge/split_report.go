@@ -0,0 +1,158 @@
+package merge
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tealeg/xlsx/v3"
+
+	"github.com/tidepool-org/clinic/pointer"
+)
+
+const (
+	SplitReportSheetPatients   = "Patients Moved"
+	SplitReportSheetClinicians = "Clinicians Moved"
+)
+
+type SplitReport struct {
+	plan SplitPlan
+}
+
+func NewSplitReport(plan SplitPlan) SplitReport {
+	return SplitReport{plan: plan}
+}
+
+func (r SplitReport) Generate() (*xlsx.File, error) {
+	report := xlsx.NewFile()
+
+	components := []func(report *xlsx.File) error{
+		r.addSummarySheet,
+		r.addPatients,
+		r.addClinicians,
+	}
+	for _, fn := range components {
+		if err := fn(report); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, sh := range report.Sheets {
+		sh.SetColWidth(1, 1, 50)
+		for i := 2; i <= sh.MaxCol; i++ {
+			_ = sh.SetColAutoWidth(i, xlsx.DefaultAutoWidth)
+		}
+	}
+
+	return report, nil
+}
+
+func (r SplitReport) addSummarySheet(report *xlsx.File) error {
+	sh, err := report.AddSheet(ReportSheetNameSummary)
+	if err != nil {
+		return err
+	}
+
+	sh.AddRow().AddCell().SetValue("SUMMARY")
+	sh.AddRow()
+
+	var currentRow *xlsx.Row
+	currentRow = sh.AddRow()
+	currentRow.AddCell().SetValue("Report Generated")
+	currentRow.AddCell().SetValue(r.plan.CreatedTime.Format(ReportTimeFormat))
+	sh.AddRow()
+
+	currentRow = sh.AddRow()
+	currentRow.AddCell().SetValue("Splitting from Workspace (Source)")
+	currentRow.AddCell().SetValue(pointer.ToString(r.plan.Source.Name))
+	currentRow = sh.AddRow()
+	currentRow.AddCell().SetValue("Splitting to New Workspace")
+	currentRow.AddCell().SetValue(pointer.ToString(r.plan.Clinic.Name))
+	currentRow = sh.AddRow()
+	currentRow.AddCell().SetValue("Can execute split plan?")
+	if r.plan.PreventsSplit() {
+		currentRow.AddCell().SetValue("No")
+		errs := GetUniqueErrorMessages(r.plan.Errors())
+		currentRow.AddCell().SetValue(fmt.Sprintf("No. %s", strings.Join(errs, "; ")))
+	} else {
+		currentRow.AddCell().SetValue("Yes")
+	}
+	sh.AddRow()
+
+	currentRow = sh.AddRow()
+	currentRow.AddCell().SetValue("Patients moved")
+	currentRow.AddCell().SetValue(strconv.Itoa(len(r.plan.PatientPlans)))
+	currentRow = sh.AddRow()
+	currentRow.AddCell().SetValue("Clinicians moved")
+	currentRow.AddCell().SetValue(strconv.Itoa(len(r.plan.ClinicianPlans)))
+	currentRow = sh.AddRow()
+	currentRow.AddCell().SetValue("Creator of new workspace")
+	currentRow.AddCell().SetValue(r.plan.CreatorUserId)
+	sh.AddRow()
+
+	sh.AddRow().AddCell().SetValue("Tags copied to new workspace ---")
+	for _, tag := range r.plan.Clinic.PatientTags {
+		sh.AddRow().AddCell().SetValue(tag.Name)
+	}
+	sh.AddRow()
+
+	sh.AddRow().AddCell().SetValue("Sites copied to new workspace ---")
+	for _, site := range r.plan.Clinic.Sites {
+		currentRow = sh.AddRow()
+		currentRow.AddCell().SetValue(site.Name)
+		if r.plan.SourceSite != nil && r.plan.SourceSite.Id == site.Id {
+			currentRow.AddCell().SetValue("Selected site, kept in source workspace")
+		}
+	}
+	sh.AddRow()
+
+	return nil
+}
+
+func (r SplitReport) addPatients(report *xlsx.File) error {
+	sh, err := report.AddSheet(SplitReportSheetPatients)
+	if err != nil {
+		return err
+	}
+
+	currentRow := sh.AddRow()
+	currentRow.AddCell().SetValue("Name ---")
+	currentRow.AddCell().SetValue("Claimed ---")
+	currentRow.AddCell().SetValue("UUID ---")
+	currentRow.AddCell().SetValue("DOB ---")
+	currentRow.AddCell().SetValue("MRN ---")
+	currentRow.AddCell().SetValue("Tags ---")
+	currentRow.AddCell().SetValue("Sites ---")
+	currentRow.AddCell().SetValue("Latest Upload ---")
+	sh.AddRow()
+
+	for _, plan := range r.plan.PatientPlans {
+		addPatientDetails(sh.AddRow(), plan.Patient, plan.TagNames, plan.SiteNames)
+	}
+	return nil
+}
+
+func (r SplitReport) addClinicians(report *xlsx.File) error {
+	sh, err := report.AddSheet(SplitReportSheetClinicians)
+	if err != nil {
+		return err
+	}
+
+	currentRow := sh.AddRow()
+	currentRow.AddCell().SetValue("Name ---")
+	currentRow.AddCell().SetValue("Email ---")
+	currentRow.AddCell().SetValue("UUID ---")
+	currentRow.AddCell().SetValue("Roles ---")
+	currentRow.AddCell().SetValue("Sites ---")
+	sh.AddRow()
+
+	for _, plan := range r.plan.ClinicianPlans {
+		currentRow = sh.AddRow()
+		currentRow.AddCell().SetValue(pointer.ToString(plan.Clinician.Name))
+		currentRow.AddCell().SetValue(pointer.ToString(plan.Clinician.Email))
+		currentRow.AddCell().SetValue(pointer.ToString(plan.Clinician.UserId))
+		currentRow.AddCell().SetValue(strings.Join(plan.Clinician.Roles, ", "))
+		currentRow.AddCell().SetValue(strings.Join(plan.SiteNames, ", "))
+	}
+	return nil
+}
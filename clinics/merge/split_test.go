@@ -0,0 +1,169 @@
+package merge_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/mock/gomock"
+
+	"github.com/tidepool-org/clinic/clinicians"
+	cliniciansTest "github.com/tidepool-org/clinic/clinicians/test"
+	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/clinics/merge"
+	clinicsTest "github.com/tidepool-org/clinic/clinics/test"
+	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+	patientsTest "github.com/tidepool-org/clinic/patients/test"
+	"github.com/tidepool-org/clinic/sites"
+)
+
+var _ = Describe("Split Planner", func() {
+	var source *clinics.Clinic
+	var sourcePatients []*patients.Patient
+	var sourceClinicians []*clinicians.Clinician
+	var clinicsService *clinicsTest.MockService
+	var patientsService *patientsTest.MockService
+	var cliniciansService *cliniciansTest.MockService
+
+	newClinician := func(role string) *clinicians.Clinician {
+		clinician := cliniciansTest.RandomClinician()
+		clinician.ClinicId = source.Id
+		clinician.Roles = []string{role}
+		return clinician
+	}
+
+	plan := func(selection merge.SplitSelection) (merge.SplitPlan, error) {
+		planner := merge.NewSplitPlanner(clinicsService, patientsService, cliniciansService, source.Id.Hex(), "New Clinic", selection)
+		return planner.Plan(context.Background())
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		clinicsService = clinicsTest.NewMockService(ctrl)
+		patientsService = patientsTest.NewMockService(ctrl)
+		cliniciansService = cliniciansTest.NewMockService(ctrl)
+
+		source = clinicsTest.RandomClinic()
+		sourcePatients = make([]*patients.Patient, 4)
+		for i := range sourcePatients {
+			patient := patientsTest.RandomPatient()
+			patient.ClinicId = source.Id
+			patient.Tags = &[]primitive.ObjectID{}
+			patient.Sites = &[]sites.Site{}
+			sourcePatients[i] = &patient
+		}
+		// The first two patients are assigned to the first site, the third one has the first tag and the last one has
+		// the second tag and the second site
+		*sourcePatients[0].Sites = []sites.Site{source.Sites[0]}
+		*sourcePatients[1].Sites = []sites.Site{source.Sites[0]}
+		*sourcePatients[2].Tags = []primitive.ObjectID{*source.PatientTags[0].Id}
+		*sourcePatients[3].Tags = []primitive.ObjectID{*source.PatientTags[1].Id}
+		*sourcePatients[3].Sites = []sites.Site{source.Sites[1]}
+
+		sourceClinicians = []*clinicians.Clinician{
+			newClinician(clinicians.RoleClinicAdmin),
+			newClinician(clinicians.RoleClinicAdmin),
+			newClinician(clinicians.RoleClinicMember),
+		}
+
+		clinicsService.EXPECT().Get(gomock.Any(), source.Id.Hex()).Return(source, nil).AnyTimes()
+		patientsService.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&patients.ListResult{
+			Patients:      sourcePatients,
+			MatchingCount: len(sourcePatients),
+		}, nil).AnyTimes()
+		cliniciansService.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(sourceClinicians, nil).AnyTimes()
+	})
+
+	It("selects the patients of the site", func() {
+		siteId := source.Sites[0].Id.Hex()
+		result, err := plan(merge.SplitSelection{SiteId: &siteId, ClinicianIds: []string{*sourceClinicians[0].UserId}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.PatientPlans).To(HaveLen(2))
+		Expect(result.PatientPlans[0].Patient.UserId).To(Equal(sourcePatients[0].UserId))
+		Expect(result.PatientPlans[1].Patient.UserId).To(Equal(sourcePatients[1].UserId))
+		Expect(result.SourceSite).ToNot(BeNil())
+		Expect(result.SourceSite.Id).To(Equal(source.Sites[0].Id))
+	})
+
+	It("selects the union of the patients of the site, the tag and the list", func() {
+		siteId := source.Sites[0].Id.Hex()
+		tagId := source.PatientTags[0].Id.Hex()
+		result, err := plan(merge.SplitSelection{
+			SiteId:       &siteId,
+			TagId:        &tagId,
+			PatientIds:   []string{*sourcePatients[0].UserId, *sourcePatients[3].UserId},
+			ClinicianIds: []string{*sourceClinicians[0].UserId},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.PatientPlans).To(HaveLen(4))
+	})
+
+	It("copies the profile, the settings and the used tags and sites to the new clinic", func() {
+		tagId := source.PatientTags[1].Id.Hex()
+		result, err := plan(merge.SplitSelection{TagId: &tagId, ClinicianIds: []string{*sourceClinicians[0].UserId}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Clinic.Id).To(BeNil())
+		Expect(result.Clinic.Name).To(HaveValue(Equal("New Clinic")))
+		Expect(result.Clinic.Address).To(Equal(source.Address))
+		Expect(result.Clinic.Timezone).To(Equal(source.Timezone))
+		Expect(result.Clinic.EHRSettings).To(BeNil())
+		Expect(result.Clinic.PatientTags).To(ConsistOf(clinics.PatientTag{Id: source.PatientTags[1].Id, Name: source.PatientTags[1].Name}))
+		Expect(result.Clinic.Sites).To(ConsistOf(sites.Site{Id: source.Sites[1].Id, Name: source.Sites[1].Name}))
+		Expect(result.SourceSite).To(BeNil())
+	})
+
+	It("makes the first selected admin the creator of the new clinic", func() {
+		result, err := plan(merge.SplitSelection{
+			PatientIds:   []string{*sourcePatients[0].UserId},
+			ClinicianIds: []string{*sourceClinicians[2].UserId, *sourceClinicians[1].UserId},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.PreventsSplit()).To(BeFalse())
+		Expect(result.CreatorUserId).To(Equal(*sourceClinicians[1].UserId))
+		Expect(result.ClinicianPlans).To(HaveLen(2))
+		Expect(result.MovedAdmins()).To(BeEmpty())
+	})
+
+	It("prevents the split when no admin is moved to the new clinic", func() {
+		result, err := plan(merge.SplitSelection{
+			PatientIds:   []string{*sourcePatients[0].UserId},
+			ClinicianIds: []string{*sourceClinicians[2].UserId},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.PreventsSplit()).To(BeTrue())
+		Expect(result.Errors()).To(ConsistOf(merge.ErrorSplitWithoutAdmin))
+	})
+
+	It("prevents the split when all admins are moved to the new clinic", func() {
+		result, err := plan(merge.SplitSelection{
+			PatientIds:   []string{*sourcePatients[0].UserId},
+			ClinicianIds: []string{*sourceClinicians[0].UserId, *sourceClinicians[1].UserId},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.PreventsSplit()).To(BeTrue())
+		Expect(result.Errors()).To(ConsistOf(merge.ErrorSplitRemovesAllAdminsOfSource))
+	})
+
+	It("returns an error when a patient is not found", func() {
+		_, err := plan(merge.SplitSelection{
+			PatientIds:   []string{"unknown"},
+			ClinicianIds: []string{*sourceClinicians[0].UserId},
+		})
+		Expect(err).To(MatchError(errs.BadRequest))
+	})
+
+	It("returns an error when a clinician is not found", func() {
+		_, err := plan(merge.SplitSelection{
+			PatientIds:   []string{*sourcePatients[0].UserId},
+			ClinicianIds: []string{"unknown"},
+		})
+		Expect(err).To(MatchError(errs.BadRequest))
+	})
+
+	It("returns an error when no patients are selected", func() {
+		_, err := plan(merge.SplitSelection{ClinicianIds: []string{*sourceClinicians[0].UserId}})
+		Expect(err).To(MatchError(errs.BadRequest))
+	})
+})
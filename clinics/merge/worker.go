@@ -0,0 +1,101 @@
+package merge
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/store"
+)
+
+// Worker executes the scheduled clinic merges and resumes the merges which were interrupted
+type Worker struct {
+	config   Config
+	executor ClinicPlanExecutor
+	logger   *zap.SugaredLogger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewWorker(config Config, executor ClinicPlanExecutor, logger *zap.SugaredLogger, lifecycle fx.Lifecycle) *Worker {
+	ctx, cancel := context.WithCancel(context.Background())
+	worker := &Worker{
+		config:   config,
+		executor: executor,
+		logger:   logger,
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			if err := executor.InitializeJobs(ctx); err != nil {
+				return err
+			}
+			go worker.run()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			// Interrupted merges are resumed by another instance after the lease expires
+			worker.cancel()
+			select {
+			case <-worker.done:
+			case <-ctx.Done():
+			}
+			return nil
+		},
+	})
+
+	return worker
+}
+
+func (w *Worker) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.config.WorkerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+			w.ExecuteDueJobs()
+		}
+	}
+}
+
+// ExecuteDueJobs executes all merge jobs which are due
+func (w *Worker) ExecuteDueJobs() {
+	for w.ctx.Err() == nil {
+		if !w.executeNextJob() {
+			return
+		}
+	}
+}
+
+func (w *Worker) executeNextJob() bool {
+	ctx, cancel := context.WithTimeout(w.ctx, store.ContextTimeout)
+	job, err := w.executor.ClaimJob(ctx)
+	cancel()
+	if errors.Is(err, errs.NotFound) {
+		return false
+	} else if err != nil {
+		w.logger.Errorw("unable to claim clinic merge job", "error", err)
+		return false
+	}
+
+	w.logger.Infow("executing clinic merge", "planId", job.Id.Hex(), "attempt", job.Attempts)
+	if err := w.executor.Resume(w.ctx, job.Id); err != nil {
+		w.logger.Errorw("unable to execute clinic merge", "planId", job.Id.Hex(), "error", err)
+	}
+
+	return true
+}
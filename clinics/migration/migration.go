@@ -3,9 +3,10 @@ package migration
 import (
 	"context"
 	"fmt"
+	"time"
+
 	"github.com/tidepool-org/clinic/store"
 	"go.mongodb.org/mongo-driver/mongo"
-	"time"
 
 	"github.com/tidepool-org/clinic/clinicians"
 	"github.com/tidepool-org/clinic/clinics"
@@ -16,19 +17,76 @@ import (
 	"go.uber.org/fx"
 )
 
+// Statuses of a migration. A migration is created as pending, becomes running when the
+// patients are being migrated, and ends as completed or failed. Failed migrations can be
+// retried, which replaces them with a new pending migration.
 const (
-	StatusPending = "PENDING"
+	StatusPending   = "PENDING"
+	StatusRunning   = "RUNNING"
+	StatusCompleted = "COMPLETED"
+	StatusFailed    = "FAILED"
 )
 
+// transitions maps each status to the statuses from which a migration can transition to it.
+// Running migrations can be updated while running to report their progress.
+var transitions = map[string][]string{
+	StatusPending:   {StatusFailed},
+	StatusRunning:   {StatusPending, StatusRunning},
+	StatusCompleted: {StatusRunning},
+	StatusFailed:    {StatusPending, StatusRunning},
+}
+
+// NonTerminalStatuses are the statuses of migrations which haven't completed or failed yet
+var NonTerminalStatuses = []string{StatusPending, StatusRunning}
+
 var ErrAlreadyMigrated = fmt.Errorf("%w: clinic is already migrated", internalErrs.ConstraintViolation)
 var ErrIncompleteClinicProfile = fmt.Errorf("%w: incomplete clinic profile", internalErrs.ConstraintViolation)
+var ErrInvalidStatus = fmt.Errorf("%w: invalid migration status", internalErrs.BadRequest)
+var ErrInvalidStatusTransition = fmt.Errorf("%w: invalid migration status transition", internalErrs.ConstraintViolation)
 
 type Migration struct {
-	ClinicId    *primitive.ObjectID `json:"clinicId" bson:"clinicId"`
-	UserId      string              `json:"userId" bson:"userId"`
-	Status      string              `json:"status" bson:"status"`
-	CreatedTime time.Time           `json:"createdTime" bson:"createdTime"`
-	UpdatedTime time.Time           `json:"updatedTime" bson:"updatedTime"`
+	ClinicId *primitive.ObjectID `json:"clinicId" bson:"clinicId"`
+	UserId   string              `json:"userId" bson:"userId"`
+	Status   string              `json:"status" bson:"status"`
+	// Error is the reason of the failure of a failed migration
+	Error            string    `json:"error,omitempty" bson:"error,omitempty"`
+	PatientsMigrated int       `json:"patientsMigrated" bson:"patientsMigrated"`
+	PatientsFailed   int       `json:"patientsFailed" bson:"patientsFailed"`
+	CreatedTime      time.Time `json:"createdTime" bson:"createdTime"`
+	UpdatedTime      time.Time `json:"updatedTime" bson:"updatedTime"`
+}
+
+// Update of the status and the progress of a migration. Counts which are nil are not updated.
+type Update struct {
+	Status           string
+	Error            string
+	PatientsMigrated *int
+	PatientsFailed   *int
+}
+
+// Validate checks that the status is known and that failed migrations have an error
+func (u Update) Validate() error {
+	if _, ok := transitions[u.Status]; !ok {
+		return fmt.Errorf("%w: %s", ErrInvalidStatus, u.Status)
+	}
+	if u.Status == StatusPending {
+		return fmt.Errorf("%w: failed migrations can only be retried", ErrInvalidStatusTransition)
+	}
+	if u.Status == StatusFailed && u.Error == "" {
+		return fmt.Errorf("%w: the error of a failed migration is required", internalErrs.BadRequest)
+	}
+	if u.Status != StatusFailed && u.Error != "" {
+		return fmt.Errorf("%w: only failed migrations have an error", internalErrs.BadRequest)
+	}
+	if (u.PatientsMigrated != nil && *u.PatientsMigrated < 0) || (u.PatientsFailed != nil && *u.PatientsFailed < 0) {
+		return fmt.Errorf("%w: patient counts can't be negative", internalErrs.BadRequest)
+	}
+	return nil
+}
+
+// PreviousStatuses returns the statuses from which a migration can transition to the status
+func PreviousStatuses(status string) []string {
+	return transitions[status]
 }
 
 func NewMigration(clinicId, userId string) *Migration {
@@ -52,7 +110,9 @@ type Migrator interface {
 	ListMigrations(ctx context.Context, clinicId string) ([]*Migration, error)
 	MigrateLegacyClinicianPatients(ctx context.Context, clinicId, userId string) (*Migration, error)
 	TriggerInitialMigration(ctx context.Context, clinicId string) (*Migration, error)
-	UpdateMigrationStatus(ctx context.Context, clinicId, userId, status string) (*Migration, error)
+	UpdateMigration(ctx context.Context, clinicId, userId string, update Update) (*Migration, error)
+	RetryMigration(ctx context.Context, clinicId, userId string) (*Migration, error)
+	ListIncompleteMigrations(ctx context.Context, updatedBefore *time.Time, pagination store.Pagination) ([]*Migration, error)
 }
 
 type Params struct {
@@ -167,8 +227,29 @@ func (m *migrator) GetMigration(ctx context.Context, clinicId string, userId str
 	return m.migrationRepo.Get(ctx, clinicId, userId)
 }
 
-func (m *migrator) UpdateMigrationStatus(ctx context.Context, clinicId, userId, status string) (*Migration, error) {
-	return m.migrationRepo.UpdateStatus(ctx, clinicId, userId, status)
+func (m *migrator) UpdateMigration(ctx context.Context, clinicId, userId string, update Update) (*Migration, error) {
+	if err := update.Validate(); err != nil {
+		return nil, err
+	}
+	return m.migrationRepo.Update(ctx, clinicId, userId, update)
+}
+
+// RetryMigration replaces a failed migration with a new pending one, which triggers the migration again
+func (m *migrator) RetryMigration(ctx context.Context, clinicId, userId string) (*Migration, error) {
+	result, err := store.WithTransaction(ctx, m.dbClient, func(sessionContext mongo.SessionContext) (any, error) {
+		return m.migrationRepo.Recreate(sessionContext, clinicId, userId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*Migration), nil
+}
+
+// ListIncompleteMigrations returns the pending and running migrations of all clinics which
+// haven't been updated since updatedBefore, the least recently updated first.
+func (m *migrator) ListIncompleteMigrations(ctx context.Context, updatedBefore *time.Time, pagination store.Pagination) ([]*Migration, error) {
+	return m.migrationRepo.ListByStatus(ctx, NonTerminalStatuses, updatedBefore, pagination)
 }
 
 func (m *migrator) assertUserIsClinician(userId string) error {
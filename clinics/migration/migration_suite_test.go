@@ -0,0 +1,17 @@
+package migration_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+
+	dbTest "github.com/tidepool-org/clinic/store/test"
+	"github.com/tidepool-org/clinic/test"
+)
+
+func TestSuite(t *testing.T) {
+	test.Test(t)
+}
+
+var _ = BeforeSuite(dbTest.SetupDatabase)
+var _ = AfterSuite(dbTest.TeardownDatabase)
@@ -0,0 +1,61 @@
+package migration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tidepool-org/clinic/clinics/migration"
+	errs "github.com/tidepool-org/clinic/errors"
+)
+
+var _ = Describe("Migration", func() {
+	Describe("Update", func() {
+		It("accepts the progress of a running migration", func() {
+			migrated := 10
+			update := migration.Update{Status: migration.StatusRunning, PatientsMigrated: &migrated}
+			Expect(update.Validate()).To(Succeed())
+		})
+
+		It("rejects pending migrations", func() {
+			update := migration.Update{Status: migration.StatusPending}
+			Expect(update.Validate()).To(MatchError(migration.ErrInvalidStatusTransition))
+		})
+
+		It("rejects unknown statuses", func() {
+			update := migration.Update{Status: "paused"}
+			Expect(update.Validate()).To(MatchError(migration.ErrInvalidStatus))
+		})
+
+		It("requires the error of failed migrations", func() {
+			update := migration.Update{Status: migration.StatusFailed}
+			Expect(update.Validate()).To(MatchError(errs.BadRequest))
+		})
+
+		It("rejects errors of migrations which haven't failed", func() {
+			update := migration.Update{Status: migration.StatusCompleted, Error: "timeout"}
+			Expect(update.Validate()).To(MatchError(errs.BadRequest))
+		})
+
+		It("rejects negative counts", func() {
+			failed := -1
+			update := migration.Update{Status: migration.StatusRunning, PatientsFailed: &failed}
+			Expect(update.Validate()).To(MatchError(errs.BadRequest))
+		})
+	})
+
+	Describe("PreviousStatuses", func() {
+		It("only completes running migrations", func() {
+			Expect(migration.PreviousStatuses(migration.StatusCompleted)).To(ConsistOf(migration.StatusRunning))
+		})
+
+		It("only retries failed migrations", func() {
+			Expect(migration.PreviousStatuses(migration.StatusPending)).To(ConsistOf(migration.StatusFailed))
+		})
+
+		It("never transitions from completed migrations", func() {
+			for _, status := range []string{migration.StatusPending, migration.StatusRunning, migration.StatusCompleted, migration.StatusFailed} {
+				Expect(migration.PreviousStatuses(status)).ToNot(ContainElement(migration.StatusCompleted))
+			}
+		})
+	})
+})
@@ -4,13 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
+
 	internalErrs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/store"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/fx"
-	"time"
 )
 
 const (
@@ -26,7 +28,13 @@ type Repository interface {
 	Get(ctx context.Context, clinicId, userId string) (*Migration, error)
 	List(ctx context.Context, clinicId string) ([]*Migration, error)
 	Create(ctx context.Context, migration *Migration) (*Migration, error)
-	UpdateStatus(ctx context.Context, clinicId, userId, status string) (*Migration, error)
+	// Update transitions the migration to the status of the update. It fails when the
+	// migration can't transition from its current status.
+	Update(ctx context.Context, clinicId, userId string, update Update) (*Migration, error)
+	// Recreate replaces a failed migration with a new pending one. Migrations are triggered
+	// by the insertion of their document, so the new document triggers the migration again.
+	Recreate(ctx context.Context, clinicId, userId string) (*Migration, error)
+	ListByStatus(ctx context.Context, statuses []string, updatedBefore *time.Time, pagination store.Pagination) ([]*Migration, error)
 }
 
 func NewRepository(db *mongo.Database, lifecycle fx.Lifecycle) (Repository, error) {
@@ -58,6 +66,15 @@ func (r *repository) Initialize(ctx context.Context) error {
 				SetUnique(true).
 				SetName("UniqueClinicianMigration"),
 		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "updatedTime", Value: 1},
+			},
+			Options: options.Index().
+				SetBackground(true).
+				SetName("MigrationsByStatus"),
+		},
 	})
 	return err
 }
@@ -103,25 +120,101 @@ func (r *repository) Create(ctx context.Context, migration *Migration) (*Migrati
 	})
 }
 
-func (r *repository) UpdateStatus(ctx context.Context, clinicId, userId, status string) (*Migration, error) {
+func (r *repository) Update(ctx context.Context, clinicId, userId string, update Update) (*Migration, error) {
+	previous := PreviousStatuses(update.Status)
+	if len(previous) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidStatus, update.Status)
+	}
+
 	selector := migrationSelector(clinicId, userId)
-	update := bson.M{
-		"$set": bson.M{
-			"status":      status,
-			"updatedTime": time.Now(),
-		},
+	set := bson.M{
+		"status":      update.Status,
+		"updatedTime": time.Now(),
+	}
+	if update.PatientsMigrated != nil {
+		set["patientsMigrated"] = *update.PatientsMigrated
+	}
+	if update.PatientsFailed != nil {
+		set["patientsFailed"] = *update.PatientsFailed
+	}
+	if update.Error != "" {
+		set["error"] = update.Error
+	}
+	mongoUpdate := bson.M{"$set": set}
+	if update.Error == "" {
+		mongoUpdate["$unset"] = bson.M{"error": ""}
 	}
 
-	res, err := r.collection.UpdateOne(ctx, selector, update)
+	// The transition is enforced atomically by only matching the migration in one of the
+	// statuses it can transition from
+	conditional := migrationSelector(clinicId, userId)
+	conditional["status"] = bson.M{"$in": previous}
+	res, err := r.collection.UpdateOne(ctx, conditional, mongoUpdate)
 	if err != nil {
 		return nil, err
-	} else if res.ModifiedCount == 0 {
-		return nil, ErrNotFound
+	}
+	if res.MatchedCount == 0 {
+		current, err := r.get(ctx, selector)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: from %s to %s", ErrInvalidStatusTransition, current.Status, update.Status)
 	}
 
 	return r.get(ctx, selector)
 }
 
+func (r *repository) Recreate(ctx context.Context, clinicId, userId string) (*Migration, error) {
+	selector := migrationSelector(clinicId, userId)
+	conditional := migrationSelector(clinicId, userId)
+	conditional["status"] = bson.M{"$in": PreviousStatuses(StatusPending)}
+
+	deleted := &Migration{}
+	err := r.collection.FindOneAndDelete(ctx, conditional).Decode(deleted)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		current, err := r.get(ctx, selector)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: from %s to %s", ErrInvalidStatusTransition, current.Status, StatusPending)
+	} else if err != nil {
+		return nil, err
+	}
+
+	migration := NewMigration(clinicId, userId)
+	migration.CreatedTime = deleted.CreatedTime
+	if _, err := r.collection.InsertOne(ctx, migration); err != nil {
+		return nil, err
+	}
+
+	return r.get(ctx, selector)
+}
+
+func (r *repository) ListByStatus(ctx context.Context, statuses []string, updatedBefore *time.Time, pagination store.Pagination) ([]*Migration, error) {
+	selector := bson.M{
+		"status": bson.M{"$in": statuses},
+	}
+	if updatedBefore != nil {
+		selector["updatedTime"] = bson.M{"$lt": *updatedBefore}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "updatedTime", Value: 1}, {Key: "_id", Value: 1}}).
+		SetSkip(int64(pagination.Offset)).
+		SetLimit(int64(pagination.Limit))
+
+	cursor, err := r.collection.Find(ctx, selector, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error listing migrations: %w", err)
+	}
+
+	migrations := []*Migration{}
+	if err = cursor.All(ctx, &migrations); err != nil {
+		return nil, fmt.Errorf("error decoding migrations: %w", err)
+	}
+
+	return migrations, nil
+}
+
 func (r *repository) get(ctx context.Context, selector bson.M) (*Migration, error) {
 	result := &Migration{}
 	err := r.collection.FindOne(ctx, selector).Decode(result)
@@ -0,0 +1,82 @@
+package migration_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/fx/fxtest"
+
+	"github.com/tidepool-org/clinic/clinics/migration"
+	dbTest "github.com/tidepool-org/clinic/store/test"
+	"github.com/tidepool-org/clinic/test"
+)
+
+var _ = Describe("Migrations Repository", func() {
+	var repo migration.Repository
+	var collection *mongo.Collection
+	var clinicId string
+	var userId string
+
+	BeforeEach(func() {
+		var err error
+		database := dbTest.GetTestDatabase()
+		collection = database.Collection("migrations")
+		lifecycle := fxtest.NewLifecycle(GinkgoT())
+		repo, err = migration.NewRepository(database, lifecycle)
+		Expect(err).ToNot(HaveOccurred())
+		lifecycle.RequireStart()
+
+		clinicId = primitive.NewObjectID().Hex()
+		userId = test.Faker.UUID().V4()
+		_, err = repo.Create(context.Background(), migration.NewMigration(clinicId, userId))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	documentId := func() primitive.ObjectID {
+		var document struct {
+			Id primitive.ObjectID `bson:"_id"`
+		}
+		Expect(collection.FindOne(context.Background(), bson.M{"userId": userId}).Decode(&document)).To(Succeed())
+		return document.Id
+	}
+
+	Describe("Recreate", func() {
+		It("replaces a failed migration with a new pending migration", func() {
+			migrated := 10
+			failed := 2
+			_, err := repo.Update(context.Background(), clinicId, userId, migration.Update{Status: migration.StatusRunning, PatientsMigrated: &migrated, PatientsFailed: &failed})
+			Expect(err).ToNot(HaveOccurred())
+			previous, err := repo.Update(context.Background(), clinicId, userId, migration.Update{Status: migration.StatusFailed, Error: "timeout"})
+			Expect(err).ToNot(HaveOccurred())
+			previousId := documentId()
+
+			result, err := repo.Recreate(context.Background(), clinicId, userId)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(migration.StatusPending))
+			Expect(result.Error).To(BeEmpty())
+			Expect(result.PatientsMigrated).To(BeZero())
+			Expect(result.PatientsFailed).To(BeZero())
+			Expect(result.CreatedTime).To(BeTemporally("==", previous.CreatedTime))
+
+			Expect(documentId()).ToNot(Equal(previousId))
+			Expect(collection.CountDocuments(context.Background(), bson.M{"userId": userId})).To(BeEquivalentTo(1))
+		})
+
+		It("doesn't recreate migrations which didn't fail", func() {
+			previousId := documentId()
+
+			_, err := repo.Recreate(context.Background(), clinicId, userId)
+			Expect(err).To(MatchError(migration.ErrInvalidStatusTransition))
+			Expect(documentId()).To(Equal(previousId))
+		})
+
+		It("returns not found for unknown migrations", func() {
+			_, err := repo.Recreate(context.Background(), clinicId, test.Faker.UUID().V4())
+			Expect(err).To(MatchError(migration.ErrNotFound))
+		})
+	})
+})
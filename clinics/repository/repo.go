@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 
+	"github.com/tidepool-org/clinic/clinicians"
 	"github.com/tidepool-org/clinic/clinics"
 	"github.com/tidepool-org/clinic/deletions"
 	"github.com/tidepool-org/clinic/sites"
@@ -115,10 +117,98 @@ func (r *repository) annotateClinics(ctx context.Context, match bson.M) (
 	if err := cursor.All(ctx, &clinicsList); err != nil {
 		return nil, err
 	}
+	if err := r.rollUpSitePatients(ctx, clinicsList); err != nil {
+		return nil, err
+	}
 
 	return clinicsList, nil
 }
 
+// rollUpSitePatients sets the total patients of the sites of the clinics, which include the
+// patients assigned to the descendants of the sites.
+func (r *repository) rollUpSitePatients(ctx context.Context, clinicsList []*clinics.Clinic) error {
+	clinicIds := make(bson.A, 0, len(clinicsList))
+	for _, clinic := range clinicsList {
+		if clinic != nil && clinic.Id != nil && len(clinic.Sites) > 0 {
+			clinicIds = append(clinicIds, *clinic.Id)
+		}
+	}
+	if len(clinicIds) == 0 {
+		return nil
+	}
+
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{
+			"clinicId": bson.M{"$in": clinicIds},
+			"sites.0":  bson.M{"$exists": true},
+		}},
+		// The patient count of each combination of assigned sites
+		bson.M{"$group": bson.M{
+			"_id":      bson.M{"clinicId": "$clinicId", "siteIds": "$sites.id"},
+			"patients": bson.M{"$sum": 1},
+		}},
+	}
+	cursor, err := r.collection.Database().Collection("patients").Aggregate(ctx, pipeline)
+	if err != nil {
+		return fmt.Errorf("unable to count site patients: %w", err)
+	}
+	var results []struct {
+		Id struct {
+			ClinicId primitive.ObjectID   `bson:"clinicId"`
+			SiteIds  []primitive.ObjectID `bson:"siteIds"`
+		} `bson:"_id"`
+		Patients int `bson:"patients"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return fmt.Errorf("unable to decode site patients: %w", err)
+	}
+
+	assignments := map[primitive.ObjectID][]sites.Assignment{}
+	for _, result := range results {
+		assignments[result.Id.ClinicId] = append(assignments[result.Id.ClinicId], sites.Assignment{
+			SiteIds:  result.Id.SiteIds,
+			Patients: result.Patients,
+		})
+	}
+	for _, clinic := range clinicsList {
+		if clinic == nil || clinic.Id == nil {
+			continue
+		}
+		totals := sites.NewHierarchy(clinic.Sites).RollUp(assignments[*clinic.Id])
+		for i := range clinic.Sites {
+			clinic.Sites[i].TotalPatients = totals[clinic.Sites[i].Id]
+		}
+	}
+	return nil
+}
+
+// ListSites returns the sites of the clinic without the patient counts.
+func (r *repository) ListSites(ctx context.Context, clinicId string) ([]sites.Site, error) {
+	clinicOID, err := primitive.ObjectIDFromHex(clinicId)
+	if err != nil {
+		return nil, clinics.ErrNotFound
+	}
+	clinic, err := r.getSites(ctx, clinicOID)
+	if err != nil {
+		return nil, err
+	}
+	return clinic.Sites, nil
+}
+
+// getSites returns the clinic with only its sites and the time of its last update, which
+// can be used to update the sites conditionally.
+func (r *repository) getSites(ctx context.Context, clinicId primitive.ObjectID) (*clinics.Clinic, error) {
+	opts := options.FindOne().SetProjection(bson.M{"sites": 1, "updatedTime": 1})
+	clinic := &clinics.Clinic{}
+	err := r.collection.FindOne(ctx, bson.M{"_id": clinicId}, opts).Decode(clinic)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, clinics.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return clinic, nil
+}
+
 func (r *repository) annotateClinic(ctx context.Context, clinicId primitive.ObjectID) (
 	*clinics.Clinic, error) {
 
@@ -621,6 +711,44 @@ func (r *repository) UpdateMembershipRestrictions(ctx context.Context, id string
 	return err
 }
 
+func (r *repository) UpdateAccessRestrictions(ctx context.Context, id string, restrictions *clinics.AccessRestrictions) error {
+	clinicId, _ := primitive.ObjectIDFromHex(id)
+	selector := bson.M{"_id": clinicId}
+
+	update := bson.M{
+		"$set": bson.M{
+			"updatedTime":        time.Now(),
+			"accessRestrictions": restrictions,
+		},
+	}
+
+	err := r.collection.FindOneAndUpdate(ctx, selector, update).Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return clinics.ErrNotFound
+	}
+
+	return err
+}
+
+func (r *repository) UpdateClinicianRoles(ctx context.Context, id string, roles []clinicians.Role) error {
+	clinicId, _ := primitive.ObjectIDFromHex(id)
+	selector := bson.M{"_id": clinicId}
+
+	update := bson.M{
+		"$set": bson.M{
+			"updatedTime":    time.Now(),
+			"clinicianRoles": roles,
+		},
+	}
+
+	err := r.collection.FindOneAndUpdate(ctx, selector, update).Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return clinics.ErrNotFound
+	}
+
+	return err
+}
+
 func (r *repository) UpdateEHRSettings(ctx context.Context, id string, settings *clinics.EHRSettings) error {
 	clinicId, _ := primitive.ObjectIDFromHex(id)
 	selector := bson.M{"_id": clinicId}
@@ -727,6 +855,10 @@ func (c *repository) CreateSite(ctx context.Context, clinicId string, site *site
 	if err := c.maintainSitesConstraintsOnCreate(ctx, clinicId, site.Name); err != nil {
 		return nil, err
 	}
+	updatedTime, err := c.maintainSitesHierarchy(ctx, clinicId, site.Id, site.ParentId)
+	if err != nil {
+		return nil, err
+	}
 	id, err := primitive.ObjectIDFromHex(clinicId)
 	if err != nil {
 		return nil, err
@@ -735,6 +867,9 @@ func (c *repository) CreateSite(ctx context.Context, clinicId string, site *site
 		site.Id = primitive.NewObjectID()
 	}
 	filter := bson.M{"_id": id}
+	if updatedTime != nil {
+		filter["updatedTime"] = *updatedTime
+	}
 	update := bson.M{
 		"$push":        bson.M{"sites": site},
 		"$currentDate": bson.M{"updatedTime": true},
@@ -743,6 +878,9 @@ func (c *repository) CreateSite(ctx context.Context, clinicId string, site *site
 	res := c.collection.FindOneAndUpdate(ctx, filter, update, opts)
 	if err := res.Err(); err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
+			if updatedTime != nil {
+				return nil, clinics.ErrSitesUpdatedConcurrently
+			}
 			return nil, clinics.ErrNotFound
 		}
 		return nil, err
@@ -807,6 +945,8 @@ func (c *repository) CreateSiteIgnoringLimit(ctx context.Context, clinicId strin
 	return nil, fmt.Errorf("unable to find newly created site %+v %s %+v", clinic.Sites, site.Name, clinic)
 }
 
+// DeleteSite moves the children of the site to its parent and removes the site. It should be run in a transaction,
+// because the sites are updated separately.
 func (c *repository) DeleteSite(ctx context.Context, clinicId, siteId string) error {
 	clinicOID, err := primitive.ObjectIDFromHex(clinicId)
 	if err != nil {
@@ -816,6 +956,19 @@ func (c *repository) DeleteSite(ctx context.Context, clinicId, siteId string) er
 	if err != nil {
 		return err
 	}
+	clinic, err := c.getSites(ctx, clinicOID)
+	if err != nil {
+		return err
+	}
+	// The children of the site are moved to its parent
+	index := slices.IndexFunc(clinic.Sites, func(site sites.Site) bool { return site.Id == siteOID })
+	if index == -1 {
+		return clinics.ErrNotFound
+	}
+	if err := c.reparentSites(ctx, *clinic, siteOID, clinic.Sites[index].ParentId); err != nil {
+		return err
+	}
+
 	selector := bson.M{
 		"_id":      clinicOID,
 		"sites.id": siteOID,
@@ -866,6 +1019,176 @@ func (c *repository) UpdateSite(ctx context.Context,
 	return c.annotateClinicSite(ctx, clinicOID, siteOID)
 }
 
+// UpdateSiteParent moves the site under the parent, or to the root of the hierarchy when
+// the parent is nil.
+func (c *repository) UpdateSiteParent(ctx context.Context,
+	clinicId, siteId string, parentId *string) (*sites.Site, error) {
+
+	clinicOID, err := primitive.ObjectIDFromHex(clinicId)
+	if err != nil {
+		return nil, err
+	}
+	siteOID, err := primitive.ObjectIDFromHex(siteId)
+	if err != nil {
+		return nil, err
+	}
+	var parentOID *primitive.ObjectID
+	if parentId != nil {
+		oid, err := primitive.ObjectIDFromHex(*parentId)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", clinics.ErrInvalidSiteParent, sites.ErrParentNotFound)
+		}
+		parentOID = &oid
+	}
+	updatedTime, err := c.maintainSitesHierarchy(ctx, clinicId, siteOID, parentOID)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := bson.M{
+		"_id":      clinicOID,
+		"sites.id": siteOID,
+	}
+	if updatedTime != nil {
+		selector["updatedTime"] = *updatedTime
+	}
+	update := bson.M{
+		"$currentDate": bson.M{"updatedTime": true},
+	}
+	if parentOID != nil {
+		update["$set"] = bson.M{"sites.$.parentId": parentOID}
+	} else {
+		update["$unset"] = bson.M{"sites.$.parentId": ""}
+	}
+	res, err := c.collection.UpdateOne(ctx, selector, update)
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount != 1 {
+		if updatedTime != nil {
+			return nil, clinics.ErrSitesUpdatedConcurrently
+		}
+		return nil, clinics.ErrNotFound
+	}
+
+	return c.annotateClinicSite(ctx, clinicOID, siteOID)
+}
+
+// UpdateSiteSettings replaces the settings overrides of the site. The overrides are removed
+// when the settings are empty.
+func (c *repository) UpdateSiteSettings(ctx context.Context,
+	clinicId, siteId string, settings *sites.Settings) (*sites.Site, error) {
+
+	clinicOID, err := primitive.ObjectIDFromHex(clinicId)
+	if err != nil {
+		return nil, err
+	}
+	siteOID, err := primitive.ObjectIDFromHex(siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := bson.M{
+		"_id":      clinicOID,
+		"sites.id": siteOID,
+	}
+	update := bson.M{
+		"$currentDate": bson.M{"updatedTime": true},
+	}
+	if settings.IsEmpty() {
+		update["$unset"] = bson.M{"sites.$.settings": ""}
+	} else {
+		update["$set"] = bson.M{"sites.$.settings": settings}
+	}
+	res, err := c.collection.UpdateOne(ctx, selector, update)
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount != 1 {
+		return nil, clinics.ErrNotFound
+	}
+
+	return c.annotateClinicSite(ctx, clinicOID, siteOID)
+}
+
+// MoveChildSites moves the children of the site under the target site.
+func (c *repository) MoveChildSites(ctx context.Context, clinicId, siteId, targetSiteId string) error {
+	clinicOID, err := primitive.ObjectIDFromHex(clinicId)
+	if err != nil {
+		return err
+	}
+	siteOID, err := primitive.ObjectIDFromHex(siteId)
+	if err != nil {
+		return err
+	}
+	targetOID, err := primitive.ObjectIDFromHex(targetSiteId)
+	if err != nil {
+		return err
+	}
+
+	clinic, err := c.getSites(ctx, clinicOID)
+	if err != nil {
+		return err
+	}
+	if err := sites.NewHierarchy(clinic.Sites).ValidateMerge(siteOID, targetOID); err != nil {
+		return fmt.Errorf("%w: %w", clinics.ErrInvalidSiteParent, err)
+	}
+
+	return c.reparentSites(ctx, *clinic, siteOID, &targetOID)
+}
+
+// reparentSites moves the children of the parent under the new parent, or to the root of
+// the hierarchy when the new parent is nil. The sites are only updated if the clinic was
+// not updated since its sites were read, because the new parent was validated against them.
+func (c *repository) reparentSites(ctx context.Context, clinic clinics.Clinic, parentId primitive.ObjectID, newParentId *primitive.ObjectID) error {
+	update := bson.M{
+		"$currentDate": bson.M{"updatedTime": true},
+	}
+	if newParentId != nil {
+		update["$set"] = bson.M{"sites.$[child].parentId": newParentId}
+	} else {
+		update["$unset"] = bson.M{"sites.$[child].parentId": ""}
+	}
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{
+		Filters: []any{bson.M{"child.parentId": parentId}},
+	})
+	selector := bson.M{
+		"_id":         clinic.Id,
+		"updatedTime": clinic.UpdatedTime,
+	}
+	res, err := c.collection.UpdateOne(ctx, selector, update, opts)
+	if err != nil {
+		return fmt.Errorf("unable to move child sites: %w", err)
+	}
+	if res.MatchedCount != 1 {
+		return clinics.ErrSitesUpdatedConcurrently
+	}
+	return nil
+}
+
+// maintainSitesHierarchy checks that the site can be moved under the parent. It returns the
+// time of the last update of the validated sites, which must be used as a condition of the
+// update of the site, or nil if the site is moved to the root of the hierarchy.
+func (c *repository) maintainSitesHierarchy(ctx context.Context,
+	clinicId string, siteId primitive.ObjectID, parentId *primitive.ObjectID) (*time.Time, error) {
+
+	if parentId == nil {
+		return nil, nil
+	}
+	clinicOID, err := primitive.ObjectIDFromHex(clinicId)
+	if err != nil {
+		return nil, err
+	}
+	clinic, err := c.getSites(ctx, clinicOID)
+	if err != nil {
+		return nil, err
+	}
+	if err := sites.NewHierarchy(clinic.Sites).ValidateParent(siteId, parentId); err != nil {
+		return nil, fmt.Errorf("%w: %w", clinics.ErrInvalidSiteParent, err)
+	}
+	return &clinic.UpdatedTime, nil
+}
+
 func (c *repository) maintainSitesConstraintsOnCreate(ctx context.Context,
 	clinicId, name string) error {
 
@@ -3,8 +3,10 @@ package service
 import (
 	"context"
 
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 
+	"github.com/tidepool-org/clinic/clinicians"
 	"github.com/tidepool-org/clinic/clinics"
 	"github.com/tidepool-org/clinic/deletions"
 	"github.com/tidepool-org/clinic/patients"
@@ -12,11 +14,12 @@ import (
 	"github.com/tidepool-org/clinic/store"
 )
 
-func NewService(repository clinics.Repository, patientsRepository patients.Repository, logger *zap.SugaredLogger) (clinics.Service, error) {
+func NewService(repository clinics.Repository, patientsRepository patients.Repository, logger *zap.SugaredLogger, dbClient *mongo.Client) (clinics.Service, error) {
 	return &service{
 		repository:         repository,
 		patientsRepository: patientsRepository,
 		logger:             logger,
+		dbClient:           dbClient,
 	}, nil
 }
 
@@ -24,6 +27,7 @@ type service struct {
 	repository         clinics.Repository
 	patientsRepository patients.Repository
 	logger             *zap.SugaredLogger
+	dbClient           *mongo.Client
 }
 
 func (s *service) Get(ctx context.Context, id string) (*clinics.Clinic, error) {
@@ -87,6 +91,38 @@ func (s *service) UpdateMembershipRestrictions(ctx context.Context, clinicId str
 	return s.repository.UpdateMembershipRestrictions(ctx, clinicId, restrictions)
 }
 
+func (s *service) GetAccessRestrictions(ctx context.Context, clinicId string) (*clinics.AccessRestrictions, error) {
+	clinic, err := s.repository.Get(ctx, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	if clinic.AccessRestrictions == nil {
+		return &clinics.AccessRestrictions{}, nil
+	}
+	return clinic.AccessRestrictions, nil
+}
+
+func (s *service) UpdateAccessRestrictions(ctx context.Context, clinicId string, restrictions *clinics.AccessRestrictions) error {
+	if err := restrictions.Validate(); err != nil {
+		return err
+	}
+	return s.repository.UpdateAccessRestrictions(ctx, clinicId, restrictions)
+}
+
+func (s *service) ListClinicianRoles(ctx context.Context, clinicId string) ([]clinicians.Role, error) {
+	clinic, err := s.repository.Get(ctx, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	return clinic.ClinicianRoles, nil
+}
+
+func (s *service) UpdateClinicianRoles(ctx context.Context, clinicId string, roles []clinicians.Role) error {
+	return s.repository.UpdateClinicianRoles(ctx, clinicId, roles)
+}
+
 func (s *service) GetEHRSettings(ctx context.Context, clinicId string) (*clinics.EHRSettings, error) {
 	if clinic, err := s.repository.Get(ctx, clinicId); err != nil {
 		return nil, err
@@ -184,8 +220,28 @@ func (s *service) CreateSiteIgnoringLimit(ctx context.Context, clinicId string,
 	return s.repository.CreateSiteIgnoringLimit(ctx, clinicId, site)
 }
 
+// DeleteSite removes the site and moves its children to its parent in a single transaction
 func (s *service) DeleteSite(ctx context.Context, clinicId, siteId string) error {
-	return s.repository.DeleteSite(ctx, clinicId, siteId)
+	_, err := store.WithTransaction(ctx, s.dbClient, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return nil, s.repository.DeleteSite(sessionCtx, clinicId, siteId)
+	})
+	return err
+}
+
+func (s *service) UpdateSiteParent(ctx context.Context, clinicId, siteId string, parentId *string) (*sites.Site, error) {
+	return s.repository.UpdateSiteParent(ctx, clinicId, siteId, parentId)
+}
+
+func (s *service) UpdateSiteSettings(ctx context.Context, clinicId, siteId string, settings *sites.Settings) (*sites.Site, error) {
+	return s.repository.UpdateSiteSettings(ctx, clinicId, siteId, settings)
+}
+
+func (s *service) MoveChildSites(ctx context.Context, clinicId, siteId, targetSiteId string) error {
+	return s.repository.MoveChildSites(ctx, clinicId, siteId, targetSiteId)
+}
+
+func (s *service) ListSites(ctx context.Context, clinicId string) ([]sites.Site, error) {
+	return s.repository.ListSites(ctx, clinicId)
 }
 
 func (s *service) UpdateSite(ctx context.Context, clinicId, siteId string, site *sites.Site) (*sites.Site, error) {
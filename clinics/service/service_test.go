@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/fx/fxtest"
@@ -44,7 +45,7 @@ var _ = Describe("Clinics", func() {
 		Expect(repository).ToNot(BeNil())
 		patientsRepoController = gomock.NewController(GinkgoT())
 		patientsRepo = patientsTest.NewMockRepository(patientsRepoController)
-		service, err = clinicsService.NewService(repository, patientsRepo, lgr)
+		service, err = clinicsService.NewService(repository, patientsRepo, lgr, database.Client())
 		Expect(err).ToNot(HaveOccurred())
 		Expect(service).ToNot(BeNil())
 		lifecycle.RequireStart()
@@ -451,6 +452,25 @@ var _ = Describe("Clinics", func() {
 			_, err := th.Repo.CreateSite(ctx, th.Clinic.Id.Hex(), site)
 			Expect(err).To(MatchError(ContainSubstring("duplicate")))
 		})
+
+		It("creates the site under its parent", func() {
+			ctx, th := newRepoTestHelper(GinkgoT())
+			site := th.newTestSite("Manhattan")
+			site.ParentId = &th.Site.Id
+
+			created, err := th.Repo.CreateSite(ctx, th.Clinic.Id.Hex(), site)
+			Expect(err).To(Succeed())
+			Expect(created.ParentId).To(HaveValue(Equal(th.Site.Id)))
+		})
+
+		It("fails when the parent doesn't exist", func() {
+			ctx, th := newRepoTestHelper(GinkgoT())
+			site := th.newTestSite("Manhattan")
+			site.ParentId = ptr(primitive.NewObjectID())
+
+			_, err := th.Repo.CreateSite(ctx, th.Clinic.Id.Hex(), site)
+			Expect(err).To(MatchError(clinics.ErrInvalidSiteParent))
+		})
 	})
 
 	Describe("DeleteSite", func() {
@@ -459,6 +479,114 @@ var _ = Describe("Clinics", func() {
 
 			Expect(th.Repo.DeleteSite(ctx, th.Clinic.Id.Hex(), th.Site.Id.Hex())).To(Succeed())
 		})
+
+		It("moves the children of the site to its parent", func() {
+			ctx, th := newRepoTestHelper(GinkgoT())
+			child := th.createTestChildSite("Manhattan", th.Site)
+			grandchild := th.createTestChildSite("Midtown", child)
+
+			Expect(th.Repo.DeleteSite(ctx, th.Clinic.Id.Hex(), child.Id.Hex())).To(Succeed())
+
+			clinic, err := th.Repo.Get(ctx, th.Clinic.Id.Hex())
+			Expect(err).To(Succeed())
+			Expect(clinic.Sites).To(HaveLen(2))
+			Expect(clinic.Sites[1].Id).To(Equal(grandchild.Id))
+			Expect(clinic.Sites[1].ParentId).To(HaveValue(Equal(th.Site.Id)))
+		})
+	})
+
+	Describe("UpdateSiteSettings", func() {
+		It("updates and removes the settings of the site", func() {
+			ctx, th := newRepoTestHelper(GinkgoT())
+			settings := &sites.Settings{Timezone: ptr("America/Denver")}
+
+			updated, err := th.Repo.UpdateSiteSettings(ctx, th.Clinic.Id.Hex(), th.Site.Id.Hex(), settings)
+			Expect(err).To(Succeed())
+			Expect(updated.Settings).To(Equal(settings))
+
+			updated, err = th.Repo.UpdateSiteSettings(ctx, th.Clinic.Id.Hex(), th.Site.Id.Hex(), &sites.Settings{})
+			Expect(err).To(Succeed())
+			Expect(updated.Settings).To(BeNil())
+		})
+	})
+
+	Describe("UpdateSiteParent", func() {
+		It("moves the site under the parent", func() {
+			ctx, th := newRepoTestHelper(GinkgoT())
+			site := th.createTestSite("Manhattan")
+			parentId := th.Site.Id.Hex()
+
+			updated, err := th.Repo.UpdateSiteParent(ctx, th.Clinic.Id.Hex(), site.Id.Hex(), &parentId)
+			Expect(err).To(Succeed())
+			Expect(updated.ParentId).To(HaveValue(Equal(th.Site.Id)))
+		})
+
+		It("moves the site to the root", func() {
+			ctx, th := newRepoTestHelper(GinkgoT())
+			site := th.createTestChildSite("Manhattan", th.Site)
+
+			updated, err := th.Repo.UpdateSiteParent(ctx, th.Clinic.Id.Hex(), site.Id.Hex(), nil)
+			Expect(err).To(Succeed())
+			Expect(updated.ParentId).To(BeNil())
+		})
+
+		It("fails when the parent is a descendant of the site", func() {
+			ctx, th := newRepoTestHelper(GinkgoT())
+			child := th.createTestChildSite("Manhattan", th.Site)
+			parentId := child.Id.Hex()
+
+			_, err := th.Repo.UpdateSiteParent(ctx, th.Clinic.Id.Hex(), th.Site.Id.Hex(), &parentId)
+			Expect(err).To(MatchError(clinics.ErrInvalidSiteParent))
+		})
+	})
+
+	Describe("ListSites", func() {
+		It("returns the sites of the clinic", func() {
+			ctx, th := newRepoTestHelper(GinkgoT())
+			child := th.createTestChildSite("Manhattan", th.Site)
+
+			result, err := th.Repo.ListSites(ctx, th.Clinic.Id.Hex())
+			Expect(err).To(Succeed())
+			Expect(result).To(HaveLen(2))
+			Expect(result[1].Id).To(Equal(child.Id))
+			Expect(result[1].ParentId).To(HaveValue(Equal(th.Site.Id)))
+		})
+	})
+
+	Describe("Get", func() {
+		It("counts the patients of the descendants of the sites once", func() {
+			ctx, th := newRepoTestHelper(GinkgoT())
+			child := th.createTestChildSite("Manhattan", th.Site)
+			patientsCollection := dbTest.GetTestDatabase().Collection("patients")
+			_, err := patientsCollection.InsertMany(ctx, []any{
+				bson.M{"clinicId": th.Clinic.Id, "userId": uuid.NewString(), "sites": bson.A{*th.Site}},
+				bson.M{"clinicId": th.Clinic.Id, "userId": uuid.NewString(), "sites": bson.A{*child}},
+				bson.M{"clinicId": th.Clinic.Id, "userId": uuid.NewString(), "sites": bson.A{*th.Site, *child}},
+			})
+			Expect(err).To(Succeed())
+
+			clinic, err := th.Repo.Get(ctx, th.Clinic.Id.Hex())
+			Expect(err).To(Succeed())
+			Expect(clinic.Sites[0].TotalPatients).To(Equal(3))
+			Expect(clinic.Sites[1].TotalPatients).To(Equal(2))
+		})
+	})
+
+	Describe("MoveChildSites", func() {
+		It("moves the children of the site under the target site", func() {
+			ctx, th := newRepoTestHelper(GinkgoT())
+			source := th.createTestSite("Manhattan")
+			child := th.createTestChildSite("Midtown", source)
+
+			Expect(th.Repo.MoveChildSites(ctx, th.Clinic.Id.Hex(), source.Id.Hex(), th.Site.Id.Hex())).To(Succeed())
+
+			result, err := th.Repo.ListSites(ctx, th.Clinic.Id.Hex())
+			Expect(err).To(Succeed())
+			Expect(result).To(ContainElement(And(
+				HaveField("Id", Equal(child.Id)),
+				HaveField("ParentId", HaveValue(Equal(th.Site.Id))),
+			)))
+		})
 	})
 
 	Describe("UpdateSite", func() {
@@ -572,6 +700,18 @@ func (r *repoTestHelper) newTestSite(name string) *sites.Site {
 	}
 }
 
+// createTestChildSite creates the new site under the parent and returns it.
+func (r *repoTestHelper) createTestChildSite(name string, parent *sites.Site) *sites.Site {
+	ctx := context.Background()
+	site := r.newTestSite(name)
+	site.ParentId = &parent.Id
+	created, err := r.Repo.CreateSite(ctx, r.Clinic.Id.Hex(), site)
+	if err != nil {
+		r.t.Fatalf("failed to create new test site: %s\n%+v", err, site)
+	}
+	return created
+}
+
 // createTestSite creates the new site and returns it, making its Id available.
 func (r *repoTestHelper) createTestSite(name string) *sites.Site {
 	ctx := context.Background()
@@ -0,0 +1,117 @@
+package clinics
+
+import (
+	"slices"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/tidepool-org/clinic/sites"
+)
+
+// ResolvedSettings are the settings of a clinic which can be overridden by its sites.
+type ResolvedSettings struct {
+	Timezone         *string
+	PreferredBgUnits string
+	EHRSettings      *EHRSettings
+}
+
+// ResolveSettings returns the settings which apply to a patient assigned to the sites.
+//
+// Each setting is taken from the first of the sites which overrides it, either directly or
+// through its closest ancestor, then from the clinic. The sites are ordered from the deepest
+// in the hierarchy, and sites at the same depth in the order of the clinic sites, so the
+// result doesn't depend on the order of the sites of the patient. Settings which aren't set by
+// the sites or the clinic keep their defaults. Site overrides of EHR settings only apply when
+// the clinic has EHR settings.
+func (c Clinic) ResolveSettings(siteIds []primitive.ObjectID) ResolvedSettings {
+	resolved := ResolvedSettings{
+		Timezone:         c.Timezone,
+		PreferredBgUnits: c.PreferredBgUnits,
+	}
+	if c.EHRSettings != nil {
+		settings := *c.EHRSettings
+		resolved.EHRSettings = &settings
+	}
+
+	var timezone, bgUnits *string
+	var destinationIds *sites.EHRDestinationIds
+	var scheduledReports *sites.ScheduledReports
+	for _, settings := range c.siteSettings(siteIds) {
+		if timezone == nil {
+			timezone = settings.Timezone
+		}
+		if bgUnits == nil {
+			bgUnits = settings.PreferredBgUnits
+		}
+		if destinationIds == nil {
+			destinationIds = settings.EHRDestinationIds
+		}
+		if scheduledReports == nil {
+			scheduledReports = settings.ScheduledReports
+		}
+	}
+
+	if timezone != nil {
+		resolved.Timezone = timezone
+	}
+	if bgUnits != nil {
+		resolved.PreferredBgUnits = *bgUnits
+	}
+	if resolved.EHRSettings != nil && destinationIds != nil {
+		resolved.EHRSettings.DestinationIds = &EHRDestinationIds{
+			Flowsheet: destinationIds.Flowsheet,
+			Notes:     destinationIds.Notes,
+			Results:   destinationIds.Results,
+		}
+	}
+	if resolved.EHRSettings != nil && scheduledReports != nil {
+		resolved.EHRSettings.ScheduledReports = ScheduledReports{
+			Cadence:               scheduledReports.Cadence,
+			OnUploadEnabled:       scheduledReports.OnUploadEnabled,
+			OnUploadNoteEventType: scheduledReports.OnUploadNoteEventType,
+		}
+	}
+
+	return resolved
+}
+
+// ResolvePatientSettings returns the settings which apply to a patient assigned to the sites.
+func (c Clinic) ResolvePatientSettings(patientSites *[]sites.Site) ResolvedSettings {
+	var siteIds []primitive.ObjectID
+	if patientSites != nil {
+		for _, site := range *patientSites {
+			siteIds = append(siteIds, site.Id)
+		}
+	}
+	return c.ResolveSettings(siteIds)
+}
+
+// siteSettings returns the settings of the sites in order of precedence, i.e. each site is
+// followed by its ancestors, starting with its parent.
+func (c Clinic) siteSettings(siteIds []primitive.ObjectID) []*sites.Settings {
+	bySiteId := make(map[primitive.ObjectID]*sites.Settings, len(c.Sites))
+	for _, site := range c.Sites {
+		bySiteId[site.Id] = site.Settings
+	}
+
+	hierarchy := sites.NewHierarchy(c.Sites)
+	var ordered []primitive.ObjectID
+	for _, site := range c.Sites {
+		if slices.Contains(siteIds, site.Id) {
+			ordered = append(ordered, site.Id)
+		}
+	}
+	slices.SortStableFunc(ordered, func(a, b primitive.ObjectID) int {
+		return hierarchy.Depth(b) - hierarchy.Depth(a)
+	})
+
+	var result []*sites.Settings
+	for _, siteId := range ordered {
+		for _, id := range append([]primitive.ObjectID{siteId}, hierarchy.Ancestors(siteId)...) {
+			if settings := bySiteId[id]; settings != nil {
+				result = append(result, settings)
+			}
+		}
+	}
+	return result
+}
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	clinicians "github.com/tidepool-org/clinic/clinicians"
 	clinics "github.com/tidepool-org/clinic/clinics"
 	deletions "github.com/tidepool-org/clinic/deletions"
 	sites "github.com/tidepool-org/clinic/sites"
@@ -175,6 +176,21 @@ func (mr *MockServiceMockRecorder) Get(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockService)(nil).Get), ctx, id)
 }
 
+// GetAccessRestrictions mocks base method.
+func (m *MockService) GetAccessRestrictions(ctx context.Context, clinicId string) (*clinics.AccessRestrictions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessRestrictions", ctx, clinicId)
+	ret0, _ := ret[0].(*clinics.AccessRestrictions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessRestrictions indicates an expected call of GetAccessRestrictions.
+func (mr *MockServiceMockRecorder) GetAccessRestrictions(ctx, clinicId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessRestrictions", reflect.TypeOf((*MockService)(nil).GetAccessRestrictions), ctx, clinicId)
+}
+
 // GetEHRSettings mocks base method.
 func (m *MockService) GetEHRSettings(ctx context.Context, clinicId string) (*clinics.EHRSettings, error) {
 	m.ctrl.T.Helper()
@@ -250,6 +266,21 @@ func (mr *MockServiceMockRecorder) List(ctx, filter, pagination any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockService)(nil).List), ctx, filter, pagination)
 }
 
+// ListClinicianRoles mocks base method.
+func (m *MockService) ListClinicianRoles(ctx context.Context, clinicId string) ([]clinicians.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClinicianRoles", ctx, clinicId)
+	ret0, _ := ret[0].([]clinicians.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClinicianRoles indicates an expected call of ListClinicianRoles.
+func (mr *MockServiceMockRecorder) ListClinicianRoles(ctx, clinicId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClinicianRoles", reflect.TypeOf((*MockService)(nil).ListClinicianRoles), ctx, clinicId)
+}
+
 // ListMembershipRestrictions mocks base method.
 func (m *MockService) ListMembershipRestrictions(ctx context.Context, clinicId string) ([]clinics.MembershipRestrictions, error) {
 	m.ctrl.T.Helper()
@@ -265,6 +296,35 @@ func (mr *MockServiceMockRecorder) ListMembershipRestrictions(ctx, clinicId any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembershipRestrictions", reflect.TypeOf((*MockService)(nil).ListMembershipRestrictions), ctx, clinicId)
 }
 
+// ListSites mocks base method.
+func (m *MockService) ListSites(ctx context.Context, clinicId string) ([]sites.Site, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSites", ctx, clinicId)
+	ret0, _ := ret[0].([]sites.Site)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSites indicates an expected call of ListSites.
+func (mr *MockServiceMockRecorder) ListSites(ctx, clinicId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSites", reflect.TypeOf((*MockService)(nil).ListSites), ctx, clinicId)
+}
+
+// MoveChildSites mocks base method.
+func (m *MockService) MoveChildSites(ctx context.Context, clinicId, siteId, targetSiteId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveChildSites", ctx, clinicId, siteId, targetSiteId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveChildSites indicates an expected call of MoveChildSites.
+func (mr *MockServiceMockRecorder) MoveChildSites(ctx, clinicId, siteId, targetSiteId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveChildSites", reflect.TypeOf((*MockService)(nil).MoveChildSites), ctx, clinicId, siteId, targetSiteId)
+}
+
 // RefreshPatientCount mocks base method.
 func (m *MockService) RefreshPatientCount(ctx context.Context, clinicId string) error {
 	m.ctrl.T.Helper()
@@ -308,6 +368,34 @@ func (mr *MockServiceMockRecorder) Update(ctx, id, clinic any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockService)(nil).Update), ctx, id, clinic)
 }
 
+// UpdateAccessRestrictions mocks base method.
+func (m *MockService) UpdateAccessRestrictions(ctx context.Context, clinicId string, restrictions *clinics.AccessRestrictions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccessRestrictions", ctx, clinicId, restrictions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAccessRestrictions indicates an expected call of UpdateAccessRestrictions.
+func (mr *MockServiceMockRecorder) UpdateAccessRestrictions(ctx, clinicId, restrictions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccessRestrictions", reflect.TypeOf((*MockService)(nil).UpdateAccessRestrictions), ctx, clinicId, restrictions)
+}
+
+// UpdateClinicianRoles mocks base method.
+func (m *MockService) UpdateClinicianRoles(ctx context.Context, clinicId string, roles []clinicians.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClinicianRoles", ctx, clinicId, roles)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClinicianRoles indicates an expected call of UpdateClinicianRoles.
+func (mr *MockServiceMockRecorder) UpdateClinicianRoles(ctx, clinicId, roles any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClinicianRoles", reflect.TypeOf((*MockService)(nil).UpdateClinicianRoles), ctx, clinicId, roles)
+}
+
 // UpdateEHRSettings mocks base method.
 func (m *MockService) UpdateEHRSettings(ctx context.Context, clinicId string, settings *clinics.EHRSettings) error {
 	m.ctrl.T.Helper()
@@ -394,6 +482,36 @@ func (mr *MockServiceMockRecorder) UpdateSite(ctx, clinicId, siteId, site any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSite", reflect.TypeOf((*MockService)(nil).UpdateSite), ctx, clinicId, siteId, site)
 }
 
+// UpdateSiteParent mocks base method.
+func (m *MockService) UpdateSiteParent(ctx context.Context, clinicId, siteId string, parentId *string) (*sites.Site, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSiteParent", ctx, clinicId, siteId, parentId)
+	ret0, _ := ret[0].(*sites.Site)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSiteParent indicates an expected call of UpdateSiteParent.
+func (mr *MockServiceMockRecorder) UpdateSiteParent(ctx, clinicId, siteId, parentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSiteParent", reflect.TypeOf((*MockService)(nil).UpdateSiteParent), ctx, clinicId, siteId, parentId)
+}
+
+// UpdateSiteSettings mocks base method.
+func (m *MockService) UpdateSiteSettings(ctx context.Context, clinicId, siteId string, settings *sites.Settings) (*sites.Site, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSiteSettings", ctx, clinicId, siteId, settings)
+	ret0, _ := ret[0].(*sites.Site)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSiteSettings indicates an expected call of UpdateSiteSettings.
+func (mr *MockServiceMockRecorder) UpdateSiteSettings(ctx, clinicId, siteId, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSiteSettings", reflect.TypeOf((*MockService)(nil).UpdateSiteSettings), ctx, clinicId, siteId, settings)
+}
+
 // UpdateSuppressedNotifications mocks base method.
 func (m *MockService) UpdateSuppressedNotifications(ctx context.Context, clinicId string, suppressedNotifications clinics.SuppressedNotifications) error {
 	m.ctrl.T.Helper()
@@ -606,6 +724,35 @@ func (mr *MockRepositoryMockRecorder) List(ctx, filter, pagination any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, filter, pagination)
 }
 
+// ListSites mocks base method.
+func (m *MockRepository) ListSites(ctx context.Context, clinicId string) ([]sites.Site, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSites", ctx, clinicId)
+	ret0, _ := ret[0].([]sites.Site)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSites indicates an expected call of ListSites.
+func (mr *MockRepositoryMockRecorder) ListSites(ctx, clinicId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSites", reflect.TypeOf((*MockRepository)(nil).ListSites), ctx, clinicId)
+}
+
+// MoveChildSites mocks base method.
+func (m *MockRepository) MoveChildSites(ctx context.Context, clinicId, siteId, targetSiteId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveChildSites", ctx, clinicId, siteId, targetSiteId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveChildSites indicates an expected call of MoveChildSites.
+func (mr *MockRepositoryMockRecorder) MoveChildSites(ctx, clinicId, siteId, targetSiteId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveChildSites", reflect.TypeOf((*MockRepository)(nil).MoveChildSites), ctx, clinicId, siteId, targetSiteId)
+}
+
 // RemoveAdmin mocks base method.
 func (m *MockRepository) RemoveAdmin(ctx context.Context, clinicId, clinicianId string, allowOrphaning bool) error {
 	m.ctrl.T.Helper()
@@ -635,6 +782,34 @@ func (mr *MockRepositoryMockRecorder) Update(ctx, id, clinic any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, id, clinic)
 }
 
+// UpdateAccessRestrictions mocks base method.
+func (m *MockRepository) UpdateAccessRestrictions(ctx context.Context, clinicId string, restrictions *clinics.AccessRestrictions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccessRestrictions", ctx, clinicId, restrictions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAccessRestrictions indicates an expected call of UpdateAccessRestrictions.
+func (mr *MockRepositoryMockRecorder) UpdateAccessRestrictions(ctx, clinicId, restrictions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccessRestrictions", reflect.TypeOf((*MockRepository)(nil).UpdateAccessRestrictions), ctx, clinicId, restrictions)
+}
+
+// UpdateClinicianRoles mocks base method.
+func (m *MockRepository) UpdateClinicianRoles(ctx context.Context, clinicId string, roles []clinicians.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClinicianRoles", ctx, clinicId, roles)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClinicianRoles indicates an expected call of UpdateClinicianRoles.
+func (mr *MockRepositoryMockRecorder) UpdateClinicianRoles(ctx, clinicId, roles any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClinicianRoles", reflect.TypeOf((*MockRepository)(nil).UpdateClinicianRoles), ctx, clinicId, roles)
+}
+
 // UpdateEHRSettings mocks base method.
 func (m *MockRepository) UpdateEHRSettings(ctx context.Context, clinicId string, settings *clinics.EHRSettings) error {
 	m.ctrl.T.Helper()
@@ -735,6 +910,36 @@ func (mr *MockRepositoryMockRecorder) UpdateSite(ctx, clinicId, siteId, site any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSite", reflect.TypeOf((*MockRepository)(nil).UpdateSite), ctx, clinicId, siteId, site)
 }
 
+// UpdateSiteParent mocks base method.
+func (m *MockRepository) UpdateSiteParent(ctx context.Context, clinicId, siteId string, parentId *string) (*sites.Site, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSiteParent", ctx, clinicId, siteId, parentId)
+	ret0, _ := ret[0].(*sites.Site)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSiteParent indicates an expected call of UpdateSiteParent.
+func (mr *MockRepositoryMockRecorder) UpdateSiteParent(ctx, clinicId, siteId, parentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSiteParent", reflect.TypeOf((*MockRepository)(nil).UpdateSiteParent), ctx, clinicId, siteId, parentId)
+}
+
+// UpdateSiteSettings mocks base method.
+func (m *MockRepository) UpdateSiteSettings(ctx context.Context, clinicId, siteId string, settings *sites.Settings) (*sites.Site, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSiteSettings", ctx, clinicId, siteId, settings)
+	ret0, _ := ret[0].(*sites.Site)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSiteSettings indicates an expected call of UpdateSiteSettings.
+func (mr *MockRepositoryMockRecorder) UpdateSiteSettings(ctx, clinicId, siteId, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSiteSettings", reflect.TypeOf((*MockRepository)(nil).UpdateSiteSettings), ctx, clinicId, siteId, settings)
+}
+
 // UpdateSuppressedNotifications mocks base method.
 func (m *MockRepository) UpdateSuppressedNotifications(ctx context.Context, clinicId string, suppressedNotifications clinics.SuppressedNotifications) error {
 	m.ctrl.T.Helper()
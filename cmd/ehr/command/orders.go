@@ -0,0 +1,15 @@
+package command
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var ordersCmd = &cobra.Command{
+	Use:   "orders",
+	Short: "Manage Xealth orders",
+	Long:  "The orders command is used to manage Xealth orders received by EHR enabled clinics",
+}
+
+func init() {
+	rootCmd.AddCommand(ordersCmd)
+}
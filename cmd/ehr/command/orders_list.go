@@ -0,0 +1,91 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"github.com/spf13/cobra"
+	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/store"
+	"github.com/tidepool-org/clinic/xealth"
+	"time"
+)
+
+var ordersListParams = struct {
+	Limit    int
+	Offset   int
+	ClinicId string
+	Status   string
+}{}
+
+var ordersListCmd = &cobra.Command{
+	Use:   "list",
+	Args:  cobra.ExactArgs(1),
+	Short: "List Xealth orders of a clinic",
+	Long:  "The list command is used to retrieve a list of Xealth orders received by a clinic",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ordersListParams.ClinicId = args[0]
+		return Run(listOrders)
+	},
+}
+
+func listOrders(clinicsService clinics.Service, xealthStore xealth.Store) error {
+	clinic, err := getEHRClinic(ordersListParams.ClinicId, clinicsService)
+	if err != nil {
+		return err
+	}
+
+	page := store.DefaultPagination().
+		WithLimit(ordersListParams.Limit).
+		WithOffset(ordersListParams.Offset)
+	filter := xealth.OrderFilter{
+		ClinicId: clinic.Id,
+	}
+	if ordersListParams.Status != "" {
+		filter.Status = &ordersListParams.Status
+	}
+
+	orders, err := xealthStore.ListOrders(context.TODO(), filter, page)
+	if err != nil {
+		return fmt.Errorf("orders list error: %w", err)
+	}
+
+	for _, order := range orders {
+		status := "(empty)"
+		lastError := "(empty)"
+		nextAttempt := "(none)"
+		if order.Status != "" {
+			status = order.Status
+		}
+		if order.LastError != nil {
+			lastError = *order.LastError
+		}
+		if order.NextAttemptTime != nil {
+			nextAttempt = order.NextAttemptTime.Format(time.RFC3339)
+		}
+
+		fmt.Printf(
+			"%s - Order %s (%s:%s) - Created %s - Status %s - Attempts %v - Next attempt %s - Last error %s\n",
+			order.Id.Hex(),
+			order.EventNotification.OrderId,
+			order.EventNotification.EventType,
+			order.EventNotification.EventContext,
+			order.CreatedTime.Format(time.RFC3339),
+			status,
+			order.Attempts,
+			nextAttempt,
+			lastError,
+		)
+	}
+
+	fmt.Printf("Found %v orders\n", len(orders))
+
+	return nil
+}
+
+func init() {
+	ordersListCmd.Flags().IntVarP(&ordersListParams.Limit, "limit", "l", 20, "The number of orders to display")
+	ordersListCmd.Flags().IntVarP(&ordersListParams.Offset, "offset", "o", 0, "The number of orders to skip")
+	ordersListCmd.Flags().StringVar(&ordersListParams.Status, "status", xealth.OrderStatusFailed, "Return only orders with the given status (pending, processed or failed). Use an empty value to return all orders")
+
+	ordersCmd.AddCommand(ordersListCmd)
+}
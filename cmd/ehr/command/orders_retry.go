@@ -0,0 +1,89 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"github.com/spf13/cobra"
+	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/store"
+	"github.com/tidepool-org/clinic/xealth"
+)
+
+var ordersRetryParams = struct {
+	Limit    int
+	ClinicId string
+	OrderId  string
+	DryRun   bool
+}{}
+
+var ordersRetryCmd = &cobra.Command{
+	Use:   "retry",
+	Args:  cobra.ExactArgs(1),
+	Short: "Retry failed Xealth orders of a clinic",
+	Long:  "The retry command is used to process failed Xealth orders of a clinic again",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ordersRetryParams.ClinicId = args[0]
+		return Run(retryOrders)
+	},
+}
+
+func retryOrders(clinicsService clinics.Service, xealthStore xealth.Store, xealthHandler xealth.Xealth) error {
+	clinic, err := getEHRClinic(ordersRetryParams.ClinicId, clinicsService)
+	if err != nil {
+		return err
+	}
+	if clinic.EHRSettings.Provider != clinics.EHRProviderXealth {
+		return fmt.Errorf("provider %s is not supported", clinic.EHRSettings.Provider)
+	}
+
+	var orders []xealth.OrderEvent
+	if ordersRetryParams.OrderId != "" {
+		order, err := xealthStore.GetOrder(context.TODO(), ordersRetryParams.OrderId)
+		if err != nil {
+			return err
+		}
+		if order.ClinicId == nil || *order.ClinicId != *clinic.Id {
+			return fmt.Errorf("order %s does not belong to clinic %s", ordersRetryParams.OrderId, clinic.Id.Hex())
+		}
+		orders = append(orders, *order)
+	} else {
+		status := xealth.OrderStatusFailed
+		page := store.DefaultPagination().WithLimit(ordersRetryParams.Limit)
+		orders, err = xealthStore.ListOrders(context.TODO(), xealth.OrderFilter{
+			ClinicId: clinic.Id,
+			Status:   &status,
+		}, page)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Retrying %v orders\n", len(orders))
+
+	failed := 0
+	for _, order := range orders {
+		fmt.Printf("Retrying order %s (document %s)\n", order.EventNotification.OrderId, order.Id.Hex())
+		if ordersRetryParams.DryRun {
+			continue
+		}
+
+		if err := xealthHandler.ProcessOrder(context.TODO(), order.Id.Hex()); err != nil {
+			failed++
+			fmt.Printf("Unable to process order: %v\n", err)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%v out of %v orders failed", failed, len(orders))
+	}
+
+	return nil
+}
+
+func init() {
+	ordersRetryCmd.Flags().IntVarP(&ordersRetryParams.Limit, "limit", "l", 20, "The max number of failed orders to retry")
+	ordersRetryCmd.Flags().StringVar(&ordersRetryParams.OrderId, "document-id", "", "The id of the order document to retry")
+	ordersRetryCmd.Flags().BoolVar(&ordersRetryParams.DryRun, "dry-run", false, "Only print the orders which would be retried")
+
+	ordersCmd.AddCommand(ordersRetryCmd)
+}
@@ -0,0 +1,53 @@
+package ehr
+
+import (
+	"fmt"
+
+	"github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+)
+
+const (
+	MRNPatientMatchingStrategy            = "MRN"
+	MRNAndDOBPatientMatchingStrategy      = "MRN_DOB"
+	DOBAndFullNamePatientMatchingStrategy = "DOB_FULLNAME"
+)
+
+// PatientCriteria are the patient demographics received from the EHR
+type PatientCriteria struct {
+	FirstName   string
+	LastName    string
+	FullName    string
+	MRN         string
+	DateOfBirth string
+}
+
+// GetFilters returns a patient filter for each of the matching strategies
+func (p PatientCriteria) GetFilters(clinicId string, strategies []string) ([]patients.Filter, error) {
+	result := make([]patients.Filter, 0, len(strategies))
+	for _, c := range strategies {
+		switch c {
+		case MRNPatientMatchingStrategy:
+			result = append(result, patients.Filter{
+				ClinicId: &clinicId,
+				Mrn:      &p.MRN,
+			})
+		case MRNAndDOBPatientMatchingStrategy:
+			result = append(result, patients.Filter{
+				ClinicId:  &clinicId,
+				Mrn:       &p.MRN,
+				BirthDate: &p.DateOfBirth,
+			})
+		case DOBAndFullNamePatientMatchingStrategy:
+			result = append(result, patients.Filter{
+				ClinicId:  &clinicId,
+				BirthDate: &p.DateOfBirth,
+				FullName:  &p.FullName,
+			})
+		default:
+			return nil, fmt.Errorf("%w: invalid critera: %s", errors.BadRequest, c)
+		}
+	}
+
+	return result, nil
+}
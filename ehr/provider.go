@@ -0,0 +1,268 @@
+package ehr
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"sort"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/store"
+)
+
+const (
+	providersGroup = `group:"ehrProviders"`
+
+	// patientClinicsBatchSize is the number of clinic memberships of a patient fetched at once when delivering reports
+	patientClinicsBatchSize = 100
+
+	// patientsMatchLimit limits the number of patients returned by a single filter. Consumers are looking for unique
+	// matches, 100 matches strongly indicates an unexpected filter behavior.
+	patientsMatchLimit = 100
+)
+
+var (
+	ErrProviderNotFound = fmt.Errorf("ehr provider %w", errors.NotFound)
+	ErrNotSupported     = fmt.Errorf("%w: the operation is not supported by the ehr provider", errors.BadRequest)
+)
+
+// Provider is an EHR integration vendor (e.g. Redox or Xealth). The provider matches the clinics and the patients of
+// the EHR messages, handles the lifecycle of the orders and the delivery of the reports.
+type Provider interface {
+	// Name returns the name of the provider used in the EHR settings of clinics
+	Name() string
+	// SubscriptionName returns the name of the patient EHR subscription managed by the provider
+	SubscriptionName() string
+	// MatchClinic returns the clinic which has the provider enabled for the given source id (e.g. redox source, xealth deployment)
+	MatchClinic(ctx context.Context, sourceId string) (*clinics.Clinic, error)
+	// MatchPatients returns the unique patients of the clinic matching any of the strategies
+	MatchPatients(ctx context.Context, clinic clinics.Clinic, criteria PatientCriteria, strategies []string) ([]*patients.Patient, error)
+	// OrderCreated is called when an order enabling the reports of a matched patient is received
+	OrderCreated(ctx context.Context, clinic clinics.Clinic, patient patients.Patient, order OrderRef) error
+	// OrderCancelled is called when an order disabling the reports of a matched patient is received
+	OrderCancelled(ctx context.Context, clinic clinics.Clinic, patient patients.Patient, order OrderRef) error
+	// DeliverReports delivers the latest reports of a subscribed patient to the clinic (e.g. after an upload)
+	DeliverReports(ctx context.Context, clinic clinics.Clinic, patient patients.Patient) error
+	// SyncClinicReports re-delivers the reports of all subscribed patients of the clinic
+	SyncClinicReports(ctx context.Context, clinicId string) error
+}
+
+// OrderRef references the EHR message which resulted in a subscription update
+type OrderRef = patients.MatchedMessage
+
+// AsProvider annotates a provider constructor, so the provider is added to the registry
+func AsProvider(constructor any) any {
+	return fx.Annotate(
+		constructor,
+		fx.As(new(Provider)),
+		fx.ResultTags(providersGroup),
+	)
+}
+
+type RegistryParams struct {
+	fx.In
+
+	Providers []Provider `group:"ehrProviders"`
+}
+
+// Registry holds the available EHR providers by name
+type Registry struct {
+	providers map[string]Provider
+}
+
+func NewRegistry(params RegistryParams) (*Registry, error) {
+	registry := &Registry{
+		providers: make(map[string]Provider, len(params.Providers)),
+	}
+	for _, provider := range params.Providers {
+		if err := registry.Register(provider); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+func (r *Registry) Register(provider Provider) error {
+	if _, ok := r.providers[provider.Name()]; ok {
+		return fmt.Errorf("ehr provider %s is already registered", provider.Name())
+	}
+	r.providers[provider.Name()] = provider
+	return nil
+}
+
+func (r *Registry) Get(name string) (Provider, error) {
+	provider, ok := r.providers[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProviderNotFound, name)
+	}
+	return provider, nil
+}
+
+// GetForClinic returns the provider of the clinic if the EHR integration is enabled
+func (r *Registry) GetForClinic(clinic clinics.Clinic) (Provider, error) {
+	if clinic.EHRSettings == nil || !clinic.EHRSettings.Enabled {
+		return nil, fmt.Errorf("%w: the clinic has no enabled ehr integration", errors.NotFound)
+	}
+	return r.Get(clinic.EHRSettings.Provider)
+}
+
+// List returns all registered providers ordered by name
+func (r *Registry) List() []Provider {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	providers := make([]Provider, 0, len(names))
+	for _, name := range names {
+		providers = append(providers, r.providers[name])
+	}
+	return providers
+}
+
+// FindClinic returns the single clinic which has the provider enabled for the given source id. Providers use it to
+// implement MatchClinic.
+func FindClinic(ctx context.Context, clinicsService clinics.Service, provider, sourceId string) (*clinics.Clinic, error) {
+	if sourceId == "" {
+		return nil, fmt.Errorf("%w: source id is required", errors.BadRequest)
+	}
+
+	enabled := true
+	filter := clinics.Filter{
+		EHRProvider: &provider,
+		EHRSourceId: &sourceId,
+		EHREnabled:  &enabled,
+	}
+	page := store.Pagination{
+		Offset: 0,
+		Limit:  2,
+	}
+
+	result, err := clinicsService.List(ctx, &filter, page)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result) > 1 {
+		return nil, fmt.Errorf("%w: multiple matching clinics found", errors.Duplicate)
+	} else if len(result) == 0 || result[0] == nil || result[0].Id == nil {
+		return nil, fmt.Errorf("%w: couldn't find a matching clinic", errors.NotFound)
+	}
+
+	return result[0], nil
+}
+
+// FindMatchingPatients returns the unique patients of the clinic matching any of the strategies. Providers use it
+// to implement MatchPatients.
+//
+// The number of matching patients is limited to 100 per strategy. It is expected that consumers of this
+// function are searching for unique patient matches. It is normal for a small number (< 10)
+// of patients to match, but 100 matches strongly indicates an unexpected filter behavior.
+func FindMatchingPatients(ctx context.Context, patientsService patients.Service, clinicId string, criteria PatientCriteria, strategies []string) ([]*patients.Patient, error) {
+	filters, err := criteria.GetFilters(clinicId, strategies)
+	if err != nil {
+		return nil, err
+	}
+
+	unique := map[string]struct{}{}
+	var matchingPatients []*patients.Patient
+	for _, filter := range filters {
+		page := store.Pagination{
+			Offset: 0,
+			Limit:  patientsMatchLimit,
+		}
+
+		result, err := patientsService.List(ctx, &filter, page, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, patient := range result.Patients {
+			if patient == nil || patient.UserId == nil {
+				continue
+			}
+			if _, found := unique[*patient.UserId]; found {
+				continue
+			}
+			unique[*patient.UserId] = struct{}{}
+			matchingPatients = append(matchingPatients, patient)
+		}
+	}
+
+	return matchingPatients, nil
+}
+
+// NewSubscriptionUpdate returns the update of the provider subscription for the given order
+func NewSubscriptionUpdate(provider Provider, order OrderRef, active bool) patients.SubscriptionUpdate {
+	return patients.SubscriptionUpdate{
+		Name:           provider.SubscriptionName(),
+		Provider:       provider.Name(),
+		Active:         active,
+		MatchedMessage: order,
+	}
+}
+
+// DeliverPatientReports calls the report delivery hook of the provider of each clinic where the patient has an
+// active subscription. Providers which retrieve the reports on demand are skipped. A failure in one clinic doesn't
+// prevent the delivery to the other clinics, the failures are logged and returned together.
+func DeliverPatientReports(ctx context.Context, registry *Registry, clinicsService clinics.Service, patientsService patients.Service, logger *zap.SugaredLogger, userId string) error {
+	hasSubscription := true
+	filter := patients.Filter{
+		UserId:          &userId,
+		HasSubscription: &hasSubscription,
+	}
+
+	var errs []error
+	for page := store.DefaultPagination().WithLimit(patientClinicsBatchSize); ; page = page.WithOffset(page.Offset + page.Limit) {
+		result, err := patientsService.List(ctx, &filter, page, nil)
+		if err != nil {
+			return stderrors.Join(append(errs, err)...)
+		}
+
+		for _, patient := range result.Patients {
+			if patient == nil || patient.ClinicId == nil {
+				continue
+			}
+
+			clinicId := patient.ClinicId.Hex()
+			if err := deliverReports(ctx, registry, clinicsService, *patient); err != nil {
+				logger.Errorw("unable to deliver patient reports", "error", err, "clinicId", clinicId, "userId", userId)
+				errs = append(errs, fmt.Errorf("clinic %s: %w", clinicId, err))
+			}
+		}
+
+		if len(result.Patients) < page.Limit {
+			return stderrors.Join(errs...)
+		}
+	}
+}
+
+func deliverReports(ctx context.Context, registry *Registry, clinicsService clinics.Service, patient patients.Patient) error {
+	clinic, err := clinicsService.Get(ctx, patient.ClinicId.Hex())
+	if err != nil {
+		return err
+	}
+
+	provider, err := registry.GetForClinic(*clinic)
+	if stderrors.Is(err, errors.NotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if subscription, ok := patient.EHRSubscriptions[provider.SubscriptionName()]; !ok || !subscription.Active {
+		return nil
+	}
+
+	err = provider.DeliverReports(ctx, *clinic, patient)
+	if err != nil && !stderrors.Is(err, ErrNotSupported) {
+		return err
+	}
+	return nil
+}
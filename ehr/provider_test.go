@@ -0,0 +1,229 @@
+package ehr_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/clinics"
+	clinicsTest "github.com/tidepool-org/clinic/clinics/test"
+	"github.com/tidepool-org/clinic/ehr"
+	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+	patientsTest "github.com/tidepool-org/clinic/patients/test"
+	"github.com/tidepool-org/clinic/redox"
+	"github.com/tidepool-org/clinic/xealth"
+)
+
+var _ = Describe("Registry", func() {
+	var registry *ehr.Registry
+
+	BeforeEach(func() {
+		var err error
+		registry, err = ehr.NewRegistry(ehr.RegistryParams{
+			Providers: []ehr.Provider{
+				xealth.NewProvider(nil, nil),
+				redox.NewProvider(nil, nil),
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("returns providers by name", func() {
+		provider, err := registry.Get(clinics.EHRProviderXealth)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(provider.Name()).To(Equal(clinics.EHRProviderXealth))
+		Expect(provider.SubscriptionName()).To(Equal(patients.SubscriptionXealthReports))
+	})
+
+	It("returns an error for unknown providers", func() {
+		_, err := registry.Get("fhir")
+		Expect(err).To(MatchError(ehr.ErrProviderNotFound))
+	})
+
+	It("lists providers ordered by name", func() {
+		providers := registry.List()
+		Expect(providers).To(HaveLen(2))
+		Expect(providers[0].Name()).To(Equal(clinics.EHRProviderRedox))
+		Expect(providers[1].Name()).To(Equal(clinics.EHRProviderXealth))
+	})
+
+	It("doesn't allow registering the same provider twice", func() {
+		Expect(registry.Register(redox.NewProvider(nil, nil))).ToNot(Succeed())
+	})
+
+	It("returns the provider of clinics with enabled ehr integration", func() {
+		clinic := clinics.Clinic{
+			EHRSettings: &clinics.EHRSettings{
+				Enabled:  true,
+				Provider: clinics.EHRProviderRedox,
+			},
+		}
+		provider, err := registry.GetForClinic(clinic)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(provider.Name()).To(Equal(clinics.EHRProviderRedox))
+
+		clinic.EHRSettings.Enabled = false
+		_, err = registry.GetForClinic(clinic)
+		Expect(err).To(MatchError(errs.NotFound))
+	})
+})
+
+type fakeProvider struct {
+	name      string
+	delivered []string
+}
+
+func (f *fakeProvider) Name() string             { return f.name }
+func (f *fakeProvider) SubscriptionName() string { return f.name + "Reports" }
+func (f *fakeProvider) MatchClinic(context.Context, string) (*clinics.Clinic, error) {
+	return nil, ehr.ErrNotSupported
+}
+func (f *fakeProvider) MatchPatients(context.Context, clinics.Clinic, ehr.PatientCriteria, []string) ([]*patients.Patient, error) {
+	return nil, ehr.ErrNotSupported
+}
+func (f *fakeProvider) OrderCreated(context.Context, clinics.Clinic, patients.Patient, ehr.OrderRef) error {
+	return nil
+}
+func (f *fakeProvider) OrderCancelled(context.Context, clinics.Clinic, patients.Patient, ehr.OrderRef) error {
+	return nil
+}
+func (f *fakeProvider) DeliverReports(_ context.Context, clinic clinics.Clinic, _ patients.Patient) error {
+	f.delivered = append(f.delivered, clinic.Id.Hex())
+	return nil
+}
+func (f *fakeProvider) SyncClinicReports(context.Context, string) error { return nil }
+
+var _ = Describe("DeliverPatientReports", func() {
+	var patientsCtrl *gomock.Controller
+	var clinicsCtrl *gomock.Controller
+	var patientsService *patientsTest.MockService
+	var clinicsService *clinicsTest.MockService
+	var provider *fakeProvider
+	var registry *ehr.Registry
+
+	BeforeEach(func() {
+		patientsCtrl = gomock.NewController(GinkgoT())
+		patientsService = patientsTest.NewMockService(patientsCtrl)
+		clinicsCtrl = gomock.NewController(GinkgoT())
+		clinicsService = clinicsTest.NewMockService(clinicsCtrl)
+
+		provider = &fakeProvider{name: "fhir"}
+		var err error
+		registry, err = ehr.NewRegistry(ehr.RegistryParams{
+			Providers: []ehr.Provider{provider},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		patientsCtrl.Finish()
+		clinicsCtrl.Finish()
+	})
+
+	userId := "1234567890"
+	newPatient := func(clinicId primitive.ObjectID, active bool) *patients.Patient {
+		return &patients.Patient{
+			ClinicId: &clinicId,
+			UserId:   &userId,
+			EHRSubscriptions: patients.EHRSubscriptions{
+				provider.SubscriptionName(): {Active: active},
+			},
+		}
+	}
+	newClinic := func(id primitive.ObjectID, enabled bool) *clinics.Clinic {
+		return &clinics.Clinic{
+			Id: &id,
+			EHRSettings: &clinics.EHRSettings{
+				Enabled:  enabled,
+				Provider: provider.Name(),
+			},
+		}
+	}
+
+	It("delivers the reports to the clinics with an active subscription and enabled integration", func() {
+		subscribed := primitive.NewObjectID()
+		inactive := primitive.NewObjectID()
+		disabled := primitive.NewObjectID()
+
+		patientsService.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&patients.ListResult{Patients: []*patients.Patient{
+				newPatient(subscribed, true),
+				newPatient(inactive, false),
+				newPatient(disabled, true),
+			}, MatchingCount: 3}, nil)
+		clinicsService.EXPECT().Get(gomock.Any(), subscribed.Hex()).Return(newClinic(subscribed, true), nil)
+		clinicsService.EXPECT().Get(gomock.Any(), inactive.Hex()).Return(newClinic(inactive, true), nil)
+		clinicsService.EXPECT().Get(gomock.Any(), disabled.Hex()).Return(newClinic(disabled, false), nil)
+
+		Expect(ehr.DeliverPatientReports(context.Background(), registry, clinicsService, patientsService, zap.NewNop().Sugar(), userId)).To(Succeed())
+		Expect(provider.delivered).To(ConsistOf(subscribed.Hex()))
+	})
+
+	It("delivers the reports to the other clinics when a clinic fails", func() {
+		failing := primitive.NewObjectID()
+		subscribed := primitive.NewObjectID()
+
+		patientsService.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&patients.ListResult{Patients: []*patients.Patient{
+				newPatient(failing, true),
+				newPatient(subscribed, true),
+			}, MatchingCount: 2}, nil)
+		clinicsService.EXPECT().Get(gomock.Any(), failing.Hex()).Return(nil, errs.NotFound)
+		clinicsService.EXPECT().Get(gomock.Any(), subscribed.Hex()).Return(newClinic(subscribed, true), nil)
+
+		err := ehr.DeliverPatientReports(context.Background(), registry, clinicsService, patientsService, zap.NewNop().Sugar(), userId)
+		Expect(err).To(MatchError(errs.NotFound))
+		Expect(err).To(MatchError(ContainSubstring(failing.Hex())))
+		Expect(provider.delivered).To(ConsistOf(subscribed.Hex()))
+	})
+})
+
+var _ = Describe("FindMatchingPatients", func() {
+	var patientsCtrl *gomock.Controller
+	var patientsService *patientsTest.MockService
+	var clinicId string
+
+	BeforeEach(func() {
+		patientsCtrl = gomock.NewController(GinkgoT())
+		patientsService = patientsTest.NewMockService(patientsCtrl)
+		clinicId = primitive.NewObjectID().Hex()
+	})
+
+	AfterEach(func() {
+		patientsCtrl.Finish()
+	})
+
+	It("returns unique patients matching any of the strategies", func() {
+		userId := "1234567890"
+		patient := &patients.Patient{UserId: &userId}
+		criteria := ehr.PatientCriteria{
+			MRN:         "1111",
+			DateOfBirth: "2010-01-01",
+			FullName:    "John Doe",
+		}
+
+		patientsService.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&patients.ListResult{Patients: []*patients.Patient{patient}, MatchingCount: 1}, nil).
+			Times(2)
+
+		result, err := ehr.FindMatchingPatients(context.Background(), patientsService, clinicId, criteria, []string{
+			ehr.MRNPatientMatchingStrategy,
+			ehr.DOBAndFullNamePatientMatchingStrategy,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(ConsistOf(patient))
+	})
+
+	It("returns an error for unknown strategies", func() {
+		_, err := ehr.FindMatchingPatients(context.Background(), patientsService, clinicId, ehr.PatientCriteria{}, []string{"SSN"})
+		Expect(err).To(MatchError(errs.BadRequest))
+	})
+})
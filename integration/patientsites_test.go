@@ -203,7 +203,7 @@ func newTestHelper(t FullGinkgoTInterface) *testHelper {
 	clinicsRepo, err := clinicsRepository.NewRepository(database, logger, lifecycle)
 	Expect(err).To(Succeed())
 
-	clinics, err := clinicsService.NewService(clinicsRepo, patientsRepo, logger)
+	clinics, err := clinicsService.NewService(clinicsRepo, patientsRepo, logger, database.Client())
 	Expect(err).To(Succeed())
 
 	patients, err := patientsService.NewService(cfg, patientsRepo, clinics, nil,
@@ -0,0 +1,111 @@
+package patients
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/pointer"
+	"github.com/tidepool-org/clinic/sites"
+)
+
+// maxReviews is the number of the most recent reviews kept for each patient
+const maxReviews = 2
+
+var ErrDuplicateMrnMismatch = fmt.Errorf("%w: the patient and the duplicate have different MRNs", errors.Conflict)
+
+// ConsolidateDuplicate returns the claimed patient with the tags, sites, reviews, EHR subscriptions and MRN of its
+// custodial duplicate in the same clinic
+func ConsolidateDuplicate(patient, duplicate Patient) (Patient, error) {
+	if patient.UserId == nil || duplicate.UserId == nil || *patient.UserId == *duplicate.UserId {
+		return patient, fmt.Errorf("%w: the patient cannot be merged with itself", errors.BadRequest)
+	}
+	if patient.IsCustodial() {
+		return patient, fmt.Errorf("%w: the patient must have claimed their account", errors.BadRequest)
+	}
+	if !duplicate.IsCustodial() {
+		return patient, fmt.Errorf("%w: only custodial duplicates can be merged", errors.BadRequest)
+	}
+
+	mrn := strings.TrimSpace(pointer.ToString(patient.Mrn))
+	duplicateMrn := strings.TrimSpace(pointer.ToString(duplicate.Mrn))
+	if mrn == "" && duplicateMrn != "" {
+		patient.Mrn = duplicate.Mrn
+	} else if mrn != "" && duplicateMrn != "" && !strings.EqualFold(mrn, duplicateMrn) {
+		return patient, ErrDuplicateMrnMismatch
+	}
+
+	patient.Tags = mergeTags(patient.Tags, duplicate.Tags)
+	patient.Sites = mergeSites(patient.Sites, duplicate.Sites)
+	patient.Reviews = mergeReviews(patient.Reviews, duplicate.Reviews)
+	patient.EHRSubscriptions = mergeEHRSubscriptions(patient.EHRSubscriptions, duplicate.EHRSubscriptions)
+
+	return patient, nil
+}
+
+func mergeTags(tags, duplicateTags *[]primitive.ObjectID) *[]primitive.ObjectID {
+	if duplicateTags == nil {
+		return tags
+	}
+
+	merged := []primitive.ObjectID{}
+	if tags != nil {
+		merged = append(merged, *tags...)
+	}
+	for _, tag := range *duplicateTags {
+		if !slices.Contains(merged, tag) {
+			merged = append(merged, tag)
+		}
+	}
+	return &merged
+}
+
+func mergeSites(patientSites, duplicateSites *[]sites.Site) *[]sites.Site {
+	if duplicateSites == nil {
+		return patientSites
+	}
+
+	merged := []sites.Site{}
+	if patientSites != nil {
+		merged = append(merged, *patientSites...)
+	}
+	for _, site := range *duplicateSites {
+		if !slices.ContainsFunc(merged, func(s sites.Site) bool { return s.Id == site.Id }) {
+			merged = append(merged, site)
+		}
+	}
+	return &merged
+}
+
+// mergeReviews keeps the most recent reviews of both patients
+func mergeReviews(reviews, duplicateReviews []Review) []Review {
+	merged := slices.Concat(reviews, duplicateReviews)
+	slices.SortStableFunc(merged, func(a, b Review) int {
+		return b.Time.Compare(a.Time)
+	})
+	if len(merged) > maxReviews {
+		merged = merged[:maxReviews]
+	}
+	return merged
+}
+
+// mergeEHRSubscriptions adds the subscriptions of the duplicate which are missing or inactive for the patient
+func mergeEHRSubscriptions(subscriptions, duplicateSubscriptions EHRSubscriptions) EHRSubscriptions {
+	if len(duplicateSubscriptions) == 0 {
+		return subscriptions
+	}
+
+	merged := make(EHRSubscriptions, len(subscriptions)+len(duplicateSubscriptions))
+	for name, subscription := range subscriptions {
+		merged[name] = subscription
+	}
+	for name, subscription := range duplicateSubscriptions {
+		if existing, ok := merged[name]; !ok || (!existing.Active && subscription.Active) {
+			merged[name] = subscription
+		}
+	}
+	return merged
+}
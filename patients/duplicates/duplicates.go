@@ -0,0 +1,262 @@
+package duplicates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/clinics/merge"
+	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/store"
+)
+
+const (
+	// CollectionName is the collection of the duplicate scans of clinics
+	CollectionName = "patient_duplicate_scans"
+
+	// patientsBatchSize is the number of patients fetched in a single query during a scan
+	patientsBatchSize = 10000
+)
+
+type Config struct {
+	// ScanEnabled enables the scheduled scans of all clinics
+	ScanEnabled bool `envconfig:"TIDEPOOL_PATIENT_DUPLICATES_SCAN_ENABLED" default:"false"`
+	// ScanInterval is the time between the scheduled scans of a clinic
+	ScanInterval   time.Duration `envconfig:"TIDEPOOL_PATIENT_DUPLICATES_SCAN_INTERVAL" default:"24h"`
+	WorkerInterval time.Duration `envconfig:"TIDEPOOL_PATIENT_DUPLICATES_WORKER_INTERVAL" default:"1h"`
+}
+
+func NewConfig() (Config, error) {
+	cfg := Config{}
+	err := envconfig.Process("", &cfg)
+	return cfg, err
+}
+
+// Scan is the result of the last duplicate scan of a clinic
+type Scan struct {
+	ClinicId     primitive.ObjectID `bson:"_id"`
+	Clusters     []Cluster          `bson:"clusters"`
+	PatientCount int                `bson:"patientCount"`
+	ScanTime     *time.Time         `bson:"scanTime,omitempty"`
+	NextScanTime time.Time          `bson:"nextScanTime"`
+}
+
+// Cluster is a group of patients which are likely duplicates of each other
+type Cluster struct {
+	Patients []ClusterPatient `bson:"patients"`
+}
+
+type ClusterPatient struct {
+	UserId      string  `bson:"userId"`
+	FullName    *string `bson:"fullName,omitempty"`
+	BirthDate   *string `bson:"birthDate,omitempty"`
+	Mrn         *string `bson:"mrn,omitempty"`
+	Email       *string `bson:"email,omitempty"`
+	IsCustodial bool    `bson:"isCustodial"`
+
+	// Duplicates is a map from conflict category to the user ids of the duplicates of the patient
+	Duplicates map[string][]string `bson:"duplicates"`
+}
+
+// Scanner detects the likely duplicate patients of a clinic with the clustering used in clinic merge reports
+type Scanner struct {
+	config     Config
+	collection *mongo.Collection
+	patients   patients.Service
+	logger     *zap.SugaredLogger
+}
+
+func NewScanner(config Config, db *mongo.Database, patientsService patients.Service, logger *zap.SugaredLogger, lifecycle fx.Lifecycle) *Scanner {
+	scanner := &Scanner{
+		config:     config,
+		collection: db.Collection(CollectionName),
+		patients:   patientsService,
+		logger:     logger,
+	}
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			return scanner.Initialize(ctx)
+		},
+	})
+
+	return scanner
+}
+
+func (s *Scanner) Initialize(ctx context.Context) error {
+	_, err := s.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "nextScanTime", Value: 1},
+		},
+		Options: options.Index().
+			SetBackground(true).
+			SetName("PatientDuplicateScansByNextScanTime"),
+	})
+	return err
+}
+
+// GetScan returns the result of the last scan of the clinic
+func (s *Scanner) GetScan(ctx context.Context, clinicId string) (*Scan, error) {
+	id, err := primitive.ObjectIDFromHex(clinicId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid clinic id", errs.BadRequest)
+	}
+
+	scan := &Scan{}
+	err = s.collection.FindOne(ctx, bson.M{"_id": id, "scanTime": bson.M{"$exists": true}}).Decode(scan)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("%w: the clinic was not scanned for duplicates", errs.NotFound)
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to get duplicate scan: %w", err)
+	}
+
+	return scan, nil
+}
+
+// Scan detects the duplicate patients of the clinic and persists the result
+func (s *Scanner) Scan(ctx context.Context, clinicId string) (*Scan, error) {
+	id, err := primitive.ObjectIDFromHex(clinicId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid clinic id", errs.BadRequest)
+	}
+
+	list, err := s.listAllPatients(ctx, clinicId)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters, err := merge.NewPatientClusterReporter(list).GetPatientClusters()
+	if err != nil {
+		return nil, fmt.Errorf("unable to detect duplicate patients: %w", err)
+	}
+
+	now := time.Now()
+	scan := &Scan{
+		ClinicId:     id,
+		Clusters:     NewClusters(clusters),
+		PatientCount: len(list),
+		ScanTime:     &now,
+		NextScanTime: now.Add(s.config.ScanInterval),
+	}
+
+	opts := options.Replace().SetUpsert(true)
+	if _, err := s.collection.ReplaceOne(ctx, bson.M{"_id": id}, scan, opts); err != nil {
+		return nil, fmt.Errorf("unable to persist duplicate scan: %w", err)
+	}
+
+	s.logger.Infow("scanned clinic for duplicate patients", "clinicId", clinicId, "patients", scan.PatientCount, "clusters", len(scan.Clusters))
+	return scan, nil
+}
+
+// CheckDuplicates returns an error if the patients are not in the same cluster of the last scan of the clinic
+func (s *Scanner) CheckDuplicates(ctx context.Context, clinicId, userId, duplicateUserId string) error {
+	id, err := primitive.ObjectIDFromHex(clinicId)
+	if err != nil {
+		return fmt.Errorf("%w: invalid clinic id", errs.BadRequest)
+	}
+
+	selector := bson.M{
+		"_id": id,
+		"clusters": bson.M{"$elemMatch": bson.M{
+			"patients.userId": bson.M{"$all": bson.A{userId, duplicateUserId}},
+		}},
+	}
+	count, err := s.collection.CountDocuments(ctx, selector)
+	if err != nil {
+		return fmt.Errorf("unable to get duplicate scan: %w", err)
+	}
+	if count == 0 {
+		return fmt.Errorf("%w: the patients were not detected as duplicates by the last scan of the clinic", errs.BadRequest)
+	}
+
+	return nil
+}
+
+// ClaimScan returns true if the scheduled scan of the clinic is due. The next scan time is updated, so the clinic is
+// not scanned by other instances.
+func (s *Scanner) ClaimScan(ctx context.Context, clinicId primitive.ObjectID) (bool, error) {
+	now := time.Now()
+	selector := bson.M{
+		"_id":          clinicId,
+		"nextScanTime": bson.M{"$lte": now},
+	}
+	update := bson.M{
+		"$set": bson.M{"nextScanTime": now.Add(s.config.ScanInterval)},
+	}
+
+	// The upsert fails with a duplicate key error when the clinic was already scanned and the scan is not due
+	_, err := s.collection.UpdateOne(ctx, selector, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("unable to claim duplicate scan: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s *Scanner) listAllPatients(ctx context.Context, clinicId string) ([]patients.Patient, error) {
+	filter := patients.Filter{
+		ClinicId:                                 &clinicId,
+		ExcludeSummaryExceptFieldsInMergeReports: true,
+		ExcludeDemo:                              true,
+	}
+	sort := []*store.Sort{{Attribute: "_id", Ascending: true}}
+
+	var list []patients.Patient
+	for page := store.DefaultPagination().WithLimit(patientsBatchSize); ; page = page.WithOffset(page.Offset + page.Limit) {
+		result, err := s.patients.List(ctx, &filter, page, sort)
+		if err != nil {
+			return nil, err
+		}
+		for _, patient := range result.Patients {
+			list = append(list, *patient)
+		}
+		if len(result.Patients) < page.Limit {
+			return list, nil
+		}
+	}
+}
+
+// NewClusters returns the clusters of the patients with their identifying attributes
+func NewClusters(clusters merge.PatientClusters) []Cluster {
+	result := make([]Cluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		c := Cluster{Patients: make([]ClusterPatient, 0, len(cluster.Patients))}
+		for _, p := range cluster.Patients {
+			duplicates := make(map[string][]string, len(p.Conflicts))
+			for category, userIds := range p.Conflicts {
+				duplicates[category] = slices.Sorted(slices.Values(userIds))
+			}
+			c.Patients = append(c.Patients, ClusterPatient{
+				UserId:      *p.Patient.UserId,
+				FullName:    p.Patient.FullName,
+				BirthDate:   p.Patient.BirthDate,
+				Mrn:         p.Patient.Mrn,
+				Email:       p.Patient.Email,
+				IsCustodial: p.Patient.IsCustodial(),
+				Duplicates:  duplicates,
+			})
+		}
+		slices.SortFunc(c.Patients, func(a, b ClusterPatient) int {
+			return strings.Compare(a.UserId, b.UserId)
+		})
+		result = append(result, c)
+	}
+	slices.SortFunc(result, func(a, b Cluster) int {
+		return strings.Compare(a.Patients[0].UserId, b.Patients[0].UserId)
+	})
+	return result
+}
@@ -0,0 +1,60 @@
+package duplicates_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/fx/fxtest"
+	"go.uber.org/zap"
+
+	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients/duplicates"
+	dbTest "github.com/tidepool-org/clinic/store/test"
+)
+
+var _ = Describe("Scanner", func() {
+	var scanner *duplicates.Scanner
+	var collection *mongo.Collection
+	var clinicId primitive.ObjectID
+
+	BeforeEach(func() {
+		database := dbTest.GetTestDatabase()
+		collection = database.Collection(duplicates.CollectionName)
+		scanner = duplicates.NewScanner(duplicates.Config{}, database, nil, zap.NewNop().Sugar(), fxtest.NewLifecycle(GinkgoT()))
+
+		clinicId = primitive.NewObjectID()
+		_, err := collection.InsertOne(context.Background(), bson.M{
+			"_id": clinicId,
+			"clusters": bson.A{
+				bson.M{"patients": bson.A{bson.M{"userId": "1111111111"}, bson.M{"userId": "2222222222"}}},
+				bson.M{"patients": bson.A{bson.M{"userId": "3333333333"}}},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		_, err := collection.DeleteOne(context.Background(), bson.M{"_id": clinicId})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("CheckDuplicates", func() {
+		It("accepts patients in the same cluster of the last scan", func() {
+			Expect(scanner.CheckDuplicates(context.Background(), clinicId.Hex(), "2222222222", "1111111111")).To(Succeed())
+		})
+
+		It("rejects patients which are not in the same cluster of the last scan", func() {
+			err := scanner.CheckDuplicates(context.Background(), clinicId.Hex(), "1111111111", "3333333333")
+			Expect(err).To(MatchError(errs.BadRequest))
+		})
+
+		It("rejects patients of clinics which were not scanned", func() {
+			err := scanner.CheckDuplicates(context.Background(), primitive.NewObjectID().Hex(), "1111111111", "2222222222")
+			Expect(err).To(MatchError(errs.BadRequest))
+		})
+	})
+})
@@ -0,0 +1,115 @@
+package duplicates
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/store"
+)
+
+// clinicsBatchSize is the number of clinics fetched in a single query by the worker
+const clinicsBatchSize = 1000
+
+// Worker periodically scans all clinics for duplicate patients
+type Worker struct {
+	config  Config
+	clinics clinics.Service
+	scanner *Scanner
+	logger  *zap.SugaredLogger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewWorker(config Config, clinicsService clinics.Service, scanner *Scanner, logger *zap.SugaredLogger, lifecycle fx.Lifecycle) *Worker {
+	ctx, cancel := context.WithCancel(context.Background())
+	worker := &Worker{
+		config:  config,
+		clinics: clinicsService,
+		scanner: scanner,
+		logger:  logger,
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+
+	if !config.ScanEnabled {
+		return worker
+	}
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go worker.run()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			worker.cancel()
+			select {
+			case <-worker.done:
+			case <-ctx.Done():
+			}
+			return nil
+		},
+	})
+
+	return worker
+}
+
+func (w *Worker) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.config.WorkerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+			w.ScanDueClinics()
+		}
+	}
+}
+
+// ScanDueClinics scans the clinics which weren't scanned within the scan interval
+func (w *Worker) ScanDueClinics() {
+	for page := store.DefaultPagination().WithLimit(clinicsBatchSize); w.ctx.Err() == nil; page = page.WithOffset(page.Offset + page.Limit) {
+		list, err := w.clinics.List(w.ctx, &clinics.Filter{}, page)
+		if err != nil {
+			w.logger.Errorw("unable to list clinics for duplicate scans", "error", err)
+			return
+		}
+
+		for _, clinic := range list {
+			if w.ctx.Err() != nil {
+				return
+			}
+			w.scanClinic(*clinic)
+		}
+
+		if len(list) < page.Limit {
+			return
+		}
+	}
+}
+
+func (w *Worker) scanClinic(clinic clinics.Clinic) {
+	clinicId := clinic.Id.Hex()
+	due, err := w.scanner.ClaimScan(w.ctx, *clinic.Id)
+	if err != nil {
+		w.logger.Errorw("unable to claim duplicate scan", "clinicId", clinicId, "error", err)
+		return
+	}
+	if !due {
+		return
+	}
+
+	if _, err := w.scanner.Scan(w.ctx, clinicId); err != nil {
+		w.logger.Errorw("unable to scan clinic for duplicate patients", "clinicId", clinicId, "error", err)
+	}
+}
@@ -0,0 +1,111 @@
+package patients_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+	patientsTest "github.com/tidepool-org/clinic/patients/test"
+	"github.com/tidepool-org/clinic/sites"
+)
+
+var _ = Describe("Duplicates", func() {
+	Describe("ConsolidateDuplicate", func() {
+		var patient patients.Patient
+		var duplicate patients.Patient
+
+		BeforeEach(func() {
+			patient = patientsTest.RandomPatient()
+			patient.Permissions = &patients.Permissions{View: &patients.Permission{}}
+
+			duplicate = patientsTest.RandomPatient()
+			duplicate.ClinicId = patient.ClinicId
+			duplicate.Mrn = patient.Mrn
+			duplicate.Permissions = &patients.CustodialAccountPermissions
+		})
+
+		It("merges the tags and sites of the duplicate", func() {
+			shared := sites.Site{Id: primitive.NewObjectID(), Name: "Shared"}
+			other := sites.Site{Id: primitive.NewObjectID(), Name: "Other"}
+			patient.Sites = &[]sites.Site{shared}
+			duplicate.Sites = &[]sites.Site{shared, other}
+			duplicateTags := append([]primitive.ObjectID{primitive.NewObjectID()}, *patient.Tags...)
+			duplicate.Tags = &duplicateTags
+
+			result, err := patients.ConsolidateDuplicate(patient, duplicate)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Tags).To(PointTo(ConsistOf(duplicateTags)))
+			Expect(result.Sites).To(PointTo(ConsistOf(shared, other)))
+		})
+
+		It("keeps the most recent reviews", func() {
+			now := time.Now()
+			patient.Reviews = []patients.Review{{ClinicianId: "1", Time: now.Add(-time.Hour)}, {ClinicianId: "2", Time: now.Add(-3 * time.Hour)}}
+			duplicate.Reviews = []patients.Review{{ClinicianId: "3", Time: now.Add(-2 * time.Hour)}}
+
+			result, err := patients.ConsolidateDuplicate(patient, duplicate)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Reviews).To(HaveLen(2))
+			Expect(result.Reviews[0].ClinicianId).To(Equal("1"))
+			Expect(result.Reviews[1].ClinicianId).To(Equal("3"))
+		})
+
+		It("adds the active EHR subscriptions of the duplicate", func() {
+			patient.EHRSubscriptions = patients.EHRSubscriptions{
+				patients.SubscriptionRedoxSummaryAndReports: {Active: false, Provider: "redox"},
+			}
+			duplicate.EHRSubscriptions = patients.EHRSubscriptions{
+				patients.SubscriptionRedoxSummaryAndReports: {Active: true, Provider: "redox"},
+				patients.SubscriptionXealthReports:          {Active: false, Provider: "xealth"},
+			}
+
+			result, err := patients.ConsolidateDuplicate(patient, duplicate)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.EHRSubscriptions).To(HaveLen(2))
+			Expect(result.EHRSubscriptions[patients.SubscriptionRedoxSummaryAndReports].Active).To(BeTrue())
+			Expect(result.EHRSubscriptions).To(HaveKey(patients.SubscriptionXealthReports))
+		})
+
+		It("uses the MRN of the duplicate when the patient doesn't have one", func() {
+			patient.Mrn = nil
+
+			result, err := patients.ConsolidateDuplicate(patient, duplicate)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Mrn).To(Equal(duplicate.Mrn))
+		})
+
+		It("returns a conflict when the MRNs are different", func() {
+			mrn := "different"
+			duplicate.Mrn = &mrn
+
+			_, err := patients.ConsolidateDuplicate(patient, duplicate)
+			Expect(err).To(MatchError(errs.Conflict))
+		})
+
+		It("doesn't merge a duplicate which is not custodial", func() {
+			duplicate.Permissions = &patients.Permissions{View: &patients.Permission{}}
+
+			_, err := patients.ConsolidateDuplicate(patient, duplicate)
+			Expect(err).To(MatchError(errs.BadRequest))
+		})
+
+		It("doesn't merge into a custodial patient", func() {
+			patient.Permissions = &patients.CustodialAccountPermissions
+
+			_, err := patients.ConsolidateDuplicate(patient, duplicate)
+			Expect(err).To(MatchError(errs.BadRequest))
+		})
+
+		It("doesn't merge the patient with itself", func() {
+			duplicate.UserId = patient.UserId
+
+			_, err := patients.ConsolidateDuplicate(patient, duplicate)
+			Expect(err).To(MatchError(errs.BadRequest))
+		})
+	})
+})
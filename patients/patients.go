@@ -21,12 +21,13 @@ const (
 )
 
 var (
-	ErrNotFound           = fmt.Errorf("patient %w", errors.NotFound)
-	ErrSummaryNotFound    = fmt.Errorf("summary %w", errors.NoChange)
-	ErrPermissionNotFound = fmt.Errorf("permission %w", errors.NotFound)
-	ErrDuplicatePatient   = fmt.Errorf("%w: patient is already a member of the clinic", errors.Duplicate)
-	ErrDuplicateEmail     = fmt.Errorf("%w: email address is already taken", errors.Duplicate)
-	ErrReviewNotOwner     = fmt.Errorf("%w: cannot revert review from another clinician", errors.Conflict)
+	ErrNotFound               = fmt.Errorf("patient %w", errors.NotFound)
+	ErrSummaryNotFound        = fmt.Errorf("summary %w", errors.NoChange)
+	ErrPermissionNotFound     = fmt.Errorf("permission %w", errors.NotFound)
+	ErrDuplicatePatient       = fmt.Errorf("%w: patient is already a member of the clinic", errors.Duplicate)
+	ErrDuplicateEmail         = fmt.Errorf("%w: email address is already taken", errors.Duplicate)
+	ErrReviewNotOwner         = fmt.Errorf("%w: cannot revert review from another clinician", errors.Conflict)
+	ErrDuplicateAgeTransition = fmt.Errorf("%w: age transition was already recorded", errors.Duplicate)
 
 	PendingDataSourceExpirationDuration = time.Hour * 24 * 30
 
@@ -57,6 +58,7 @@ type Service interface {
 	DeleteReview(ctx context.Context, clinicId, clinicianId, userId string) ([]Review, error)
 	UpdateEmail(ctx context.Context, userId string, email *string) error
 	Remove(ctx context.Context, clinicId string, userId string, metadata deletions.Metadata) error
+	MergeDuplicate(ctx context.Context, clinicId, userId, duplicateUserId string, metadata deletions.Metadata) (*Patient, error)
 	UpdatePermissions(ctx context.Context, clinicId, userId string, permissions *Permissions) (*Patient, error)
 	DeletePermission(ctx context.Context, clinicId, userId, permission string) (*Patient, error)
 	DeleteFromAllClinics(ctx context.Context, userId string, metadata deletions.Metadata) ([]string, error)
@@ -71,6 +73,7 @@ type Service interface {
 	UpdatePatientDataSources(ctx context.Context, userId string, dataSources *DataSources) error
 	TideReport(ctx context.Context, clinicId string, params TideReportParams) (*Tide, error)
 	UpdateEHRSubscription(ctx context.Context, clinicId, userId string, update SubscriptionUpdate) error
+	AddAgeTransition(ctx context.Context, clinicId, userId string, transition AgeTransition) error
 	RescheduleLastSubscriptionOrderForAllPatients(ctx context.Context, clinicId, subscription, ordersCollection, targetCollection string) error
 	RescheduleLastSubscriptionOrderForPatient(ctx context.Context, clinicIds []string, userId, subscription, ordersCollection, targetCollection string) error
 	DeleteSites(ctx context.Context, clinicId string, siteId string) error
@@ -123,6 +126,7 @@ type Patient struct {
 	Sites                      *[]sites.Site              `bson:"sites,omitempty"`
 	GlycemicRanges             GlycemicRanges             `bson:"glycemicRanges,omitempty"`
 	DiagnosisType              *DiagnosisType             `bson:"diagnosisType,omitempty"`
+	AgeTransitions             []AgeTransition            `bson:"ageTransitions,omitempty"`
 
 	// DEPRECATED: Remove when Tidepool Web starts using provider connection requests
 	LastRequestedDexcomConnectTime time.Time `bson:"lastRequestedDexcomConnectTime,omitempty"`
@@ -212,6 +216,15 @@ type MatchedMessage struct {
 	EventType  string             `bson:"eventType"`
 }
 
+// AgeTransition records an action taken when a patient reached an age threshold (e.g. the age of consent)
+type AgeTransition struct {
+	Type        string    `bson:"type"`
+	Age         int       `bson:"age"`
+	Provider    string    `bson:"provider"`
+	Result      string    `bson:"result,omitempty"`
+	CreatedTime time.Time `bson:"createdTime"`
+}
+
 type Review struct {
 	ClinicianId string    `json:"clinicianId"`
 	Time        time.Time `json:"time"`
@@ -229,9 +242,10 @@ type ConnectionRequest struct {
 }
 
 type SubscriptionUpdate struct {
-	Name           string
-	Provider       string
-	Active         bool
+	Name     string
+	Provider string
+	Active   bool
+	// MatchedMessage is the message which caused the update. It's not set when the subscription is updated by the service.
 	MatchedMessage MatchedMessage
 }
 
@@ -367,6 +381,8 @@ type TideReportParams struct {
 	LastDataCutoff time.Time
 	Categories     []string
 	ExcludeNoData  bool
+	// Sites limits the report to the patients assigned to at least one of the sites
+	Sites *[]string
 }
 
 type GlycemicRangeType string
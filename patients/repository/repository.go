@@ -322,6 +322,52 @@ func (r *repository) Remove(ctx context.Context, clinicId string, userId string,
 	return nil
 }
 
+// MergeDuplicate consolidates the custodial duplicate into the claimed patient and removes the duplicate
+func (r *repository) MergeDuplicate(ctx context.Context, clinicId, userId, duplicateUserId string, metadata deletions.Metadata) (*patients.Patient, error) {
+	patient, err := r.Get(ctx, clinicId, userId)
+	if err != nil {
+		return nil, err
+	}
+	duplicate, err := r.Get(ctx, clinicId, duplicateUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	consolidated, err := patients.ConsolidateDuplicate(*patient, *duplicate)
+	if err != nil {
+		return nil, err
+	}
+
+	// The duplicate is removed first, because the MRN of the duplicate may be unique in the clinic
+	if err := r.Remove(ctx, clinicId, duplicateUserId, metadata); err != nil {
+		return nil, err
+	}
+
+	selector := bson.M{
+		"userId":      patient.UserId,
+		"clinicId":    patient.ClinicId,
+		"updatedTime": patient.UpdatedTime,
+	}
+	res, err := r.collection.UpdateOne(ctx, selector, bson.M{
+		"$set": bson.M{
+			"mrn":              consolidated.Mrn,
+			"tags":             consolidated.Tags,
+			"sites":            consolidated.Sites,
+			"reviews":          consolidated.Reviews,
+			"ehrSubscriptions": consolidated.EHRSubscriptions,
+			"updatedTime":      time.Now(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, fmt.Errorf("%w: the patient was updated concurrently", errors2.Conflict)
+	}
+
+	return r.Get(ctx, clinicId, userId)
+}
+
 func (r *repository) Count(ctx context.Context, filter *patients.Filter) (int, error) {
 	count, err := r.collection.CountDocuments(ctx, r.generateListFilterQuery(filter))
 	if err != nil {
@@ -1145,7 +1191,9 @@ func (r *repository) UpdateEHRSubscription(ctx context.Context, clinicId, patien
 	}
 
 	subscription.Active = update.Active
-	subscription.MatchedMessages = append(subscription.MatchedMessages, update.MatchedMessage)
+	if !update.MatchedMessage.DocumentId.IsZero() {
+		subscription.MatchedMessages = append(subscription.MatchedMessages, update.MatchedMessage)
+	}
 	subscription.Provider = update.Provider
 	subscription.UpdatedAt = now
 	subscriptions[update.Name] = subscription
@@ -1165,6 +1213,40 @@ func (r *repository) UpdateEHRSubscription(ctx context.Context, clinicId, patien
 	return nil
 }
 
+// AddAgeTransition records the age transition of the patient, unless a transition of the same type was already recorded
+func (r *repository) AddAgeTransition(ctx context.Context, clinicId, userId string, transition patients.AgeTransition) error {
+	patient, err := r.Get(ctx, clinicId, userId)
+	if err != nil {
+		return err
+	}
+
+	selector := bson.M{
+		"clinicId":            patient.ClinicId,
+		"userId":              patient.UserId,
+		"ageTransitions.type": bson.M{"$ne": transition.Type},
+	}
+
+	if transition.CreatedTime.IsZero() {
+		transition.CreatedTime = time.Now()
+	}
+
+	res, err := r.collection.UpdateOne(ctx, selector, bson.M{
+		"$push": bson.M{
+			"ageTransitions": transition,
+		},
+		"$set": bson.M{
+			"updatedTime": time.Now(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error adding age transition: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return patients.ErrDuplicateAgeTransition
+	}
+	return nil
+}
+
 func (r *repository) generateListFilterQuery(filter *patients.Filter) bson.M {
 	selector := bson.M{}
 	orSelectors := bson.A{}
@@ -1749,6 +1831,7 @@ func (r *repository) TideReport(ctx context.Context, clinicId string, params pat
 			"tags":                            bson.M{"$all": tags},
 			"summary.cgmStats.dates.lastData": bson.M{"$gte": params.LastDataCutoff},
 		}
+		addTideSitesSelector(selector, params.Sites)
 
 		opts := options.Find()
 		opts.SetLimit(int64(remaining))
@@ -1811,6 +1894,8 @@ func (r *repository) TideReport(ctx context.Context, clinicId string, params pat
 			},
 		}
 
+		addTideSitesSelector(selector, params.Sites)
+
 		opts := options.Find()
 		opts.SetLimit(int64(TideReportNoDataPatientLimit))
 
@@ -1841,6 +1926,12 @@ func (r *repository) TideReport(ctx context.Context, clinicId string, params pat
 	return &tide, nil
 }
 
+func addTideSitesSelector(selector bson.M, sites *[]string) {
+	if sites != nil {
+		selector["sites.id"] = bson.M{"$in": store.ObjectIDSFromStringArray(*sites)}
+	}
+}
+
 func (r *repository) DeleteSites(ctx context.Context, clinicId, siteId string) error {
 	siteOID, err := primitive.ObjectIDFromHex(siteId)
 	if err != nil {
@@ -26,6 +26,7 @@ import (
 
 	"github.com/tidepool-org/clinic/config"
 	"github.com/tidepool-org/clinic/deletions"
+	errors2 "github.com/tidepool-org/clinic/errors"
 	"github.com/tidepool-org/clinic/patients"
 	patientsRepository "github.com/tidepool-org/clinic/patients/repository"
 	patientsTest "github.com/tidepool-org/clinic/patients/test"
@@ -567,6 +568,58 @@ var _ = Describe("Patients Repository", func() {
 			})
 		})
 
+		Describe("MergeDuplicate", func() {
+			var patient patients.Patient
+			var duplicate patients.Patient
+
+			BeforeEach(func() {
+				patient = patientsTest.RandomPatient()
+				patient.ClinicId = &clinicId
+				patient.Mrn = nil
+				patient.Permissions = &patients.Permissions{View: &patients.Permission{}}
+
+				duplicate = patientsTest.RandomPatient()
+				duplicate.ClinicId = &clinicId
+				duplicate.Permissions = &patients.CustodialAccountPermissions
+
+				_, err := collection.InsertMany(context.Background(), []interface{}{patient, duplicate})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				_, err := collection.DeleteMany(context.Background(), bson.M{"clinicId": clinicId, "userId": bson.M{"$in": []string{*patient.UserId, *duplicate.UserId}}})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("consolidates the duplicate into the patient", func() {
+				result, err := repo.MergeDuplicate(context.Background(), clinicIdString, *patient.UserId, *duplicate.UserId, deletions.Metadata{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Mrn).To(Equal(duplicate.Mrn))
+				Expect(result.Tags).To(PointTo(ConsistOf(append(*patient.Tags, *duplicate.Tags...))))
+				Expect(result.UpdatedTime).To(BeTemporally(">", patient.UpdatedTime))
+			})
+
+			It("removes the duplicate and creates a deletion record", func() {
+				_, err := repo.MergeDuplicate(context.Background(), clinicIdString, *patient.UserId, *duplicate.UserId, deletions.Metadata{})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = repo.Get(context.Background(), clinicIdString, *duplicate.UserId)
+				Expect(err).To(MatchError(errors2.NotFound))
+
+				count, err := deletionsCollection.CountDocuments(context.Background(), bson.M{"patient.userId": duplicate.UserId, "patient.clinicId": clinicId})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(BeNumerically("==", 1))
+			})
+
+			It("doesn't merge a patient which is not custodial", func() {
+				_, err := repo.MergeDuplicate(context.Background(), clinicIdString, *duplicate.UserId, *patient.UserId, deletions.Metadata{})
+				Expect(err).To(MatchError(errors2.BadRequest))
+
+				_, err = repo.Get(context.Background(), clinicIdString, *patient.UserId)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
 		Describe("Delete from all clinics", func() {
 			It("deletes the correct patients", func() {
 				// Add the same user to  a different clinic
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 
@@ -47,13 +48,53 @@ func (s *service) Get(ctx context.Context, clinicId string, userId string) (*pat
 }
 
 func (s *service) Count(ctx context.Context, filter *patients.Filter) (int, error) {
+	filter, err := s.expandSitesFilter(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
 	return s.patientsRepo.Count(ctx, filter)
 }
 
 func (s *service) List(ctx context.Context, filter *patients.Filter, pagination store.Pagination, sorts []*store.Sort) (*patients.ListResult, error) {
+	filter, err := s.expandSitesFilter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
 	return s.patientsRepo.List(ctx, filter, pagination, sorts)
 }
 
+// expandSitesFilter returns a copy of the filter which includes the descendants of the
+// sites, so that filtering by a region includes the patients of all of its clinics and
+// departments.
+func (s *service) expandSitesFilter(ctx context.Context, filter *patients.Filter) (*patients.Filter, error) {
+	if filter == nil || filter.Sites == nil || len(*filter.Sites) == 0 || filter.ClinicId == nil {
+		return filter, nil
+	}
+	if _, err := primitive.ObjectIDFromHex(*filter.ClinicId); err != nil {
+		return filter, nil
+	}
+	clinicSites, err := s.clinicsService.ListSites(ctx, *filter.ClinicId)
+	if err != nil {
+		return nil, err
+	}
+	expanded := sites.NewHierarchy(clinicSites).ExpandIds(*filter.Sites)
+	copied := *filter
+	copied.Sites = &expanded
+	return &copied, nil
+}
+
+func (s *service) TideReport(ctx context.Context, clinicId string, params patients.TideReportParams) (*patients.Tide, error) {
+	if params.Sites != nil && len(*params.Sites) > 0 {
+		clinicSites, err := s.clinicsService.ListSites(ctx, clinicId)
+		if err != nil {
+			return nil, err
+		}
+		expanded := sites.NewHierarchy(clinicSites).ExpandIds(*params.Sites)
+		params.Sites = &expanded
+	}
+	return s.patientsRepo.TideReport(ctx, clinicId, params)
+}
+
 func (s *service) Create(ctx context.Context, patient patients.Patient) (*patients.Patient, error) {
 	clinicId := patient.ClinicId.Hex()
 
@@ -164,6 +205,9 @@ func (s *service) resolveSites(ctx context.Context,
 				"clinicId", clinicId)
 			return nil, clinics.ErrSiteNotFound
 		}
+		// The hierarchy and the settings are only maintained on the clinic sites
+		clinicSite.ParentId = nil
+		clinicSite.Settings = nil
 		fromClinic = append(fromClinic, clinicSite)
 	}
 	return fromClinic, nil
@@ -206,6 +250,20 @@ func (s *service) Remove(ctx context.Context, clinicId string, userId string, me
 	return nil
 }
 
+func (s *service) MergeDuplicate(ctx context.Context, clinicId, userId, duplicateUserId string, metadata deletions.Metadata) (*patients.Patient, error) {
+	s.logger.Infow("merging duplicate patient", "userId", userId, "duplicateUserId", duplicateUserId, "clinicId", clinicId)
+	res, err := store.WithTransaction(ctx, s.dbClient, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return s.patientsRepo.MergeDuplicate(sessionCtx, clinicId, userId, duplicateUserId, metadata)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_ = s.clinicsService.RefreshPatientCount(ctx, clinicId) // Ignore any error, already logged
+
+	return res.(*patients.Patient), nil
+}
+
 func (s *service) UpdatePermissions(ctx context.Context, clinicId, userId string, permissions *patients.Permissions) (*patients.Patient, error) {
 	res, err := store.WithTransaction(ctx, s.dbClient, func(sessionCtx mongo.SessionContext) (interface{}, error) {
 		if permissions != nil && permissions.Custodian != nil {
@@ -376,6 +434,10 @@ func (s *service) UpdateEHRSubscription(ctx context.Context, clinicId, userId st
 	return s.patientsRepo.UpdateEHRSubscription(ctx, clinicId, userId, update)
 }
 
+func (s *service) AddAgeTransition(ctx context.Context, clinicId, userId string, transition patients.AgeTransition) error {
+	return s.patientsRepo.AddAgeTransition(ctx, clinicId, userId, transition)
+}
+
 func (s *service) RescheduleLastSubscriptionOrderForAllPatients(ctx context.Context, clinicId, subscription, ordersCollection, targetCollection string) error {
 	s.logger.Infow("rescheduling all patient subscriptions", "subscription", subscription, "clinicId", clinicId)
 	return s.patientsRepo.RescheduleLastSubscriptionOrderForAllPatients(ctx, clinicId, subscription, ordersCollection, targetCollection)
@@ -502,10 +564,6 @@ func getUpdatedBy(update patients.PatientUpdate) *string {
 	return update.Patient.InvitedBy
 }
 
-func (s *service) TideReport(ctx context.Context, clinicId string, params patients.TideReportParams) (*patients.Tide, error) {
-	return s.patientsRepo.TideReport(ctx, clinicId, params)
-}
-
 func mrnChanged(existing patients.Patient, updated patients.Patient) bool {
 	return (existing.Mrn == nil && updated.Mrn != nil) ||
 		(existing.Mrn != nil && updated.Mrn == nil) ||
@@ -44,6 +44,20 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AddAgeTransition mocks base method.
+func (m *MockService) AddAgeTransition(ctx context.Context, clinicId, userId string, transition patients.AgeTransition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAgeTransition", ctx, clinicId, userId, transition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAgeTransition indicates an expected call of AddAgeTransition.
+func (mr *MockServiceMockRecorder) AddAgeTransition(ctx, clinicId, userId, transition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAgeTransition", reflect.TypeOf((*MockService)(nil).AddAgeTransition), ctx, clinicId, userId, transition)
+}
+
 // AddProviderConnectionRequest mocks base method.
 func (m *MockService) AddProviderConnectionRequest(ctx context.Context, clinicId, userId string, request patients.ConnectionRequest) error {
 	m.ctrl.T.Helper()
@@ -262,6 +276,21 @@ func (mr *MockServiceMockRecorder) List(ctx, filter, pagination, sort any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockService)(nil).List), ctx, filter, pagination, sort)
 }
 
+// MergeDuplicate mocks base method.
+func (m *MockService) MergeDuplicate(ctx context.Context, clinicId, userId, duplicateUserId string, metadata deletions.Metadata) (*patients.Patient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeDuplicate", ctx, clinicId, userId, duplicateUserId, metadata)
+	ret0, _ := ret[0].(*patients.Patient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeDuplicate indicates an expected call of MergeDuplicate.
+func (mr *MockServiceMockRecorder) MergeDuplicate(ctx, clinicId, userId, duplicateUserId, metadata any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDuplicate", reflect.TypeOf((*MockService)(nil).MergeDuplicate), ctx, clinicId, userId, duplicateUserId, metadata)
+}
+
 // MergeSites mocks base method.
 func (m *MockService) MergeSites(ctx context.Context, clinicId, sourceSiteId string, targetSite *sites.Site) error {
 	m.ctrl.T.Helper()
@@ -472,6 +501,20 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddAgeTransition mocks base method.
+func (m *MockRepository) AddAgeTransition(ctx context.Context, clinicId, userId string, transition patients.AgeTransition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAgeTransition", ctx, clinicId, userId, transition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAgeTransition indicates an expected call of AddAgeTransition.
+func (mr *MockRepositoryMockRecorder) AddAgeTransition(ctx, clinicId, userId, transition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAgeTransition", reflect.TypeOf((*MockRepository)(nil).AddAgeTransition), ctx, clinicId, userId, transition)
+}
+
 // AddProviderConnectionRequest mocks base method.
 func (m *MockRepository) AddProviderConnectionRequest(ctx context.Context, clinicId, userId string, request patients.ConnectionRequest) error {
 	m.ctrl.T.Helper()
@@ -720,6 +763,21 @@ func (mr *MockRepositoryMockRecorder) List(ctx, filter, pagination, sort any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, filter, pagination, sort)
 }
 
+// MergeDuplicate mocks base method.
+func (m *MockRepository) MergeDuplicate(ctx context.Context, clinicId, userId, duplicateUserId string, metadata deletions.Metadata) (*patients.Patient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeDuplicate", ctx, clinicId, userId, duplicateUserId, metadata)
+	ret0, _ := ret[0].(*patients.Patient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeDuplicate indicates an expected call of MergeDuplicate.
+func (mr *MockRepositoryMockRecorder) MergeDuplicate(ctx, clinicId, userId, duplicateUserId, metadata any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDuplicate", reflect.TypeOf((*MockRepository)(nil).MergeDuplicate), ctx, clinicId, userId, duplicateUserId, metadata)
+}
+
 // MergeSites mocks base method.
 func (m *MockRepository) MergeSites(ctx context.Context, clinicId, sourceSiteId string, targetSite *sites.Site) error {
 	m.ctrl.T.Helper()
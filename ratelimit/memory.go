@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+)
+
+type bucketState struct {
+	tokens  float64
+	updated time.Time
+}
+
+// MemoryLimiter keeps the token buckets in memory, so the limits are enforced per instance of the service
+type MemoryLimiter struct {
+	mu      sync.Mutex
+	buckets *simplelru.LRU
+	now     func() time.Time
+}
+
+var _ Limiter = &MemoryLimiter{}
+
+func NewMemoryLimiter(config Config) (Limiter, error) {
+	buckets, err := simplelru.NewLRU(config.MaxBuckets, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MemoryLimiter{
+		buckets: buckets,
+		now:     time.Now,
+	}, nil
+}
+
+func (m *MemoryLimiter) Take(_ context.Context, cost float64, buckets ...Bucket) (Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	states := make([]*bucketState, len(buckets))
+	for i, bucket := range buckets {
+		state := m.getBucketState(bucket, now)
+		states[i] = state
+
+		// Requests which cost more than the capacity of the bucket are allowed when the bucket is full
+		required := math.Min(cost, bucket.Limit.Burst)
+		if state.tokens < required {
+			return Result{
+				Allowed:    false,
+				RetryAfter: time.Duration((required - state.tokens) / bucket.Limit.Rate * float64(time.Second)),
+				Bucket:     bucket.Key,
+			}, nil
+		}
+	}
+
+	for i, bucket := range buckets {
+		states[i].tokens -= math.Min(cost, bucket.Limit.Burst)
+	}
+
+	return Result{Allowed: true}, nil
+}
+
+// getBucketState returns the state of the bucket with the tokens replenished since the last update
+func (m *MemoryLimiter) getBucketState(bucket Bucket, now time.Time) *bucketState {
+	if s, ok := m.buckets.Get(bucket.Key); ok {
+		state := s.(*bucketState)
+		elapsed := now.Sub(state.updated).Seconds()
+		state.tokens = math.Min(bucket.Limit.Burst, state.tokens+elapsed*bucket.Limit.Rate)
+		state.updated = now
+		return state
+	}
+
+	state := &bucketState{
+		tokens:  bucket.Limit.Burst,
+		updated: now,
+	}
+	m.buckets.Add(bucket.Key, state)
+	return state
+}
@@ -0,0 +1,71 @@
+package ratelimit_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tidepool-org/clinic/ratelimit"
+)
+
+var _ = Describe("Memory Limiter", func() {
+	var limiter ratelimit.Limiter
+	subject := ratelimit.Bucket{Key: "subject:1234567890", Limit: ratelimit.Limit{Rate: 1, Burst: 2}}
+	clinic := ratelimit.Bucket{Key: "clinic:6066fbabc6f484277200ac64", Limit: ratelimit.Limit{Rate: 1, Burst: 10}}
+
+	BeforeEach(func() {
+		var err error
+		limiter, err = ratelimit.NewMemoryLimiter(ratelimit.Config{MaxBuckets: 10})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("allows requests up to the burst", func() {
+		for i := 0; i < 2; i++ {
+			result, err := limiter.Take(context.Background(), 1, subject)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Allowed).To(BeTrue())
+		}
+
+		result, err := limiter.Take(context.Background(), 1, subject)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Allowed).To(BeFalse())
+		Expect(result.Bucket).To(Equal(subject.Key))
+		Expect(result.RetryAfter).To(BeNumerically("~", time.Second, 100*time.Millisecond))
+	})
+
+	It("replenishes the tokens over time", func() {
+		fast := ratelimit.Bucket{Key: "subject:fast", Limit: ratelimit.Limit{Rate: 100, Burst: 1}}
+		Expect(limiter.Take(context.Background(), 1, fast)).To(HaveField("Allowed", BeTrue()))
+		Expect(limiter.Take(context.Background(), 1, fast)).To(HaveField("Allowed", BeFalse()))
+
+		Eventually(func() (ratelimit.Result, error) {
+			return limiter.Take(context.Background(), 1, fast)
+		}).Should(HaveField("Allowed", BeTrue()))
+	})
+
+	It("charges expensive requests more", func() {
+		Expect(limiter.Take(context.Background(), 2, subject)).To(HaveField("Allowed", BeTrue()))
+		Expect(limiter.Take(context.Background(), 1, subject)).To(HaveField("Allowed", BeFalse()))
+	})
+
+	It("allows requests which cost more than the burst when the bucket is full", func() {
+		Expect(limiter.Take(context.Background(), 20, subject)).To(HaveField("Allowed", BeTrue()))
+		Expect(limiter.Take(context.Background(), 1, subject)).To(HaveField("Allowed", BeFalse()))
+	})
+
+	It("doesn't take tokens from any bucket when a request is denied", func() {
+		Expect(limiter.Take(context.Background(), 2, subject, clinic)).To(HaveField("Allowed", BeTrue()))
+
+		result, err := limiter.Take(context.Background(), 2, subject, clinic)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Allowed).To(BeFalse())
+		Expect(result.Bucket).To(Equal(subject.Key))
+
+		// The clinic still has the eight tokens which weren't taken by the denied request
+		other := ratelimit.Bucket{Key: "subject:other", Limit: clinic.Limit}
+		Expect(limiter.Take(context.Background(), 8, other, clinic)).To(HaveField("Allowed", BeTrue()))
+		Expect(limiter.Take(context.Background(), 1, other, clinic)).To(HaveField("Bucket", clinic.Key))
+	})
+})
@@ -0,0 +1,50 @@
+package ratelimit
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "tidepool"
+	metricsSubsystem = "clinic_rate_limit"
+
+	bucketTypeSubject        = "subject"
+	bucketTypeServiceAccount = "service_account"
+	bucketTypeClinic         = "clinic"
+)
+
+type metrics struct {
+	rejections *prometheus.CounterVec
+}
+
+var (
+	defaultMetrics     *metrics
+	defaultMetricsOnce sync.Once
+)
+
+// newMetrics returns the rate limit metrics. The collectors are registered with the default prometheus registry
+// only once, because the middleware may be instantiated multiple times.
+func newMetrics() *metrics {
+	defaultMetricsOnce.Do(func() {
+		defaultMetrics = &metrics{
+			rejections: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Subsystem: metricsSubsystem,
+				Name:      "rejections_total",
+				Help:      "The total number of requests rejected because a rate limit was exceeded",
+			}, []string{"bucket"}),
+		}
+
+		prometheus.MustRegister(defaultMetrics.rejections)
+	})
+
+	return defaultMetrics
+}
+
+func getBucketType(key string) string {
+	bucketType, _, _ := strings.Cut(key, ":")
+	return bucketType
+}
@@ -0,0 +1,72 @@
+package ratelimit
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/auth"
+)
+
+const clinicIdPathParameter = "clinicId"
+
+// NewMiddleware returns a middleware which limits the rate of requests of each subject and clinic. Backend services
+// and clinic service accounts have a separate limit. Unauthenticated requests are not limited.
+func NewMiddleware(limiter Limiter, config Config, logger *zap.SugaredLogger) echo.MiddlewareFunc {
+	metrics := newMetrics()
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			authData := auth.GetAuthData(c.Request().Context())
+			// Backend services act on behalf of all users and clinics and are never limited
+			if !config.Enabled || authData == nil || authData.SubjectId == "" || authData.ServerAccess {
+				return next(c)
+			}
+
+			buckets := getBuckets(config, authData, c.Param(clinicIdPathParameter))
+			cost := config.RouteCosts.Get(c.Request().Method, c.Path())
+
+			result, err := limiter.Take(c.Request().Context(), cost, buckets...)
+			if err != nil {
+				// Don't fail the requests if the limiter is unavailable
+				logger.Errorw("unable to check the rate limit", "subjectId", authData.SubjectId, zap.Error(err))
+				return next(c)
+			}
+			if !result.Allowed {
+				metrics.rejections.WithLabelValues(getBucketType(result.Bucket)).Inc()
+				retryAfter := int(math.Ceil(result.RetryAfter.Seconds()))
+				c.Response().Header().Set("Retry-After", strconv.Itoa(max(retryAfter, 1)))
+				return echo.NewHTTPError(http.StatusTooManyRequests, "rate limit exceeded")
+			}
+
+			return next(c)
+		}
+	}
+}
+
+func getBuckets(config Config, authData *auth.Auth, clinicId string) []Bucket {
+	var buckets []Bucket
+	if authData.APIKey != nil {
+		buckets = append(buckets, Bucket{
+			Key:   bucketTypeServiceAccount + ":" + authData.SubjectId,
+			Limit: Limit{Rate: config.ServiceAccountRate, Burst: config.ServiceAccountBurst},
+		})
+	} else {
+		buckets = append(buckets, Bucket{
+			Key:   bucketTypeSubject + ":" + authData.SubjectId,
+			Limit: Limit{Rate: config.SubjectRate, Burst: config.SubjectBurst},
+		})
+	}
+
+	if clinicId != "" {
+		buckets = append(buckets, Bucket{
+			Key:   bucketTypeClinic + ":" + clinicId,
+			Limit: Limit{Rate: config.ClinicRate, Burst: config.ClinicBurst},
+		})
+	}
+
+	return buckets
+}
@@ -0,0 +1,116 @@
+package ratelimit_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/auth"
+	"github.com/tidepool-org/clinic/ratelimit"
+	ratelimitTest "github.com/tidepool-org/clinic/ratelimit/test"
+)
+
+var _ = Describe("Middleware", func() {
+	const clinicId = "6066fbabc6f484277200ac64"
+
+	var limiter *ratelimitTest.MockLimiter
+	var e *echo.Echo
+	var authData *auth.Auth
+
+	BeforeEach(func() {
+		limiter = ratelimitTest.NewMockLimiter(gomock.NewController(GinkgoT()))
+		authData = &auth.Auth{SubjectId: "1234567890"}
+
+		config := ratelimit.Config{
+			Enabled:             true,
+			SubjectRate:         1,
+			SubjectBurst:        2,
+			ServiceAccountRate:  3,
+			ServiceAccountBurst: 4,
+			ClinicRate:          5,
+			ClinicBurst:         6,
+			RouteCosts:          ratelimit.DefaultRouteCosts,
+		}
+
+		e = echo.New()
+		e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(c echo.Context) error {
+				if authData != nil {
+					auth.SetAuthData(c, authData)
+				}
+				return next(c)
+			}
+		})
+		e.Use(ratelimit.NewMiddleware(limiter, config, zap.NewNop().Sugar()))
+		ok := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+		e.GET("/v1/clinics/:clinicId/tide_report", ok)
+		e.GET("/v1/clinics/:clinicId/patients", ok)
+		e.GET("/v1/clinicians/:userId/clinics", ok)
+	})
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	It("charges the subject and the clinic", func() {
+		limiter.EXPECT().Take(gomock.Any(), 1.0,
+			ratelimit.Bucket{Key: "subject:1234567890", Limit: ratelimit.Limit{Rate: 1, Burst: 2}},
+			ratelimit.Bucket{Key: "clinic:" + clinicId, Limit: ratelimit.Limit{Rate: 5, Burst: 6}},
+		).Return(ratelimit.Result{Allowed: true}, nil)
+
+		Expect(serve("/v1/clinics/" + clinicId + "/patients").Code).To(Equal(http.StatusOK))
+	})
+
+	It("charges the cost of the route", func() {
+		limiter.EXPECT().Take(gomock.Any(), 10.0, gomock.Any()).Return(ratelimit.Result{Allowed: true}, nil)
+
+		Expect(serve("/v1/clinics/" + clinicId + "/tide_report").Code).To(Equal(http.StatusOK))
+	})
+
+	It("charges service accounts separately", func() {
+		authData.APIKey = &auth.APIKeyAuth{Id: "key", ClinicId: clinicId}
+		limiter.EXPECT().Take(gomock.Any(), 1.0,
+			ratelimit.Bucket{Key: "service_account:1234567890", Limit: ratelimit.Limit{Rate: 3, Burst: 4}},
+			ratelimit.Bucket{Key: "clinic:" + clinicId, Limit: ratelimit.Limit{Rate: 5, Burst: 6}},
+		).Return(ratelimit.Result{Allowed: true}, nil)
+
+		Expect(serve("/v1/clinics/" + clinicId + "/patients").Code).To(Equal(http.StatusOK))
+	})
+
+	It("doesn't limit backend services", func() {
+		authData = &auth.Auth{SubjectId: "hydrophone", ServerAccess: true}
+		Expect(serve("/v1/clinics/" + clinicId + "/patients").Code).To(Equal(http.StatusOK))
+	})
+
+	It("doesn't limit unauthenticated requests", func() {
+		authData = nil
+		Expect(serve("/v1/clinicians/1234567890/clinics").Code).To(Equal(http.StatusOK))
+	})
+
+	It("responds with too many requests and the retry after header", func() {
+		limiter.EXPECT().Take(gomock.Any(), gomock.Any(), gomock.Any()).Return(ratelimit.Result{
+			Allowed:    false,
+			RetryAfter: 1500 * time.Millisecond,
+			Bucket:     "subject:1234567890",
+		}, nil)
+
+		rec := serve("/v1/clinicians/1234567890/clinics")
+		Expect(rec.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(rec.Header().Get("Retry-After")).To(Equal("2"))
+	})
+
+	It("allows requests when the limiter fails", func() {
+		limiter.EXPECT().Take(gomock.Any(), gomock.Any(), gomock.Any()).Return(ratelimit.Result{}, context.DeadlineExceeded)
+
+		Expect(serve("/v1/clinicians/1234567890/clinics").Code).To(Equal(http.StatusOK))
+	})
+})
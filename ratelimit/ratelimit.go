@@ -0,0 +1,120 @@
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Config struct {
+	// Enabled is false by default, so the limits can be tuned in each environment before they are enforced
+	Enabled bool `envconfig:"TIDEPOOL_RATE_LIMIT_ENABLED" default:"false"`
+	// The rates are in cost units per second, most routes cost one unit. Backend services are never limited, service
+	// accounts (i.e. clinic api keys) are limited by the service account rate.
+	SubjectRate         float64 `envconfig:"TIDEPOOL_RATE_LIMIT_SUBJECT_RATE" default:"10"`
+	SubjectBurst        float64 `envconfig:"TIDEPOOL_RATE_LIMIT_SUBJECT_BURST" default:"50"`
+	ServiceAccountRate  float64 `envconfig:"TIDEPOOL_RATE_LIMIT_SERVICE_ACCOUNT_RATE" default:"50"`
+	ServiceAccountBurst float64 `envconfig:"TIDEPOOL_RATE_LIMIT_SERVICE_ACCOUNT_BURST" default:"250"`
+	ClinicRate          float64 `envconfig:"TIDEPOOL_RATE_LIMIT_CLINIC_RATE" default:"50"`
+	ClinicBurst         float64 `envconfig:"TIDEPOOL_RATE_LIMIT_CLINIC_BURST" default:"250"`
+	// RouteCosts override the default costs of routes
+	RouteCosts RouteCosts `envconfig:"TIDEPOOL_RATE_LIMIT_ROUTE_COSTS"`
+	// MaxBuckets limits the memory used by the in-memory limiter, the least recently used buckets are evicted
+	MaxBuckets int `envconfig:"TIDEPOOL_RATE_LIMIT_MAX_BUCKETS" default:"100000"`
+}
+
+func NewConfig() (Config, error) {
+	cfg := Config{}
+	if err := envconfig.Process("", &cfg); err != nil {
+		return cfg, err
+	}
+	if err := cfg.validate(); err != nil {
+		return cfg, err
+	}
+
+	costs := make(RouteCosts, len(DefaultRouteCosts)+len(cfg.RouteCosts))
+	for route, cost := range DefaultRouteCosts {
+		costs[route] = cost
+	}
+	for route, cost := range cfg.RouteCosts {
+		costs[route] = cost
+	}
+	cfg.RouteCosts = costs
+
+	return cfg, nil
+}
+
+// validate rejects rates which aren't positive, the tokens of such buckets are never replenished
+func (c Config) validate() error {
+	rates := map[string]float64{
+		"TIDEPOOL_RATE_LIMIT_SUBJECT_RATE":         c.SubjectRate,
+		"TIDEPOOL_RATE_LIMIT_SERVICE_ACCOUNT_RATE": c.ServiceAccountRate,
+		"TIDEPOOL_RATE_LIMIT_CLINIC_RATE":          c.ClinicRate,
+	}
+	for name, rate := range rates {
+		if rate <= 0 {
+			return fmt.Errorf("%s must be positive, got %v", name, rate)
+		}
+	}
+	return nil
+}
+
+// RouteCosts are the costs of routes keyed by the method and the route path (e.g. "GET /v1/clinics/:clinicId").
+// Routes without a cost cost one unit.
+type RouteCosts map[string]float64
+
+// Decode parses the route costs from a JSON object
+func (r *RouteCosts) Decode(value string) error {
+	costs := map[string]float64{}
+	if err := json.Unmarshal([]byte(value), &costs); err != nil {
+		return fmt.Errorf("invalid route costs: %w", err)
+	}
+	*r = costs
+	return nil
+}
+
+func (r RouteCosts) Get(method string, path string) float64 {
+	if cost, ok := r[method+" "+path]; ok {
+		return cost
+	}
+	return 1
+}
+
+// DefaultRouteCosts are the costs of routes which are more expensive than usual
+var DefaultRouteCosts = RouteCosts{
+	"GET /v1/clinics/:clinicId/tide_report":    10,
+	"POST /v1/clinics/:clinicId/reports/merge": 20,
+	"POST /v1/clinics/:clinicId/merge":         20,
+}
+
+// Limit is the rate at which the tokens of a bucket are replenished and the capacity of the bucket
+type Limit struct {
+	Rate  float64
+	Burst float64
+}
+
+// Bucket is a token bucket identified by its key
+type Bucket struct {
+	Key   string
+	Limit Limit
+}
+
+type Result struct {
+	Allowed bool
+	// RetryAfter is how long the client should wait before retrying a denied request
+	RetryAfter time.Duration
+	// Bucket is the key of the bucket which denied the request
+	Bucket string
+}
+
+//go:generate go tool mockgen -source=./ratelimit.go -destination=./test/mock_ratelimit.go -package test
+
+// Limiter is implemented by the in-memory limiter of a single instance, and can be implemented by a shared backend
+// (e.g. redis) to enforce the limits across all instances of the service
+type Limiter interface {
+	// Take removes the cost from all buckets if all of them have enough tokens. Otherwise, no tokens are removed.
+	Take(ctx context.Context, cost float64, buckets ...Bucket) (Result, error)
+}
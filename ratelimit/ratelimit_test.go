@@ -0,0 +1,28 @@
+package ratelimit_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tidepool-org/clinic/ratelimit"
+)
+
+var _ = Describe("Config", func() {
+	It("uses the default rates", func() {
+		cfg, err := ratelimit.NewConfig()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.SubjectRate).To(Equal(10.0))
+		Expect(cfg.RouteCosts.Get("POST", "/v1/clinics/:clinicId/merge")).To(Equal(20.0))
+	})
+
+	DescribeTable("rejects rates which aren't positive",
+		func(name string, value string) {
+			GinkgoT().Setenv(name, value)
+			_, err := ratelimit.NewConfig()
+			Expect(err).To(MatchError(ContainSubstring(name)))
+		},
+		Entry("zero subject rate", "TIDEPOOL_RATE_LIMIT_SUBJECT_RATE", "0"),
+		Entry("negative service account rate", "TIDEPOOL_RATE_LIMIT_SERVICE_ACCOUNT_RATE", "-1"),
+		Entry("zero clinic rate", "TIDEPOOL_RATE_LIMIT_CLINIC_RATE", "0"),
+	)
+})
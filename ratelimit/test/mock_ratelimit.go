@@ -0,0 +1,62 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./ratelimit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./ratelimit.go -destination=./test/mock_ratelimit.go -package test
+//
+
+// Package test is a generated GoMock package.
+package test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	ratelimit "github.com/tidepool-org/clinic/ratelimit"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLimiter is a mock of Limiter interface.
+type MockLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockLimiterMockRecorder
+	isgomock struct{}
+}
+
+// MockLimiterMockRecorder is the mock recorder for MockLimiter.
+type MockLimiterMockRecorder struct {
+	mock *MockLimiter
+}
+
+// NewMockLimiter creates a new mock instance.
+func NewMockLimiter(ctrl *gomock.Controller) *MockLimiter {
+	mock := &MockLimiter{ctrl: ctrl}
+	mock.recorder = &MockLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLimiter) EXPECT() *MockLimiterMockRecorder {
+	return m.recorder
+}
+
+// Take mocks base method.
+func (m *MockLimiter) Take(ctx context.Context, cost float64, buckets ...ratelimit.Bucket) (ratelimit.Result, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, cost}
+	for _, a := range buckets {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Take", varargs...)
+	ret0, _ := ret[0].(ratelimit.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockLimiterMockRecorder) Take(ctx, cost any, buckets ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, cost}, buckets...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockLimiter)(nil).Take), varargs...)
+}
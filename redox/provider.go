@@ -0,0 +1,109 @@
+package redox
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/fx"
+
+	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/ehr"
+	"github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/store"
+)
+
+var ProviderModule = fx.Provide(ehr.AsProvider(NewProvider))
+
+// Provider exposes the redox integration as an ehr.Provider
+type Provider struct {
+	clinics  clinics.Service
+	patients patients.Service
+}
+
+var _ ehr.Provider = &Provider{}
+
+func NewProvider(clinics clinics.Service, patients patients.Service) *Provider {
+	return &Provider{
+		clinics:  clinics,
+		patients: patients,
+	}
+}
+
+func (p *Provider) Name() string {
+	return clinics.EHRProviderRedox
+}
+
+func (p *Provider) SubscriptionName() string {
+	return patients.SubscriptionRedoxSummaryAndReports
+}
+
+// MatchClinic returns the clinic with the given redox source id
+func (p *Provider) MatchClinic(ctx context.Context, sourceId string) (*clinics.Clinic, error) {
+	return ehr.FindClinic(ctx, p.clinics, clinics.EHRProviderRedox, sourceId)
+}
+
+func (p *Provider) MatchPatients(ctx context.Context, clinic clinics.Clinic, criteria ehr.PatientCriteria, strategies []string) ([]*patients.Patient, error) {
+	return ehr.FindMatchingPatients(ctx, p.patients, clinic.Id.Hex(), criteria, strategies)
+}
+
+// OrderCreated enables the summary and reports subscription of the patient
+func (p *Provider) OrderCreated(ctx context.Context, clinic clinics.Clinic, patient patients.Patient, order ehr.OrderRef) error {
+	return p.patients.UpdateEHRSubscription(ctx, clinic.Id.Hex(), *patient.UserId, ehr.NewSubscriptionUpdate(p, order, true))
+}
+
+// OrderCancelled disables the summary and reports subscription of the patient
+func (p *Provider) OrderCancelled(ctx context.Context, clinic clinics.Clinic, patient patients.Patient, order ehr.OrderRef) error {
+	return p.patients.UpdateEHRSubscription(ctx, clinic.Id.Hex(), *patient.UserId, ehr.NewSubscriptionUpdate(p, order, false))
+}
+
+// DeliverReports reschedules the last order of the patient, so the reports are sent to the EHR, if reports on upload
+// are enabled by the clinic, or by the sites of the patient
+func (p *Provider) DeliverReports(ctx context.Context, clinic clinics.Clinic, patient patients.Patient) error {
+	settings := clinic.ResolvePatientSettings(patient.Sites).EHRSettings
+	if settings == nil || !settings.ScheduledReports.OnUploadEnabled {
+		return nil
+	}
+
+	return p.patients.RescheduleLastSubscriptionOrderForPatient(
+		ctx,
+		[]string{clinic.Id.Hex()},
+		*patient.UserId,
+		patients.SubscriptionRedoxSummaryAndReports,
+		messagesCollectionName,
+		summaryAndReportsRescheduledOrdersCollectionName,
+	)
+}
+
+// SyncClinicReports reschedules the last order of all subscribed patients of the clinic
+func (p *Provider) SyncClinicReports(ctx context.Context, clinicId string) error {
+	enabled := true
+	filter := clinics.Filter{
+		Ids:         []string{clinicId},
+		EHRProvider: &clinics.EHRProviderRedox,
+		EHREnabled:  &enabled,
+	}
+	page := store.Pagination{
+		Offset: 0,
+		Limit:  2,
+	}
+
+	result, err := p.clinics.List(ctx, &filter, page)
+	if err != nil {
+		return err
+	}
+
+	if len(result) > 1 {
+		return fmt.Errorf("%w: multiple matching clinics found", errors.Duplicate)
+	} else if len(result) == 0 || result[0] == nil || result[0].Id == nil {
+		return fmt.Errorf("%w: couldn't find a matching clinic", errors.NotFound)
+	}
+
+	return p.patients.RescheduleLastSubscriptionOrderForAllPatients(
+		ctx,
+		clinicId,
+		patients.SubscriptionRedoxSummaryAndReports,
+		messagesCollectionName,
+		summaryAndReportsRescheduledOrdersCollectionName,
+	)
+}
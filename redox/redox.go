@@ -17,10 +17,10 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/ehr"
 	"github.com/tidepool-org/clinic/errors"
 	"github.com/tidepool-org/clinic/patients"
 	models "github.com/tidepool-org/clinic/redox_models"
-	"github.com/tidepool-org/clinic/store"
 )
 
 const (
@@ -29,9 +29,9 @@ const (
 	summaryAndReportsRescheduledOrdersCollectionName = "scheduledSummaryAndReportsOrders"
 	rescheduledMessagesExpiration                    = 90 * 24 * time.Hour
 
-	MRNPatientMatchingCriteria            = "MRN"
-	MRNAndDOBPatientMatchingCriteria      = "MRN_DOB"
-	DOBAndFullNamePatientMatchingCriteria = "DOB_FULLNAME"
+	MRNPatientMatchingCriteria            = ehr.MRNPatientMatchingStrategy
+	MRNAndDOBPatientMatchingCriteria      = ehr.MRNAndDOBPatientMatchingStrategy
+	DOBAndFullNamePatientMatchingCriteria = ehr.DOBAndFullNamePatientMatchingStrategy
 )
 
 type Config struct {
@@ -45,19 +45,19 @@ type Redox interface {
 	FindMessage(ctx context.Context, documentId, dataModel, eventType string) (*models.MessageEnvelope, error)
 	MatchNewOrderToPatient(ctx context.Context, match MatchOrder) (*MatchResult, error)
 	FindMatchingClinic(ctx context.Context, criteria ClinicMatchingCriteria) (*clinics.Clinic, error)
-	RescheduleSubscriptionOrders(ctx context.Context, clinicId string) error
-	RescheduleSubscriptionOrdersForPatient(ctx context.Context, patientId string) error
 }
 type MatchOrder struct {
-	DocumentId         primitive.ObjectID
-	Order              models.NewOrder
-	PatientAttributes  []string
-	SubscriptionUpdate *patients.SubscriptionUpdate
+	DocumentId        primitive.ObjectID
+	Order             models.NewOrder
+	PatientAttributes []string
 }
 
 type MatchResult struct {
 	Clinic   clinics.Clinic
 	Patients []*patients.Patient
+	// Settings are the EHR settings of the clinic, overridden by the sites of the patient when
+	// a single patient is matched.
+	Settings *clinics.EHRSettings
 }
 
 func NewConfig() (Config, error) {
@@ -65,15 +65,19 @@ func NewConfig() (Config, error) {
 	err := envconfig.Process("", &cfg)
 	return cfg, err
 }
-func NewHandler(config Config, clinics clinics.Service, patients patients.Service, db *mongo.Database, logger *zap.SugaredLogger, lifecycle fx.Lifecycle) (Redox, error) {
+func NewHandler(config Config, providers *ehr.Registry, db *mongo.Database, logger *zap.SugaredLogger, lifecycle fx.Lifecycle) (Redox, error) {
+	provider, err := providers.Get(clinics.EHRProviderRedox)
+	if err != nil {
+		return nil, err
+	}
+
 	handler := &Handler{
 		messagesCollection:                     db.Collection(messagesCollectionName),
 		rescheduledSummaryAndReportsCollection: db.Collection(summaryAndReportsRescheduledOrdersCollectionName),
 		config:                                 config,
 		logger:                                 logger,
 
-		clinics:  clinics,
-		patients: patients,
+		provider: provider,
 	}
 
 	lifecycle.Append(fx.Hook{
@@ -91,8 +95,7 @@ type Handler struct {
 	rescheduledSummaryAndReportsCollection *mongo.Collection
 	logger                                 *zap.SugaredLogger
 
-	clinics  clinics.Service
-	patients patients.Service
+	provider ehr.Provider
 }
 
 func (h *Handler) Initialize(ctx context.Context) error {
@@ -230,105 +233,7 @@ func (h *Handler) FindMatchingClinicFromNewOrder(ctx context.Context, order *mod
 }
 
 func (h *Handler) FindMatchingClinic(ctx context.Context, criteria ClinicMatchingCriteria) (*clinics.Clinic, error) {
-	if criteria.SourceId == "" {
-		return nil, fmt.Errorf("%w: source id is required", errors.BadRequest)
-	}
-
-	enabled := true
-	filter := clinics.Filter{
-		EHRProvider: &clinics.EHRProviderRedox,
-		EHRSourceId: &criteria.SourceId,
-		EHREnabled:  &enabled,
-	}
-	page := store.Pagination{
-		Offset: 0,
-		Limit:  2,
-	}
-
-	result, err := h.clinics.List(ctx, &filter, page)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(result) > 1 {
-		return nil, fmt.Errorf("%w: multiple matching clinics found", errors.Duplicate)
-	} else if len(result) == 0 || result[0] == nil || result[0].Id == nil {
-		return nil, fmt.Errorf("%w: couldn't find a matching clinic", errors.NotFound)
-	}
-
-	return result[0], nil
-}
-
-func (h *Handler) RescheduleSubscriptionOrders(ctx context.Context, clinicId string) error {
-	enabled := true
-	filter := clinics.Filter{
-		Ids:         []string{clinicId},
-		EHRProvider: &clinics.EHRProviderRedox,
-		EHREnabled:  &enabled,
-	}
-	page := store.Pagination{
-		Offset: 0,
-		Limit:  2,
-	}
-
-	result, err := h.clinics.List(ctx, &filter, page)
-	if err != nil {
-		return err
-	}
-
-	if len(result) > 1 {
-		return fmt.Errorf("%w: multiple matching clinics found", errors.Duplicate)
-	} else if len(result) == 0 || result[0] == nil || result[0].Id == nil {
-		return fmt.Errorf("%w: couldn't find a matching clinic", errors.NotFound)
-	}
-
-	return h.patients.RescheduleLastSubscriptionOrderForAllPatients(
-		ctx,
-		clinicId,
-		patients.SubscriptionRedoxSummaryAndReports,
-		messagesCollectionName,
-		summaryAndReportsRescheduledOrdersCollectionName,
-	)
-}
-
-func (h *Handler) RescheduleSubscriptionOrdersForPatient(ctx context.Context, patientId string) error {
-	enabled := true
-	limit := 10000
-	filter := clinics.Filter{
-		EHRProvider:                     &clinics.EHRProviderRedox,
-		EHREnabled:                      &enabled,
-		ScheduledReportsOnUploadEnabled: &enabled,
-	}
-	page := store.Pagination{
-		Offset: 0,
-		Limit:  limit,
-	}
-
-	clinicIds := make([]string, 0, 100)
-	for {
-		result, err := h.clinics.List(ctx, &filter, page)
-		if err != nil {
-			return err
-		}
-		for _, clinic := range result {
-			if clinic != nil && clinic.Id != nil {
-				clinicIds = append(clinicIds, clinic.Id.Hex())
-			}
-		}
-		if len(result) < limit {
-			break
-		}
-		page.Offset += len(result)
-	}
-
-	return h.patients.RescheduleLastSubscriptionOrderForPatient(
-		ctx,
-		clinicIds,
-		patientId,
-		patients.SubscriptionRedoxSummaryAndReports,
-		messagesCollectionName,
-		summaryAndReportsRescheduledOrdersCollectionName,
-	)
+	return h.provider.MatchClinic(ctx, criteria.SourceId)
 }
 
 func (h *Handler) MatchNewOrderToPatient(ctx context.Context, matchOrder MatchOrder) (*MatchResult, error) {
@@ -345,29 +250,19 @@ func (h *Handler) MatchNewOrderToPatient(ctx context.Context, matchOrder MatchOr
 		return nil, err
 	}
 
-	// Update the subscription for matched patient only if single match was found
-	if matchOrder.SubscriptionUpdate != nil && len(matchingPatients) == 1 {
-		match := matchingPatients[0]
-		err = h.patients.UpdateEHRSubscription(ctx, clinic.Id.Hex(), *match.UserId, *matchOrder.SubscriptionUpdate)
-		if err != nil {
-			return nil, err
-		}
+	settings := clinic.ResolveSettings(nil)
+	if len(matchingPatients) == 1 {
+		settings = clinic.ResolvePatientSettings(matchingPatients[0].Sites)
 	}
 
 	return &MatchResult{
 		Clinic:   *clinic,
 		Patients: matchingPatients,
+		Settings: settings.EHRSettings,
 	}, nil
 }
 
 // findMatchingPatients based on a MatchOrder.
-//
-// The number of matching patients is limited to 100 per filter. It is expected that consumers of this
-// function are searching for unique patient matches. It is normal for a small number (< 10)
-// of patients to match, but 100 matches strongly indicates an unexpected filter behavior.
-//
-// Placing a limit of 100 prevents a misconfigured filter from needlessly returning all of a
-// clinic's patients.
 func (h *Handler) findMatchingPatients(ctx context.Context, clinic clinics.Clinic, matchOrder MatchOrder) ([]*patients.Patient, error) {
 	criteria, err := GetPatientMatchingValuesFromNewOrder(matchOrder.Order, clinic)
 	if err != nil {
@@ -377,39 +272,7 @@ func (h *Handler) findMatchingPatients(ctx context.Context, clinic clinics.Clini
 		return nil, nil
 	}
 
-	unique := map[string]struct{}{}
-	var matchingPatients []*patients.Patient
-
-	filters, err := criteria.GetFilters(clinic.Id.Hex(), matchOrder.PatientAttributes)
-	if err != nil {
-		return nil, err
-	}
-	for _, filter := range filters {
-		page := store.Pagination{
-			Offset: 0,
-			Limit:  100,
-		}
-
-		result, err := h.patients.List(ctx, &filter, page, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		if result.MatchingCount > 0 {
-			for _, patient := range result.Patients {
-				if patient == nil || patient.UserId == nil {
-					continue
-				}
-				if _, found := unique[*patient.UserId]; found {
-					continue
-				}
-				unique[*patient.UserId] = struct{}{}
-				matchingPatients = append(matchingPatients, patient)
-			}
-		}
-	}
-
-	return matchingPatients, nil
+	return h.provider.MatchPatients(ctx, clinic, *criteria, matchOrder.PatientAttributes)
 }
 
 type VerificationRequest struct {
@@ -421,42 +284,7 @@ type VerificationResponse struct {
 	Challenge string `json:"challenge"`
 }
 
-type PatientMatchingValues struct {
-	FirstName   string
-	LastName    string
-	FullName    string
-	MRN         string
-	DateOfBirth string
-}
-
-func (p PatientMatchingValues) GetFilters(clinicId string, criteria []string) ([]patients.Filter, error) {
-	result := make([]patients.Filter, 0, len(criteria))
-	for _, c := range criteria {
-		switch c {
-		case MRNPatientMatchingCriteria:
-			result = append(result, patients.Filter{
-				ClinicId: &clinicId,
-				Mrn:      &p.MRN,
-			})
-		case MRNAndDOBPatientMatchingCriteria:
-			result = append(result, patients.Filter{
-				ClinicId:  &clinicId,
-				Mrn:       &p.MRN,
-				BirthDate: &p.DateOfBirth,
-			})
-		case DOBAndFullNamePatientMatchingCriteria:
-			result = append(result, patients.Filter{
-				ClinicId:  &clinicId,
-				BirthDate: &p.DateOfBirth,
-				FullName:  &p.FullName,
-			})
-		default:
-			return nil, fmt.Errorf("%w: invalid critera: %s", errors.BadRequest, c)
-		}
-	}
-
-	return result, nil
-}
+type PatientMatchingValues = ehr.PatientCriteria
 
 type ClinicMatchingCriteria struct {
 	SourceId string
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/tidepool-org/clinic/clinics"
 	clinicsTest "github.com/tidepool-org/clinic/clinics/test"
+	"github.com/tidepool-org/clinic/ehr"
 	"github.com/tidepool-org/clinic/errors"
 	"github.com/tidepool-org/clinic/patients"
 	patientsTest "github.com/tidepool-org/clinic/patients/test"
@@ -48,8 +49,12 @@ var _ = Describe("Redox", func() {
 		patientsService = patientsTest.NewMockService(patientsCtrl)
 		clinicsService = clinicsTest.NewMockService(clinicsCtrl)
 
-		var err error
-		handler, err = redox.NewHandler(config, clinicsService, patientsService, database, zap.NewNop().Sugar(), lifecycle)
+		registry, err := ehr.NewRegistry(ehr.RegistryParams{
+			Providers: []ehr.Provider{redox.NewProvider(clinicsService, patientsService)},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		handler, err = redox.NewHandler(config, registry, database, zap.NewNop().Sugar(), lifecycle)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(handler).ToNot(BeNil())
 		lifecycle.RequireStart()
@@ -232,17 +237,6 @@ var _ = Describe("Redox", func() {
 				clinicsService.
 					EXPECT().
 					List(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*clinics.Clinic{&clinic}, nil)
-
-				matchOrder.SubscriptionUpdate = &patients.SubscriptionUpdate{
-					Name:     "summaryAndReports",
-					Provider: clinics.EHRProviderRedox,
-					Active:   true,
-					MatchedMessage: patients.MatchedMessage{
-						DocumentId: documentId,
-						DataModel:  "Order",
-						EventType:  "New",
-					},
-				}
 			})
 
 			It("does not return an error when mrn cannot be found", func() {
@@ -281,13 +275,6 @@ var _ = Describe("Redox", func() {
 					MatchingCount: 1,
 				}, nil)
 
-				patientsService.EXPECT().UpdateEHRSubscription(
-					gomock.Any(),
-					gomock.Eq(clinicId),
-					gomock.Eq(*patient.UserId),
-					gomock.Eq(*matchOrder.SubscriptionUpdate),
-				).Return(nil)
-
 				res, err := handler.MatchNewOrderToPatient(context.Background(), matchOrder)
 				Expect(err).To(BeNil())
 				Expect(res).ToNot(BeNil())
@@ -354,7 +341,6 @@ var _ = Describe("Redox", func() {
 
 				matchOrder.Order = order
 				matchOrder.PatientAttributes = []string{redox.MRNPatientMatchingCriteria, redox.DOBAndFullNamePatientMatchingCriteria}
-				matchOrder.SubscriptionUpdate = nil
 			})
 
 			It("returns unique patients when multiple matches are found", func() {
@@ -406,7 +392,6 @@ var _ = Describe("Redox", func() {
 
 				matchOrder.Order = order
 				matchOrder.PatientAttributes = []string{redox.MRNPatientMatchingCriteria, redox.DOBAndFullNamePatientMatchingCriteria}
-				matchOrder.SubscriptionUpdate = nil
 			})
 
 			It("returns unique patients when multiple matches are found", func() {
@@ -0,0 +1,195 @@
+package sites
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MaxSiteDepth limits the levels of the site hierarchy, e.g. regions, clinics and departments.
+const MaxSiteDepth int = 3
+
+var (
+	ErrParentNotFound   = errors.New("parent site not found")
+	ErrCyclicHierarchy  = errors.New("a site can't be a descendant of itself")
+	ErrMaxDepthExceeded = fmt.Errorf("the site hierarchy can't be deeper than %d levels", MaxSiteDepth)
+)
+
+// Assignment is the number of patients assigned to the same combination of sites.
+type Assignment struct {
+	SiteIds  []primitive.ObjectID `bson:"_id"`
+	Patients int                  `bson:"patients"`
+}
+
+// Hierarchy of the sites of a clinic.
+//
+// Sites without a parent, or whose parent doesn't exist (e.g. after moving a subset of the
+// sites to another clinic), are roots.
+type Hierarchy struct {
+	sites    map[primitive.ObjectID]Site
+	children map[primitive.ObjectID][]primitive.ObjectID
+}
+
+func NewHierarchy(all []Site) Hierarchy {
+	h := Hierarchy{
+		sites:    make(map[primitive.ObjectID]Site, len(all)),
+		children: make(map[primitive.ObjectID][]primitive.ObjectID),
+	}
+	for _, site := range all {
+		h.sites[site.Id] = site
+	}
+	for _, site := range all {
+		if parentId, ok := h.parent(site.Id); ok {
+			h.children[parentId] = append(h.children[parentId], site.Id)
+		}
+	}
+	return h
+}
+
+func (h Hierarchy) parent(id primitive.ObjectID) (primitive.ObjectID, bool) {
+	site, ok := h.sites[id]
+	if !ok || site.ParentId == nil || *site.ParentId == id {
+		return primitive.NilObjectID, false
+	}
+	if _, ok := h.sites[*site.ParentId]; !ok {
+		return primitive.NilObjectID, false
+	}
+	return *site.ParentId, true
+}
+
+// Ancestors returns the ids of the ancestors of the site, starting with its parent.
+func (h Hierarchy) Ancestors(id primitive.ObjectID) []primitive.ObjectID {
+	var ancestors []primitive.ObjectID
+	for parentId, ok := h.parent(id); ok; parentId, ok = h.parent(parentId) {
+		if parentId == id || slices.Contains(ancestors, parentId) {
+			// Stored cycles are never followed
+			break
+		}
+		ancestors = append(ancestors, parentId)
+	}
+	return ancestors
+}
+
+// Descendants returns the ids of the children of the site, their children, and so on.
+func (h Hierarchy) Descendants(id primitive.ObjectID) []primitive.ObjectID {
+	var descendants []primitive.ObjectID
+	queue := slices.Clone(h.children[id])
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == id || slices.Contains(descendants, current) {
+			continue
+		}
+		descendants = append(descendants, current)
+		queue = append(queue, h.children[current]...)
+	}
+	return descendants
+}
+
+// Depth of the site in the hierarchy. Roots have a depth of 1.
+func (h Hierarchy) Depth(id primitive.ObjectID) int {
+	return len(h.Ancestors(id)) + 1
+}
+
+// Height of the subtree of the site. Sites without children have a height of 1.
+func (h Hierarchy) Height(id primitive.ObjectID) int {
+	height := 1
+	for _, descendantId := range h.Descendants(id) {
+		// The depth of the descendant relative to the site
+		relative := slices.Index(h.Ancestors(descendantId), id) + 2
+		height = max(height, relative)
+	}
+	return height
+}
+
+// ExpandIds returns the site ids and the ids of all of their descendants. Ids which don't
+// match a site are returned as they are.
+func (h Hierarchy) ExpandIds(ids []string) []string {
+	expanded := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !slices.Contains(expanded, id) {
+			expanded = append(expanded, id)
+		}
+		oid, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			continue
+		}
+		for _, descendantId := range h.Descendants(oid) {
+			if hex := descendantId.Hex(); !slices.Contains(expanded, hex) {
+				expanded = append(expanded, hex)
+			}
+		}
+	}
+	return expanded
+}
+
+// ExpandSites returns the sites and all of their descendants.
+func (h Hierarchy) ExpandSites(sites []Site) []Site {
+	expanded := make([]Site, 0, len(sites))
+	for _, site := range sites {
+		for _, id := range append([]primitive.ObjectID{site.Id}, h.Descendants(site.Id)...) {
+			if slices.ContainsFunc(expanded, func(s Site) bool { return s.Id == id }) {
+				continue
+			}
+			if existing, ok := h.sites[id]; ok {
+				expanded = append(expanded, Site{Id: existing.Id, Name: existing.Name})
+			} else {
+				expanded = append(expanded, site)
+			}
+		}
+	}
+	return expanded
+}
+
+// ValidateParent checks that the site (which may not exist yet) can be moved under the
+// parent. A nil parent makes the site a root, which is always valid.
+func (h Hierarchy) ValidateParent(siteId primitive.ObjectID, parentId *primitive.ObjectID) error {
+	if parentId == nil {
+		return nil
+	}
+	if _, ok := h.sites[*parentId]; !ok {
+		return ErrParentNotFound
+	}
+	if *parentId == siteId || slices.Contains(h.Descendants(siteId), *parentId) {
+		return ErrCyclicHierarchy
+	}
+	if h.Depth(*parentId)+h.Height(siteId) > MaxSiteDepth {
+		return ErrMaxDepthExceeded
+	}
+	return nil
+}
+
+// ValidateMerge checks that the children of the source site can be moved under the target
+// site when the source is merged into the target.
+func (h Hierarchy) ValidateMerge(sourceId, targetId primitive.ObjectID) error {
+	if slices.Contains(h.Descendants(sourceId), targetId) {
+		return ErrCyclicHierarchy
+	}
+	if h.Depth(targetId)+h.Height(sourceId)-1 > MaxSiteDepth {
+		return ErrMaxDepthExceeded
+	}
+	return nil
+}
+
+// RollUp returns the number of distinct patients assigned to each site or to any of its
+// descendants.
+func (h Hierarchy) RollUp(assignments []Assignment) map[primitive.ObjectID]int {
+	totals := make(map[primitive.ObjectID]int, len(h.sites))
+	for _, assignment := range assignments {
+		counted := map[primitive.ObjectID]struct{}{}
+		for _, siteId := range assignment.SiteIds {
+			if _, ok := h.sites[siteId]; !ok {
+				continue
+			}
+			for _, id := range append([]primitive.ObjectID{siteId}, h.Ancestors(siteId)...) {
+				counted[id] = struct{}{}
+			}
+		}
+		for id := range counted {
+			totals[id] += assignment.Patients
+		}
+	}
+	return totals
+}
@@ -0,0 +1,128 @@
+package sites_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/tidepool-org/clinic/sites"
+)
+
+var _ = Describe("Hierarchy", func() {
+	var region, clinic, department, other sites.Site
+	var hierarchy sites.Hierarchy
+
+	child := func(name string, parent sites.Site) sites.Site {
+		site := *sites.New(name)
+		site.ParentId = &parent.Id
+		return site
+	}
+
+	BeforeEach(func() {
+		region = *sites.New("Region")
+		clinic = child("Clinic", region)
+		department = child("Department", clinic)
+		other = *sites.New("Other")
+		hierarchy = sites.NewHierarchy([]sites.Site{region, clinic, department, other})
+	})
+
+	It("returns the ancestors of a site", func() {
+		Expect(hierarchy.Ancestors(department.Id)).To(Equal([]primitive.ObjectID{clinic.Id, region.Id}))
+		Expect(hierarchy.Ancestors(region.Id)).To(BeEmpty())
+	})
+
+	It("returns the descendants of a site", func() {
+		Expect(hierarchy.Descendants(region.Id)).To(ConsistOf(clinic.Id, department.Id))
+		Expect(hierarchy.Descendants(other.Id)).To(BeEmpty())
+	})
+
+	It("treats sites with an unknown parent as roots", func() {
+		orphan := child("Orphan", *sites.New("Removed"))
+		hierarchy = sites.NewHierarchy([]sites.Site{orphan})
+		Expect(hierarchy.Depth(orphan.Id)).To(Equal(1))
+	})
+
+	It("doesn't follow stored cycles", func() {
+		first := *sites.New("First")
+		second := child("Second", first)
+		first.ParentId = &second.Id
+		hierarchy = sites.NewHierarchy([]sites.Site{first, second})
+		Expect(hierarchy.Ancestors(first.Id)).To(Equal([]primitive.ObjectID{second.Id}))
+		Expect(hierarchy.Descendants(first.Id)).To(Equal([]primitive.ObjectID{second.Id}))
+	})
+
+	It("computes the depth and the height of sites", func() {
+		Expect(hierarchy.Depth(department.Id)).To(Equal(3))
+		Expect(hierarchy.Height(region.Id)).To(Equal(3))
+		Expect(hierarchy.Height(department.Id)).To(Equal(1))
+	})
+
+	It("expands site ids to include their descendants", func() {
+		Expect(hierarchy.ExpandIds([]string{clinic.Id.Hex(), "unknown"})).To(ConsistOf(clinic.Id.Hex(), department.Id.Hex(), "unknown"))
+	})
+
+	It("expands sites to include their descendants", func() {
+		Expect(hierarchy.ExpandSites([]sites.Site{clinic})).To(ConsistOf(
+			sites.Site{Id: clinic.Id, Name: clinic.Name},
+			sites.Site{Id: department.Id, Name: department.Name},
+		))
+	})
+
+	Describe("ValidateParent", func() {
+		It("allows moving a site to the root", func() {
+			Expect(hierarchy.ValidateParent(department.Id, nil)).To(Succeed())
+		})
+
+		It("allows moving a site under another site", func() {
+			Expect(hierarchy.ValidateParent(other.Id, &clinic.Id)).To(Succeed())
+		})
+
+		It("allows creating a site under another site", func() {
+			Expect(hierarchy.ValidateParent(primitive.NewObjectID(), &clinic.Id)).To(Succeed())
+		})
+
+		It("rejects an unknown parent", func() {
+			parentId := primitive.NewObjectID()
+			Expect(hierarchy.ValidateParent(other.Id, &parentId)).To(MatchError(sites.ErrParentNotFound))
+		})
+
+		It("rejects moving a site under itself or its descendants", func() {
+			Expect(hierarchy.ValidateParent(clinic.Id, &clinic.Id)).To(MatchError(sites.ErrCyclicHierarchy))
+			Expect(hierarchy.ValidateParent(region.Id, &department.Id)).To(MatchError(sites.ErrCyclicHierarchy))
+		})
+
+		It("rejects hierarchies deeper than the maximum", func() {
+			Expect(hierarchy.ValidateParent(other.Id, &department.Id)).To(MatchError(sites.ErrMaxDepthExceeded))
+			Expect(hierarchy.ValidateParent(region.Id, &other.Id)).To(MatchError(sites.ErrMaxDepthExceeded))
+		})
+	})
+
+	Describe("ValidateMerge", func() {
+		It("allows merging a site with children into a site at the same level", func() {
+			Expect(hierarchy.ValidateMerge(clinic.Id, other.Id)).To(Succeed())
+		})
+
+		It("rejects merging a site into one of its descendants", func() {
+			Expect(hierarchy.ValidateMerge(region.Id, department.Id)).To(MatchError(sites.ErrCyclicHierarchy))
+		})
+
+		It("rejects merges which make the hierarchy deeper than the maximum", func() {
+			otherClinic := child("Other Clinic", other)
+			hierarchy = sites.NewHierarchy([]sites.Site{region, clinic, department, other, otherClinic})
+			Expect(hierarchy.ValidateMerge(clinic.Id, otherClinic.Id)).To(Succeed())
+			Expect(hierarchy.ValidateMerge(region.Id, otherClinic.Id)).To(MatchError(sites.ErrMaxDepthExceeded))
+		})
+	})
+
+	It("rolls up the patients of the descendants without counting patients twice", func() {
+		totals := hierarchy.RollUp([]sites.Assignment{
+			{SiteIds: []primitive.ObjectID{department.Id}, Patients: 2},
+			{SiteIds: []primitive.ObjectID{clinic.Id, department.Id}, Patients: 3},
+			{SiteIds: []primitive.ObjectID{other.Id, primitive.NewObjectID()}, Patients: 1},
+		})
+		Expect(totals[region.Id]).To(Equal(5))
+		Expect(totals[clinic.Id]).To(Equal(5))
+		Expect(totals[department.Id]).To(Equal(5))
+		Expect(totals[other.Id]).To(Equal(1))
+	})
+})
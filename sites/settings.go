@@ -0,0 +1,31 @@
+package sites
+
+// Settings of a site which override the settings of its clinic for the patients assigned to
+// the site. Unset values are inherited from the closest ancestor of the site which sets them,
+// or from the clinic.
+type Settings struct {
+	Timezone         *string `bson:"timezone,omitempty" json:"timezone,omitempty"`
+	PreferredBgUnits *string `bson:"preferredBgUnits,omitempty" json:"preferredBgUnits,omitempty"`
+	// EHRDestinationIds replace the destination ids of the clinic EHR settings, e.g. to route
+	// the notes of the site to a different department.
+	EHRDestinationIds *EHRDestinationIds `bson:"ehrDestinationIds,omitempty" json:"ehrDestinationIds,omitempty"`
+	// ScheduledReports replace the scheduled reports of the clinic EHR settings.
+	ScheduledReports *ScheduledReports `bson:"scheduledReports,omitempty" json:"scheduledReports,omitempty"`
+}
+
+type EHRDestinationIds struct {
+	Flowsheet string `bson:"flowsheet" json:"flowsheet"`
+	Notes     string `bson:"notes" json:"notes"`
+	Results   string `bson:"results" json:"results"`
+}
+
+type ScheduledReports struct {
+	Cadence               string  `bson:"cadence" json:"cadence"`
+	OnUploadEnabled       bool    `bson:"onUploadEnabled" json:"onUploadEnabled"`
+	OnUploadNoteEventType *string `bson:"onUploadNoteEventType" json:"onUploadNoteEventType,omitempty"`
+}
+
+// IsEmpty returns true when the settings don't override any of the clinic settings.
+func (s *Settings) IsEmpty() bool {
+	return s == nil || (s.Timezone == nil && s.PreferredBgUnits == nil && s.EHRDestinationIds == nil && s.ScheduledReports == nil)
+}
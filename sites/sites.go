@@ -18,6 +18,14 @@ type Site struct {
 	Id       primitive.ObjectID `bson:"id" json:"id"`
 	Name     string             `bson:"name" json:"name"`
 	Patients int                `bson:"patients,omitempty" json:"patients,omitzero"`
+	// ParentId is the id of the site which contains this site, e.g. the region of a clinic or
+	// the clinic of a department. It's only maintained on the sites of clinics.
+	ParentId *primitive.ObjectID `bson:"parentId,omitempty" json:"parentId,omitempty"`
+	// TotalPatients is the number of patients assigned to the site or any of its descendants.
+	TotalPatients int `bson:"-" json:"totalPatients,omitzero"`
+	// Settings override the settings of the clinic. They're only maintained on the sites of
+	// clinics.
+	Settings *Settings `bson:"settings,omitempty" json:"settings,omitempty"`
 }
 
 // Equals compares Name and Id to determine if two sites are equal.
@@ -47,8 +55,9 @@ func New(name string) *Site {
 	}
 }
 
-// MaxSitesPerClinic limits the sites per clinic, to prevent abuse.
-const MaxSitesPerClinic int = 50
+// MaxSitesPerClinic limits the sites per clinic, to prevent abuse. It accommodates the
+// regions, clinics and departments of large health systems.
+const MaxSitesPerClinic int = 250
 
 // MaybeRenameSite by adding numbered suffixes, if a duplicate site exists in targetSites.
 //
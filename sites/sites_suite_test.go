@@ -0,0 +1,11 @@
+package sites_test
+
+import (
+	"testing"
+
+	"github.com/tidepool-org/clinic/test"
+)
+
+func TestSuite(t *testing.T) {
+	test.Test(t)
+}
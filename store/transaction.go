@@ -11,7 +11,13 @@ import (
 
 type Transaction = func(sessCtx mongo.SessionContext) (interface{}, error)
 
+// WithTransaction runs the transaction in a new session. When the context belongs to a session already (e.g. a service
+// is called in the transaction of the caller), the transaction joins it, so the changes are committed together.
 func WithTransaction(ctx context.Context, dbClient *mongo.Client, txn Transaction) (interface{}, error) {
+	if sessCtx, ok := ctx.(mongo.SessionContext); ok {
+		return txn(sessCtx)
+	}
+
 	session, err := dbClient.StartSession()
 	if err != nil {
 		return nil, fmt.Errorf("unable to start sessions %w", err)
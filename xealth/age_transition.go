@@ -0,0 +1,393 @@
+package xealth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/store"
+	"github.com/tidepool-org/clinic/xealth_client"
+)
+
+const (
+	// AgeTransitionPatientEmail is triggered when a patient enrolled through the guardian flow reaches
+	// the age when they can use their own account. The guardian email is replaced by the patient email.
+	AgeTransitionPatientEmail = "patientEmail"
+	// AgeTransitionReconsent is triggered when a patient reaches the age of majority and needs to consent again.
+	// The xealth subscription is suspended, until the patient consents to a new order placed in the EHR.
+	AgeTransitionReconsent = "reconsent"
+
+	AgeTransitionResultEmailUpdated       = "emailUpdated"
+	AgeTransitionResultEmailUnchanged     = "emailUnchanged"
+	AgeTransitionResultReconsentRequested = "reconsentRequested"
+
+	// patientsBatchSize is the number of patients fetched at once when looking for age transitions
+	patientsBatchSize = 1000
+)
+
+// errPatientEmailUnavailable is returned when the patient email can't be used yet. The transition isn't recorded,
+// so it's retried on the next run (e.g. after a new order with the patient email is received).
+var errPatientEmailUnavailable = errors.New("patient email is unavailable")
+
+// Clock allows the current time to be replaced in tests
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+var SystemClock Clock = systemClock{}
+
+type AgeThresholds struct {
+	// GuardianFlowMaxAge is the age after which patients are no longer enrolled through the guardian flow
+	GuardianFlowMaxAge int
+	// AgeOfMajority is the default age when patients need to consent again
+	AgeOfMajority int
+	// AgeOfMajorityByState overrides the age of majority for clinics in the given states
+	AgeOfMajorityByState map[string]int
+}
+
+func NewAgeThresholds(config *Config) AgeThresholds {
+	return AgeThresholds{
+		GuardianFlowMaxAge:   config.GuardianFlowMaxAge,
+		AgeOfMajority:        config.AgeOfMajority,
+		AgeOfMajorityByState: config.AgeOfMajorityByState,
+	}
+}
+
+func (a AgeThresholds) GetAgeOfMajority(state *string) int {
+	if state != nil {
+		for s, age := range a.AgeOfMajorityByState {
+			if strings.EqualFold(strings.TrimSpace(*state), s) {
+				return age
+			}
+		}
+	}
+	return a.AgeOfMajority
+}
+
+type DueAgeTransition struct {
+	Type string
+	Age  int
+}
+
+// GetDueAgeTransitions returns the transitions of a custodial patient with an active xealth subscription which
+// are due at the given time. A transition is due when the patient was enrolled before reaching the age threshold,
+// has reached it since, and the transition wasn't recorded yet.
+func GetDueAgeTransitions(patient patients.Patient, state *string, thresholds AgeThresholds, now time.Time) []DueAgeTransition {
+	if !patient.IsCustodial() || patient.BirthDate == nil {
+		return nil
+	}
+
+	subscription, ok := patient.EHRSubscriptions[patients.SubscriptionXealthReports]
+	if !ok || !subscription.Active {
+		return nil
+	}
+
+	birthDate, err := time.Parse(types.DateFormat, *patient.BirthDate)
+	if err != nil {
+		return nil
+	}
+
+	recorded := make(map[string]struct{}, len(patient.AgeTransitions))
+	for _, transition := range patient.AgeTransitions {
+		recorded[transition.Type] = struct{}{}
+	}
+
+	candidates := []DueAgeTransition{
+		{Type: AgeTransitionPatientEmail, Age: thresholds.GuardianFlowMaxAge},
+		{Type: AgeTransitionReconsent, Age: thresholds.GetAgeOfMajority(state)},
+	}
+
+	var due []DueAgeTransition
+	for _, candidate := range candidates {
+		if _, ok := recorded[candidate.Type]; ok || candidate.Age <= 0 {
+			continue
+		}
+
+		reachedAt := birthDate.AddDate(candidate.Age, 0, 0)
+		if subscription.CreatedAt.Before(reachedAt) && !now.Before(reachedAt) {
+			due = append(due, candidate)
+		}
+	}
+
+	return due
+}
+
+// AgeTransitionProcessor applies the due age transitions of xealth patients
+type AgeTransitionProcessor struct {
+	thresholds AgeThresholds
+	clinics    clinics.Service
+	patients   patients.Service
+	store      Store
+	clock      Clock
+	logger     *zap.SugaredLogger
+}
+
+func NewAgeTransitionProcessor(thresholds AgeThresholds, clinics clinics.Service, patients patients.Service, store Store, clock Clock, logger *zap.SugaredLogger) *AgeTransitionProcessor {
+	return &AgeTransitionProcessor{
+		thresholds: thresholds,
+		clinics:    clinics,
+		patients:   patients,
+		store:      store,
+		clock:      clock,
+		logger:     logger,
+	}
+}
+
+// ProcessAllPatients applies the due age transitions of all custodial patients with a xealth subscription. The patients
+// of clinics which can't be retrieved are skipped and the errors are returned after all other patients are processed.
+func (a *AgeTransitionProcessor) ProcessAllPatients(ctx context.Context) error {
+	isCustodial := true
+	hasSubscription := true
+	filter := patients.Filter{
+		IsCustodial:     &isCustodial,
+		HasSubscription: &hasSubscription,
+	}
+
+	var errs []error
+	clinicsById := make(map[string]*clinics.Clinic)
+	for page := store.DefaultPagination().WithLimit(patientsBatchSize); ; page = page.WithOffset(page.Offset + page.Limit) {
+		result, err := a.patients.List(ctx, &filter, page, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, patient := range result.Patients {
+			if patient.ClinicId == nil || patient.UserId == nil {
+				continue
+			}
+
+			clinicId := patient.ClinicId.Hex()
+			clinic, ok := clinicsById[clinicId]
+			if !ok {
+				clinic, err = a.clinics.Get(ctx, clinicId)
+				if err != nil {
+					a.logger.Errorw("unable to get clinic, skipping its patients", "clinicId", clinicId, "error", err)
+					errs = append(errs, fmt.Errorf("unable to get clinic %s: %w", clinicId, err))
+				}
+				// Failed clinics are cached as nil, so they are only retrieved once
+				clinicsById[clinicId] = clinic
+			}
+			if clinic == nil {
+				continue
+			}
+
+			if err := a.ProcessPatient(ctx, *clinic, *patient); err != nil {
+				a.logger.Errorw("unable to process age transitions", "clinicId", clinicId, "userId", *patient.UserId, "error", err)
+			}
+		}
+
+		if len(result.Patients) < page.Limit {
+			return errors.Join(errs...)
+		}
+	}
+}
+
+// ProcessPatient applies and records the due age transitions of the patient
+func (a *AgeTransitionProcessor) ProcessPatient(ctx context.Context, clinic clinics.Clinic, patient patients.Patient) error {
+	for _, due := range GetDueAgeTransitions(patient, clinic.State, a.thresholds, a.clock.Now()) {
+		logger := a.logger.With("clinicId", clinic.Id.Hex(), "userId", *patient.UserId, "transition", due.Type, "age", due.Age)
+
+		var result string
+		var err error
+		switch due.Type {
+		case AgeTransitionPatientEmail:
+			result, err = a.transitionToPatientEmail(ctx, clinic, patient)
+		case AgeTransitionReconsent:
+			result, err = a.requestReconsent(ctx, clinic, patient)
+		default:
+			err = fmt.Errorf("unsupported age transition %s", due.Type)
+		}
+		if errors.Is(err, errPatientEmailUnavailable) {
+			logger.Infow("patient email is unavailable, the transition will be retried")
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		err = a.patients.AddAgeTransition(ctx, clinic.Id.Hex(), *patient.UserId, patients.AgeTransition{
+			Type:        due.Type,
+			Age:         due.Age,
+			Provider:    clinics.EHRProviderXealth,
+			Result:      result,
+			CreatedTime: a.clock.Now(),
+		})
+		if errors.Is(err, patients.ErrDuplicateAgeTransition) {
+			logger.Infow("age transition was already recorded")
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		logger.Infow("recorded age transition", "result", result)
+	}
+
+	return nil
+}
+
+// transitionToPatientEmail replaces the guardian email of the patient with the email received in the last xealth order
+func (a *AgeTransitionProcessor) transitionToPatientEmail(ctx context.Context, clinic clinics.Clinic, patient patients.Patient) (string, error) {
+	email, err := a.getPatientEmailFromLastOrder(ctx, patient)
+	if err != nil {
+		return "", err
+	}
+	if email == "" {
+		return "", errPatientEmailUnavailable
+	}
+	if patient.Email != nil && strings.EqualFold(*patient.Email, email) {
+		return AgeTransitionResultEmailUnchanged, nil
+	}
+
+	update := patient
+	update.Email = &email
+	_, err = a.patients.Update(ctx, patients.PatientUpdate{
+		ClinicId: clinic.Id.Hex(),
+		UserId:   *patient.UserId,
+		Patient:  update,
+	})
+	if errors.Is(err, patients.ErrDuplicateEmail) {
+		return "", errPatientEmailUnavailable
+	} else if err != nil {
+		return "", fmt.Errorf("unable to update patient email: %w", err)
+	}
+
+	return AgeTransitionResultEmailUpdated, nil
+}
+
+// requestReconsent suspends the xealth subscription of the patient. The reports are no longer available in the EHR
+// until a new order, which requires the consent of the patient, is placed.
+func (a *AgeTransitionProcessor) requestReconsent(ctx context.Context, clinic clinics.Clinic, patient patients.Patient) (string, error) {
+	err := a.patients.UpdateEHRSubscription(ctx, clinic.Id.Hex(), *patient.UserId, patients.SubscriptionUpdate{
+		Name:     patients.SubscriptionXealthReports,
+		Provider: clinics.EHRProviderXealth,
+		Active:   false,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to suspend xealth subscription: %w", err)
+	}
+
+	return AgeTransitionResultReconsentRequested, nil
+}
+
+func (a *AgeTransitionProcessor) getPatientEmailFromLastOrder(ctx context.Context, patient patients.Patient) (string, error) {
+	subscription := patient.EHRSubscriptions[patients.SubscriptionXealthReports]
+	for i := len(subscription.MatchedMessages) - 1; i >= 0; i-- {
+		message := subscription.MatchedMessages[i]
+		if message.EventType != string(xealth_client.EventNotificationEventContextNew) {
+			continue
+		}
+
+		order, err := a.store.GetOrder(ctx, message.DocumentId.Hex())
+		if err != nil {
+			return "", err
+		}
+		if !order.HasOrderData() {
+			return "", nil
+		}
+
+		criteria, err := NewPatientMatchingCriteriaFromOrder(&order.OrderData)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(criteria.Email), nil
+	}
+
+	return "", nil
+}
+
+// AgeTransitionWorker periodically applies the age transitions of xealth patients
+type AgeTransitionWorker struct {
+	config    *Config
+	processor *AgeTransitionProcessor
+	logger    *zap.SugaredLogger
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewAgeTransitionWorker(clinics clinics.Service, patients patients.Service, store Store, logger *zap.SugaredLogger, lifecycle fx.Lifecycle) (*AgeTransitionWorker, error) {
+	cfg := ModuleConfig{}
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, err
+	}
+
+	worker := &AgeTransitionWorker{
+		logger: logger,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+
+	if !cfg.Enabled {
+		return worker, nil
+	}
+
+	worker.config = &Config{}
+	if err := envconfig.Process("", worker.config); err != nil {
+		return nil, err
+	}
+	worker.processor = NewAgeTransitionProcessor(NewAgeThresholds(worker.config), clinics, patients, store, SystemClock, logger)
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go worker.run()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			close(worker.stop)
+			select {
+			case <-worker.done:
+			case <-ctx.Done():
+			}
+			return nil
+		},
+	})
+
+	return worker, nil
+}
+
+func (w *AgeTransitionWorker) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.config.AgeTransitionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+			w.processAllPatients()
+		}
+	}
+}
+
+func (w *AgeTransitionWorker) processAllPatients() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-w.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if err := w.processor.ProcessAllPatients(ctx); err != nil {
+		w.logger.Errorw("unable to process xealth age transitions", "error", err)
+	}
+}
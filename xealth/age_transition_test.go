@@ -0,0 +1,219 @@
+package xealth_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/clinics"
+	clinicsTest "github.com/tidepool-org/clinic/clinics/test"
+	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+	patientsTest "github.com/tidepool-org/clinic/patients/test"
+	"github.com/tidepool-org/clinic/xealth"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (f *fakeClock) Now() time.Time {
+	return f.now
+}
+
+var _ = Describe("Age Transitions", func() {
+	var thresholds xealth.AgeThresholds
+	var patient patients.Patient
+	var enrolledAt time.Time
+
+	BeforeEach(func() {
+		thresholds = xealth.AgeThresholds{
+			GuardianFlowMaxAge:   13,
+			AgeOfMajority:        18,
+			AgeOfMajorityByState: map[string]int{"NE": 19},
+		}
+
+		birthDate := "2010-06-15"
+		userId := "1234567890"
+		clinicId := primitive.NewObjectID()
+		enrolledAt = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		patient = patients.Patient{
+			ClinicId:    &clinicId,
+			UserId:      &userId,
+			BirthDate:   &birthDate,
+			Permissions: &patients.CustodialAccountPermissions,
+			EHRSubscriptions: patients.EHRSubscriptions{
+				patients.SubscriptionXealthReports: {
+					Active:    true,
+					Provider:  clinics.EHRProviderXealth,
+					CreatedAt: enrolledAt,
+				},
+			},
+		}
+	})
+
+	Describe("GetDueAgeTransitions", func() {
+		It("returns nothing before the patient reaches the guardian flow max age", func() {
+			now := time.Date(2023, 6, 14, 23, 59, 0, 0, time.UTC)
+			Expect(xealth.GetDueAgeTransitions(patient, nil, thresholds, now)).To(BeEmpty())
+		})
+
+		It("returns the patient email transition on the birthday", func() {
+			now := time.Date(2023, 6, 15, 0, 0, 0, 0, time.UTC)
+			Expect(xealth.GetDueAgeTransitions(patient, nil, thresholds, now)).To(ConsistOf(xealth.DueAgeTransition{
+				Type: xealth.AgeTransitionPatientEmail,
+				Age:  13,
+			}))
+		})
+
+		It("doesn't return transitions which were already recorded", func() {
+			patient.AgeTransitions = []patients.AgeTransition{{Type: xealth.AgeTransitionPatientEmail, Age: 13}}
+			now := time.Date(2023, 6, 15, 0, 0, 0, 0, time.UTC)
+			Expect(xealth.GetDueAgeTransitions(patient, nil, thresholds, now)).To(BeEmpty())
+		})
+
+		It("doesn't return transitions for patients enrolled after reaching the age threshold", func() {
+			subscription := patient.EHRSubscriptions[patients.SubscriptionXealthReports]
+			subscription.CreatedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			patient.EHRSubscriptions[patients.SubscriptionXealthReports] = subscription
+
+			now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+			Expect(xealth.GetDueAgeTransitions(patient, nil, thresholds, now)).To(BeEmpty())
+		})
+
+		It("uses the age of majority of the clinic state", func() {
+			patient.AgeTransitions = []patients.AgeTransition{{Type: xealth.AgeTransitionPatientEmail, Age: 13}}
+			state := "NE"
+
+			now := time.Date(2028, 6, 15, 0, 0, 0, 0, time.UTC)
+			Expect(xealth.GetDueAgeTransitions(patient, &state, thresholds, now)).To(BeEmpty())
+
+			now = time.Date(2029, 6, 15, 0, 0, 0, 0, time.UTC)
+			Expect(xealth.GetDueAgeTransitions(patient, &state, thresholds, now)).To(ConsistOf(xealth.DueAgeTransition{
+				Type: xealth.AgeTransitionReconsent,
+				Age:  19,
+			}))
+		})
+
+		It("doesn't return transitions for patients with inactive subscriptions", func() {
+			subscription := patient.EHRSubscriptions[patients.SubscriptionXealthReports]
+			subscription.Active = false
+			patient.EHRSubscriptions[patients.SubscriptionXealthReports] = subscription
+
+			now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+			Expect(xealth.GetDueAgeTransitions(patient, nil, thresholds, now)).To(BeEmpty())
+		})
+	})
+
+	Describe("ProcessPatient", func() {
+		var patientsCtrl *gomock.Controller
+		var clinicsCtrl *gomock.Controller
+		var patientsService *patientsTest.MockService
+		var clinicsService *clinicsTest.MockService
+		var clock *fakeClock
+		var processor *xealth.AgeTransitionProcessor
+		var clinic clinics.Clinic
+
+		BeforeEach(func() {
+			patientsCtrl = gomock.NewController(GinkgoT())
+			patientsService = patientsTest.NewMockService(patientsCtrl)
+			clinicsCtrl = gomock.NewController(GinkgoT())
+			clinicsService = clinicsTest.NewMockService(clinicsCtrl)
+
+			clock = &fakeClock{}
+			processor = xealth.NewAgeTransitionProcessor(thresholds, clinicsService, patientsService, nil, clock, zap.NewNop().Sugar())
+			clinic = clinics.Clinic{Id: patient.ClinicId}
+		})
+
+		AfterEach(func() {
+			patientsCtrl.Finish()
+			clinicsCtrl.Finish()
+		})
+
+		It("suspends the subscription and records the reconsent transition", func() {
+			clock.now = time.Date(2028, 6, 15, 0, 0, 0, 0, time.UTC)
+
+			var recorded []patients.AgeTransition
+			patientsService.EXPECT().
+				UpdateEHRSubscription(gomock.Any(), patient.ClinicId.Hex(), *patient.UserId, patients.SubscriptionUpdate{
+					Name:     patients.SubscriptionXealthReports,
+					Provider: clinics.EHRProviderXealth,
+					Active:   false,
+				}).
+				Return(nil)
+			patientsService.EXPECT().
+				AddAgeTransition(gomock.Any(), patient.ClinicId.Hex(), *patient.UserId, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _ string, transition patients.AgeTransition) error {
+					recorded = append(recorded, transition)
+					return nil
+				})
+
+			Expect(processor.ProcessPatient(context.Background(), clinic, patient)).To(Succeed())
+			Expect(recorded).To(HaveLen(1))
+			Expect(recorded[0].Type).To(Equal(xealth.AgeTransitionReconsent))
+			Expect(recorded[0].Result).To(Equal(xealth.AgeTransitionResultReconsentRequested))
+			Expect(recorded[0].CreatedTime).To(Equal(clock.now))
+		})
+
+		It("doesn't record the patient email transition when the email is unavailable", func() {
+			clock.now = time.Date(2023, 6, 15, 0, 0, 0, 0, time.UTC)
+
+			patientsService.EXPECT().
+				AddAgeTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Times(0)
+
+			Expect(processor.ProcessPatient(context.Background(), clinic, patient)).To(Succeed())
+		})
+
+		It("ignores transitions recorded concurrently", func() {
+			clock.now = time.Date(2028, 6, 15, 0, 0, 0, 0, time.UTC)
+
+			patientsService.EXPECT().
+				UpdateEHRSubscription(gomock.Any(), patient.ClinicId.Hex(), *patient.UserId, gomock.Any()).
+				Return(nil)
+			patientsService.EXPECT().
+				AddAgeTransition(gomock.Any(), patient.ClinicId.Hex(), *patient.UserId, gomock.Any()).
+				Return(patients.ErrDuplicateAgeTransition)
+
+			Expect(processor.ProcessPatient(context.Background(), clinic, patient)).To(Succeed())
+		})
+	})
+
+	Describe("ProcessAllPatients", func() {
+		It("processes the patients of the other clinics when a clinic can't be retrieved", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			patientsService := patientsTest.NewMockService(ctrl)
+			clinicsService := clinicsTest.NewMockService(ctrl)
+			clock := &fakeClock{now: time.Date(2028, 6, 15, 0, 0, 0, 0, time.UTC)}
+			processor := xealth.NewAgeTransitionProcessor(thresholds, clinicsService, patientsService, nil, clock, zap.NewNop().Sugar())
+
+			failingClinicId := primitive.NewObjectID()
+			otherPatient := patient
+			otherPatient.ClinicId = &failingClinicId
+
+			patientsService.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&patients.ListResult{Patients: []*patients.Patient{&otherPatient, &patient}}, nil)
+			clinicsService.EXPECT().
+				Get(gomock.Any(), failingClinicId.Hex()).
+				Return(nil, errs.NotFound)
+			clinicsService.EXPECT().
+				Get(gomock.Any(), patient.ClinicId.Hex()).
+				Return(&clinics.Clinic{Id: patient.ClinicId}, nil)
+			patientsService.EXPECT().
+				UpdateEHRSubscription(gomock.Any(), patient.ClinicId.Hex(), *patient.UserId, gomock.Any()).
+				Return(nil)
+			patientsService.EXPECT().
+				AddAgeTransition(gomock.Any(), patient.ClinicId.Hex(), *patient.UserId, gomock.Any()).
+				Return(nil)
+
+			err := processor.ProcessAllPatients(context.Background())
+			Expect(err).To(MatchError(errs.NotFound))
+		})
+	})
+})
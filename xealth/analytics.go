@@ -0,0 +1,101 @@
+package xealth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/store"
+)
+
+const (
+	ReportViewIntervalDay   = "day"
+	ReportViewIntervalWeek  = "week"
+	ReportViewIntervalMonth = "month"
+)
+
+type ReportViewHistoryFilter struct {
+	ClinicId         primitive.ObjectID
+	PatientUserId    *string
+	SystemLogin      *string
+	CreatedTimeStart *time.Time
+	CreatedTimeEnd   *time.Time
+	// Sites limits the views to the patients assigned to at least one of the sites
+	Sites *[]string
+}
+
+type PatientsNotViewedFilter struct {
+	ClinicId primitive.ObjectID
+	// Sites limits the list to the patients assigned to at least one of the sites
+	Sites *[]string
+}
+
+type ReportViewList struct {
+	Views      []ReportView
+	TotalCount int
+}
+
+type ReportViewStats struct {
+	Views          int                     `bson:"views"`
+	UniquePatients int                     `bson:"uniquePatients"`
+	UniqueViewers  int                     `bson:"uniqueViewers"`
+	Periods        []ReportViewPeriodStats `bson:"periods"`
+	Viewers        []ReportViewerStats     `bson:"viewers"`
+}
+
+type ReportViewPeriodStats struct {
+	StartTime      time.Time `bson:"startTime"`
+	Views          int       `bson:"views"`
+	UniquePatients int       `bson:"uniquePatients"`
+	UniqueViewers  int       `bson:"uniqueViewers"`
+}
+
+type ReportViewerStats struct {
+	UserId         string    `bson:"userId"`
+	SystemLogin    *string   `bson:"systemLogin,omitempty"`
+	Views          int       `bson:"views"`
+	UniquePatients int       `bson:"uniquePatients"`
+	LastViewedTime time.Time `bson:"lastViewedTime"`
+}
+
+type PatientReportViewStatus struct {
+	Patient        patients.Patient `bson:",inline"`
+	LastUploadTime time.Time        `bson:"lastUploadTime"`
+	LastViewedTime *time.Time       `bson:"lastViewedTime,omitempty"`
+}
+
+type PatientReportViewStatusList struct {
+	Patients   []PatientReportViewStatus
+	TotalCount int
+}
+
+func ValidateReportViewInterval(interval string) error {
+	switch interval {
+	case ReportViewIntervalDay, ReportViewIntervalWeek, ReportViewIntervalMonth:
+		return nil
+	default:
+		return fmt.Errorf("%w: invalid interval %s", errs.BadRequest, interval)
+	}
+}
+
+func (d *defaultHandler) ListReportViews(ctx context.Context, filter ReportViewHistoryFilter, pagination store.Pagination) (*ReportViewList, error) {
+	return d.store.ListReportViews(ctx, filter, pagination)
+}
+
+func (d *defaultHandler) GetReportViewStats(ctx context.Context, filter ReportViewHistoryFilter, interval string) (*ReportViewStats, error) {
+	if err := ValidateReportViewInterval(interval); err != nil {
+		return nil, err
+	}
+
+	return d.store.GetReportViewStats(ctx, filter, interval)
+}
+
+// ListPatientsNotViewedSinceLastUpload returns the patients with an active xealth subscription
+// whose reports were not viewed by any EHR user after the patient's last upload, most recent uploads first
+func (d *defaultHandler) ListPatientsNotViewedSinceLastUpload(ctx context.Context, filter PatientsNotViewedFilter, pagination store.Pagination) (*PatientReportViewStatusList, error) {
+	return d.store.ListPatientsNotViewedSinceLastUpload(ctx, filter, pagination)
+}
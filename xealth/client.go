@@ -2,11 +2,11 @@ package xealth
 
 import (
 	"context"
-	"fmt"
 	"github.com/tidepool-org/clinic/xealth_client"
 	"go.uber.org/zap"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
+	"golang.org/x/sync/singleflight"
 	"net/http"
 	"sync"
 	"time"
@@ -14,15 +14,24 @@ import (
 
 const gracePeriod = time.Second * 30
 
+// refreshWindow is how long before the expiration (minus the grace period) the token is refreshed
+// in the background, while the current token is still being handed out to callers
+const refreshWindow = time.Minute * 2
+
+const tokenRefreshKey = "token"
+
 type authenticator struct {
 	config *clientcredentials.Config
-	mu     *sync.Mutex
+	mu     *sync.RWMutex
+	group  *singleflight.Group
 
-	token  *oauth2.Token
-	logger *zap.SugaredLogger
+	token   *oauth2.Token
+	logger  *zap.SugaredLogger
+	metrics *clientMetrics
+	now     func() time.Time
 }
 
-func newAuthenticator(config *Config, logger *zap.SugaredLogger) (*authenticator, error) {
+func newAuthenticator(config *Config, metrics *clientMetrics, logger *zap.SugaredLogger) (*authenticator, error) {
 	return &authenticator{
 		config: &clientcredentials.Config{
 			ClientID:     config.ClientId,
@@ -30,53 +39,99 @@ func newAuthenticator(config *Config, logger *zap.SugaredLogger) (*authenticator
 			TokenURL:     config.TokenUrl,
 			AuthStyle:    oauth2.AuthStyleInHeader,
 		},
-		mu:     &sync.Mutex{},
-		logger: logger,
+		mu:      &sync.RWMutex{},
+		group:   &singleflight.Group{},
+		logger:  logger,
+		metrics: metrics,
+		now:     time.Now,
 	}, nil
 }
 
 func (a *authenticator) GetToken(ctx context.Context) (*oauth2.Token, error) {
-	a.mu.Lock()
-	defer a.mu.Unlock()
+	a.mu.RLock()
+	token := a.token
+	a.mu.RUnlock()
+
+	if !a.tokenIsValid(token) {
+		return a.refreshToken(ctx)
+	}
+
+	if a.tokenNeedsRefresh(token) {
+		// The current token is still valid, refresh it in the background without blocking the caller.
+		// The context of the request is not used, because the refresh may outlive the request.
+		go func() {
+			if _, err := a.refreshToken(context.Background()); err != nil {
+				a.logger.Warnw("unable to proactively refresh xealth token", "error", err)
+			}
+		}()
+	}
+
+	return token, nil
+}
+
+// refreshToken obtains a new token from xealth. Concurrent callers share a single in-flight request.
+func (a *authenticator) refreshToken(ctx context.Context) (*oauth2.Token, error) {
+	result, err, _ := a.group.Do(tokenRefreshKey, func() (interface{}, error) {
+		a.mu.RLock()
+		current := a.token
+		a.mu.RUnlock()
+
+		// Another caller may have refreshed the token while we were waiting
+		if a.tokenIsValid(current) && !a.tokenNeedsRefresh(current) {
+			return current, nil
+		}
 
-	if !a.tokenIsValid() {
 		a.logger.Debugw("obtaining token from xealth", "tokenUrl", a.config.TokenURL, "clientId", a.config.ClientID)
 		token, err := a.config.Token(ctx)
 		if err != nil {
+			a.metrics.tokenRefreshes.WithLabelValues(metricsResultFailure).Inc()
 			return nil, err
 		}
+		a.metrics.tokenRefreshes.WithLabelValues(metricsResultSuccess).Inc()
 
+		a.mu.Lock()
 		a.token = token
+		a.mu.Unlock()
+
+		return token, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return a.token, nil
+	return result.(*oauth2.Token), nil
 }
 
-func (a *authenticator) tokenIsValid() bool {
-	if a.token == nil || a.token.Expiry.Add(-gracePeriod).Before(time.Now()) {
+func (a *authenticator) tokenIsValid(token *oauth2.Token) bool {
+	if token == nil || token.Expiry.Add(-gracePeriod).Before(a.now()) {
 		return false
 	}
 
 	return true
 }
 
+func (a *authenticator) tokenNeedsRefresh(token *oauth2.Token) bool {
+	return token == nil || token.Expiry.Add(-gracePeriod-refreshWindow).Before(a.now())
+}
+
 func NewClient(config *Config, logger *zap.SugaredLogger) (xealth_client.ClientWithResponsesInterface, error) {
-	auth, err := newAuthenticator(config, logger)
+	metrics := newClientMetrics()
+
+	auth, err := newAuthenticator(config, metrics, logger)
 	if err != nil {
 		return nil, err
 	}
 
-	withToken := func(ctx context.Context, req *http.Request) error {
-		token, e := auth.GetToken(ctx)
-		if e != nil || token == nil {
-			logger.Errorw("unable to obtain xealth token", "error", e)
-			return e
-		}
-
-		logger.Debugw("obtained token for xealth", "token", token, "url", req.URL)
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %v", token.AccessToken))
-		return nil
-	}
+	doer := newResilientDoer(http.DefaultClient, auth, RetryConfig{
+		MaxRetries:              config.MaxRetries,
+		InitialBackoff:          config.InitialBackoff,
+		MaxBackoff:              config.MaxBackoff,
+		CircuitBreakerThreshold: config.CircuitBreakerThreshold,
+		CircuitBreakerCooldown:  config.CircuitBreakerCooldown,
+	}, metrics, logger)
 
-	return xealth_client.NewClientWithResponses(config.ServerBaseUrl, xealth_client.WithRequestEditorFn(withToken))
+	return xealth_client.NewClientWithResponses(
+		config.ServerBaseUrl,
+		xealth_client.WithHTTPClient(doer),
+	)
 }
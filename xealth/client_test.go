@@ -0,0 +1,148 @@
+package xealth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/tidepool-org/clinic/xealth"
+	"github.com/tidepool-org/clinic/xealth_client"
+)
+
+var _ = Describe("Client", func() {
+	var server *httptest.Server
+	var client xealth_client.ClientWithResponsesInterface
+	var tokenRequests atomic.Int32
+	var orderRequests atomic.Int32
+	var failedOrderRequests int32
+	var tokenStatus int
+
+	BeforeEach(func() {
+		tokenRequests.Store(0)
+		orderRequests.Store(0)
+		failedOrderRequests = 0
+		tokenStatus = http.StatusOK
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/oauth2/token" {
+				tokenRequests.Add(1)
+				if tokenStatus != http.StatusOK {
+					w.WriteHeader(tokenStatus)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"access_token":"xealth-token","token_type":"Bearer","expires_in":3600}`))
+				return
+			}
+
+			Expect(r.Header.Get("Authorization")).To(Equal("Bearer xealth-token"))
+			if strings.HasPrefix(r.URL.Path, "/v2/partner/read/order/") {
+				if orderRequests.Add(1) <= failedOrderRequests {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{}`))
+				return
+			}
+
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+
+		var err error
+		client, err = xealth.NewClient(&xealth.Config{
+			ClientId:                "client",
+			ClientSecret:            "secret",
+			TokenUrl:                server.URL + "/oauth2/token",
+			ServerBaseUrl:           server.URL + "/v2",
+			MaxRetries:              2,
+			InitialBackoff:          time.Millisecond,
+			MaxBackoff:              time.Millisecond * 5,
+			CircuitBreakerThreshold: 3,
+			CircuitBreakerCooldown:  time.Hour,
+		}, zap.NewNop().Sugar())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("reuses the access token for subsequent requests", func() {
+		for i := 0; i < 3; i++ {
+			res, err := client.GetPartnerReadOrderDeploymentOrderIdWithResponse(context.Background(), "deployment", "order", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		}
+		Expect(tokenRequests.Load()).To(Equal(int32(1)))
+	})
+
+	It("retries failed idempotent requests", func() {
+		failedOrderRequests = 2
+
+		res, err := client.GetPartnerReadOrderDeploymentOrderIdWithResponse(context.Background(), "deployment", "order", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(orderRequests.Load()).To(Equal(int32(3)))
+	})
+
+	It("returns the last response when the retries are exhausted", func() {
+		failedOrderRequests = 3
+
+		res, err := client.GetPartnerReadOrderDeploymentOrderIdWithResponse(context.Background(), "deployment", "order", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.StatusCode()).To(Equal(http.StatusServiceUnavailable))
+		Expect(orderRequests.Load()).To(Equal(int32(3)))
+	})
+
+	It("retries when the token endpoint is unavailable", func() {
+		tokenStatus = http.StatusServiceUnavailable
+
+		_, err := client.GetPartnerReadOrderDeploymentOrderIdWithResponse(context.Background(), "deployment", "order", nil)
+		Expect(err).To(HaveOccurred())
+		Expect(tokenRequests.Load()).To(Equal(int32(3)))
+		Expect(orderRequests.Load()).To(Equal(int32(0)))
+	})
+
+	It("doesn't open the circuit breaker when the token endpoint is unavailable", func() {
+		tokenStatus = http.StatusServiceUnavailable
+		_, err := client.GetPartnerReadOrderDeploymentOrderIdWithResponse(context.Background(), "deployment", "order", nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err).ToNot(MatchError(xealth.ErrCircuitOpen))
+
+		tokenStatus = http.StatusOK
+		res, err := client.GetPartnerReadOrderDeploymentOrderIdWithResponse(context.Background(), "deployment", "order", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+	})
+
+	It("doesn't retry non-idempotent requests", func() {
+		res, err := client.PostPartnerWriteOrderDeploymentWithResponse(context.Background(), "deployment", nil, xealth_client.PostPartnerWriteOrderDeploymentJSONRequestBody{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.StatusCode()).To(Equal(http.StatusServiceUnavailable))
+	})
+
+	It("opens the circuit breaker after consecutive failures for a deployment", func() {
+		failedOrderRequests = 100
+
+		_, err := client.GetPartnerReadOrderDeploymentOrderIdWithResponse(context.Background(), "failing", "order", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(orderRequests.Load()).To(Equal(int32(3)))
+
+		_, err = client.GetPartnerReadOrderDeploymentOrderIdWithResponse(context.Background(), "failing", "order", nil)
+		Expect(err).To(MatchError(xealth.ErrCircuitOpen))
+		Expect(orderRequests.Load()).To(Equal(int32(3)))
+
+		failedOrderRequests = 0
+		res, err := client.GetPartnerReadOrderDeploymentOrderIdWithResponse(context.Background(), "healthy", "order", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+	})
+})
@@ -3,6 +3,7 @@ package xealth
 import (
 	"context"
 	"fmt"
+	"github.com/tidepool-org/clinic/store"
 	"github.com/tidepool-org/clinic/xealth_client"
 	"net/http"
 )
@@ -19,6 +20,10 @@ func (d *disabledHandler) HandleEventNotification(ctx context.Context, event xea
 	return fmt.Errorf("the xealth integration is not enabled")
 }
 
+func (d *disabledHandler) ProcessOrder(ctx context.Context, documentId string) error {
+	return fmt.Errorf("the xealth integration is not enabled")
+}
+
 func (d *disabledHandler) AuthorizeRequest(req *http.Request) error {
 	return fmt.Errorf("the xealth integration is not enabled")
 }
@@ -38,3 +43,15 @@ func (d *disabledHandler) GetPrograms(ctx context.Context, event xealth_client.G
 func (d *disabledHandler) GetPDFReport(ctx context.Context, request PDFReportRequest) (*PDFReport, error) {
 	return nil, fmt.Errorf("the xealth integration is not enabled")
 }
+
+func (d *disabledHandler) ListReportViews(ctx context.Context, filter ReportViewHistoryFilter, pagination store.Pagination) (*ReportViewList, error) {
+	return nil, fmt.Errorf("the xealth integration is not enabled")
+}
+
+func (d *disabledHandler) GetReportViewStats(ctx context.Context, filter ReportViewHistoryFilter, interval string) (*ReportViewStats, error) {
+	return nil, fmt.Errorf("the xealth integration is not enabled")
+}
+
+func (d *disabledHandler) ListPatientsNotViewedSinceLastUpload(ctx context.Context, filter PatientsNotViewedFilter, pagination store.Pagination) (*PatientReportViewStatusList, error) {
+	return nil, fmt.Errorf("the xealth integration is not enabled")
+}
@@ -2,12 +2,13 @@ package xealth
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/oapi-codegen/runtime/types"
 	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/ehr"
 	errs "github.com/tidepool-org/clinic/errors"
 	"github.com/tidepool-org/clinic/patients"
-	"github.com/tidepool-org/clinic/store"
 	"github.com/tidepool-org/clinic/xealth_client"
 	"go.uber.org/zap"
 	"strings"
@@ -37,8 +38,7 @@ type Matcher[R Response] struct {
 
 	matchDateOfBirth bool
 
-	clinics  clinics.Service
-	patients patients.Service
+	provider ehr.Provider
 	logger   *zap.SugaredLogger
 
 	noClinicsResp  R
@@ -60,10 +60,9 @@ type MatchingResult[R Response] struct {
 	Response R
 }
 
-func NewMatcher[R Response](clinics clinics.Service, patients patients.Service, logger *zap.SugaredLogger) *Matcher[R] {
+func NewMatcher[R Response](provider ehr.Provider, logger *zap.SugaredLogger) *Matcher[R] {
 	return &Matcher[R]{
-		clinics:  clinics,
-		patients: patients,
+		provider: provider,
 		logger:   logger,
 
 		matchDateOfBirth: true,
@@ -184,24 +183,20 @@ func (m *Matcher[R]) DisableErrorOnNoMatchingPatients() *Matcher[R] {
 
 func (m *Matcher[R]) Match(ctx context.Context) (result MatchingResult[R], err error) {
 	m.logger.Infow("finding xealth enabled clinic", "deployment", m.deployment)
-	matchingClinics, err := m.findClinic(ctx, m.deployment)
-	if err != nil {
-		return
-	}
-
-	clinicsCount := len(matchingClinics)
-	if clinicsCount == 0 {
+	result.Clinic, err = m.provider.MatchClinic(ctx, m.deployment)
+	if errors.Is(err, errs.NotFound) {
 		m.logger.Warnw("no xealth enabled clinics found", "deployment", m.deployment)
 		result.Response = m.noClinicsResp
 		err = m.noClinicsErr
 		return
-	} else if clinicsCount > 1 {
+	} else if errors.Is(err, errs.Duplicate) {
 		m.logger.Warnw("multiple xealth enabled clinics found", "deployment", m.deployment)
 		result.Response = m.multipleClinicsResp
 		err = m.multipleClinicsErr
 		return
+	} else if err != nil {
+		return
 	} else {
-		result.Clinic = matchingClinics[0]
 		m.logger.Infow("found xealth enabled clinic", "deployment", m.deployment, "clinicId", result.Clinic.Id.Hex())
 	}
 
@@ -255,41 +250,13 @@ func (m *Matcher[R]) Match(ctx context.Context) (result MatchingResult[R], err e
 	return
 }
 
-func (m *Matcher[R]) findClinic(ctx context.Context, deployment string) ([]*clinics.Clinic, error) {
-	enabled := true
-	filter := &clinics.Filter{
-		EHRProvider: &clinics.EHRProviderXealth,
-		EHRSourceId: &deployment,
-		EHREnabled:  &enabled,
-	}
-	page := store.Pagination{
-		Offset: 0,
-		Limit:  2,
-	}
-
-	return m.clinics.List(ctx, filter, page)
-}
-
 func (m *Matcher[R]) findMatchingPatients(ctx context.Context, criteria *PatientMatchingCriteria, clinic *clinics.Clinic) ([]*patients.Patient, error) {
-	clinicId := clinic.Id.Hex()
-	page := store.Pagination{
-		Offset: 0,
-		Limit:  100,
-	}
-
-	filter := patients.Filter{
-		ClinicId: &clinicId,
-		Mrn:      &criteria.Mrn,
-	}
+	strategy := ehr.MRNPatientMatchingStrategy
 	if m.matchDateOfBirth {
-		filter.BirthDate = &criteria.DateOfBirth
-	}
-	result, err := m.patients.List(ctx, &filter, page, nil)
-	if err != nil {
-		return nil, err
+		strategy = ehr.MRNAndDOBPatientMatchingStrategy
 	}
 
-	return result.Patients, nil
+	return m.provider.MatchPatients(ctx, *clinic, criteria.ToEHRCriteria(), []string{strategy})
 }
 
 type PatientMatchingCriteria struct {
@@ -301,12 +268,24 @@ type PatientMatchingCriteria struct {
 	Email       string
 }
 
-func (p *PatientMatchingCriteria) IsPatientUnder13() bool {
+// ToEHRCriteria returns the provider independent patient matching criteria
+func (p *PatientMatchingCriteria) ToEHRCriteria() ehr.PatientCriteria {
+	return ehr.PatientCriteria{
+		FirstName:   p.FirstName,
+		LastName:    p.LastName,
+		FullName:    p.FullName,
+		MRN:         p.Mrn,
+		DateOfBirth: p.DateOfBirth,
+	}
+}
+
+// IsPatientUnderAge returns true if the patient hasn't reached the given age at the given time
+func (p *PatientMatchingCriteria) IsPatientUnderAge(age int, now time.Time) bool {
 	dob, err := time.Parse(types.DateFormat, p.DateOfBirth)
 	if err != nil {
 		return false
 	}
-	return dob.AddDate(13, 0, 0).After(time.Now())
+	return dob.AddDate(age, 0, 0).After(now)
 }
 
 func (p *PatientMatchingCriteria) Validate() error {
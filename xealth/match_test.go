@@ -73,7 +73,7 @@ var _ = Describe("Matcher", func() {
 			})
 
 			It("is correct", func() {
-				matcher := xealth.NewMatcher[*xealth_client.PreorderFormResponse](clinicsService, patientsService, zap.NewNop().Sugar()).
+				matcher := xealth.NewMatcher[*xealth_client.PreorderFormResponse](xealth.NewProvider(clinicsService, patientsService), zap.NewNop().Sugar()).
 					FromInitialPreorderForRequest(initialPreorderFormRequest).
 					DisableErrorOnNoMatchingPatients()
 
@@ -97,7 +97,7 @@ var _ = Describe("Matcher", func() {
 			})
 
 			It("is correct", func() {
-				matcher := xealth.NewMatcher[*xealth_client.PreorderFormResponse](clinicsService, patientsService, zap.NewNop().Sugar()).
+				matcher := xealth.NewMatcher[*xealth_client.PreorderFormResponse](xealth.NewProvider(clinicsService, patientsService), zap.NewNop().Sugar()).
 					FromSubsequentPreorderForRequest(subsequentPreorderFormRequest).
 					DisableErrorOnNoMatchingPatients()
 
@@ -121,7 +121,7 @@ var _ = Describe("Matcher", func() {
 			})
 
 			It("is correct", func() {
-				matcher := xealth.NewMatcher[*xealth_client.PreorderFormResponse](clinicsService, patientsService, zap.NewNop().Sugar()).
+				matcher := xealth.NewMatcher[*xealth_client.PreorderFormResponse](xealth.NewProvider(clinicsService, patientsService), zap.NewNop().Sugar()).
 					FromOrder(orderEvent).
 					DisableErrorOnNoMatchingPatients()
 
@@ -145,7 +145,7 @@ var _ = Describe("Matcher", func() {
 			})
 
 			It("is correct", func() {
-				matcher := xealth.NewMatcher[*xealth_client.PreorderFormResponse](clinicsService, patientsService, zap.NewNop().Sugar()).
+				matcher := xealth.NewMatcher[*xealth_client.PreorderFormResponse](xealth.NewProvider(clinicsService, patientsService), zap.NewNop().Sugar()).
 					FromProgramsRequest(request).
 					DisableErrorOnNoMatchingPatients()
 
@@ -169,7 +169,7 @@ var _ = Describe("Matcher", func() {
 			})
 
 			It("is correct", func() {
-				matcher := xealth.NewMatcher[*xealth_client.PreorderFormResponse](clinicsService, patientsService, zap.NewNop().Sugar()).
+				matcher := xealth.NewMatcher[*xealth_client.PreorderFormResponse](xealth.NewProvider(clinicsService, patientsService), zap.NewNop().Sugar()).
 					FromProgramUrlRequest(request).
 					DisableErrorOnNoMatchingPatients()
 
@@ -0,0 +1,79 @@
+package xealth
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "tidepool"
+	metricsSubsystem = "clinic_xealth_client"
+
+	metricsResultSuccess     = "success"
+	metricsResultFailure     = "failure"
+	metricsResultCircuitOpen = "circuit_open"
+)
+
+type clientMetrics struct {
+	requests             *prometheus.CounterVec
+	requestDuration      *prometheus.HistogramVec
+	retries              *prometheus.CounterVec
+	circuitBreakerOpened *prometheus.CounterVec
+	tokenRefreshes       *prometheus.CounterVec
+}
+
+var (
+	defaultClientMetrics     *clientMetrics
+	defaultClientMetricsOnce sync.Once
+)
+
+// newClientMetrics returns the xealth client metrics. The collectors are registered with the default
+// prometheus registry only once, because the client may be instantiated multiple times.
+func newClientMetrics() *clientMetrics {
+	defaultClientMetricsOnce.Do(func() {
+		defaultClientMetrics = &clientMetrics{
+			requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Subsystem: metricsSubsystem,
+				Name:      "requests_total",
+				Help:      "The total number of requests sent to xealth",
+			}, []string{"deployment", "method", "result"}),
+			requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+				Namespace: metricsNamespace,
+				Subsystem: metricsSubsystem,
+				Name:      "request_duration_seconds",
+				Help:      "The duration of requests sent to xealth",
+				Buckets:   prometheus.DefBuckets,
+			}, []string{"deployment", "method"}),
+			retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Subsystem: metricsSubsystem,
+				Name:      "retries_total",
+				Help:      "The total number of retried requests to xealth",
+			}, []string{"deployment"}),
+			circuitBreakerOpened: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Subsystem: metricsSubsystem,
+				Name:      "circuit_breaker_opened_total",
+				Help:      "The total number of times the circuit breaker for a deployment was opened",
+			}, []string{"deployment"}),
+			tokenRefreshes: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Subsystem: metricsSubsystem,
+				Name:      "token_refreshes_total",
+				Help:      "The total number of access tokens obtained from xealth",
+			}, []string{"result"}),
+		}
+
+		prometheus.MustRegister(
+			defaultClientMetrics.requests,
+			defaultClientMetrics.requestDuration,
+			defaultClientMetrics.retries,
+			defaultClientMetrics.circuitBreakerOpened,
+			defaultClientMetrics.tokenRefreshes,
+		)
+	})
+
+	return defaultClientMetrics
+}
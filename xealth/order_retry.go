@@ -0,0 +1,118 @@
+package xealth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/store"
+)
+
+// OrderRetryWorker periodically re-processes xealth orders which failed or were left in pending state
+type OrderRetryWorker struct {
+	config  *Config
+	handler Xealth
+	store   Store
+	logger  *zap.SugaredLogger
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewOrderRetryWorker(handler Xealth, store Store, logger *zap.SugaredLogger, lifecycle fx.Lifecycle) (*OrderRetryWorker, error) {
+	cfg := ModuleConfig{}
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, err
+	}
+
+	worker := &OrderRetryWorker{
+		handler: handler,
+		store:   store,
+		logger:  logger,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	if !cfg.Enabled {
+		return worker, nil
+	}
+
+	worker.config = &Config{}
+	if err := envconfig.Process("", worker.config); err != nil {
+		return nil, err
+	}
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go worker.run()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			close(worker.stop)
+			select {
+			case <-worker.done:
+			case <-ctx.Done():
+			}
+			return nil
+		},
+	})
+
+	return worker, nil
+}
+
+func (w *OrderRetryWorker) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.config.OrderRetryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+			w.RetryDueOrders()
+		}
+	}
+}
+
+// RetryDueOrders processes all orders which are due for a retry
+func (w *OrderRetryWorker) RetryDueOrders() {
+	for {
+		select {
+		case <-w.stop:
+			return
+		default:
+		}
+
+		if !w.retryNextOrder() {
+			return
+		}
+	}
+}
+
+func (w *OrderRetryWorker) retryNextOrder() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), store.ContextTimeout)
+	defer cancel()
+
+	order, err := w.store.ClaimOrderForRetry(ctx, w.config.OrderRetryLease, w.config.OrderRetryMaxAttempts)
+	if errors.Is(err, errs.NotFound) {
+		return false
+	} else if err != nil {
+		w.logger.Errorw("unable to claim xealth order for retry", "error", err)
+		return false
+	}
+
+	logger := w.logger.With("documentId", order.Id.Hex(), "orderId", order.EventNotification.OrderId, "attempt", order.Attempts)
+	logger.Infow("retrying xealth order")
+	if err := w.handler.ProcessOrder(ctx, order.Id.Hex()); err != nil {
+		logger.Errorw("unable to process xealth order", "error", err)
+	}
+
+	return true
+}
@@ -0,0 +1,24 @@
+package xealth_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tidepool-org/clinic/xealth"
+)
+
+var _ = Describe("Order Retry", func() {
+	Describe("GetOrderRetryBackoff", func() {
+		It("doubles the backoff after every attempt", func() {
+			Expect(xealth.GetOrderRetryBackoff(1, time.Minute, time.Hour)).To(Equal(time.Minute))
+			Expect(xealth.GetOrderRetryBackoff(2, time.Minute, time.Hour)).To(Equal(time.Minute * 2))
+			Expect(xealth.GetOrderRetryBackoff(3, time.Minute, time.Hour)).To(Equal(time.Minute * 4))
+		})
+
+		It("is capped at the max backoff", func() {
+			Expect(xealth.GetOrderRetryBackoff(20, time.Minute, time.Hour)).To(Equal(time.Hour))
+		})
+	})
+})
@@ -0,0 +1,65 @@
+package xealth
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+
+	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/ehr"
+	"github.com/tidepool-org/clinic/patients"
+)
+
+var ProviderModule = fx.Provide(ehr.AsProvider(NewProvider))
+
+// Provider exposes the xealth integration as an ehr.Provider
+type Provider struct {
+	clinics  clinics.Service
+	patients patients.Service
+}
+
+var _ ehr.Provider = &Provider{}
+
+func NewProvider(clinics clinics.Service, patients patients.Service) *Provider {
+	return &Provider{
+		clinics:  clinics,
+		patients: patients,
+	}
+}
+
+func (p *Provider) Name() string {
+	return clinics.EHRProviderXealth
+}
+
+func (p *Provider) SubscriptionName() string {
+	return patients.SubscriptionXealthReports
+}
+
+// MatchClinic returns the clinic with the given xealth deployment
+func (p *Provider) MatchClinic(ctx context.Context, deployment string) (*clinics.Clinic, error) {
+	return ehr.FindClinic(ctx, p.clinics, clinics.EHRProviderXealth, deployment)
+}
+
+func (p *Provider) MatchPatients(ctx context.Context, clinic clinics.Clinic, criteria ehr.PatientCriteria, strategies []string) ([]*patients.Patient, error) {
+	return ehr.FindMatchingPatients(ctx, p.patients, clinic.Id.Hex(), criteria, strategies)
+}
+
+// OrderCreated enables the reports subscription of the patient
+func (p *Provider) OrderCreated(ctx context.Context, clinic clinics.Clinic, patient patients.Patient, order ehr.OrderRef) error {
+	return p.patients.UpdateEHRSubscription(ctx, clinic.Id.Hex(), *patient.UserId, ehr.NewSubscriptionUpdate(p, order, true))
+}
+
+// OrderCancelled disables the reports subscription of the patient
+func (p *Provider) OrderCancelled(ctx context.Context, clinic clinics.Clinic, patient patients.Patient, order ehr.OrderRef) error {
+	return p.patients.UpdateEHRSubscription(ctx, clinic.Id.Hex(), *patient.UserId, ehr.NewSubscriptionUpdate(p, order, false))
+}
+
+// DeliverReports is not supported, because xealth retrieves reports on demand
+func (p *Provider) DeliverReports(ctx context.Context, clinic clinics.Clinic, patient patients.Patient) error {
+	return ehr.ErrNotSupported
+}
+
+// SyncClinicReports is not supported, because xealth retrieves reports on demand
+func (p *Provider) SyncClinicReports(ctx context.Context, clinicId string) error {
+	return ehr.ErrNotSupported
+}
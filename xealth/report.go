@@ -58,11 +58,13 @@ func GenerateReportUrl(baseUrl string, token string, patient patients.Patient, c
 	query.Add("mrn", *patient.Mrn)
 	query.Add("fullName", *patient.FullName)
 
-	if clinic.Timezone != nil {
-		query.Add("tzName", *clinic.Timezone)
+	// The sites of the patient can override the settings of the clinic
+	settings := clinic.ResolvePatientSettings(patient.Sites)
+	if settings.Timezone != nil {
+		query.Add("tzName", *settings.Timezone)
 	}
-	if clinic.PreferredBgUnits != "" {
-		query.Add("bgUnits", clinic.PreferredBgUnits)
+	if settings.PreferredBgUnits != "" {
+		query.Add("bgUnits", settings.PreferredBgUnits)
 	}
 
 	endDate := getReportEndDate(patient)
@@ -0,0 +1,280 @@
+package xealth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/tidepool-org/clinic/xealth_client"
+)
+
+var ErrCircuitOpen = errors.New("xealth circuit breaker is open")
+
+const unknownDeployment = "unknown"
+
+type RetryConfig struct {
+	// MaxRetries is the maximum number of retries of idempotent requests after the initial attempt
+	MaxRetries int
+	// InitialBackoff is the backoff before the first retry. It's doubled for every subsequent retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the exponential backoff between retries
+	MaxBackoff time.Duration
+	// CircuitBreakerThreshold is the number of consecutive failures after which requests to a deployment are rejected
+	CircuitBreakerThreshold int
+	// CircuitBreakerCooldown is the time requests to a deployment are rejected before a trial request is allowed
+	CircuitBreakerCooldown time.Duration
+}
+
+type tokenSource interface {
+	GetToken(ctx context.Context) (*oauth2.Token, error)
+}
+
+// resilientDoer authenticates requests to xealth, retries failed idempotent requests with jittered exponential
+// backoff and stops calling a deployment for a cooldown period after too many consecutive failures.
+type resilientDoer struct {
+	config  RetryConfig
+	next    xealth_client.HttpRequestDoer
+	tokens  tokenSource
+	metrics *clientMetrics
+	logger  *zap.SugaredLogger
+
+	mu       *sync.Mutex
+	breakers map[string]*circuitBreaker
+	sleep    func(ctx context.Context, d time.Duration) error
+	now      func() time.Time
+}
+
+var _ xealth_client.HttpRequestDoer = &resilientDoer{}
+
+func newResilientDoer(next xealth_client.HttpRequestDoer, tokens tokenSource, config RetryConfig, metrics *clientMetrics, logger *zap.SugaredLogger) *resilientDoer {
+	return &resilientDoer{
+		config:   config,
+		next:     next,
+		tokens:   tokens,
+		metrics:  metrics,
+		logger:   logger,
+		mu:       &sync.Mutex{},
+		breakers: make(map[string]*circuitBreaker),
+		sleep:    sleepWithContext,
+		now:      time.Now,
+	}
+}
+
+func (r *resilientDoer) Do(req *http.Request) (*http.Response, error) {
+	deployment := getDeploymentFromPath(req.URL.Path)
+	breaker := r.getCircuitBreaker(deployment)
+	logger := r.logger.With("deployment", deployment, "method", req.Method, "url", req.URL)
+
+	attempts := 1
+	if isIdempotent(req.Method) && r.config.MaxRetries > 0 {
+		attempts += r.config.MaxRetries
+	}
+
+	var res *http.Response
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			r.metrics.retries.WithLabelValues(deployment).Inc()
+			if err := r.sleep(req.Context(), r.backoff(attempt)); err != nil {
+				return nil, err
+			}
+		}
+
+		// The token endpoint is shared by all deployments, so its failures don't count towards the circuit breaker
+		var token *oauth2.Token
+		if token, err = r.getToken(req.Context()); err != nil {
+			res = nil
+			logger.Warnw("unable to obtain xealth token", "attempt", attempt+1, "attempts", attempts, "error", err)
+			continue
+		}
+
+		if !breaker.Allow(r.now()) {
+			r.metrics.requests.WithLabelValues(deployment, req.Method, metricsResultCircuitOpen).Inc()
+			return nil, fmt.Errorf("%w for deployment %s", ErrCircuitOpen, deployment)
+		}
+
+		start := r.now()
+		res, err = r.do(req, token)
+		r.metrics.requestDuration.WithLabelValues(deployment, req.Method).Observe(r.now().Sub(start).Seconds())
+
+		if err == nil && !isRetryableStatus(res.StatusCode) {
+			breaker.RecordSuccess()
+			r.metrics.requests.WithLabelValues(deployment, req.Method, metricsResultSuccess).Inc()
+			return res, nil
+		}
+
+		r.metrics.requests.WithLabelValues(deployment, req.Method, metricsResultFailure).Inc()
+		if breaker.RecordFailure(r.now()) {
+			r.metrics.circuitBreakerOpened.WithLabelValues(deployment).Inc()
+			logger.Warnw("opening xealth circuit breaker", "cooldown", r.config.CircuitBreakerCooldown)
+		}
+
+		if err != nil {
+			logger.Warnw("xealth request failed", "attempt", attempt+1, "attempts", attempts, "error", err)
+		} else {
+			logger.Warnw("unexpected xealth response status", "attempt", attempt+1, "attempts", attempts, "status", res.StatusCode)
+			if attempt < attempts-1 {
+				// The response is discarded, because the request will be retried
+				_, _ = io.Copy(io.Discard, res.Body)
+				_ = res.Body.Close()
+			}
+		}
+	}
+
+	return res, err
+}
+
+func (r *resilientDoer) getToken(ctx context.Context) (*oauth2.Token, error) {
+	token, err := r.tokens.GetToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to obtain xealth token: %w", err)
+	} else if token == nil {
+		return nil, fmt.Errorf("unable to obtain xealth token")
+	}
+
+	return token, nil
+}
+
+func (r *resilientDoer) do(req *http.Request, token *oauth2.Token) (*http.Response, error) {
+	// Clone the request, because it may be sent multiple times
+	attempt := req.Clone(req.Context())
+	attempt.Header.Set("Authorization", fmt.Sprintf("Bearer %v", token.AccessToken))
+	return r.next.Do(attempt)
+}
+
+func (r *resilientDoer) backoff(attempt int) time.Duration {
+	backoff := r.config.InitialBackoff
+	for i := 1; i < attempt && backoff < r.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if r.config.MaxBackoff > 0 && backoff > r.config.MaxBackoff {
+		backoff = r.config.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	// Full jitter
+	return time.Duration(rand.Int64N(int64(backoff) + 1))
+}
+
+func (r *resilientDoer) getCircuitBreaker(deployment string) *circuitBreaker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	breaker, ok := r.breakers[deployment]
+	if !ok {
+		breaker = newCircuitBreaker(r.config.CircuitBreakerThreshold, r.config.CircuitBreakerCooldown)
+		r.breakers[deployment] = breaker
+	}
+
+	return breaker
+}
+
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu                  *sync.Mutex
+	consecutiveFailures int
+	openUntil           time.Time
+	trialInFlight       bool
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		mu:        &sync.Mutex{},
+	}
+}
+
+// Allow returns true if a request can be sent. After the cooldown period only a single trial request is allowed
+// until its outcome is recorded.
+func (c *circuitBreaker) Allow(now time.Time) bool {
+	if c.threshold <= 0 {
+		return true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.consecutiveFailures < c.threshold {
+		return true
+	}
+	if now.Before(c.openUntil) || c.trialInFlight {
+		return false
+	}
+
+	c.trialInFlight = true
+	return true
+}
+
+func (c *circuitBreaker) RecordSuccess() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.consecutiveFailures = 0
+	c.trialInFlight = false
+	c.openUntil = time.Time{}
+}
+
+// RecordFailure records a failed request and returns true if the breaker transitioned to the open state
+func (c *circuitBreaker) RecordFailure(now time.Time) bool {
+	if c.threshold <= 0 {
+		return false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	wasTrial := c.trialInFlight
+	c.trialInFlight = false
+	c.consecutiveFailures++
+	if c.consecutiveFailures == c.threshold || wasTrial {
+		c.openUntil = now.Add(c.cooldown)
+		return true
+	}
+
+	return false
+}
+
+func isIdempotent(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// getDeploymentFromPath extracts the deployment from partner api paths (e.g. /partner/read/order/{deployment}/{orderId})
+func getDeploymentFromPath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if segment == "partner" && i+3 < len(segments) {
+			return segments[i+3]
+		}
+	}
+	return unknownDeployment
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
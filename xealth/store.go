@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/store"
 	"github.com/tidepool-org/clinic/xealth_client"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -24,15 +26,56 @@ type Store interface {
 	CreatePreorderData(ctx context.Context, data PreorderFormData) error
 	CreateOrder(ctx context.Context, order OrderEvent) (*OrderEvent, error)
 	GetOrder(ctx context.Context, documentId string) (*OrderEvent, error)
+	ListOrders(ctx context.Context, filter OrderFilter, pagination store.Pagination) ([]OrderEvent, error)
+	UpdateOrderData(ctx context.Context, documentId string, data xealth_client.ReadOrderResponse) error
+	UpdateOrderStatus(ctx context.Context, documentId string, update OrderStatusUpdate) error
+	ClaimOrderForRetry(ctx context.Context, lease time.Duration, maxAttempts int) (*OrderEvent, error)
 	GetReportView(ctx context.Context, documentId string) (*ReportView, error)
 	GetMostRecentReportView(ctx context.Context, filter ReportViewFilter) (*ReportView, error)
 	CreateReportView(ctx context.Context, view ReportView) (*ReportView, error)
+	ListReportViews(ctx context.Context, filter ReportViewHistoryFilter, pagination store.Pagination) (*ReportViewList, error)
+	GetReportViewStats(ctx context.Context, filter ReportViewHistoryFilter, interval string) (*ReportViewStats, error)
+	ListPatientsNotViewedSinceLastUpload(ctx context.Context, filter PatientsNotViewedFilter, pagination store.Pagination) (*PatientReportViewStatusList, error)
 }
 
+const (
+	OrderStatusPending   = "pending"
+	OrderStatusProcessed = "processed"
+	OrderStatusFailed    = "failed"
+)
+
 type OrderEvent struct {
 	Id                *primitive.ObjectID             `bson:"_id,omitempty"`
+	ClinicId          *primitive.ObjectID             `bson:"clinicId,omitempty"`
 	EventNotification xealth_client.EventNotification `bson:"eventNotification"`
 	OrderData         xealth_client.ReadOrderResponse `bson:"orderData"`
+
+	// Status is the processing status of the order. Orders created before the status was introduced don't have one.
+	Status          string     `bson:"status,omitempty"`
+	Attempts        int        `bson:"attempts"`
+	LastError       *string    `bson:"lastError,omitempty"`
+	NextAttemptTime *time.Time `bson:"nextAttemptTime,omitempty"`
+	CreatedTime     time.Time  `bson:"createdTime,omitempty"`
+	ModifiedTime    time.Time  `bson:"modifiedTime,omitempty"`
+}
+
+// HasOrderData returns true if the order details were retrieved from xealth
+func (o OrderEvent) HasOrderData() bool {
+	return o.OrderData.OrderInfo.OrderId != ""
+}
+
+type OrderFilter struct {
+	ClinicId *primitive.ObjectID
+	Status   *string
+}
+
+type OrderStatusUpdate struct {
+	Status string
+	// Error is the error which caused the processing to fail
+	Error error
+	// NextAttemptTime is the time after which a failed order will be retried. Failed orders without next attempt time
+	// are not retried automatically.
+	NextAttemptTime *time.Time
 }
 
 type ReportView struct {
@@ -58,6 +101,7 @@ type defaultStore struct {
 	orders       *mongo.Collection
 	preorderData *mongo.Collection
 	reportViews  *mongo.Collection
+	patients     *mongo.Collection
 	logger       *zap.SugaredLogger
 }
 
@@ -66,6 +110,7 @@ func NewStore(db *mongo.Database, logger *zap.SugaredLogger, lifecycle fx.Lifecy
 		orders:       db.Collection(ordersCollection),
 		preorderData: db.Collection(preorderDataCollection),
 		reportViews:  db.Collection(reportViewCollection),
+		patients:     db.Collection(patients.CollectionName),
 		logger:       logger,
 	}
 
@@ -93,6 +138,30 @@ func (d *defaultStore) Initialize(ctx context.Context) error {
 		return err
 	}
 
+	_, err = d.orders.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "nextAttemptTime", Value: 1},
+			},
+			Options: options.Index().
+				SetName("OrderRetry").
+				SetPartialFilterExpression(bson.M{"status": bson.M{"$exists": true}}),
+		},
+		{
+			Keys: bson.D{
+				{Key: "clinicId", Value: 1},
+				{Key: "status", Value: 1},
+				{Key: "createdTime", Value: -1},
+			},
+			Options: options.Index().
+				SetName("ClinicOrders"),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
 	_, err = d.reportViews.Indexes().CreateMany(ctx, []mongo.IndexModel{
 		{
 			Keys: bson.D{
@@ -106,6 +175,23 @@ func (d *defaultStore) Initialize(ctx context.Context) error {
 			Options: options.Index().
 				SetName("LastReportView"),
 		},
+		{
+			Keys: bson.D{
+				{Key: "clinicId", Value: 1},
+				{Key: "patientUserId", Value: 1},
+				{Key: "createdTime", Value: -1},
+			},
+			Options: options.Index().
+				SetName("PatientReportViews"),
+		},
+		{
+			Keys: bson.D{
+				{Key: "clinicId", Value: 1},
+				{Key: "createdTime", Value: -1},
+			},
+			Options: options.Index().
+				SetName("ClinicReportViews"),
+		},
 	})
 
 	return err
@@ -150,12 +236,19 @@ func (d *defaultStore) CreatePreorderData(ctx context.Context, data PreorderForm
 
 func (d *defaultStore) CreateOrder(ctx context.Context, order OrderEvent) (*OrderEvent, error) {
 	logger := d.logger.With(
-		"deployment", order.OrderData.OrderInfo.Deployment,
-		"orderId", order.OrderData.OrderInfo.OrderId,
+		"deployment", order.EventNotification.Deployment,
+		"orderId", order.EventNotification.OrderId,
 	)
 
 	logger.Debug("inserting xealth order event")
 
+	now := time.Now()
+	if order.Status == "" {
+		order.Status = OrderStatusPending
+	}
+	order.CreatedTime = now
+	order.ModifiedTime = now
+
 	res, err := d.orders.InsertOne(ctx, order)
 	if err != nil {
 		return nil, err
@@ -183,6 +276,123 @@ func (d *defaultStore) GetOrder(ctx context.Context, id string) (*OrderEvent, er
 	return orderEvent, nil
 }
 
+func (d *defaultStore) ListOrders(ctx context.Context, filter OrderFilter, pagination store.Pagination) ([]OrderEvent, error) {
+	selector := bson.M{}
+	if filter.ClinicId != nil {
+		selector["clinicId"] = *filter.ClinicId
+	}
+	if filter.Status != nil {
+		selector["status"] = *filter.Status
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "createdTime", Value: -1}}).
+		SetSkip(int64(pagination.Offset)).
+		SetLimit(int64(pagination.Limit))
+
+	cur, err := d.orders.Find(ctx, selector, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []OrderEvent
+	if err := cur.All(ctx, &orders); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+func (d *defaultStore) UpdateOrderData(ctx context.Context, documentId string, data xealth_client.ReadOrderResponse) error {
+	objId, err := primitive.ObjectIDFromHex(documentId)
+	if err != nil {
+		return errs.NotFound
+	}
+
+	res, err := d.orders.UpdateOne(ctx, bson.M{"_id": objId}, bson.M{
+		"$set": bson.M{
+			"orderData":    data,
+			"modifiedTime": time.Now(),
+		},
+	})
+	if err != nil {
+		return err
+	} else if res.MatchedCount == 0 {
+		return errs.NotFound
+	}
+
+	return nil
+}
+
+func (d *defaultStore) UpdateOrderStatus(ctx context.Context, documentId string, update OrderStatusUpdate) error {
+	objId, err := primitive.ObjectIDFromHex(documentId)
+	if err != nil {
+		return errs.NotFound
+	}
+
+	set := bson.M{
+		"status":       update.Status,
+		"modifiedTime": time.Now(),
+	}
+	unset := bson.M{}
+	if update.Error != nil {
+		set["lastError"] = update.Error.Error()
+	} else {
+		unset["lastError"] = ""
+	}
+	if update.NextAttemptTime != nil {
+		set["nextAttemptTime"] = *update.NextAttemptTime
+	} else {
+		unset["nextAttemptTime"] = ""
+	}
+	upd := bson.M{"$set": set}
+	if len(unset) > 0 {
+		upd["$unset"] = unset
+	}
+
+	res, err := d.orders.UpdateOne(ctx, bson.M{"_id": objId}, upd)
+	if err != nil {
+		return err
+	} else if res.MatchedCount == 0 {
+		return errs.NotFound
+	}
+
+	return nil
+}
+
+// ClaimOrderForRetry atomically finds a pending or failed order which is due for a retry, increments the number of attempts
+// and postpones the next attempt by the duration of the lease, so the same order isn't picked up by another worker
+func (d *defaultStore) ClaimOrderForRetry(ctx context.Context, lease time.Duration, maxAttempts int) (*OrderEvent, error) {
+	now := time.Now()
+	selector := bson.M{
+		"status":          bson.M{"$in": []string{OrderStatusPending, OrderStatusFailed}},
+		"nextAttemptTime": bson.M{"$lte": now},
+		"attempts":        bson.M{"$lt": maxAttempts},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"nextAttemptTime": now.Add(lease),
+			"modifiedTime":    now,
+		},
+		"$inc": bson.M{
+			"attempts": 1,
+		},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.M{"nextAttemptTime": 1}).
+		SetReturnDocument(options.After)
+
+	order := &OrderEvent{}
+	err := d.orders.FindOneAndUpdate(ctx, selector, update, opts).Decode(order)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, errs.NotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
 func (d *defaultStore) GetReportView(ctx context.Context, documentId string) (*ReportView, error) {
 	objId, err := primitive.ObjectIDFromHex(documentId)
 	if err != nil {
@@ -247,3 +457,308 @@ func (d *defaultStore) CreateReportView(ctx context.Context, view ReportView) (*
 
 	return d.GetReportView(ctx, res.InsertedID.(primitive.ObjectID).Hex())
 }
+
+func (d *defaultStore) ListReportViews(ctx context.Context, filter ReportViewHistoryFilter, pagination store.Pagination) (*ReportViewList, error) {
+	pipeline, err := reportViewHistoryStages(filter)
+	if err != nil {
+		return nil, err
+	}
+	pipeline = append(pipeline, bson.M{"$facet": bson.M{
+		"data": bson.A{
+			bson.M{"$sort": bson.M{"createdTime": -1}},
+			bson.M{"$skip": pagination.Offset},
+			bson.M{"$limit": pagination.Limit},
+		},
+		"meta": bson.A{
+			bson.M{"$count": "count"},
+		},
+	}})
+
+	cur, err := d.reportViews.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []struct {
+		Data []ReportView `bson:"data"`
+		Meta []struct {
+			Count int `bson:"count"`
+		} `bson:"meta"`
+	}
+	if err := cur.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	list := &ReportViewList{
+		Views: []ReportView{},
+	}
+	if len(results) > 0 {
+		if results[0].Data != nil {
+			list.Views = results[0].Data
+		}
+		if len(results[0].Meta) > 0 {
+			list.TotalCount = results[0].Meta[0].Count
+		}
+	}
+
+	return list, nil
+}
+
+func (d *defaultStore) GetReportViewStats(ctx context.Context, filter ReportViewHistoryFilter, interval string) (*ReportViewStats, error) {
+	counts := bson.M{
+		"views":    bson.M{"$sum": 1},
+		"patients": bson.M{"$addToSet": "$patientUserId"},
+		"viewers":  bson.M{"$addToSet": "$userId"},
+	}
+	withId := func(id interface{}, fields bson.M) bson.M {
+		group := bson.M{"_id": id}
+		for k, v := range fields {
+			group[k] = v
+		}
+		return group
+	}
+
+	pipeline, err := reportViewHistoryStages(filter)
+	if err != nil {
+		return nil, err
+	}
+	pipeline = append(pipeline,
+		bson.M{"$facet": bson.M{
+			"totals": bson.A{
+				bson.M{"$group": withId(nil, counts)},
+				bson.M{"$project": bson.M{
+					"views":          1,
+					"uniquePatients": bson.M{"$size": "$patients"},
+					"uniqueViewers":  bson.M{"$size": "$viewers"},
+				}},
+			},
+			"periods": bson.A{
+				bson.M{"$group": withId(bson.M{"$dateTrunc": bson.M{"date": "$createdTime", "unit": interval}}, counts)},
+				bson.M{"$sort": bson.M{"_id": 1}},
+				bson.M{"$project": bson.M{
+					"_id":            0,
+					"startTime":      "$_id",
+					"views":          1,
+					"uniquePatients": bson.M{"$size": "$patients"},
+					"uniqueViewers":  bson.M{"$size": "$viewers"},
+				}},
+			},
+			"viewers": bson.A{
+				bson.M{"$sort": bson.M{"createdTime": -1}},
+				bson.M{"$group": bson.M{
+					"_id":            "$userId",
+					"views":          bson.M{"$sum": 1},
+					"patients":       bson.M{"$addToSet": "$patientUserId"},
+					"systemLogin":    bson.M{"$first": "$systemLogin"},
+					"lastViewedTime": bson.M{"$first": "$createdTime"},
+				}},
+				bson.M{"$sort": bson.D{{Key: "views", Value: -1}, {Key: "_id", Value: 1}}},
+				bson.M{"$project": bson.M{
+					"_id":            0,
+					"userId":         "$_id",
+					"systemLogin":    1,
+					"views":          1,
+					"uniquePatients": bson.M{"$size": "$patients"},
+					"lastViewedTime": 1,
+				}},
+			},
+		}},
+	)
+
+	cur, err := d.reportViews.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []struct {
+		Totals  []ReportViewStats       `bson:"totals"`
+		Periods []ReportViewPeriodStats `bson:"periods"`
+		Viewers []ReportViewerStats     `bson:"viewers"`
+	}
+	if err := cur.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	stats := &ReportViewStats{
+		Periods: []ReportViewPeriodStats{},
+		Viewers: []ReportViewerStats{},
+	}
+	if len(results) > 0 {
+		if len(results[0].Totals) > 0 {
+			stats.Views = results[0].Totals[0].Views
+			stats.UniquePatients = results[0].Totals[0].UniquePatients
+			stats.UniqueViewers = results[0].Totals[0].UniqueViewers
+		}
+		if results[0].Periods != nil {
+			stats.Periods = results[0].Periods
+		}
+		if results[0].Viewers != nil {
+			stats.Viewers = results[0].Viewers
+		}
+	}
+
+	return stats, nil
+}
+
+// ListPatientsNotViewedSinceLastUpload joins the subscribed patients with their most recent report view and returns
+// the ones whose last upload is more recent than the view
+func (d *defaultStore) ListPatientsNotViewedSinceLastUpload(ctx context.Context, filter PatientsNotViewedFilter, pagination store.Pagination) (*PatientReportViewStatusList, error) {
+	selector := bson.M{
+		"clinicId": filter.ClinicId,
+		"userId":   bson.M{"$exists": true},
+		"ehrSubscriptions." + patients.SubscriptionXealthReports + ".active": true,
+	}
+	if filter.Sites != nil {
+		siteIds, err := parseSiteIds(*filter.Sites)
+		if err != nil {
+			return nil, err
+		}
+		selector["sites.id"] = bson.M{"$in": siteIds}
+	}
+
+	pipeline := []bson.M{
+		{"$match": selector},
+		{"$addFields": bson.M{
+			"lastUploadTime": bson.M{"$max": bson.A{
+				"$summary.cgmStats.dates.lastUploadDate",
+				"$summary.bgmStats.dates.lastUploadDate",
+			}},
+		}},
+		{"$match": bson.M{
+			"lastUploadTime": bson.M{"$type": "date"},
+		}},
+		{"$lookup": bson.M{
+			"from": reportViewCollection,
+			"let":  bson.M{"patientUserId": "$userId"},
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{
+					"clinicId": filter.ClinicId,
+					"$expr":    bson.M{"$eq": bson.A{"$patientUserId", "$$patientUserId"}},
+				}},
+				bson.M{"$sort": bson.M{"createdTime": -1}},
+				bson.M{"$limit": 1},
+				bson.M{"$project": bson.M{"_id": 0, "createdTime": 1}},
+			},
+			"as": "lastReportView",
+		}},
+		{"$addFields": bson.M{
+			"lastViewedTime": bson.M{"$arrayElemAt": bson.A{"$lastReportView.createdTime", 0}},
+		}},
+		{"$match": bson.M{
+			"$expr": bson.M{"$or": bson.A{
+				bson.M{"$eq": bson.A{bson.M{"$type": "$lastViewedTime"}, "missing"}},
+				bson.M{"$lt": bson.A{"$lastViewedTime", "$lastUploadTime"}},
+			}},
+		}},
+		{"$unset": "lastReportView"},
+		{"$facet": bson.M{
+			"data": bson.A{
+				bson.M{"$sort": bson.D{{Key: "lastUploadTime", Value: -1}, {Key: "_id", Value: 1}}},
+				bson.M{"$skip": pagination.Offset},
+				bson.M{"$limit": pagination.Limit},
+			},
+			"meta": bson.A{
+				bson.M{"$count": "count"},
+			},
+		}},
+	}
+
+	cur, err := d.patients.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []struct {
+		Data []PatientReportViewStatus `bson:"data"`
+		Meta []struct {
+			Count int `bson:"count"`
+		} `bson:"meta"`
+	}
+	if err := cur.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	list := &PatientReportViewStatusList{
+		Patients: []PatientReportViewStatus{},
+	}
+	if len(results) > 0 {
+		if results[0].Data != nil {
+			list.Patients = results[0].Data
+		}
+		if len(results[0].Meta) > 0 {
+			list.TotalCount = results[0].Meta[0].Count
+		}
+	}
+
+	return list, nil
+}
+
+// reportViewHistoryStages returns the aggregation stages matching the report views of the filter. When the filter is
+// limited to sites, the views of patients which aren't assigned to any of the sites are excluded.
+func reportViewHistoryStages(filter ReportViewHistoryFilter) ([]bson.M, error) {
+	stages := []bson.M{
+		{"$match": reportViewHistorySelector(filter)},
+	}
+	if filter.Sites == nil {
+		return stages, nil
+	}
+
+	siteIds, err := parseSiteIds(*filter.Sites)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(stages,
+		bson.M{"$lookup": bson.M{
+			"from": patients.CollectionName,
+			"let":  bson.M{"patientUserId": "$patientUserId"},
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{
+					"clinicId": filter.ClinicId,
+					"sites.id": bson.M{"$in": siteIds},
+					"$expr":    bson.M{"$eq": bson.A{"$userId", "$$patientUserId"}},
+				}},
+				bson.M{"$limit": 1},
+				bson.M{"$project": bson.M{"_id": 1}},
+			},
+			"as": "sitePatients",
+		}},
+		bson.M{"$match": bson.M{"sitePatients": bson.M{"$ne": bson.A{}}}},
+		bson.M{"$unset": "sitePatients"},
+	), nil
+}
+
+func parseSiteIds(sites []string) ([]primitive.ObjectID, error) {
+	siteIds := make([]primitive.ObjectID, 0, len(sites))
+	for _, site := range sites {
+		siteId, err := primitive.ObjectIDFromHex(site)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid site id", errs.BadRequest)
+		}
+		siteIds = append(siteIds, siteId)
+	}
+	return siteIds, nil
+}
+
+func reportViewHistorySelector(filter ReportViewHistoryFilter) bson.M {
+	selector := bson.M{
+		"clinicId": filter.ClinicId,
+	}
+	if filter.PatientUserId != nil {
+		selector["patientUserId"] = *filter.PatientUserId
+	}
+	if filter.SystemLogin != nil {
+		selector["systemLogin"] = *filter.SystemLogin
+	}
+	if filter.CreatedTimeStart != nil || filter.CreatedTimeEnd != nil {
+		createdTime := bson.M{}
+		if filter.CreatedTimeStart != nil {
+			createdTime["$gte"] = *filter.CreatedTimeStart
+		}
+		if filter.CreatedTimeEnd != nil {
+			createdTime["$lt"] = *filter.CreatedTimeEnd
+		}
+		selector["createdTime"] = createdTime
+	}
+	return selector
+}
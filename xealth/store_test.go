@@ -0,0 +1,315 @@
+package xealth_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/fx/fxtest"
+	"go.uber.org/zap"
+
+	errs "github.com/tidepool-org/clinic/errors"
+	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/store"
+	dbTest "github.com/tidepool-org/clinic/store/test"
+	"github.com/tidepool-org/clinic/test"
+	"github.com/tidepool-org/clinic/xealth"
+	"github.com/tidepool-org/clinic/xealth_client"
+)
+
+var _ = Describe("Store", func() {
+	var xealthStore xealth.Store
+	var clinicId primitive.ObjectID
+
+	BeforeEach(func() {
+		// Remove orders created by other specs, so they are not claimed for retry
+		_, err := dbTest.GetTestDatabase().Collection("xealth_order").DeleteMany(context.Background(), bson.M{})
+		Expect(err).ToNot(HaveOccurred())
+
+		lifecycle := fxtest.NewLifecycle(GinkgoT())
+		xealthStore, err = xealth.NewStore(dbTest.GetTestDatabase(), zap.NewNop().Sugar(), lifecycle)
+		Expect(err).ToNot(HaveOccurred())
+		lifecycle.RequireStart()
+
+		clinicId = primitive.NewObjectID()
+	})
+
+	createOrder := func(status string, nextAttemptTime time.Time) *xealth.OrderEvent {
+		order, err := xealthStore.CreateOrder(context.Background(), xealth.OrderEvent{
+			ClinicId: &clinicId,
+			EventNotification: xealth_client.EventNotification{
+				Deployment:   test.Faker.UUID().V4(),
+				OrderId:      test.Faker.UUID().V4(),
+				EventType:    xealth_client.EventNotificationEventTypeOrder,
+				EventContext: xealth_client.EventNotificationEventContextNew,
+			},
+			Status:          status,
+			Attempts:        1,
+			NextAttemptTime: &nextAttemptTime,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return order
+	}
+
+	Describe("CreateOrder", func() {
+		It("persists the notification without order data", func() {
+			order := createOrder(xealth.OrderStatusPending, time.Now())
+			Expect(order.Status).To(Equal(xealth.OrderStatusPending))
+			Expect(order.HasOrderData()).To(BeFalse())
+			Expect(order.CreatedTime).ToNot(BeZero())
+		})
+	})
+
+	Describe("UpdateOrderStatus", func() {
+		It("records the error and clears it after successful processing", func() {
+			order := createOrder(xealth.OrderStatusPending, time.Now())
+
+			Expect(xealthStore.UpdateOrderStatus(context.Background(), order.Id.Hex(), xealth.OrderStatusUpdate{
+				Status: xealth.OrderStatusFailed,
+				Error:  errs.NotFound,
+			})).To(Succeed())
+
+			updated, err := xealthStore.GetOrder(context.Background(), order.Id.Hex())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.Status).To(Equal(xealth.OrderStatusFailed))
+			Expect(updated.LastError).ToNot(BeNil())
+			Expect(updated.NextAttemptTime).To(BeNil())
+
+			Expect(xealthStore.UpdateOrderStatus(context.Background(), order.Id.Hex(), xealth.OrderStatusUpdate{
+				Status: xealth.OrderStatusProcessed,
+			})).To(Succeed())
+
+			updated, err = xealthStore.GetOrder(context.Background(), order.Id.Hex())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.Status).To(Equal(xealth.OrderStatusProcessed))
+			Expect(updated.LastError).To(BeNil())
+		})
+	})
+
+	Describe("ListOrders", func() {
+		It("returns the orders of the clinic with the given status", func() {
+			failed := createOrder(xealth.OrderStatusFailed, time.Now().Add(time.Hour))
+			createOrder(xealth.OrderStatusProcessed, time.Now().Add(time.Hour))
+
+			status := xealth.OrderStatusFailed
+			orders, err := xealthStore.ListOrders(context.Background(), xealth.OrderFilter{
+				ClinicId: &clinicId,
+				Status:   &status,
+			}, store.DefaultPagination())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(orders).To(HaveLen(1))
+			Expect(orders[0].Id).To(Equal(failed.Id))
+		})
+	})
+
+	Describe("ClaimOrderForRetry", func() {
+		It("claims due orders only once", func() {
+			due := createOrder(xealth.OrderStatusFailed, time.Now().Add(-time.Hour*24*365))
+
+			claimed, err := xealthStore.ClaimOrderForRetry(context.Background(), time.Minute, 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(claimed.Id).To(Equal(due.Id))
+			Expect(claimed.Attempts).To(Equal(2))
+			Expect(*claimed.NextAttemptTime).To(BeTemporally(">", time.Now()))
+
+			_, err = xealthStore.ClaimOrderForRetry(context.Background(), time.Minute, 10)
+			Expect(err).To(MatchError(errs.NotFound))
+		})
+
+		It("doesn't claim orders which exceeded the max number of attempts", func() {
+			createOrder(xealth.OrderStatusFailed, time.Now().Add(-time.Hour*24*365))
+
+			_, err := xealthStore.ClaimOrderForRetry(context.Background(), time.Minute, 1)
+			Expect(err).To(MatchError(errs.NotFound))
+		})
+	})
+
+	Describe("Report Views", func() {
+		var patientUserId string
+		var systemLogin string
+		var viewTime time.Time
+
+		createReportView := func(userId, patientUserId string, systemLogin *string, createdTime time.Time) {
+			_, err := xealthStore.CreateReportView(context.Background(), xealth.ReportView{
+				UserId:        userId,
+				DeploymentId:  "tidepool",
+				SystemLogin:   systemLogin,
+				PatientUserId: patientUserId,
+				ProgramId:     test.Faker.UUID().V4(),
+				ClinicId:      clinicId,
+				CreatedTime:   createdTime,
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			patientUserId = test.Faker.UUID().V4()
+			systemLogin = test.Faker.Internet().Email()
+			viewTime = time.Date(2024, 3, 6, 12, 0, 0, 0, time.UTC)
+
+			createReportView("viewer-1", patientUserId, &systemLogin, viewTime)
+			createReportView("viewer-1", patientUserId, &systemLogin, viewTime.Add(time.Hour))
+			createReportView("viewer-2", test.Faker.UUID().V4(), nil, viewTime.AddDate(0, 0, 7))
+		})
+
+		It("lists the views of the patient", func() {
+			list, err := xealthStore.ListReportViews(context.Background(), xealth.ReportViewHistoryFilter{
+				ClinicId:      clinicId,
+				PatientUserId: &patientUserId,
+			}, store.DefaultPagination().WithLimit(1))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.TotalCount).To(Equal(2))
+			Expect(list.Views).To(HaveLen(1))
+			Expect(list.Views[0].CreatedTime).To(BeTemporally("==", viewTime.Add(time.Hour)))
+		})
+
+		It("lists the views of the system login", func() {
+			list, err := xealthStore.ListReportViews(context.Background(), xealth.ReportViewHistoryFilter{
+				ClinicId:    clinicId,
+				SystemLogin: &systemLogin,
+			}, store.DefaultPagination())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.TotalCount).To(Equal(2))
+		})
+
+		It("limits the views to the patients of the sites", func() {
+			siteId := primitive.NewObjectID()
+			_, err := dbTest.GetTestDatabase().Collection(patients.CollectionName).InsertOne(context.Background(), bson.M{
+				"clinicId": clinicId,
+				"userId":   patientUserId,
+				"sites":    bson.A{bson.M{"id": siteId, "name": "North"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			filter := xealth.ReportViewHistoryFilter{
+				ClinicId: clinicId,
+				Sites:    &[]string{siteId.Hex()},
+			}
+			list, err := xealthStore.ListReportViews(context.Background(), filter, store.DefaultPagination())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.TotalCount).To(Equal(2))
+			Expect(list.Views).To(HaveEach(HaveField("PatientUserId", patientUserId)))
+
+			stats, err := xealthStore.GetReportViewStats(context.Background(), filter, xealth.ReportViewIntervalWeek)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stats.Views).To(Equal(2))
+			Expect(stats.UniquePatients).To(Equal(1))
+		})
+
+		It("aggregates the views by week and viewer", func() {
+			stats, err := xealthStore.GetReportViewStats(context.Background(), xealth.ReportViewHistoryFilter{
+				ClinicId: clinicId,
+			}, xealth.ReportViewIntervalWeek)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stats.Views).To(Equal(3))
+			Expect(stats.UniquePatients).To(Equal(2))
+			Expect(stats.UniqueViewers).To(Equal(2))
+			Expect(stats.Periods).To(HaveLen(2))
+			Expect(stats.Periods[0].Views).To(Equal(2))
+			Expect(stats.Viewers).To(HaveLen(2))
+			Expect(stats.Viewers[0].UserId).To(Equal("viewer-1"))
+			Expect(stats.Viewers[0].SystemLogin).To(PointTo(Equal(systemLogin)))
+			Expect(stats.Viewers[0].LastViewedTime).To(BeTemporally("==", viewTime.Add(time.Hour)))
+		})
+	})
+
+	Describe("ListPatientsNotViewedSinceLastUpload", func() {
+		var viewedUserId, notViewedUserId, viewedBeforeUploadUserId string
+		var uploadTime time.Time
+
+		createPatient := func(userId string, active bool, lastUpload *time.Time) {
+			patient := bson.M{
+				"clinicId": clinicId,
+				"userId":   userId,
+				"ehrSubscriptions": bson.M{
+					patients.SubscriptionXealthReports: bson.M{"active": active},
+				},
+			}
+			if lastUpload != nil {
+				patient["summary"] = bson.M{
+					"cgmStats": bson.M{"dates": bson.M{"lastUploadDate": *lastUpload}},
+				}
+			}
+			_, err := dbTest.GetTestDatabase().Collection(patients.CollectionName).InsertOne(context.Background(), patient)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		createReportView := func(patientUserId string, createdTime time.Time) {
+			_, err := xealthStore.CreateReportView(context.Background(), xealth.ReportView{
+				UserId:        "viewer",
+				DeploymentId:  "tidepool",
+				PatientUserId: patientUserId,
+				ProgramId:     test.Faker.UUID().V4(),
+				ClinicId:      clinicId,
+				CreatedTime:   createdTime,
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			uploadTime = time.Date(2024, 3, 6, 12, 0, 0, 0, time.UTC)
+			viewedUserId = test.Faker.UUID().V4()
+			notViewedUserId = test.Faker.UUID().V4()
+			viewedBeforeUploadUserId = test.Faker.UUID().V4()
+
+			createPatient(viewedUserId, true, &uploadTime)
+			createReportView(viewedUserId, uploadTime.Add(time.Hour))
+
+			notViewedUploadTime := uploadTime.Add(-time.Hour)
+			createPatient(notViewedUserId, true, &notViewedUploadTime)
+
+			createPatient(viewedBeforeUploadUserId, true, &uploadTime)
+			createReportView(viewedBeforeUploadUserId, uploadTime.Add(-time.Hour))
+
+			createPatient(test.Faker.UUID().V4(), false, &uploadTime)
+			createPatient(test.Faker.UUID().V4(), true, nil)
+		})
+
+		It("returns the patients with uploads after the last view, most recent uploads first", func() {
+			list, err := xealthStore.ListPatientsNotViewedSinceLastUpload(context.Background(), xealth.PatientsNotViewedFilter{
+				ClinicId: clinicId,
+			}, store.DefaultPagination())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.TotalCount).To(Equal(2))
+			Expect(list.Patients).To(HaveLen(2))
+			Expect(list.Patients[0].Patient.UserId).To(PointTo(Equal(viewedBeforeUploadUserId)))
+			Expect(list.Patients[0].LastUploadTime).To(BeTemporally("==", uploadTime))
+			Expect(list.Patients[0].LastViewedTime).To(PointTo(BeTemporally("==", uploadTime.Add(-time.Hour))))
+			Expect(list.Patients[1].Patient.UserId).To(PointTo(Equal(notViewedUserId)))
+			Expect(list.Patients[1].LastViewedTime).To(BeNil())
+		})
+
+		It("limits the patients to the sites", func() {
+			siteId := primitive.NewObjectID()
+			_, err := dbTest.GetTestDatabase().Collection(patients.CollectionName).UpdateOne(context.Background(), bson.M{
+				"clinicId": clinicId,
+				"userId":   notViewedUserId,
+			}, bson.M{
+				"$set": bson.M{"sites": bson.A{bson.M{"id": siteId, "name": "North"}}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			list, err := xealthStore.ListPatientsNotViewedSinceLastUpload(context.Background(), xealth.PatientsNotViewedFilter{
+				ClinicId: clinicId,
+				Sites:    &[]string{siteId.Hex()},
+			}, store.DefaultPagination())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.TotalCount).To(Equal(1))
+			Expect(list.Patients[0].Patient.UserId).To(PointTo(Equal(notViewedUserId)))
+		})
+
+		It("paginates the results", func() {
+			list, err := xealthStore.ListPatientsNotViewedSinceLastUpload(context.Background(), xealth.PatientsNotViewedFilter{
+				ClinicId: clinicId,
+			}, store.DefaultPagination().WithOffset(1).WithLimit(1))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.TotalCount).To(Equal(2))
+			Expect(list.Patients).To(HaveLen(1))
+			Expect(list.Patients[0].Patient.UserId).To(PointTo(Equal(notViewedUserId)))
+		})
+	})
+})
@@ -7,8 +7,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/kelseyhightower/envconfig"
 	"github.com/tidepool-org/clinic/clinics"
+	"github.com/tidepool-org/clinic/ehr"
 	errs "github.com/tidepool-org/clinic/errors"
 	"github.com/tidepool-org/clinic/patients"
+	"github.com/tidepool-org/clinic/store"
 	"github.com/tidepool-org/clinic/xealth_client"
 	"github.com/tidepool-org/platform/auth"
 	"github.com/tidepool-org/platform/log"
@@ -40,6 +42,23 @@ type Config struct {
 	TokenUrl               string `envconfig:"TIDEPOOL_XEALTH_TOKEN_URL" default:"https://auth-sandbox.xealth.io/oauth2/token"`
 	ServerBaseUrl          string `envconfig:"TIDEPOOL_XEALTH_SERVER_BASE_URL" default:"https://api-sandbox.xealth.io/v2"`
 	TidepoolApplicationUrl string `envconfig:"TIDEPOOL_APPLICATION_URL" required:"true"`
+
+	MaxRetries              int           `envconfig:"TIDEPOOL_XEALTH_CLIENT_MAX_RETRIES" default:"3"`
+	InitialBackoff          time.Duration `envconfig:"TIDEPOOL_XEALTH_CLIENT_INITIAL_BACKOFF" default:"250ms"`
+	MaxBackoff              time.Duration `envconfig:"TIDEPOOL_XEALTH_CLIENT_MAX_BACKOFF" default:"5s"`
+	CircuitBreakerThreshold int           `envconfig:"TIDEPOOL_XEALTH_CLIENT_CIRCUIT_BREAKER_THRESHOLD" default:"10"`
+	CircuitBreakerCooldown  time.Duration `envconfig:"TIDEPOOL_XEALTH_CLIENT_CIRCUIT_BREAKER_COOLDOWN" default:"30s"`
+
+	OrderRetryInterval       time.Duration `envconfig:"TIDEPOOL_XEALTH_ORDER_RETRY_INTERVAL" default:"30s"`
+	OrderRetryInitialBackoff time.Duration `envconfig:"TIDEPOOL_XEALTH_ORDER_RETRY_INITIAL_BACKOFF" default:"1m"`
+	OrderRetryMaxBackoff     time.Duration `envconfig:"TIDEPOOL_XEALTH_ORDER_RETRY_MAX_BACKOFF" default:"1h"`
+	OrderRetryMaxAttempts    int           `envconfig:"TIDEPOOL_XEALTH_ORDER_RETRY_MAX_ATTEMPTS" default:"10"`
+	OrderRetryLease          time.Duration `envconfig:"TIDEPOOL_XEALTH_ORDER_RETRY_LEASE" default:"5m"`
+
+	AgeTransitionInterval time.Duration  `envconfig:"TIDEPOOL_XEALTH_AGE_TRANSITION_INTERVAL" default:"24h"`
+	GuardianFlowMaxAge    int            `envconfig:"TIDEPOOL_XEALTH_GUARDIAN_FLOW_MAX_AGE" default:"13"`
+	AgeOfMajority         int            `envconfig:"TIDEPOOL_XEALTH_AGE_OF_MAJORITY" default:"18"`
+	AgeOfMajorityByState  map[string]int `envconfig:"TIDEPOOL_XEALTH_AGE_OF_MAJORITY_BY_STATE" default:"AL:19,MS:21,NE:19"`
 }
 
 type Xealth interface {
@@ -47,9 +66,13 @@ type Xealth interface {
 	ProcessInitialPreorderRequest(ctx context.Context, request xealth_client.PreorderFormRequest0) (*xealth_client.PreorderFormResponse, error)
 	ProcessSubsequentPreorderRequest(ctx context.Context, request xealth_client.PreorderFormRequest1) (*xealth_client.PreorderFormResponse, error)
 	HandleEventNotification(ctx context.Context, event xealth_client.EventNotification) error
+	ProcessOrder(ctx context.Context, documentId string) error
 	GetPrograms(ctx context.Context, request xealth_client.GetProgramsRequest) (*xealth_client.GetProgramsResponse, error)
 	GetProgramUrl(ctx context.Context, request xealth_client.GetProgramUrlRequest) (*xealth_client.GetProgramUrlResponse, error)
 	GetPDFReport(ctx context.Context, request PDFReportRequest) (*PDFReport, error)
+	ListReportViews(ctx context.Context, filter ReportViewHistoryFilter, pagination store.Pagination) (*ReportViewList, error)
+	GetReportViewStats(ctx context.Context, filter ReportViewHistoryFilter, interval string) (*ReportViewStats, error)
+	ListPatientsNotViewedSinceLastUpload(ctx context.Context, filter PatientsNotViewedFilter, pagination store.Pagination) (*PatientReportViewStatusList, error)
 }
 
 type defaultHandler struct {
@@ -60,13 +83,14 @@ type defaultHandler struct {
 	clinics    clinics.Service
 	logger     *zap.SugaredLogger
 	patients   patients.Service
+	provider   ehr.Provider
 	store      Store
 	users      patients.UserService
 }
 
 var _ Xealth = &defaultHandler{}
 
-func NewHandler(authClient auth.Client, clinics clinics.Service, patients patients.Service, users patients.UserService, store Store, logger *zap.SugaredLogger) (Xealth, error) {
+func NewHandler(authClient auth.Client, clinicsService clinics.Service, patients patients.Service, users patients.UserService, providers *ehr.Registry, store Store, logger *zap.SugaredLogger) (Xealth, error) {
 	cfg := ModuleConfig{}
 	if err := envconfig.Process("", &cfg); err != nil {
 		return nil, err
@@ -86,12 +110,18 @@ func NewHandler(authClient auth.Client, clinics clinics.Service, patients patien
 		return nil, err
 	}
 
+	provider, err := providers.Get(clinics.EHRProviderXealth)
+	if err != nil {
+		return nil, err
+	}
+
 	return &defaultHandler{
 		authClient: authClient,
 		config:     clientConfig,
 		client:     client,
-		clinics:    clinics,
+		clinics:    clinicsService,
 		patients:   patients,
+		provider:   provider,
 		users:      users,
 		store:      store,
 		logger:     logger,
@@ -111,7 +141,7 @@ func (d *defaultHandler) AuthorizeRequest(req *http.Request) error {
 }
 
 func (d *defaultHandler) ProcessInitialPreorderRequest(ctx context.Context, request xealth_client.PreorderFormRequest0) (*xealth_client.PreorderFormResponse, error) {
-	match, err := NewMatcher[*xealth_client.PreorderFormResponse](d.clinics, d.patients, d.logger).
+	match, err := NewMatcher[*xealth_client.PreorderFormResponse](d.provider, d.logger).
 		FromInitialPreorderForRequest(request).
 		DisableErrorOnNoMatchingPatients().
 		Match(ctx)
@@ -120,7 +150,7 @@ func (d *defaultHandler) ProcessInitialPreorderRequest(ctx context.Context, requ
 	}
 
 	dataTrackingId := uuid.NewString()
-	if match.Criteria.IsPatientUnder13() {
+	if match.Criteria.IsPatientUnderAge(d.config.GuardianFlowMaxAge, time.Now()) {
 		return NewGuardianFlowResponseBuilder().
 			WithMatchingResult(match).
 			WithDataTrackingId(dataTrackingId).
@@ -142,7 +172,7 @@ func (d *defaultHandler) ProcessInitialPreorderRequest(ctx context.Context, requ
 }
 
 func (d *defaultHandler) ProcessSubsequentPreorderRequest(ctx context.Context, request xealth_client.PreorderFormRequest1) (*xealth_client.PreorderFormResponse, error) {
-	match, err := NewMatcher[*xealth_client.PreorderFormResponse](d.clinics, d.patients, d.logger).
+	match, err := NewMatcher[*xealth_client.PreorderFormResponse](d.provider, d.logger).
 		FromSubsequentPreorderForRequest(request).
 		DisableErrorOnNoMatchingPatients().
 		Match(ctx)
@@ -150,7 +180,7 @@ func (d *defaultHandler) ProcessSubsequentPreorderRequest(ctx context.Context, r
 		return nil, fmt.Errorf("matching subsequent preorder request: %w", err)
 	}
 
-	if match.Criteria.IsPatientUnder13() {
+	if match.Criteria.IsPatientUnderAge(d.config.GuardianFlowMaxAge, time.Now()) {
 		return NewGuardianFlowResponseBuilder().
 			WithMatchingResult(match).
 			WithDataTrackingId(request.FormData.DataTrackingId).
@@ -180,7 +210,7 @@ func (d *defaultHandler) HandleEventNotification(ctx context.Context, event xeal
 		return nil
 	}
 
-	match, err := NewMatcher[*xealth_client.EventNotificationResponse](d.clinics, d.patients, d.logger).
+	match, err := NewMatcher[*xealth_client.EventNotificationResponse](d.provider, d.logger).
 		FromEventNotification(event).
 		DisableErrorOnNoMatchingClinics().
 		DisableErrorOnNoMatchingPatients().
@@ -199,31 +229,97 @@ func (d *defaultHandler) HandleEventNotification(ctx context.Context, event xeal
 		return nil
 	}
 
-	// Retrieve the full order details from Xealth
-	data, err := d.GetXealthOrder(ctx, event.Deployment, event.OrderId)
+	// Persist the notification before retrieving the order details from Xealth, so the order can
+	// be retried if the processing fails. The lease prevents the retry worker from picking up the order
+	// while it's being processed.
+	nextAttemptTime := time.Now().Add(d.config.OrderRetryLease)
+	order, err := d.store.CreateOrder(ctx, OrderEvent{
+		ClinicId:          match.Clinic.Id,
+		EventNotification: event,
+		Status:            OrderStatusPending,
+		Attempts:          1,
+		NextAttemptTime:   &nextAttemptTime,
+	})
 	if err != nil {
 		return err
 	}
 
-	// Save the order in the database
-	order, err := d.store.CreateOrder(ctx, OrderEvent{
-		EventNotification: event,
-		OrderData:         *data,
-	})
+	// The notification is acknowledged even if the processing fails, because the order will be retried
+	if err := d.processOrder(ctx, *order); err != nil {
+		d.logger.Errorw("unable to process xealth order", "error", err, "clinicId", match.Clinic.Id.Hex(), "orderId", event.OrderId, "documentId", order.Id.Hex())
+	}
+
+	return nil
+}
+
+func (d *defaultHandler) ProcessOrder(ctx context.Context, documentId string) error {
+	order, err := d.store.GetOrder(ctx, documentId)
 	if err != nil {
 		return err
 	}
+	if order.Status == OrderStatusProcessed {
+		return fmt.Errorf("%w: order %s was already processed", errs.BadRequest, documentId)
+	}
+
+	return d.processOrder(ctx, *order)
+}
+
+func (d *defaultHandler) processOrder(ctx context.Context, order OrderEvent) error {
+	err := d.retrieveAndHandleOrder(ctx, order)
+	update := OrderStatusUpdate{
+		Status: OrderStatusProcessed,
+	}
+	if err != nil {
+		update.Status = OrderStatusFailed
+		update.Error = err
+		if order.Attempts < d.config.OrderRetryMaxAttempts {
+			nextAttemptTime := time.Now().Add(GetOrderRetryBackoff(order.Attempts, d.config.OrderRetryInitialBackoff, d.config.OrderRetryMaxBackoff))
+			update.NextAttemptTime = &nextAttemptTime
+		}
+	}
+
+	if e := d.store.UpdateOrderStatus(ctx, order.Id.Hex(), update); e != nil {
+		d.logger.Errorw("unable to update xealth order status", "error", e, "documentId", order.Id.Hex(), "status", update.Status)
+	}
+
+	return err
+}
+
+func (d *defaultHandler) retrieveAndHandleOrder(ctx context.Context, order OrderEvent) error {
+	if !order.HasOrderData() {
+		// Retrieve the full order details from Xealth
+		data, err := d.GetXealthOrder(ctx, order.EventNotification.Deployment, order.EventNotification.OrderId)
+		if err != nil {
+			return err
+		}
+
+		if err := d.store.UpdateOrderData(ctx, order.Id.Hex(), *data); err != nil {
+			return err
+		}
+	}
 
 	return d.handleNewOrder(ctx, order.Id.Hex())
 }
 
+// GetOrderRetryBackoff returns the exponential backoff after the given number of failed attempts
+func GetOrderRetryBackoff(attempts int, initial time.Duration, max time.Duration) time.Duration {
+	backoff := initial
+	for i := 1; i < attempts && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		backoff = max
+	}
+	return backoff
+}
+
 func (d *defaultHandler) GetPrograms(ctx context.Context, event xealth_client.GetProgramsRequest) (*xealth_client.GetProgramsResponse, error) {
 	response := &xealth_client.GetProgramsResponse{}
 	if err := response.FromGetProgramsResponse1(xealth_client.GetProgramsResponse1{Present: false}); err != nil {
 		return nil, err
 	}
 
-	match, err := NewMatcher[*xealth_client.GetProgramsResponse](d.clinics, d.patients, d.logger).
+	match, err := NewMatcher[*xealth_client.GetProgramsResponse](d.provider, d.logger).
 		FromProgramsRequest(event).
 		OnNoMatchingPatientsRespondWith(response).
 		OnNoMatchingClinicsRespondWith(response).
@@ -310,7 +406,7 @@ func (d *defaultHandler) GetPrograms(ctx context.Context, event xealth_client.Ge
 }
 
 func (d *defaultHandler) GetProgramUrl(ctx context.Context, event xealth_client.GetProgramUrlRequest) (*xealth_client.GetProgramUrlResponse, error) {
-	match, err := NewMatcher[*xealth_client.GetProgramUrlResponse](d.clinics, d.patients, d.logger).
+	match, err := NewMatcher[*xealth_client.GetProgramUrlResponse](d.provider, d.logger).
 		FromProgramUrlRequest(event).
 		Match(ctx)
 	if err != nil {
@@ -418,7 +514,7 @@ func (d *defaultHandler) handleNewOrder(ctx context.Context, documentId string)
 		}
 	}
 
-	match, err := NewMatcher[*xealth_client.EventNotificationResponse](d.clinics, d.patients, d.logger).
+	match, err := NewMatcher[*xealth_client.EventNotificationResponse](d.provider, d.logger).
 		FromOrder(*order).
 		DisableErrorOnNoMatchingClinics().
 		DisableErrorOnNoMatchingPatients().
@@ -432,9 +528,9 @@ func (d *defaultHandler) handleNewOrder(ctx context.Context, documentId string)
 		return nil
 	}
 
-	update, err := GetSubscriptionUpdateFromOrderEvent(*order, match.Clinic)
+	orderRef, err := GetOrderRefFromOrderEvent(*order, match.Clinic)
 	if err != nil {
-		return fmt.Errorf("unable to create subscription update: %w", err)
+		return fmt.Errorf("unable to get order reference: %w", err)
 	}
 
 	if match.Patient == nil {
@@ -466,7 +562,11 @@ func (d *defaultHandler) handleNewOrder(ctx context.Context, documentId string)
 		}
 	}
 
-	err = d.patients.UpdateEHRSubscription(ctx, match.Clinic.Id.Hex(), *match.Patient.UserId, *update)
+	if order.EventNotification.EventContext == xealth_client.EventNotificationEventContextCancel {
+		err = d.provider.OrderCancelled(ctx, *match.Clinic, *match.Patient, *orderRef)
+	} else {
+		err = d.provider.OrderCreated(ctx, *match.Clinic, *match.Patient, *orderRef)
+	}
 	if err != nil {
 		return fmt.Errorf("unable to update ehr subscription: %w", err)
 	}
@@ -545,7 +645,8 @@ func GetPatientCreateFromOrder(match MatchingResult[*xealth_client.EventNotifica
 	return &create, nil
 }
 
-func GetSubscriptionUpdateFromOrderEvent(orderEvent OrderEvent, clinic *clinics.Clinic) (*patients.SubscriptionUpdate, error) {
+// GetOrderRefFromOrderEvent returns the reference of a new or cancelled order of the reports program of the clinic
+func GetOrderRefFromOrderEvent(orderEvent OrderEvent, clinic *clinics.Clinic) (*ehr.OrderRef, error) {
 	if orderEvent.EventNotification.EventType != xealth_client.EventNotificationEventTypeOrder {
 		return nil, fmt.Errorf("%w: unsupported event type %s", errs.BadRequest, orderEvent.EventNotification.EventType)
 	}
@@ -555,25 +656,16 @@ func GetSubscriptionUpdateFromOrderEvent(orderEvent OrderEvent, clinic *clinics.
 		return nil, fmt.Errorf("%w: unknown program id in order %s", errs.BadRequest, orderEvent.OrderData.OrderInfo.OrderId)
 	}
 
-	update := patients.SubscriptionUpdate{
-		Name: patients.SubscriptionXealthReports,
-		MatchedMessage: patients.MatchedMessage{
-			DocumentId: *orderEvent.Id,
-			DataModel:  string(orderEvent.EventNotification.EventType),
-			EventType:  string(orderEvent.EventNotification.EventContext),
-		},
-		Provider: clinics.EHRProviderXealth,
-	}
-
-	if orderEvent.EventNotification.EventContext == xealth_client.EventNotificationEventContextNew {
-		update.Active = true
-	} else if orderEvent.EventNotification.EventContext == xealth_client.EventNotificationEventContextCancel {
-		update.Active = false
-	} else {
+	if orderEvent.EventNotification.EventContext != xealth_client.EventNotificationEventContextNew &&
+		orderEvent.EventNotification.EventContext != xealth_client.EventNotificationEventContextCancel {
 		return nil, fmt.Errorf("%w: unsupported event context %s", errs.BadRequest, orderEvent.EventNotification.EventContext)
 	}
 
-	return &update, nil
+	return &ehr.OrderRef{
+		DocumentId: *orderEvent.Id,
+		DataModel:  string(orderEvent.EventNotification.EventType),
+		EventType:  string(orderEvent.EventNotification.EventContext),
+	}, nil
 }
 
 func (d *defaultHandler) GetXealthOrder(ctx context.Context, deployment, orderId string) (*xealth_client.ReadOrderResponse, error) {